@@ -3,6 +3,7 @@ package autonity
 import (
 	"bytes"
 	"errors"
+	"math"
 	"math/big"
 	"strings"
 	"sync"
@@ -198,6 +199,37 @@ func (c *AutonityContract) Committee(header *types.Header, db vm.StateDB) ([]typ
 	return c.callGetCommittee(db, header)
 }
 
+// LiquidContracts returns the Liquid contracts of all the registered validators.
+func (c *AutonityContract) LiquidContracts(header *types.Header, db vm.StateDB) ([]common.Address, error) {
+	return c.callGetLiquidContracts(db, header)
+}
+
+// Validator returns the validator registered with the given node address.
+func (c *AutonityContract) Validator(header *types.Header, db vm.StateDB, addr common.Address) (*AutonityValidator, error) {
+	return c.callGetValidator(db, header, addr)
+}
+
+// EpochID returns the current epoch.
+func (c *AutonityContract) EpochID(header *types.Header, db vm.StateDB) (uint64, error) {
+	return c.callEpochID(db, header)
+}
+
+// EpochPeriod returns the epoch period in effect.
+func (c *AutonityContract) EpochPeriod(header *types.Header, db vm.StateDB) (uint64, error) {
+	return c.callGetEpochPeriod(db, header)
+}
+
+// EpochFromBlock returns the epoch of the given block, which must have been
+// finalized in the state.
+func (c *AutonityContract) EpochFromBlock(header *types.Header, db vm.StateDB, block uint64) (uint64, error) {
+	return c.callGetEpochFromBlock(db, header, block)
+}
+
+// LiquidBalance returns the balance of an account in the given Liquid contract.
+func (c *AutonityContract) LiquidBalance(header *types.Header, db vm.StateDB, liquid common.Address, account common.Address) (*big.Int, error) {
+	return c.callLiquidBalanceOf(db, header, liquid, account)
+}
+
 func (c *AutonityContract) MinimumBaseFee(block *types.Header, db vm.StateDB) (*big.Int, error) {
 	if block.Number.Uint64() <= 1 {
 		return new(big.Int).SetUint64(c.chainConfig.AutonityContractConfig.MinBaseFee), nil
@@ -242,10 +274,17 @@ func (c *AutonityContract) electProposer(parentHeader *types.Header, height uint
 	}
 
 	// for power weighted sampling, we distribute seed into a 256bits key-space, and compute the hit index.
-	h := new(big.Int).SetUint64(height)
-	r := new(big.Int).SetInt64(round)
-	key := r.Add(r, h.Mul(h, seed))
-	value := new(big.Int).SetBytes(crypto.Keccak256(key.Bytes()))
+	var value *big.Int
+	if c.chainConfig != nil && c.chainConfig.IsRandomBeacon(parentHeader.Number) {
+		// the beacon of the parent block is unknown until it is proposed, and its
+		// proposer cannot bias it as BLS signatures are unique.
+		value = new(big.Int).SetBytes(crypto.Keccak256(parentHeader.BeaconSeed().Bytes(), common.BigToHash(big.NewInt(round)).Bytes()))
+	} else {
+		h := new(big.Int).SetUint64(height)
+		r := new(big.Int).SetInt64(round)
+		key := r.Add(r, h.Mul(h, seed))
+		value = new(big.Int).SetBytes(crypto.Keccak256(key.Bytes()))
+	}
 	index := value.Mod(value, totalVotingPower)
 
 	// find the index hit which committee member which line up in the committee list.
@@ -320,6 +359,22 @@ func (c *AutonityContract) FinalizeAndGetCommittee(header *types.Header, statedb
 	return committee, receipt, nil
 }
 
+// ApplyFinalize runs the finalize() system call of the Autonity contract on the
+// given EVM, as FinalizeAndGetCommittee does at the end of every block, so that
+// it can be traced. It returns the output of the call and the gas it used. The
+// contract upgrade which may follow it is not applied.
+func ApplyFinalize(evm *vm.EVM) ([]byte, uint64, error) {
+	gas := uint64(math.MaxUint64)
+	ret, leftOver, err := evm.Call(vm.AccountRef(params.DeployerAddress), params.AutonityContractAddress, FinalizeCallData(), gas, new(big.Int))
+	return ret, gas - leftOver, err
+}
+
+// FinalizeCallData returns the input of the finalize() system call. finalize()
+// takes no argument, its selector is kept across upgrades.
+func FinalizeCallData() []byte {
+	return common.CopyBytes(generated.AutonityAbi.Methods["finalize"].ID)
+}
+
 func (c *AutonityContract) upgradeAutonityContract(statedb vm.StateDB, header *types.Header) error {
 	log.Info("Initiating Autonity Contracts upgrade", "header", header.Number.Uint64())
 
@@ -373,6 +428,8 @@ func (c *EVMContract) upgradeAbiCache(newAbi string) error {
 
 // ABI returns the current autonity contract's ABI
 func (c *EVMContract) ABI() *abi.ABI {
+	c.RLock()
+	defer c.RUnlock()
 	return c.contractABI
 }
 
@@ -425,6 +482,10 @@ type UpgradeManagerContract struct {
 	EVMContract
 }
 
+type GovernanceContract struct {
+	EVMContract
+}
+
 func NewGenesisEVMContract(genesisEvmProvider GenesisEVMProvider, statedb vm.StateDB, db ethdb.Database, chainConfig *params.ChainConfig) *GenesisEVMContracts {
 	evmProvider := func(header *types.Header, origin common.Address, statedb vm.StateDB) *vm.EVM {
 		if header != nil {
@@ -489,6 +550,14 @@ func NewGenesisEVMContract(genesisEvmProvider GenesisEVMProvider, statedb vm.Sta
 				chainConfig: chainConfig,
 			},
 		},
+		GovernanceContract: GovernanceContract{
+			EVMContract{
+				evmProvider: evmProvider,
+				contractABI: &generated.GovernanceAbi,
+				db:          db,
+				chainConfig: chainConfig,
+			},
+		},
 		statedb: statedb,
 	}
 }
@@ -501,6 +570,7 @@ type GenesisEVMContracts struct {
 	SupplyControlContract
 	StabilizationContract
 	UpgradeManagerContract
+	GovernanceContract
 
 	statedb vm.StateDB
 }
@@ -571,3 +641,7 @@ func (c *GenesisEVMContracts) DeployStabilizationContract(
 func (c *GenesisEVMContracts) DeployUpgradeManagerContract(autonityAddress common.Address, operatorAddress common.Address, bytecode []byte) error {
 	return c.UpgradeManagerContract.DeployContract(nil, params.DeployerAddress, c.statedb, bytecode, autonityAddress, operatorAddress)
 }
+
+func (c *GenesisEVMContracts) DeployGovernanceContract(autonityAddress common.Address, config GovernanceConfig, bytecode []byte) error {
+	return c.GovernanceContract.DeployContract(nil, params.DeployerAddress, c.statedb, bytecode, autonityAddress, config)
+}
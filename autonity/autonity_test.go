@@ -142,6 +142,35 @@ func TestElectProposer(t *testing.T) {
 			log.Print("electing ", "proposer: ", c.Address.String(), " stake: ", stake, " scheduled: ", scheduled)
 		}
 	})
+
+	t.Run("Proposer election from the random beacon of the parent header", func(t *testing.T) {
+		beaconContract := &AutonityContract{EVMContract: EVMContract{chainConfig: &params.ChainConfig{RandomBeaconBlock: new(big.Int).SetUint64(height)}}}
+		committee := generateCommittee(linearPowers)
+		parentHeader := newBlockHeader(height, committee)
+		parentHeader.Beacon = crypto.Keccak256([]byte("beacon"))
+		for r := int64(0); r <= int64(3); r++ {
+			require.Equal(t, beaconContract.electProposer(parentHeader, height, r), beaconContract.electProposer(parentHeader, height, r))
+		}
+
+		// the height no longer matters, only the beacon does
+		require.Equal(t, beaconContract.electProposer(parentHeader, height, 0), beaconContract.electProposer(parentHeader, height+1, 0))
+
+		// the proposers are elected proportionally to their voting power
+		counterMap := make(map[common.Address]int)
+		const beacons = 20000
+		for i := 0; i < beacons; i++ {
+			parentHeader.Beacon = crypto.Keccak256(big.NewInt(int64(i)).Bytes())
+			counterMap[beaconContract.electProposer(parentHeader, height, 0)]++
+		}
+		for _, c := range committee {
+			expected := float64(c.VotingPower.Uint64()) / 1500
+			require.InDelta(t, expected, float64(counterMap[c.Address])/beacons, 0.015)
+		}
+
+		// before the fork the legacy election applies
+		parentHeader.Number = new(big.Int).SetUint64(height - 1)
+		require.Equal(t, ac.electProposer(parentHeader, height-1, 1), beaconContract.electProposer(parentHeader, height-1, 1))
+	})
 }
 
 func newBlockHeader(height uint64, committee types.Committee) *types.Header {
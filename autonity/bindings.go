@@ -121,6 +121,37 @@ type AutonityValidator struct {
 	State                    uint8
 }
 
+// GovernanceConfig is an auto generated low-level Go binding around an user-defined struct.
+type GovernanceConfig struct {
+	VotingPeriod   *big.Int
+	Quorum         *big.Int
+	Threshold      *big.Int
+	Timelock       *big.Int
+	MinVotingPower *big.Int
+}
+
+// GovernanceProposal is an auto generated low-level Go binding around an user-defined struct.
+type GovernanceProposal struct {
+	Proposer    common.Address
+	Description string
+	Targets     []common.Address
+	Calldatas   [][]byte
+	EndBlock    *big.Int
+	TotalStake  *big.Int
+	Eta         *big.Int
+	YesVotes    *big.Int
+	NoVotes     *big.Int
+	State       uint8
+}
+
+// GovernanceVote is an auto generated low-level Go binding around an user-defined struct.
+type GovernanceVote struct {
+	Choice     uint8
+	Power      *big.Int
+	Validators []common.Address
+	Locked     []*big.Int
+}
+
 // IOracleRoundData is an auto generated low-level Go binding around an user-defined struct.
 type IOracleRoundData struct {
 	Round     *big.Int
@@ -888,7 +919,7 @@ var AccountabilityMetaData = &bind.MetaData{
 		"6b5f444c": "setEpochPeriod(uint256)",
 		"e7bb0b52": "slashingHistory(address,uint256)",
 	},
-	Bin: "0x608060405260006011553480156200001657600080fd5b5060405162003d8f38038062003d8f8339810160408190526200003991620000f7565b600180546001600160a01b0319166001600160a01b03841690811790915560408051636fd8d26960e11b8152905163dfb1a4d2916004808201926020929091908290030181865afa15801562000093573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620000b99190620001bf565b6000558051600355602081015160045560408101516005556060810151600655608081015160075560a081015160085560c0015160095550620001d9565b6000808284036101008112156200010d57600080fd5b83516001600160a01b03811681146200012557600080fd5b925060e0601f19820112156200013a57600080fd5b5060405160e081016001600160401b03811182821017156200016c57634e487b7160e01b600052604160045260246000fd5b80604052506020840151815260408401516020820152606084015160408201526080840151606082015260a0840151608082015260c084015160a082015260e084015160c0820152809150509250929050565b600060208284031215620001d257600080fd5b5051919050565b613ba680620001e96000396000f3fe6080604052600436106100c25760003560e01c806379502c551161007f578063b5b7a18411610059578063b5b7a1841461029f578063bebaa8fc146102c3578063c50d21f0146102f0578063e7bb0b521461031057600080fd5b806379502c55146101d75780637ccecadd1461023b5780639cb22b061461027257600080fd5b806301567739146100c75780630b7914301461011a5780631de9d9b6146101525780634108a95a146101675780636b5f444c146101975780636c9789b0146101b7575b600080fd5b3480156100d357600080fd5b506100fd6100e2366004612f7e565b600a602052600090815260409020546001600160a01b031681565b6040516001600160a01b0390911681526020015b60405180910390f35b34801561012657600080fd5b5061013a610135366004612fa2565b610348565b6040516101119c9b9a99989796959493929190613045565b610165610160366004612f7e565b61045b565b005b34801561017357600080fd5b506101876101823660046130e0565b61066a565b6040519015158152602001610111565b3480156101a357600080fd5b506101656101b2366004612fa2565b61071a565b3480156101c357600080fd5b506101656101d236600461311e565b610749565b3480156101e357600080fd5b506003546004546005546006546007546008546009546102069695949392919087565b604080519788526020880196909652948601939093526060850191909152608084015260a083015260c082015260e001610111565b34801561024757600080fd5b5061025b6102563660046130e0565b61078c565b604080519215158352602083019190915201610111565b34801561027e57600080fd5b5061029261028d366004612f7e565b6108e1565b6040516101119190613216565b3480156102ab57600080fd5b506102b560005481565b604051908152602001610111565b3480156102cf57600080fd5b506102e36102de366004612f7e565b610b08565b6040516101119190613229565b3480156102fc57600080fd5b5061016561030b3660046133be565b610da7565b34801561031c57600080fd5b506102b561032b3660046134ca565b600e60209081526000928352604080842090915290825290205481565b6002818154811061035857600080fd5b600091825260209091206008909102018054600182015460028301805460ff8085169650610100850481169562010000860482169563010000008104909216946001600160a01b03600160201b9093048316949216929091906103ba906134f6565b80601f01602080910402602001604051908101604052809291908181526020018280546103e6906134f6565b80156104335780601f1061040857610100808354040283529160200191610433565b820191906000526020600020905b81548152906001019060200180831161041657829003601f168201915b505050505090806003015490806004015490806005015490806006015490806007015490508c565b6001546001600160a01b0316331461048e5760405162461bcd60e51b815260040161048590613530565b60405180910390fd5b6001546001600160a01b038281166000908152600a6020526040808220549051630c825d9760e11b8152908316600482015290929190911690631904bb2e90602401600060405180830381865afa1580156104ed573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405261051591908101906135db565b516040519091506000906001600160a01b038316906108fc90349084818181858888f193505050503d8060008114610569576040519150601f19603f3d011682016040523d82523d6000602084013e61056e565b606091505b505090508061064157600160009054906101000a90046001600160a01b03166001600160a01b031663f7866ee36040518163ffffffff1660e01b8152600401602060405180830381865afa1580156105ca573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906105ee9190613759565b6001600160a01b03163460405160006040518083038185875af1925050503d8060008114610638576040519150601f19603f3d011682016040523d82523d6000602084013e61063d565b606091505b5050505b50506001600160a01b03166000908152600a6020526040902080546001600160a01b0319169055565b60008061067684611114565b6001546040516396b477cb60e01b8152600481018690529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa1580156106c5573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906106e99190613776565b6001600160a01b0387166000908152600e602090815260408083209383529290522054919091109150509392505050565b6001546001600160a01b031633146107445760405162461bcd60e51b815260040161048590613530565b600055565b6001546001600160a01b031633146107735760405162461bcd60e51b815260040161048590613530565b61077b61117f565b8015610789576107896114d7565b50565b600080600061079a85611114565b6001546040516396b477cb60e01b8152600481018790529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa1580156107e9573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061080d9190613776565b6001600160a01b0388166000908152600e6020908152604080832084845290915290205490915082116108475760009350600092506108d7565b6001600160a01b0387166000908152600c6020526040902054156108ce576001600160a01b0387166000908152600c602052604081205460029061088d906001906137a5565b8154811061089d5761089d6137b8565b906000526020600020906008020190506000945060036000015481600401546108c691906137ce565b9350506108d7565b60019350600092505b5050935093915050565b6108e9612e91565b6001600160a01b0382166000908152600c602052604090205461093e5760405162461bcd60e51b815260206004820152600d60248201526c37379030b1b1bab9b0ba34b7b760991b6044820152606401610485565b6001600160a01b0382166000908152600c6020526040902054600290610966906001906137a5565b81548110610976576109766137b8565b600091825260209182902060408051610180810182526008909302909101805460ff8082168552610100820481169585019590955292939092918401916201000090041660028111156109cb576109cb612fbb565b60028111156109dc576109dc612fbb565b815281546020909101906301000000900460ff166009811115610a0157610a01612fbb565b6009811115610a1257610a12612fbb565b815281546001600160a01b03600160201b909104811660208301526001830154166040820152600282018054606090920191610a4d906134f6565b80601f0160208091040260200160405190810160405280929190818152602001828054610a79906134f6565b8015610ac65780601f10610a9b57610100808354040283529160200191610ac6565b820191906000526020600020905b815481529060010190602001808311610aa957829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509050919050565b6001600160a01b0381166000908152600b60205260408120546060919067ffffffffffffffff811115610b3d57610b3d61328b565b604051908082528060200260200182016040528015610b7657816020015b610b63612e91565b815260200190600190039081610b5b5790505b50905060005b6001600160a01b0384166000908152600b6020526040902054811015610da0576001600160a01b0384166000908152600b6020526040902080546002919083908110610bca57610bca6137b8565b906000526020600020015481548110610be557610be56137b8565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115610c3a57610c3a612fbb565b6002811115610c4b57610c4b612fbb565b815281546020909101906301000000900460ff166009811115610c7057610c70612fbb565b6009811115610c8157610c81612fbb565b815281546001600160a01b03600160201b909104811660208301526001830154166040820152600282018054606090920191610cbc906134f6565b80601f0160208091040260200160405190810160405280929190818152602001828054610ce8906134f6565b8015610d355780601f10610d0a57610100808354040283529160200191610d35565b820191906000526020600020905b815481529060010190602001808311610d1857829003601f168201915b5050505050815260200160038201548152602001600482015481526020016005820154815260200160068201548152602001600782015481525050828281518110610d8257610d826137b8565b60200260200101819052508080610d98906137e1565b915050610b7c565b5092915050565b600154604051630c825d9760e11b81523360048201526000916001600160a01b031690631904bb2e90602401600060405180830381865afa158015610df0573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052610e1891908101906135db565b60208101519091506001600160a01b03163314610e8d5760405162461bcd60e51b815260206004820152602d60248201527f66756e6374696f6e207265737472696374656420746f2061207265676973746560448201526c3932b2103b30b634b230ba37b960991b6064820152608401610485565b60808201516001600160a01b03163314610ee95760405162461bcd60e51b815260206004820152601d60248201527f6576656e74207265706f72746572206d7573742062652063616c6c65720000006044820152606401610485565b6001826000015160ff16111561109e576000610f04836117b9565b905080610f1057505050565b336000908152600d6020908152604091829020825161018081018452815460ff80821683526101008204811694830194909452909391929184019162010000909104166002811115610f6457610f64612fbb565b6002811115610f7557610f75612fbb565b815281546020909101906301000000900460ff166009811115610f9a57610f9a612fbb565b6009811115610fab57610fab612fbb565b815281546001600160a01b03600160201b909104811660208301526001830154166040820152600282018054606090920191610fe6906134f6565b80601f0160208091040260200160405190810160405280929190818152602001828054611012906134f6565b801561105f5780601f106110345761010080835404028352916020019161105f565b820191906000526020600020905b81548152906001019060200180831161104257829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509250505b6000826040015160028111156110b6576110b6612fbb565b036110c8576110c4826119f2565b5050565b6001826040015160028111156110e0576110e0612fbb565b036110ee576110c482611bf8565b60028260400151600281111561110657611106612fbb565b036110c4576110c482611d67565b6000600982600981111561112a5761112a612fbb565b036111385760025b92915050565b600082600981111561114c5761114c612fbb565b03611158576002611132565b600182600981111561116c5761116c612fbb565b03611178576002611132565b6002611132565b6011545b6010548110156114d2576000601082815481106111a2576111a26137b8565b90600052602060002001549050806000036111bd57506114c0565b6111c86001826137a5565b90506000600282815481106111df576111df6137b8565b600091825260209182902060408051610180810182526008909302909101805460ff80821685526101008204811695850195909552929390929184019162010000900416600281111561123457611234612fbb565b600281111561124557611245612fbb565b815281546020909101906301000000900460ff16600981111561126a5761126a612fbb565b600981111561127b5761127b612fbb565b815281546001600160a01b03600160201b9091048116602083015260018301541660408201526002820180546060909201916112b6906134f6565b80601f01602080910402602001604051908101604052809291908181526020018280546112e2906134f6565b801561132f5780601f106113045761010080835404028352916020019161132f565b820191906000526020600020905b81548152906001019060200180831161131257829003601f168201915b505050505081526020016003820154815260200160048201548152602001600582015481526020016006820154815260200160078201548152505090504360036000015482610140015161138391906137ce565b1115611390575050601155565b60a08101516001600160a01b03166000908152600c6020526040812081905560608201516113bd90611114565b60a08301516001600160a01b03166000908152600e60209081526040808320610120870151845290915290205490915081116113fb575050506114c0565b60a0820180516001600160a01b039081166000908152600e6020908152604080832061012088015184528252808320869055845184168352600b825280832080546001808201835591855283852001899055600f805491820181559093527f8d1108e10bcb7c27dddfc02ed9d693a074039d026cf4ea4240b40f7d581ac80290920187905592518151858152938401879052909116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f910160405180910390a25050505b806114ca816137e1565b915050611183565b601155565b600080600160009054906101000a90046001600160a01b03166001600160a01b031663c9d97af46040518163ffffffff1660e01b8152600401602060405180830381865afa15801561152d573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906115519190613776565b905060005b600f548110156115c857816002600f8381548110611576576115766137b8565b906000526020600020015481548110611591576115916137b8565b906000526020600020906008020160050154036115b6576115b36001846137ce565b92505b806115c0816137e1565b915050611556565b5060005b600f548110156117ac5761179a6002600f83815481106115ee576115ee6137b8565b906000526020600020015481548110611609576116096137b8565b600091825260209182902060408051610180810182526008909302909101805460ff80821685526101008204811695850195909552929390929184019162010000900416600281111561165e5761165e612fbb565b600281111561166f5761166f612fbb565b815281546020909101906301000000900460ff16600981111561169457611694612fbb565b60098111156116a5576116a5612fbb565b815281546001600160a01b03600160201b9091048116602083015260018301541660408201526002820180546060909201916116e0906134f6565b80601f016020809104026020016040519081016040528092919081815260200182805461170c906134f6565b80156117595780601f1061172e57610100808354040283529160200191611759565b820191906000526020600020905b81548152906001019060200180831161173c57829003601f168201915b505050505081526020016003820154815260200160048201548152602001600582015481526020016006820154815260200160078201548152505084611ea7565b806117a4816137e1565b9150506115cc565b506110c4600f6000612f04565b6000816020015160ff166000036118ee57336000908152600d6020908152604091829020845181549286015160ff9081166101000261ffff1990941691161791909117808255918401518492829062ff000019166201000083600281111561182357611823612fbb565b021790555060608201518154829063ff0000001916630100000083600981111561184f5761184f612fbb565b021790555060808201518154640100000000600160c01b031916600160201b6001600160a01b039283160217825560a08301516001830180546001600160a01b0319169190921617905560c082015160028201906118ad9082613845565b5060e0820151600382015561010082015160048201556101208201516005820155610140820151600682015561016090910151600790910155506000919050565b602080830151336000908152600d90925260409091205460ff9182169161191c916101009004166001613905565b60ff161461196c5760405162461bcd60e51b815260206004820152601960248201527f6368756e6b73206d75737420626520636f6e746967756f7573000000000000006044820152606401610485565b336000908152600d6020526040902060c083015161198d9160020190612441565b336000908152600d6020526040902080546001919082906119b7908290610100900460ff16613905565b92506101000a81548160ff021916908360ff160217905550816000015160ff16826020015160016119e89190613905565b60ff161492915050565b6000806000806000611a0960fe8760c0015161258b565b9450945094509450945084611a605760405162461bcd60e51b815260206004820152601960248201527f6661696c65642070726f6f6620766572696669636174696f6e000000000000006044820152606401610485565b8560a001516001600160a01b0316846001600160a01b031614611a955760405162461bcd60e51b81526004016104859061391e565b85606001516009811115611aab57611aab612fbb565b8314611ac95760405162461bcd60e51b815260040161048590613949565b438210611b115760405162461bcd60e51b815260206004820152601660248201527563616e277420626520696e207468652066757475726560501b6044820152606401610485565b60008211611b575760405162461bcd60e51b815260206004820152601360248201527263616e27742062652061742067656e6573697360681b6044820152606401610485565b6001546040516396b477cb60e01b8152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa158015611ba1573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611bc59190613776565b610100880184905261012088018190524361014089015261016088018390529050611bef876125f2565b50505050505050565b6000806000806000611c0f60fc8760c0015161258b565b9450945094509450945084611c665760405162461bcd60e51b815260206004820152601e60248201527f6661696c65642061636375736174696f6e20766572696669636174696f6e00006044820152606401610485565b8560a001516001600160a01b0316846001600160a01b031614611c9b5760405162461bcd60e51b81526004016104859061391e565b85606001516009811115611cb157611cb1612fbb565b8314611ccf5760405162461bcd60e51b815260040161048590613949565b6001546040516396b477cb60e01b8152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa158015611d19573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611d3d9190613776565b610100880184905261012088018190524361014089015261016088018390529050611bef87612876565b6000806000806000611d7e60fd8760c0015161258b565b9450945094509450945084611dd55760405162461bcd60e51b815260206004820152601d60248201527f6661696c656420696e6e6f63656e636520766572696669636174696f6e0000006044820152606401610485565b8560a001516001600160a01b0316846001600160a01b031614611e0a5760405162461bcd60e51b81526004016104859061391e565b85606001516009811115611e2057611e20612fbb565b8314611e3e5760405162461bcd60e51b815260040161048590613949565b438210611e865760405162461bcd60e51b815260206004820152601660248201527563616e277420626520696e207468652066757475726560501b6044820152606401610485565b61010086018290526101608601819052611e9f86612b2d565b505050505050565b60015460a0830151604051630c825d9760e11b81526001600160a01b0391821660048201526000929190911690631904bb2e90602401600060405180830381865afa158015611efa573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052611f2291908101906135db565b608084015160a08501516001600160a01b039081166000908152600a6020526040902080546001600160a01b03191691909216179055905060038161026001516003811115611f7357611f73612fbb565b03611f7d57505050565b6000611f94611f8f8560600151611114565b612e38565b61022083015160075491925090600090611fae9083613973565b600654611fbb9087613973565b611fc590856137ce565b611fcf91906137ce565b600954909150811115611fe157506009545b60008461012001518560c001518660a00151611ffd91906137ce565b61200791906137ce565b60095490915060009061201a8385613973565b612024919061398a565b905060008111801561203557508181145b1561216557600060a087018190526101008701819052610120870181905260c08701526101e08601805182919061206d9083906137ce565b90525061022086018051600191906120869083906137ce565b905250600361026087015260006102008701526001546040516301adf0b760e51b81526001600160a01b03909116906335be16e0906120c99089906004016139bc565b600060405180830381600087803b1580156120e357600080fd5b505af11580156120f7573d6000803e3d6000fd5b5050505060208681015160e08a0151604080516001600160a01b03909316835292820184905260008284015260016060830152608082015290517f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f99181900360a00190a15050505050505050565b610120860151819081116121925780876101200181815161218691906137a5565b905250600090506121ad565b6101208701516121a290826137a5565b600061012089015290505b801561222a5780876101000151106121f5578087610100018181516121d291906137a5565b90525060a0870180518291906121e99083906137a5565b9052506000905061222a565b61010087015161220590826137a5565b90508661010001518760a00181815161221e91906137a5565b90525060006101008801525b60008111801561224d575060008760a001518860c0015161224b91906137ce565b115b156122f95760008760a001518860c0015161226891906137ce565b60c08901516122779084613973565b612281919061398a565b905060008860a001518960c0015161229991906137ce565b60a08a01516122a89085613973565b6122b2919061398a565b9050818960c0018181516122c691906137a5565b90525060a0890180518291906122dd9083906137a5565b9052506122ea81836137ce565b6122f490846137a5565b925050505b61230381836137a5565b915081876101e00181815161231891906137ce565b90525061022087018051600191906123319083906137ce565b90525060005461022088015160085461234a9190613973565b6123549190613973565b61235e90436137ce565b61020088015260026102608801526001546040516301adf0b760e51b81526001600160a01b03909116906335be16e09061239c908a906004016139bc565b600060405180830381600087803b1580156123b657600080fd5b505af11580156123ca573d6000803e3d6000fd5b5050506020808901516102008a015160e08d0151604080516001600160a01b039094168452938301879052928201526000606082015260808101919091527f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f9915060a00160405180910390a1505050505050505050565b8154600260018083161561010002038216048251808201602081106020841001600281146124eb5760018114612510578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b808210156124d557815184556001840193506020820191506124b8565b815191036101000a908190040290915550611bef565b60028302826020036101000a846020036101000a602089015104020185018755611bef565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b808210156125715781518455600184019350602082019150612554565b815191036101000a90819004029091555050505050505050565b600080600080600080865160206125a291906137ce565b90506125ac612f22565b60a081838a8c5afa6125bd57600080fd5b80516001036125cb57600196505b602081015160408201516060830151608090930151989b919a509850909695509350505050565b60006126018260600151611114565b60a08301516001600160a01b03166000908152600e602090815260408083206101208701518452909152902054909150811161264f5760405162461bcd60e51b815260040161048590613b2c565b6002805460e084018190526001810182556000829052835160089091027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace018054602086015160ff9081166101000261ffff1990921693169290921791909117808255604085015185939091839162ff00001990911690620100009084908111156126dc576126dc612fbb565b021790555060608201518154829063ff0000001916630100000083600981111561270857612708612fbb565b021790555060808201518154640100000000600160c01b031916600160201b6001600160a01b039283160217825560a08301516001830180546001600160a01b0319169190921617905560c082015160028201906127669082613845565b5060e0828101516003830155610100830151600483015561012080840151600584015561014084015160068401556101609093015160079092019190915560a0840180516001600160a01b039081166000908152600b602090815260408083209589018051875460018181018a5598865284862001558051600f8054988901815585527f8d1108e10bcb7c27dddfc02ed9d693a074039d026cf4ea4240b40f7d581ac80290970196909655845184168352600e82528083209689015183529590528490208590559051915192519116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f9161286a91858252602082015260400190565b60405180910390a25050565b60a08101516001600160a01b03166000908152600c6020526040902054156128e05760405162461bcd60e51b815260206004820181905260248201527f616c72656164792070726f63657373696e6720616e2061636375736174696f6e6044820152606401610485565b60006128ef8260600151611114565b60a08301516001600160a01b03166000908152600e602090815260408083206101208701518452909152902054909150811161293d5760405162461bcd60e51b815260040161048590613b2c565b6002805460e084018190526001810182556000829052835160089091027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace018054602086015160ff9081166101000261ffff1990921693169290921791909117808255604085015185939091839162ff00001990911690620100009084908111156129ca576129ca612fbb565b021790555060608201518154829063ff000000191663010000008360098111156129f6576129f6612fbb565b021790555060808201518154640100000000600160c01b031916600160201b6001600160a01b039283160217825560a08301516001830180546001600160a01b0319169190921617905560c08201516002820190612a549082613845565b5060e082810151600383015561010083015160048301556101208301516005830155610140830151600683015561016090920151600790910155820151612a9c9060016137ce565b60a08301516001600160a01b03166000908152600c602052604090205560e0820151601090612acc9060016137ce565b81546001810183556000928352602092839020015560a083015160e084015160408051858152938401919091526001600160a01b03909116917f2e8e354b41470731dafa7c3df150e9498a8d5b9c51ff0259fbf77f721ba40351910161286a565b60a08101516001600160a01b03166000908152600c602052604081205490819003612b9a5760405162461bcd60e51b815260206004820152601860248201527f6e6f206173736f6369617465642061636375736174696f6e00000000000000006044820152606401610485565b81606001516009811115612bb057612bb0612fbb565b6002612bbd6001846137a5565b81548110612bcd57612bcd6137b8565b60009182526020909120600890910201546301000000900460ff166009811115612bf957612bf9612fbb565b14612c565760405162461bcd60e51b815260206004820152602760248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e206044820152661c9d5b19481a5960ca1b6064820152608401610485565b6101008201516002612c696001846137a5565b81548110612c7957612c796137b8565b90600052602060002090600802016004015414612ce65760405162461bcd60e51b815260206004820152602560248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e20604482015264626c6f636b60d81b6064820152608401610485565b6101608201516002612cf96001846137a5565b81548110612d0957612d096137b8565b90600052602060002090600802016007015414612d745760405162461bcd60e51b8152602060048201526024808201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e206044820152630d0c2e6d60e31b6064820152608401610485565b6011545b601054811015612dde578160108281548110612d9657612d966137b8565b906000526020600020015403612dcc57600060108281548110612dbb57612dbb6137b8565b600091825260209091200155612dde565b80612dd6816137e1565b915050612d78565b5060a0820180516001600160a01b039081166000908152600c602090815260408083208390559351935191825292909116917f1fa96beb8dddcb7d4484dd00c4059e872439f7a474a2ecf49c430fc6e86c9e1f910161286a565b600081612e4757505060055490565b60018203612e5757505060055490565b60028203612e6757505060055490565b60038203612e7757505060055490565b60048203612e885750612710919050565b50612710919050565b6040805161018081018252600080825260208201819052909182019081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b50805460008255906000526020600020908101906107899190612f40565b6040518060a001604052806005906020820280368337509192915050565b5b80821115612f555760008155600101612f41565b5090565b6001600160a01b038116811461078957600080fd5b8035612f7981612f59565b919050565b600060208284031215612f9057600080fd5b8135612f9b81612f59565b9392505050565b600060208284031215612fb457600080fd5b5035919050565b634e487b7160e01b600052602160045260246000fd5b60038110612fe157612fe1612fbb565b9052565b600a8110612fe157612fe1612fbb565b60005b83811015613010578181015183820152602001612ff8565b50506000910152565b60008151808452613031816020860160208601612ff5565b601f01601f19169290920160200192915050565b600061018060ff8f16835260ff8e166020840152613066604084018e612fd1565b613073606084018d612fe5565b6001600160a01b038b811660808501528a1660a084015260c0830181905261309d8184018a613019565b60e0840198909852505061010081019490945261012084019290925261014083015261016090910152979650505050505050565b8035600a8110612f7957600080fd5b6000806000606084860312156130f557600080fd5b833561310081612f59565b925061310e602085016130d1565b9150604084013590509250925092565b60006020828403121561313057600080fd5b81358015158114612f9b57600080fd5b805160ff16825260006101806020830151613160602086018260ff169052565b5060408301516131736040860182612fd1565b5060608301516131866060860182612fe5565b5060808301516131a160808601826001600160a01b03169052565b5060a08301516131bc60a08601826001600160a01b03169052565b5060c08301518160c08601526131d482860182613019565b60e08581015190870152610100808601519087015261012080860151908701526101408086015190870152610160948501519490950193909352509192915050565b602081526000612f9b6020830184613140565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101561327e57603f1988860301845261326c858351613140565b94509285019290850190600101613250565b5092979650505050505050565b634e487b7160e01b600052604160045260246000fd5b604051610180810167ffffffffffffffff811182821017156132c5576132c561328b565b60405290565b604051610280810167ffffffffffffffff811182821017156132c5576132c561328b565b604051601f8201601f1916810167ffffffffffffffff811182821017156133185761331861328b565b604052919050565b803560ff81168114612f7957600080fd5b803560038110612f7957600080fd5b600067ffffffffffffffff82111561335a5761335a61328b565b50601f01601f191660200190565b600082601f83011261337957600080fd5b813561338c61338782613340565b6132ef565b8181528460208386010111156133a157600080fd5b816020850160208301376000918101602001919091529392505050565b6000602082840312156133d057600080fd5b813567ffffffffffffffff808211156133e857600080fd5b9083019061018082860312156133fd57600080fd5b6134056132a1565b61340e83613320565b815261341c60208401613320565b602082015261342d60408401613331565b604082015261343e606084016130d1565b606082015261344f60808401612f6e565b608082015261346060a08401612f6e565b60a082015260c08301358281111561347757600080fd5b61348387828601613368565b60c08301525060e083810135908201526101008084013590820152610120808401359082015261014080840135908201526101609283013592810192909252509392505050565b600080604083850312156134dd57600080fd5b82356134e881612f59565b946020939093013593505050565b600181811c9082168061350a57607f821691505b60208210810361352a57634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526024908201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460408201526330ba37b960e11b606082015260800190565b8051612f7981612f59565b600082601f83011261359057600080fd5b815161359e61338782613340565b8181528460208386010111156135b357600080fd5b6135c4826020830160208701612ff5565b949350505050565b805160048110612f7957600080fd5b6000602082840312156135ed57600080fd5b815167ffffffffffffffff8082111561360557600080fd5b90830190610280828603121561361a57600080fd5b6136226132cb565b61362b83613574565b815261363960208401613574565b602082015261364a60408401613574565b604082015260608301518281111561366157600080fd5b61366d8782860161357f565b6060830152506080830151608082015260a083015160a082015260c083015160c082015260e083015160e08201526101008084015181830152506101208084015181830152506101408084015181830152506101608084015181830152506101806136d9818501613574565b908201526101a083810151908201526101c080840151908201526101e0808401519082015261020080840151908201526102208084015190820152610240808401518381111561372857600080fd5b6137348882870161357f565b828401525050610260915061374a8284016135cc565b91810191909152949350505050565b60006020828403121561376b57600080fd5b8151612f9b81612f59565b60006020828403121561378857600080fd5b5051919050565b634e487b7160e01b600052601160045260246000fd5b818103818111156111325761113261378f565b634e487b7160e01b600052603260045260246000fd5b808201808211156111325761113261378f565b6000600182016137f3576137f361378f565b5060010190565b601f82111561384057600081815260208120601f850160051c810160208610156138215750805b601f850160051c820191505b81811015611e9f5782815560010161382d565b505050565b815167ffffffffffffffff81111561385f5761385f61328b565b6138738161386d84546134f6565b846137fa565b602080601f8311600181146138a857600084156138905750858301515b600019600386901b1c1916600185901b178555611e9f565b600085815260208120601f198616915b828110156138d7578886015182559484019460019091019084016138b8565b50858210156138f55787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60ff81811683821601908111156111325761113261378f565b6020808252601190820152700decccccadcc8cae440dad2e6dac2e8c6d607b1b604082015260600190565b60208082526010908201526f0e4ead8ca40d2c840dad2e6dac2e8c6d60831b604082015260600190565b80820281158282048414176111325761113261378f565b6000826139a757634e487b7160e01b600052601260045260246000fd5b500490565b60048110612fe157612fe1612fbb565b602081526139d66020820183516001600160a01b03169052565b600060208301516139f260408401826001600160a01b03169052565b5060408301516001600160a01b0381166060840152506060830151610280806080850152613a246102a0850183613019565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a0613aab818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f190161026080880191909152909150613b0d8483613019565b935080870151915050613b22828601826139ac565b5090949350505050565b60208082526024908201527f616c726561647920736c6173686564206174207468652070726f6f66277320656040820152630e0dec6d60e31b60608201526080019056fea26469706673582212200beb213a013844825bbe0612f53b0f9ddb392dec9c7fe442e686cd4063e250e464736f6c63430008150033",
+	Bin: "0x608060405260006011553480156200001657600080fd5b5060405162003d8f38038062003d8f8339810160408190526200003991620000f7565b600180546001600160a01b0319166001600160a01b03841690811790915560408051636fd8d26960e11b8152905163dfb1a4d2916004808201926020929091908290030181865afa15801562000093573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620000b99190620001bf565b6000558051600355602081015160045560408101516005556060810151600655608081015160075560a081015160085560c0015160095550620001d9565b6000808284036101008112156200010d57600080fd5b83516001600160a01b03811681146200012557600080fd5b925060e0601f19820112156200013a57600080fd5b5060405160e081016001600160401b03811182821017156200016c57634e487b7160e01b600052604160045260246000fd5b80604052506020840151815260408401516020820152606084015160408201526080840151606082015260a0840151608082015260c084015160a082015260e084015160c0820152809150509250929050565b600060208284031215620001d257600080fd5b5051919050565b613ba680620001e96000396000f3fe6080604052600436106100c25760003560e01c806379502c551161007f578063b5b7a18411610059578063b5b7a1841461029f578063bebaa8fc146102c3578063c50d21f0146102f0578063e7bb0b521461031057600080fd5b806379502c55146101d75780637ccecadd1461023b5780639cb22b061461027257600080fd5b806301567739146100c75780630b7914301461011a5780631de9d9b6146101525780634108a95a146101675780636b5f444c146101975780636c9789b0146101b7575b600080fd5b3480156100d357600080fd5b506100fd6100e2366004612f7e565b600a602052600090815260409020546001600160a01b031681565b6040516001600160a01b0390911681526020015b60405180910390f35b34801561012657600080fd5b5061013a610135366004612fa2565b610348565b6040516101119c9b9a99989796959493929190613045565b610165610160366004612f7e565b61045b565b005b34801561017357600080fd5b506101876101823660046130e0565b61066a565b6040519015158152602001610111565b3480156101a357600080fd5b506101656101b2366004612fa2565b61071a565b3480156101c357600080fd5b506101656101d236600461311e565b610749565b3480156101e357600080fd5b506003546004546005546006546007546008546009546102069695949392919087565b604080519788526020880196909652948601939093526060850191909152608084015260a083015260c082015260e001610111565b34801561024757600080fd5b5061025b6102563660046130e0565b61078c565b604080519215158352602083019190915201610111565b34801561027e57600080fd5b5061029261028d366004612f7e565b6108e1565b6040516101119190613216565b3480156102ab57600080fd5b506102b560005481565b604051908152602001610111565b3480156102cf57600080fd5b506102e36102de366004612f7e565b610b08565b6040516101119190613229565b3480156102fc57600080fd5b5061016561030b3660046133be565b610da7565b34801561031c57600080fd5b506102b561032b3660046134ca565b600e60209081526000928352604080842090915290825290205481565b6002818154811061035857600080fd5b600091825260209091206008909102018054600182015460028301805460ff8085169650610100850481169562010000860482169563010000008104909216946001600160a01b03600160201b9093048316949216929091906103ba906134f6565b80601f01602080910402602001604051908101604052809291908181526020018280546103e6906134f6565b80156104335780601f1061040857610100808354040283529160200191610433565b820191906000526020600020905b81548152906001019060200180831161041657829003601f168201915b505050505090806003015490806004015490806005015490806006015490806007015490508c565b6001546001600160a01b0316331461048e5760405162461bcd60e51b815260040161048590613530565b60405180910390fd5b6001546001600160a01b038281166000908152600a6020526040808220549051630c825d9760e11b8152908316600482015290929190911690631904bb2e90602401600060405180830381865afa1580156104ed573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405261051591908101906135db565b516040519091506000906001600160a01b038316906108fc90349084818181858888f193505050503d8060008114610569576040519150601f19603f3d011682016040523d82523d6000602084013e61056e565b606091505b505090508061064157600160009054906101000a90046001600160a01b03166001600160a01b031663f7866ee36040518163ffffffff1660e01b8152600401602060405180830381865afa1580156105ca573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906105ee9190613759565b6001600160a01b03163460405160006040518083038185875af1925050503d8060008114610638576040519150601f19603f3d011682016040523d82523d6000602084013e61063d565b606091505b5050505b50506001600160a01b03166000908152600a6020526040902080546001600160a01b0319169055565b60008061067684611114565b6001546040516396b477cb60e01b8152600481018690529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa1580156106c5573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906106e99190613776565b6001600160a01b0387166000908152600e602090815260408083209383529290522054919091109150509392505050565b6001546001600160a01b031633146107445760405162461bcd60e51b815260040161048590613530565b600055565b6001546001600160a01b031633146107735760405162461bcd60e51b815260040161048590613530565b61077b61117f565b8015610789576107896114d7565b50565b600080600061079a85611114565b6001546040516396b477cb60e01b8152600481018790529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa1580156107e9573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061080d9190613776565b6001600160a01b0388166000908152600e6020908152604080832084845290915290205490915082116108475760009350600092506108d7565b6001600160a01b0387166000908152600c6020526040902054156108ce576001600160a01b0387166000908152600c602052604081205460029061088d906001906137a5565b8154811061089d5761089d6137b8565b906000526020600020906008020190506000945060036000015481600401546108c691906137ce565b9350506108d7565b60019350600092505b5050935093915050565b6108e9612e91565b6001600160a01b0382166000908152600c602052604090205461093e5760405162461bcd60e51b815260206004820152600d60248201526c37379030b1b1bab9b0ba34b7b760991b6044820152606401610485565b6001600160a01b0382166000908152600c6020526040902054600290610966906001906137a5565b81548110610976576109766137b8565b600091825260209182902060408051610180810182526008909302909101805460ff8082168552610100820481169585019590955292939092918401916201000090041660028111156109cb576109cb612fbb565b60028111156109dc576109dc612fbb565b815281546020909101906301000000900460ff166009811115610a0157610a01612fbb565b6009811115610a1257610a12612fbb565b815281546001600160a01b03600160201b909104811660208301526001830154166040820152600282018054606090920191610a4d906134f6565b80601f0160208091040260200160405190810160405280929190818152602001828054610a79906134f6565b8015610ac65780601f10610a9b57610100808354040283529160200191610ac6565b820191906000526020600020905b815481529060010190602001808311610aa957829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509050919050565b6001600160a01b0381166000908152600b60205260408120546060919067ffffffffffffffff811115610b3d57610b3d61328b565b604051908082528060200260200182016040528015610b7657816020015b610b63612e91565b815260200190600190039081610b5b5790505b50905060005b6001600160a01b0384166000908152600b6020526040902054811015610da0576001600160a01b0384166000908152600b6020526040902080546002919083908110610bca57610bca6137b8565b906000526020600020015481548110610be557610be56137b8565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115610c3a57610c3a612fbb565b6002811115610c4b57610c4b612fbb565b815281546020909101906301000000900460ff166009811115610c7057610c70612fbb565b6009811115610c8157610c81612fbb565b815281546001600160a01b03600160201b909104811660208301526001830154166040820152600282018054606090920191610cbc906134f6565b80601f0160208091040260200160405190810160405280929190818152602001828054610ce8906134f6565b8015610d355780601f10610d0a57610100808354040283529160200191610d35565b820191906000526020600020905b815481529060010190602001808311610d1857829003601f168201915b5050505050815260200160038201548152602001600482015481526020016005820154815260200160068201548152602001600782015481525050828281518110610d8257610d826137b8565b60200260200101819052508080610d98906137e1565b915050610b7c565b5092915050565b600154604051630c825d9760e11b81523360048201526000916001600160a01b031690631904bb2e90602401600060405180830381865afa158015610df0573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052610e1891908101906135db565b60208101519091506001600160a01b03163314610e8d5760405162461bcd60e51b815260206004820152602d60248201527f66756e6374696f6e207265737472696374656420746f2061207265676973746560448201526c3932b2103b30b634b230ba37b960991b6064820152608401610485565b60808201516001600160a01b03163314610ee95760405162461bcd60e51b815260206004820152601d60248201527f6576656e74207265706f72746572206d7573742062652063616c6c65720000006044820152606401610485565b6001826000015160ff16111561109e576000610f04836117b9565b905080610f1057505050565b336000908152600d6020908152604091829020825161018081018452815460ff80821683526101008204811694830194909452909391929184019162010000909104166002811115610f6457610f64612fbb565b6002811115610f7557610f75612fbb565b815281546020909101906301000000900460ff166009811115610f9a57610f9a612fbb565b6009811115610fab57610fab612fbb565b815281546001600160a01b03600160201b909104811660208301526001830154166040820152600282018054606090920191610fe6906134f6565b80601f0160208091040260200160405190810160405280929190818152602001828054611012906134f6565b801561105f5780601f106110345761010080835404028352916020019161105f565b820191906000526020600020905b81548152906001019060200180831161104257829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509250505b6000826040015160028111156110b6576110b6612fbb565b036110c8576110c4826119f2565b5050565b6001826040015160028111156110e0576110e0612fbb565b036110ee576110c482611bf8565b60028260400151600281111561110657611106612fbb565b036110c4576110c482611d67565b6000600982600981111561112a5761112a612fbb565b036111385760025b92915050565b600082600981111561114c5761114c612fbb565b03611158576002611132565b600182600981111561116c5761116c612fbb565b03611178576002611132565b6002611132565b6011545b6010548110156114d2576000601082815481106111a2576111a26137b8565b90600052602060002001549050806000036111bd57506114c0565b6111c86001826137a5565b90506000600282815481106111df576111df6137b8565b600091825260209182902060408051610180810182526008909302909101805460ff80821685526101008204811695850195909552929390929184019162010000900416600281111561123457611234612fbb565b600281111561124557611245612fbb565b815281546020909101906301000000900460ff16600981111561126a5761126a612fbb565b600981111561127b5761127b612fbb565b815281546001600160a01b03600160201b9091048116602083015260018301541660408201526002820180546060909201916112b6906134f6565b80601f01602080910402602001604051908101604052809291908181526020018280546112e2906134f6565b801561132f5780601f106113045761010080835404028352916020019161132f565b820191906000526020600020905b81548152906001019060200180831161131257829003601f168201915b505050505081526020016003820154815260200160048201548152602001600582015481526020016006820154815260200160078201548152505090504360036000015482610140015161138391906137ce565b1115611390575050601155565b60a08101516001600160a01b03166000908152600c6020526040812081905560608201516113bd90611114565b60a08301516001600160a01b03166000908152600e60209081526040808320610120870151845290915290205490915081116113fb575050506114c0565b60a0820180516001600160a01b039081166000908152600e6020908152604080832061012088015184528252808320869055845184168352600b825280832080546001808201835591855283852001899055600f805491820181559093527f8d1108e10bcb7c27dddfc02ed9d693a074039d026cf4ea4240b40f7d581ac80290920187905592518151858152938401879052909116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f910160405180910390a25050505b806114ca816137e1565b915050611183565b601155565b600080600160009054906101000a90046001600160a01b03166001600160a01b031663c9d97af46040518163ffffffff1660e01b8152600401602060405180830381865afa15801561152d573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906115519190613776565b905060005b600f548110156115c857816002600f8381548110611576576115766137b8565b906000526020600020015481548110611591576115916137b8565b906000526020600020906008020160050154036115b6576115b36001846137ce565b92505b806115c0816137e1565b915050611556565b5060005b600f548110156117ac5761179a6002600f83815481106115ee576115ee6137b8565b906000526020600020015481548110611609576116096137b8565b600091825260209182902060408051610180810182526008909302909101805460ff80821685526101008204811695850195909552929390929184019162010000900416600281111561165e5761165e612fbb565b600281111561166f5761166f612fbb565b815281546020909101906301000000900460ff16600981111561169457611694612fbb565b60098111156116a5576116a5612fbb565b815281546001600160a01b03600160201b9091048116602083015260018301541660408201526002820180546060909201916116e0906134f6565b80601f016020809104026020016040519081016040528092919081815260200182805461170c906134f6565b80156117595780601f1061172e57610100808354040283529160200191611759565b820191906000526020600020905b81548152906001019060200180831161173c57829003601f168201915b505050505081526020016003820154815260200160048201548152602001600582015481526020016006820154815260200160078201548152505084611ea7565b806117a4816137e1565b9150506115cc565b506110c4600f6000612f04565b6000816020015160ff166000036118ee57336000908152600d6020908152604091829020845181549286015160ff9081166101000261ffff1990941691161791909117808255918401518492829062ff000019166201000083600281111561182357611823612fbb565b021790555060608201518154829063ff0000001916630100000083600981111561184f5761184f612fbb565b021790555060808201518154640100000000600160c01b031916600160201b6001600160a01b039283160217825560a08301516001830180546001600160a01b0319169190921617905560c082015160028201906118ad9082613845565b5060e0820151600382015561010082015160048201556101208201516005820155610140820151600682015561016090910151600790910155506000919050565b602080830151336000908152600d90925260409091205460ff9182169161191c916101009004166001613905565b60ff161461196c5760405162461bcd60e51b815260206004820152601960248201527f6368756e6b73206d75737420626520636f6e746967756f7573000000000000006044820152606401610485565b336000908152600d6020526040902060c083015161198d9160020190612441565b336000908152600d6020526040902080546001919082906119b7908290610100900460ff16613905565b92506101000a81548160ff021916908360ff160217905550816000015160ff16826020015160016119e89190613905565b60ff161492915050565b6000806000806000611a0960fe8760c0015161258b565b9450945094509450945084611a605760405162461bcd60e51b815260206004820152601960248201527f6661696c65642070726f6f6620766572696669636174696f6e000000000000006044820152606401610485565b8560a001516001600160a01b0316846001600160a01b031614611a955760405162461bcd60e51b81526004016104859061391e565b85606001516009811115611aab57611aab612fbb565b8314611ac95760405162461bcd60e51b815260040161048590613949565b438210611b115760405162461bcd60e51b815260206004820152601660248201527563616e277420626520696e207468652066757475726560501b6044820152606401610485565b60008211611b575760405162461bcd60e51b815260206004820152601360248201527263616e27742062652061742067656e6573697360681b6044820152606401610485565b6001546040516396b477cb60e01b8152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa158015611ba1573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611bc59190613776565b610100880184905261012088018190524361014089015261016088018390529050611bef876125f2565b50505050505050565b6000806000806000611c0f60fc8760c0015161258b565b9450945094509450945084611c665760405162461bcd60e51b815260206004820152601e60248201527f6661696c65642061636375736174696f6e20766572696669636174696f6e00006044820152606401610485565b8560a001516001600160a01b0316846001600160a01b031614611c9b5760405162461bcd60e51b81526004016104859061391e565b85606001516009811115611cb157611cb1612fbb565b8314611ccf5760405162461bcd60e51b815260040161048590613949565b6001546040516396b477cb60e01b8152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa158015611d19573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611d3d9190613776565b610100880184905261012088018190524361014089015261016088018390529050611bef87612876565b6000806000806000611d7e60fd8760c0015161258b565b9450945094509450945084611dd55760405162461bcd60e51b815260206004820152601d60248201527f6661696c656420696e6e6f63656e636520766572696669636174696f6e0000006044820152606401610485565b8560a001516001600160a01b0316846001600160a01b031614611e0a5760405162461bcd60e51b81526004016104859061391e565b85606001516009811115611e2057611e20612fbb565b8314611e3e5760405162461bcd60e51b815260040161048590613949565b438210611e865760405162461bcd60e51b815260206004820152601660248201527563616e277420626520696e207468652066757475726560501b6044820152606401610485565b61010086018290526101608601819052611e9f86612b2d565b505050505050565b60015460a0830151604051630c825d9760e11b81526001600160a01b0391821660048201526000929190911690631904bb2e90602401600060405180830381865afa158015611efa573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052611f2291908101906135db565b608084015160a08501516001600160a01b039081166000908152600a6020526040902080546001600160a01b03191691909216179055905060038161026001516003811115611f7357611f73612fbb565b03611f7d57505050565b6000611f94611f8f8560600151611114565b612e38565b61022083015160075491925090600090611fae9083613973565b600654611fbb9087613973565b611fc590856137ce565b611fcf91906137ce565b600954909150811115611fe157506009545b60008461012001518560c001518660a00151611ffd91906137ce565b61200791906137ce565b60095490915060009061201a8385613973565b612024919061398a565b905060008111801561203557508181145b1561216557600060a087018190526101008701819052610120870181905260c08701526101e08601805182919061206d9083906137ce565b90525061022086018051600191906120869083906137ce565b905250600361026087015260006102008701526001546040516301adf0b760e51b81526001600160a01b03909116906335be16e0906120c99089906004016139bc565b600060405180830381600087803b1580156120e357600080fd5b505af11580156120f7573d6000803e3d6000fd5b5050505060208681015160e08a0151604080516001600160a01b03909316835292820184905260008284015260016060830152608082015290517f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f99181900360a00190a15050505050505050565b610120860151819081116121925780876101200181815161218691906137a5565b905250600090506121ad565b6101208701516121a290826137a5565b600061012089015290505b801561222a5780876101000151106121f5578087610100018181516121d291906137a5565b90525060a0870180518291906121e99083906137a5565b9052506000905061222a565b61010087015161220590826137a5565b90508661010001518760a00181815161221e91906137a5565b90525060006101008801525b60008111801561224d575060008760a001518860c0015161224b91906137ce565b115b156122f95760008760a001518860c0015161226891906137ce565b60c08901516122779084613973565b612281919061398a565b905060008860a001518960c0015161229991906137ce565b60a08a01516122a89085613973565b6122b2919061398a565b9050818960c0018181516122c691906137a5565b90525060a0890180518291906122dd9083906137a5565b9052506122ea81836137ce565b6122f490846137a5565b925050505b61230381836137a5565b915081876101e00181815161231891906137ce565b90525061022087018051600191906123319083906137ce565b90525060005461022088015160085461234a9190613973565b6123549190613973565b61235e90436137ce565b61020088015260026102608801526001546040516301adf0b760e51b81526001600160a01b03909116906335be16e09061239c908a906004016139bc565b600060405180830381600087803b1580156123b657600080fd5b505af11580156123ca573d6000803e3d6000fd5b5050506020808901516102008a015160e08d0151604080516001600160a01b039094168452938301879052928201526000606082015260808101919091527f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f9915060a00160405180910390a1505050505050505050565b8154600260018083161561010002038216048251808201602081106020841001600281146124eb5760018114612510578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b808210156124d557815184556001840193506020820191506124b8565b815191036101000a908190040290915550611bef565b60028302826020036101000a846020036101000a602089015104020185018755611bef565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b808210156125715781518455600184019350602082019150612554565b815191036101000a90819004029091555050505050505050565b600080600080600080865160206125a291906137ce565b90506125ac612f22565b60a081838a8c5afa6125bd57600080fd5b80516001036125cb57600196505b602081015160408201516060830151608090930151989b919a509850909695509350505050565b60006126018260600151611114565b60a08301516001600160a01b03166000908152600e602090815260408083206101208701518452909152902054909150811161264f5760405162461bcd60e51b815260040161048590613b2c565b6002805460e084018190526001810182556000829052835160089091027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace018054602086015160ff9081166101000261ffff1990921693169290921791909117808255604085015185939091839162ff00001990911690620100009084908111156126dc576126dc612fbb565b021790555060608201518154829063ff0000001916630100000083600981111561270857612708612fbb565b021790555060808201518154640100000000600160c01b031916600160201b6001600160a01b039283160217825560a08301516001830180546001600160a01b0319169190921617905560c082015160028201906127669082613845565b5060e0828101516003830155610100830151600483015561012080840151600584015561014084015160068401556101609093015160079092019190915560a0840180516001600160a01b039081166000908152600b602090815260408083209589018051875460018181018a5598865284862001558051600f8054988901815585527f8d1108e10bcb7c27dddfc02ed9d693a074039d026cf4ea4240b40f7d581ac80290970196909655845184168352600e82528083209689015183529590528490208590559051915192519116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f9161286a91858252602082015260400190565b60405180910390a25050565b60a08101516001600160a01b03166000908152600c6020526040902054156128e05760405162461bcd60e51b815260206004820181905260248201527f616c72656164792070726f63657373696e6720616e2061636375736174696f6e6044820152606401610485565b60006128ef8260600151611114565b60a08301516001600160a01b03166000908152600e602090815260408083206101208701518452909152902054909150811161293d5760405162461bcd60e51b815260040161048590613b2c565b6002805460e084018190526001810182556000829052835160089091027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace018054602086015160ff9081166101000261ffff1990921693169290921791909117808255604085015185939091839162ff00001990911690620100009084908111156129ca576129ca612fbb565b021790555060608201518154829063ff000000191663010000008360098111156129f6576129f6612fbb565b021790555060808201518154640100000000600160c01b031916600160201b6001600160a01b039283160217825560a08301516001830180546001600160a01b0319169190921617905560c08201516002820190612a549082613845565b5060e082810151600383015561010083015160048301556101208301516005830155610140830151600683015561016090920151600790910155820151612a9c9060016137ce565b60a08301516001600160a01b03166000908152600c602052604090205560e0820151601090612acc9060016137ce565b81546001810183556000928352602092839020015560a083015160e084015160408051858152938401919091526001600160a01b03909116917f2e8e354b41470731dafa7c3df150e9498a8d5b9c51ff0259fbf77f721ba40351910161286a565b60a08101516001600160a01b03166000908152600c602052604081205490819003612b9a5760405162461bcd60e51b815260206004820152601860248201527f6e6f206173736f6369617465642061636375736174696f6e00000000000000006044820152606401610485565b81606001516009811115612bb057612bb0612fbb565b6002612bbd6001846137a5565b81548110612bcd57612bcd6137b8565b60009182526020909120600890910201546301000000900460ff166009811115612bf957612bf9612fbb565b14612c565760405162461bcd60e51b815260206004820152602760248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e206044820152661c9d5b19481a5960ca1b6064820152608401610485565b6101008201516002612c696001846137a5565b81548110612c7957612c796137b8565b90600052602060002090600802016004015414612ce65760405162461bcd60e51b815260206004820152602560248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e20604482015264626c6f636b60d81b6064820152608401610485565b6101608201516002612cf96001846137a5565b81548110612d0957612d096137b8565b90600052602060002090600802016007015414612d745760405162461bcd60e51b8152602060048201526024808201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e206044820152630d0c2e6d60e31b6064820152608401610485565b6011545b601054811015612dde578160108281548110612d9657612d966137b8565b906000526020600020015403612dcc57600060108281548110612dbb57612dbb6137b8565b600091825260209091200155612dde565b80612dd6816137e1565b915050612d78565b5060a0820180516001600160a01b039081166000908152600c602090815260408083208390559351935191825292909116917f1fa96beb8dddcb7d4484dd00c4059e872439f7a474a2ecf49c430fc6e86c9e1f910161286a565b600081612e4757505060055490565b60018203612e5757505060055490565b60028203612e6757505060055490565b60038203612e7757505060055490565b60048203612e885750612710919050565b50612710919050565b6040805161018081018252600080825260208201819052909182019081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b50805460008255906000526020600020908101906107899190612f40565b6040518060a001604052806005906020820280368337509192915050565b5b80821115612f555760008155600101612f41565b5090565b6001600160a01b038116811461078957600080fd5b8035612f7981612f59565b919050565b600060208284031215612f9057600080fd5b8135612f9b81612f59565b9392505050565b600060208284031215612fb457600080fd5b5035919050565b634e487b7160e01b600052602160045260246000fd5b60038110612fe157612fe1612fbb565b9052565b600a8110612fe157612fe1612fbb565b60005b83811015613010578181015183820152602001612ff8565b50506000910152565b60008151808452613031816020860160208601612ff5565b601f01601f19169290920160200192915050565b600061018060ff8f16835260ff8e166020840152613066604084018e612fd1565b613073606084018d612fe5565b6001600160a01b038b811660808501528a1660a084015260c0830181905261309d8184018a613019565b60e0840198909852505061010081019490945261012084019290925261014083015261016090910152979650505050505050565b8035600a8110612f7957600080fd5b6000806000606084860312156130f557600080fd5b833561310081612f59565b925061310e602085016130d1565b9150604084013590509250925092565b60006020828403121561313057600080fd5b81358015158114612f9b57600080fd5b805160ff16825260006101806020830151613160602086018260ff169052565b5060408301516131736040860182612fd1565b5060608301516131866060860182612fe5565b5060808301516131a160808601826001600160a01b03169052565b5060a08301516131bc60a08601826001600160a01b03169052565b5060c08301518160c08601526131d482860182613019565b60e08581015190870152610100808601519087015261012080860151908701526101408086015190870152610160948501519490950193909352509192915050565b602081526000612f9b6020830184613140565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101561327e57603f1988860301845261326c858351613140565b94509285019290850190600101613250565b5092979650505050505050565b634e487b7160e01b600052604160045260246000fd5b604051610180810167ffffffffffffffff811182821017156132c5576132c561328b565b60405290565b604051610280810167ffffffffffffffff811182821017156132c5576132c561328b565b604051601f8201601f1916810167ffffffffffffffff811182821017156133185761331861328b565b604052919050565b803560ff81168114612f7957600080fd5b803560038110612f7957600080fd5b600067ffffffffffffffff82111561335a5761335a61328b565b50601f01601f191660200190565b600082601f83011261337957600080fd5b813561338c61338782613340565b6132ef565b8181528460208386010111156133a157600080fd5b816020850160208301376000918101602001919091529392505050565b6000602082840312156133d057600080fd5b813567ffffffffffffffff808211156133e857600080fd5b9083019061018082860312156133fd57600080fd5b6134056132a1565b61340e83613320565b815261341c60208401613320565b602082015261342d60408401613331565b604082015261343e606084016130d1565b606082015261344f60808401612f6e565b608082015261346060a08401612f6e565b60a082015260c08301358281111561347757600080fd5b61348387828601613368565b60c08301525060e083810135908201526101008084013590820152610120808401359082015261014080840135908201526101609283013592810192909252509392505050565b600080604083850312156134dd57600080fd5b82356134e881612f59565b946020939093013593505050565b600181811c9082168061350a57607f821691505b60208210810361352a57634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526024908201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460408201526330ba37b960e11b606082015260800190565b8051612f7981612f59565b600082601f83011261359057600080fd5b815161359e61338782613340565b8181528460208386010111156135b357600080fd5b6135c4826020830160208701612ff5565b949350505050565b805160048110612f7957600080fd5b6000602082840312156135ed57600080fd5b815167ffffffffffffffff8082111561360557600080fd5b90830190610280828603121561361a57600080fd5b6136226132cb565b61362b83613574565b815261363960208401613574565b602082015261364a60408401613574565b604082015260608301518281111561366157600080fd5b61366d8782860161357f565b6060830152506080830151608082015260a083015160a082015260c083015160c082015260e083015160e08201526101008084015181830152506101208084015181830152506101408084015181830152506101608084015181830152506101806136d9818501613574565b908201526101a083810151908201526101c080840151908201526101e0808401519082015261020080840151908201526102208084015190820152610240808401518381111561372857600080fd5b6137348882870161357f565b828401525050610260915061374a8284016135cc565b91810191909152949350505050565b60006020828403121561376b57600080fd5b8151612f9b81612f59565b60006020828403121561378857600080fd5b5051919050565b634e487b7160e01b600052601160045260246000fd5b818103818111156111325761113261378f565b634e487b7160e01b600052603260045260246000fd5b808201808211156111325761113261378f565b6000600182016137f3576137f361378f565b5060010190565b601f82111561384057600081815260208120601f850160051c810160208610156138215750805b601f850160051c820191505b81811015611e9f5782815560010161382d565b505050565b815167ffffffffffffffff81111561385f5761385f61328b565b6138738161386d84546134f6565b846137fa565b602080601f8311600181146138a857600084156138905750858301515b600019600386901b1c1916600185901b178555611e9f565b600085815260208120601f198616915b828110156138d7578886015182559484019460019091019084016138b8565b50858210156138f55787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60ff81811683821601908111156111325761113261378f565b6020808252601190820152700decccccadcc8cae440dad2e6dac2e8c6d607b1b604082015260600190565b60208082526010908201526f0e4ead8ca40d2c840dad2e6dac2e8c6d60831b604082015260600190565b80820281158282048414176111325761113261378f565b6000826139a757634e487b7160e01b600052601260045260246000fd5b500490565b60048110612fe157612fe1612fbb565b602081526139d66020820183516001600160a01b03169052565b600060208301516139f260408401826001600160a01b03169052565b5060408301516001600160a01b0381166060840152506060830151610280806080850152613a246102a0850183613019565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a0613aab818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f190161026080880191909152909150613b0d8483613019565b935080870151915050613b22828601826139ac565b5090949350505050565b60208082526024908201527f616c726561647920736c6173686564206174207468652070726f6f66277320656040820152630e0dec6d60e31b60608201526080019056fea264697066735822122052b831b0a21395fd47b0f0af57aa227e9bb20a73607238bc0d5620e4d55df78364736f6c63430008150033",
 }
 
 // AccountabilityABI is the input ABI used to generate the binding from.
@@ -2119,7 +2150,7 @@ func (_Accountability *AccountabilityFilterer) ParseSlashingEvent(log types.Log)
 
 // AutonityMetaData contains all meta data concerning the Autonity contract.
 var AutonityMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"contractLiquid\",\"name\":\"liquidContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator[]\",\"name\":\"_validators\",\"type\":\"tuple[]\"},{\"components\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"treasuryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minBaseFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegationRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"treasuryAccount\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Policy\",\"name\":\"policy\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"contractIAccountability\",\"name\":\"accountabilityContract\",\"type\":\"address\"},{\"internalType\":\"contractIOracle\",\"name\":\"oracleContract\",\"type\":\"address\"},{\"internalType\":\"contractIACU\",\"name\":\"acuContract\",\"type\":\"address\"},{\"internalType\":\"contractISupplyControl\",\"name\":\"supplyControlContract\",\"type\":\"address\"},{\"internalType\":\"contractIStabilization\",\"name\":\"stabilizationContract\",\"type\":\"address\"},{\"internalType\":\"contractUpgradeManager\",\"name\":\"upgradeManagerContract\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Contracts\",\"name\":\"contracts\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"epochPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"committeeSize\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Protocol\",\"name\":\"protocol\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"contractVersion\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Config\",\"name\":\"_config\",\"type\":\"tuple\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"ActivatedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"delegatee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"name\":\"BondingRejected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"BurnedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"}],\"name\":\"CommissionRateChange\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"period\",\"type\":\"uint256\"}],\"name\":\"EpochPeriodUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"gasPrice\",\"type\":\"uint256\"}],\"name\":\"MinimumBaseFeeUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"MintedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewBondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epoch\",\"type\":\"uint256\"}],\"name\":\"NewEpoch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewUnbondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"PausedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"liquidContract\",\"type\":\"address\"}],\"name\":\"RegisteredValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Rewarded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[],\"name\":\"COMMISSION_RATE_PRECISION\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"activateValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"bond\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_rate\",\"type\":\"uint256\"}],\"name\":\"changeCommissionRate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"completeContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"computeCommittee\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"config\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"treasuryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minBaseFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegationRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"treasuryAccount\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Policy\",\"name\":\"policy\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"contractIAccountability\",\"name\":\"accountabilityContract\",\"type\":\"address\"},{\"internalType\":\"contractIOracle\",\"name\":\"oracleContract\",\"type\":\"address\"},{\"internalType\":\"contractIACU\",\"name\":\"acuContract\",\"type\":\"address\"},{\"internalType\":\"contractISupplyControl\",\"name\":\"supplyControlContract\",\"type\":\"address\"},{\"internalType\":\"contractIStabilization\",\"name\":\"stabilizationContract\",\"type\":\"address\"},{\"internalType\":\"contractUpgradeManager\",\"name\":\"upgradeManagerContract\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Contracts\",\"name\":\"contracts\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"epochPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"committeeSize\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Protocol\",\"name\":\"protocol\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"contractVersion\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deployer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochID\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochReward\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochTotalBondedStake\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalize\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalizeInitialization\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlockPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommittee\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommitteeEnodes\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_block\",\"type\":\"uint256\"}],\"name\":\"getEpochFromBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEpochPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getLastEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxCommitteeSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinimumBaseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNewContract\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOperator\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOracle\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"height\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"round\",\"type\":\"uint256\"}],\"name\":\"getProposer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryAccount\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getUnbondingPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"getValidator\",\"outputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"contractLiquid\",\"name\":\"liquidContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"pauseValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enode\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_oracleAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"_signatures\",\"type\":\"bytes\"}],\"name\":\"registerValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"resetContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIAccountability\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAccountabilityContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIACU\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAcuContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_size\",\"type\":\"uint256\"}],\"name\":\"setCommitteeSize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setEpochPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_price\",\"type\":\"uint256\"}],\"name\":\"setMinimumBaseFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setOperatorAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setOracleContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIStabilization\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setStabilizationContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractISupplyControl\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setSupplyControlContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setTreasuryAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_treasuryFee\",\"type\":\"uint256\"}],\"name\":\"setTreasuryFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setUnbondingPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractUpgradeManager\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setUpgradeManagerContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalRedistributed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"unbond\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"contractLiquid\",\"name\":\"liquidContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"_val\",\"type\":\"tuple\"}],\"name\":\"updateValidatorAndTransferSlashedFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_bytecode\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_abi\",\"type\":\"string\"}],\"name\":\"upgradeContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	ABI: "[{\"inputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"contractLiquid\",\"name\":\"liquidContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator[]\",\"name\":\"_validators\",\"type\":\"tuple[]\"},{\"components\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"treasuryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minBaseFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegationRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"treasuryAccount\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Policy\",\"name\":\"policy\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"contractIAccountability\",\"name\":\"accountabilityContract\",\"type\":\"address\"},{\"internalType\":\"contractIOracle\",\"name\":\"oracleContract\",\"type\":\"address\"},{\"internalType\":\"contractIACU\",\"name\":\"acuContract\",\"type\":\"address\"},{\"internalType\":\"contractISupplyControl\",\"name\":\"supplyControlContract\",\"type\":\"address\"},{\"internalType\":\"contractIStabilization\",\"name\":\"stabilizationContract\",\"type\":\"address\"},{\"internalType\":\"contractUpgradeManager\",\"name\":\"upgradeManagerContract\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Contracts\",\"name\":\"contracts\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"epochPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"committeeSize\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Protocol\",\"name\":\"protocol\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"contractVersion\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Config\",\"name\":\"_config\",\"type\":\"tuple\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"ActivatedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"delegatee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"name\":\"BondingRejected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"BurnedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"}],\"name\":\"CommissionRateChange\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"ConsensusEndpointsUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"period\",\"type\":\"uint256\"}],\"name\":\"EpochPeriodUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"gasPrice\",\"type\":\"uint256\"}],\"name\":\"MinimumBaseFeeUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"MintedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewBondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epoch\",\"type\":\"uint256\"}],\"name\":\"NewEpoch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewUnbondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"PausedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"liquidContract\",\"type\":\"address\"}],\"name\":\"RegisteredValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Rewarded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[],\"name\":\"COMMISSION_RATE_PRECISION\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"activateValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"bond\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_rate\",\"type\":\"uint256\"}],\"name\":\"changeCommissionRate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"completeContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"computeCommittee\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"config\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"treasuryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minBaseFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegationRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"treasuryAccount\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Policy\",\"name\":\"policy\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"contractIAccountability\",\"name\":\"accountabilityContract\",\"type\":\"address\"},{\"internalType\":\"contractIOracle\",\"name\":\"oracleContract\",\"type\":\"address\"},{\"internalType\":\"contractIACU\",\"name\":\"acuContract\",\"type\":\"address\"},{\"internalType\":\"contractISupplyControl\",\"name\":\"supplyControlContract\",\"type\":\"address\"},{\"internalType\":\"contractIStabilization\",\"name\":\"stabilizationContract\",\"type\":\"address\"},{\"internalType\":\"contractUpgradeManager\",\"name\":\"upgradeManagerContract\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Contracts\",\"name\":\"contracts\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"epochPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"committeeSize\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Protocol\",\"name\":\"protocol\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"contractVersion\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deployer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochID\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochReward\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochTotalBondedStake\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalize\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalizeInitialization\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlockPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommittee\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommitteeEnodes\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_block\",\"type\":\"uint256\"}],\"name\":\"getEpochFromBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEpochPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getLastEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxCommitteeSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinimumBaseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNewContract\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOperator\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOracle\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"height\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"round\",\"type\":\"uint256\"}],\"name\":\"getProposer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryAccount\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getUnbondingPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"getValidator\",\"outputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"contractLiquid\",\"name\":\"liquidContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_delegator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"lockLiquid\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"pauseValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enode\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_oracleAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"_signatures\",\"type\":\"bytes\"}],\"name\":\"registerValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"resetContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIAccountability\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAccountabilityContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIACU\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAcuContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_size\",\"type\":\"uint256\"}],\"name\":\"setCommitteeSize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setEpochPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_price\",\"type\":\"uint256\"}],\"name\":\"setMinimumBaseFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setOperatorAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setOracleContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIStabilization\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setStabilizationContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractISupplyControl\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setSupplyControlContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setTreasuryAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_treasuryFee\",\"type\":\"uint256\"}],\"name\":\"setTreasuryFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setUnbondingPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractUpgradeManager\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setUpgradeManagerContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalRedistributed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"unbond\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_delegator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"unlockLiquid\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_record\",\"type\":\"bytes\"}],\"name\":\"updateConsensusEndpoints\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"contractLiquid\",\"name\":\"liquidContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"_val\",\"type\":\"tuple\"}],\"name\":\"updateValidatorAndTransferSlashedFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_bytecode\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_abi\",\"type\":\"string\"}],\"name\":\"upgradeContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
 	Sigs: map[string]string{
 		"2f2c3f2e": "COMMISSION_RATE_PRECISION()",
 		"b46e5520": "activateValidator(address)",
@@ -2158,6 +2189,7 @@ var AutonityMetaData = &bind.MetaData{
 		"b7ab4db5": "getValidators()",
 		"0d8e6e2c": "getVersion()",
 		"c2362dd5": "lastEpochBlock()",
+		"2a400bc3": "lockLiquid(address,address,uint256)",
 		"40c10f19": "mint(address,uint256)",
 		"06fdde03": "name()",
 		"0ae65e7a": "pauseValidator(address)",
@@ -2182,10 +2214,12 @@ var AutonityMetaData = &bind.MetaData{
 		"a9059cbb": "transfer(address,uint256)",
 		"23b872dd": "transferFrom(address,address,uint256)",
 		"a5d059ca": "unbond(address,uint256)",
+		"39b17fec": "unlockLiquid(address,address,uint256)",
+		"da45591b": "updateConsensusEndpoints(bytes)",
 		"35be16e0": "updateValidatorAndTransferSlashedFunds((address,address,address,string,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,address,uint256,uint256,uint256,uint256,uint256,bytes,uint8))",
 		"b2ea9adb": "upgradeContract(bytes,string)",
 	},
-	Bin: "0x60806040526000600b556000600c553480156200001b57600080fd5b506040516200a0fa3803806200a0fa8339810160408190526200003e9162000e64565b601c546000036200006757602a80546001600160a01b031916331790556200006782826200006f565b505062001325565b80518051600d55602080820151600e55604080830151600f55606080840151601055608093840151601180546001600160a01b03199081166001600160a01b03938416179091558487015180516012805484169185169190911790558086015160138054841691851691909117905580850151601480548416918516919091179055808401516015805484169185169190911790559586015160168054831691841691909117905560a0909501516017805487169183169190911790558286015180516018805490971692169190911790945591830151601955820151601a5590810151601b55810151601c5560005b825181101562000420576000838281518110620001805762000180620010a1565b602002602001015160a0015190506000848381518110620001a557620001a5620010a1565b60200260200101516101a00181815250506000848381518110620001cd57620001cd620010a1565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620002095762000209620010a1565b602002602001015160a00181815250506000848381518110620002305762000230620010a1565b60209081029190910101516101c00152600f5484518590849081106200025a576200025a620010a1565b602002602001015160800181815250506000848381518110620002815762000281620010a1565b602002602001015161026001906003811115620002a257620002a2620010b7565b90816003811115620002b857620002b8620010b7565b815250506000848381518110620002d357620002d3620010a1565b602002602001015161016001818152505062000311848381518110620002fd57620002fd620010a1565b60200260200101516200042560201b60201c565b6200033e8483815181106200032a576200032a620010a1565b60200260200101516200056060201b60201c565b8060276000868581518110620003585762000358620010a1565b6020026020010151600001516001600160a01b03166001600160a01b031681526020019081526020016000206000828254620003959190620010e3565b925050819055508060296000828254620003b09190620010e3565b925050819055506200040a848381518110620003d057620003d0620010a1565b60200260200101516020015182868581518110620003f257620003f2620010a1565b6020026020010151600001516200079160201b60201c565b50806200041781620010ff565b9150506200015f565b505050565b60006200043c82606001516200097c60201b60201c565b6001600160a01b03909116602084015290508015620004905760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064015b60405180910390fd5b6020808301516001600160a01b03908116600090815260289092526040909120600101541615620005045760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000487565b612710826080015111156200055c5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000487565b5050565b6101808101516001600160a01b0316620005e357601d546000906200058590620009ca565b905081602001518260000151836080015183604051620005a59062000aea565b620005b494939291906200111b565b604051809103906000f080158015620005d1573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352602890955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620006959082620011ff565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f8201556102008201516010820155610220820151601182015561024082015160128201906200075f9082620011ff565b5061026082015160138201805460ff19166001836003811115620007875762000787620010b7565b0217905550505050565b60008211620007ef5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000487565b6001600160a01b038116600090815260276020526040902054821115620008595760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000487565b6001600160a01b0381166000908152602760205260408120805484929062000883908490620012cb565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b03199182161782559551600182018054919098169616959095179095559051600284015551910155805491926200090f83620010ff565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a35050505050565b6000806200098962000af8565b60008060ff9050604083875160208901845afa620009a657600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b606081600003620009f25750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000a22578062000a0981620010ff565b915062000a1a9050600a83620012f7565b9150620009f6565b6000816001600160401b0381111562000a3f5762000a3f62000b16565b6040519080825280601f01601f19166020018201604052801562000a6a576020820181803683370190505b5090505b841562000ae25762000a82600183620012cb565b915062000a91600a866200130e565b62000a9e906030620010e3565b60f81b81838151811062000ab65762000ab6620010a1565b60200101906001600160f81b031916908160001a90535062000ada600a86620012f7565b945062000a6e565b949350505050565b6115318062008bc983390190565b60405180604001604052806002906020820280368337509192915050565b634e487b7160e01b600052604160045260246000fd5b604051608081016001600160401b038111828210171562000b515762000b5162000b16565b60405290565b60405160a081016001600160401b038111828210171562000b515762000b5162000b16565b60405161028081016001600160401b038111828210171562000b515762000b5162000b16565b604051601f8201601f191681016001600160401b038111828210171562000bcd5762000bcd62000b16565b604052919050565b6001600160a01b038116811462000beb57600080fd5b50565b805162000bfb8162000bd5565b919050565b60005b8381101562000c1d57818101518382015260200162000c03565b50506000910152565b600082601f83011262000c3857600080fd5b81516001600160401b0381111562000c545762000c5462000b16565b62000c69601f8201601f191660200162000ba2565b81815284602083860101111562000c7f57600080fd5b62000ae282602083016020870162000c00565b80516004811062000bfb57600080fd5b600060c0828403121562000cb557600080fd5b60405160c081016001600160401b038111828210171562000cda5762000cda62000b16565b8060405250809150825162000cef8162000bd5565b8152602083015162000d018162000bd5565b6020820152604083015162000d168162000bd5565b6040820152606083015162000d2b8162000bd5565b6060820152608083015162000d408162000bd5565b608082015260a083015162000d558162000bd5565b60a0919091015292915050565b60006080828403121562000d7557600080fd5b62000d7f62000b2c565b9050815162000d8e8162000bd5565b8082525060208201516020820152604082015160408201526060820151606082015292915050565b600081830361020081121562000dcb57600080fd5b62000dd562000b2c565b915060a081121562000de657600080fd5b5062000df162000b57565b82518152602083015160208201526040830151604082015260608301516060820152608083015162000e238162000bd5565b6080820152815262000e398360a0840162000ca2565b602082015262000e4e83610160840162000d62565b60408201526101e0820151606082015292915050565b60008061022080848603121562000e7a57600080fd5b83516001600160401b038082111562000e9257600080fd5b818601915086601f83011262000ea757600080fd5b815160208282111562000ebe5762000ebe62000b16565b8160051b62000ecf82820162000ba2565b928352848101820192828101908b85111562000eea57600080fd5b83870192505b848310156200107f5782518681111562000f0957600080fd5b8701610280818e03601f1901121562000f2157600080fd5b62000f2b62000b7c565b62000f3886830162000bee565b815262000f486040830162000bee565b8682015262000f5a6060830162000bee565b604082015260808201518881111562000f7257600080fd5b62000f828f888386010162000c26565b60608301525060a0820151608082015260c082015160a082015260e082015160c082015261010082015160e082015261012082015161010082015261014082015161012082015261016082015161014082015261018082015161016082015262000ff06101a0830162000bee565b6101808201526101c08201516101a08201526101e08201516101c08201526102008201516101e0820152898201516102008201526102408201518a820152610260820151888111156200104257600080fd5b620010528f888386010162000c26565b6102408301525062001068610280830162000c92565b610260820152835250918301919083019062000ef0565b8099505050506200109389828a0162000db6565b955050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b80820180821115620010f957620010f9620010cd565b92915050565b600060018201620011145762001114620010cd565b5060010190565b600060018060a01b0380871683528086166020840152508360408301526080606083015282518060808401526200115a8160a085016020870162000c00565b601f01601f19169190910160a00195945050505050565b600181811c908216806200118657607f821691505b602082108103620011a757634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200042057600081815260208120601f850160051c81016020861015620011d65750805b601f850160051c820191505b81811015620011f757828155600101620011e2565b505050505050565b81516001600160401b038111156200121b576200121b62000b16565b62001233816200122c845462001171565b84620011ad565b602080601f8311600181146200126b5760008415620012525750858301515b600019600386901b1c1916600185901b178555620011f7565b600085815260208120601f198616915b828110156200129c578886015182559484019460019091019084016200127b565b5085821015620012bb5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b81810381811115620010f957620010f9620010cd565b634e487b7160e01b600052601260045260246000fd5b600082620013095762001309620012e1565b500490565b600082620013205762001320620012e1565b500690565b61789480620013356000396000f3fe608060405260043610620003db5760003560e01c8063872cf0591162000203578063b66b3e791162000117578063d372c07e11620000a7578063dd62ed3e1162000075578063dd62ed3e1462000cbc578063dfb1a4d21462000d06578063e7f43c681462000d1d578063f7866ee31462000d3d57005b8063d372c07e1462000c38578063d5f394881462000c5d578063d861b0e81462000c7f578063d886f8a21462000c9757005b8063cb696f5411620000e5578063cb696f541462000bb1578063ceaad4551462000bd6578063cf9c57191462000bfb578063cfd19fb91462000c1357005b8063b66b3e791462000b41578063b7ab4db51462000b69578063c2362dd51462000b81578063c9d97af41462000b9957005b8063a5d059ca1162000193578063ae1f5fa01162000161578063ae1f5fa01462000aab578063b2ea9adb1462000ad2578063b3ecbadd1462000af7578063b46e55201462000b1c57005b8063a5d059ca1462000a13578063a8b2216e1462000a38578063a9059cbb1462000a5f578063ab8f6ffe1462000a8457005b80639bb851c011620001d15780639bb851c014620009995780639c98e47114620009b15780639dc29fac14620009c9578063a515366a14620009ee57005b8063872cf05914620008fd5780638bac7dad146200091557806395d89b41146200093a57806396b477cb146200096857005b806340c10f1911620002fb57806370a08231116200028b578063819b64631162000259578063819b6463146200087c578063833b1fce146200089357806384467fdb14620008b3578063852c484914620008d857005b806370a082311462000728578063731b3a03146200076257806377e741c7146200077957806379502c55146200079e57005b8063520fdbbc11620002c9578063520fdbbc14620006895780635f7d394914620006ae5780636b5f444c14620006ec5780636fd2c80b146200071157005b806340c10f191462000600578063436459691462000625578063496ccd9b146200063c5780634bb278f3146200066157005b80631604e416116200037757806329070c6d116200034557806329070c6d146200058e5780632f2c3f2e14620005a5578063313ce56714620005bd57806335be16e014620005db57005b80631604e416146200050657806318160ddd146200051e5780631904bb2e146200053557806323b872dd146200056957005b80630d8e6e2c11620003b55780630d8e6e2c14620004845780631122063314620004a5578063114eaf5514620004bc5780631250a28d14620004e157005b806306fdde0314620003e5578063095ea7b314620004295780630ae65e7a146200045f57005b36620003e357005b005b348015620003f257600080fd5b506040805180820190915260068152652732bbba37b760d11b60208201525b604051620004209190620052f4565b60405180910390f35b3480156200043657600080fd5b506200044e6200044836600462005326565b62000d5d565b604051901515815260200162000420565b3480156200046c57600080fd5b50620003e36200047e36600462005355565b62000d76565b3480156200049157600080fd5b50601c545b60405190815260200162000420565b348015620004b257600080fd5b50600e5462000496565b348015620004c957600080fd5b50620003e3620004db36600462005375565b62000e09565b348015620004ee57600080fd5b50620003e36200050036600462005355565b62000e3b565b3480156200051357600080fd5b506200049660245481565b3480156200052b57600080fd5b5060295462000496565b3480156200054257600080fd5b506200055a6200055436600462005355565b62000e8a565b604051620004209190620053c8565b3480156200057657600080fd5b506200044e6200058836600462005541565b62001138565b3480156200059b57600080fd5b50600d5462000496565b348015620005b257600080fd5b506200049661271081565b348015620005ca57600080fd5b506040516012815260200162000420565b348015620005e857600080fd5b50620003e3620005fa36600462005587565b62001192565b3480156200060d57600080fd5b50620003e36200061f36600462005326565b6200136e565b3480156200063257600080fd5b50601a5462000496565b3480156200064957600080fd5b50620003e36200065b36600462005355565b62001428565b3480156200066e57600080fd5b506200067962001532565b6040516200042092919062005648565b3480156200069657600080fd5b50620003e3620006a836600462005355565b620018f9565b348015620006bb57600080fd5b50620006d3620006cd36600462005665565b62001af4565b6040516001600160a01b03909116815260200162000420565b348015620006f957600080fd5b50620003e36200070b36600462005375565b62001d0b565b3480156200071e57600080fd5b5060105462000496565b3480156200073557600080fd5b50620004966200074736600462005355565b6001600160a01b031660009081526027602052604090205490565b3480156200076f57600080fd5b5060205462000496565b3480156200078657600080fd5b50620003e36200079836600462005375565b62001e91565b348015620007ab57600080fd5b506040805160a08082018352600d548252600e54602080840191909152600f54838501526010546060808501919091526011546001600160a01b03908116608080870191909152865160c0810188526012548316815260135483168186015260145483168189015260155483168185015260165483168183015260175483169581019590955286519081018752601854909116815260195492810192909252601a5494820194909452601b5493810193909352601c546200086a939084565b60405162000420949392919062005688565b3480156200088957600080fd5b50601b5462000496565b348015620008a057600080fd5b506013546001600160a01b0316620006d3565b348015620008c057600080fd5b50620003e3620008d236600462005802565b62001ec3565b348015620008e557600080fd5b50620003e3620008f736600462005326565b62001ff2565b3480156200090a57600080fd5b50620003e362002183565b3480156200092257600080fd5b50620003e36200093436600462005375565b620021bf565b3480156200094757600080fd5b50604080518082019091526003815262272a2760e91b602082015262000411565b3480156200097557600080fd5b50620004966200098736600462005375565b6000908152601f602052604090205490565b348015620009a657600080fd5b506200049660235481565b348015620009be57600080fd5b506200049660215481565b348015620009d657600080fd5b50620003e3620009e836600462005326565b62002243565b348015620009fb57600080fd5b50620003e362000a0d36600462005326565b62002359565b34801562000a2057600080fd5b50620003e362000a3236600462005326565b6200242c565b34801562000a4557600080fd5b5062000a50620024c3565b604051620004209190620058ab565b34801562000a6c57600080fd5b506200044e62000a7e36600462005326565b620025a6565b34801562000a9157600080fd5b5062000a9c620025b5565b60405162000420919062005911565b34801562000ab857600080fd5b5062000ac3620026c7565b60405162000420919062005926565b34801562000adf57600080fd5b50620003e362000af136600462005975565b6200290d565b34801562000b0457600080fd5b50620003e362000b1636600462005355565b62002954565b34801562000b2957600080fd5b50620003e362000b3b36600462005355565b620029a3565b34801562000b4e57600080fd5b5062000b5962002c26565b60405162000420929190620059e0565b34801562000b7657600080fd5b5062000ac362002d5d565b34801562000b8e57600080fd5b506200049660205481565b34801562000ba657600080fd5b5062000496601e5481565b34801562000bbe57600080fd5b50620003e362000bd036600462005375565b62002dc1565b34801562000be357600080fd5b50620003e362000bf536600462005355565b62002e24565b34801562000c0857600080fd5b50620003e362002e73565b34801562000c2057600080fd5b50620003e362000c3236600462005355565b62002ec7565b34801562000c4557600080fd5b50620003e362000c5736600462005355565b62002f16565b34801562000c6a57600080fd5b50602a54620006d3906001600160a01b031681565b34801562000c8c57600080fd5b50620003e362002f65565b34801562000ca457600080fd5b50620003e362000cb636600462005355565b62002fa6565b34801562000cc957600080fd5b506200049662000cdb36600462005a12565b6001600160a01b03918216600090815260266020908152604080832093909416825291909152205490565b34801562000d1357600080fd5b5060195462000496565b34801562000d2a57600080fd5b506018546001600160a01b0316620006d3565b34801562000d4a57600080fd5b506011546001600160a01b0316620006d3565b600062000d6c33848462002ff5565b5060015b92915050565b6001600160a01b038082166000818152602860205260409020600101549091161462000dbf5760405162461bcd60e51b815260040162000db69062005a50565b60405180910390fd5b6001600160a01b0381811660009081526028602052604090205416331462000dfb5760405162461bcd60e51b815260040162000db69062005a87565b62000e06816200311e565b50565b6018546001600160a01b0316331462000e365760405162461bcd60e51b815260040162000db69062005ad3565b601055565b6018546001600160a01b0316331462000e685760405162461bcd60e51b815260040162000db69062005ad3565b601280546001600160a01b0319166001600160a01b0392909216919091179055565b62000e94620050cd565b6001600160a01b038083166000818152602860205260409020600101549091161462000ed45760405162461bcd60e51b815260040162000db69062005b0a565b6001600160a01b03808316600090815260286020908152604091829020825161028081018452815485168152600182015485169281019290925260028101549093169181019190915260038201805491929160608401919062000f379062005b41565b80601f016020809104026020016040519081016040528092919081815260200182805462000f659062005b41565b801562000fb65780601f1062000f8a5761010080835404028352916020019162000fb6565b820191906000526020600020905b81548152906001019060200180831162000f9857829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e090920191620010729062005b41565b80601f0160208091040260200160405190810160405280929190818152602001828054620010a09062005b41565b8015620010f15780601f10620010c557610100808354040283529160200191620010f1565b820191906000526020600020905b815481529060010190602001808311620010d357829003601f168201915b5050509183525050601382015460209091019060ff1660038111156200111b576200111b6200538f565b60038111156200112f576200112f6200538f565b90525092915050565b600062001147848484620031f5565b6001600160a01b03841660009081526026602090815260408083203384529091528120546200117890849062005b93565b90506200118785338362002ff5565b506001949350505050565b6012546001600160a01b03163314620011fa5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e74726044820152621858dd60ea1b606482015260840162000db6565b600061012082013560288262001217604086016020870162005355565b6001600160a01b03166001600160a01b031681526020019081526020016000206009015462001247919062005b93565b60c08301356028600062001262604087016020880162005355565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001292919062005b93565b60a084013560286000620012ad604088016020890162005355565b6001600160a01b03166001600160a01b0316815260200190815260200160002060050154620012dd919062005b93565b620012e9919062005ba9565b620012f5919062005ba9565b6011546001600160a01b03166000908152602760205260408120805492935083929091906200132690849062005ba9565b909155508290506028600062001343604084016020850162005355565b6001600160a01b03168152602081019190915260400160002062001368828262005db3565b50505050565b6018546001600160a01b031633146200139b5760405162461bcd60e51b815260040162000db69062005ad3565b6001600160a01b03821660009081526027602052604081208054839290620013c590849062005ba9565b925050819055508060296000828254620013e0919062005ba9565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf906020015b60405180910390a25050565b6018546001600160a01b03163314620014555760405162461bcd60e51b815260040162000db69062005ad3565b601380546001600160a01b0319166001600160a01b03838116918217909255601454604051637adbf97360e01b8152600481019290925290911690637adbf97390602401600060405180830381600087803b158015620014b457600080fd5b505af1158015620014c9573d6000803e3d6000fd5b5050601654604051637adbf97360e01b81526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b1580156200151657600080fd5b505af11580156200152b573d6000803e3d6000fd5b5050505050565b602a546000906060906001600160a01b03163314620015655760405162461bcd60e51b815260040162000db69062005f09565b601e54436000818152601f60209081526040822093909355601954925490926200158f9162005ba9565b6012546040516306c9789b60e41b8152929091146004830181905292506001600160a01b031690636c9789b090602401600060405180830381600087803b158015620015da57600080fd5b505af1158015620015ef573d6000803e3d6000fd5b505050508015620016e45762001604620032fe565b6200160e620036e8565b62001618620037d7565b600062001624620026c7565b60135460405163422811f960e11b81529192506001600160a01b03169063845023f2906200165790849060040162005926565b600060405180830381600087803b1580156200167257600080fd5b505af115801562001687573d6000803e3d6000fd5b50505050436020819055506001601e6000828254620016a7919062005ba9565b9091555050601e546040519081527febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3359060200160405180910390a1505b60135460408051634bb278f360e01b815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af115801562001730573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062001756919062005f4c565b90508015620017d2576014546040805163a2e6204560e01b815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af1925050508015620017ca575060408051601f3d908101601f19168201909252620017c79181019062005f4c565b60015b15620017d257505b600254602280546040805160208084028201810190925282815260ff9094169391839160009084015b82821015620018e9576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620018519062005b41565b80601f01602080910402602001604051908101604052809291908181526020018280546200187f9062005b41565b8015620018d05780601f10620018a457610100808354040283529160200191620018d0565b820191906000526020600020905b815481529060010190602001808311620018b257829003601f168201915b50505050508152505081526020019060010190620017fb565b5050505090509350935050509091565b6018546001600160a01b03163314620019265760405162461bcd60e51b815260040162000db69062005ad3565b601880546001600160a01b0319166001600160a01b0383811691821790925560135460405163b3ab15fb60e01b815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b1580156200198557600080fd5b505af11580156200199a573d6000803e3d6000fd5b505060145460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620019e657600080fd5b505af1158015620019fb573d6000803e3d6000fd5b505060155460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001a4757600080fd5b505af115801562001a5c573d6000803e3d6000fd5b505060165460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001aa857600080fd5b505af115801562001abd573d6000803e3d6000fd5b505060175460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401620014fb565b600080805b60225481101562001b50576022818154811062001b1a5762001b1a62005f70565b9060005260206000209060030201600101548262001b39919062005ba9565b91508062001b478162005f86565b91505062001af9565b508060000362001ba35760405162461bcd60e51b815260206004820152601c60248201527f54686520636f6d6d6974746565206973206e6f74207374616b696e6700000000604482015260640162000db6565b60008362001bb360638762005fa2565b62001bbf919062005ba9565b905060008160405160200162001bd791815260200190565b60408051601f1981840301815291905280516020909101209050600062001bff848362005fd2565b90506000805b60225481101562001caf576022818154811062001c265762001c2662005f70565b9060005260206000209060030201600101548262001c45919062005ba9565b915062001c5460018362005b93565b831162001c9a576022818154811062001c715762001c7162005f70565b60009182526020909120600390910201546001600160a01b0316965062000d7095505050505050565b8062001ca68162005f86565b91505062001c05565b5060405162461bcd60e51b815260206004820152602960248201527f5468657265206973206e6f2076616c696461746f72206c65667420696e20746860448201526865206e6574776f726b60b81b606482015260840162000db6565b6018546001600160a01b0316331462001d385760405162461bcd60e51b815260040162000db69062005ad3565b60195481101562001def578060205462001d53919062005ba9565b431062001def5760405162461bcd60e51b815260206004820152605760248201527f63757272656e7420636861696e2068656164206578636565642074686520776960448201527f6e646f773a206c617374426c6f636b45706f6368202b205f6e6577506572696f60648201527f642c2074727920616761696e206c6174746572206f6e2e000000000000000000608482015260a40162000db6565b6019819055601254604051631ad7d11360e21b8152600481018390526001600160a01b0390911690636b5f444c90602401600060405180830381600087803b15801562001e3b57600080fd5b505af115801562001e50573d6000803e3d6000fd5b505050507fd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f818160405162001e8691815260200190565b60405180910390a150565b6018546001600160a01b0316331462001ebe5760405162461bcd60e51b815260040162000db69062005ad3565b600d55565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b03168152602001868152602001600d6000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020014381526020016000815260200160008152602001600081526020018481526020016000600381111562001f925762001f926200538f565b9052905062001fa28183620038f6565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c9262001fe392339289918b9162005fe9565b60405180910390a15050505050565b6001600160a01b0380831660008181526028602052604090206001015490911614620020325760405162461bcd60e51b815260040162000db69062005a50565b6001600160a01b038281166000908152602860205260409020541633146200206e5760405162461bcd60e51b815260040162000db69062005a87565b612710811115620020c25760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e207261746500604482015260640162000db6565b604080516060810182526001600160a01b038481168252436020808401918252838501868152600c80546000908152600a909352958220855181546001600160a01b0319169516949094178455915160018085019190915591516002909301929092558354929390929091906200213b90849062005ba9565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6018546001600160a01b03163314620021b05760405162461bcd60e51b815260040162000db69062005ad3565b6002805460ff19166001179055565b6018546001600160a01b03163314620021ec5760405162461bcd60e51b815260040162000db69062005ad3565b600081116200223e5760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e2774206265203000000000000000604482015260640162000db6565b601b55565b6018546001600160a01b03163314620022705760405162461bcd60e51b815260040162000db69062005ad3565b6001600160a01b038216600090815260276020526040902054811115620022d35760405162461bcd60e51b8152602060048201526016602482015275416d6f756e7420657863656564732062616c616e636560501b604482015260640162000db6565b6001600160a01b03821660009081526027602052604081208054839290620022fd90849062005b93565b92505081905550806029600082825462002318919062005b93565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a3906020016200141c565b6001600160a01b0380831660008181526028602052604090206001015490911614620023995760405162461bcd60e51b815260040162000db69062005b0a565b6001600160a01b03821660009081526028602052604081206013015460ff166003811115620023cc57620023cc6200538f565b146200241b5760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f206265206163746976650000000000604482015260640162000db6565b6200242882823362003d50565b5050565b6001600160a01b03808316600081815260286020526040902060010154909116146200246c5760405162461bcd60e51b815260040162000db69062005b0a565b60008111620024b65760405162461bcd60e51b81526020600482015260156024820152740756e626f6e64696e6720616d6f756e74206973203605c1b604482015260640162000db6565b6200242882823362003f3c565b60606025805480602002602001604051908101604052809291908181526020016000905b828210156200259d578382906000526020600020018054620025099062005b41565b80601f0160208091040260200160405190810160405280929190818152602001828054620025379062005b41565b8015620025885780601f106200255c5761010080835404028352916020019162002588565b820191906000526020600020905b8154815290600101906020018083116200256a57829003601f168201915b505050505081526020019060010190620024e7565b50505050905090565b600062000d6c338484620031f5565b60606022805480602002602001604051908101604052809291908181526020016000905b828210156200259d576000848152602090819020604080516060810182526003860290920180546001600160a01b03168352600181015493830193909352600283018054929392918401916200262f9062005b41565b80601f01602080910402602001604051908101604052809291908181526020018280546200265d9062005b41565b8015620026ae5780601f106200268257610100808354040283529160200191620026ae565b820191906000526020600020905b8154815290600101906020018083116200269057829003601f168201915b50505050508152505081526020019060010190620025d9565b602a546060906001600160a01b03163314620026f75760405162461bcd60e51b815260040162000db69062005f09565b601d54620027485760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f72730000000000000000604482015260640162000db6565b620027526200519d565b601b546080820152601d81526028602082015260226040820152602160608201526200277e816200426f565b6200278c60256000620051bb565b60225480620027d35760405162461bcd60e51b8152602060048201526012602482015271636f6d6d697474656520697320656d70747960701b604482015260640162000db6565b60008167ffffffffffffffff811115620027f157620027f162005757565b6040519080825280602002602001820160405280156200281b578160200160208202803683370190505b50905060005b8281101562002905576000602860006022848154811062002846576200284662005f70565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060258054600181018255925292507f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c0190620028ae9083018262006032565b50600281015483516001600160a01b0390911690849084908110620028d757620028d762005f70565b6001600160a01b03909216602092830291909101909101525080620028fc8162005f86565b91505062002821565b509250505090565b6018546001600160a01b031633146200293a5760405162461bcd60e51b815260040162000db69062005ad3565b620029476000836200428a565b620024286001826200428a565b6018546001600160a01b03163314620029815760405162461bcd60e51b815260040162000db69062005ad3565b601580546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b0380821660008181526028602052604090206001015490911614620029e35760405162461bcd60e51b815260040162000db69062005a50565b6001600160a01b0380821660009081526028602052604090208054909116331462002a695760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201526e1c99585cdd5c9e481858d8dbdd5b9d608a1b606482015260840162000db6565b6000601382015460ff16600381111562002a875762002a876200538f565b0362002ad65760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c7265616479206163746976650000000000000000604482015260640162000db6565b6002601382015460ff16600381111562002af45762002af46200538f565b14801562002b055750438160100154115b1562002b545760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c000000000000000000604482015260640162000db6565b6003601382015460ff16600381111562002b725762002b726200538f565b0362002bc15760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c7900000000604482015260640162000db6565b60138101805460ff1916905580546019546020546001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b59162002c11919062005ba9565b60405190815260200160405180910390a35050565b6060806000600181805462002c3b9062005b41565b80601f016020809104026020016040519081016040528092919081815260200182805462002c699062005b41565b801562002cba5780601f1062002c8e5761010080835404028352916020019162002cba565b820191906000526020600020905b81548152906001019060200180831162002c9c57829003601f168201915b5050505050915080805462002ccf9062005b41565b80601f016020809104026020016040519081016040528092919081815260200182805462002cfd9062005b41565b801562002d4e5780601f1062002d225761010080835404028352916020019162002d4e565b820191906000526020600020905b81548152906001019060200180831162002d3057829003601f168201915b50505050509050915091509091565b6060601d80548060200260200160405190810160405280929190818152602001828054801562002db757602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162002d98575b5050505050905090565b6018546001600160a01b0316331462002dee5760405162461bcd60e51b815260040162000db69062005ad3565b600e8190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200162001e86565b6018546001600160a01b0316331462002e515760405162461bcd60e51b815260040162000db69062005ad3565b601780546001600160a01b0319166001600160a01b0392909216919091179055565b6018546001600160a01b0316331462002ea05760405162461bcd60e51b815260040162000db69062005ad3565b62002ead600080620051db565b62002ebb60016000620051db565b6002805460ff19169055565b6018546001600160a01b0316331462002ef45760405162461bcd60e51b815260040162000db69062005ad3565b601680546001600160a01b0319166001600160a01b0392909216919091179055565b6018546001600160a01b0316331462002f435760405162461bcd60e51b815260040162000db69062005ad3565b601480546001600160a01b0319166001600160a01b0392909216919091179055565b602a546001600160a01b0316331462002f925760405162461bcd60e51b815260040162000db69062005f09565b62002f9c620036e8565b62000e06620026c7565b6018546001600160a01b0316331462002fd35760405162461bcd60e51b815260040162000db69062005ad3565b601180546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b038316620030595760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840162000db6565b6001600160a01b038216620030bc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840162000db6565b6001600160a01b0383811660008181526026602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6001600160a01b038116600090815260286020526040812090601382015460ff1660038111156200315357620031536200538f565b14620031a25760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d757374206265206163746976650000000000000000604482015260640162000db6565b60138101805460ff1916600117905580546019546020546001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c9162002c11919062005ba9565b6001600160a01b038316600090815260276020526040902054811115620032585760405162461bcd60e51b8152602060048201526016602482015275616d6f756e7420657863656564732062616c616e636560501b604482015260640162000db6565b6001600160a01b038316600090815260276020526040812080548392906200328290849062005b93565b90915550506001600160a01b03821660009081526027602052604081208054839290620032b190849062005ba9565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef836040516200311191815260200190565b476000036200330957565b600d544790600090670de0b6b3a7640000906200332890849062005fa2565b6200333491906200611b565b90508015620033b2576011546040516000916001600160a01b03169083908381818185875af1925050503d80600081146200338c576040519150601f19603f3d011682016040523d82523d6000602084013e62003391565b606091505b5090915050801515600103620033b057620033ad828462005b93565b92505b505b8160236000828254620033c6919062005ba9565b90915550600090505b602254811015620036e35760006028600060228481548110620033f657620033f662005f70565b600091825260208083206003909202909101546001600160a01b0316835282019290925260400181206021546022805492945090918791908690811062003441576200344162005f70565b9060005260206000209060030201600101546200345f919062005fa2565b6200346b91906200611b565b90508015620036cb576002601383015460ff1660038111156200349257620034926200538f565b1480620034ba57506003601383015460ff166003811115620034b857620034b86200538f565b145b156200356257601254602280546001600160a01b0390921691631de9d9b691849187908110620034ee57620034ee62005f70565b600091825260209091206003909102015460405160e084901b6001600160e01b03191681526001600160a01b0390911660048201526024016000604051808303818588803b1580156200354057600080fd5b505af115801562003555573d6000803e3d6000fd5b50505050505050620036ce565b600082600501548284600801546200357b919062005fa2565b6200358791906200611b565b9050600062003597828462005b93565b90508115620035fc5783546040516001600160a01b03909116906108fc9084906000818181858888f193505050503d8060008114620035f3576040519150601f19603f3d011682016040523d82523d6000602084013e620035f8565b606091505b5050505b8015620036845783600c0160009054906101000a90046001600160a01b03166001600160a01b031663fb489a7b826040518263ffffffff1660e01b815260040160206040518083038185885af11580156200365b573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019062003682919062006132565b505b60018401546040518481526001600160a01b03909116907fb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe5639060200160405180910390a250505b50505b80620036da8162005f86565b915050620033cf565b505050565b6004545b60055481101562003715576200370f81620037078162005f86565b9250620043dd565b620036ec565b50600554600455600854600754036200372a57565b6009545b60085481101562003757576200375181620037498162005f86565b925062004604565b6200372e565b50600854600955600754805b600854811015620037d1576010546000828152600660205260409020600401544391620037909162005ba9565b11620037b657620037a181620048ff565b620037ae60018362005ba9565b9150620037bc565b620037d1565b80620037c88162005f86565b91505062003763565b50600755565b600c54600b541015620038f457600b546000908152600a60205260409020601054600182015443916200380a9162005ba9565b1115620038145750565b600281015481546001600160a01b03908116600090815260286020526040808220600490810185905585548416835291819020600c015490516319fac8fd60e01b81529216926319fac8fd926200386f920190815260200190565b600060405180830381600087803b1580156200388a57600080fd5b505af11580156200389f573d6000803e3d6000fd5b5050600b80546000908152600a6020526040812080546001600160a01b031916815560018082018390556002909101829055825490945091925090620038e790849062005ba9565b90915550620037d7915050565b565b60e2815114620039405760405162461bcd60e51b8152602060048201526014602482015273092dcecc2d8d2c840e0e4dedecc40d8cadccee8d60631b604482015260640162000db6565b60308261024001515114620039985760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e67746800000000604482015260640162000db6565b620039a38262004a4a565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a00000000000060208083019190915284519251919260009262003a06920160609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905060008262003a26835162004b77565b8360405160200162003a3b939291906200614c565b60408051601f198184030181528282528051602091820120600280855260608501845290945060009392909183019080368337019050509050600080808062003a93898262003a8d6041600262005fa2565b62004c98565b9050600062003ab28a62003aaa6041600262005fa2565b606062004c98565b905060205b825181101562003b835762003acd838262004db1565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa15801562003b29573d6000803e3d6000fd5b5050604051601f19015190508762003b436041846200611b565b8151811062003b565762003b5662005f70565b6001600160a01b039092166020928302919091019091015262003b7b60418262005ba9565b905062003ab7565b508a602001516001600160a01b03168660008151811062003ba85762003ba862005f70565b60200260200101516001600160a01b03161462003c1a5760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f66604482015268081c1c9bdd9a59195960ba1b606482015260840162000db6565b8a604001516001600160a01b03168660018151811062003c3e5762003c3e62005f70565b60200260200101516001600160a01b03161462003cb25760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201526a1bd9881c1c9bdd9a59195960aa1b606482015260840162000db6565b600162003cca8c6102400151838e6000015162004de8565b1462003d385760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e65727368697020604482015275383937b7b3103337b9103932b3b4b9ba3930ba34b7b760511b606482015260840162000db6565b62003d438b62004e57565b5050505050505050505050565b6000821162003dae5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000db6565b6001600160a01b03811660009081526027602052604090205482111562003e185760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000db6565b6001600160a01b0381166000908152602760205260408120805484929062003e4290849062005b93565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b031991821617825595516001820180549190981696169590951790955590516002840155519101558054919262003ece8362005f86565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d91015b60405180910390a35050505050565b6001600160a01b0380841660009081526028602052604090208054909183811691161480620040b257600c820154604051631092ab9160e31b81526001600160a01b03858116600483015260009216906384955c8890602401602060405180830381865afa15801562003fb3573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062003fd9919062006132565b905084811015620040415760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201526a746f6e2062616c616e636560a81b606482015260840162000db6565b600c83015460405163282d3fdf60e01b81526001600160a01b038681166004830152602482018890529091169063282d3fdf90604401600060405180830381600087803b1580156200409257600080fd5b505af1158015620040a7573d6000803e3d6000fd5b505050505062004145565b8382600b01548360080154620040c9919062005b93565b1015620041295760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201526662616c616e636560c81b606482015260840162000db6565b8382600b0160008282546200413f919062005ba9565b90915550505b6040805160e0810182526001600160a01b0380861682528781166020808401918252838501898152600060608601818152436080880190815260a088018381528a151560c08a019081526008805486526006909752998420985189549089166001600160a01b0319918216178a55965160018a01805491909916971696909617909655915160028701559051600386015592516004850155905160059093018054945115156101000261ff00199415159490941661ffff199095169490941792909217909255805491620042198362005f86565b9190505550826001600160a01b0316856001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc838760405162003f2d9291909115158252602082015260400190565b60fa60a06000808285855af4620036e3573d6000803e3d6000fd5b8154600260018083161561010002038216048251808201602081106020841001600281146200433957600181146200435f578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b8082101562004322578151845560018401935060208201915062004303565b815191036101000a908190040290915550620043d4565b60028302826020036101000a846020036101000a602089015104020185018755620043d4565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b80821015620043c25781518455600184019350602082019150620043a3565b815191036101000a9081900402909155505b50505050505050565b600081815260036020908152604080832060018101546001600160a01b03168452602890925282209091601382015460ff1660038111156200442357620044236200538f565b14620044c357600282015482546001600160a01b0316600090815260276020526040812080549091906200445990849062005ba9565b909155505081546001830154600284015460138401546040517f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f87834294620044b6946001600160a01b0391821694911692909160ff9091169062006195565b60405180910390a1505050565b805482546001600160a01b03908116911614620045c05760008082600801548360050154620044f3919062005b93565b9050806000036200450b578360020154915062004531565b80846002015484600d015462004522919062005fa2565b6200452e91906200611b565b91505b600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b1580156200458357600080fd5b505af115801562004598573d6000803e3d6000fd5b505050508183600d016000828254620045b2919062005ba9565b90915550620045e092505050565b8160020154816008016000828254620045da919062005ba9565b90915550505b8160020154816005016000828254620045fa919062005ba9565b9091555050505050565b600081815260066020908152604080832060018101546001600160a01b0316845260289092528220600582015491929091610100900460ff16620047f8576002830154600c8301548454604051637eee288d60e01b81526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b1580156200469957600080fd5b505af1158015620046ae573d6000803e3d6000fd5b50505050600c8301548454604051632770a7eb60e21b81526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b1580156200470457600080fd5b505af115801562004719573d6000803e3d6000fd5b5050505060008360080154846005015462004735919062005b93565b600d85015490915062004749828462005fa2565b6200475591906200611b565b92508184600d0160008282546200476d919062005b93565b909155505060068401546000036200478c5760038501839055620047b4565b60068401546007850154620047a2908562005fa2565b620047ae91906200611b565b60038601555b82846006016000828254620047ca919062005ba9565b90915550506003850154600785018054600090620047ea90849062005ba9565b90915550620048cd92505050565b506002820154600882015481111562004812575060088101545b81600901546000036200482c576003830181905562004854565b6009820154600a83015462004842908362005fa2565b6200484e91906200611b565b60038401555b808260090160008282546200486a919062005ba9565b90915550506003830154600a830180546000906200488a90849062005ba9565b9250508190555080826008016000828254620048a7919062005b93565b90915550506002830154600b83018054600090620048c790849062005b93565b90915550505b6005808401805460ff1916600117905582018054829190600090620048f490849062005b93565b909155505050505050565b600081815260066020526040812060038101549091036200491e575050565b60018101546001600160a01b031660009081526028602052604081206005830154909190610100900460ff16620049ba578160070154826006015484600301546200496a919062005fa2565b6200497691906200611b565b9050808260060160008282546200498e919062005b93565b90915550506003830154600783018054600090620049ae90849062005b93565b9091555062004a1f9050565b81600a015482600901548460030154620049d5919062005fa2565b620049e191906200611b565b905080826009016000828254620049f9919062005b93565b90915550506003830154600a8301805460009062004a1990849062005b93565b90915550505b82546001600160a01b031660009081526027602052604081208054839290620048f490849062005ba9565b600062004a5b826060015162005088565b6001600160a01b0390911660208401529050801562004aab5760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b604482015260640162000db6565b6020808301516001600160a01b0390811660009081526028909252604090912060010154161562004b1f5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000db6565b61271082608001511115620024285760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000db6565b60608160000362004b9f5750506040805180820190915260018152600360fc1b602082015290565b8160005b811562004bcf578062004bb68162005f86565b915062004bc79050600a836200611b565b915062004ba3565b60008167ffffffffffffffff81111562004bed5762004bed62005757565b6040519080825280601f01601f19166020018201604052801562004c18576020820181803683370190505b5090505b841562004c905762004c3060018362005b93565b915062004c3f600a8662005fd2565b62004c4c90603062005ba9565b60f81b81838151811062004c645762004c6462005f70565b60200101906001600160f81b031916908160001a90535062004c88600a866200611b565b945062004c1c565b949350505050565b60608162004ca881601f62005ba9565b101562004ce95760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b604482015260640162000db6565b62004cf5828462005ba9565b8451101562004d3b5760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b604482015260640162000db6565b60608215801562004d5c576040519150600082526020820160405262004da8565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101562004d9757805183526020928301920162004d7d565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b81101562004de15762004dde601b82620061c3565b90505b9250925092565b600062004df46200521a565b600085858560405160200162004e0d93929190620061df565b6040516020818303038152906040529050600060fb905060008251602062004e36919062005ba9565b90506020848285855afa62004e4a57600080fd5b5050905195945050505050565b6101808101516001600160a01b031662004eda57601d5460009062004e7c9062004b77565b90508160200151826000015183608001518360405162004e9c9062005238565b62004eab94939291906200622e565b604051809103906000f08015801562004ec8573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b039586161790915584518416835260289095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062004f8c90826200626d565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f8201556102008201516010820155610220820151601182015561024082015160128201906200505690826200626d565b5061026082015160138201805460ff191660018360038111156200507e576200507e6200538f565b0217905550505050565b6000806200509562005246565b60008060ff9050604083875160208901845afa620050b257600080fd5b50508051602090910151600160601b90910494909350915050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020016000815260200160008152602001600081526020016000815260200160608152602001600060038111156200519857620051986200538f565b905290565b6040518060a001604052806005906020820280368337509192915050565b508054600082559060005260206000209081019062000e06919062005264565b508054620051e99062005b41565b6000825580601f10620051fa575050565b601f01602090049060005260206000209081019062000e06919062005289565b60405180602001604052806001906020820280368337509192915050565b611531806200632e83390190565b60405180604001604052806002906020820280368337509192915050565b80821115620052855760006200527b8282620051db565b5060010162005264565b5090565b5b808211156200528557600081556001016200528a565b60005b83811015620052bd578181015183820152602001620052a3565b50506000910152565b60008151808452620052e0816020860160208601620052a0565b601f01601f19169290920160200192915050565b602081526000620053096020830184620052c6565b9392505050565b6001600160a01b038116811462000e0657600080fd5b600080604083850312156200533a57600080fd5b8235620053478162005310565b946020939093013593505050565b6000602082840312156200536857600080fd5b8135620053098162005310565b6000602082840312156200538857600080fd5b5035919050565b634e487b7160e01b600052602160045260246000fd5b60048110620053c457634e487b7160e01b600052602160045260246000fd5b9052565b60208152620053e36020820183516001600160a01b03169052565b600060208301516200540060408401826001600160a01b03169052565b5060408301516001600160a01b0381166060840152506060830151610280806080850152620054346102a0850183620052c6565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a0620054bc818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f190161026080880191909152909150620055208483620052c6565b9350808701519150506200553782860182620053a5565b5090949350505050565b6000806000606084860312156200555757600080fd5b8335620055648162005310565b92506020840135620055768162005310565b929592945050506040919091013590565b6000602082840312156200559a57600080fd5b813567ffffffffffffffff811115620055b257600080fd5b820161028081850312156200530957600080fd5b600081518084526020808501808196508360051b8101915082860160005b858110156200563b578284038952815180516001600160a01b0316855285810151868601526040908101516060918601829052906200562681870183620052c6565b9a87019a9550505090840190600101620055e4565b5091979650505050505050565b821515815260406020820152600062004c906040830184620055c6565b600080604083850312156200567957600080fd5b50508035926020909101359150565b845181526020808601518183015260408087015181840152606080880151818501526080808901516001600160a01b03908116828701528851811660a08088019190915294890151811660c087015292880151831660e086015290870151821661010085015286015116610120830152840151610200820190620057186101408401826001600160a01b03169052565b5083516001600160a01b0316610160830152602084015161018083015260408401516101a08301526060909301516101c08201526101e0015292915050565b634e487b7160e01b600052604160045260246000fd5b600082601f8301126200577f57600080fd5b813567ffffffffffffffff808211156200579d576200579d62005757565b604051601f8301601f19908116603f01168101908282118183101715620057c857620057c862005757565b81604052838152866020858801011115620057e257600080fd5b836020870160208301376000602085830101528094505050505092915050565b600080600080608085870312156200581957600080fd5b843567ffffffffffffffff808211156200583257600080fd5b62005840888389016200576d565b955060208701359150620058548262005310565b909350604086013590808211156200586b57600080fd5b62005879888389016200576d565b935060608701359150808211156200589057600080fd5b506200589f878288016200576d565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b828110156200590457603f19888603018452620058f1858351620052c6565b94509285019290850190600101620058d2565b5092979650505050505050565b602081526000620053096020830184620055c6565b6020808252825182820181905260009190848201906040850190845b81811015620059695783516001600160a01b03168352928401929184019160010162005942565b50909695505050505050565b600080604083850312156200598957600080fd5b823567ffffffffffffffff80821115620059a257600080fd5b620059b0868387016200576d565b93506020850135915080821115620059c757600080fd5b50620059d6858286016200576d565b9150509250929050565b604081526000620059f56040830185620052c6565b828103602084015262005a098185620052c6565b95945050505050565b6000806040838503121562005a2657600080fd5b823562005a338162005310565b9150602083013562005a458162005310565b809150509250929050565b6020808252601c908201527f76616c696461746f72206d757374206265207265676973746572656400000000604082015260600190565b6020808252602c908201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160408201526b191b5a5b881858d8dbdd5b9d60a21b606082015260800190565b6020808252601a908201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604082015260600190565b60208082526018908201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604082015260600190565b600181811c9082168062005b5657607f821691505b60208210810362005b7757634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b8181038181111562000d705762000d7062005b7d565b8082018082111562000d705762000d7062005b7d565b6000813562000d708162005310565b80546001600160a01b0319166001600160a01b0392909216919091179055565b6000808335601e1984360301811262005c0657600080fd5b83018035915067ffffffffffffffff82111562005c2257600080fd5b60200191503681900382131562005c3857600080fd5b9250929050565b601f821115620036e357600081815260208120601f850160051c8101602086101562005c685750805b601f850160051c820191505b8181101562005c895782815560010162005c74565b505050505050565b600019600383901b1c191660019190911b1790565b67ffffffffffffffff83111562005cc15762005cc162005757565b62005cd98362005cd2835462005b41565b8362005c3f565b6000601f84116001811462005d0c576000851562005cf75750838201355b62005d03868262005c91565b8455506200152b565b600083815260209020601f19861690835b8281101562005d3f578685013582556020948501946001909201910162005d1d565b508682101562005d5d5760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b600081356004811062000d7057600080fd5b6004821062005da057634e487b7160e01b600052602160045260246000fd5b60ff1981541660ff831681178255505050565b62005dc962005dc28362005bbf565b8262005bce565b62005de562005ddb6020840162005bbf565b6001830162005bce565b62005e0162005df76040840162005bbf565b6002830162005bce565b62005e10606083018362005bee565b62005e2081836003860162005ca6565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b82015562005e9362005e89610180840162005bbf565b600c830162005bce565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f8201556102008201356010820155610220820135601182015562005eda61024083018362005bee565b62005eea81836012860162005ca6565b50506200242862005eff610260840162005d6f565b6013830162005d81565b60208082526023908201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60408201526218dbdb60ea1b606082015260800190565b60006020828403121562005f5f57600080fd5b815180151581146200530957600080fd5b634e487b7160e01b600052603260045260246000fd5b60006001820162005f9b5762005f9b62005b7d565b5060010190565b808202811582820484141762000d705762000d7062005b7d565b634e487b7160e01b600052601260045260246000fd5b60008262005fe45762005fe462005fbc565b500690565b600060018060a01b0380881683528087166020840152808616604084015260a060608401526200601d60a0840186620052c6565b91508084166080840152509695505050505050565b8181036200603e575050565b6200604a825462005b41565b67ffffffffffffffff81111562006065576200606562005757565b6200607d8162006076845462005b41565b8462005c3f565b6000601f821160018114620060b057600083156200609b5750848201545b620060a7848262005c91565b8555506200152b565b600085815260209020601f19841690600086815260209020845b83811015620060ec5782860154825560019586019590910190602001620060ca565b50858310156200610b5781850154600019600388901b60f8161c191681555b5050505050600190811b01905550565b6000826200612d576200612d62005fbc565b500490565b6000602082840312156200614557600080fd5b5051919050565b6000845162006160818460208901620052a0565b84519083019062006176818360208901620052a0565b84519101906200618b818360208801620052a0565b0195945050505050565b6001600160a01b03858116825284166020820152604081018390526080810162005a096060830184620053a5565b60ff818116838216019081111562000d705762000d7062005b7d565b60008451620061f3818460208901620052a0565b84519083019062006209818360208901620052a0565b60609490941b6bffffffffffffffffffffffff19169301928352505060140192915050565b6001600160a01b03858116825284166020820152604081018390526080606082018190526000906200626390830184620052c6565b9695505050505050565b815167ffffffffffffffff8111156200628a576200628a62005757565b6200629b8162006076845462005b41565b602080601f831160018114620062cf5760008415620062ba5750858301515b620062c6858262005c91565b86555062005c89565b600085815260208120601f198616915b828110156200630057888601518255948401946001909101908401620062df565b50858210156200610b57939096015160001960f8600387901b161c19169092555050600190811b0190555056fe60806040523480156200001157600080fd5b506040516200153138038062001531833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61115980620003d86000396000f3fe60806040526004361061014b5760003560e01c806359355736116100b6578063949813b81161006f578063949813b8146103c557806395d89b41146103e55780639dc29fac146103fa578063a9059cbb1461041a578063dd62ed3e1461043a578063fb489a7b1461048057600080fd5b806359355736146102e35780635ea1d6f81461031957806361d027b31461032f57806370a082311461034f5780637eee288d1461038557806384955c88146103a557600080fd5b8063282d3fdf11610108578063282d3fdf146102245780632f2c3f2e14610244578063313ce5671461025a578063372500ab146102765780633a5381b51461028b57806340c10f19146102c357600080fd5b806306fdde0314610150578063095ea7b31461017b57806318160ddd146101ab578063187cf4d7146101ca57806319fac8fd146101e257806323b872dd14610204575b600080fd5b34801561015c57600080fd5b50610165610488565b6040516101729190610ece565b60405180910390f35b34801561018757600080fd5b5061019b610196366004610f38565b61051a565b6040519015158152602001610172565b3480156101b757600080fd5b506004545b604051908152602001610172565b3480156101d657600080fd5b506101bc633b9aca0081565b3480156101ee57600080fd5b506102026101fd366004610f62565b610531565b005b34801561021057600080fd5b5061019b61021f366004610f7b565b610569565b34801561023057600080fd5b5061020261023f366004610f38565b61065c565b34801561025057600080fd5b506101bc61271081565b34801561026657600080fd5b5060405160128152602001610172565b34801561028257600080fd5b50610202610741565b34801561029757600080fd5b50600a546102ab906001600160a01b031681565b6040516001600160a01b039091168152602001610172565b3480156102cf57600080fd5b506102026102de366004610f38565b6107ef565b3480156102ef57600080fd5b506101bc6102fe366004610fb7565b6001600160a01b031660009081526002602052604090205490565b34801561032557600080fd5b506101bc600c5481565b34801561033b57600080fd5b50600b546102ab906001600160a01b031681565b34801561035b57600080fd5b506101bc61036a366004610fb7565b6001600160a01b031660009081526001602052604090205490565b34801561039157600080fd5b506102026103a0366004610f38565b610857565b3480156103b157600080fd5b506101bc6103c0366004610fb7565b61091d565b3480156103d157600080fd5b506101bc6103e0366004610fb7565b61094b565b3480156103f157600080fd5b50610165610979565b34801561040657600080fd5b50610202610415366004610f38565b610988565b34801561042657600080fd5b5061019b610435366004610f38565b6109e8565b34801561044657600080fd5b506101bc610455366004610fd9565b6001600160a01b03918216600090815260036020908152604080832093909416825291909152205490565b6101bc610a35565b6060600880546104979061100c565b80601f01602080910402602001604051908101604052809291908181526020018280546104c39061100c565b80156105105780601f106104e557610100808354040283529160200191610510565b820191906000526020600020905b8154815290600101906020018083116104f357829003601f168201915b5050505050905090565b6000610527338484610b9d565b5060015b92915050565b6000546001600160a01b031633146105645760405162461bcd60e51b815260040161055b90611046565b60405180910390fd5b600c55565b6001600160a01b0383166000908152600360209081526040808320338452909152812054828110156105ee5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b606482015260840161055b565b61060285336105fd86856110a4565b610b9d565b61060c8584610cc1565b6106168484610da9565b836001600160a01b0316856001600160a01b03166000805160206111048339815191528560405161064991815260200190565b60405180910390a3506001949350505050565b6000546001600160a01b031633146106865760405162461bcd60e51b815260040161055b90611046565b6001600160a01b03821660009081526002602090815260408083205460019092529091205482916106b6916110a4565b10156107105760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110b7565b90915550505050565b600061074c33610df4565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461079e576040519150601f19603f3d011682016040523d82523d6000602084013e6107a3565b606091505b50509050806107eb5760405162461bcd60e51b81526020600482015260146024820152732330b4b632b2103a379039b2b7321022ba3432b960611b604482015260640161055b565b5050565b6000546001600160a01b031633146108195760405162461bcd60e51b815260040161055b90611046565b6108238282610da9565b6040518181526001600160a01b03831690600090600080516020611104833981519152906020015b60405180910390a35050565b6000546001600160a01b031633146108815760405162461bcd60e51b815260040161055b90611046565b6001600160a01b0382166000908152600260205260409020548111156108f55760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110a4565b6001600160a01b038116600090815260026020908152604080832054600190925282205461052b91906110a4565b600061095682610e59565b6001600160a01b03831660009081526005602052604090205461052b91906110b7565b6060600980546104979061100c565b6000546001600160a01b031633146109b25760405162461bcd60e51b815260040161055b90611046565b6109bc8282610cc1565b6040518181526000906001600160a01b038416906000805160206111048339815191529060200161084b565b60006109f43383610cc1565b6109fe8383610da9565b6040518281526001600160a01b0384169033906000805160206111048339815191529060200160405180910390a350600192915050565b600080546001600160a01b03163314610a605760405162461bcd60e51b815260040161055b90611046565b600c54349060009061271090610a7690846110ca565b610a8091906110e1565b905081811115610ad25760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f72207265776172640000000000000000604482015260640161055b565b610adc81836110a4565b600b546040519193506001600160a01b0316906108fc9083906000818181858888f193505050503d8060008114610b2f576040519150601f19603f3d011682016040523d82523d6000602084013e610b34565b606091505b505060045460009150610b4b633b9aca00856110ca565b610b5591906110e1565b905080600754610b6591906110b7565b600755600454600090633b9aca0090610b7e90846110ca565b610b8891906110e1565b9050610b9481846110b7565b94505050505090565b6001600160a01b038316610bff5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840161055b565b6001600160a01b038216610c605760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840161055b565b6001600160a01b0383811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b610cca82610df4565b506001600160a01b038216600090815260016020908152604080832054600290925290912054610cfa90826110a4565b821115610d495760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e64730000000000604482015260640161055b565b610d5382826110a4565b6001600160a01b038416600090815260016020526040902055808203610d8d576001600160a01b0383166000908152600660205260408120555b8160046000828254610d9f91906110a4565b9091555050505050565b610db282610df4565b506001600160a01b03821660009081526001602052604081208054839290610ddb9084906110b7565b92505081905550806004600082825461073891906110b7565b600080610e0083610e59565b6001600160a01b038416600090815260056020526040902054909150610e279082906110b7565b6001600160a01b0390931660009081526005602090815260408083208690556007546006909252909120555090919050565b6001600160a01b038116600090815260016020526040812054808203610e825750600092915050565b6001600160a01b038316600090815260066020526040812054600754610ea891906110a4565b90506000633b9aca00610ebb84846110ca565b610ec591906110e1565b95945050505050565b600060208083528351808285015260005b81811015610efb57858101830151858201604001528201610edf565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b0381168114610f3357600080fd5b919050565b60008060408385031215610f4b57600080fd5b610f5483610f1c565b946020939093013593505050565b600060208284031215610f7457600080fd5b5035919050565b600080600060608486031215610f9057600080fd5b610f9984610f1c565b9250610fa760208501610f1c565b9150604084013590509250925092565b600060208284031215610fc957600080fd5b610fd282610f1c565b9392505050565b60008060408385031215610fec57600080fd5b610ff583610f1c565b915061100360208401610f1c565b90509250929050565b600181811c9082168061102057607f821691505b60208210810361104057634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561052b5761052b61108e565b8082018082111561052b5761052b61108e565b808202811582820484141761052b5761052b61108e565b6000826110fe57634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa26469706673582212201289c3ecaa1bb9a23311896b9eb4649d18bd05371b1d553ca14b0a83d5f2471f64736f6c63430008150033a264697066735822122072fde446fb7e586265947bea04483775f862a71747d658ed5f1f822b4faa4d9b64736f6c6343000815003360806040523480156200001157600080fd5b506040516200153138038062001531833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61115980620003d86000396000f3fe60806040526004361061014b5760003560e01c806359355736116100b6578063949813b81161006f578063949813b8146103c557806395d89b41146103e55780639dc29fac146103fa578063a9059cbb1461041a578063dd62ed3e1461043a578063fb489a7b1461048057600080fd5b806359355736146102e35780635ea1d6f81461031957806361d027b31461032f57806370a082311461034f5780637eee288d1461038557806384955c88146103a557600080fd5b8063282d3fdf11610108578063282d3fdf146102245780632f2c3f2e14610244578063313ce5671461025a578063372500ab146102765780633a5381b51461028b57806340c10f19146102c357600080fd5b806306fdde0314610150578063095ea7b31461017b57806318160ddd146101ab578063187cf4d7146101ca57806319fac8fd146101e257806323b872dd14610204575b600080fd5b34801561015c57600080fd5b50610165610488565b6040516101729190610ece565b60405180910390f35b34801561018757600080fd5b5061019b610196366004610f38565b61051a565b6040519015158152602001610172565b3480156101b757600080fd5b506004545b604051908152602001610172565b3480156101d657600080fd5b506101bc633b9aca0081565b3480156101ee57600080fd5b506102026101fd366004610f62565b610531565b005b34801561021057600080fd5b5061019b61021f366004610f7b565b610569565b34801561023057600080fd5b5061020261023f366004610f38565b61065c565b34801561025057600080fd5b506101bc61271081565b34801561026657600080fd5b5060405160128152602001610172565b34801561028257600080fd5b50610202610741565b34801561029757600080fd5b50600a546102ab906001600160a01b031681565b6040516001600160a01b039091168152602001610172565b3480156102cf57600080fd5b506102026102de366004610f38565b6107ef565b3480156102ef57600080fd5b506101bc6102fe366004610fb7565b6001600160a01b031660009081526002602052604090205490565b34801561032557600080fd5b506101bc600c5481565b34801561033b57600080fd5b50600b546102ab906001600160a01b031681565b34801561035b57600080fd5b506101bc61036a366004610fb7565b6001600160a01b031660009081526001602052604090205490565b34801561039157600080fd5b506102026103a0366004610f38565b610857565b3480156103b157600080fd5b506101bc6103c0366004610fb7565b61091d565b3480156103d157600080fd5b506101bc6103e0366004610fb7565b61094b565b3480156103f157600080fd5b50610165610979565b34801561040657600080fd5b50610202610415366004610f38565b610988565b34801561042657600080fd5b5061019b610435366004610f38565b6109e8565b34801561044657600080fd5b506101bc610455366004610fd9565b6001600160a01b03918216600090815260036020908152604080832093909416825291909152205490565b6101bc610a35565b6060600880546104979061100c565b80601f01602080910402602001604051908101604052809291908181526020018280546104c39061100c565b80156105105780601f106104e557610100808354040283529160200191610510565b820191906000526020600020905b8154815290600101906020018083116104f357829003601f168201915b5050505050905090565b6000610527338484610b9d565b5060015b92915050565b6000546001600160a01b031633146105645760405162461bcd60e51b815260040161055b90611046565b60405180910390fd5b600c55565b6001600160a01b0383166000908152600360209081526040808320338452909152812054828110156105ee5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b606482015260840161055b565b61060285336105fd86856110a4565b610b9d565b61060c8584610cc1565b6106168484610da9565b836001600160a01b0316856001600160a01b03166000805160206111048339815191528560405161064991815260200190565b60405180910390a3506001949350505050565b6000546001600160a01b031633146106865760405162461bcd60e51b815260040161055b90611046565b6001600160a01b03821660009081526002602090815260408083205460019092529091205482916106b6916110a4565b10156107105760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110b7565b90915550505050565b600061074c33610df4565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461079e576040519150601f19603f3d011682016040523d82523d6000602084013e6107a3565b606091505b50509050806107eb5760405162461bcd60e51b81526020600482015260146024820152732330b4b632b2103a379039b2b7321022ba3432b960611b604482015260640161055b565b5050565b6000546001600160a01b031633146108195760405162461bcd60e51b815260040161055b90611046565b6108238282610da9565b6040518181526001600160a01b03831690600090600080516020611104833981519152906020015b60405180910390a35050565b6000546001600160a01b031633146108815760405162461bcd60e51b815260040161055b90611046565b6001600160a01b0382166000908152600260205260409020548111156108f55760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110a4565b6001600160a01b038116600090815260026020908152604080832054600190925282205461052b91906110a4565b600061095682610e59565b6001600160a01b03831660009081526005602052604090205461052b91906110b7565b6060600980546104979061100c565b6000546001600160a01b031633146109b25760405162461bcd60e51b815260040161055b90611046565b6109bc8282610cc1565b6040518181526000906001600160a01b038416906000805160206111048339815191529060200161084b565b60006109f43383610cc1565b6109fe8383610da9565b6040518281526001600160a01b0384169033906000805160206111048339815191529060200160405180910390a350600192915050565b600080546001600160a01b03163314610a605760405162461bcd60e51b815260040161055b90611046565b600c54349060009061271090610a7690846110ca565b610a8091906110e1565b905081811115610ad25760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f72207265776172640000000000000000604482015260640161055b565b610adc81836110a4565b600b546040519193506001600160a01b0316906108fc9083906000818181858888f193505050503d8060008114610b2f576040519150601f19603f3d011682016040523d82523d6000602084013e610b34565b606091505b505060045460009150610b4b633b9aca00856110ca565b610b5591906110e1565b905080600754610b6591906110b7565b600755600454600090633b9aca0090610b7e90846110ca565b610b8891906110e1565b9050610b9481846110b7565b94505050505090565b6001600160a01b038316610bff5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840161055b565b6001600160a01b038216610c605760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840161055b565b6001600160a01b0383811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b610cca82610df4565b506001600160a01b038216600090815260016020908152604080832054600290925290912054610cfa90826110a4565b821115610d495760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e64730000000000604482015260640161055b565b610d5382826110a4565b6001600160a01b038416600090815260016020526040902055808203610d8d576001600160a01b0383166000908152600660205260408120555b8160046000828254610d9f91906110a4565b9091555050505050565b610db282610df4565b506001600160a01b03821660009081526001602052604081208054839290610ddb9084906110b7565b92505081905550806004600082825461073891906110b7565b600080610e0083610e59565b6001600160a01b038416600090815260056020526040902054909150610e279082906110b7565b6001600160a01b0390931660009081526005602090815260408083208690556007546006909252909120555090919050565b6001600160a01b038116600090815260016020526040812054808203610e825750600092915050565b6001600160a01b038316600090815260066020526040812054600754610ea891906110a4565b90506000633b9aca00610ebb84846110ca565b610ec591906110e1565b95945050505050565b600060208083528351808285015260005b81811015610efb57858101830151858201604001528201610edf565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b0381168114610f3357600080fd5b919050565b60008060408385031215610f4b57600080fd5b610f5483610f1c565b946020939093013593505050565b600060208284031215610f7457600080fd5b5035919050565b600080600060608486031215610f9057600080fd5b610f9984610f1c565b9250610fa760208501610f1c565b9150604084013590509250925092565b600060208284031215610fc957600080fd5b610fd282610f1c565b9392505050565b60008060408385031215610fec57600080fd5b610ff583610f1c565b915061100360208401610f1c565b90509250929050565b600181811c9082168061102057607f821691505b60208210810361104057634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561052b5761052b61108e565b8082018082111561052b5761052b61108e565b808202811582820484141761052b5761052b61108e565b6000826110fe57634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa26469706673582212201289c3ecaa1bb9a23311896b9eb4649d18bd05371b1d553ca14b0a83d5f2471f64736f6c63430008150033",
+	Bin: "0x60806040526000600b556000600c553480156200001b57600080fd5b506040516200a4683803806200a4688339810160408190526200003e9162000e64565b601c546000036200006757602a80546001600160a01b031916331790556200006782826200006f565b505062001325565b80518051600d55602080820151600e55604080830151600f55606080840151601055608093840151601180546001600160a01b03199081166001600160a01b03938416179091558487015180516012805484169185169190911790558086015160138054841691851691909117905580850151601480548416918516919091179055808401516015805484169185169190911790559586015160168054831691841691909117905560a0909501516017805487169183169190911790558286015180516018805490971692169190911790945591830151601955820151601a5590810151601b55810151601c5560005b825181101562000420576000838281518110620001805762000180620010a1565b602002602001015160a0015190506000848381518110620001a557620001a5620010a1565b60200260200101516101a00181815250506000848381518110620001cd57620001cd620010a1565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620002095762000209620010a1565b602002602001015160a00181815250506000848381518110620002305762000230620010a1565b60209081029190910101516101c00152600f5484518590849081106200025a576200025a620010a1565b602002602001015160800181815250506000848381518110620002815762000281620010a1565b602002602001015161026001906003811115620002a257620002a2620010b7565b90816003811115620002b857620002b8620010b7565b815250506000848381518110620002d357620002d3620010a1565b602002602001015161016001818152505062000311848381518110620002fd57620002fd620010a1565b60200260200101516200042560201b60201c565b6200033e8483815181106200032a576200032a620010a1565b60200260200101516200056060201b60201c565b8060276000868581518110620003585762000358620010a1565b6020026020010151600001516001600160a01b03166001600160a01b031681526020019081526020016000206000828254620003959190620010e3565b925050819055508060296000828254620003b09190620010e3565b925050819055506200040a848381518110620003d057620003d0620010a1565b60200260200101516020015182868581518110620003f257620003f2620010a1565b6020026020010151600001516200079160201b60201c565b50806200041781620010ff565b9150506200015f565b505050565b60006200043c82606001516200097c60201b60201c565b6001600160a01b03909116602084015290508015620004905760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064015b60405180910390fd5b6020808301516001600160a01b03908116600090815260289092526040909120600101541615620005045760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000487565b612710826080015111156200055c5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000487565b5050565b6101808101516001600160a01b0316620005e357601d546000906200058590620009ca565b905081602001518260000151836080015183604051620005a59062000aea565b620005b494939291906200111b565b604051809103906000f080158015620005d1573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352602890955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620006959082620011ff565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f8201556102008201516010820155610220820151601182015561024082015160128201906200075f9082620011ff565b5061026082015160138201805460ff19166001836003811115620007875762000787620010b7565b0217905550505050565b60008211620007ef5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000487565b6001600160a01b038116600090815260276020526040902054821115620008595760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000487565b6001600160a01b0381166000908152602760205260408120805484929062000883908490620012cb565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b03199182161782559551600182018054919098169616959095179095559051600284015551910155805491926200090f83620010ff565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a35050505050565b6000806200098962000af8565b60008060ff9050604083875160208901845afa620009a657600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b606081600003620009f25750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000a22578062000a0981620010ff565b915062000a1a9050600a83620012f7565b9150620009f6565b6000816001600160401b0381111562000a3f5762000a3f62000b16565b6040519080825280601f01601f19166020018201604052801562000a6a576020820181803683370190505b5090505b841562000ae25762000a82600183620012cb565b915062000a91600a866200130e565b62000a9e906030620010e3565b60f81b81838151811062000ab65762000ab6620010a1565b60200101906001600160f81b031916908160001a90535062000ada600a86620012f7565b945062000a6e565b949350505050565b6115318062008f3783390190565b60405180604001604052806002906020820280368337509192915050565b634e487b7160e01b600052604160045260246000fd5b604051608081016001600160401b038111828210171562000b515762000b5162000b16565b60405290565b60405160a081016001600160401b038111828210171562000b515762000b5162000b16565b60405161028081016001600160401b038111828210171562000b515762000b5162000b16565b604051601f8201601f191681016001600160401b038111828210171562000bcd5762000bcd62000b16565b604052919050565b6001600160a01b038116811462000beb57600080fd5b50565b805162000bfb8162000bd5565b919050565b60005b8381101562000c1d57818101518382015260200162000c03565b50506000910152565b600082601f83011262000c3857600080fd5b81516001600160401b0381111562000c545762000c5462000b16565b62000c69601f8201601f191660200162000ba2565b81815284602083860101111562000c7f57600080fd5b62000ae282602083016020870162000c00565b80516004811062000bfb57600080fd5b600060c0828403121562000cb557600080fd5b60405160c081016001600160401b038111828210171562000cda5762000cda62000b16565b8060405250809150825162000cef8162000bd5565b8152602083015162000d018162000bd5565b6020820152604083015162000d168162000bd5565b6040820152606083015162000d2b8162000bd5565b6060820152608083015162000d408162000bd5565b608082015260a083015162000d558162000bd5565b60a0919091015292915050565b60006080828403121562000d7557600080fd5b62000d7f62000b2c565b9050815162000d8e8162000bd5565b8082525060208201516020820152604082015160408201526060820151606082015292915050565b600081830361020081121562000dcb57600080fd5b62000dd562000b2c565b915060a081121562000de657600080fd5b5062000df162000b57565b82518152602083015160208201526040830151604082015260608301516060820152608083015162000e238162000bd5565b6080820152815262000e398360a0840162000ca2565b602082015262000e4e83610160840162000d62565b60408201526101e0820151606082015292915050565b60008061022080848603121562000e7a57600080fd5b83516001600160401b038082111562000e9257600080fd5b818601915086601f83011262000ea757600080fd5b815160208282111562000ebe5762000ebe62000b16565b8160051b62000ecf82820162000ba2565b928352848101820192828101908b85111562000eea57600080fd5b83870192505b848310156200107f5782518681111562000f0957600080fd5b8701610280818e03601f1901121562000f2157600080fd5b62000f2b62000b7c565b62000f3886830162000bee565b815262000f486040830162000bee565b8682015262000f5a6060830162000bee565b604082015260808201518881111562000f7257600080fd5b62000f828f888386010162000c26565b60608301525060a0820151608082015260c082015160a082015260e082015160c082015261010082015160e082015261012082015161010082015261014082015161012082015261016082015161014082015261018082015161016082015262000ff06101a0830162000bee565b6101808201526101c08201516101a08201526101e08201516101c08201526102008201516101e0820152898201516102008201526102408201518a820152610260820151888111156200104257600080fd5b620010528f888386010162000c26565b6102408301525062001068610280830162000c92565b610260820152835250918301919083019062000ef0565b8099505050506200109389828a0162000db6565b955050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b80820180821115620010f957620010f9620010cd565b92915050565b600060018201620011145762001114620010cd565b5060010190565b600060018060a01b0380871683528086166020840152508360408301526080606083015282518060808401526200115a8160a085016020870162000c00565b601f01601f19169190910160a00195945050505050565b600181811c908216806200118657607f821691505b602082108103620011a757634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200042057600081815260208120601f850160051c81016020861015620011d65750805b601f850160051c820191505b81811015620011f757828155600101620011e2565b505050505050565b81516001600160401b038111156200121b576200121b62000b16565b62001233816200122c845462001171565b84620011ad565b602080601f8311600181146200126b5760008415620012525750858301515b600019600386901b1c1916600185901b178555620011f7565b600085815260208120601f198616915b828110156200129c578886015182559484019460019091019084016200127b565b5085821015620012bb5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b81810381811115620010f957620010f9620010cd565b634e487b7160e01b600052601260045260246000fd5b600082620013095762001309620012e1565b500490565b600082620013205762001320620012e1565b500690565b617c0280620013356000396000f3fe608060405260043610620003ff5760003560e01c8063872cf059116200020f578063b66b3e791162000123578063d372c07e11620000b3578063da45591b1162000081578063da45591b1462000d2a578063dd62ed3e1462000d4f578063dfb1a4d21462000d99578063e7f43c681462000db0578063f7866ee31462000dd057005b8063d372c07e1462000ca6578063d5f394881462000ccb578063d861b0e81462000ced578063d886f8a21462000d0557005b8063cb696f5411620000f1578063cb696f541462000c1f578063ceaad4551462000c44578063cf9c57191462000c69578063cfd19fb91462000c8157005b8063b66b3e791462000baf578063b7ab4db51462000bd7578063c2362dd51462000bef578063c9d97af41462000c0757005b8063a5d059ca116200019f578063ae1f5fa0116200016d578063ae1f5fa01462000b19578063b2ea9adb1462000b40578063b3ecbadd1462000b65578063b46e55201462000b8a57005b8063a5d059ca1462000a81578063a8b2216e1462000aa6578063a9059cbb1462000acd578063ab8f6ffe1462000af257005b80639bb851c011620001dd5780639bb851c01462000a075780639c98e4711462000a1f5780639dc29fac1462000a37578063a515366a1462000a5c57005b8063872cf059146200096b5780638bac7dad146200098357806395d89b4114620009a857806396b477cb14620009d657005b806339b17fec11620003135780636fd2c80b11620002a357806379502c55116200027157806379502c55146200080c578063819b646314620008ea578063833b1fce146200090157806384467fdb1462000921578063852c4849146200094657005b80636fd2c80b146200077f57806370a082311462000796578063731b3a0314620007d057806377e741c714620007e757005b80634bb278f311620002e15780634bb278f314620006cf578063520fdbbc14620006f75780635f7d3949146200071c5780636b5f444c146200075a57005b806339b17fec146200064957806340c10f19146200066e578063436459691462000693578063496ccd9b14620006aa57005b806318160ddd116200038f5780632a400bc3116200035d5780632a400bc314620005c95780632f2c3f2e14620005ee578063313ce567146200060657806335be16e0146200062457005b806318160ddd14620005425780631904bb2e146200055957806323b872dd146200058d57806329070c6d14620005b257005b80631122063311620003cd5780631122063314620004c9578063114eaf5514620004e05780631250a28d14620005055780631604e416146200052a57005b806306fdde031462000409578063095ea7b3146200044d5780630ae65e7a14620004835780630d8e6e2c14620004a857005b366200040757005b005b3480156200041657600080fd5b506040805180820190915260068152652732bbba37b760d11b60208201525b604051620004449190620055da565b60405180910390f35b3480156200045a57600080fd5b50620004726200046c3660046200560c565b62000df0565b604051901515815260200162000444565b3480156200049057600080fd5b5062000407620004a23660046200563b565b62000e09565b348015620004b557600080fd5b50601c545b60405190815260200162000444565b348015620004d657600080fd5b50600e54620004ba565b348015620004ed57600080fd5b5062000407620004ff3660046200565b565b62000e9c565b3480156200051257600080fd5b5062000407620005243660046200563b565b62000ece565b3480156200053757600080fd5b50620004ba60245481565b3480156200054f57600080fd5b50602954620004ba565b3480156200056657600080fd5b506200057e620005783660046200563b565b62000f1d565b604051620004449190620056ae565b3480156200059a57600080fd5b5062000472620005ac36600462005827565b620011cb565b348015620005bf57600080fd5b50600d54620004ba565b348015620005d657600080fd5b5062000407620005e836600462005827565b62001225565b348015620005fb57600080fd5b50620004ba61271081565b3480156200061357600080fd5b506040516012815260200162000444565b3480156200063157600080fd5b5062000407620006433660046200586d565b62001312565b3480156200065657600080fd5b50620004076200066836600462005827565b620014ee565b3480156200067b57600080fd5b50620004076200068d3660046200560c565b620015a7565b348015620006a057600080fd5b50601a54620004ba565b348015620006b757600080fd5b5062000407620006c93660046200563b565b62001661565b348015620006dc57600080fd5b50620006e76200176b565b604051620004449291906200592e565b3480156200070457600080fd5b5062000407620007163660046200563b565b62001b32565b3480156200072957600080fd5b50620007416200073b3660046200594b565b62001d2d565b6040516001600160a01b03909116815260200162000444565b3480156200076757600080fd5b5062000407620007793660046200565b565b62001f44565b3480156200078c57600080fd5b50601054620004ba565b348015620007a357600080fd5b50620004ba620007b53660046200563b565b6001600160a01b031660009081526027602052604090205490565b348015620007dd57600080fd5b50602054620004ba565b348015620007f457600080fd5b5062000407620008063660046200565b565b620020ca565b3480156200081957600080fd5b506040805160a08082018352600d548252600e54602080840191909152600f54838501526010546060808501919091526011546001600160a01b03908116608080870191909152865160c0810188526012548316815260135483168186015260145483168189015260155483168185015260165483168183015260175483169581019590955286519081018752601854909116815260195492810192909252601a5494820194909452601b5493810193909352601c54620008d8939084565b6040516200044494939291906200596e565b348015620008f757600080fd5b50601b54620004ba565b3480156200090e57600080fd5b506013546001600160a01b031662000741565b3480156200092e57600080fd5b50620004076200094036600462005ae8565b620020fc565b3480156200095357600080fd5b5062000407620009653660046200560c565b6200222b565b3480156200097857600080fd5b5062000407620023bc565b3480156200099057600080fd5b5062000407620009a23660046200565b565b620023f8565b348015620009b557600080fd5b50604080518082019091526003815262272a2760e91b602082015262000435565b348015620009e357600080fd5b50620004ba620009f53660046200565b565b6000908152601f602052604090205490565b34801562000a1457600080fd5b50620004ba60235481565b34801562000a2c57600080fd5b50620004ba60215481565b34801562000a4457600080fd5b506200040762000a563660046200560c565b6200247c565b34801562000a6957600080fd5b506200040762000a7b3660046200560c565b62002592565b34801562000a8e57600080fd5b506200040762000aa03660046200560c565b62002665565b34801562000ab357600080fd5b5062000abe620026fc565b60405162000444919062005b91565b34801562000ada57600080fd5b506200047262000aec3660046200560c565b620027df565b34801562000aff57600080fd5b5062000b0a620027ee565b60405162000444919062005bf7565b34801562000b2657600080fd5b5062000b3162002900565b60405162000444919062005c0c565b34801562000b4d57600080fd5b506200040762000b5f36600462005c5b565b62002b46565b34801562000b7257600080fd5b506200040762000b843660046200563b565b62002b8d565b34801562000b9757600080fd5b506200040762000ba93660046200563b565b62002bdc565b34801562000bbc57600080fd5b5062000bc762002e18565b6040516200044492919062005cc6565b34801562000be457600080fd5b5062000b3162002f4f565b34801562000bfc57600080fd5b50620004ba60205481565b34801562000c1457600080fd5b50620004ba601e5481565b34801562000c2c57600080fd5b506200040762000c3e3660046200565b565b62002fb3565b34801562000c5157600080fd5b506200040762000c633660046200563b565b62003016565b34801562000c7657600080fd5b506200040762003065565b34801562000c8e57600080fd5b506200040762000ca03660046200563b565b620030b9565b34801562000cb357600080fd5b506200040762000cc53660046200563b565b62003108565b34801562000cd857600080fd5b50602a5462000741906001600160a01b031681565b34801562000cfa57600080fd5b506200040762003157565b34801562000d1257600080fd5b506200040762000d243660046200563b565b62003198565b34801562000d3757600080fd5b506200040762000d4936600462005cf8565b620031e7565b34801562000d5c57600080fd5b50620004ba62000d6e36600462005d31565b6001600160a01b03918216600090815260266020908152604080832093909416825291909152205490565b34801562000da657600080fd5b50601954620004ba565b34801562000dbd57600080fd5b506018546001600160a01b031662000741565b34801562000ddd57600080fd5b506011546001600160a01b031662000741565b600062000dff338484620032aa565b5060015b92915050565b6001600160a01b038082166000818152602860205260409020600101549091161462000e525760405162461bcd60e51b815260040162000e499062005d6f565b60405180910390fd5b6001600160a01b0381811660009081526028602052604090205416331462000e8e5760405162461bcd60e51b815260040162000e499062005da6565b62000e9981620033d3565b50565b6018546001600160a01b0316331462000ec95760405162461bcd60e51b815260040162000e499062005df2565b601055565b6018546001600160a01b0316331462000efb5760405162461bcd60e51b815260040162000e499062005df2565b601280546001600160a01b0319166001600160a01b0392909216919091179055565b62000f27620053b3565b6001600160a01b038083166000818152602860205260409020600101549091161462000f675760405162461bcd60e51b815260040162000e499062005e29565b6001600160a01b03808316600090815260286020908152604091829020825161028081018452815485168152600182015485169281019290925260028101549093169181019190915260038201805491929160608401919062000fca9062005e60565b80601f016020809104026020016040519081016040528092919081815260200182805462000ff89062005e60565b8015620010495780601f106200101d5761010080835404028352916020019162001049565b820191906000526020600020905b8154815290600101906020018083116200102b57829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e090920191620011059062005e60565b80601f0160208091040260200160405190810160405280929190818152602001828054620011339062005e60565b8015620011845780601f10620011585761010080835404028352916020019162001184565b820191906000526020600020905b8154815290600101906020018083116200116657829003601f168201915b5050509183525050601382015460209091019060ff166003811115620011ae57620011ae62005675565b6003811115620011c257620011c262005675565b90525092915050565b6000620011da848484620034aa565b6001600160a01b03841660009081526026602090815260408083203384529091528120546200120b90849062005eb2565b90506200121a853383620032aa565b506001949350505050565b6018546001600160a01b03163314620012525760405162461bcd60e51b815260040162000e499062005df2565b6001600160a01b0380841660008181526028602052604090206001015490911614620012925760405162461bcd60e51b815260040162000e499062005d6f565b6001600160a01b0383811660009081526028602052604090819020600c0154905163282d3fdf60e01b815284831660048201526024810184905291169063282d3fdf906044015b600060405180830381600087803b158015620012f457600080fd5b505af115801562001309573d6000803e3d6000fd5b50505050505050565b6012546001600160a01b031633146200137a5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e74726044820152621858dd60ea1b606482015260840162000e49565b60006101208201356028826200139760408601602087016200563b565b6001600160a01b03166001600160a01b0316815260200190815260200160002060090154620013c7919062005eb2565b60c083013560286000620013e260408701602088016200563b565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001412919062005eb2565b60a0840135602860006200142d60408801602089016200563b565b6001600160a01b03166001600160a01b03168152602001908152602001600020600501546200145d919062005eb2565b62001469919062005ec8565b62001475919062005ec8565b6011546001600160a01b0316600090815260276020526040812080549293508392909190620014a690849062005ec8565b9091555082905060286000620014c360408401602085016200563b565b6001600160a01b031681526020810191909152604001600020620014e88282620060d2565b50505050565b6018546001600160a01b031633146200151b5760405162461bcd60e51b815260040162000e499062005df2565b6001600160a01b03808416600081815260286020526040902060010154909116146200155b5760405162461bcd60e51b815260040162000e499062005d6f565b6001600160a01b0383811660009081526028602052604090819020600c01549051637eee288d60e01b8152848316600482015260248101849052911690637eee288d90604401620012d9565b6018546001600160a01b03163314620015d45760405162461bcd60e51b815260040162000e499062005df2565b6001600160a01b03821660009081526027602052604081208054839290620015fe90849062005ec8565b92505081905550806029600082825462001619919062005ec8565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf906020015b60405180910390a25050565b6018546001600160a01b031633146200168e5760405162461bcd60e51b815260040162000e499062005df2565b601380546001600160a01b0319166001600160a01b03838116918217909255601454604051637adbf97360e01b8152600481019290925290911690637adbf97390602401600060405180830381600087803b158015620016ed57600080fd5b505af115801562001702573d6000803e3d6000fd5b5050601654604051637adbf97360e01b81526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b1580156200174f57600080fd5b505af115801562001764573d6000803e3d6000fd5b5050505050565b602a546000906060906001600160a01b031633146200179e5760405162461bcd60e51b815260040162000e499062006228565b601e54436000818152601f6020908152604082209390935560195492549092620017c89162005ec8565b6012546040516306c9789b60e41b8152929091146004830181905292506001600160a01b031690636c9789b090602401600060405180830381600087803b1580156200181357600080fd5b505af115801562001828573d6000803e3d6000fd5b5050505080156200191d576200183d620035b3565b620018476200399d565b6200185162003a8c565b60006200185d62002900565b60135460405163422811f960e11b81529192506001600160a01b03169063845023f2906200189090849060040162005c0c565b600060405180830381600087803b158015620018ab57600080fd5b505af1158015620018c0573d6000803e3d6000fd5b50505050436020819055506001601e6000828254620018e0919062005ec8565b9091555050601e546040519081527febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3359060200160405180910390a1505b60135460408051634bb278f360e01b815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af115801562001969573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200198f91906200626b565b9050801562001a0b576014546040805163a2e6204560e01b815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af192505050801562001a03575060408051601f3d908101601f1916820190925262001a00918101906200626b565b60015b1562001a0b57505b600254602280546040805160208084028201810190925282815260ff9094169391839160009084015b8282101562001b22576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162001a8a9062005e60565b80601f016020809104026020016040519081016040528092919081815260200182805462001ab89062005e60565b801562001b095780601f1062001add5761010080835404028352916020019162001b09565b820191906000526020600020905b81548152906001019060200180831162001aeb57829003601f168201915b5050505050815250508152602001906001019062001a34565b5050505090509350935050509091565b6018546001600160a01b0316331462001b5f5760405162461bcd60e51b815260040162000e499062005df2565b601880546001600160a01b0319166001600160a01b0383811691821790925560135460405163b3ab15fb60e01b815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b15801562001bbe57600080fd5b505af115801562001bd3573d6000803e3d6000fd5b505060145460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001c1f57600080fd5b505af115801562001c34573d6000803e3d6000fd5b505060155460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001c8057600080fd5b505af115801562001c95573d6000803e3d6000fd5b505060165460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001ce157600080fd5b505af115801562001cf6573d6000803e3d6000fd5b505060175460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb915060240162001734565b600080805b60225481101562001d89576022818154811062001d535762001d536200628f565b9060005260206000209060030201600101548262001d72919062005ec8565b91508062001d8081620062a5565b91505062001d32565b508060000362001ddc5760405162461bcd60e51b815260206004820152601c60248201527f54686520636f6d6d6974746565206973206e6f74207374616b696e6700000000604482015260640162000e49565b60008362001dec606387620062c1565b62001df8919062005ec8565b905060008160405160200162001e1091815260200190565b60408051601f1981840301815291905280516020909101209050600062001e388483620062f1565b90506000805b60225481101562001ee8576022818154811062001e5f5762001e5f6200628f565b9060005260206000209060030201600101548262001e7e919062005ec8565b915062001e8d60018362005eb2565b831162001ed3576022818154811062001eaa5762001eaa6200628f565b60009182526020909120600390910201546001600160a01b0316965062000e0395505050505050565b8062001edf81620062a5565b91505062001e3e565b5060405162461bcd60e51b815260206004820152602960248201527f5468657265206973206e6f2076616c696461746f72206c65667420696e20746860448201526865206e6574776f726b60b81b606482015260840162000e49565b6018546001600160a01b0316331462001f715760405162461bcd60e51b815260040162000e499062005df2565b60195481101562002028578060205462001f8c919062005ec8565b4310620020285760405162461bcd60e51b815260206004820152605760248201527f63757272656e7420636861696e2068656164206578636565642074686520776960448201527f6e646f773a206c617374426c6f636b45706f6368202b205f6e6577506572696f60648201527f642c2074727920616761696e206c6174746572206f6e2e000000000000000000608482015260a40162000e49565b6019819055601254604051631ad7d11360e21b8152600481018390526001600160a01b0390911690636b5f444c90602401600060405180830381600087803b1580156200207457600080fd5b505af115801562002089573d6000803e3d6000fd5b505050507fd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f8181604051620020bf91815260200190565b60405180910390a150565b6018546001600160a01b03163314620020f75760405162461bcd60e51b815260040162000e499062005df2565b600d55565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b03168152602001868152602001600d6000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200143815260200160008152602001600081526020016000815260200184815260200160006003811115620021cb57620021cb62005675565b90529050620021db818362003bab565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c926200221c92339289918b9162006308565b60405180910390a15050505050565b6001600160a01b03808316600081815260286020526040902060010154909116146200226b5760405162461bcd60e51b815260040162000e499062005d6f565b6001600160a01b03828116600090815260286020526040902054163314620022a75760405162461bcd60e51b815260040162000e499062005da6565b612710811115620022fb5760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e207261746500604482015260640162000e49565b604080516060810182526001600160a01b038481168252436020808401918252838501868152600c80546000908152600a909352958220855181546001600160a01b0319169516949094178455915160018085019190915591516002909301929092558354929390929091906200237490849062005ec8565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6018546001600160a01b03163314620023e95760405162461bcd60e51b815260040162000e499062005df2565b6002805460ff19166001179055565b6018546001600160a01b03163314620024255760405162461bcd60e51b815260040162000e499062005df2565b60008111620024775760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e2774206265203000000000000000604482015260640162000e49565b601b55565b6018546001600160a01b03163314620024a95760405162461bcd60e51b815260040162000e499062005df2565b6001600160a01b0382166000908152602760205260409020548111156200250c5760405162461bcd60e51b8152602060048201526016602482015275416d6f756e7420657863656564732062616c616e636560501b604482015260640162000e49565b6001600160a01b038216600090815260276020526040812080548392906200253690849062005eb2565b92505081905550806029600082825462002551919062005eb2565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a39060200162001655565b6001600160a01b0380831660008181526028602052604090206001015490911614620025d25760405162461bcd60e51b815260040162000e499062005e29565b6001600160a01b03821660009081526028602052604081206013015460ff16600381111562002605576200260562005675565b14620026545760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f206265206163746976650000000000604482015260640162000e49565b6200266182823362004005565b5050565b6001600160a01b0380831660008181526028602052604090206001015490911614620026a55760405162461bcd60e51b815260040162000e499062005e29565b60008111620026ef5760405162461bcd60e51b81526020600482015260156024820152740756e626f6e64696e6720616d6f756e74206973203605c1b604482015260640162000e49565b62002661828233620041f1565b60606025805480602002602001604051908101604052809291908181526020016000905b82821015620027d6578382906000526020600020018054620027429062005e60565b80601f0160208091040260200160405190810160405280929190818152602001828054620027709062005e60565b8015620027c15780601f106200279557610100808354040283529160200191620027c1565b820191906000526020600020905b815481529060010190602001808311620027a357829003601f168201915b50505050508152602001906001019062002720565b50505050905090565b600062000dff338484620034aa565b60606022805480602002602001604051908101604052809291908181526020016000905b82821015620027d6576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620028689062005e60565b80601f0160208091040260200160405190810160405280929190818152602001828054620028969062005e60565b8015620028e75780601f10620028bb57610100808354040283529160200191620028e7565b820191906000526020600020905b815481529060010190602001808311620028c957829003601f168201915b5050505050815250508152602001906001019062002812565b602a546060906001600160a01b03163314620029305760405162461bcd60e51b815260040162000e499062006228565b601d54620029815760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f72730000000000000000604482015260640162000e49565b6200298b62005483565b601b546080820152601d8152602860208201526022604082015260216060820152620029b78162004524565b620029c560256000620054a1565b6022548062002a0c5760405162461bcd60e51b8152602060048201526012602482015271636f6d6d697474656520697320656d70747960701b604482015260640162000e49565b60008167ffffffffffffffff81111562002a2a5762002a2a62005a3d565b60405190808252806020026020018201604052801562002a54578160200160208202803683370190505b50905060005b8281101562002b3e576000602860006022848154811062002a7f5762002a7f6200628f565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060258054600181018255925292507f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c019062002ae79083018262006351565b50600281015483516001600160a01b039091169084908490811062002b105762002b106200628f565b6001600160a01b0390921660209283029190910190910152508062002b3581620062a5565b91505062002a5a565b509250505090565b6018546001600160a01b0316331462002b735760405162461bcd60e51b815260040162000e499062005df2565b62002b806000836200453f565b620026616001826200453f565b6018546001600160a01b0316331462002bba5760405162461bcd60e51b815260040162000e499062005df2565b601580546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b038082166000818152602860205260409020600101549091161462002c1c5760405162461bcd60e51b815260040162000e499062005d6f565b6001600160a01b0380821660009081526028602052604090208054909116331462002c5b5760405162461bcd60e51b815260040162000e49906200643a565b6000601382015460ff16600381111562002c795762002c7962005675565b0362002cc85760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c7265616479206163746976650000000000000000604482015260640162000e49565b6002601382015460ff16600381111562002ce65762002ce662005675565b14801562002cf75750438160100154115b1562002d465760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c000000000000000000604482015260640162000e49565b6003601382015460ff16600381111562002d645762002d6462005675565b0362002db35760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c7900000000604482015260640162000e49565b60138101805460ff1916905580546019546020546001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b59162002e03919062005ec8565b60405190815260200160405180910390a35050565b6060806000600181805462002e2d9062005e60565b80601f016020809104026020016040519081016040528092919081815260200182805462002e5b9062005e60565b801562002eac5780601f1062002e805761010080835404028352916020019162002eac565b820191906000526020600020905b81548152906001019060200180831162002e8e57829003601f168201915b5050505050915080805462002ec19062005e60565b80601f016020809104026020016040519081016040528092919081815260200182805462002eef9062005e60565b801562002f405780601f1062002f145761010080835404028352916020019162002f40565b820191906000526020600020905b81548152906001019060200180831162002f2257829003601f168201915b50505050509050915091509091565b6060601d80548060200260200160405190810160405280929190818152602001828054801562002fa957602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162002f8a575b5050505050905090565b6018546001600160a01b0316331462002fe05760405162461bcd60e51b815260040162000e499062005df2565b600e8190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd38912890602001620020bf565b6018546001600160a01b03163314620030435760405162461bcd60e51b815260040162000e499062005df2565b601780546001600160a01b0319166001600160a01b0392909216919091179055565b6018546001600160a01b03163314620030925760405162461bcd60e51b815260040162000e499062005df2565b6200309f600080620054c1565b620030ad60016000620054c1565b6002805460ff19169055565b6018546001600160a01b03163314620030e65760405162461bcd60e51b815260040162000e499062005df2565b601680546001600160a01b0319166001600160a01b0392909216919091179055565b6018546001600160a01b03163314620031355760405162461bcd60e51b815260040162000e499062005df2565b601480546001600160a01b0319166001600160a01b0392909216919091179055565b602a546001600160a01b03163314620031845760405162461bcd60e51b815260040162000e499062006228565b6200318e6200399d565b62000e9962002900565b6018546001600160a01b03163314620031c55760405162461bcd60e51b815260040162000e499062005df2565b601180546001600160a01b0319166001600160a01b0392909216919091179055565b6000620031f48262004691565b6001600160a01b03808216600081815260286020526040902060010154929350911614620032365760405162461bcd60e51b815260040162000e499062005d6f565b6001600160a01b03818116600090815260286020526040902054163314620032725760405162461bcd60e51b815260040162000e49906200643a565b6040516001600160a01b038216907f68d46f7ed6d57eea4fc550f4783b3f528ef5f858f9e78b9a7728b1bef0a1b92f90600090a25050565b6001600160a01b0383166200330e5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840162000e49565b6001600160a01b038216620033715760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840162000e49565b6001600160a01b0383811660008181526026602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6001600160a01b038116600090815260286020526040812090601382015460ff16600381111562003408576200340862005675565b14620034575760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d757374206265206163746976650000000000000000604482015260640162000e49565b60138101805460ff1916600117905580546019546020546001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c9162002e03919062005ec8565b6001600160a01b0383166000908152602760205260409020548111156200350d5760405162461bcd60e51b8152602060048201526016602482015275616d6f756e7420657863656564732062616c616e636560501b604482015260640162000e49565b6001600160a01b038316600090815260276020526040812080548392906200353790849062005eb2565b90915550506001600160a01b038216600090815260276020526040812080548392906200356690849062005ec8565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef83604051620033c691815260200190565b47600003620035be57565b600d544790600090670de0b6b3a764000090620035dd908490620062c1565b620035e9919062006489565b9050801562003667576011546040516000916001600160a01b03169083908381818185875af1925050503d806000811462003641576040519150601f19603f3d011682016040523d82523d6000602084013e62003646565b606091505b5090915050801515600103620036655762003662828462005eb2565b92505b505b81602360008282546200367b919062005ec8565b90915550600090505b602254811015620039985760006028600060228481548110620036ab57620036ab6200628f565b600091825260208083206003909202909101546001600160a01b03168352820192909252604001812060215460228054929450909187919086908110620036f657620036f66200628f565b906000526020600020906003020160010154620037149190620062c1565b62003720919062006489565b9050801562003980576002601383015460ff16600381111562003747576200374762005675565b14806200376f57506003601383015460ff1660038111156200376d576200376d62005675565b145b156200381757601254602280546001600160a01b0390921691631de9d9b691849187908110620037a357620037a36200628f565b600091825260209091206003909102015460405160e084901b6001600160e01b03191681526001600160a01b0390911660048201526024016000604051808303818588803b158015620037f557600080fd5b505af11580156200380a573d6000803e3d6000fd5b5050505050505062003983565b60008260050154828460080154620038309190620062c1565b6200383c919062006489565b905060006200384c828462005eb2565b90508115620038b15783546040516001600160a01b03909116906108fc9084906000818181858888f193505050503d8060008114620038a8576040519150601f19603f3d011682016040523d82523d6000602084013e620038ad565b606091505b5050505b8015620039395783600c0160009054906101000a90046001600160a01b03166001600160a01b031663fb489a7b826040518263ffffffff1660e01b815260040160206040518083038185885af115801562003910573d6000803e3d6000fd5b50505050506040513d601f19601f82011682018060405250810190620039379190620064a0565b505b60018401546040518481526001600160a01b03909116907fb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe5639060200160405180910390a250505b50505b806200398f81620062a5565b91505062003684565b505050565b6004545b600554811015620039ca57620039c481620039bc81620062a5565b9250620046c3565b620039a1565b5060055460045560085460075403620039df57565b6009545b60085481101562003a0c5762003a0681620039fe81620062a5565b9250620048ea565b620039e3565b50600854600955600754805b60085481101562003a8657601054600082815260066020526040902060040154439162003a459162005ec8565b1162003a6b5762003a568162004be5565b62003a6360018362005ec8565b915062003a71565b62003a86565b8062003a7d81620062a5565b91505062003a18565b50600755565b600c54600b54101562003ba957600b546000908152600a602052604090206010546001820154439162003abf9162005ec8565b111562003ac95750565b600281015481546001600160a01b03908116600090815260286020526040808220600490810185905585548416835291819020600c015490516319fac8fd60e01b81529216926319fac8fd9262003b24920190815260200190565b600060405180830381600087803b15801562003b3f57600080fd5b505af115801562003b54573d6000803e3d6000fd5b5050600b80546000908152600a6020526040812080546001600160a01b03191681556001808201839055600290910182905582549094509192509062003b9c90849062005ec8565b9091555062003a8c915050565b565b60e281511462003bf55760405162461bcd60e51b8152602060048201526014602482015273092dcecc2d8d2c840e0e4dedecc40d8cadccee8d60631b604482015260640162000e49565b6030826102400151511462003c4d5760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e67746800000000604482015260640162000e49565b62003c588262004d30565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a00000000000060208083019190915284519251919260009262003cbb920160609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905060008262003cdb835162004e5d565b8360405160200162003cf093929190620064ba565b60408051601f198184030181528282528051602091820120600280855260608501845290945060009392909183019080368337019050509050600080808062003d48898262003d4260416002620062c1565b62004f7e565b9050600062003d678a62003d5f60416002620062c1565b606062004f7e565b905060205b825181101562003e385762003d82838262005097565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa15801562003dde573d6000803e3d6000fd5b5050604051601f19015190508762003df860418462006489565b8151811062003e0b5762003e0b6200628f565b6001600160a01b039092166020928302919091019091015262003e3060418262005ec8565b905062003d6c565b508a602001516001600160a01b03168660008151811062003e5d5762003e5d6200628f565b60200260200101516001600160a01b03161462003ecf5760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f66604482015268081c1c9bdd9a59195960ba1b606482015260840162000e49565b8a604001516001600160a01b03168660018151811062003ef35762003ef36200628f565b60200260200101516001600160a01b03161462003f675760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201526a1bd9881c1c9bdd9a59195960aa1b606482015260840162000e49565b600162003f7f8c6102400151838e60000151620050ce565b1462003fed5760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e65727368697020604482015275383937b7b3103337b9103932b3b4b9ba3930ba34b7b760511b606482015260840162000e49565b62003ff88b6200513d565b5050505050505050505050565b60008211620040635760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000e49565b6001600160a01b038116600090815260276020526040902054821115620040cd5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000e49565b6001600160a01b03811660009081526027602052604081208054849290620040f790849062005eb2565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b03199182161782559551600182018054919098169616959095179095559051600284015551910155805491926200418383620062a5565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d91015b60405180910390a35050505050565b6001600160a01b03808416600090815260286020526040902080549091838116911614806200436757600c820154604051631092ab9160e31b81526001600160a01b03858116600483015260009216906384955c8890602401602060405180830381865afa15801562004268573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200428e9190620064a0565b905084811015620042f65760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201526a746f6e2062616c616e636560a81b606482015260840162000e49565b600c83015460405163282d3fdf60e01b81526001600160a01b038681166004830152602482018890529091169063282d3fdf90604401600060405180830381600087803b1580156200434757600080fd5b505af11580156200435c573d6000803e3d6000fd5b5050505050620043fa565b8382600b015483600801546200437e919062005eb2565b1015620043de5760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201526662616c616e636560c81b606482015260840162000e49565b8382600b016000828254620043f4919062005ec8565b90915550505b6040805160e0810182526001600160a01b0380861682528781166020808401918252838501898152600060608601818152436080880190815260a088018381528a151560c08a019081526008805486526006909752998420985189549089166001600160a01b0319918216178a55965160018a01805491909916971696909617909655915160028701559051600386015592516004850155905160059093018054945115156101000261ff00199415159490941661ffff199095169490941792909217909255805491620044ce83620062a5565b9190505550826001600160a01b0316856001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc8387604051620041e29291909115158252602082015260400190565b60fa60a06000808285855af462003998573d6000803e3d6000fd5b815460026001808316156101000203821604825180820160208110602084100160028114620045ee576001811462004614578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b80821015620045d75781518455600184019350602082019150620045b8565b815191036101000a90819004029091555062001309565b60028302826020036101000a846020036101000a60208901510402018501875562001309565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b8082101562004677578151845560018401935060208201915062004658565b815191036101000a90819004029091555050505050505050565b60006200469d62005500565b600060f890506020828551602087016000855af1620046bb57600080fd5b505192915050565b600081815260036020908152604080832060018101546001600160a01b03168452602890925282209091601382015460ff16600381111562004709576200470962005675565b14620047a957600282015482546001600160a01b0316600090815260276020526040812080549091906200473f90849062005ec8565b909155505081546001830154600284015460138401546040517f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f878342946200479c946001600160a01b0391821694911692909160ff9091169062006503565b60405180910390a1505050565b805482546001600160a01b03908116911614620048a65760008082600801548360050154620047d9919062005eb2565b905080600003620047f1578360020154915062004817565b80846002015484600d0154620048089190620062c1565b62004814919062006489565b91505b600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b1580156200486957600080fd5b505af11580156200487e573d6000803e3d6000fd5b505050508183600d01600082825462004898919062005ec8565b90915550620048c692505050565b8160020154816008016000828254620048c0919062005ec8565b90915550505b8160020154816005016000828254620048e0919062005ec8565b9091555050505050565b600081815260066020908152604080832060018101546001600160a01b0316845260289092528220600582015491929091610100900460ff1662004ade576002830154600c8301548454604051637eee288d60e01b81526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b1580156200497f57600080fd5b505af115801562004994573d6000803e3d6000fd5b50505050600c8301548454604051632770a7eb60e21b81526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b158015620049ea57600080fd5b505af1158015620049ff573d6000803e3d6000fd5b5050505060008360080154846005015462004a1b919062005eb2565b600d85015490915062004a2f8284620062c1565b62004a3b919062006489565b92508184600d01600082825462004a53919062005eb2565b9091555050600684015460000362004a72576003850183905562004a9a565b6006840154600785015462004a889085620062c1565b62004a94919062006489565b60038601555b8284600601600082825462004ab0919062005ec8565b9091555050600385015460078501805460009062004ad090849062005ec8565b9091555062004bb392505050565b506002820154600882015481111562004af8575060088101545b816009015460000362004b12576003830181905562004b3a565b6009820154600a83015462004b289083620062c1565b62004b34919062006489565b60038401555b8082600901600082825462004b50919062005ec8565b90915550506003830154600a8301805460009062004b7090849062005ec8565b925050819055508082600801600082825462004b8d919062005eb2565b90915550506002830154600b8301805460009062004bad90849062005eb2565b90915550505b6005808401805460ff191660011790558201805482919060009062004bda90849062005eb2565b909155505050505050565b6000818152600660205260408120600381015490910362004c04575050565b60018101546001600160a01b031660009081526028602052604081206005830154909190610100900460ff1662004ca05781600701548260060154846003015462004c509190620062c1565b62004c5c919062006489565b90508082600601600082825462004c74919062005eb2565b9091555050600383015460078301805460009062004c9490849062005eb2565b9091555062004d059050565b81600a01548260090154846003015462004cbb9190620062c1565b62004cc7919062006489565b90508082600901600082825462004cdf919062005eb2565b90915550506003830154600a8301805460009062004cff90849062005eb2565b90915550505b82546001600160a01b03166000908152602760205260408120805483929062004bda90849062005ec8565b600062004d4182606001516200536e565b6001600160a01b0390911660208401529050801562004d915760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b604482015260640162000e49565b6020808301516001600160a01b0390811660009081526028909252604090912060010154161562004e055760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000e49565b61271082608001511115620026615760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000e49565b60608160000362004e855750506040805180820190915260018152600360fc1b602082015290565b8160005b811562004eb5578062004e9c81620062a5565b915062004ead9050600a8362006489565b915062004e89565b60008167ffffffffffffffff81111562004ed35762004ed362005a3d565b6040519080825280601f01601f19166020018201604052801562004efe576020820181803683370190505b5090505b841562004f765762004f1660018362005eb2565b915062004f25600a86620062f1565b62004f3290603062005ec8565b60f81b81838151811062004f4a5762004f4a6200628f565b60200101906001600160f81b031916908160001a90535062004f6e600a8662006489565b945062004f02565b949350505050565b60608162004f8e81601f62005ec8565b101562004fcf5760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b604482015260640162000e49565b62004fdb828462005ec8565b84511015620050215760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b604482015260640162000e49565b6060821580156200504257604051915060008252602082016040526200508e565b6040519150601f8416801560200281840101858101878315602002848b0101015b818310156200507d57805183526020928301920162005063565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b811015620050c757620050c4601b8262006531565b90505b9250925092565b6000620050da62005500565b6000858585604051602001620050f3939291906200654d565b6040516020818303038152906040529050600060fb90506000825160206200511c919062005ec8565b90506020848285855afa6200513057600080fd5b5050905195945050505050565b6101808101516001600160a01b0316620051c057601d54600090620051629062004e5d565b90508160200151826000015183608001518360405162005182906200551e565b6200519194939291906200659c565b604051809103906000f080158015620051ae573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352602890955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620052729082620065db565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f8201556102008201516010820155610220820151601182015561024082015160128201906200533c9082620065db565b5061026082015160138201805460ff1916600183600381111562005364576200536462005675565b0217905550505050565b6000806200537b6200552c565b60008060ff9050604083875160208901845afa6200539857600080fd5b50508051602090910151600160601b90910494909350915050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020016000815260200160008152602001600081526020016000815260200160608152602001600060038111156200547e576200547e62005675565b905290565b6040518060a001604052806005906020820280368337509192915050565b508054600082559060005260206000209081019062000e9991906200554a565b508054620054cf9062005e60565b6000825580601f10620054e0575050565b601f01602090049060005260206000209081019062000e9991906200556f565b60405180602001604052806001906020820280368337509192915050565b611531806200669c83390190565b60405180604001604052806002906020820280368337509192915050565b808211156200556b576000620055618282620054c1565b506001016200554a565b5090565b5b808211156200556b576000815560010162005570565b60005b83811015620055a357818101518382015260200162005589565b50506000910152565b60008151808452620055c681602086016020860162005586565b601f01601f19169290920160200192915050565b602081526000620055ef6020830184620055ac565b9392505050565b6001600160a01b038116811462000e9957600080fd5b600080604083850312156200562057600080fd5b82356200562d81620055f6565b946020939093013593505050565b6000602082840312156200564e57600080fd5b8135620055ef81620055f6565b6000602082840312156200566e57600080fd5b5035919050565b634e487b7160e01b600052602160045260246000fd5b60048110620056aa57634e487b7160e01b600052602160045260246000fd5b9052565b60208152620056c96020820183516001600160a01b03169052565b60006020830151620056e660408401826001600160a01b03169052565b5060408301516001600160a01b03811660608401525060608301516102808060808501526200571a6102a0850183620055ac565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a0620057a2818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f190161026080880191909152909150620058068483620055ac565b9350808701519150506200581d828601826200568b565b5090949350505050565b6000806000606084860312156200583d57600080fd5b83356200584a81620055f6565b925060208401356200585c81620055f6565b929592945050506040919091013590565b6000602082840312156200588057600080fd5b813567ffffffffffffffff8111156200589857600080fd5b82016102808185031215620055ef57600080fd5b600081518084526020808501808196508360051b8101915082860160005b8581101562005921578284038952815180516001600160a01b0316855285810151868601526040908101516060918601829052906200590c81870183620055ac565b9a87019a9550505090840190600101620058ca565b5091979650505050505050565b821515815260406020820152600062004f766040830184620058ac565b600080604083850312156200595f57600080fd5b50508035926020909101359150565b845181526020808601518183015260408087015181840152606080880151818501526080808901516001600160a01b03908116828701528851811660a08088019190915294890151811660c087015292880151831660e086015290870151821661010085015286015116610120830152840151610200820190620059fe6101408401826001600160a01b03169052565b5083516001600160a01b0316610160830152602084015161018083015260408401516101a08301526060909301516101c08201526101e0015292915050565b634e487b7160e01b600052604160045260246000fd5b600082601f83011262005a6557600080fd5b813567ffffffffffffffff8082111562005a835762005a8362005a3d565b604051601f8301601f19908116603f0116810190828211818310171562005aae5762005aae62005a3d565b8160405283815286602085880101111562005ac857600080fd5b836020870160208301376000602085830101528094505050505092915050565b6000806000806080858703121562005aff57600080fd5b843567ffffffffffffffff8082111562005b1857600080fd5b62005b268883890162005a53565b95506020870135915062005b3a82620055f6565b9093506040860135908082111562005b5157600080fd5b62005b5f8883890162005a53565b9350606087013591508082111562005b7657600080fd5b5062005b858782880162005a53565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101562005bea57603f1988860301845262005bd7858351620055ac565b9450928501929085019060010162005bb8565b5092979650505050505050565b602081526000620055ef6020830184620058ac565b6020808252825182820181905260009190848201906040850190845b8181101562005c4f5783516001600160a01b03168352928401929184019160010162005c28565b50909695505050505050565b6000806040838503121562005c6f57600080fd5b823567ffffffffffffffff8082111562005c8857600080fd5b62005c968683870162005a53565b9350602085013591508082111562005cad57600080fd5b5062005cbc8582860162005a53565b9150509250929050565b60408152600062005cdb6040830185620055ac565b828103602084015262005cef8185620055ac565b95945050505050565b60006020828403121562005d0b57600080fd5b813567ffffffffffffffff81111562005d2357600080fd5b62004f768482850162005a53565b6000806040838503121562005d4557600080fd5b823562005d5281620055f6565b9150602083013562005d6481620055f6565b809150509250929050565b6020808252601c908201527f76616c696461746f72206d757374206265207265676973746572656400000000604082015260600190565b6020808252602c908201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160408201526b191b5a5b881858d8dbdd5b9d60a21b606082015260800190565b6020808252601a908201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604082015260600190565b60208082526018908201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604082015260600190565b600181811c9082168062005e7557607f821691505b60208210810362005e9657634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b8181038181111562000e035762000e0362005e9c565b8082018082111562000e035762000e0362005e9c565b6000813562000e0381620055f6565b80546001600160a01b0319166001600160a01b0392909216919091179055565b6000808335601e1984360301811262005f2557600080fd5b83018035915067ffffffffffffffff82111562005f4157600080fd5b60200191503681900382131562005f5757600080fd5b9250929050565b601f8211156200399857600081815260208120601f850160051c8101602086101562005f875750805b601f850160051c820191505b8181101562005fa85782815560010162005f93565b505050505050565b600019600383901b1c191660019190911b1790565b67ffffffffffffffff83111562005fe05762005fe062005a3d565b62005ff88362005ff1835462005e60565b8362005f5e565b6000601f8411600181146200602b5760008515620060165750838201355b62006022868262005fb0565b84555062001764565b600083815260209020601f19861690835b828110156200605e57868501358255602094850194600190920191016200603c565b50868210156200607c5760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b600081356004811062000e0357600080fd5b60048210620060bf57634e487b7160e01b600052602160045260246000fd5b60ff1981541660ff831681178255505050565b620060e8620060e18362005ede565b8262005eed565b62006104620060fa6020840162005ede565b6001830162005eed565b62006120620061166040840162005ede565b6002830162005eed565b6200612f606083018362005f0d565b6200613f81836003860162005fc5565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b820155620061b2620061a8610180840162005ede565b600c830162005eed565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f82015561020082013560108201556102208201356011820155620061f961024083018362005f0d565b6200620981836012860162005fc5565b5050620026616200621e61026084016200608e565b60138301620060a0565b60208082526023908201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60408201526218dbdb60ea1b606082015260800190565b6000602082840312156200627e57600080fd5b81518015158114620055ef57600080fd5b634e487b7160e01b600052603260045260246000fd5b600060018201620062ba57620062ba62005e9c565b5060010190565b808202811582820484141762000e035762000e0362005e9c565b634e487b7160e01b600052601260045260246000fd5b600082620063035762006303620062db565b500690565b600060018060a01b0380881683528087166020840152808616604084015260a060608401526200633c60a0840186620055ac565b91508084166080840152509695505050505050565b8181036200635d575050565b62006369825462005e60565b67ffffffffffffffff81111562006384576200638462005a3d565b6200639c8162006395845462005e60565b8462005f5e565b6000601f821160018114620063cf5760008315620063ba5750848201545b620063c6848262005fb0565b85555062001764565b600085815260209020601f19841690600086815260209020845b838110156200640b5782860154825560019586019590910190602001620063e9565b50858310156200642a5781850154600019600388901b60f8161c191681555b5050505050600190811b01905550565b6020808252602f908201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460408201526e1c99585cdd5c9e481858d8dbdd5b9d608a1b606082015260800190565b6000826200649b576200649b620062db565b500490565b600060208284031215620064b357600080fd5b5051919050565b60008451620064ce81846020890162005586565b845190830190620064e481836020890162005586565b8451910190620064f981836020880162005586565b0195945050505050565b6001600160a01b03858116825284166020820152604081018390526080810162005cef60608301846200568b565b60ff818116838216019081111562000e035762000e0362005e9c565b600084516200656181846020890162005586565b8451908301906200657781836020890162005586565b60609490941b6bffffffffffffffffffffffff19169301928352505060140192915050565b6001600160a01b0385811682528416602082015260408101839052608060608201819052600090620065d190830184620055ac565b9695505050505050565b815167ffffffffffffffff811115620065f857620065f862005a3d565b620066098162006395845462005e60565b602080601f8311600181146200663d5760008415620066285750858301515b62006634858262005fb0565b86555062005fa8565b600085815260208120601f198616915b828110156200666e578886015182559484019460019091019084016200664d565b50858210156200642a57939096015160001960f8600387901b161c19169092555050600190811b0190555056fe60806040523480156200001157600080fd5b506040516200153138038062001531833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61115980620003d86000396000f3fe60806040526004361061014b5760003560e01c806359355736116100b6578063949813b81161006f578063949813b8146103c557806395d89b41146103e55780639dc29fac146103fa578063a9059cbb1461041a578063dd62ed3e1461043a578063fb489a7b1461048057600080fd5b806359355736146102e35780635ea1d6f81461031957806361d027b31461032f57806370a082311461034f5780637eee288d1461038557806384955c88146103a557600080fd5b8063282d3fdf11610108578063282d3fdf146102245780632f2c3f2e14610244578063313ce5671461025a578063372500ab146102765780633a5381b51461028b57806340c10f19146102c357600080fd5b806306fdde0314610150578063095ea7b31461017b57806318160ddd146101ab578063187cf4d7146101ca57806319fac8fd146101e257806323b872dd14610204575b600080fd5b34801561015c57600080fd5b50610165610488565b6040516101729190610ece565b60405180910390f35b34801561018757600080fd5b5061019b610196366004610f38565b61051a565b6040519015158152602001610172565b3480156101b757600080fd5b506004545b604051908152602001610172565b3480156101d657600080fd5b506101bc633b9aca0081565b3480156101ee57600080fd5b506102026101fd366004610f62565b610531565b005b34801561021057600080fd5b5061019b61021f366004610f7b565b610569565b34801561023057600080fd5b5061020261023f366004610f38565b61065c565b34801561025057600080fd5b506101bc61271081565b34801561026657600080fd5b5060405160128152602001610172565b34801561028257600080fd5b50610202610741565b34801561029757600080fd5b50600a546102ab906001600160a01b031681565b6040516001600160a01b039091168152602001610172565b3480156102cf57600080fd5b506102026102de366004610f38565b6107ef565b3480156102ef57600080fd5b506101bc6102fe366004610fb7565b6001600160a01b031660009081526002602052604090205490565b34801561032557600080fd5b506101bc600c5481565b34801561033b57600080fd5b50600b546102ab906001600160a01b031681565b34801561035b57600080fd5b506101bc61036a366004610fb7565b6001600160a01b031660009081526001602052604090205490565b34801561039157600080fd5b506102026103a0366004610f38565b610857565b3480156103b157600080fd5b506101bc6103c0366004610fb7565b61091d565b3480156103d157600080fd5b506101bc6103e0366004610fb7565b61094b565b3480156103f157600080fd5b50610165610979565b34801561040657600080fd5b50610202610415366004610f38565b610988565b34801561042657600080fd5b5061019b610435366004610f38565b6109e8565b34801561044657600080fd5b506101bc610455366004610fd9565b6001600160a01b03918216600090815260036020908152604080832093909416825291909152205490565b6101bc610a35565b6060600880546104979061100c565b80601f01602080910402602001604051908101604052809291908181526020018280546104c39061100c565b80156105105780601f106104e557610100808354040283529160200191610510565b820191906000526020600020905b8154815290600101906020018083116104f357829003601f168201915b5050505050905090565b6000610527338484610b9d565b5060015b92915050565b6000546001600160a01b031633146105645760405162461bcd60e51b815260040161055b90611046565b60405180910390fd5b600c55565b6001600160a01b0383166000908152600360209081526040808320338452909152812054828110156105ee5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b606482015260840161055b565b61060285336105fd86856110a4565b610b9d565b61060c8584610cc1565b6106168484610da9565b836001600160a01b0316856001600160a01b03166000805160206111048339815191528560405161064991815260200190565b60405180910390a3506001949350505050565b6000546001600160a01b031633146106865760405162461bcd60e51b815260040161055b90611046565b6001600160a01b03821660009081526002602090815260408083205460019092529091205482916106b6916110a4565b10156107105760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110b7565b90915550505050565b600061074c33610df4565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461079e576040519150601f19603f3d011682016040523d82523d6000602084013e6107a3565b606091505b50509050806107eb5760405162461bcd60e51b81526020600482015260146024820152732330b4b632b2103a379039b2b7321022ba3432b960611b604482015260640161055b565b5050565b6000546001600160a01b031633146108195760405162461bcd60e51b815260040161055b90611046565b6108238282610da9565b6040518181526001600160a01b03831690600090600080516020611104833981519152906020015b60405180910390a35050565b6000546001600160a01b031633146108815760405162461bcd60e51b815260040161055b90611046565b6001600160a01b0382166000908152600260205260409020548111156108f55760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110a4565b6001600160a01b038116600090815260026020908152604080832054600190925282205461052b91906110a4565b600061095682610e59565b6001600160a01b03831660009081526005602052604090205461052b91906110b7565b6060600980546104979061100c565b6000546001600160a01b031633146109b25760405162461bcd60e51b815260040161055b90611046565b6109bc8282610cc1565b6040518181526000906001600160a01b038416906000805160206111048339815191529060200161084b565b60006109f43383610cc1565b6109fe8383610da9565b6040518281526001600160a01b0384169033906000805160206111048339815191529060200160405180910390a350600192915050565b600080546001600160a01b03163314610a605760405162461bcd60e51b815260040161055b90611046565b600c54349060009061271090610a7690846110ca565b610a8091906110e1565b905081811115610ad25760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f72207265776172640000000000000000604482015260640161055b565b610adc81836110a4565b600b546040519193506001600160a01b0316906108fc9083906000818181858888f193505050503d8060008114610b2f576040519150601f19603f3d011682016040523d82523d6000602084013e610b34565b606091505b505060045460009150610b4b633b9aca00856110ca565b610b5591906110e1565b905080600754610b6591906110b7565b600755600454600090633b9aca0090610b7e90846110ca565b610b8891906110e1565b9050610b9481846110b7565b94505050505090565b6001600160a01b038316610bff5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840161055b565b6001600160a01b038216610c605760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840161055b565b6001600160a01b0383811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b610cca82610df4565b506001600160a01b038216600090815260016020908152604080832054600290925290912054610cfa90826110a4565b821115610d495760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e64730000000000604482015260640161055b565b610d5382826110a4565b6001600160a01b038416600090815260016020526040902055808203610d8d576001600160a01b0383166000908152600660205260408120555b8160046000828254610d9f91906110a4565b9091555050505050565b610db282610df4565b506001600160a01b03821660009081526001602052604081208054839290610ddb9084906110b7565b92505081905550806004600082825461073891906110b7565b600080610e0083610e59565b6001600160a01b038416600090815260056020526040902054909150610e279082906110b7565b6001600160a01b0390931660009081526005602090815260408083208690556007546006909252909120555090919050565b6001600160a01b038116600090815260016020526040812054808203610e825750600092915050565b6001600160a01b038316600090815260066020526040812054600754610ea891906110a4565b90506000633b9aca00610ebb84846110ca565b610ec591906110e1565b95945050505050565b600060208083528351808285015260005b81811015610efb57858101830151858201604001528201610edf565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b0381168114610f3357600080fd5b919050565b60008060408385031215610f4b57600080fd5b610f5483610f1c565b946020939093013593505050565b600060208284031215610f7457600080fd5b5035919050565b600080600060608486031215610f9057600080fd5b610f9984610f1c565b9250610fa760208501610f1c565b9150604084013590509250925092565b600060208284031215610fc957600080fd5b610fd282610f1c565b9392505050565b60008060408385031215610fec57600080fd5b610ff583610f1c565b915061100360208401610f1c565b90509250929050565b600181811c9082168061102057607f821691505b60208210810361104057634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561052b5761052b61108e565b8082018082111561052b5761052b61108e565b808202811582820484141761052b5761052b61108e565b6000826110fe57634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa264697066735822122017fd2bd0de854f2773d08a39cedadcc044801e593b5b7f2ef205669d0552eb2964736f6c63430008150033a26469706673582212207b9b94ec7295e5c9e448903ea899a9f4dde4fc76d0e766ac8bbcebe9e134333e64736f6c6343000815003360806040523480156200001157600080fd5b506040516200153138038062001531833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61115980620003d86000396000f3fe60806040526004361061014b5760003560e01c806359355736116100b6578063949813b81161006f578063949813b8146103c557806395d89b41146103e55780639dc29fac146103fa578063a9059cbb1461041a578063dd62ed3e1461043a578063fb489a7b1461048057600080fd5b806359355736146102e35780635ea1d6f81461031957806361d027b31461032f57806370a082311461034f5780637eee288d1461038557806384955c88146103a557600080fd5b8063282d3fdf11610108578063282d3fdf146102245780632f2c3f2e14610244578063313ce5671461025a578063372500ab146102765780633a5381b51461028b57806340c10f19146102c357600080fd5b806306fdde0314610150578063095ea7b31461017b57806318160ddd146101ab578063187cf4d7146101ca57806319fac8fd146101e257806323b872dd14610204575b600080fd5b34801561015c57600080fd5b50610165610488565b6040516101729190610ece565b60405180910390f35b34801561018757600080fd5b5061019b610196366004610f38565b61051a565b6040519015158152602001610172565b3480156101b757600080fd5b506004545b604051908152602001610172565b3480156101d657600080fd5b506101bc633b9aca0081565b3480156101ee57600080fd5b506102026101fd366004610f62565b610531565b005b34801561021057600080fd5b5061019b61021f366004610f7b565b610569565b34801561023057600080fd5b5061020261023f366004610f38565b61065c565b34801561025057600080fd5b506101bc61271081565b34801561026657600080fd5b5060405160128152602001610172565b34801561028257600080fd5b50610202610741565b34801561029757600080fd5b50600a546102ab906001600160a01b031681565b6040516001600160a01b039091168152602001610172565b3480156102cf57600080fd5b506102026102de366004610f38565b6107ef565b3480156102ef57600080fd5b506101bc6102fe366004610fb7565b6001600160a01b031660009081526002602052604090205490565b34801561032557600080fd5b506101bc600c5481565b34801561033b57600080fd5b50600b546102ab906001600160a01b031681565b34801561035b57600080fd5b506101bc61036a366004610fb7565b6001600160a01b031660009081526001602052604090205490565b34801561039157600080fd5b506102026103a0366004610f38565b610857565b3480156103b157600080fd5b506101bc6103c0366004610fb7565b61091d565b3480156103d157600080fd5b506101bc6103e0366004610fb7565b61094b565b3480156103f157600080fd5b50610165610979565b34801561040657600080fd5b50610202610415366004610f38565b610988565b34801561042657600080fd5b5061019b610435366004610f38565b6109e8565b34801561044657600080fd5b506101bc610455366004610fd9565b6001600160a01b03918216600090815260036020908152604080832093909416825291909152205490565b6101bc610a35565b6060600880546104979061100c565b80601f01602080910402602001604051908101604052809291908181526020018280546104c39061100c565b80156105105780601f106104e557610100808354040283529160200191610510565b820191906000526020600020905b8154815290600101906020018083116104f357829003601f168201915b5050505050905090565b6000610527338484610b9d565b5060015b92915050565b6000546001600160a01b031633146105645760405162461bcd60e51b815260040161055b90611046565b60405180910390fd5b600c55565b6001600160a01b0383166000908152600360209081526040808320338452909152812054828110156105ee5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b606482015260840161055b565b61060285336105fd86856110a4565b610b9d565b61060c8584610cc1565b6106168484610da9565b836001600160a01b0316856001600160a01b03166000805160206111048339815191528560405161064991815260200190565b60405180910390a3506001949350505050565b6000546001600160a01b031633146106865760405162461bcd60e51b815260040161055b90611046565b6001600160a01b03821660009081526002602090815260408083205460019092529091205482916106b6916110a4565b10156107105760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110b7565b90915550505050565b600061074c33610df4565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461079e576040519150601f19603f3d011682016040523d82523d6000602084013e6107a3565b606091505b50509050806107eb5760405162461bcd60e51b81526020600482015260146024820152732330b4b632b2103a379039b2b7321022ba3432b960611b604482015260640161055b565b5050565b6000546001600160a01b031633146108195760405162461bcd60e51b815260040161055b90611046565b6108238282610da9565b6040518181526001600160a01b03831690600090600080516020611104833981519152906020015b60405180910390a35050565b6000546001600160a01b031633146108815760405162461bcd60e51b815260040161055b90611046565b6001600160a01b0382166000908152600260205260409020548111156108f55760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110a4565b6001600160a01b038116600090815260026020908152604080832054600190925282205461052b91906110a4565b600061095682610e59565b6001600160a01b03831660009081526005602052604090205461052b91906110b7565b6060600980546104979061100c565b6000546001600160a01b031633146109b25760405162461bcd60e51b815260040161055b90611046565b6109bc8282610cc1565b6040518181526000906001600160a01b038416906000805160206111048339815191529060200161084b565b60006109f43383610cc1565b6109fe8383610da9565b6040518281526001600160a01b0384169033906000805160206111048339815191529060200160405180910390a350600192915050565b600080546001600160a01b03163314610a605760405162461bcd60e51b815260040161055b90611046565b600c54349060009061271090610a7690846110ca565b610a8091906110e1565b905081811115610ad25760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f72207265776172640000000000000000604482015260640161055b565b610adc81836110a4565b600b546040519193506001600160a01b0316906108fc9083906000818181858888f193505050503d8060008114610b2f576040519150601f19603f3d011682016040523d82523d6000602084013e610b34565b606091505b505060045460009150610b4b633b9aca00856110ca565b610b5591906110e1565b905080600754610b6591906110b7565b600755600454600090633b9aca0090610b7e90846110ca565b610b8891906110e1565b9050610b9481846110b7565b94505050505090565b6001600160a01b038316610bff5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840161055b565b6001600160a01b038216610c605760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840161055b565b6001600160a01b0383811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b610cca82610df4565b506001600160a01b038216600090815260016020908152604080832054600290925290912054610cfa90826110a4565b821115610d495760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e64730000000000604482015260640161055b565b610d5382826110a4565b6001600160a01b038416600090815260016020526040902055808203610d8d576001600160a01b0383166000908152600660205260408120555b8160046000828254610d9f91906110a4565b9091555050505050565b610db282610df4565b506001600160a01b03821660009081526001602052604081208054839290610ddb9084906110b7565b92505081905550806004600082825461073891906110b7565b600080610e0083610e59565b6001600160a01b038416600090815260056020526040902054909150610e279082906110b7565b6001600160a01b0390931660009081526005602090815260408083208690556007546006909252909120555090919050565b6001600160a01b038116600090815260016020526040812054808203610e825750600092915050565b6001600160a01b038316600090815260066020526040812054600754610ea891906110a4565b90506000633b9aca00610ebb84846110ca565b610ec591906110e1565b95945050505050565b600060208083528351808285015260005b81811015610efb57858101830151858201604001528201610edf565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b0381168114610f3357600080fd5b919050565b60008060408385031215610f4b57600080fd5b610f5483610f1c565b946020939093013593505050565b600060208284031215610f7457600080fd5b5035919050565b600080600060608486031215610f9057600080fd5b610f9984610f1c565b9250610fa760208501610f1c565b9150604084013590509250925092565b600060208284031215610fc957600080fd5b610fd282610f1c565b9392505050565b60008060408385031215610fec57600080fd5b610ff583610f1c565b915061100360208401610f1c565b90509250929050565b600181811c9082168061102057607f821691505b60208210810361104057634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561052b5761052b61108e565b8082018082111561052b5761052b61108e565b808202811582820484141761052b5761052b61108e565b6000826110fe57634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa264697066735822122017fd2bd0de854f2773d08a39cedadcc044801e593b5b7f2ef205669d0552eb2964736f6c63430008150033",
 }
 
 // AutonityABI is the input ABI used to generate the binding from.
@@ -3565,6 +3599,27 @@ func (_Autonity *AutonityTransactorSession) FinalizeInitialization() (*types.Tra
 	return _Autonity.Contract.FinalizeInitialization(&_Autonity.TransactOpts)
 }
 
+// LockLiquid is a paid mutator transaction binding the contract method 0x2a400bc3.
+//
+// Solidity: function lockLiquid(address _validator, address _delegator, uint256 _amount) returns()
+func (_Autonity *AutonityTransactor) LockLiquid(opts *bind.TransactOpts, _validator common.Address, _delegator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Autonity.contract.Transact(opts, "lockLiquid", _validator, _delegator, _amount)
+}
+
+// LockLiquid is a paid mutator transaction binding the contract method 0x2a400bc3.
+//
+// Solidity: function lockLiquid(address _validator, address _delegator, uint256 _amount) returns()
+func (_Autonity *AutonitySession) LockLiquid(_validator common.Address, _delegator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Autonity.Contract.LockLiquid(&_Autonity.TransactOpts, _validator, _delegator, _amount)
+}
+
+// LockLiquid is a paid mutator transaction binding the contract method 0x2a400bc3.
+//
+// Solidity: function lockLiquid(address _validator, address _delegator, uint256 _amount) returns()
+func (_Autonity *AutonityTransactorSession) LockLiquid(_validator common.Address, _delegator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Autonity.Contract.LockLiquid(&_Autonity.TransactOpts, _validator, _delegator, _amount)
+}
+
 // Mint is a paid mutator transaction binding the contract method 0x40c10f19.
 //
 // Solidity: function mint(address _addr, uint256 _amount) returns()
@@ -3985,6 +4040,48 @@ func (_Autonity *AutonityTransactorSession) Unbond(_validator common.Address, _a
 	return _Autonity.Contract.Unbond(&_Autonity.TransactOpts, _validator, _amount)
 }
 
+// UnlockLiquid is a paid mutator transaction binding the contract method 0x39b17fec.
+//
+// Solidity: function unlockLiquid(address _validator, address _delegator, uint256 _amount) returns()
+func (_Autonity *AutonityTransactor) UnlockLiquid(opts *bind.TransactOpts, _validator common.Address, _delegator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Autonity.contract.Transact(opts, "unlockLiquid", _validator, _delegator, _amount)
+}
+
+// UnlockLiquid is a paid mutator transaction binding the contract method 0x39b17fec.
+//
+// Solidity: function unlockLiquid(address _validator, address _delegator, uint256 _amount) returns()
+func (_Autonity *AutonitySession) UnlockLiquid(_validator common.Address, _delegator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Autonity.Contract.UnlockLiquid(&_Autonity.TransactOpts, _validator, _delegator, _amount)
+}
+
+// UnlockLiquid is a paid mutator transaction binding the contract method 0x39b17fec.
+//
+// Solidity: function unlockLiquid(address _validator, address _delegator, uint256 _amount) returns()
+func (_Autonity *AutonityTransactorSession) UnlockLiquid(_validator common.Address, _delegator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Autonity.Contract.UnlockLiquid(&_Autonity.TransactOpts, _validator, _delegator, _amount)
+}
+
+// UpdateConsensusEndpoints is a paid mutator transaction binding the contract method 0xda45591b.
+//
+// Solidity: function updateConsensusEndpoints(bytes _record) returns()
+func (_Autonity *AutonityTransactor) UpdateConsensusEndpoints(opts *bind.TransactOpts, _record []byte) (*types.Transaction, error) {
+	return _Autonity.contract.Transact(opts, "updateConsensusEndpoints", _record)
+}
+
+// UpdateConsensusEndpoints is a paid mutator transaction binding the contract method 0xda45591b.
+//
+// Solidity: function updateConsensusEndpoints(bytes _record) returns()
+func (_Autonity *AutonitySession) UpdateConsensusEndpoints(_record []byte) (*types.Transaction, error) {
+	return _Autonity.Contract.UpdateConsensusEndpoints(&_Autonity.TransactOpts, _record)
+}
+
+// UpdateConsensusEndpoints is a paid mutator transaction binding the contract method 0xda45591b.
+//
+// Solidity: function updateConsensusEndpoints(bytes _record) returns()
+func (_Autonity *AutonityTransactorSession) UpdateConsensusEndpoints(_record []byte) (*types.Transaction, error) {
+	return _Autonity.Contract.UpdateConsensusEndpoints(&_Autonity.TransactOpts, _record)
+}
+
 // UpdateValidatorAndTransferSlashedFunds is a paid mutator transaction binding the contract method 0x35be16e0.
 //
 // Solidity: function updateValidatorAndTransferSlashedFunds((address,address,address,string,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,address,uint256,uint256,uint256,uint256,uint256,bytes,uint8) _val) returns()
@@ -4804,6 +4901,150 @@ func (_Autonity *AutonityFilterer) ParseCommissionRateChange(log types.Log) (*Au
 	return event, nil
 }
 
+// AutonityConsensusEndpointsUpdatedIterator is returned from FilterConsensusEndpointsUpdated and is used to iterate over the raw logs and unpacked data for ConsensusEndpointsUpdated events raised by the Autonity contract.
+type AutonityConsensusEndpointsUpdatedIterator struct {
+	Event *AutonityConsensusEndpointsUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AutonityConsensusEndpointsUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AutonityConsensusEndpointsUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AutonityConsensusEndpointsUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AutonityConsensusEndpointsUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AutonityConsensusEndpointsUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AutonityConsensusEndpointsUpdated represents a ConsensusEndpointsUpdated event raised by the Autonity contract.
+type AutonityConsensusEndpointsUpdated struct {
+	Addr common.Address
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterConsensusEndpointsUpdated is a free log retrieval operation binding the contract event 0x68d46f7ed6d57eea4fc550f4783b3f528ef5f858f9e78b9a7728b1bef0a1b92f.
+//
+// Solidity: event ConsensusEndpointsUpdated(address indexed addr)
+func (_Autonity *AutonityFilterer) FilterConsensusEndpointsUpdated(opts *bind.FilterOpts, addr []common.Address) (*AutonityConsensusEndpointsUpdatedIterator, error) {
+
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+
+	logs, sub, err := _Autonity.contract.FilterLogs(opts, "ConsensusEndpointsUpdated", addrRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AutonityConsensusEndpointsUpdatedIterator{contract: _Autonity.contract, event: "ConsensusEndpointsUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchConsensusEndpointsUpdated is a free log subscription operation binding the contract event 0x68d46f7ed6d57eea4fc550f4783b3f528ef5f858f9e78b9a7728b1bef0a1b92f.
+//
+// Solidity: event ConsensusEndpointsUpdated(address indexed addr)
+func (_Autonity *AutonityFilterer) WatchConsensusEndpointsUpdated(opts *bind.WatchOpts, sink chan<- *AutonityConsensusEndpointsUpdated, addr []common.Address) (event.Subscription, error) {
+
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+
+	logs, sub, err := _Autonity.contract.WatchLogs(opts, "ConsensusEndpointsUpdated", addrRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AutonityConsensusEndpointsUpdated)
+				if err := _Autonity.contract.UnpackLog(event, "ConsensusEndpointsUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConsensusEndpointsUpdated is a log parse operation binding the contract event 0x68d46f7ed6d57eea4fc550f4783b3f528ef5f858f9e78b9a7728b1bef0a1b92f.
+//
+// Solidity: event ConsensusEndpointsUpdated(address indexed addr)
+func (_Autonity *AutonityFilterer) ParseConsensusEndpointsUpdated(log types.Log) (*AutonityConsensusEndpointsUpdated, error) {
+	event := new(AutonityConsensusEndpointsUpdated)
+	if err := _Autonity.contract.UnpackLog(event, "ConsensusEndpointsUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // AutonityEpochPeriodUpdatedIterator is returned from FilterEpochPeriodUpdated and is used to iterate over the raw logs and unpacked data for EpochPeriodUpdated events raised by the Autonity contract.
 type AutonityEpochPeriodUpdatedIterator struct {
 	Event *AutonityEpochPeriodUpdated // Event containing the contract specifics and raw log
@@ -6254,7 +6495,7 @@ func (_Autonity *AutonityFilterer) ParseTransfer(log types.Log) (*AutonityTransf
 
 // AutonityUpgradeTestMetaData contains all meta data concerning the AutonityUpgradeTest contract.
 var AutonityUpgradeTestMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"ActivatedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"delegatee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"name\":\"BondingRejected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"BurnedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"}],\"name\":\"CommissionRateChange\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"period\",\"type\":\"uint256\"}],\"name\":\"EpochPeriodUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"gasPrice\",\"type\":\"uint256\"}],\"name\":\"MinimumBaseFeeUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"MintedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewBondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epoch\",\"type\":\"uint256\"}],\"name\":\"NewEpoch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewUnbondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"PausedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"liquidContract\",\"type\":\"address\"}],\"name\":\"RegisteredValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Rewarded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[],\"name\":\"COMMISSION_RATE_PRECISION\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"activateValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"bond\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_rate\",\"type\":\"uint256\"}],\"name\":\"changeCommissionRate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"completeContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"computeCommittee\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"config\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"treasuryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minBaseFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegationRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"treasuryAccount\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Policy\",\"name\":\"policy\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"contractIAccountability\",\"name\":\"accountabilityContract\",\"type\":\"address\"},{\"internalType\":\"contractIOracle\",\"name\":\"oracleContract\",\"type\":\"address\"},{\"internalType\":\"contractIACU\",\"name\":\"acuContract\",\"type\":\"address\"},{\"internalType\":\"contractISupplyControl\",\"name\":\"supplyControlContract\",\"type\":\"address\"},{\"internalType\":\"contractIStabilization\",\"name\":\"stabilizationContract\",\"type\":\"address\"},{\"internalType\":\"contractUpgradeManager\",\"name\":\"upgradeManagerContract\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Contracts\",\"name\":\"contracts\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"epochPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"committeeSize\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Protocol\",\"name\":\"protocol\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"contractVersion\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deployer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochID\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochReward\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochTotalBondedStake\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalize\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalizeInitialization\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlockPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommittee\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommitteeEnodes\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_block\",\"type\":\"uint256\"}],\"name\":\"getEpochFromBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEpochPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getLastEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxCommitteeSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinimumBaseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNewContract\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOperator\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOracle\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"height\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"round\",\"type\":\"uint256\"}],\"name\":\"getProposer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryAccount\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getUnbondingPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"getValidator\",\"outputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"contractLiquid\",\"name\":\"liquidContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"pauseValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enode\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_oracleAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"_signatures\",\"type\":\"bytes\"}],\"name\":\"registerValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"resetContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIAccountability\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAccountabilityContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIACU\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAcuContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_size\",\"type\":\"uint256\"}],\"name\":\"setCommitteeSize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setEpochPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_price\",\"type\":\"uint256\"}],\"name\":\"setMinimumBaseFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setOperatorAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setOracleContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIStabilization\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setStabilizationContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractISupplyControl\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setSupplyControlContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setTreasuryAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_treasuryFee\",\"type\":\"uint256\"}],\"name\":\"setTreasuryFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setUnbondingPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractUpgradeManager\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setUpgradeManagerContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalRedistributed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"unbond\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"contractLiquid\",\"name\":\"liquidContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"_val\",\"type\":\"tuple\"}],\"name\":\"updateValidatorAndTransferSlashedFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_bytecode\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_abi\",\"type\":\"string\"}],\"name\":\"upgradeContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"ActivatedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"delegatee\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"name\":\"BondingRejected\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"BurnedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"rate\",\"type\":\"uint256\"}],\"name\":\"CommissionRateChange\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"}],\"name\":\"ConsensusEndpointsUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"period\",\"type\":\"uint256\"}],\"name\":\"EpochPeriodUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"gasPrice\",\"type\":\"uint256\"}],\"name\":\"MinimumBaseFeeUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"MintedStake\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewBondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"epoch\",\"type\":\"uint256\"}],\"name\":\"NewEpoch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"validator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"delegator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"selfBonded\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"NewUnbondingRequest\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"effectiveBlock\",\"type\":\"uint256\"}],\"name\":\"PausedValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"treasury\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"liquidContract\",\"type\":\"address\"}],\"name\":\"RegisteredValidator\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"Rewarded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"stateMutability\":\"payable\",\"type\":\"fallback\"},{\"inputs\":[],\"name\":\"COMMISSION_RATE_PRECISION\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"activateValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"bond\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_rate\",\"type\":\"uint256\"}],\"name\":\"changeCommissionRate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"completeContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"computeCommittee\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"config\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"treasuryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minBaseFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"delegationRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"addresspayable\",\"name\":\"treasuryAccount\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Policy\",\"name\":\"policy\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"contractIAccountability\",\"name\":\"accountabilityContract\",\"type\":\"address\"},{\"internalType\":\"contractIOracle\",\"name\":\"oracleContract\",\"type\":\"address\"},{\"internalType\":\"contractIACU\",\"name\":\"acuContract\",\"type\":\"address\"},{\"internalType\":\"contractISupplyControl\",\"name\":\"supplyControlContract\",\"type\":\"address\"},{\"internalType\":\"contractIStabilization\",\"name\":\"stabilizationContract\",\"type\":\"address\"},{\"internalType\":\"contractUpgradeManager\",\"name\":\"upgradeManagerContract\",\"type\":\"address\"}],\"internalType\":\"structAutonity.Contracts\",\"name\":\"contracts\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"operatorAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"epochPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"blockPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"committeeSize\",\"type\":\"uint256\"}],\"internalType\":\"structAutonity.Protocol\",\"name\":\"protocol\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"contractVersion\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"deployer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochID\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochReward\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"epochTotalBondedStake\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalize\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"finalizeInitialization\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getBlockPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommittee\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"votingPower\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"internalType\":\"structAutonity.CommitteeMember[]\",\"name\":\"\",\"type\":\"tuple[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getCommitteeEnodes\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_block\",\"type\":\"uint256\"}],\"name\":\"getEpochFromBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getEpochPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getLastEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMaxCommitteeSize\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinimumBaseFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNewContract\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOperator\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOracle\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"height\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"round\",\"type\":\"uint256\"}],\"name\":\"getProposer\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryAccount\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getTreasuryFee\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getUnbondingPeriod\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"}],\"name\":\"getValidator\",\"outputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"contractLiquid\",\"name\":\"liquidContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getValidators\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"lastEpochBlock\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_delegator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"lockLiquid\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_addr\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"mint\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"pauseValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enode\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_oracleAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"_consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"bytes\",\"name\":\"_signatures\",\"type\":\"bytes\"}],\"name\":\"registerValidator\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"resetContractUpgrade\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIAccountability\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAccountabilityContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIACU\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setAcuContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_size\",\"type\":\"uint256\"}],\"name\":\"setCommitteeSize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setEpochPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_price\",\"type\":\"uint256\"}],\"name\":\"setMinimumBaseFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setOperatorAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setOracleContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractIStabilization\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setStabilizationContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractISupplyControl\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setSupplyControlContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"setTreasuryAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_treasuryFee\",\"type\":\"uint256\"}],\"name\":\"setTreasuryFee\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_period\",\"type\":\"uint256\"}],\"name\":\"setUnbondingPeriod\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"contractUpgradeManager\",\"name\":\"_address\",\"type\":\"address\"}],\"name\":\"setUpgradeManagerContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalRedistributed\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"unbond\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_validator\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_delegator\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"unlockLiquid\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_record\",\"type\":\"bytes\"}],\"name\":\"updateConsensusEndpoints\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"addresspayable\",\"name\":\"treasury\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"nodeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"oracleAddress\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"enode\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"commissionRate\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"bondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"unbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfBondedStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingShares\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"selfUnbondingStakeLocked\",\"type\":\"uint256\"},{\"internalType\":\"contractLiquid\",\"name\":\"liquidContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"liquidSupply\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"registrationBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalSlashed\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"jailReleaseBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"provableFaultCount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"consensusKey\",\"type\":\"bytes\"},{\"internalType\":\"enumValidatorState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structAutonity.Validator\",\"name\":\"_val\",\"type\":\"tuple\"}],\"name\":\"updateValidatorAndTransferSlashedFunds\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes\",\"name\":\"_bytecode\",\"type\":\"bytes\"},{\"internalType\":\"string\",\"name\":\"_abi\",\"type\":\"string\"}],\"name\":\"upgradeContract\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
 	Sigs: map[string]string{
 		"2f2c3f2e": "COMMISSION_RATE_PRECISION()",
 		"b46e5520": "activateValidator(address)",
@@ -6293,6 +6534,7 @@ var AutonityUpgradeTestMetaData = &bind.MetaData{
 		"b7ab4db5": "getValidators()",
 		"0d8e6e2c": "getVersion()",
 		"c2362dd5": "lastEpochBlock()",
+		"2a400bc3": "lockLiquid(address,address,uint256)",
 		"40c10f19": "mint(address,uint256)",
 		"06fdde03": "name()",
 		"0ae65e7a": "pauseValidator(address)",
@@ -6317,10 +6559,12 @@ var AutonityUpgradeTestMetaData = &bind.MetaData{
 		"a9059cbb": "transfer(address,uint256)",
 		"23b872dd": "transferFrom(address,address,uint256)",
 		"a5d059ca": "unbond(address,uint256)",
+		"39b17fec": "unlockLiquid(address,address,uint256)",
+		"da45591b": "updateConsensusEndpoints(bytes)",
 		"35be16e0": "updateValidatorAndTransferSlashedFunds((address,address,address,string,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,address,uint256,uint256,uint256,uint256,uint256,bytes,uint8))",
 		"b2ea9adb": "upgradeContract(bytes,string)",
 	},
-	Bin: "0x60806040526000600b556000600c553480156200001b57600080fd5b50604080516000808252602082019092529062000051565b6200003d62000d4e565b815260200190600190039081620000335790505b506040805161012081018252600d546080808301918252600e5460a080850191909152600f5460c08086019190915260105460e08601526011546001600160a01b039081166101008701529385528551908101865260125484168152601354841660208281019190915260145485168288015260155485166060808401919091526016548616838601526017548616938301939093528086019190915285519283018652601854909316825260195492820192909252601a5481850152601b548183015292820192909252601c54918101829052906000036200014c57602a80546001600160a01b031916331790556200014c828262000169565b5050601c546001036200016357620001636200051f565b6200115e565b80518051600d55602080820151600e55604080830151600f55606080840151601055608093840151601180546001600160a01b03199081166001600160a01b03938416179091558487015180516012805484169185169190911790558086015160138054841691851691909117905580850151601480548416918516919091179055808401516015805484169185169190911790559586015160168054831691841691909117905560a0909501516017805487169183169190911790558286015180516018805490971692169190911790945591830151601955820151601a5590810151601b55810151601c5560005b82518110156200051a5760008382815181106200027a576200027a62000ebd565b602002602001015160a00151905060008483815181106200029f576200029f62000ebd565b60200260200101516101a00181815250506000848381518110620002c757620002c762000ebd565b602002602001015161018001906001600160a01b031690816001600160a01b031681525050600084838151811062000303576200030362000ebd565b602002602001015160a001818152505060008483815181106200032a576200032a62000ebd565b60209081029190910101516101c00152600f54845185908490811062000354576200035462000ebd565b6020026020010151608001818152505060008483815181106200037b576200037b62000ebd565b6020026020010151610260019060038111156200039c576200039c62000ed3565b90816003811115620003b257620003b262000ed3565b815250506000848381518110620003cd57620003cd62000ebd565b60200260200101516101600181815250506200040b848381518110620003f757620003f762000ebd565b60200260200101516200068d60201b60201c565b6200043884838151811062000424576200042462000ebd565b6020026020010151620007c460201b60201c565b806027600086858151811062000452576200045262000ebd565b6020026020010151600001516001600160a01b03166001600160a01b0316815260200190815260200160002060008282546200048f919062000eff565b925050819055508060296000828254620004aa919062000eff565b9250508190555062000504848381518110620004ca57620004ca62000ebd565b60200260200101516020015182868581518110620004ec57620004ec62000ebd565b602002602001015160000151620009f560201b60201c565b5080620005118162000f1b565b91505062000259565b505050565b602a546001600160a01b031633146200058b5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201526218dbdb60ea1b60648201526084015b60405180910390fd5b600260286000601d600181548110620005a857620005a862000ebd565b60009182526020808320909101546001600160a01b0316835282019290925260400181206005018054909190620005e190849062000f4d565b92505081905550600260286000601d60018154811062000605576200060562000ebd565b60009182526020808320909101546001600160a01b03168352820192909252604001812060080180549091906200063e90849062000f4d565b90915550506002601c556018546001600160a01b031660009081526027602052604081206103e8905562000673908062000e1e565b620006816001600062000e1e565b6002805460ff19169055565b6000620006a4826060015162000be060201b60201c565b6001600160a01b03909116602084015290508015620006f45760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b604482015260640162000582565b6020808301516001600160a01b03908116600090815260289092526040909120600101541615620007685760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000582565b61271082608001511115620007c05760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000582565b5050565b6101808101516001600160a01b03166200084757601d54600090620007e99062000c2e565b905081602001518260000151836080015183604051620008099062000e60565b62000818949392919062000f64565b604051809103906000f08015801562000835573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352602890955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620008f9908262001065565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f820155610200820151601082015561022082015160118201556102408201516012820190620009c3908262001065565b5061026082015160138201805460ff19166001836003811115620009eb57620009eb62000ed3565b0217905550505050565b6000821162000a535760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000582565b6001600160a01b03811660009081526027602052604090205482111562000abd5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000582565b6001600160a01b0381166000908152602760205260408120805484929062000ae790849062001131565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b031991821617825595516001820180549190981696169590951790955590516002840155519101558054919262000b738362000f1b565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a35050505050565b60008062000bed62000e6e565b60008060ff9050604083875160208901845afa62000c0a57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000c565750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000c86578062000c6d8162000f1b565b915062000c7e9050600a8362000f4d565b915062000c5a565b6000816001600160401b0381111562000ca35762000ca362000ea7565b6040519080825280601f01601f19166020018201604052801562000cce576020820181803683370190505b5090505b841562000d465762000ce660018362001131565b915062000cf5600a8662001147565b62000d0290603062000eff565b60f81b81838151811062000d1a5762000d1a62000ebd565b60200101906001600160f81b031916908160001a90535062000d3e600a8662000f4d565b945062000cd2565b949350505050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562000e195762000e1962000ed3565b905290565b50805462000e2c9062000fd7565b6000825580601f1062000e3d575050565b601f01602090049060005260206000209081019062000e5d919062000e8c565b50565b6115318062008a2b83390190565b60405180604001604052806002906020820280368337509192915050565b5b8082111562000ea3576000815560010162000e8d565b5090565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b8082018082111562000f155762000f1562000ee9565b92915050565b60006001820162000f305762000f3062000ee9565b5060010190565b634e487b7160e01b600052601260045260246000fd5b60008262000f5f5762000f5f62000f37565b500490565b600060018060a01b038087168352602081871681850152856040850152608060608501528451915081608085015260005b8281101562000fb35785810182015185820160a00152810162000f95565b5050600060a0828501015260a0601f19601f83011684010191505095945050505050565b600181811c9082168062000fec57607f821691505b6020821081036200100d57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200051a57600081815260208120601f850160051c810160208610156200103c5750805b601f850160051c820191505b818110156200105d5782815560010162001048565b505050505050565b81516001600160401b0381111562001081576200108162000ea7565b620010998162001092845462000fd7565b8462001013565b602080601f831160018114620010d15760008415620010b85750858301515b600019600386901b1c1916600185901b1785556200105d565b600085815260208120601f198616915b828110156200110257888601518255948401946001909101908401620010e1565b5085821015620011215787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b8181038181111562000f155762000f1562000ee9565b60008262001159576200115962000f37565b500690565b6178bd806200116e6000396000f3fe608060405260043610620003db5760003560e01c8063872cf0591162000203578063b66b3e791162000117578063d372c07e11620000a7578063dd62ed3e1162000075578063dd62ed3e1462000cbc578063dfb1a4d21462000d06578063e7f43c681462000d1d578063f7866ee31462000d3d57005b8063d372c07e1462000c38578063d5f394881462000c5d578063d861b0e81462000c7f578063d886f8a21462000c9757005b8063cb696f5411620000e5578063cb696f541462000bb1578063ceaad4551462000bd6578063cf9c57191462000bfb578063cfd19fb91462000c1357005b8063b66b3e791462000b41578063b7ab4db51462000b69578063c2362dd51462000b81578063c9d97af41462000b9957005b8063a5d059ca1162000193578063ae1f5fa01162000161578063ae1f5fa01462000aab578063b2ea9adb1462000ad2578063b3ecbadd1462000af7578063b46e55201462000b1c57005b8063a5d059ca1462000a13578063a8b2216e1462000a38578063a9059cbb1462000a5f578063ab8f6ffe1462000a8457005b80639bb851c011620001d15780639bb851c014620009995780639c98e47114620009b15780639dc29fac14620009c9578063a515366a14620009ee57005b8063872cf05914620008fd5780638bac7dad146200091557806395d89b41146200093a57806396b477cb146200096857005b806340c10f1911620002fb57806370a08231116200028b578063819b64631162000259578063819b6463146200087c578063833b1fce146200089357806384467fdb14620008b3578063852c484914620008d857005b806370a082311462000728578063731b3a03146200076257806377e741c7146200077957806379502c55146200079e57005b8063520fdbbc11620002c9578063520fdbbc14620006895780635f7d394914620006ae5780636b5f444c14620006ec5780636fd2c80b146200071157005b806340c10f191462000600578063436459691462000625578063496ccd9b146200063c5780634bb278f3146200066157005b80631604e416116200037757806329070c6d116200034557806329070c6d146200058e5780632f2c3f2e14620005a5578063313ce56714620005bd57806335be16e014620005db57005b80631604e416146200050657806318160ddd146200051e5780631904bb2e146200053557806323b872dd146200056957005b80630d8e6e2c11620003b55780630d8e6e2c14620004845780631122063314620004a5578063114eaf5514620004bc5780631250a28d14620004e157005b806306fdde0314620003e5578063095ea7b314620004295780630ae65e7a146200045f57005b36620003e357005b005b348015620003f257600080fd5b506040805180820190915260068152652732bbba37b760d11b60208201525b6040516200042091906200531d565b60405180910390f35b3480156200043657600080fd5b506200044e620004483660046200534f565b62000d5d565b604051901515815260200162000420565b3480156200046c57600080fd5b50620003e36200047e3660046200537e565b62000d76565b3480156200049157600080fd5b50601c545b60405190815260200162000420565b348015620004b257600080fd5b50600e5462000496565b348015620004c957600080fd5b50620003e3620004db3660046200539e565b62000e09565b348015620004ee57600080fd5b50620003e3620005003660046200537e565b62000e3b565b3480156200051357600080fd5b506200049660245481565b3480156200052b57600080fd5b5060295462000496565b3480156200054257600080fd5b506200055a620005543660046200537e565b62000e8a565b604051620004209190620053f1565b3480156200057657600080fd5b506200044e620005883660046200556a565b62001138565b3480156200059b57600080fd5b50600d5462000496565b348015620005b257600080fd5b506200049661271081565b348015620005ca57600080fd5b506040516012815260200162000420565b348015620005e857600080fd5b50620003e3620005fa366004620055b0565b62001192565b3480156200060d57600080fd5b50620003e36200061f3660046200534f565b6200136e565b3480156200063257600080fd5b50601a5462000496565b3480156200064957600080fd5b50620003e36200065b3660046200537e565b62001428565b3480156200066e57600080fd5b506200067962001532565b6040516200042092919062005671565b3480156200069657600080fd5b50620003e3620006a83660046200537e565b620018f9565b348015620006bb57600080fd5b50620006d3620006cd3660046200568e565b62001af4565b6040516001600160a01b03909116815260200162000420565b348015620006f957600080fd5b50620003e36200070b3660046200539e565b62001d0b565b3480156200071e57600080fd5b5060105462000496565b3480156200073557600080fd5b5062000496620007473660046200537e565b6001600160a01b031660009081526027602052604090205490565b3480156200076f57600080fd5b5060205462000496565b3480156200078657600080fd5b50620003e3620007983660046200539e565b62001e91565b348015620007ab57600080fd5b506040805160a08082018352600d548252600e54602080840191909152600f54838501526010546060808501919091526011546001600160a01b03908116608080870191909152865160c0810188526012548316815260135483168186015260145483168189015260155483168185015260165483168183015260175483169581019590955286519081018752601854909116815260195492810192909252601a5494820194909452601b5493810193909352601c546200086a939084565b604051620004209493929190620056b1565b3480156200088957600080fd5b50601b5462000496565b348015620008a057600080fd5b506013546001600160a01b0316620006d3565b348015620008c057600080fd5b50620003e3620008d23660046200582b565b62001ec3565b348015620008e557600080fd5b50620003e3620008f73660046200534f565b62001ff2565b3480156200090a57600080fd5b50620003e362002183565b3480156200092257600080fd5b50620003e3620009343660046200539e565b620021bf565b3480156200094757600080fd5b50604080518082019091526003815262272a2760e91b602082015262000411565b3480156200097557600080fd5b5062000496620009873660046200539e565b6000908152601f602052604090205490565b348015620009a657600080fd5b506200049660235481565b348015620009be57600080fd5b506200049660215481565b348015620009d657600080fd5b50620003e3620009e83660046200534f565b62002243565b348015620009fb57600080fd5b50620003e362000a0d3660046200534f565b62002359565b34801562000a2057600080fd5b50620003e362000a323660046200534f565b6200242c565b34801562000a4557600080fd5b5062000a50620024c3565b604051620004209190620058d4565b34801562000a6c57600080fd5b506200044e62000a7e3660046200534f565b620025a6565b34801562000a9157600080fd5b5062000a9c620025b5565b6040516200042091906200593a565b34801562000ab857600080fd5b5062000ac3620026c7565b6040516200042091906200594f565b34801562000adf57600080fd5b50620003e362000af13660046200599e565b6200290d565b34801562000b0457600080fd5b50620003e362000b163660046200537e565b62002954565b34801562000b2957600080fd5b50620003e362000b3b3660046200537e565b620029a3565b34801562000b4e57600080fd5b5062000b5962002c26565b6040516200042092919062005a09565b34801562000b7657600080fd5b5062000ac362002d5d565b34801562000b8e57600080fd5b506200049660205481565b34801562000ba657600080fd5b5062000496601e5481565b34801562000bbe57600080fd5b50620003e362000bd03660046200539e565b62002dc1565b34801562000be357600080fd5b50620003e362000bf53660046200537e565b62002e24565b34801562000c0857600080fd5b50620003e362002e73565b34801562000c2057600080fd5b50620003e362000c323660046200537e565b62002ec7565b34801562000c4557600080fd5b50620003e362000c573660046200537e565b62002f16565b34801562000c6a57600080fd5b50602a54620006d3906001600160a01b031681565b34801562000c8c57600080fd5b50620003e362002f65565b34801562000ca457600080fd5b50620003e362000cb63660046200537e565b62002fa6565b34801562000cc957600080fd5b506200049662000cdb36600462005a3b565b6001600160a01b03918216600090815260266020908152604080832093909416825291909152205490565b34801562000d1357600080fd5b5060195462000496565b34801562000d2a57600080fd5b506018546001600160a01b0316620006d3565b34801562000d4a57600080fd5b506011546001600160a01b0316620006d3565b600062000d6c33848462002ff5565b5060015b92915050565b6001600160a01b038082166000818152602860205260409020600101549091161462000dbf5760405162461bcd60e51b815260040162000db69062005a79565b60405180910390fd5b6001600160a01b0381811660009081526028602052604090205416331462000dfb5760405162461bcd60e51b815260040162000db69062005ab0565b62000e06816200311e565b50565b6018546001600160a01b0316331462000e365760405162461bcd60e51b815260040162000db69062005afc565b601055565b6018546001600160a01b0316331462000e685760405162461bcd60e51b815260040162000db69062005afc565b601280546001600160a01b0319166001600160a01b0392909216919091179055565b62000e94620050f6565b6001600160a01b038083166000818152602860205260409020600101549091161462000ed45760405162461bcd60e51b815260040162000db69062005b33565b6001600160a01b03808316600090815260286020908152604091829020825161028081018452815485168152600182015485169281019290925260028101549093169181019190915260038201805491929160608401919062000f379062005b6a565b80601f016020809104026020016040519081016040528092919081815260200182805462000f659062005b6a565b801562000fb65780601f1062000f8a5761010080835404028352916020019162000fb6565b820191906000526020600020905b81548152906001019060200180831162000f9857829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e090920191620010729062005b6a565b80601f0160208091040260200160405190810160405280929190818152602001828054620010a09062005b6a565b8015620010f15780601f10620010c557610100808354040283529160200191620010f1565b820191906000526020600020905b815481529060010190602001808311620010d357829003601f168201915b5050509183525050601382015460209091019060ff1660038111156200111b576200111b620053b8565b60038111156200112f576200112f620053b8565b90525092915050565b600062001147848484620031f5565b6001600160a01b03841660009081526026602090815260408083203384529091528120546200117890849062005bbc565b90506200118785338362002ff5565b506001949350505050565b6012546001600160a01b03163314620011fa5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e74726044820152621858dd60ea1b606482015260840162000db6565b60006101208201356028826200121760408601602087016200537e565b6001600160a01b03166001600160a01b031681526020019081526020016000206009015462001247919062005bbc565b60c0830135602860006200126260408701602088016200537e565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001292919062005bbc565b60a084013560286000620012ad60408801602089016200537e565b6001600160a01b03166001600160a01b0316815260200190815260200160002060050154620012dd919062005bbc565b620012e9919062005bd2565b620012f5919062005bd2565b6011546001600160a01b03166000908152602760205260408120805492935083929091906200132690849062005bd2565b90915550829050602860006200134360408401602085016200537e565b6001600160a01b03168152602081019190915260400160002062001368828262005ddc565b50505050565b6018546001600160a01b031633146200139b5760405162461bcd60e51b815260040162000db69062005afc565b6001600160a01b03821660009081526027602052604081208054839290620013c590849062005bd2565b925050819055508060296000828254620013e0919062005bd2565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf906020015b60405180910390a25050565b6018546001600160a01b03163314620014555760405162461bcd60e51b815260040162000db69062005afc565b601380546001600160a01b0319166001600160a01b03838116918217909255601454604051637adbf97360e01b8152600481019290925290911690637adbf97390602401600060405180830381600087803b158015620014b457600080fd5b505af1158015620014c9573d6000803e3d6000fd5b5050601654604051637adbf97360e01b81526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b1580156200151657600080fd5b505af11580156200152b573d6000803e3d6000fd5b5050505050565b602a546000906060906001600160a01b03163314620015655760405162461bcd60e51b815260040162000db69062005f32565b601e54436000818152601f60209081526040822093909355601954925490926200158f9162005bd2565b6012546040516306c9789b60e41b8152929091146004830181905292506001600160a01b031690636c9789b090602401600060405180830381600087803b158015620015da57600080fd5b505af1158015620015ef573d6000803e3d6000fd5b505050508015620016e4576200160462003327565b6200160e62003711565b6200161862003800565b600062001624620026c7565b60135460405163422811f960e11b81529192506001600160a01b03169063845023f290620016579084906004016200594f565b600060405180830381600087803b1580156200167257600080fd5b505af115801562001687573d6000803e3d6000fd5b50505050436020819055506001601e6000828254620016a7919062005bd2565b9091555050601e546040519081527febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3359060200160405180910390a1505b60135460408051634bb278f360e01b815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af115801562001730573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062001756919062005f75565b90508015620017d2576014546040805163a2e6204560e01b815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af1925050508015620017ca575060408051601f3d908101601f19168201909252620017c79181019062005f75565b60015b15620017d257505b600254602280546040805160208084028201810190925282815260ff9094169391839160009084015b82821015620018e9576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620018519062005b6a565b80601f01602080910402602001604051908101604052809291908181526020018280546200187f9062005b6a565b8015620018d05780601f10620018a457610100808354040283529160200191620018d0565b820191906000526020600020905b815481529060010190602001808311620018b257829003601f168201915b50505050508152505081526020019060010190620017fb565b5050505090509350935050509091565b6018546001600160a01b03163314620019265760405162461bcd60e51b815260040162000db69062005afc565b601880546001600160a01b0319166001600160a01b0383811691821790925560135460405163b3ab15fb60e01b815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b1580156200198557600080fd5b505af11580156200199a573d6000803e3d6000fd5b505060145460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620019e657600080fd5b505af1158015620019fb573d6000803e3d6000fd5b505060155460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001a4757600080fd5b505af115801562001a5c573d6000803e3d6000fd5b505060165460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001aa857600080fd5b505af115801562001abd573d6000803e3d6000fd5b505060175460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401620014fb565b600080805b60225481101562001b50576022818154811062001b1a5762001b1a62005f99565b9060005260206000209060030201600101548262001b39919062005bd2565b91508062001b478162005faf565b91505062001af9565b508060000362001ba35760405162461bcd60e51b815260206004820152601c60248201527f54686520636f6d6d6974746565206973206e6f74207374616b696e6700000000604482015260640162000db6565b60008362001bb360638762005fcb565b62001bbf919062005bd2565b905060008160405160200162001bd791815260200190565b60408051601f1981840301815291905280516020909101209050600062001bff848362005ffb565b90506000805b60225481101562001caf576022818154811062001c265762001c2662005f99565b9060005260206000209060030201600101548262001c45919062005bd2565b915062001c5460018362005bbc565b831162001c9a576022818154811062001c715762001c7162005f99565b60009182526020909120600390910201546001600160a01b0316965062000d7095505050505050565b8062001ca68162005faf565b91505062001c05565b5060405162461bcd60e51b815260206004820152602960248201527f5468657265206973206e6f2076616c696461746f72206c65667420696e20746860448201526865206e6574776f726b60b81b606482015260840162000db6565b6018546001600160a01b0316331462001d385760405162461bcd60e51b815260040162000db69062005afc565b60195481101562001def578060205462001d53919062005bd2565b431062001def5760405162461bcd60e51b815260206004820152605760248201527f63757272656e7420636861696e2068656164206578636565642074686520776960448201527f6e646f773a206c617374426c6f636b45706f6368202b205f6e6577506572696f60648201527f642c2074727920616761696e206c6174746572206f6e2e000000000000000000608482015260a40162000db6565b6019819055601254604051631ad7d11360e21b8152600481018390526001600160a01b0390911690636b5f444c90602401600060405180830381600087803b15801562001e3b57600080fd5b505af115801562001e50573d6000803e3d6000fd5b505050507fd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f818160405162001e8691815260200190565b60405180910390a150565b6018546001600160a01b0316331462001ebe5760405162461bcd60e51b815260040162000db69062005afc565b600d55565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b03168152602001868152602001600d6000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020014381526020016000815260200160008152602001600081526020018481526020016000600381111562001f925762001f92620053b8565b9052905062001fa281836200391f565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c9262001fe392339289918b9162006012565b60405180910390a15050505050565b6001600160a01b0380831660008181526028602052604090206001015490911614620020325760405162461bcd60e51b815260040162000db69062005a79565b6001600160a01b038281166000908152602860205260409020541633146200206e5760405162461bcd60e51b815260040162000db69062005ab0565b612710811115620020c25760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e207261746500604482015260640162000db6565b604080516060810182526001600160a01b038481168252436020808401918252838501868152600c80546000908152600a909352958220855181546001600160a01b0319169516949094178455915160018085019190915591516002909301929092558354929390929091906200213b90849062005bd2565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6018546001600160a01b03163314620021b05760405162461bcd60e51b815260040162000db69062005afc565b6002805460ff19166001179055565b6018546001600160a01b03163314620021ec5760405162461bcd60e51b815260040162000db69062005afc565b600081116200223e5760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e2774206265203000000000000000604482015260640162000db6565b601b55565b6018546001600160a01b03163314620022705760405162461bcd60e51b815260040162000db69062005afc565b6001600160a01b038216600090815260276020526040902054811115620022d35760405162461bcd60e51b8152602060048201526016602482015275416d6f756e7420657863656564732062616c616e636560501b604482015260640162000db6565b6001600160a01b03821660009081526027602052604081208054839290620022fd90849062005bbc565b92505081905550806029600082825462002318919062005bbc565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a3906020016200141c565b6001600160a01b0380831660008181526028602052604090206001015490911614620023995760405162461bcd60e51b815260040162000db69062005b33565b6001600160a01b03821660009081526028602052604081206013015460ff166003811115620023cc57620023cc620053b8565b146200241b5760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f206265206163746976650000000000604482015260640162000db6565b6200242882823362003d79565b5050565b6001600160a01b03808316600081815260286020526040902060010154909116146200246c5760405162461bcd60e51b815260040162000db69062005b33565b60008111620024b65760405162461bcd60e51b81526020600482015260156024820152740756e626f6e64696e6720616d6f756e74206973203605c1b604482015260640162000db6565b6200242882823362003f65565b60606025805480602002602001604051908101604052809291908181526020016000905b828210156200259d578382906000526020600020018054620025099062005b6a565b80601f0160208091040260200160405190810160405280929190818152602001828054620025379062005b6a565b8015620025885780601f106200255c5761010080835404028352916020019162002588565b820191906000526020600020905b8154815290600101906020018083116200256a57829003601f168201915b505050505081526020019060010190620024e7565b50505050905090565b600062000d6c338484620031f5565b60606022805480602002602001604051908101604052809291908181526020016000905b828210156200259d576000848152602090819020604080516060810182526003860290920180546001600160a01b03168352600181015493830193909352600283018054929392918401916200262f9062005b6a565b80601f01602080910402602001604051908101604052809291908181526020018280546200265d9062005b6a565b8015620026ae5780601f106200268257610100808354040283529160200191620026ae565b820191906000526020600020905b8154815290600101906020018083116200269057829003601f168201915b50505050508152505081526020019060010190620025d9565b602a546060906001600160a01b03163314620026f75760405162461bcd60e51b815260040162000db69062005f32565b601d54620027485760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f72730000000000000000604482015260640162000db6565b62002752620051c6565b601b546080820152601d81526028602082015260226040820152602160608201526200277e8162004298565b6200278c60256000620051e4565b60225480620027d35760405162461bcd60e51b8152602060048201526012602482015271636f6d6d697474656520697320656d70747960701b604482015260640162000db6565b60008167ffffffffffffffff811115620027f157620027f162005780565b6040519080825280602002602001820160405280156200281b578160200160208202803683370190505b50905060005b8281101562002905576000602860006022848154811062002846576200284662005f99565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060258054600181018255925292507f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c0190620028ae908301826200605b565b50600281015483516001600160a01b0390911690849084908110620028d757620028d762005f99565b6001600160a01b03909216602092830291909101909101525080620028fc8162005faf565b91505062002821565b509250505090565b6018546001600160a01b031633146200293a5760405162461bcd60e51b815260040162000db69062005afc565b62002947600083620042b3565b62002428600182620042b3565b6018546001600160a01b03163314620029815760405162461bcd60e51b815260040162000db69062005afc565b601580546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b0380821660008181526028602052604090206001015490911614620029e35760405162461bcd60e51b815260040162000db69062005a79565b6001600160a01b0380821660009081526028602052604090208054909116331462002a695760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201526e1c99585cdd5c9e481858d8dbdd5b9d608a1b606482015260840162000db6565b6000601382015460ff16600381111562002a875762002a87620053b8565b0362002ad65760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c7265616479206163746976650000000000000000604482015260640162000db6565b6002601382015460ff16600381111562002af45762002af4620053b8565b14801562002b055750438160100154115b1562002b545760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c000000000000000000604482015260640162000db6565b6003601382015460ff16600381111562002b725762002b72620053b8565b0362002bc15760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c7900000000604482015260640162000db6565b60138101805460ff1916905580546019546020546001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b59162002c11919062005bd2565b60405190815260200160405180910390a35050565b6060806000600181805462002c3b9062005b6a565b80601f016020809104026020016040519081016040528092919081815260200182805462002c699062005b6a565b801562002cba5780601f1062002c8e5761010080835404028352916020019162002cba565b820191906000526020600020905b81548152906001019060200180831162002c9c57829003601f168201915b5050505050915080805462002ccf9062005b6a565b80601f016020809104026020016040519081016040528092919081815260200182805462002cfd9062005b6a565b801562002d4e5780601f1062002d225761010080835404028352916020019162002d4e565b820191906000526020600020905b81548152906001019060200180831162002d3057829003601f168201915b50505050509050915091509091565b6060601d80548060200260200160405190810160405280929190818152602001828054801562002db757602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162002d98575b5050505050905090565b6018546001600160a01b0316331462002dee5760405162461bcd60e51b815260040162000db69062005afc565b600e8190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200162001e86565b6018546001600160a01b0316331462002e515760405162461bcd60e51b815260040162000db69062005afc565b601780546001600160a01b0319166001600160a01b0392909216919091179055565b6018546001600160a01b0316331462002ea05760405162461bcd60e51b815260040162000db69062005afc565b62002ead60008062005204565b62002ebb6001600062005204565b6002805460ff19169055565b6018546001600160a01b0316331462002ef45760405162461bcd60e51b815260040162000db69062005afc565b601680546001600160a01b0319166001600160a01b0392909216919091179055565b6018546001600160a01b0316331462002f435760405162461bcd60e51b815260040162000db69062005afc565b601480546001600160a01b0319166001600160a01b0392909216919091179055565b602a546001600160a01b0316331462002f925760405162461bcd60e51b815260040162000db69062005f32565b62002f9c62003711565b62000e06620026c7565b6018546001600160a01b0316331462002fd35760405162461bcd60e51b815260040162000db69062005afc565b601180546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b038316620030595760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840162000db6565b6001600160a01b038216620030bc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840162000db6565b6001600160a01b0383811660008181526026602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6001600160a01b038116600090815260286020526040812090601382015460ff166003811115620031535762003153620053b8565b14620031a25760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d757374206265206163746976650000000000000000604482015260640162000db6565b60138101805460ff1916600117905580546019546020546001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c9162002c11919062005bd2565b6001600160a01b038316600090815260276020526040902054811115620032585760405162461bcd60e51b8152602060048201526016602482015275616d6f756e7420657863656564732062616c616e636560501b604482015260640162000db6565b6001600160a01b038316600090815260276020526040812080548392906200328290849062005bbc565b9250508190555080602960008282546200329d919062005bd2565b90915550620032b0905081600262005fcb565b6001600160a01b03831660009081526027602052604081208054909190620032da90849062005bd2565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef836040516200311191815260200190565b476000036200333257565b600d544790600090670de0b6b3a7640000906200335190849062005fcb565b6200335d919062006144565b90508015620033db576011546040516000916001600160a01b03169083908381818185875af1925050503d8060008114620033b5576040519150601f19603f3d011682016040523d82523d6000602084013e620033ba565b606091505b5090915050801515600103620033d957620033d6828462005bbc565b92505b505b8160236000828254620033ef919062005bd2565b90915550600090505b6022548110156200370c57600060286000602284815481106200341f576200341f62005f99565b600091825260208083206003909202909101546001600160a01b031683528201929092526040018120602154602280549294509091879190869081106200346a576200346a62005f99565b90600052602060002090600302016001015462003488919062005fcb565b62003494919062006144565b90508015620036f4576002601383015460ff166003811115620034bb57620034bb620053b8565b1480620034e357506003601383015460ff166003811115620034e157620034e1620053b8565b145b156200358b57601254602280546001600160a01b0390921691631de9d9b69184918790811062003517576200351762005f99565b600091825260209091206003909102015460405160e084901b6001600160e01b03191681526001600160a01b0390911660048201526024016000604051808303818588803b1580156200356957600080fd5b505af11580156200357e573d6000803e3d6000fd5b50505050505050620036f7565b60008260050154828460080154620035a4919062005fcb565b620035b0919062006144565b90506000620035c0828462005bbc565b90508115620036255783546040516001600160a01b03909116906108fc9084906000818181858888f193505050503d80600081146200361c576040519150601f19603f3d011682016040523d82523d6000602084013e62003621565b606091505b5050505b8015620036ad5783600c0160009054906101000a90046001600160a01b03166001600160a01b031663fb489a7b826040518263ffffffff1660e01b815260040160206040518083038185885af115801562003684573d6000803e3d6000fd5b50505050506040513d601f19601f82011682018060405250810190620036ab91906200615b565b505b60018401546040518481526001600160a01b03909116907fb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe5639060200160405180910390a250505b50505b80620037038162005faf565b915050620033f8565b505050565b6004545b6005548110156200373e576200373881620037308162005faf565b925062004406565b62003715565b50600554600455600854600754036200375357565b6009545b60085481101562003780576200377a81620037728162005faf565b92506200462d565b62003757565b50600854600955600754805b600854811015620037fa576010546000828152600660205260409020600401544391620037b99162005bd2565b11620037df57620037ca8162004928565b620037d760018362005bd2565b9150620037e5565b620037fa565b80620037f18162005faf565b9150506200378c565b50600755565b600c54600b5410156200391d57600b546000908152600a6020526040902060105460018201544391620038339162005bd2565b11156200383d5750565b600281015481546001600160a01b03908116600090815260286020526040808220600490810185905585548416835291819020600c015490516319fac8fd60e01b81529216926319fac8fd9262003898920190815260200190565b600060405180830381600087803b158015620038b357600080fd5b505af1158015620038c8573d6000803e3d6000fd5b5050600b80546000908152600a6020526040812080546001600160a01b0319168155600180820183905560029091018290558254909450919250906200391090849062005bd2565b9091555062003800915050565b565b60e2815114620039695760405162461bcd60e51b8152602060048201526014602482015273092dcecc2d8d2c840e0e4dedecc40d8cadccee8d60631b604482015260640162000db6565b60308261024001515114620039c15760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e67746800000000604482015260640162000db6565b620039cc8262004a73565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a00000000000060208083019190915284519251919260009262003a2f920160609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905060008262003a4f835162004ba0565b8360405160200162003a649392919062006175565b60408051601f198184030181528282528051602091820120600280855260608501845290945060009392909183019080368337019050509050600080808062003abc898262003ab66041600262005fcb565b62004cc1565b9050600062003adb8a62003ad36041600262005fcb565b606062004cc1565b905060205b825181101562003bac5762003af6838262004dda565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa15801562003b52573d6000803e3d6000fd5b5050604051601f19015190508762003b6c60418462006144565b8151811062003b7f5762003b7f62005f99565b6001600160a01b039092166020928302919091019091015262003ba460418262005bd2565b905062003ae0565b508a602001516001600160a01b03168660008151811062003bd15762003bd162005f99565b60200260200101516001600160a01b03161462003c435760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f66604482015268081c1c9bdd9a59195960ba1b606482015260840162000db6565b8a604001516001600160a01b03168660018151811062003c675762003c6762005f99565b60200260200101516001600160a01b03161462003cdb5760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201526a1bd9881c1c9bdd9a59195960aa1b606482015260840162000db6565b600162003cf38c6102400151838e6000015162004e11565b1462003d615760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e65727368697020604482015275383937b7b3103337b9103932b3b4b9ba3930ba34b7b760511b606482015260840162000db6565b62003d6c8b62004e80565b5050505050505050505050565b6000821162003dd75760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000db6565b6001600160a01b03811660009081526027602052604090205482111562003e415760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000db6565b6001600160a01b0381166000908152602760205260408120805484929062003e6b90849062005bbc565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b031991821617825595516001820180549190981696169590951790955590516002840155519101558054919262003ef78362005faf565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d91015b60405180910390a35050505050565b6001600160a01b0380841660009081526028602052604090208054909183811691161480620040db57600c820154604051631092ab9160e31b81526001600160a01b03858116600483015260009216906384955c8890602401602060405180830381865afa15801562003fdc573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200400291906200615b565b9050848110156200406a5760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201526a746f6e2062616c616e636560a81b606482015260840162000db6565b600c83015460405163282d3fdf60e01b81526001600160a01b038681166004830152602482018890529091169063282d3fdf90604401600060405180830381600087803b158015620040bb57600080fd5b505af1158015620040d0573d6000803e3d6000fd5b50505050506200416e565b8382600b01548360080154620040f2919062005bbc565b1015620041525760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201526662616c616e636560c81b606482015260840162000db6565b8382600b01600082825462004168919062005bd2565b90915550505b6040805160e0810182526001600160a01b0380861682528781166020808401918252838501898152600060608601818152436080880190815260a088018381528a151560c08a019081526008805486526006909752998420985189549089166001600160a01b0319918216178a55965160018a01805491909916971696909617909655915160028701559051600386015592516004850155905160059093018054945115156101000261ff00199415159490941661ffff199095169490941792909217909255805491620042428362005faf565b9190505550826001600160a01b0316856001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc838760405162003f569291909115158252602082015260400190565b60fa60a06000808285855af46200370c573d6000803e3d6000fd5b81546002600180831615610100020382160482518082016020811060208410016002811462004362576001811462004388578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b808210156200434b57815184556001840193506020820191506200432c565b815191036101000a908190040290915550620043fd565b60028302826020036101000a846020036101000a602089015104020185018755620043fd565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b80821015620043eb5781518455600184019350602082019150620043cc565b815191036101000a9081900402909155505b50505050505050565b600081815260036020908152604080832060018101546001600160a01b03168452602890925282209091601382015460ff1660038111156200444c576200444c620053b8565b14620044ec57600282015482546001600160a01b0316600090815260276020526040812080549091906200448290849062005bd2565b909155505081546001830154600284015460138401546040517f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f87834294620044df946001600160a01b0391821694911692909160ff90911690620061be565b60405180910390a1505050565b805482546001600160a01b03908116911614620045e957600080826008015483600501546200451c919062005bbc565b9050806000036200453457836002015491506200455a565b80846002015484600d01546200454b919062005fcb565b62004557919062006144565b91505b600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b158015620045ac57600080fd5b505af1158015620045c1573d6000803e3d6000fd5b505050508183600d016000828254620045db919062005bd2565b909155506200460992505050565b816002015481600801600082825462004603919062005bd2565b90915550505b816002015481600501600082825462004623919062005bd2565b9091555050505050565b600081815260066020908152604080832060018101546001600160a01b0316845260289092528220600582015491929091610100900460ff1662004821576002830154600c8301548454604051637eee288d60e01b81526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b158015620046c257600080fd5b505af1158015620046d7573d6000803e3d6000fd5b50505050600c8301548454604051632770a7eb60e21b81526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b1580156200472d57600080fd5b505af115801562004742573d6000803e3d6000fd5b505050506000836008015484600501546200475e919062005bbc565b600d85015490915062004772828462005fcb565b6200477e919062006144565b92508184600d01600082825462004796919062005bbc565b90915550506006840154600003620047b55760038501839055620047dd565b60068401546007850154620047cb908562005fcb565b620047d7919062006144565b60038601555b82846006016000828254620047f3919062005bd2565b909155505060038501546007850180546000906200481390849062005bd2565b90915550620048f692505050565b50600282015460088201548111156200483b575060088101545b81600901546000036200485557600383018190556200487d565b6009820154600a8301546200486b908362005fcb565b62004877919062006144565b60038401555b8082600901600082825462004893919062005bd2565b90915550506003830154600a83018054600090620048b390849062005bd2565b9250508190555080826008016000828254620048d0919062005bbc565b90915550506002830154600b83018054600090620048f090849062005bbc565b90915550505b6005808401805460ff19166001179055820180548291906000906200491d90849062005bbc565b909155505050505050565b6000818152600660205260408120600381015490910362004947575050565b60018101546001600160a01b031660009081526028602052604081206005830154909190610100900460ff16620049e35781600701548260060154846003015462004993919062005fcb565b6200499f919062006144565b905080826006016000828254620049b7919062005bbc565b90915550506003830154600783018054600090620049d790849062005bbc565b9091555062004a489050565b81600a015482600901548460030154620049fe919062005fcb565b62004a0a919062006144565b90508082600901600082825462004a22919062005bbc565b90915550506003830154600a8301805460009062004a4290849062005bbc565b90915550505b82546001600160a01b0316600090815260276020526040812080548392906200491d90849062005bd2565b600062004a848260600151620050b1565b6001600160a01b0390911660208401529050801562004ad45760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b604482015260640162000db6565b6020808301516001600160a01b0390811660009081526028909252604090912060010154161562004b485760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000db6565b61271082608001511115620024285760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000db6565b60608160000362004bc85750506040805180820190915260018152600360fc1b602082015290565b8160005b811562004bf8578062004bdf8162005faf565b915062004bf09050600a8362006144565b915062004bcc565b60008167ffffffffffffffff81111562004c165762004c1662005780565b6040519080825280601f01601f19166020018201604052801562004c41576020820181803683370190505b5090505b841562004cb95762004c5960018362005bbc565b915062004c68600a8662005ffb565b62004c7590603062005bd2565b60f81b81838151811062004c8d5762004c8d62005f99565b60200101906001600160f81b031916908160001a90535062004cb1600a8662006144565b945062004c45565b949350505050565b60608162004cd181601f62005bd2565b101562004d125760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b604482015260640162000db6565b62004d1e828462005bd2565b8451101562004d645760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b604482015260640162000db6565b60608215801562004d85576040519150600082526020820160405262004dd1565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101562004dc057805183526020928301920162004da6565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b81101562004e0a5762004e07601b82620061ec565b90505b9250925092565b600062004e1d62005243565b600085858560405160200162004e369392919062006208565b6040516020818303038152906040529050600060fb905060008251602062004e5f919062005bd2565b90506020848285855afa62004e7357600080fd5b5050905195945050505050565b6101808101516001600160a01b031662004f0357601d5460009062004ea59062004ba0565b90508160200151826000015183608001518360405162004ec59062005261565b62004ed4949392919062006257565b604051809103906000f08015801562004ef1573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b039586161790915584518416835260289095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062004fb5908262006296565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f8201556102008201516010820155610220820151601182015561024082015160128201906200507f908262006296565b5061026082015160138201805460ff19166001836003811115620050a757620050a7620053b8565b0217905550505050565b600080620050be6200526f565b60008060ff9050604083875160208901845afa620050db57600080fd5b50508051602090910151600160601b90910494909350915050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b0316815260200160008152602001600081526020016000815260200160008152602001600081526020016060815260200160006003811115620051c157620051c1620053b8565b905290565b6040518060a001604052806005906020820280368337509192915050565b508054600082559060005260206000209081019062000e0691906200528d565b508054620052129062005b6a565b6000825580601f1062005223575050565b601f01602090049060005260206000209081019062000e069190620052b2565b60405180602001604052806001906020820280368337509192915050565b611531806200635783390190565b60405180604001604052806002906020820280368337509192915050565b80821115620052ae576000620052a4828262005204565b506001016200528d565b5090565b5b80821115620052ae5760008155600101620052b3565b60005b83811015620052e6578181015183820152602001620052cc565b50506000910152565b6000815180845262005309816020860160208601620052c9565b601f01601f19169290920160200192915050565b602081526000620053326020830184620052ef565b9392505050565b6001600160a01b038116811462000e0657600080fd5b600080604083850312156200536357600080fd5b8235620053708162005339565b946020939093013593505050565b6000602082840312156200539157600080fd5b8135620053328162005339565b600060208284031215620053b157600080fd5b5035919050565b634e487b7160e01b600052602160045260246000fd5b60048110620053ed57634e487b7160e01b600052602160045260246000fd5b9052565b602081526200540c6020820183516001600160a01b03169052565b600060208301516200542960408401826001600160a01b03169052565b5060408301516001600160a01b03811660608401525060608301516102808060808501526200545d6102a0850183620052ef565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a0620054e5818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f190161026080880191909152909150620055498483620052ef565b9350808701519150506200556082860182620053ce565b5090949350505050565b6000806000606084860312156200558057600080fd5b83356200558d8162005339565b925060208401356200559f8162005339565b929592945050506040919091013590565b600060208284031215620055c357600080fd5b813567ffffffffffffffff811115620055db57600080fd5b820161028081850312156200533257600080fd5b600081518084526020808501808196508360051b8101915082860160005b8581101562005664578284038952815180516001600160a01b0316855285810151868601526040908101516060918601829052906200564f81870183620052ef565b9a87019a95505050908401906001016200560d565b5091979650505050505050565b821515815260406020820152600062004cb96040830184620055ef565b60008060408385031215620056a257600080fd5b50508035926020909101359150565b845181526020808601518183015260408087015181840152606080880151818501526080808901516001600160a01b03908116828701528851811660a08088019190915294890151811660c087015292880151831660e086015290870151821661010085015286015116610120830152840151610200820190620057416101408401826001600160a01b03169052565b5083516001600160a01b0316610160830152602084015161018083015260408401516101a08301526060909301516101c08201526101e0015292915050565b634e487b7160e01b600052604160045260246000fd5b600082601f830112620057a857600080fd5b813567ffffffffffffffff80821115620057c657620057c662005780565b604051601f8301601f19908116603f01168101908282118183101715620057f157620057f162005780565b816040528381528660208588010111156200580b57600080fd5b836020870160208301376000602085830101528094505050505092915050565b600080600080608085870312156200584257600080fd5b843567ffffffffffffffff808211156200585b57600080fd5b620058698883890162005796565b9550602087013591506200587d8262005339565b909350604086013590808211156200589457600080fd5b620058a28883890162005796565b93506060870135915080821115620058b957600080fd5b50620058c88782880162005796565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b828110156200592d57603f198886030184526200591a858351620052ef565b94509285019290850190600101620058fb565b5092979650505050505050565b602081526000620053326020830184620055ef565b6020808252825182820181905260009190848201906040850190845b81811015620059925783516001600160a01b0316835292840192918401916001016200596b565b50909695505050505050565b60008060408385031215620059b257600080fd5b823567ffffffffffffffff80821115620059cb57600080fd5b620059d98683870162005796565b93506020850135915080821115620059f057600080fd5b50620059ff8582860162005796565b9150509250929050565b60408152600062005a1e6040830185620052ef565b828103602084015262005a328185620052ef565b95945050505050565b6000806040838503121562005a4f57600080fd5b823562005a5c8162005339565b9150602083013562005a6e8162005339565b809150509250929050565b6020808252601c908201527f76616c696461746f72206d757374206265207265676973746572656400000000604082015260600190565b6020808252602c908201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160408201526b191b5a5b881858d8dbdd5b9d60a21b606082015260800190565b6020808252601a908201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604082015260600190565b60208082526018908201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604082015260600190565b600181811c9082168062005b7f57607f821691505b60208210810362005ba057634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b8181038181111562000d705762000d7062005ba6565b8082018082111562000d705762000d7062005ba6565b6000813562000d708162005339565b80546001600160a01b0319166001600160a01b0392909216919091179055565b6000808335601e1984360301811262005c2f57600080fd5b83018035915067ffffffffffffffff82111562005c4b57600080fd5b60200191503681900382131562005c6157600080fd5b9250929050565b601f8211156200370c57600081815260208120601f850160051c8101602086101562005c915750805b601f850160051c820191505b8181101562005cb25782815560010162005c9d565b505050505050565b600019600383901b1c191660019190911b1790565b67ffffffffffffffff83111562005cea5762005cea62005780565b62005d028362005cfb835462005b6a565b8362005c68565b6000601f84116001811462005d35576000851562005d205750838201355b62005d2c868262005cba565b8455506200152b565b600083815260209020601f19861690835b8281101562005d68578685013582556020948501946001909201910162005d46565b508682101562005d865760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b600081356004811062000d7057600080fd5b6004821062005dc957634e487b7160e01b600052602160045260246000fd5b60ff1981541660ff831681178255505050565b62005df262005deb8362005be8565b8262005bf7565b62005e0e62005e046020840162005be8565b6001830162005bf7565b62005e2a62005e206040840162005be8565b6002830162005bf7565b62005e39606083018362005c17565b62005e4981836003860162005ccf565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b82015562005ebc62005eb2610180840162005be8565b600c830162005bf7565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f8201556102008201356010820155610220820135601182015562005f0361024083018362005c17565b62005f1381836012860162005ccf565b50506200242862005f28610260840162005d98565b6013830162005daa565b60208082526023908201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60408201526218dbdb60ea1b606082015260800190565b60006020828403121562005f8857600080fd5b815180151581146200533257600080fd5b634e487b7160e01b600052603260045260246000fd5b60006001820162005fc45762005fc462005ba6565b5060010190565b808202811582820484141762000d705762000d7062005ba6565b634e487b7160e01b600052601260045260246000fd5b6000826200600d576200600d62005fe5565b500690565b600060018060a01b0380881683528087166020840152808616604084015260a060608401526200604660a0840186620052ef565b91508084166080840152509695505050505050565b81810362006067575050565b62006073825462005b6a565b67ffffffffffffffff8111156200608e576200608e62005780565b620060a6816200609f845462005b6a565b8462005c68565b6000601f821160018114620060d95760008315620060c45750848201545b620060d0848262005cba565b8555506200152b565b600085815260209020601f19841690600086815260209020845b83811015620061155782860154825560019586019590910190602001620060f3565b5085831015620061345781850154600019600388901b60f8161c191681555b5050505050600190811b01905550565b60008262006156576200615662005fe5565b500490565b6000602082840312156200616e57600080fd5b5051919050565b6000845162006189818460208901620052c9565b8451908301906200619f818360208901620052c9565b8451910190620061b4818360208801620052c9565b0195945050505050565b6001600160a01b03858116825284166020820152604081018390526080810162005a326060830184620053ce565b60ff818116838216019081111562000d705762000d7062005ba6565b600084516200621c818460208901620052c9565b84519083019062006232818360208901620052c9565b60609490941b6bffffffffffffffffffffffff19169301928352505060140192915050565b6001600160a01b03858116825284166020820152604081018390526080606082018190526000906200628c90830184620052ef565b9695505050505050565b815167ffffffffffffffff811115620062b357620062b362005780565b620062c4816200609f845462005b6a565b602080601f831160018114620062f85760008415620062e35750858301515b620062ef858262005cba565b86555062005cb2565b600085815260208120601f198616915b82811015620063295788860151825594840194600190910190840162006308565b50858210156200613457939096015160001960f8600387901b161c19169092555050600190811b0190555056fe60806040523480156200001157600080fd5b506040516200153138038062001531833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61115980620003d86000396000f3fe60806040526004361061014b5760003560e01c806359355736116100b6578063949813b81161006f578063949813b8146103c557806395d89b41146103e55780639dc29fac146103fa578063a9059cbb1461041a578063dd62ed3e1461043a578063fb489a7b1461048057600080fd5b806359355736146102e35780635ea1d6f81461031957806361d027b31461032f57806370a082311461034f5780637eee288d1461038557806384955c88146103a557600080fd5b8063282d3fdf11610108578063282d3fdf146102245780632f2c3f2e14610244578063313ce5671461025a578063372500ab146102765780633a5381b51461028b57806340c10f19146102c357600080fd5b806306fdde0314610150578063095ea7b31461017b57806318160ddd146101ab578063187cf4d7146101ca57806319fac8fd146101e257806323b872dd14610204575b600080fd5b34801561015c57600080fd5b50610165610488565b6040516101729190610ece565b60405180910390f35b34801561018757600080fd5b5061019b610196366004610f38565b61051a565b6040519015158152602001610172565b3480156101b757600080fd5b506004545b604051908152602001610172565b3480156101d657600080fd5b506101bc633b9aca0081565b3480156101ee57600080fd5b506102026101fd366004610f62565b610531565b005b34801561021057600080fd5b5061019b61021f366004610f7b565b610569565b34801561023057600080fd5b5061020261023f366004610f38565b61065c565b34801561025057600080fd5b506101bc61271081565b34801561026657600080fd5b5060405160128152602001610172565b34801561028257600080fd5b50610202610741565b34801561029757600080fd5b50600a546102ab906001600160a01b031681565b6040516001600160a01b039091168152602001610172565b3480156102cf57600080fd5b506102026102de366004610f38565b6107ef565b3480156102ef57600080fd5b506101bc6102fe366004610fb7565b6001600160a01b031660009081526002602052604090205490565b34801561032557600080fd5b506101bc600c5481565b34801561033b57600080fd5b50600b546102ab906001600160a01b031681565b34801561035b57600080fd5b506101bc61036a366004610fb7565b6001600160a01b031660009081526001602052604090205490565b34801561039157600080fd5b506102026103a0366004610f38565b610857565b3480156103b157600080fd5b506101bc6103c0366004610fb7565b61091d565b3480156103d157600080fd5b506101bc6103e0366004610fb7565b61094b565b3480156103f157600080fd5b50610165610979565b34801561040657600080fd5b50610202610415366004610f38565b610988565b34801561042657600080fd5b5061019b610435366004610f38565b6109e8565b34801561044657600080fd5b506101bc610455366004610fd9565b6001600160a01b03918216600090815260036020908152604080832093909416825291909152205490565b6101bc610a35565b6060600880546104979061100c565b80601f01602080910402602001604051908101604052809291908181526020018280546104c39061100c565b80156105105780601f106104e557610100808354040283529160200191610510565b820191906000526020600020905b8154815290600101906020018083116104f357829003601f168201915b5050505050905090565b6000610527338484610b9d565b5060015b92915050565b6000546001600160a01b031633146105645760405162461bcd60e51b815260040161055b90611046565b60405180910390fd5b600c55565b6001600160a01b0383166000908152600360209081526040808320338452909152812054828110156105ee5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b606482015260840161055b565b61060285336105fd86856110a4565b610b9d565b61060c8584610cc1565b6106168484610da9565b836001600160a01b0316856001600160a01b03166000805160206111048339815191528560405161064991815260200190565b60405180910390a3506001949350505050565b6000546001600160a01b031633146106865760405162461bcd60e51b815260040161055b90611046565b6001600160a01b03821660009081526002602090815260408083205460019092529091205482916106b6916110a4565b10156107105760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110b7565b90915550505050565b600061074c33610df4565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461079e576040519150601f19603f3d011682016040523d82523d6000602084013e6107a3565b606091505b50509050806107eb5760405162461bcd60e51b81526020600482015260146024820152732330b4b632b2103a379039b2b7321022ba3432b960611b604482015260640161055b565b5050565b6000546001600160a01b031633146108195760405162461bcd60e51b815260040161055b90611046565b6108238282610da9565b6040518181526001600160a01b03831690600090600080516020611104833981519152906020015b60405180910390a35050565b6000546001600160a01b031633146108815760405162461bcd60e51b815260040161055b90611046565b6001600160a01b0382166000908152600260205260409020548111156108f55760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110a4565b6001600160a01b038116600090815260026020908152604080832054600190925282205461052b91906110a4565b600061095682610e59565b6001600160a01b03831660009081526005602052604090205461052b91906110b7565b6060600980546104979061100c565b6000546001600160a01b031633146109b25760405162461bcd60e51b815260040161055b90611046565b6109bc8282610cc1565b6040518181526000906001600160a01b038416906000805160206111048339815191529060200161084b565b60006109f43383610cc1565b6109fe8383610da9565b6040518281526001600160a01b0384169033906000805160206111048339815191529060200160405180910390a350600192915050565b600080546001600160a01b03163314610a605760405162461bcd60e51b815260040161055b90611046565b600c54349060009061271090610a7690846110ca565b610a8091906110e1565b905081811115610ad25760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f72207265776172640000000000000000604482015260640161055b565b610adc81836110a4565b600b546040519193506001600160a01b0316906108fc9083906000818181858888f193505050503d8060008114610b2f576040519150601f19603f3d011682016040523d82523d6000602084013e610b34565b606091505b505060045460009150610b4b633b9aca00856110ca565b610b5591906110e1565b905080600754610b6591906110b7565b600755600454600090633b9aca0090610b7e90846110ca565b610b8891906110e1565b9050610b9481846110b7565b94505050505090565b6001600160a01b038316610bff5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840161055b565b6001600160a01b038216610c605760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840161055b565b6001600160a01b0383811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b610cca82610df4565b506001600160a01b038216600090815260016020908152604080832054600290925290912054610cfa90826110a4565b821115610d495760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e64730000000000604482015260640161055b565b610d5382826110a4565b6001600160a01b038416600090815260016020526040902055808203610d8d576001600160a01b0383166000908152600660205260408120555b8160046000828254610d9f91906110a4565b9091555050505050565b610db282610df4565b506001600160a01b03821660009081526001602052604081208054839290610ddb9084906110b7565b92505081905550806004600082825461073891906110b7565b600080610e0083610e59565b6001600160a01b038416600090815260056020526040902054909150610e279082906110b7565b6001600160a01b0390931660009081526005602090815260408083208690556007546006909252909120555090919050565b6001600160a01b038116600090815260016020526040812054808203610e825750600092915050565b6001600160a01b038316600090815260066020526040812054600754610ea891906110a4565b90506000633b9aca00610ebb84846110ca565b610ec591906110e1565b95945050505050565b600060208083528351808285015260005b81811015610efb57858101830151858201604001528201610edf565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b0381168114610f3357600080fd5b919050565b60008060408385031215610f4b57600080fd5b610f5483610f1c565b946020939093013593505050565b600060208284031215610f7457600080fd5b5035919050565b600080600060608486031215610f9057600080fd5b610f9984610f1c565b9250610fa760208501610f1c565b9150604084013590509250925092565b600060208284031215610fc957600080fd5b610fd282610f1c565b9392505050565b60008060408385031215610fec57600080fd5b610ff583610f1c565b915061100360208401610f1c565b90509250929050565b600181811c9082168061102057607f821691505b60208210810361104057634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561052b5761052b61108e565b8082018082111561052b5761052b61108e565b808202811582820484141761052b5761052b61108e565b6000826110fe57634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa26469706673582212201289c3ecaa1bb9a23311896b9eb4649d18bd05371b1d553ca14b0a83d5f2471f64736f6c63430008150033a26469706673582212208ffb14cce12297712fe3e14fef4396a65a8691ccc3205864b3528b233ca37f3e64736f6c6343000815003360806040523480156200001157600080fd5b506040516200153138038062001531833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61115980620003d86000396000f3fe60806040526004361061014b5760003560e01c806359355736116100b6578063949813b81161006f578063949813b8146103c557806395d89b41146103e55780639dc29fac146103fa578063a9059cbb1461041a578063dd62ed3e1461043a578063fb489a7b1461048057600080fd5b806359355736146102e35780635ea1d6f81461031957806361d027b31461032f57806370a082311461034f5780637eee288d1461038557806384955c88146103a557600080fd5b8063282d3fdf11610108578063282d3fdf146102245780632f2c3f2e14610244578063313ce5671461025a578063372500ab146102765780633a5381b51461028b57806340c10f19146102c357600080fd5b806306fdde0314610150578063095ea7b31461017b57806318160ddd146101ab578063187cf4d7146101ca57806319fac8fd146101e257806323b872dd14610204575b600080fd5b34801561015c57600080fd5b50610165610488565b6040516101729190610ece565b60405180910390f35b34801561018757600080fd5b5061019b610196366004610f38565b61051a565b6040519015158152602001610172565b3480156101b757600080fd5b506004545b604051908152602001610172565b3480156101d657600080fd5b506101bc633b9aca0081565b3480156101ee57600080fd5b506102026101fd366004610f62565b610531565b005b34801561021057600080fd5b5061019b61021f366004610f7b565b610569565b34801561023057600080fd5b5061020261023f366004610f38565b61065c565b34801561025057600080fd5b506101bc61271081565b34801561026657600080fd5b5060405160128152602001610172565b34801561028257600080fd5b50610202610741565b34801561029757600080fd5b50600a546102ab906001600160a01b031681565b6040516001600160a01b039091168152602001610172565b3480156102cf57600080fd5b506102026102de366004610f38565b6107ef565b3480156102ef57600080fd5b506101bc6102fe366004610fb7565b6001600160a01b031660009081526002602052604090205490565b34801561032557600080fd5b506101bc600c5481565b34801561033b57600080fd5b50600b546102ab906001600160a01b031681565b34801561035b57600080fd5b506101bc61036a366004610fb7565b6001600160a01b031660009081526001602052604090205490565b34801561039157600080fd5b506102026103a0366004610f38565b610857565b3480156103b157600080fd5b506101bc6103c0366004610fb7565b61091d565b3480156103d157600080fd5b506101bc6103e0366004610fb7565b61094b565b3480156103f157600080fd5b50610165610979565b34801561040657600080fd5b50610202610415366004610f38565b610988565b34801561042657600080fd5b5061019b610435366004610f38565b6109e8565b34801561044657600080fd5b506101bc610455366004610fd9565b6001600160a01b03918216600090815260036020908152604080832093909416825291909152205490565b6101bc610a35565b6060600880546104979061100c565b80601f01602080910402602001604051908101604052809291908181526020018280546104c39061100c565b80156105105780601f106104e557610100808354040283529160200191610510565b820191906000526020600020905b8154815290600101906020018083116104f357829003601f168201915b5050505050905090565b6000610527338484610b9d565b5060015b92915050565b6000546001600160a01b031633146105645760405162461bcd60e51b815260040161055b90611046565b60405180910390fd5b600c55565b6001600160a01b0383166000908152600360209081526040808320338452909152812054828110156105ee5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b606482015260840161055b565b61060285336105fd86856110a4565b610b9d565b61060c8584610cc1565b6106168484610da9565b836001600160a01b0316856001600160a01b03166000805160206111048339815191528560405161064991815260200190565b60405180910390a3506001949350505050565b6000546001600160a01b031633146106865760405162461bcd60e51b815260040161055b90611046565b6001600160a01b03821660009081526002602090815260408083205460019092529091205482916106b6916110a4565b10156107105760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110b7565b90915550505050565b600061074c33610df4565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461079e576040519150601f19603f3d011682016040523d82523d6000602084013e6107a3565b606091505b50509050806107eb5760405162461bcd60e51b81526020600482015260146024820152732330b4b632b2103a379039b2b7321022ba3432b960611b604482015260640161055b565b5050565b6000546001600160a01b031633146108195760405162461bcd60e51b815260040161055b90611046565b6108238282610da9565b6040518181526001600160a01b03831690600090600080516020611104833981519152906020015b60405180910390a35050565b6000546001600160a01b031633146108815760405162461bcd60e51b815260040161055b90611046565b6001600160a01b0382166000908152600260205260409020548111156108f55760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110a4565b6001600160a01b038116600090815260026020908152604080832054600190925282205461052b91906110a4565b600061095682610e59565b6001600160a01b03831660009081526005602052604090205461052b91906110b7565b6060600980546104979061100c565b6000546001600160a01b031633146109b25760405162461bcd60e51b815260040161055b90611046565b6109bc8282610cc1565b6040518181526000906001600160a01b038416906000805160206111048339815191529060200161084b565b60006109f43383610cc1565b6109fe8383610da9565b6040518281526001600160a01b0384169033906000805160206111048339815191529060200160405180910390a350600192915050565b600080546001600160a01b03163314610a605760405162461bcd60e51b815260040161055b90611046565b600c54349060009061271090610a7690846110ca565b610a8091906110e1565b905081811115610ad25760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f72207265776172640000000000000000604482015260640161055b565b610adc81836110a4565b600b546040519193506001600160a01b0316906108fc9083906000818181858888f193505050503d8060008114610b2f576040519150601f19603f3d011682016040523d82523d6000602084013e610b34565b606091505b505060045460009150610b4b633b9aca00856110ca565b610b5591906110e1565b905080600754610b6591906110b7565b600755600454600090633b9aca0090610b7e90846110ca565b610b8891906110e1565b9050610b9481846110b7565b94505050505090565b6001600160a01b038316610bff5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840161055b565b6001600160a01b038216610c605760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840161055b565b6001600160a01b0383811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b610cca82610df4565b506001600160a01b038216600090815260016020908152604080832054600290925290912054610cfa90826110a4565b821115610d495760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e64730000000000604482015260640161055b565b610d5382826110a4565b6001600160a01b038416600090815260016020526040902055808203610d8d576001600160a01b0383166000908152600660205260408120555b8160046000828254610d9f91906110a4565b9091555050505050565b610db282610df4565b506001600160a01b03821660009081526001602052604081208054839290610ddb9084906110b7565b92505081905550806004600082825461073891906110b7565b600080610e0083610e59565b6001600160a01b038416600090815260056020526040902054909150610e279082906110b7565b6001600160a01b0390931660009081526005602090815260408083208690556007546006909252909120555090919050565b6001600160a01b038116600090815260016020526040812054808203610e825750600092915050565b6001600160a01b038316600090815260066020526040812054600754610ea891906110a4565b90506000633b9aca00610ebb84846110ca565b610ec591906110e1565b95945050505050565b600060208083528351808285015260005b81811015610efb57858101830151858201604001528201610edf565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b0381168114610f3357600080fd5b919050565b60008060408385031215610f4b57600080fd5b610f5483610f1c565b946020939093013593505050565b600060208284031215610f7457600080fd5b5035919050565b600080600060608486031215610f9057600080fd5b610f9984610f1c565b9250610fa760208501610f1c565b9150604084013590509250925092565b600060208284031215610fc957600080fd5b610fd282610f1c565b9392505050565b60008060408385031215610fec57600080fd5b610ff583610f1c565b915061100360208401610f1c565b90509250929050565b600181811c9082168061102057607f821691505b60208210810361104057634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561052b5761052b61108e565b8082018082111561052b5761052b61108e565b808202811582820484141761052b5761052b61108e565b6000826110fe57634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa26469706673582212201289c3ecaa1bb9a23311896b9eb4649d18bd05371b1d553ca14b0a83d5f2471f64736f6c63430008150033",
+	Bin: "0x60806040526000600b556000600c553480156200001b57600080fd5b50604080516000808252602082019092529062000051565b6200003d62000d4e565b815260200190600190039081620000335790505b506040805161012081018252600d546080808301918252600e5460a080850191909152600f5460c08086019190915260105460e08601526011546001600160a01b039081166101008701529385528551908101865260125484168152601354841660208281019190915260145485168288015260155485166060808401919091526016548616838601526017548616938301939093528086019190915285519283018652601854909316825260195492820192909252601a5481850152601b548183015292820192909252601c54918101829052906000036200014c57602a80546001600160a01b031916331790556200014c828262000169565b5050601c546001036200016357620001636200051f565b6200115e565b80518051600d55602080820151600e55604080830151600f55606080840151601055608093840151601180546001600160a01b03199081166001600160a01b03938416179091558487015180516012805484169185169190911790558086015160138054841691851691909117905580850151601480548416918516919091179055808401516015805484169185169190911790559586015160168054831691841691909117905560a0909501516017805487169183169190911790558286015180516018805490971692169190911790945591830151601955820151601a5590810151601b55810151601c5560005b82518110156200051a5760008382815181106200027a576200027a62000ebd565b602002602001015160a00151905060008483815181106200029f576200029f62000ebd565b60200260200101516101a00181815250506000848381518110620002c757620002c762000ebd565b602002602001015161018001906001600160a01b031690816001600160a01b031681525050600084838151811062000303576200030362000ebd565b602002602001015160a001818152505060008483815181106200032a576200032a62000ebd565b60209081029190910101516101c00152600f54845185908490811062000354576200035462000ebd565b6020026020010151608001818152505060008483815181106200037b576200037b62000ebd565b6020026020010151610260019060038111156200039c576200039c62000ed3565b90816003811115620003b257620003b262000ed3565b815250506000848381518110620003cd57620003cd62000ebd565b60200260200101516101600181815250506200040b848381518110620003f757620003f762000ebd565b60200260200101516200068d60201b60201c565b6200043884838151811062000424576200042462000ebd565b6020026020010151620007c460201b60201c565b806027600086858151811062000452576200045262000ebd565b6020026020010151600001516001600160a01b03166001600160a01b0316815260200190815260200160002060008282546200048f919062000eff565b925050819055508060296000828254620004aa919062000eff565b9250508190555062000504848381518110620004ca57620004ca62000ebd565b60200260200101516020015182868581518110620004ec57620004ec62000ebd565b602002602001015160000151620009f560201b60201c565b5080620005118162000f1b565b91505062000259565b505050565b602a546001600160a01b031633146200058b5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201526218dbdb60ea1b60648201526084015b60405180910390fd5b600260286000601d600181548110620005a857620005a862000ebd565b60009182526020808320909101546001600160a01b0316835282019290925260400181206005018054909190620005e190849062000f4d565b92505081905550600260286000601d60018154811062000605576200060562000ebd565b60009182526020808320909101546001600160a01b03168352820192909252604001812060080180549091906200063e90849062000f4d565b90915550506002601c556018546001600160a01b031660009081526027602052604081206103e8905562000673908062000e1e565b620006816001600062000e1e565b6002805460ff19169055565b6000620006a4826060015162000be060201b60201c565b6001600160a01b03909116602084015290508015620006f45760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b604482015260640162000582565b6020808301516001600160a01b03908116600090815260289092526040909120600101541615620007685760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000582565b61271082608001511115620007c05760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000582565b5050565b6101808101516001600160a01b03166200084757601d54600090620007e99062000c2e565b905081602001518260000151836080015183604051620008099062000e60565b62000818949392919062000f64565b604051809103906000f08015801562000835573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352602890955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620008f9908262001065565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f820155610200820151601082015561022082015160118201556102408201516012820190620009c3908262001065565b5061026082015160138201805460ff19166001836003811115620009eb57620009eb62000ed3565b0217905550505050565b6000821162000a535760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000582565b6001600160a01b03811660009081526027602052604090205482111562000abd5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000582565b6001600160a01b0381166000908152602760205260408120805484929062000ae790849062001131565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b031991821617825595516001820180549190981696169590951790955590516002840155519101558054919262000b738362000f1b565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a35050505050565b60008062000bed62000e6e565b60008060ff9050604083875160208901845afa62000c0a57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000c565750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000c86578062000c6d8162000f1b565b915062000c7e9050600a8362000f4d565b915062000c5a565b6000816001600160401b0381111562000ca35762000ca362000ea7565b6040519080825280601f01601f19166020018201604052801562000cce576020820181803683370190505b5090505b841562000d465762000ce660018362001131565b915062000cf5600a8662001147565b62000d0290603062000eff565b60f81b81838151811062000d1a5762000d1a62000ebd565b60200101906001600160f81b031916908160001a90535062000d3e600a8662000f4d565b945062000cd2565b949350505050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562000e195762000e1962000ed3565b905290565b50805462000e2c9062000fd7565b6000825580601f1062000e3d575050565b601f01602090049060005260206000209081019062000e5d919062000e8c565b50565b6115318062008d9983390190565b60405180604001604052806002906020820280368337509192915050565b5b8082111562000ea3576000815560010162000e8d565b5090565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b8082018082111562000f155762000f1562000ee9565b92915050565b60006001820162000f305762000f3062000ee9565b5060010190565b634e487b7160e01b600052601260045260246000fd5b60008262000f5f5762000f5f62000f37565b500490565b600060018060a01b038087168352602081871681850152856040850152608060608501528451915081608085015260005b8281101562000fb35785810182015185820160a00152810162000f95565b5050600060a0828501015260a0601f19601f83011684010191505095945050505050565b600181811c9082168062000fec57607f821691505b6020821081036200100d57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200051a57600081815260208120601f850160051c810160208610156200103c5750805b601f850160051c820191505b818110156200105d5782815560010162001048565b505050505050565b81516001600160401b0381111562001081576200108162000ea7565b620010998162001092845462000fd7565b8462001013565b602080601f831160018114620010d15760008415620010b85750858301515b600019600386901b1c1916600185901b1785556200105d565b600085815260208120601f198616915b828110156200110257888601518255948401946001909101908401620010e1565b5085821015620011215787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b8181038181111562000f155762000f1562000ee9565b60008262001159576200115962000f37565b500690565b617c2b806200116e6000396000f3fe608060405260043610620003ff5760003560e01c8063872cf059116200020f578063b66b3e791162000123578063d372c07e11620000b3578063da45591b1162000081578063da45591b1462000d2a578063dd62ed3e1462000d4f578063dfb1a4d21462000d99578063e7f43c681462000db0578063f7866ee31462000dd057005b8063d372c07e1462000ca6578063d5f394881462000ccb578063d861b0e81462000ced578063d886f8a21462000d0557005b8063cb696f5411620000f1578063cb696f541462000c1f578063ceaad4551462000c44578063cf9c57191462000c69578063cfd19fb91462000c8157005b8063b66b3e791462000baf578063b7ab4db51462000bd7578063c2362dd51462000bef578063c9d97af41462000c0757005b8063a5d059ca116200019f578063ae1f5fa0116200016d578063ae1f5fa01462000b19578063b2ea9adb1462000b40578063b3ecbadd1462000b65578063b46e55201462000b8a57005b8063a5d059ca1462000a81578063a8b2216e1462000aa6578063a9059cbb1462000acd578063ab8f6ffe1462000af257005b80639bb851c011620001dd5780639bb851c01462000a075780639c98e4711462000a1f5780639dc29fac1462000a37578063a515366a1462000a5c57005b8063872cf059146200096b5780638bac7dad146200098357806395d89b4114620009a857806396b477cb14620009d657005b806339b17fec11620003135780636fd2c80b11620002a357806379502c55116200027157806379502c55146200080c578063819b646314620008ea578063833b1fce146200090157806384467fdb1462000921578063852c4849146200094657005b80636fd2c80b146200077f57806370a082311462000796578063731b3a0314620007d057806377e741c714620007e757005b80634bb278f311620002e15780634bb278f314620006cf578063520fdbbc14620006f75780635f7d3949146200071c5780636b5f444c146200075a57005b806339b17fec146200064957806340c10f19146200066e578063436459691462000693578063496ccd9b14620006aa57005b806318160ddd116200038f5780632a400bc3116200035d5780632a400bc314620005c95780632f2c3f2e14620005ee578063313ce567146200060657806335be16e0146200062457005b806318160ddd14620005425780631904bb2e146200055957806323b872dd146200058d57806329070c6d14620005b257005b80631122063311620003cd5780631122063314620004c9578063114eaf5514620004e05780631250a28d14620005055780631604e416146200052a57005b806306fdde031462000409578063095ea7b3146200044d5780630ae65e7a14620004835780630d8e6e2c14620004a857005b366200040757005b005b3480156200041657600080fd5b506040805180820190915260068152652732bbba37b760d11b60208201525b60405162000444919062005603565b60405180910390f35b3480156200045a57600080fd5b50620004726200046c36600462005635565b62000df0565b604051901515815260200162000444565b3480156200049057600080fd5b5062000407620004a236600462005664565b62000e09565b348015620004b557600080fd5b50601c545b60405190815260200162000444565b348015620004d657600080fd5b50600e54620004ba565b348015620004ed57600080fd5b5062000407620004ff36600462005684565b62000e9c565b3480156200051257600080fd5b50620004076200052436600462005664565b62000ece565b3480156200053757600080fd5b50620004ba60245481565b3480156200054f57600080fd5b50602954620004ba565b3480156200056657600080fd5b506200057e6200057836600462005664565b62000f1d565b604051620004449190620056d7565b3480156200059a57600080fd5b5062000472620005ac36600462005850565b620011cb565b348015620005bf57600080fd5b50600d54620004ba565b348015620005d657600080fd5b5062000407620005e836600462005850565b62001225565b348015620005fb57600080fd5b50620004ba61271081565b3480156200061357600080fd5b506040516012815260200162000444565b3480156200063157600080fd5b50620004076200064336600462005896565b62001312565b3480156200065657600080fd5b50620004076200066836600462005850565b620014ee565b3480156200067b57600080fd5b50620004076200068d36600462005635565b620015a7565b348015620006a057600080fd5b50601a54620004ba565b348015620006b757600080fd5b5062000407620006c936600462005664565b62001661565b348015620006dc57600080fd5b50620006e76200176b565b6040516200044492919062005957565b3480156200070457600080fd5b50620004076200071636600462005664565b62001b32565b3480156200072957600080fd5b50620007416200073b36600462005974565b62001d2d565b6040516001600160a01b03909116815260200162000444565b3480156200076757600080fd5b50620004076200077936600462005684565b62001f44565b3480156200078c57600080fd5b50601054620004ba565b348015620007a357600080fd5b50620004ba620007b536600462005664565b6001600160a01b031660009081526027602052604090205490565b348015620007dd57600080fd5b50602054620004ba565b348015620007f457600080fd5b50620004076200080636600462005684565b620020ca565b3480156200081957600080fd5b506040805160a08082018352600d548252600e54602080840191909152600f54838501526010546060808501919091526011546001600160a01b03908116608080870191909152865160c0810188526012548316815260135483168186015260145483168189015260155483168185015260165483168183015260175483169581019590955286519081018752601854909116815260195492810192909252601a5494820194909452601b5493810193909352601c54620008d8939084565b60405162000444949392919062005997565b348015620008f757600080fd5b50601b54620004ba565b3480156200090e57600080fd5b506013546001600160a01b031662000741565b3480156200092e57600080fd5b50620004076200094036600462005b11565b620020fc565b3480156200095357600080fd5b50620004076200096536600462005635565b6200222b565b3480156200097857600080fd5b5062000407620023bc565b3480156200099057600080fd5b5062000407620009a236600462005684565b620023f8565b348015620009b557600080fd5b50604080518082019091526003815262272a2760e91b602082015262000435565b348015620009e357600080fd5b50620004ba620009f536600462005684565b6000908152601f602052604090205490565b34801562000a1457600080fd5b50620004ba60235481565b34801562000a2c57600080fd5b50620004ba60215481565b34801562000a4457600080fd5b506200040762000a5636600462005635565b6200247c565b34801562000a6957600080fd5b506200040762000a7b36600462005635565b62002592565b34801562000a8e57600080fd5b506200040762000aa036600462005635565b62002665565b34801562000ab357600080fd5b5062000abe620026fc565b60405162000444919062005bba565b34801562000ada57600080fd5b506200047262000aec36600462005635565b620027df565b34801562000aff57600080fd5b5062000b0a620027ee565b60405162000444919062005c20565b34801562000b2657600080fd5b5062000b3162002900565b60405162000444919062005c35565b34801562000b4d57600080fd5b506200040762000b5f36600462005c84565b62002b46565b34801562000b7257600080fd5b506200040762000b8436600462005664565b62002b8d565b34801562000b9757600080fd5b506200040762000ba936600462005664565b62002bdc565b34801562000bbc57600080fd5b5062000bc762002e18565b6040516200044492919062005cef565b34801562000be457600080fd5b5062000b3162002f4f565b34801562000bfc57600080fd5b50620004ba60205481565b34801562000c1457600080fd5b50620004ba601e5481565b34801562000c2c57600080fd5b506200040762000c3e36600462005684565b62002fb3565b34801562000c5157600080fd5b506200040762000c6336600462005664565b62003016565b34801562000c7657600080fd5b506200040762003065565b34801562000c8e57600080fd5b506200040762000ca036600462005664565b620030b9565b34801562000cb357600080fd5b506200040762000cc536600462005664565b62003108565b34801562000cd857600080fd5b50602a5462000741906001600160a01b031681565b34801562000cfa57600080fd5b506200040762003157565b34801562000d1257600080fd5b506200040762000d2436600462005664565b62003198565b34801562000d3757600080fd5b506200040762000d4936600462005d21565b620031e7565b34801562000d5c57600080fd5b50620004ba62000d6e36600462005d5a565b6001600160a01b03918216600090815260266020908152604080832093909416825291909152205490565b34801562000da657600080fd5b50601954620004ba565b34801562000dbd57600080fd5b506018546001600160a01b031662000741565b34801562000ddd57600080fd5b506011546001600160a01b031662000741565b600062000dff338484620032aa565b5060015b92915050565b6001600160a01b038082166000818152602860205260409020600101549091161462000e525760405162461bcd60e51b815260040162000e499062005d98565b60405180910390fd5b6001600160a01b0381811660009081526028602052604090205416331462000e8e5760405162461bcd60e51b815260040162000e499062005dcf565b62000e9981620033d3565b50565b6018546001600160a01b0316331462000ec95760405162461bcd60e51b815260040162000e499062005e1b565b601055565b6018546001600160a01b0316331462000efb5760405162461bcd60e51b815260040162000e499062005e1b565b601280546001600160a01b0319166001600160a01b0392909216919091179055565b62000f27620053dc565b6001600160a01b038083166000818152602860205260409020600101549091161462000f675760405162461bcd60e51b815260040162000e499062005e52565b6001600160a01b03808316600090815260286020908152604091829020825161028081018452815485168152600182015485169281019290925260028101549093169181019190915260038201805491929160608401919062000fca9062005e89565b80601f016020809104026020016040519081016040528092919081815260200182805462000ff89062005e89565b8015620010495780601f106200101d5761010080835404028352916020019162001049565b820191906000526020600020905b8154815290600101906020018083116200102b57829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e090920191620011059062005e89565b80601f0160208091040260200160405190810160405280929190818152602001828054620011339062005e89565b8015620011845780601f10620011585761010080835404028352916020019162001184565b820191906000526020600020905b8154815290600101906020018083116200116657829003601f168201915b5050509183525050601382015460209091019060ff166003811115620011ae57620011ae6200569e565b6003811115620011c257620011c26200569e565b90525092915050565b6000620011da848484620034aa565b6001600160a01b03841660009081526026602090815260408083203384529091528120546200120b90849062005edb565b90506200121a853383620032aa565b506001949350505050565b6018546001600160a01b03163314620012525760405162461bcd60e51b815260040162000e499062005e1b565b6001600160a01b0380841660008181526028602052604090206001015490911614620012925760405162461bcd60e51b815260040162000e499062005d98565b6001600160a01b0383811660009081526028602052604090819020600c0154905163282d3fdf60e01b815284831660048201526024810184905291169063282d3fdf906044015b600060405180830381600087803b158015620012f457600080fd5b505af115801562001309573d6000803e3d6000fd5b50505050505050565b6012546001600160a01b031633146200137a5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e74726044820152621858dd60ea1b606482015260840162000e49565b600061012082013560288262001397604086016020870162005664565b6001600160a01b03166001600160a01b0316815260200190815260200160002060090154620013c7919062005edb565b60c083013560286000620013e2604087016020880162005664565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001412919062005edb565b60a0840135602860006200142d604088016020890162005664565b6001600160a01b03166001600160a01b03168152602001908152602001600020600501546200145d919062005edb565b62001469919062005ef1565b62001475919062005ef1565b6011546001600160a01b0316600090815260276020526040812080549293508392909190620014a690849062005ef1565b9091555082905060286000620014c3604084016020850162005664565b6001600160a01b031681526020810191909152604001600020620014e88282620060fb565b50505050565b6018546001600160a01b031633146200151b5760405162461bcd60e51b815260040162000e499062005e1b565b6001600160a01b03808416600081815260286020526040902060010154909116146200155b5760405162461bcd60e51b815260040162000e499062005d98565b6001600160a01b0383811660009081526028602052604090819020600c01549051637eee288d60e01b8152848316600482015260248101849052911690637eee288d90604401620012d9565b6018546001600160a01b03163314620015d45760405162461bcd60e51b815260040162000e499062005e1b565b6001600160a01b03821660009081526027602052604081208054839290620015fe90849062005ef1565b92505081905550806029600082825462001619919062005ef1565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf906020015b60405180910390a25050565b6018546001600160a01b031633146200168e5760405162461bcd60e51b815260040162000e499062005e1b565b601380546001600160a01b0319166001600160a01b03838116918217909255601454604051637adbf97360e01b8152600481019290925290911690637adbf97390602401600060405180830381600087803b158015620016ed57600080fd5b505af115801562001702573d6000803e3d6000fd5b5050601654604051637adbf97360e01b81526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b1580156200174f57600080fd5b505af115801562001764573d6000803e3d6000fd5b5050505050565b602a546000906060906001600160a01b031633146200179e5760405162461bcd60e51b815260040162000e499062006251565b601e54436000818152601f6020908152604082209390935560195492549092620017c89162005ef1565b6012546040516306c9789b60e41b8152929091146004830181905292506001600160a01b031690636c9789b090602401600060405180830381600087803b1580156200181357600080fd5b505af115801562001828573d6000803e3d6000fd5b5050505080156200191d576200183d620035dc565b62001847620039c6565b6200185162003ab5565b60006200185d62002900565b60135460405163422811f960e11b81529192506001600160a01b03169063845023f2906200189090849060040162005c35565b600060405180830381600087803b158015620018ab57600080fd5b505af1158015620018c0573d6000803e3d6000fd5b50505050436020819055506001601e6000828254620018e0919062005ef1565b9091555050601e546040519081527febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3359060200160405180910390a1505b60135460408051634bb278f360e01b815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af115801562001969573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200198f919062006294565b9050801562001a0b576014546040805163a2e6204560e01b815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af192505050801562001a03575060408051601f3d908101601f1916820190925262001a009181019062006294565b60015b1562001a0b57505b600254602280546040805160208084028201810190925282815260ff9094169391839160009084015b8282101562001b22576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162001a8a9062005e89565b80601f016020809104026020016040519081016040528092919081815260200182805462001ab89062005e89565b801562001b095780601f1062001add5761010080835404028352916020019162001b09565b820191906000526020600020905b81548152906001019060200180831162001aeb57829003601f168201915b5050505050815250508152602001906001019062001a34565b5050505090509350935050509091565b6018546001600160a01b0316331462001b5f5760405162461bcd60e51b815260040162000e499062005e1b565b601880546001600160a01b0319166001600160a01b0383811691821790925560135460405163b3ab15fb60e01b815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b15801562001bbe57600080fd5b505af115801562001bd3573d6000803e3d6000fd5b505060145460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001c1f57600080fd5b505af115801562001c34573d6000803e3d6000fd5b505060155460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001c8057600080fd5b505af115801562001c95573d6000803e3d6000fd5b505060165460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001ce157600080fd5b505af115801562001cf6573d6000803e3d6000fd5b505060175460405163b3ab15fb60e01b81526001600160a01b038581166004830152909116925063b3ab15fb915060240162001734565b600080805b60225481101562001d89576022818154811062001d535762001d53620062b8565b9060005260206000209060030201600101548262001d72919062005ef1565b91508062001d8081620062ce565b91505062001d32565b508060000362001ddc5760405162461bcd60e51b815260206004820152601c60248201527f54686520636f6d6d6974746565206973206e6f74207374616b696e6700000000604482015260640162000e49565b60008362001dec606387620062ea565b62001df8919062005ef1565b905060008160405160200162001e1091815260200190565b60408051601f1981840301815291905280516020909101209050600062001e3884836200631a565b90506000805b60225481101562001ee8576022818154811062001e5f5762001e5f620062b8565b9060005260206000209060030201600101548262001e7e919062005ef1565b915062001e8d60018362005edb565b831162001ed3576022818154811062001eaa5762001eaa620062b8565b60009182526020909120600390910201546001600160a01b0316965062000e0395505050505050565b8062001edf81620062ce565b91505062001e3e565b5060405162461bcd60e51b815260206004820152602960248201527f5468657265206973206e6f2076616c696461746f72206c65667420696e20746860448201526865206e6574776f726b60b81b606482015260840162000e49565b6018546001600160a01b0316331462001f715760405162461bcd60e51b815260040162000e499062005e1b565b60195481101562002028578060205462001f8c919062005ef1565b4310620020285760405162461bcd60e51b815260206004820152605760248201527f63757272656e7420636861696e2068656164206578636565642074686520776960448201527f6e646f773a206c617374426c6f636b45706f6368202b205f6e6577506572696f60648201527f642c2074727920616761696e206c6174746572206f6e2e000000000000000000608482015260a40162000e49565b6019819055601254604051631ad7d11360e21b8152600481018390526001600160a01b0390911690636b5f444c90602401600060405180830381600087803b1580156200207457600080fd5b505af115801562002089573d6000803e3d6000fd5b505050507fd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f8181604051620020bf91815260200190565b60405180910390a150565b6018546001600160a01b03163314620020f75760405162461bcd60e51b815260040162000e499062005e1b565b600d55565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b03168152602001868152602001600d6000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200143815260200160008152602001600081526020016000815260200184815260200160006003811115620021cb57620021cb6200569e565b90529050620021db818362003bd4565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c926200221c92339289918b9162006331565b60405180910390a15050505050565b6001600160a01b03808316600081815260286020526040902060010154909116146200226b5760405162461bcd60e51b815260040162000e499062005d98565b6001600160a01b03828116600090815260286020526040902054163314620022a75760405162461bcd60e51b815260040162000e499062005dcf565b612710811115620022fb5760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e207261746500604482015260640162000e49565b604080516060810182526001600160a01b038481168252436020808401918252838501868152600c80546000908152600a909352958220855181546001600160a01b0319169516949094178455915160018085019190915591516002909301929092558354929390929091906200237490849062005ef1565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6018546001600160a01b03163314620023e95760405162461bcd60e51b815260040162000e499062005e1b565b6002805460ff19166001179055565b6018546001600160a01b03163314620024255760405162461bcd60e51b815260040162000e499062005e1b565b60008111620024775760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e2774206265203000000000000000604482015260640162000e49565b601b55565b6018546001600160a01b03163314620024a95760405162461bcd60e51b815260040162000e499062005e1b565b6001600160a01b0382166000908152602760205260409020548111156200250c5760405162461bcd60e51b8152602060048201526016602482015275416d6f756e7420657863656564732062616c616e636560501b604482015260640162000e49565b6001600160a01b038216600090815260276020526040812080548392906200253690849062005edb565b92505081905550806029600082825462002551919062005edb565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a39060200162001655565b6001600160a01b0380831660008181526028602052604090206001015490911614620025d25760405162461bcd60e51b815260040162000e499062005e52565b6001600160a01b03821660009081526028602052604081206013015460ff1660038111156200260557620026056200569e565b14620026545760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f206265206163746976650000000000604482015260640162000e49565b620026618282336200402e565b5050565b6001600160a01b0380831660008181526028602052604090206001015490911614620026a55760405162461bcd60e51b815260040162000e499062005e52565b60008111620026ef5760405162461bcd60e51b81526020600482015260156024820152740756e626f6e64696e6720616d6f756e74206973203605c1b604482015260640162000e49565b620026618282336200421a565b60606025805480602002602001604051908101604052809291908181526020016000905b82821015620027d6578382906000526020600020018054620027429062005e89565b80601f0160208091040260200160405190810160405280929190818152602001828054620027709062005e89565b8015620027c15780601f106200279557610100808354040283529160200191620027c1565b820191906000526020600020905b815481529060010190602001808311620027a357829003601f168201915b50505050508152602001906001019062002720565b50505050905090565b600062000dff338484620034aa565b60606022805480602002602001604051908101604052809291908181526020016000905b82821015620027d6576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620028689062005e89565b80601f0160208091040260200160405190810160405280929190818152602001828054620028969062005e89565b8015620028e75780601f10620028bb57610100808354040283529160200191620028e7565b820191906000526020600020905b815481529060010190602001808311620028c957829003601f168201915b5050505050815250508152602001906001019062002812565b602a546060906001600160a01b03163314620029305760405162461bcd60e51b815260040162000e499062006251565b601d54620029815760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f72730000000000000000604482015260640162000e49565b6200298b620054ac565b601b546080820152601d8152602860208201526022604082015260216060820152620029b7816200454d565b620029c560256000620054ca565b6022548062002a0c5760405162461bcd60e51b8152602060048201526012602482015271636f6d6d697474656520697320656d70747960701b604482015260640162000e49565b60008167ffffffffffffffff81111562002a2a5762002a2a62005a66565b60405190808252806020026020018201604052801562002a54578160200160208202803683370190505b50905060005b8281101562002b3e576000602860006022848154811062002a7f5762002a7f620062b8565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060258054600181018255925292507f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c019062002ae7908301826200637a565b50600281015483516001600160a01b039091169084908490811062002b105762002b10620062b8565b6001600160a01b0390921660209283029190910190910152508062002b3581620062ce565b91505062002a5a565b509250505090565b6018546001600160a01b0316331462002b735760405162461bcd60e51b815260040162000e499062005e1b565b62002b8060008362004568565b6200266160018262004568565b6018546001600160a01b0316331462002bba5760405162461bcd60e51b815260040162000e499062005e1b565b601580546001600160a01b0319166001600160a01b0392909216919091179055565b6001600160a01b038082166000818152602860205260409020600101549091161462002c1c5760405162461bcd60e51b815260040162000e499062005d98565b6001600160a01b0380821660009081526028602052604090208054909116331462002c5b5760405162461bcd60e51b815260040162000e499062006463565b6000601382015460ff16600381111562002c795762002c796200569e565b0362002cc85760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c7265616479206163746976650000000000000000604482015260640162000e49565b6002601382015460ff16600381111562002ce65762002ce66200569e565b14801562002cf75750438160100154115b1562002d465760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c000000000000000000604482015260640162000e49565b6003601382015460ff16600381111562002d645762002d646200569e565b0362002db35760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c7900000000604482015260640162000e49565b60138101805460ff1916905580546019546020546001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b59162002e03919062005ef1565b60405190815260200160405180910390a35050565b6060806000600181805462002e2d9062005e89565b80601f016020809104026020016040519081016040528092919081815260200182805462002e5b9062005e89565b801562002eac5780601f1062002e805761010080835404028352916020019162002eac565b820191906000526020600020905b81548152906001019060200180831162002e8e57829003601f168201915b5050505050915080805462002ec19062005e89565b80601f016020809104026020016040519081016040528092919081815260200182805462002eef9062005e89565b801562002f405780601f1062002f145761010080835404028352916020019162002f40565b820191906000526020600020905b81548152906001019060200180831162002f2257829003601f168201915b50505050509050915091509091565b6060601d80548060200260200160405190810160405280929190818152602001828054801562002fa957602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162002f8a575b5050505050905090565b6018546001600160a01b0316331462002fe05760405162461bcd60e51b815260040162000e499062005e1b565b600e8190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd38912890602001620020bf565b6018546001600160a01b03163314620030435760405162461bcd60e51b815260040162000e499062005e1b565b601780546001600160a01b0319166001600160a01b0392909216919091179055565b6018546001600160a01b03163314620030925760405162461bcd60e51b815260040162000e499062005e1b565b6200309f600080620054ea565b620030ad60016000620054ea565b6002805460ff19169055565b6018546001600160a01b03163314620030e65760405162461bcd60e51b815260040162000e499062005e1b565b601680546001600160a01b0319166001600160a01b0392909216919091179055565b6018546001600160a01b03163314620031355760405162461bcd60e51b815260040162000e499062005e1b565b601480546001600160a01b0319166001600160a01b0392909216919091179055565b602a546001600160a01b03163314620031845760405162461bcd60e51b815260040162000e499062006251565b6200318e620039c6565b62000e9962002900565b6018546001600160a01b03163314620031c55760405162461bcd60e51b815260040162000e499062005e1b565b601180546001600160a01b0319166001600160a01b0392909216919091179055565b6000620031f482620046ba565b6001600160a01b03808216600081815260286020526040902060010154929350911614620032365760405162461bcd60e51b815260040162000e499062005d98565b6001600160a01b03818116600090815260286020526040902054163314620032725760405162461bcd60e51b815260040162000e499062006463565b6040516001600160a01b038216907f68d46f7ed6d57eea4fc550f4783b3f528ef5f858f9e78b9a7728b1bef0a1b92f90600090a25050565b6001600160a01b0383166200330e5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840162000e49565b6001600160a01b038216620033715760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840162000e49565b6001600160a01b0383811660008181526026602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6001600160a01b038116600090815260286020526040812090601382015460ff1660038111156200340857620034086200569e565b14620034575760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d757374206265206163746976650000000000000000604482015260640162000e49565b60138101805460ff1916600117905580546019546020546001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c9162002e03919062005ef1565b6001600160a01b0383166000908152602760205260409020548111156200350d5760405162461bcd60e51b8152602060048201526016602482015275616d6f756e7420657863656564732062616c616e636560501b604482015260640162000e49565b6001600160a01b038316600090815260276020526040812080548392906200353790849062005edb565b92505081905550806029600082825462003552919062005ef1565b90915550620035659050816002620062ea565b6001600160a01b038316600090815260276020526040812080549091906200358f90849062005ef1565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef83604051620033c691815260200190565b47600003620035e757565b600d544790600090670de0b6b3a76400009062003606908490620062ea565b620036129190620064b2565b9050801562003690576011546040516000916001600160a01b03169083908381818185875af1925050503d80600081146200366a576040519150601f19603f3d011682016040523d82523d6000602084013e6200366f565b606091505b50909150508015156001036200368e576200368b828462005edb565b92505b505b8160236000828254620036a4919062005ef1565b90915550600090505b602254811015620039c15760006028600060228481548110620036d457620036d4620062b8565b600091825260208083206003909202909101546001600160a01b031683528201929092526040018120602154602280549294509091879190869081106200371f576200371f620062b8565b9060005260206000209060030201600101546200373d9190620062ea565b620037499190620064b2565b90508015620039a9576002601383015460ff1660038111156200377057620037706200569e565b14806200379857506003601383015460ff1660038111156200379657620037966200569e565b145b156200384057601254602280546001600160a01b0390921691631de9d9b691849187908110620037cc57620037cc620062b8565b600091825260209091206003909102015460405160e084901b6001600160e01b03191681526001600160a01b0390911660048201526024016000604051808303818588803b1580156200381e57600080fd5b505af115801562003833573d6000803e3d6000fd5b50505050505050620039ac565b60008260050154828460080154620038599190620062ea565b620038659190620064b2565b9050600062003875828462005edb565b90508115620038da5783546040516001600160a01b03909116906108fc9084906000818181858888f193505050503d8060008114620038d1576040519150601f19603f3d011682016040523d82523d6000602084013e620038d6565b606091505b5050505b8015620039625783600c0160009054906101000a90046001600160a01b03166001600160a01b031663fb489a7b826040518263ffffffff1660e01b815260040160206040518083038185885af115801562003939573d6000803e3d6000fd5b50505050506040513d601f19601f82011682018060405250810190620039609190620064c9565b505b60018401546040518481526001600160a01b03909116907fb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe5639060200160405180910390a250505b50505b80620039b881620062ce565b915050620036ad565b505050565b6004545b600554811015620039f357620039ed81620039e581620062ce565b9250620046ec565b620039ca565b506005546004556008546007540362003a0857565b6009545b60085481101562003a355762003a2f8162003a2781620062ce565b925062004913565b62003a0c565b50600854600955600754805b60085481101562003aaf57601054600082815260066020526040902060040154439162003a6e9162005ef1565b1162003a945762003a7f8162004c0e565b62003a8c60018362005ef1565b915062003a9a565b62003aaf565b8062003aa681620062ce565b91505062003a41565b50600755565b600c54600b54101562003bd257600b546000908152600a602052604090206010546001820154439162003ae89162005ef1565b111562003af25750565b600281015481546001600160a01b03908116600090815260286020526040808220600490810185905585548416835291819020600c015490516319fac8fd60e01b81529216926319fac8fd9262003b4d920190815260200190565b600060405180830381600087803b15801562003b6857600080fd5b505af115801562003b7d573d6000803e3d6000fd5b5050600b80546000908152600a6020526040812080546001600160a01b03191681556001808201839055600290910182905582549094509192509062003bc590849062005ef1565b9091555062003ab5915050565b565b60e281511462003c1e5760405162461bcd60e51b8152602060048201526014602482015273092dcecc2d8d2c840e0e4dedecc40d8cadccee8d60631b604482015260640162000e49565b6030826102400151511462003c765760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e67746800000000604482015260640162000e49565b62003c818262004d59565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a00000000000060208083019190915284519251919260009262003ce4920160609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905060008262003d04835162004e86565b8360405160200162003d1993929190620064e3565b60408051601f198184030181528282528051602091820120600280855260608501845290945060009392909183019080368337019050509050600080808062003d71898262003d6b60416002620062ea565b62004fa7565b9050600062003d908a62003d8860416002620062ea565b606062004fa7565b905060205b825181101562003e615762003dab8382620050c0565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa15801562003e07573d6000803e3d6000fd5b5050604051601f19015190508762003e21604184620064b2565b8151811062003e345762003e34620062b8565b6001600160a01b039092166020928302919091019091015262003e5960418262005ef1565b905062003d95565b508a602001516001600160a01b03168660008151811062003e865762003e86620062b8565b60200260200101516001600160a01b03161462003ef85760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f66604482015268081c1c9bdd9a59195960ba1b606482015260840162000e49565b8a604001516001600160a01b03168660018151811062003f1c5762003f1c620062b8565b60200260200101516001600160a01b03161462003f905760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201526a1bd9881c1c9bdd9a59195960aa1b606482015260840162000e49565b600162003fa88c6102400151838e60000151620050f7565b14620040165760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e65727368697020604482015275383937b7b3103337b9103932b3b4b9ba3930ba34b7b760511b606482015260840162000e49565b620040218b62005166565b5050505050505050505050565b600082116200408c5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000e49565b6001600160a01b038116600090815260276020526040902054821115620040f65760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000e49565b6001600160a01b038116600090815260276020526040812080548492906200412090849062005edb565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b0319918216178255955160018201805491909816961695909517909555905160028401555191015580549192620041ac83620062ce565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d91015b60405180910390a35050505050565b6001600160a01b03808416600090815260286020526040902080549091838116911614806200439057600c820154604051631092ab9160e31b81526001600160a01b03858116600483015260009216906384955c8890602401602060405180830381865afa15801562004291573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620042b79190620064c9565b9050848110156200431f5760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201526a746f6e2062616c616e636560a81b606482015260840162000e49565b600c83015460405163282d3fdf60e01b81526001600160a01b038681166004830152602482018890529091169063282d3fdf90604401600060405180830381600087803b1580156200437057600080fd5b505af115801562004385573d6000803e3d6000fd5b505050505062004423565b8382600b01548360080154620043a7919062005edb565b1015620044075760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201526662616c616e636560c81b606482015260840162000e49565b8382600b0160008282546200441d919062005ef1565b90915550505b6040805160e0810182526001600160a01b0380861682528781166020808401918252838501898152600060608601818152436080880190815260a088018381528a151560c08a019081526008805486526006909752998420985189549089166001600160a01b0319918216178a55965160018a01805491909916971696909617909655915160028701559051600386015592516004850155905160059093018054945115156101000261ff00199415159490941661ffff199095169490941792909217909255805491620044f783620062ce565b9190505550826001600160a01b0316856001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc83876040516200420b9291909115158252602082015260400190565b60fa60a06000808285855af4620039c1573d6000803e3d6000fd5b8154600260018083161561010002038216048251808201602081106020841001600281146200461757600181146200463d578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b80821015620046005781518455600184019350602082019150620045e1565b815191036101000a90819004029091555062001309565b60028302826020036101000a846020036101000a60208901510402018501875562001309565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b80821015620046a0578151845560018401935060208201915062004681565b815191036101000a90819004029091555050505050505050565b6000620046c662005529565b600060f890506020828551602087016000855af1620046e457600080fd5b505192915050565b600081815260036020908152604080832060018101546001600160a01b03168452602890925282209091601382015460ff1660038111156200473257620047326200569e565b14620047d257600282015482546001600160a01b0316600090815260276020526040812080549091906200476890849062005ef1565b909155505081546001830154600284015460138401546040517f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f87834294620047c5946001600160a01b0391821694911692909160ff909116906200652c565b60405180910390a1505050565b805482546001600160a01b03908116911614620048cf576000808260080154836005015462004802919062005edb565b9050806000036200481a578360020154915062004840565b80846002015484600d0154620048319190620062ea565b6200483d9190620064b2565b91505b600c83015484546040516340c10f1960e01b81526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b1580156200489257600080fd5b505af1158015620048a7573d6000803e3d6000fd5b505050508183600d016000828254620048c1919062005ef1565b90915550620048ef92505050565b8160020154816008016000828254620048e9919062005ef1565b90915550505b816002015481600501600082825462004909919062005ef1565b9091555050505050565b600081815260066020908152604080832060018101546001600160a01b0316845260289092528220600582015491929091610100900460ff1662004b07576002830154600c8301548454604051637eee288d60e01b81526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b158015620049a857600080fd5b505af1158015620049bd573d6000803e3d6000fd5b50505050600c8301548454604051632770a7eb60e21b81526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b15801562004a1357600080fd5b505af115801562004a28573d6000803e3d6000fd5b5050505060008360080154846005015462004a44919062005edb565b600d85015490915062004a588284620062ea565b62004a649190620064b2565b92508184600d01600082825462004a7c919062005edb565b9091555050600684015460000362004a9b576003850183905562004ac3565b6006840154600785015462004ab19085620062ea565b62004abd9190620064b2565b60038601555b8284600601600082825462004ad9919062005ef1565b9091555050600385015460078501805460009062004af990849062005ef1565b9091555062004bdc92505050565b506002820154600882015481111562004b21575060088101545b816009015460000362004b3b576003830181905562004b63565b6009820154600a83015462004b519083620062ea565b62004b5d9190620064b2565b60038401555b8082600901600082825462004b79919062005ef1565b90915550506003830154600a8301805460009062004b9990849062005ef1565b925050819055508082600801600082825462004bb6919062005edb565b90915550506002830154600b8301805460009062004bd690849062005edb565b90915550505b6005808401805460ff191660011790558201805482919060009062004c0390849062005edb565b909155505050505050565b6000818152600660205260408120600381015490910362004c2d575050565b60018101546001600160a01b031660009081526028602052604081206005830154909190610100900460ff1662004cc95781600701548260060154846003015462004c799190620062ea565b62004c859190620064b2565b90508082600601600082825462004c9d919062005edb565b9091555050600383015460078301805460009062004cbd90849062005edb565b9091555062004d2e9050565b81600a01548260090154846003015462004ce49190620062ea565b62004cf09190620064b2565b90508082600901600082825462004d08919062005edb565b90915550506003830154600a8301805460009062004d2890849062005edb565b90915550505b82546001600160a01b03166000908152602760205260408120805483929062004c0390849062005ef1565b600062004d6a826060015162005397565b6001600160a01b0390911660208401529050801562004dba5760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b604482015260640162000e49565b6020808301516001600160a01b0390811660009081526028909252604090912060010154161562004e2e5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000e49565b61271082608001511115620026615760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000e49565b60608160000362004eae5750506040805180820190915260018152600360fc1b602082015290565b8160005b811562004ede578062004ec581620062ce565b915062004ed69050600a83620064b2565b915062004eb2565b60008167ffffffffffffffff81111562004efc5762004efc62005a66565b6040519080825280601f01601f19166020018201604052801562004f27576020820181803683370190505b5090505b841562004f9f5762004f3f60018362005edb565b915062004f4e600a866200631a565b62004f5b90603062005ef1565b60f81b81838151811062004f735762004f73620062b8565b60200101906001600160f81b031916908160001a90535062004f97600a86620064b2565b945062004f2b565b949350505050565b60608162004fb781601f62005ef1565b101562004ff85760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b604482015260640162000e49565b62005004828462005ef1565b845110156200504a5760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b604482015260640162000e49565b6060821580156200506b5760405191506000825260208201604052620050b7565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015620050a65780518352602092830192016200508c565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b811015620050f057620050ed601b826200655a565b90505b9250925092565b60006200510362005529565b60008585856040516020016200511c9392919062006576565b6040516020818303038152906040529050600060fb905060008251602062005145919062005ef1565b90506020848285855afa6200515957600080fd5b5050905195945050505050565b6101808101516001600160a01b0316620051e957601d546000906200518b9062004e86565b905081602001518260000151836080015183604051620051ab9062005547565b620051ba9493929190620065c5565b604051809103906000f080158015620051d7573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b03958616179091558451841683526028909552604091829020865181548716908516178155935190840180548616918416919091179055840151600283018054909416911617909155606082015182919060038201906200529b908262006604565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062005365908262006604565b5061026082015160138201805460ff191660018360038111156200538d576200538d6200569e565b0217905550505050565b600080620053a462005555565b60008060ff9050604083875160208901845afa620053c157600080fd5b50508051602090910151600160601b90910494909350915050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b0316815260200160008152602001600081526020016000815260200160008152602001600081526020016060815260200160006003811115620054a757620054a76200569e565b905290565b6040518060a001604052806005906020820280368337509192915050565b508054600082559060005260206000209081019062000e99919062005573565b508054620054f89062005e89565b6000825580601f1062005509575050565b601f01602090049060005260206000209081019062000e99919062005598565b60405180602001604052806001906020820280368337509192915050565b61153180620066c583390190565b60405180604001604052806002906020820280368337509192915050565b80821115620055945760006200558a8282620054ea565b5060010162005573565b5090565b5b8082111562005594576000815560010162005599565b60005b83811015620055cc578181015183820152602001620055b2565b50506000910152565b60008151808452620055ef816020860160208601620055af565b601f01601f19169290920160200192915050565b602081526000620056186020830184620055d5565b9392505050565b6001600160a01b038116811462000e9957600080fd5b600080604083850312156200564957600080fd5b823562005656816200561f565b946020939093013593505050565b6000602082840312156200567757600080fd5b813562005618816200561f565b6000602082840312156200569757600080fd5b5035919050565b634e487b7160e01b600052602160045260246000fd5b60048110620056d357634e487b7160e01b600052602160045260246000fd5b9052565b60208152620056f26020820183516001600160a01b03169052565b600060208301516200570f60408401826001600160a01b03169052565b5060408301516001600160a01b0381166060840152506060830151610280806080850152620057436102a0850183620055d5565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a0620057cb818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f1901610260808801919091529091506200582f8483620055d5565b9350808701519150506200584682860182620056b4565b5090949350505050565b6000806000606084860312156200586657600080fd5b833562005873816200561f565b9250602084013562005885816200561f565b929592945050506040919091013590565b600060208284031215620058a957600080fd5b813567ffffffffffffffff811115620058c157600080fd5b820161028081850312156200561857600080fd5b600081518084526020808501808196508360051b8101915082860160005b858110156200594a578284038952815180516001600160a01b0316855285810151868601526040908101516060918601829052906200593581870183620055d5565b9a87019a9550505090840190600101620058f3565b5091979650505050505050565b821515815260406020820152600062004f9f6040830184620058d5565b600080604083850312156200598857600080fd5b50508035926020909101359150565b845181526020808601518183015260408087015181840152606080880151818501526080808901516001600160a01b03908116828701528851811660a08088019190915294890151811660c087015292880151831660e08601529087015182166101008501528601511661012083015284015161020082019062005a276101408401826001600160a01b03169052565b5083516001600160a01b0316610160830152602084015161018083015260408401516101a08301526060909301516101c08201526101e0015292915050565b634e487b7160e01b600052604160045260246000fd5b600082601f83011262005a8e57600080fd5b813567ffffffffffffffff8082111562005aac5762005aac62005a66565b604051601f8301601f19908116603f0116810190828211818310171562005ad75762005ad762005a66565b8160405283815286602085880101111562005af157600080fd5b836020870160208301376000602085830101528094505050505092915050565b6000806000806080858703121562005b2857600080fd5b843567ffffffffffffffff8082111562005b4157600080fd5b62005b4f8883890162005a7c565b95506020870135915062005b63826200561f565b9093506040860135908082111562005b7a57600080fd5b62005b888883890162005a7c565b9350606087013591508082111562005b9f57600080fd5b5062005bae8782880162005a7c565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101562005c1357603f1988860301845262005c00858351620055d5565b9450928501929085019060010162005be1565b5092979650505050505050565b602081526000620056186020830184620058d5565b6020808252825182820181905260009190848201906040850190845b8181101562005c785783516001600160a01b03168352928401929184019160010162005c51565b50909695505050505050565b6000806040838503121562005c9857600080fd5b823567ffffffffffffffff8082111562005cb157600080fd5b62005cbf8683870162005a7c565b9350602085013591508082111562005cd657600080fd5b5062005ce58582860162005a7c565b9150509250929050565b60408152600062005d046040830185620055d5565b828103602084015262005d188185620055d5565b95945050505050565b60006020828403121562005d3457600080fd5b813567ffffffffffffffff81111562005d4c57600080fd5b62004f9f8482850162005a7c565b6000806040838503121562005d6e57600080fd5b823562005d7b816200561f565b9150602083013562005d8d816200561f565b809150509250929050565b6020808252601c908201527f76616c696461746f72206d757374206265207265676973746572656400000000604082015260600190565b6020808252602c908201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160408201526b191b5a5b881858d8dbdd5b9d60a21b606082015260800190565b6020808252601a908201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604082015260600190565b60208082526018908201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604082015260600190565b600181811c9082168062005e9e57607f821691505b60208210810362005ebf57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052601160045260246000fd5b8181038181111562000e035762000e0362005ec5565b8082018082111562000e035762000e0362005ec5565b6000813562000e03816200561f565b80546001600160a01b0319166001600160a01b0392909216919091179055565b6000808335601e1984360301811262005f4e57600080fd5b83018035915067ffffffffffffffff82111562005f6a57600080fd5b60200191503681900382131562005f8057600080fd5b9250929050565b601f821115620039c157600081815260208120601f850160051c8101602086101562005fb05750805b601f850160051c820191505b8181101562005fd15782815560010162005fbc565b505050505050565b600019600383901b1c191660019190911b1790565b67ffffffffffffffff83111562006009576200600962005a66565b62006021836200601a835462005e89565b8362005f87565b6000601f8411600181146200605457600085156200603f5750838201355b6200604b868262005fd9565b84555062001764565b600083815260209020601f19861690835b8281101562006087578685013582556020948501946001909201910162006065565b5086821015620060a55760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b600081356004811062000e0357600080fd5b60048210620060e857634e487b7160e01b600052602160045260246000fd5b60ff1981541660ff831681178255505050565b620061116200610a8362005f07565b8262005f16565b6200612d620061236020840162005f07565b6001830162005f16565b620061496200613f6040840162005f07565b6002830162005f16565b62006158606083018362005f36565b6200616881836003860162005fee565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b820155620061db620061d1610180840162005f07565b600c830162005f16565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f820155610200820135601082015561022082013560118201556200622261024083018362005f36565b6200623281836012860162005fee565b505062002661620062476102608401620060b7565b60138301620060c9565b60208082526023908201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60408201526218dbdb60ea1b606082015260800190565b600060208284031215620062a757600080fd5b815180151581146200561857600080fd5b634e487b7160e01b600052603260045260246000fd5b600060018201620062e357620062e362005ec5565b5060010190565b808202811582820484141762000e035762000e0362005ec5565b634e487b7160e01b600052601260045260246000fd5b6000826200632c576200632c62006304565b500690565b600060018060a01b0380881683528087166020840152808616604084015260a060608401526200636560a0840186620055d5565b91508084166080840152509695505050505050565b81810362006386575050565b62006392825462005e89565b67ffffffffffffffff811115620063ad57620063ad62005a66565b620063c581620063be845462005e89565b8462005f87565b6000601f821160018114620063f85760008315620063e35750848201545b620063ef848262005fd9565b85555062001764565b600085815260209020601f19841690600086815260209020845b8381101562006434578286015482556001958601959091019060200162006412565b5085831015620064535781850154600019600388901b60f8161c191681555b5050505050600190811b01905550565b6020808252602f908201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460408201526e1c99585cdd5c9e481858d8dbdd5b9d608a1b606082015260800190565b600082620064c457620064c462006304565b500490565b600060208284031215620064dc57600080fd5b5051919050565b60008451620064f7818460208901620055af565b8451908301906200650d818360208901620055af565b845191019062006522818360208801620055af565b0195945050505050565b6001600160a01b03858116825284166020820152604081018390526080810162005d186060830184620056b4565b60ff818116838216019081111562000e035762000e0362005ec5565b600084516200658a818460208901620055af565b845190830190620065a0818360208901620055af565b60609490941b6bffffffffffffffffffffffff19169301928352505060140192915050565b6001600160a01b0385811682528416602082015260408101839052608060608201819052600090620065fa90830184620055d5565b9695505050505050565b815167ffffffffffffffff81111562006621576200662162005a66565b6200663281620063be845462005e89565b602080601f831160018114620066665760008415620066515750858301515b6200665d858262005fd9565b86555062005fd1565b600085815260208120601f198616915b82811015620066975788860151825594840194600190910190840162006676565b50858210156200645357939096015160001960f8600387901b161c19169092555050600190811b0190555056fe60806040523480156200001157600080fd5b506040516200153138038062001531833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61115980620003d86000396000f3fe60806040526004361061014b5760003560e01c806359355736116100b6578063949813b81161006f578063949813b8146103c557806395d89b41146103e55780639dc29fac146103fa578063a9059cbb1461041a578063dd62ed3e1461043a578063fb489a7b1461048057600080fd5b806359355736146102e35780635ea1d6f81461031957806361d027b31461032f57806370a082311461034f5780637eee288d1461038557806384955c88146103a557600080fd5b8063282d3fdf11610108578063282d3fdf146102245780632f2c3f2e14610244578063313ce5671461025a578063372500ab146102765780633a5381b51461028b57806340c10f19146102c357600080fd5b806306fdde0314610150578063095ea7b31461017b57806318160ddd146101ab578063187cf4d7146101ca57806319fac8fd146101e257806323b872dd14610204575b600080fd5b34801561015c57600080fd5b50610165610488565b6040516101729190610ece565b60405180910390f35b34801561018757600080fd5b5061019b610196366004610f38565b61051a565b6040519015158152602001610172565b3480156101b757600080fd5b506004545b604051908152602001610172565b3480156101d657600080fd5b506101bc633b9aca0081565b3480156101ee57600080fd5b506102026101fd366004610f62565b610531565b005b34801561021057600080fd5b5061019b61021f366004610f7b565b610569565b34801561023057600080fd5b5061020261023f366004610f38565b61065c565b34801561025057600080fd5b506101bc61271081565b34801561026657600080fd5b5060405160128152602001610172565b34801561028257600080fd5b50610202610741565b34801561029757600080fd5b50600a546102ab906001600160a01b031681565b6040516001600160a01b039091168152602001610172565b3480156102cf57600080fd5b506102026102de366004610f38565b6107ef565b3480156102ef57600080fd5b506101bc6102fe366004610fb7565b6001600160a01b031660009081526002602052604090205490565b34801561032557600080fd5b506101bc600c5481565b34801561033b57600080fd5b50600b546102ab906001600160a01b031681565b34801561035b57600080fd5b506101bc61036a366004610fb7565b6001600160a01b031660009081526001602052604090205490565b34801561039157600080fd5b506102026103a0366004610f38565b610857565b3480156103b157600080fd5b506101bc6103c0366004610fb7565b61091d565b3480156103d157600080fd5b506101bc6103e0366004610fb7565b61094b565b3480156103f157600080fd5b50610165610979565b34801561040657600080fd5b50610202610415366004610f38565b610988565b34801561042657600080fd5b5061019b610435366004610f38565b6109e8565b34801561044657600080fd5b506101bc610455366004610fd9565b6001600160a01b03918216600090815260036020908152604080832093909416825291909152205490565b6101bc610a35565b6060600880546104979061100c565b80601f01602080910402602001604051908101604052809291908181526020018280546104c39061100c565b80156105105780601f106104e557610100808354040283529160200191610510565b820191906000526020600020905b8154815290600101906020018083116104f357829003601f168201915b5050505050905090565b6000610527338484610b9d565b5060015b92915050565b6000546001600160a01b031633146105645760405162461bcd60e51b815260040161055b90611046565b60405180910390fd5b600c55565b6001600160a01b0383166000908152600360209081526040808320338452909152812054828110156105ee5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b606482015260840161055b565b61060285336105fd86856110a4565b610b9d565b61060c8584610cc1565b6106168484610da9565b836001600160a01b0316856001600160a01b03166000805160206111048339815191528560405161064991815260200190565b60405180910390a3506001949350505050565b6000546001600160a01b031633146106865760405162461bcd60e51b815260040161055b90611046565b6001600160a01b03821660009081526002602090815260408083205460019092529091205482916106b6916110a4565b10156107105760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110b7565b90915550505050565b600061074c33610df4565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461079e576040519150601f19603f3d011682016040523d82523d6000602084013e6107a3565b606091505b50509050806107eb5760405162461bcd60e51b81526020600482015260146024820152732330b4b632b2103a379039b2b7321022ba3432b960611b604482015260640161055b565b5050565b6000546001600160a01b031633146108195760405162461bcd60e51b815260040161055b90611046565b6108238282610da9565b6040518181526001600160a01b03831690600090600080516020611104833981519152906020015b60405180910390a35050565b6000546001600160a01b031633146108815760405162461bcd60e51b815260040161055b90611046565b6001600160a01b0382166000908152600260205260409020548111156108f55760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110a4565b6001600160a01b038116600090815260026020908152604080832054600190925282205461052b91906110a4565b600061095682610e59565b6001600160a01b03831660009081526005602052604090205461052b91906110b7565b6060600980546104979061100c565b6000546001600160a01b031633146109b25760405162461bcd60e51b815260040161055b90611046565b6109bc8282610cc1565b6040518181526000906001600160a01b038416906000805160206111048339815191529060200161084b565b60006109f43383610cc1565b6109fe8383610da9565b6040518281526001600160a01b0384169033906000805160206111048339815191529060200160405180910390a350600192915050565b600080546001600160a01b03163314610a605760405162461bcd60e51b815260040161055b90611046565b600c54349060009061271090610a7690846110ca565b610a8091906110e1565b905081811115610ad25760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f72207265776172640000000000000000604482015260640161055b565b610adc81836110a4565b600b546040519193506001600160a01b0316906108fc9083906000818181858888f193505050503d8060008114610b2f576040519150601f19603f3d011682016040523d82523d6000602084013e610b34565b606091505b505060045460009150610b4b633b9aca00856110ca565b610b5591906110e1565b905080600754610b6591906110b7565b600755600454600090633b9aca0090610b7e90846110ca565b610b8891906110e1565b9050610b9481846110b7565b94505050505090565b6001600160a01b038316610bff5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840161055b565b6001600160a01b038216610c605760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840161055b565b6001600160a01b0383811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b610cca82610df4565b506001600160a01b038216600090815260016020908152604080832054600290925290912054610cfa90826110a4565b821115610d495760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e64730000000000604482015260640161055b565b610d5382826110a4565b6001600160a01b038416600090815260016020526040902055808203610d8d576001600160a01b0383166000908152600660205260408120555b8160046000828254610d9f91906110a4565b9091555050505050565b610db282610df4565b506001600160a01b03821660009081526001602052604081208054839290610ddb9084906110b7565b92505081905550806004600082825461073891906110b7565b600080610e0083610e59565b6001600160a01b038416600090815260056020526040902054909150610e279082906110b7565b6001600160a01b0390931660009081526005602090815260408083208690556007546006909252909120555090919050565b6001600160a01b038116600090815260016020526040812054808203610e825750600092915050565b6001600160a01b038316600090815260066020526040812054600754610ea891906110a4565b90506000633b9aca00610ebb84846110ca565b610ec591906110e1565b95945050505050565b600060208083528351808285015260005b81811015610efb57858101830151858201604001528201610edf565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b0381168114610f3357600080fd5b919050565b60008060408385031215610f4b57600080fd5b610f5483610f1c565b946020939093013593505050565b600060208284031215610f7457600080fd5b5035919050565b600080600060608486031215610f9057600080fd5b610f9984610f1c565b9250610fa760208501610f1c565b9150604084013590509250925092565b600060208284031215610fc957600080fd5b610fd282610f1c565b9392505050565b60008060408385031215610fec57600080fd5b610ff583610f1c565b915061100360208401610f1c565b90509250929050565b600181811c9082168061102057607f821691505b60208210810361104057634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561052b5761052b61108e565b8082018082111561052b5761052b61108e565b808202811582820484141761052b5761052b61108e565b6000826110fe57634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa264697066735822122017fd2bd0de854f2773d08a39cedadcc044801e593b5b7f2ef205669d0552eb2964736f6c63430008150033a26469706673582212204d7a6c27c695716af00fba4555dc2ee18cfa0eea0f77ab88fe6f51fe99321c7864736f6c6343000815003360806040523480156200001157600080fd5b506040516200153138038062001531833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61115980620003d86000396000f3fe60806040526004361061014b5760003560e01c806359355736116100b6578063949813b81161006f578063949813b8146103c557806395d89b41146103e55780639dc29fac146103fa578063a9059cbb1461041a578063dd62ed3e1461043a578063fb489a7b1461048057600080fd5b806359355736146102e35780635ea1d6f81461031957806361d027b31461032f57806370a082311461034f5780637eee288d1461038557806384955c88146103a557600080fd5b8063282d3fdf11610108578063282d3fdf146102245780632f2c3f2e14610244578063313ce5671461025a578063372500ab146102765780633a5381b51461028b57806340c10f19146102c357600080fd5b806306fdde0314610150578063095ea7b31461017b57806318160ddd146101ab578063187cf4d7146101ca57806319fac8fd146101e257806323b872dd14610204575b600080fd5b34801561015c57600080fd5b50610165610488565b6040516101729190610ece565b60405180910390f35b34801561018757600080fd5b5061019b610196366004610f38565b61051a565b6040519015158152602001610172565b3480156101b757600080fd5b506004545b604051908152602001610172565b3480156101d657600080fd5b506101bc633b9aca0081565b3480156101ee57600080fd5b506102026101fd366004610f62565b610531565b005b34801561021057600080fd5b5061019b61021f366004610f7b565b610569565b34801561023057600080fd5b5061020261023f366004610f38565b61065c565b34801561025057600080fd5b506101bc61271081565b34801561026657600080fd5b5060405160128152602001610172565b34801561028257600080fd5b50610202610741565b34801561029757600080fd5b50600a546102ab906001600160a01b031681565b6040516001600160a01b039091168152602001610172565b3480156102cf57600080fd5b506102026102de366004610f38565b6107ef565b3480156102ef57600080fd5b506101bc6102fe366004610fb7565b6001600160a01b031660009081526002602052604090205490565b34801561032557600080fd5b506101bc600c5481565b34801561033b57600080fd5b50600b546102ab906001600160a01b031681565b34801561035b57600080fd5b506101bc61036a366004610fb7565b6001600160a01b031660009081526001602052604090205490565b34801561039157600080fd5b506102026103a0366004610f38565b610857565b3480156103b157600080fd5b506101bc6103c0366004610fb7565b61091d565b3480156103d157600080fd5b506101bc6103e0366004610fb7565b61094b565b3480156103f157600080fd5b50610165610979565b34801561040657600080fd5b50610202610415366004610f38565b610988565b34801561042657600080fd5b5061019b610435366004610f38565b6109e8565b34801561044657600080fd5b506101bc610455366004610fd9565b6001600160a01b03918216600090815260036020908152604080832093909416825291909152205490565b6101bc610a35565b6060600880546104979061100c565b80601f01602080910402602001604051908101604052809291908181526020018280546104c39061100c565b80156105105780601f106104e557610100808354040283529160200191610510565b820191906000526020600020905b8154815290600101906020018083116104f357829003601f168201915b5050505050905090565b6000610527338484610b9d565b5060015b92915050565b6000546001600160a01b031633146105645760405162461bcd60e51b815260040161055b90611046565b60405180910390fd5b600c55565b6001600160a01b0383166000908152600360209081526040808320338452909152812054828110156105ee5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b606482015260840161055b565b61060285336105fd86856110a4565b610b9d565b61060c8584610cc1565b6106168484610da9565b836001600160a01b0316856001600160a01b03166000805160206111048339815191528560405161064991815260200190565b60405180910390a3506001949350505050565b6000546001600160a01b031633146106865760405162461bcd60e51b815260040161055b90611046565b6001600160a01b03821660009081526002602090815260408083205460019092529091205482916106b6916110a4565b10156107105760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110b7565b90915550505050565b600061074c33610df4565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461079e576040519150601f19603f3d011682016040523d82523d6000602084013e6107a3565b606091505b50509050806107eb5760405162461bcd60e51b81526020600482015260146024820152732330b4b632b2103a379039b2b7321022ba3432b960611b604482015260640161055b565b5050565b6000546001600160a01b031633146108195760405162461bcd60e51b815260040161055b90611046565b6108238282610da9565b6040518181526001600160a01b03831690600090600080516020611104833981519152906020015b60405180910390a35050565b6000546001600160a01b031633146108815760405162461bcd60e51b815260040161055b90611046565b6001600160a01b0382166000908152600260205260409020548111156108f55760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110a4565b6001600160a01b038116600090815260026020908152604080832054600190925282205461052b91906110a4565b600061095682610e59565b6001600160a01b03831660009081526005602052604090205461052b91906110b7565b6060600980546104979061100c565b6000546001600160a01b031633146109b25760405162461bcd60e51b815260040161055b90611046565b6109bc8282610cc1565b6040518181526000906001600160a01b038416906000805160206111048339815191529060200161084b565b60006109f43383610cc1565b6109fe8383610da9565b6040518281526001600160a01b0384169033906000805160206111048339815191529060200160405180910390a350600192915050565b600080546001600160a01b03163314610a605760405162461bcd60e51b815260040161055b90611046565b600c54349060009061271090610a7690846110ca565b610a8091906110e1565b905081811115610ad25760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f72207265776172640000000000000000604482015260640161055b565b610adc81836110a4565b600b546040519193506001600160a01b0316906108fc9083906000818181858888f193505050503d8060008114610b2f576040519150601f19603f3d011682016040523d82523d6000602084013e610b34565b606091505b505060045460009150610b4b633b9aca00856110ca565b610b5591906110e1565b905080600754610b6591906110b7565b600755600454600090633b9aca0090610b7e90846110ca565b610b8891906110e1565b9050610b9481846110b7565b94505050505090565b6001600160a01b038316610bff5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840161055b565b6001600160a01b038216610c605760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840161055b565b6001600160a01b0383811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b610cca82610df4565b506001600160a01b038216600090815260016020908152604080832054600290925290912054610cfa90826110a4565b821115610d495760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e64730000000000604482015260640161055b565b610d5382826110a4565b6001600160a01b038416600090815260016020526040902055808203610d8d576001600160a01b0383166000908152600660205260408120555b8160046000828254610d9f91906110a4565b9091555050505050565b610db282610df4565b506001600160a01b03821660009081526001602052604081208054839290610ddb9084906110b7565b92505081905550806004600082825461073891906110b7565b600080610e0083610e59565b6001600160a01b038416600090815260056020526040902054909150610e279082906110b7565b6001600160a01b0390931660009081526005602090815260408083208690556007546006909252909120555090919050565b6001600160a01b038116600090815260016020526040812054808203610e825750600092915050565b6001600160a01b038316600090815260066020526040812054600754610ea891906110a4565b90506000633b9aca00610ebb84846110ca565b610ec591906110e1565b95945050505050565b600060208083528351808285015260005b81811015610efb57858101830151858201604001528201610edf565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b0381168114610f3357600080fd5b919050565b60008060408385031215610f4b57600080fd5b610f5483610f1c565b946020939093013593505050565b600060208284031215610f7457600080fd5b5035919050565b600080600060608486031215610f9057600080fd5b610f9984610f1c565b9250610fa760208501610f1c565b9150604084013590509250925092565b600060208284031215610fc957600080fd5b610fd282610f1c565b9392505050565b60008060408385031215610fec57600080fd5b610ff583610f1c565b915061100360208401610f1c565b90509250929050565b600181811c9082168061102057607f821691505b60208210810361104057634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561052b5761052b61108e565b8082018082111561052b5761052b61108e565b808202811582820484141761052b5761052b61108e565b6000826110fe57634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa264697066735822122017fd2bd0de854f2773d08a39cedadcc044801e593b5b7f2ef205669d0552eb2964736f6c63430008150033",
 }
 
 // AutonityUpgradeTestABI is the input ABI used to generate the binding from.
@@ -7700,6 +7944,27 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestTransactorSession) FinalizeInitia
 	return _AutonityUpgradeTest.Contract.FinalizeInitialization(&_AutonityUpgradeTest.TransactOpts)
 }
 
+// LockLiquid is a paid mutator transaction binding the contract method 0x2a400bc3.
+//
+// Solidity: function lockLiquid(address _validator, address _delegator, uint256 _amount) returns()
+func (_AutonityUpgradeTest *AutonityUpgradeTestTransactor) LockLiquid(opts *bind.TransactOpts, _validator common.Address, _delegator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _AutonityUpgradeTest.contract.Transact(opts, "lockLiquid", _validator, _delegator, _amount)
+}
+
+// LockLiquid is a paid mutator transaction binding the contract method 0x2a400bc3.
+//
+// Solidity: function lockLiquid(address _validator, address _delegator, uint256 _amount) returns()
+func (_AutonityUpgradeTest *AutonityUpgradeTestSession) LockLiquid(_validator common.Address, _delegator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _AutonityUpgradeTest.Contract.LockLiquid(&_AutonityUpgradeTest.TransactOpts, _validator, _delegator, _amount)
+}
+
+// LockLiquid is a paid mutator transaction binding the contract method 0x2a400bc3.
+//
+// Solidity: function lockLiquid(address _validator, address _delegator, uint256 _amount) returns()
+func (_AutonityUpgradeTest *AutonityUpgradeTestTransactorSession) LockLiquid(_validator common.Address, _delegator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _AutonityUpgradeTest.Contract.LockLiquid(&_AutonityUpgradeTest.TransactOpts, _validator, _delegator, _amount)
+}
+
 // Mint is a paid mutator transaction binding the contract method 0x40c10f19.
 //
 // Solidity: function mint(address _addr, uint256 _amount) returns()
@@ -8120,6 +8385,48 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestTransactorSession) Unbond(_valida
 	return _AutonityUpgradeTest.Contract.Unbond(&_AutonityUpgradeTest.TransactOpts, _validator, _amount)
 }
 
+// UnlockLiquid is a paid mutator transaction binding the contract method 0x39b17fec.
+//
+// Solidity: function unlockLiquid(address _validator, address _delegator, uint256 _amount) returns()
+func (_AutonityUpgradeTest *AutonityUpgradeTestTransactor) UnlockLiquid(opts *bind.TransactOpts, _validator common.Address, _delegator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _AutonityUpgradeTest.contract.Transact(opts, "unlockLiquid", _validator, _delegator, _amount)
+}
+
+// UnlockLiquid is a paid mutator transaction binding the contract method 0x39b17fec.
+//
+// Solidity: function unlockLiquid(address _validator, address _delegator, uint256 _amount) returns()
+func (_AutonityUpgradeTest *AutonityUpgradeTestSession) UnlockLiquid(_validator common.Address, _delegator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _AutonityUpgradeTest.Contract.UnlockLiquid(&_AutonityUpgradeTest.TransactOpts, _validator, _delegator, _amount)
+}
+
+// UnlockLiquid is a paid mutator transaction binding the contract method 0x39b17fec.
+//
+// Solidity: function unlockLiquid(address _validator, address _delegator, uint256 _amount) returns()
+func (_AutonityUpgradeTest *AutonityUpgradeTestTransactorSession) UnlockLiquid(_validator common.Address, _delegator common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _AutonityUpgradeTest.Contract.UnlockLiquid(&_AutonityUpgradeTest.TransactOpts, _validator, _delegator, _amount)
+}
+
+// UpdateConsensusEndpoints is a paid mutator transaction binding the contract method 0xda45591b.
+//
+// Solidity: function updateConsensusEndpoints(bytes _record) returns()
+func (_AutonityUpgradeTest *AutonityUpgradeTestTransactor) UpdateConsensusEndpoints(opts *bind.TransactOpts, _record []byte) (*types.Transaction, error) {
+	return _AutonityUpgradeTest.contract.Transact(opts, "updateConsensusEndpoints", _record)
+}
+
+// UpdateConsensusEndpoints is a paid mutator transaction binding the contract method 0xda45591b.
+//
+// Solidity: function updateConsensusEndpoints(bytes _record) returns()
+func (_AutonityUpgradeTest *AutonityUpgradeTestSession) UpdateConsensusEndpoints(_record []byte) (*types.Transaction, error) {
+	return _AutonityUpgradeTest.Contract.UpdateConsensusEndpoints(&_AutonityUpgradeTest.TransactOpts, _record)
+}
+
+// UpdateConsensusEndpoints is a paid mutator transaction binding the contract method 0xda45591b.
+//
+// Solidity: function updateConsensusEndpoints(bytes _record) returns()
+func (_AutonityUpgradeTest *AutonityUpgradeTestTransactorSession) UpdateConsensusEndpoints(_record []byte) (*types.Transaction, error) {
+	return _AutonityUpgradeTest.Contract.UpdateConsensusEndpoints(&_AutonityUpgradeTest.TransactOpts, _record)
+}
+
 // UpdateValidatorAndTransferSlashedFunds is a paid mutator transaction binding the contract method 0x35be16e0.
 //
 // Solidity: function updateValidatorAndTransferSlashedFunds((address,address,address,string,uint256,uint256,uint256,uint256,uint256,uint256,uint256,uint256,address,uint256,uint256,uint256,uint256,uint256,bytes,uint8) _val) returns()
@@ -8939,9 +9246,9 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) ParseCommissionRateChan
 	return event, nil
 }
 
-// AutonityUpgradeTestEpochPeriodUpdatedIterator is returned from FilterEpochPeriodUpdated and is used to iterate over the raw logs and unpacked data for EpochPeriodUpdated events raised by the AutonityUpgradeTest contract.
-type AutonityUpgradeTestEpochPeriodUpdatedIterator struct {
-	Event *AutonityUpgradeTestEpochPeriodUpdated // Event containing the contract specifics and raw log
+// AutonityUpgradeTestConsensusEndpointsUpdatedIterator is returned from FilterConsensusEndpointsUpdated and is used to iterate over the raw logs and unpacked data for ConsensusEndpointsUpdated events raised by the AutonityUpgradeTest contract.
+type AutonityUpgradeTestConsensusEndpointsUpdatedIterator struct {
+	Event *AutonityUpgradeTestConsensusEndpointsUpdated // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -8955,7 +9262,7 @@ type AutonityUpgradeTestEpochPeriodUpdatedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *AutonityUpgradeTestEpochPeriodUpdatedIterator) Next() bool {
+func (it *AutonityUpgradeTestConsensusEndpointsUpdatedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -8964,7 +9271,7 @@ func (it *AutonityUpgradeTestEpochPeriodUpdatedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(AutonityUpgradeTestEpochPeriodUpdated)
+			it.Event = new(AutonityUpgradeTestConsensusEndpointsUpdated)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -8979,7 +9286,7 @@ func (it *AutonityUpgradeTestEpochPeriodUpdatedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(AutonityUpgradeTestEpochPeriodUpdated)
+		it.Event = new(AutonityUpgradeTestConsensusEndpointsUpdated)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -8995,41 +9302,51 @@ func (it *AutonityUpgradeTestEpochPeriodUpdatedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *AutonityUpgradeTestEpochPeriodUpdatedIterator) Error() error {
+func (it *AutonityUpgradeTestConsensusEndpointsUpdatedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *AutonityUpgradeTestEpochPeriodUpdatedIterator) Close() error {
+func (it *AutonityUpgradeTestConsensusEndpointsUpdatedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// AutonityUpgradeTestEpochPeriodUpdated represents a EpochPeriodUpdated event raised by the AutonityUpgradeTest contract.
-type AutonityUpgradeTestEpochPeriodUpdated struct {
-	Period *big.Int
-	Raw    types.Log // Blockchain specific contextual infos
+// AutonityUpgradeTestConsensusEndpointsUpdated represents a ConsensusEndpointsUpdated event raised by the AutonityUpgradeTest contract.
+type AutonityUpgradeTestConsensusEndpointsUpdated struct {
+	Addr common.Address
+	Raw  types.Log // Blockchain specific contextual infos
 }
 
-// FilterEpochPeriodUpdated is a free log retrieval operation binding the contract event 0xd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f81.
+// FilterConsensusEndpointsUpdated is a free log retrieval operation binding the contract event 0x68d46f7ed6d57eea4fc550f4783b3f528ef5f858f9e78b9a7728b1bef0a1b92f.
 //
-// Solidity: event EpochPeriodUpdated(uint256 period)
-func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) FilterEpochPeriodUpdated(opts *bind.FilterOpts) (*AutonityUpgradeTestEpochPeriodUpdatedIterator, error) {
+// Solidity: event ConsensusEndpointsUpdated(address indexed addr)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) FilterConsensusEndpointsUpdated(opts *bind.FilterOpts, addr []common.Address) (*AutonityUpgradeTestConsensusEndpointsUpdatedIterator, error) {
 
-	logs, sub, err := _AutonityUpgradeTest.contract.FilterLogs(opts, "EpochPeriodUpdated")
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+
+	logs, sub, err := _AutonityUpgradeTest.contract.FilterLogs(opts, "ConsensusEndpointsUpdated", addrRule)
 	if err != nil {
 		return nil, err
 	}
-	return &AutonityUpgradeTestEpochPeriodUpdatedIterator{contract: _AutonityUpgradeTest.contract, event: "EpochPeriodUpdated", logs: logs, sub: sub}, nil
+	return &AutonityUpgradeTestConsensusEndpointsUpdatedIterator{contract: _AutonityUpgradeTest.contract, event: "ConsensusEndpointsUpdated", logs: logs, sub: sub}, nil
 }
 
-// WatchEpochPeriodUpdated is a free log subscription operation binding the contract event 0xd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f81.
+// WatchConsensusEndpointsUpdated is a free log subscription operation binding the contract event 0x68d46f7ed6d57eea4fc550f4783b3f528ef5f858f9e78b9a7728b1bef0a1b92f.
 //
-// Solidity: event EpochPeriodUpdated(uint256 period)
-func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchEpochPeriodUpdated(opts *bind.WatchOpts, sink chan<- *AutonityUpgradeTestEpochPeriodUpdated) (event.Subscription, error) {
+// Solidity: event ConsensusEndpointsUpdated(address indexed addr)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchConsensusEndpointsUpdated(opts *bind.WatchOpts, sink chan<- *AutonityUpgradeTestConsensusEndpointsUpdated, addr []common.Address) (event.Subscription, error) {
 
-	logs, sub, err := _AutonityUpgradeTest.contract.WatchLogs(opts, "EpochPeriodUpdated")
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+
+	logs, sub, err := _AutonityUpgradeTest.contract.WatchLogs(opts, "ConsensusEndpointsUpdated", addrRule)
 	if err != nil {
 		return nil, err
 	}
@@ -9039,8 +9356,8 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchEpochPeriodUpdated
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(AutonityUpgradeTestEpochPeriodUpdated)
-				if err := _AutonityUpgradeTest.contract.UnpackLog(event, "EpochPeriodUpdated", log); err != nil {
+				event := new(AutonityUpgradeTestConsensusEndpointsUpdated)
+				if err := _AutonityUpgradeTest.contract.UnpackLog(event, "ConsensusEndpointsUpdated", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -9061,21 +9378,21 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchEpochPeriodUpdated
 	}), nil
 }
 
-// ParseEpochPeriodUpdated is a log parse operation binding the contract event 0xd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f81.
+// ParseConsensusEndpointsUpdated is a log parse operation binding the contract event 0x68d46f7ed6d57eea4fc550f4783b3f528ef5f858f9e78b9a7728b1bef0a1b92f.
 //
-// Solidity: event EpochPeriodUpdated(uint256 period)
-func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) ParseEpochPeriodUpdated(log types.Log) (*AutonityUpgradeTestEpochPeriodUpdated, error) {
-	event := new(AutonityUpgradeTestEpochPeriodUpdated)
-	if err := _AutonityUpgradeTest.contract.UnpackLog(event, "EpochPeriodUpdated", log); err != nil {
+// Solidity: event ConsensusEndpointsUpdated(address indexed addr)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) ParseConsensusEndpointsUpdated(log types.Log) (*AutonityUpgradeTestConsensusEndpointsUpdated, error) {
+	event := new(AutonityUpgradeTestConsensusEndpointsUpdated)
+	if err := _AutonityUpgradeTest.contract.UnpackLog(event, "ConsensusEndpointsUpdated", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// AutonityUpgradeTestMinimumBaseFeeUpdatedIterator is returned from FilterMinimumBaseFeeUpdated and is used to iterate over the raw logs and unpacked data for MinimumBaseFeeUpdated events raised by the AutonityUpgradeTest contract.
-type AutonityUpgradeTestMinimumBaseFeeUpdatedIterator struct {
-	Event *AutonityUpgradeTestMinimumBaseFeeUpdated // Event containing the contract specifics and raw log
+// AutonityUpgradeTestEpochPeriodUpdatedIterator is returned from FilterEpochPeriodUpdated and is used to iterate over the raw logs and unpacked data for EpochPeriodUpdated events raised by the AutonityUpgradeTest contract.
+type AutonityUpgradeTestEpochPeriodUpdatedIterator struct {
+	Event *AutonityUpgradeTestEpochPeriodUpdated // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -9089,7 +9406,7 @@ type AutonityUpgradeTestMinimumBaseFeeUpdatedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *AutonityUpgradeTestMinimumBaseFeeUpdatedIterator) Next() bool {
+func (it *AutonityUpgradeTestEpochPeriodUpdatedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -9098,7 +9415,7 @@ func (it *AutonityUpgradeTestMinimumBaseFeeUpdatedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(AutonityUpgradeTestMinimumBaseFeeUpdated)
+			it.Event = new(AutonityUpgradeTestEpochPeriodUpdated)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -9113,7 +9430,7 @@ func (it *AutonityUpgradeTestMinimumBaseFeeUpdatedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(AutonityUpgradeTestMinimumBaseFeeUpdated)
+		it.Event = new(AutonityUpgradeTestEpochPeriodUpdated)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -9129,41 +9446,41 @@ func (it *AutonityUpgradeTestMinimumBaseFeeUpdatedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *AutonityUpgradeTestMinimumBaseFeeUpdatedIterator) Error() error {
+func (it *AutonityUpgradeTestEpochPeriodUpdatedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *AutonityUpgradeTestMinimumBaseFeeUpdatedIterator) Close() error {
+func (it *AutonityUpgradeTestEpochPeriodUpdatedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// AutonityUpgradeTestMinimumBaseFeeUpdated represents a MinimumBaseFeeUpdated event raised by the AutonityUpgradeTest contract.
-type AutonityUpgradeTestMinimumBaseFeeUpdated struct {
-	GasPrice *big.Int
-	Raw      types.Log // Blockchain specific contextual infos
+// AutonityUpgradeTestEpochPeriodUpdated represents a EpochPeriodUpdated event raised by the AutonityUpgradeTest contract.
+type AutonityUpgradeTestEpochPeriodUpdated struct {
+	Period *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
 }
 
-// FilterMinimumBaseFeeUpdated is a free log retrieval operation binding the contract event 0x1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd389128.
+// FilterEpochPeriodUpdated is a free log retrieval operation binding the contract event 0xd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f81.
 //
-// Solidity: event MinimumBaseFeeUpdated(uint256 gasPrice)
-func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) FilterMinimumBaseFeeUpdated(opts *bind.FilterOpts) (*AutonityUpgradeTestMinimumBaseFeeUpdatedIterator, error) {
+// Solidity: event EpochPeriodUpdated(uint256 period)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) FilterEpochPeriodUpdated(opts *bind.FilterOpts) (*AutonityUpgradeTestEpochPeriodUpdatedIterator, error) {
 
-	logs, sub, err := _AutonityUpgradeTest.contract.FilterLogs(opts, "MinimumBaseFeeUpdated")
+	logs, sub, err := _AutonityUpgradeTest.contract.FilterLogs(opts, "EpochPeriodUpdated")
 	if err != nil {
 		return nil, err
 	}
-	return &AutonityUpgradeTestMinimumBaseFeeUpdatedIterator{contract: _AutonityUpgradeTest.contract, event: "MinimumBaseFeeUpdated", logs: logs, sub: sub}, nil
+	return &AutonityUpgradeTestEpochPeriodUpdatedIterator{contract: _AutonityUpgradeTest.contract, event: "EpochPeriodUpdated", logs: logs, sub: sub}, nil
 }
 
-// WatchMinimumBaseFeeUpdated is a free log subscription operation binding the contract event 0x1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd389128.
+// WatchEpochPeriodUpdated is a free log subscription operation binding the contract event 0xd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f81.
 //
-// Solidity: event MinimumBaseFeeUpdated(uint256 gasPrice)
-func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchMinimumBaseFeeUpdated(opts *bind.WatchOpts, sink chan<- *AutonityUpgradeTestMinimumBaseFeeUpdated) (event.Subscription, error) {
+// Solidity: event EpochPeriodUpdated(uint256 period)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchEpochPeriodUpdated(opts *bind.WatchOpts, sink chan<- *AutonityUpgradeTestEpochPeriodUpdated) (event.Subscription, error) {
 
-	logs, sub, err := _AutonityUpgradeTest.contract.WatchLogs(opts, "MinimumBaseFeeUpdated")
+	logs, sub, err := _AutonityUpgradeTest.contract.WatchLogs(opts, "EpochPeriodUpdated")
 	if err != nil {
 		return nil, err
 	}
@@ -9173,8 +9490,142 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchMinimumBaseFeeUpda
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(AutonityUpgradeTestMinimumBaseFeeUpdated)
-				if err := _AutonityUpgradeTest.contract.UnpackLog(event, "MinimumBaseFeeUpdated", log); err != nil {
+				event := new(AutonityUpgradeTestEpochPeriodUpdated)
+				if err := _AutonityUpgradeTest.contract.UnpackLog(event, "EpochPeriodUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEpochPeriodUpdated is a log parse operation binding the contract event 0xd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f81.
+//
+// Solidity: event EpochPeriodUpdated(uint256 period)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) ParseEpochPeriodUpdated(log types.Log) (*AutonityUpgradeTestEpochPeriodUpdated, error) {
+	event := new(AutonityUpgradeTestEpochPeriodUpdated)
+	if err := _AutonityUpgradeTest.contract.UnpackLog(event, "EpochPeriodUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AutonityUpgradeTestMinimumBaseFeeUpdatedIterator is returned from FilterMinimumBaseFeeUpdated and is used to iterate over the raw logs and unpacked data for MinimumBaseFeeUpdated events raised by the AutonityUpgradeTest contract.
+type AutonityUpgradeTestMinimumBaseFeeUpdatedIterator struct {
+	Event *AutonityUpgradeTestMinimumBaseFeeUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AutonityUpgradeTestMinimumBaseFeeUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AutonityUpgradeTestMinimumBaseFeeUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AutonityUpgradeTestMinimumBaseFeeUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AutonityUpgradeTestMinimumBaseFeeUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AutonityUpgradeTestMinimumBaseFeeUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AutonityUpgradeTestMinimumBaseFeeUpdated represents a MinimumBaseFeeUpdated event raised by the AutonityUpgradeTest contract.
+type AutonityUpgradeTestMinimumBaseFeeUpdated struct {
+	GasPrice *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterMinimumBaseFeeUpdated is a free log retrieval operation binding the contract event 0x1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd389128.
+//
+// Solidity: event MinimumBaseFeeUpdated(uint256 gasPrice)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) FilterMinimumBaseFeeUpdated(opts *bind.FilterOpts) (*AutonityUpgradeTestMinimumBaseFeeUpdatedIterator, error) {
+
+	logs, sub, err := _AutonityUpgradeTest.contract.FilterLogs(opts, "MinimumBaseFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &AutonityUpgradeTestMinimumBaseFeeUpdatedIterator{contract: _AutonityUpgradeTest.contract, event: "MinimumBaseFeeUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchMinimumBaseFeeUpdated is a free log subscription operation binding the contract event 0x1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd389128.
+//
+// Solidity: event MinimumBaseFeeUpdated(uint256 gasPrice)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchMinimumBaseFeeUpdated(opts *bind.WatchOpts, sink chan<- *AutonityUpgradeTestMinimumBaseFeeUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _AutonityUpgradeTest.contract.WatchLogs(opts, "MinimumBaseFeeUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AutonityUpgradeTestMinimumBaseFeeUpdated)
+				if err := _AutonityUpgradeTest.contract.UnpackLog(event, "MinimumBaseFeeUpdated", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -10167,29 +10618,1799 @@ type AutonityUpgradeTestRewarded struct {
 // Solidity: event Rewarded(address indexed addr, uint256 amount)
 func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) FilterRewarded(opts *bind.FilterOpts, addr []common.Address) (*AutonityUpgradeTestRewardedIterator, error) {
 
-	var addrRule []interface{}
-	for _, addrItem := range addr {
-		addrRule = append(addrRule, addrItem)
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+
+	logs, sub, err := _AutonityUpgradeTest.contract.FilterLogs(opts, "Rewarded", addrRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AutonityUpgradeTestRewardedIterator{contract: _AutonityUpgradeTest.contract, event: "Rewarded", logs: logs, sub: sub}, nil
+}
+
+// WatchRewarded is a free log subscription operation binding the contract event 0xb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe563.
+//
+// Solidity: event Rewarded(address indexed addr, uint256 amount)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchRewarded(opts *bind.WatchOpts, sink chan<- *AutonityUpgradeTestRewarded, addr []common.Address) (event.Subscription, error) {
+
+	var addrRule []interface{}
+	for _, addrItem := range addr {
+		addrRule = append(addrRule, addrItem)
+	}
+
+	logs, sub, err := _AutonityUpgradeTest.contract.WatchLogs(opts, "Rewarded", addrRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AutonityUpgradeTestRewarded)
+				if err := _AutonityUpgradeTest.contract.UnpackLog(event, "Rewarded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRewarded is a log parse operation binding the contract event 0xb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe563.
+//
+// Solidity: event Rewarded(address indexed addr, uint256 amount)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) ParseRewarded(log types.Log) (*AutonityUpgradeTestRewarded, error) {
+	event := new(AutonityUpgradeTestRewarded)
+	if err := _AutonityUpgradeTest.contract.UnpackLog(event, "Rewarded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// AutonityUpgradeTestTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the AutonityUpgradeTest contract.
+type AutonityUpgradeTestTransferIterator struct {
+	Event *AutonityUpgradeTestTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AutonityUpgradeTestTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AutonityUpgradeTestTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AutonityUpgradeTestTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AutonityUpgradeTestTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AutonityUpgradeTestTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AutonityUpgradeTestTransfer represents a Transfer event raised by the AutonityUpgradeTest contract.
+type AutonityUpgradeTestTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*AutonityUpgradeTestTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _AutonityUpgradeTest.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &AutonityUpgradeTestTransferIterator{contract: _AutonityUpgradeTest.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *AutonityUpgradeTestTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _AutonityUpgradeTest.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AutonityUpgradeTestTransfer)
+				if err := _AutonityUpgradeTest.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
+func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) ParseTransfer(log types.Log) (*AutonityUpgradeTestTransfer, error) {
+	event := new(AutonityUpgradeTestTransfer)
+	if err := _AutonityUpgradeTest.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// BytesLibMetaData contains all meta data concerning the BytesLib contract.
+var BytesLibMetaData = &bind.MetaData{
+	ABI: "[]",
+	Bin: "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea2646970667358221220831cbf1d093207c822ca0bb8903ee29676712d9a6abe4b340d9c5562c8a851e464736f6c63430008150033",
+}
+
+// BytesLibABI is the input ABI used to generate the binding from.
+// Deprecated: Use BytesLibMetaData.ABI instead.
+var BytesLibABI = BytesLibMetaData.ABI
+
+// BytesLibBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use BytesLibMetaData.Bin instead.
+var BytesLibBin = BytesLibMetaData.Bin
+
+// DeployBytesLib deploys a new Ethereum contract, binding an instance of BytesLib to it.
+func DeployBytesLib(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *BytesLib, error) {
+	parsed, err := BytesLibMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(BytesLibBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &BytesLib{BytesLibCaller: BytesLibCaller{contract: contract}, BytesLibTransactor: BytesLibTransactor{contract: contract}, BytesLibFilterer: BytesLibFilterer{contract: contract}}, nil
+}
+
+// BytesLib is an auto generated Go binding around an Ethereum contract.
+type BytesLib struct {
+	BytesLibCaller     // Read-only binding to the contract
+	BytesLibTransactor // Write-only binding to the contract
+	BytesLibFilterer   // Log filterer for contract events
+}
+
+// BytesLibCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BytesLibCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BytesLibTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BytesLibTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BytesLibFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BytesLibFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BytesLibSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BytesLibSession struct {
+	Contract     *BytesLib         // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// BytesLibCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BytesLibCallerSession struct {
+	Contract *BytesLibCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts   // Call options to use throughout this session
+}
+
+// BytesLibTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BytesLibTransactorSession struct {
+	Contract     *BytesLibTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// BytesLibRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BytesLibRaw struct {
+	Contract *BytesLib // Generic contract binding to access the raw methods on
+}
+
+// BytesLibCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BytesLibCallerRaw struct {
+	Contract *BytesLibCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BytesLibTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BytesLibTransactorRaw struct {
+	Contract *BytesLibTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBytesLib creates a new instance of BytesLib, bound to a specific deployed contract.
+func NewBytesLib(address common.Address, backend bind.ContractBackend) (*BytesLib, error) {
+	contract, err := bindBytesLib(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BytesLib{BytesLibCaller: BytesLibCaller{contract: contract}, BytesLibTransactor: BytesLibTransactor{contract: contract}, BytesLibFilterer: BytesLibFilterer{contract: contract}}, nil
+}
+
+// NewBytesLibCaller creates a new read-only instance of BytesLib, bound to a specific deployed contract.
+func NewBytesLibCaller(address common.Address, caller bind.ContractCaller) (*BytesLibCaller, error) {
+	contract, err := bindBytesLib(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BytesLibCaller{contract: contract}, nil
+}
+
+// NewBytesLibTransactor creates a new write-only instance of BytesLib, bound to a specific deployed contract.
+func NewBytesLibTransactor(address common.Address, transactor bind.ContractTransactor) (*BytesLibTransactor, error) {
+	contract, err := bindBytesLib(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BytesLibTransactor{contract: contract}, nil
+}
+
+// NewBytesLibFilterer creates a new log filterer instance of BytesLib, bound to a specific deployed contract.
+func NewBytesLibFilterer(address common.Address, filterer bind.ContractFilterer) (*BytesLibFilterer, error) {
+	contract, err := bindBytesLib(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BytesLibFilterer{contract: contract}, nil
+}
+
+// bindBytesLib binds a generic wrapper to an already deployed contract.
+func bindBytesLib(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(BytesLibABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BytesLib *BytesLibRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BytesLib.Contract.BytesLibCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BytesLib *BytesLibRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BytesLib.Contract.BytesLibTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BytesLib *BytesLibRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BytesLib.Contract.BytesLibTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BytesLib *BytesLibCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BytesLib.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BytesLib *BytesLibTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BytesLib.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BytesLib *BytesLibTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BytesLib.Contract.contract.Transact(opts, method, params...)
+}
+
+// GovernanceMetaData contains all meta data concerning the Governance contract.
+var GovernanceMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"addresspayable\",\"name\":\"_autonity\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"uint256\",\"name\":\"votingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"quorum\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"timelock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minVotingPower\",\"type\":\"uint256\"}],\"internalType\":\"structGovernance.Config\",\"name\":\"_config\",\"type\":\"tuple\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"votingPeriod\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"quorum\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"timelock\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"minVotingPower\",\"type\":\"uint256\"}],\"name\":\"ConfigUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"ProposalCancelled\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"proposer\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"targets\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"bytes[]\",\"name\":\"calldatas\",\"type\":\"bytes[]\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"endBlock\",\"type\":\"uint256\"}],\"name\":\"ProposalCreated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"ProposalExecuted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"yesVotes\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"noVotes\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"accepted\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"eta\",\"type\":\"uint256\"}],\"name\":\"ProposalTallied\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"support\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"power\",\"type\":\"uint256\"}],\"name\":\"VoteCast\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"voter\",\"type\":\"address\"}],\"name\":\"VoteReleased\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"PRECISION\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"cancel\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"execute\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getConfig\",\"outputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"votingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"quorum\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"timelock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minVotingPower\",\"type\":\"uint256\"}],\"internalType\":\"structGovernance.Config\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"getProposal\",\"outputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"proposer\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"description\",\"type\":\"string\"},{\"internalType\":\"address[]\",\"name\":\"targets\",\"type\":\"address[]\"},{\"internalType\":\"bytes[]\",\"name\":\"calldatas\",\"type\":\"bytes[]\"},{\"internalType\":\"uint256\",\"name\":\"endBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"totalStake\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"eta\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"yesVotes\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"noVotes\",\"type\":\"uint256\"},{\"internalType\":\"enumGovernance.ProposalState\",\"name\":\"state\",\"type\":\"uint8\"}],\"internalType\":\"structGovernance.Proposal\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getProposalCount\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getVote\",\"outputs\":[{\"components\":[{\"internalType\":\"uint8\",\"name\":\"choice\",\"type\":\"uint8\"},{\"internalType\":\"uint256\",\"name\":\"power\",\"type\":\"uint256\"},{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"locked\",\"type\":\"uint256[]\"}],\"internalType\":\"structGovernance.Vote\",\"name\":\"\",\"type\":\"tuple\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"getVoters\",\"outputs\":[{\"internalType\":\"address[]\",\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"_targets\",\"type\":\"address[]\"},{\"internalType\":\"bytes[]\",\"name\":\"_calldatas\",\"type\":\"bytes[]\"},{\"internalType\":\"string\",\"name\":\"_description\",\"type\":\"string\"}],\"name\":\"propose\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"release\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"uint256\",\"name\":\"votingPeriod\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"quorum\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"threshold\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"timelock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"minVotingPower\",\"type\":\"uint256\"}],\"internalType\":\"structGovernance.Config\",\"name\":\"_config\",\"type\":\"tuple\"}],\"name\":\"setConfig\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"}],\"name\":\"tally\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"_support\",\"type\":\"bool\"},{\"internalType\":\"address[]\",\"name\":\"_validators\",\"type\":\"address[]\"}],\"name\":\"vote\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_id\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address[]\",\"name\":\"_validators\",\"type\":\"address[]\"}],\"name\":\"votingPower\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"_power\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Sigs: map[string]string{
+		"aaf5eb68": "PRECISION()",
+		"40e58ee5": "cancel(uint256)",
+		"fe0d94c1": "execute(uint256)",
+		"c3f909d4": "getConfig()",
+		"c7f758a8": "getProposal(uint256)",
+		"c08cc02d": "getProposalCount()",
+		"bc3f931f": "getVote(uint256,address)",
+		"86b646f2": "getVoters(uint256)",
+		"159bf8cf": "propose(address[],bytes[],string)",
+		"37bdc99b": "release(uint256)",
+		"e7556912": "setConfig((uint256,uint256,uint256,uint256,uint256))",
+		"ed8b6b31": "tally(uint256)",
+		"86f05678": "vote(uint256,bool,address[])",
+		"9f30ff30": "votingPower(uint256,address,address[])",
+	},
+	Bin: "0x60806040523480156200001157600080fd5b5060405162002bae38038062002bae8339810160408190526200003491620001d8565b600080546001600160a01b0319166001600160a01b0384161790556200005a8162000062565b50506200028b565b8051620000b65760405162461bcd60e51b815260206004820152601560248201527f696e76616c696420766f74696e6720706572696f64000000000000000000000060448201526064015b60405180910390fd5b61271081602001511115620000ff5760405162461bcd60e51b815260206004820152600e60248201526d696e76616c69642071756f72756d60901b6044820152606401620000ad565b600081604001511180156200011a5750612710816040015111155b6200015c5760405162461bcd60e51b81526020600482015260116024820152701a5b9d985b1a59081d1a1c995cda1bdb19607a1b6044820152606401620000ad565b805160018190556020808301516002819055604080850151600381905560608087015160048190556080808901516005819055855198895296880195909552928601919091528401528201527f2ba978126f856e763cfc2e7694871d83c30f5c931c5e16443db1e38c63dafd219060a00160405180910390a150565b60008082840360c0811215620001ed57600080fd5b83516001600160a01b03811681146200020557600080fd5b925060a0601f19820112156200021a57600080fd5b5060405160a081016001600160401b03811182821017156200024c57634e487b7160e01b600052604160045260246000fd5b80604052506020840151815260408401516020820152606084015160408201526080840151606082015260a08401516080820152809150509250929050565b612913806200029b6000396000f3fe608060405234801561001057600080fd5b50600436106100ea5760003560e01c8063bc3f931f1161008c578063c7f758a811610066578063c7f758a8146101fe578063e75569121461021e578063ed8b6b3114610231578063fe0d94c11461024457600080fd5b8063bc3f931f1461018c578063c08cc02d146101ac578063c3f909d4146101b457600080fd5b806386b646f2116100c857806386b646f21461013d57806386f056781461015d5780639f30ff3014610170578063aaf5eb681461018357600080fd5b8063159bf8cf146100ef57806337bdc99b1461011557806340e58ee51461012a575b600080fd5b6101026100fd366004611e71565b610257565b6040519081526020015b60405180910390f35b610128610123366004611f7d565b6106f2565b005b610128610138366004611f7d565b61095a565b61015061014b366004611f7d565b6109ff565b60405161010c9190611fda565b61012861016b366004611fed565b610a6b565b61010261017e366004612041565b610f27565b61010261271081565b61019f61019a366004612068565b6110b7565b60405161010c9190612098565b600654610102565b6101bc6111df565b60405161010c9190600060a082019050825182526020830151602083015260408301516040830152606083015160608301526080830151608083015292915050565b61021161020c366004611f7d565b611246565b60405161010c91906121e9565b61012861022c3660046122b7565b6114ea565b61012861023f366004611f7d565b611545565b610128610252366004611f7d565b6116b5565b60008084511161029f5760405162461bcd60e51b815260206004820152600e60248201526d195b5c1d1e481c1c9bdc1bdcd85b60921b60448201526064015b60405180910390fd5b82518451146102e85760405162461bcd60e51b81526020600482015260156024820152740c6c2d8d8e640d8cadccee8d040dad2e6dac2e8c6d605b1b6044820152606401610296565b60068054600181018255600091825281546040805163b7ab4db560e01b815290519293600a85027ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d3f0193909283926001600160a01b039091169163b7ab4db59160048082019286929091908290030181865afa15801561036c573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526103949190810190612337565b905060005b81518110156105df576000805483516001600160a01b0390911690631904bb2e908590859081106103cc576103cc6123d1565b60200260200101516040518263ffffffff1660e01b81526004016103ff91906001600160a01b0391909116815260200190565b600060405180830381865afa15801561041c573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526104449190810190612443565b9050600060096000888152602001908152602001600020600085858151811061046f5761046f6123d1565b6020908102919091018101516001600160a01b0390811683529082019290925260400160002061018084015181549083166001600160a01b03199182161782558451600183018054919094169116179091556101008301516002820181905560a08401519192506104df916125d7565b60038201556101a0820151600482015560a08201516005870180546000906105089084906125ea565b9091555050610180820151604051631092ab9160e31b81523360048201526000916105899184916001600160a01b0316906384955c88906024015b602060405180830381865afa158015610560573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061058491906125fd565b6118bf565b9150610597905081876125ea565b9550336001600160a01b031683600001516001600160a01b0316036105c9576101008301516105c690876125ea565b95505b50505080806105d790612616565b915050610399565b506000821180156105f257506005548210155b61063a5760405162461bcd60e51b815260206004820152601960248201527834b739bab33334b1b4b2b73a103b37ba34b733903837bbb2b960391b6044820152606401610296565b82546001600160a01b031916331783556001830161065887826126b8565b50875161066e90600285019060208b0190611b1e565b50865161068490600385019060208a0190611b83565b5060015461069290436125ea565b6004840181905560098401805460ff19169055604051339186917f3cb586fc92d2dd2c453581211155b0ef86a580179e1bffd96b3590ceb5945b65916106de918d918d918d9190612778565b60405180910390a350919695505050505050565b60065481106107135760405162461bcd60e51b8152600401610296906127c3565b600060068281548110610728576107286123d1565b60009182526020909120600a9091020190506004600982015460ff166004811115610755576107556121b1565b14806107645750806004015443115b6107ac5760405162461bcd60e51b81526020600482015260196024820152783b37ba34b733903832b934b7b21034b9903737ba1037bb32b960391b6044820152606401610296565b6000828152600860209081526040808320338452909152902060028101546108165760405162461bcd60e51b815260206004820152601760248201527f6e6f206c6f636b6564206c6971756964206e6577746f6e0000000000000000006044820152606401610296565b60005b600282015481101561090b576000546002830180546001600160a01b03909216916339b17fec919084908110610851576108516123d1565b9060005260206000200160009054906101000a90046001600160a01b031633856003018581548110610885576108856123d1565b6000918252602090912001546040516001600160e01b031960e086901b1681526001600160a01b0393841660048201529290911660248301526044820152606401600060405180830381600087803b1580156108e057600080fd5b505af11580156108f4573d6000803e3d6000fd5b50505050808061090390612616565b915050610819565b5061091a600282016000611bd5565b610928600382016000611bd5565b604051339084907ff2d1b6805ed805e90676fe890865e6e22992435036a1637c2e19ca60ef1716b290600090a3505050565b600061096582611912565b80549091506001600160a01b031633146109c15760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f74207468652070726f706f7365720000000000006044820152606401610296565b60098101805460ff1916600417905560405182907f416e669c63d9a3a5e36ee7cc7e2104b8db28ccd286aa18966e98fa230c73b08c90600090a25050565b600081815260076020908152604091829020805483518184028101840190945280845260609392830182828015610a5f57602002820191906000526020600020905b81546001600160a01b03168152600190910190602001808311610a41575b50505050509050919050565b6000610a7684611912565b90508060040154431115610ac45760405162461bcd60e51b81526020600482015260156024820152743b37ba34b733903832b934b7b21034b99037bb32b960591b6044820152606401610296565b60008481526008602090815260408083203384529091529020805460ff1615610b1f5760405162461bcd60e51b815260206004820152600d60248201526c185b1c9958591e481d9bdd1959609a1b6044820152606401610296565b6000805b8451811015610df257801580610b86575084610b406001836125d7565b81518110610b5057610b506123d1565b60200260200101516001600160a01b0316858281518110610b7357610b736123d1565b60200260200101516001600160a01b0316115b610ba25760405162461bcd60e51b8152600401610296906127ed565b600087815260096020526040812086518290889085908110610bc657610bc66123d1565b6020908102919091018101516001600160a01b03908116835290820192909252604001600020805490925016610c3e5760405162461bcd60e51b815260206004820152601d60248201527f76616c696461746f72206e6f7420696e2074686520736e617073686f740000006044820152606401610296565b6001810154336001600160a01b0390911603610c66576002810154610c6390846125ea565b92505b8054604051631092ab9160e31b81523360048201526000918291610ca09185916001600160a01b03909116906384955c8890602401610543565b9150915081600003610cb457505050610de0565b60005488516001600160a01b0390911690632a400bc3908a9087908110610cdd57610cdd6123d1565b60209081029190910101516040516001600160e01b031960e084901b1681526001600160a01b03909116600482015233602482015260448101859052606401600060405180830381600087803b158015610d3657600080fd5b505af1158015610d4a573d6000803e3d6000fd5b5050505081836005016000828254610d6291906125ea565b9250508190555085600201888581518110610d7f57610d7f6123d1565b602090810291909101810151825460018082018555600094855283852090910180546001600160a01b0319166001600160a01b039093169290921790915560038901805491820181558352912001829055610dda81866125ea565b94505050505b80610dea81612616565b915050610b23565b50600081118015610e0557506005548110155b610e4d5760405162461bcd60e51b815260206004820152601960248201527834b739bab33334b1b4b2b73a103b37ba34b733903837bbb2b960391b6044820152606401610296565b84610e59576002610e5c565b60015b825460ff9190911660ff1990911617825560018083018290556000878152600760209081526040822080549384018155825290200180546001600160a01b031916331790558415610ec65780836007016000828254610ebb91906125ea565b90915550610ee09050565b80836008016000828254610eda91906125ea565b90915550505b60408051861515815260208101839052339188917fcbdf6214089cba887ecbf35a0b6a734589959c9763342c756bb2a80ca2bc9f6e910160405180910390a3505050505050565b6006546000908410610f4b5760405162461bcd60e51b8152600401610296906127c3565b60005b82518110156110af57801580610fb1575082610f6b6001836125d7565b81518110610f7b57610f7b6123d1565b60200260200101516001600160a01b0316838281518110610f9e57610f9e6123d1565b60200260200101516001600160a01b0316115b610fcd5760405162461bcd60e51b8152600401610296906127ed565b600085815260096020526040812084518290869085908110610ff157610ff16123d1565b6020908102919091018101516001600160a01b03908116835290820192909252604001600020805490925016611027575061109d565b60018101546001600160a01b0380871691160361105057600281015461104d90846125ea565b92505b8054604051631092ab9160e31b81526001600160a01b03878116600483015260009261108a928592909116906384955c8890602401610543565b9150611098905081856125ea565b935050505b806110a781612616565b915050610f4e565b509392505050565b6110e56040518060800160405280600060ff1681526020016000815260200160608152602001606081525090565b60008381526008602090815260408083206001600160a01b03861684528252918290208251608081018452815460ff1681526001820154818401526002820180548551818602810186018752818152929593949386019383018282801561117557602002820191906000526020600020905b81546001600160a01b03168152600190910190602001808311611157575b50505050508152602001600382018054806020026020016040519081016040528092919081815260200182805480156111cd57602002820191906000526020600020905b8154815260200190600101908083116111b9575b50505050508152505090505b92915050565b6112116040518060a0016040528060008152602001600081526020016000815260200160008152602001600081525090565b506040805160a08101825260015481526002546020820152600354918101919091526004546060820152600554608082015290565b61124e611bf3565b600654821061126f5760405162461bcd60e51b8152600401610296906127c3565b60068281548110611282576112826123d1565b6000918252602091829020604080516101408101909152600a9092020180546001600160a01b0316825260018101805492939192918401916112c39061262f565b80601f01602080910402602001604051908101604052809291908181526020018280546112ef9061262f565b801561133c5780601f106113115761010080835404028352916020019161133c565b820191906000526020600020905b81548152906001019060200180831161131f57829003601f168201915b505050505081526020016002820180548060200260200160405190810160405280929190818152602001828054801561139e57602002820191906000526020600020905b81546001600160a01b03168152600190910190602001808311611380575b5050505050815260200160038201805480602002602001604051908101604052809291908181526020016000905b828210156114785783829060005260206000200180546113eb9061262f565b80601f01602080910402602001604051908101604052809291908181526020018280546114179061262f565b80156114645780601f1061143957610100808354040283529160200191611464565b820191906000526020600020905b81548152906001019060200180831161144757829003601f168201915b5050505050815260200190600101906113cc565b505050908252506004828101546020830152600583015460408301526006830154606083015260078301546080830152600883015460a0830152600983015460c09092019160ff16908111156114d0576114d06121b1565b60048111156114e1576114e16121b1565b90525092915050565b3330146115395760405162461bcd60e51b815260206004820152601c60248201527f63616c6c6572206973206e6f742074686520676f7665726e616e6365000000006044820152606401610296565b611542816119bb565b50565b600061155082611912565b9050806004015443116115a15760405162461bcd60e51b81526020600482015260196024820152783b37ba34b733903832b934b7b21034b9903737ba1037bb32b960391b6044820152606401610296565b6000816008015482600701546115b791906125ea565b9050600080821180156115e6575060025460058401546115d7919061282e565b6115e36127108461282e565b10155b801561160f57506003546115fa908361282e565b612710846007015461160c919061282e565b10155b9050801561163d5760098301805460ff1916600217905560045461163390436125ea565b600684015561164d565b60098301805460ff191660011790555b837f462a743cfc5478a80328a625e4961bcae9b1cf71b711c00bfc4d1ec7dbb79d43846007015485600801548487600601546040516116a79493929190938452602084019290925215156040830152606082015260800190565b60405180910390a250505050565b60065481106116d65760405162461bcd60e51b8152600401610296906127c3565b6000600682815481106116eb576116eb6123d1565b60009182526020909120600a9091020190506002600982015460ff166004811115611718576117186121b1565b1461175e5760405162461bcd60e51b81526020600482015260166024820152751c1c9bdc1bdcd85b081a5cc81b9bdd081c5d595d595960521b6044820152606401610296565b80600601544310156117ab5760405162461bcd60e51b81526020600482015260166024820152751c1c9bdc1bdcd85b081a5cc81d1a5b595b1bd8dad95960521b6044820152606401610296565b60098101805460ff1916600317905560005b600282015481101561188f576000808360020183815481106117e1576117e16123d1565b6000918252602090912001546003850180546001600160a01b03909216918590811061180f5761180f6123d1565b906000526020600020016040516118269190612845565b6000604051808303816000865af19150503d8060008114611863576040519150601f19603f3d011682016040523d82523d6000602084013e611868565b606091505b50915091508161187a57805160208201fd5b5050808061188790612616565b9150506117bd565b5060405182907f712ae1383f79ac853f8d882153778e0260ef8f03b504e2866e0593e04d2b291f90600090a25050565b600080836005015484600401546118d691906125d7565b9150818310156118e4578291505b811561190b57600484015460038501546118fe908461282e565b61190891906128bb565b90505b9250929050565b60065460009082106119365760405162461bcd60e51b8152600401610296906127c3565b60006006838154811061194b5761194b6123d1565b600091825260208220600a909102019150600982015460ff166004811115611975576119756121b1565b146111d95760405162461bcd60e51b815260206004820152601660248201527570726f706f73616c206973206e6f742061637469766560501b6044820152606401610296565b8051611a015760405162461bcd60e51b81526020600482015260156024820152741a5b9d985b1a59081d9bdd1a5b99c81c195c9a5bd9605a1b6044820152606401610296565b61271081602001511115611a485760405162461bcd60e51b815260206004820152600e60248201526d696e76616c69642071756f72756d60901b6044820152606401610296565b60008160400151118015611a625750612710816040015111155b611aa25760405162461bcd60e51b81526020600482015260116024820152701a5b9d985b1a59081d1a1c995cda1bdb19607a1b6044820152606401610296565b805160018190556020808301516002819055604080850151600381905560608087015160048190556080808901516005819055855198895296880195909552928601919091528401528201527f2ba978126f856e763cfc2e7694871d83c30f5c931c5e16443db1e38c63dafd219060a00160405180910390a150565b828054828255906000526020600020908101928215611b73579160200282015b82811115611b7357825182546001600160a01b0319166001600160a01b03909116178255602090920191600190910190611b3e565b50611b7f929150611c5f565b5090565b828054828255906000526020600020908101928215611bc9579160200282015b82811115611bc95782518290611bb990826126b8565b5091602001919060010190611ba3565b50611b7f929150611c74565b50805460008255906000526020600020908101906115429190611c5f565b60405180610140016040528060006001600160a01b03168152602001606081526020016060815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160006004811115611c5a57611c5a6121b1565b905290565b5b80821115611b7f5760008155600101611c60565b80821115611b7f576000611c888282611c91565b50600101611c74565b508054611c9d9061262f565b6000825580601f10611cad575050565b601f0160209004906000526020600020908101906115429190611c5f565b634e487b7160e01b600052604160045260246000fd5b604051610280810167ffffffffffffffff81118282101715611d0557611d05611ccb565b60405290565b604051601f8201601f1916810167ffffffffffffffff81118282101715611d3457611d34611ccb565b604052919050565b600067ffffffffffffffff821115611d5657611d56611ccb565b5060051b60200190565b6001600160a01b038116811461154257600080fd5b600082601f830112611d8657600080fd5b81356020611d9b611d9683611d3c565b611d0b565b82815260059290921b84018101918181019086841115611dba57600080fd5b8286015b84811015611dde578035611dd181611d60565b8352918301918301611dbe565b509695505050505050565b600067ffffffffffffffff821115611e0357611e03611ccb565b50601f01601f191660200190565b6000611e1f611d9684611de9565b9050828152838383011115611e3357600080fd5b828260208301376000602084830101529392505050565b600082601f830112611e5b57600080fd5b611e6a83833560208501611e11565b9392505050565b600080600060608486031215611e8657600080fd5b833567ffffffffffffffff80821115611e9e57600080fd5b611eaa87838801611d75565b9450602091508186013581811115611ec157600080fd5b8601601f81018813611ed257600080fd5b8035611ee0611d9682611d3c565b81815260059190911b8201840190848101908a831115611eff57600080fd5b8584015b83811015611f4c57803586811115611f1b5760008081fd5b8501603f81018d13611f2d5760008081fd5b611f3e8d8983013560408401611e11565b845250918601918601611f03565b5096505050506040860135915080821115611f6657600080fd5b50611f7386828701611e4a565b9150509250925092565b600060208284031215611f8f57600080fd5b5035919050565b600081518084526020808501945080840160005b83811015611fcf5781516001600160a01b031687529582019590820190600101611faa565b509495945050505050565b602081526000611e6a6020830184611f96565b60008060006060848603121561200257600080fd5b833592506020840135801515811461201957600080fd5b9150604084013567ffffffffffffffff81111561203557600080fd5b611f7386828701611d75565b60008060006060848603121561205657600080fd5b83359250602084013561201981611d60565b6000806040838503121561207b57600080fd5b82359150602083013561208d81611d60565b809150509250929050565b6000602080835260ff845116818401528084015160408401526040840151608060608501526120ca60a0850182611f96565b6060860151858203601f19016080870152805180835290840192506000918401905b80831015611dde57835182529284019260019290920191908401906120ec565b60005b8381101561212757818101518382015260200161210f565b50506000910152565b6000815180845261214881602086016020860161210c565b601f01601f19169290920160200192915050565b600081518084526020808501808196508360051b8101915082860160005b858110156121a4578284038952612192848351612130565b9885019893509084019060010161217a565b5091979650505050505050565b634e487b7160e01b600052602160045260246000fd5b600581106121e557634e487b7160e01b600052602160045260246000fd5b9052565b602081526122036020820183516001600160a01b03169052565b60006020830151610140806040850152612221610160850183612130565b91506040850151601f198086850301606087015261223f8483611f96565b935060608701519150808685030160808701525061225d838261215c565b925050608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506122ad828601826121c7565b5090949350505050565b600060a082840312156122c957600080fd5b60405160a0810181811067ffffffffffffffff821117156122ec576122ec611ccb565b806040525082358152602083013560208201526040830135604082015260608301356060820152608083013560808201528091505092915050565b805161233281611d60565b919050565b6000602080838503121561234a57600080fd5b825167ffffffffffffffff81111561236157600080fd5b8301601f8101851361237257600080fd5b8051612380611d9682611d3c565b81815260059190911b8201830190838101908783111561239f57600080fd5b928401925b828410156123c65783516123b781611d60565b825292840192908401906123a4565b979650505050505050565b634e487b7160e01b600052603260045260246000fd5b600082601f8301126123f857600080fd5b8151612406611d9682611de9565b81815284602083860101111561241b57600080fd5b61242c82602083016020870161210c565b949350505050565b80516004811061233257600080fd5b60006020828403121561245557600080fd5b815167ffffffffffffffff8082111561246d57600080fd5b90830190610280828603121561248257600080fd5b61248a611ce1565b61249383612327565b81526124a160208401612327565b60208201526124b260408401612327565b60408201526060830151828111156124c957600080fd5b6124d5878286016123e7565b6060830152506080830151608082015260a083015160a082015260c083015160c082015260e083015160e0820152610100808401518183015250610120808401518183015250610140808401518183015250610160808401518183015250610180612541818501612327565b908201526101a083810151908201526101c080840151908201526101e0808401519082015261020080840151908201526102208084015190820152610240808401518381111561259057600080fd5b61259c888287016123e7565b82840152505061026091506125b2828401612434565b91810191909152949350505050565b634e487b7160e01b600052601160045260246000fd5b818103818111156111d9576111d96125c1565b808201808211156111d9576111d96125c1565b60006020828403121561260f57600080fd5b5051919050565b600060018201612628576126286125c1565b5060010190565b600181811c9082168061264357607f821691505b60208210810361266357634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156126b357600081815260208120601f850160051c810160208610156126905750805b601f850160051c820191505b818110156126af5782815560010161269c565b5050505b505050565b815167ffffffffffffffff8111156126d2576126d2611ccb565b6126e6816126e0845461262f565b84612669565b602080601f83116001811461271b57600084156127035750858301515b600019600386901b1c1916600185901b1785556126af565b600085815260208120601f198616915b8281101561274a5788860151825594840194600190910190840161272b565b50858210156127685787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60808152600061278b6080830187611f96565b828103602084015261279d818761215c565b905082810360408401526127b18186612130565b91505082606083015295945050505050565b60208082526010908201526f1d5b9adb9bdddb881c1c9bdc1bdcd85b60821b604082015260600190565b60208082526021908201527f76616c696461746f7273206e6f7420696e20617363656e64696e67206f7264656040820152603960f91b606082015260800190565b80820281158282048414176111d9576111d96125c1565b60008083546128538161262f565b6001828116801561286b5760018114612880576128af565b60ff19841687528215158302870194506128af565b8760005260208060002060005b858110156128a65781548a82015290840190820161288d565b50505082870194505b50929695505050505050565b6000826128d857634e487b7160e01b600052601260045260246000fd5b50049056fea2646970667358221220d0d5016d9905f783d60285533046510a10b962de59b1b0d71438bdeeb07bbe2a64736f6c63430008150033",
+}
+
+// GovernanceABI is the input ABI used to generate the binding from.
+// Deprecated: Use GovernanceMetaData.ABI instead.
+var GovernanceABI = GovernanceMetaData.ABI
+
+// Deprecated: Use GovernanceMetaData.Sigs instead.
+// GovernanceFuncSigs maps the 4-byte function signature to its string representation.
+var GovernanceFuncSigs = GovernanceMetaData.Sigs
+
+// GovernanceBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use GovernanceMetaData.Bin instead.
+var GovernanceBin = GovernanceMetaData.Bin
+
+// DeployGovernance deploys a new Ethereum contract, binding an instance of Governance to it.
+func DeployGovernance(auth *bind.TransactOpts, backend bind.ContractBackend, _autonity common.Address, _config GovernanceConfig) (common.Address, *types.Transaction, *Governance, error) {
+	parsed, err := GovernanceMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(GovernanceBin), backend, _autonity, _config)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Governance{GovernanceCaller: GovernanceCaller{contract: contract}, GovernanceTransactor: GovernanceTransactor{contract: contract}, GovernanceFilterer: GovernanceFilterer{contract: contract}}, nil
+}
+
+// Governance is an auto generated Go binding around an Ethereum contract.
+type Governance struct {
+	GovernanceCaller     // Read-only binding to the contract
+	GovernanceTransactor // Write-only binding to the contract
+	GovernanceFilterer   // Log filterer for contract events
+}
+
+// GovernanceCaller is an auto generated read-only Go binding around an Ethereum contract.
+type GovernanceCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovernanceTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type GovernanceTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovernanceFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type GovernanceFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GovernanceSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type GovernanceSession struct {
+	Contract     *Governance       // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GovernanceCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type GovernanceCallerSession struct {
+	Contract *GovernanceCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts     // Call options to use throughout this session
+}
+
+// GovernanceTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type GovernanceTransactorSession struct {
+	Contract     *GovernanceTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// GovernanceRaw is an auto generated low-level Go binding around an Ethereum contract.
+type GovernanceRaw struct {
+	Contract *Governance // Generic contract binding to access the raw methods on
+}
+
+// GovernanceCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type GovernanceCallerRaw struct {
+	Contract *GovernanceCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// GovernanceTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type GovernanceTransactorRaw struct {
+	Contract *GovernanceTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewGovernance creates a new instance of Governance, bound to a specific deployed contract.
+func NewGovernance(address common.Address, backend bind.ContractBackend) (*Governance, error) {
+	contract, err := bindGovernance(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Governance{GovernanceCaller: GovernanceCaller{contract: contract}, GovernanceTransactor: GovernanceTransactor{contract: contract}, GovernanceFilterer: GovernanceFilterer{contract: contract}}, nil
+}
+
+// NewGovernanceCaller creates a new read-only instance of Governance, bound to a specific deployed contract.
+func NewGovernanceCaller(address common.Address, caller bind.ContractCaller) (*GovernanceCaller, error) {
+	contract, err := bindGovernance(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceCaller{contract: contract}, nil
+}
+
+// NewGovernanceTransactor creates a new write-only instance of Governance, bound to a specific deployed contract.
+func NewGovernanceTransactor(address common.Address, transactor bind.ContractTransactor) (*GovernanceTransactor, error) {
+	contract, err := bindGovernance(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceTransactor{contract: contract}, nil
+}
+
+// NewGovernanceFilterer creates a new log filterer instance of Governance, bound to a specific deployed contract.
+func NewGovernanceFilterer(address common.Address, filterer bind.ContractFilterer) (*GovernanceFilterer, error) {
+	contract, err := bindGovernance(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceFilterer{contract: contract}, nil
+}
+
+// bindGovernance binds a generic wrapper to an already deployed contract.
+func bindGovernance(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(GovernanceABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Governance *GovernanceRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Governance.Contract.GovernanceCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Governance *GovernanceRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Governance.Contract.GovernanceTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Governance *GovernanceRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Governance.Contract.GovernanceTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Governance *GovernanceCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Governance.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Governance *GovernanceTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Governance.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Governance *GovernanceTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Governance.Contract.contract.Transact(opts, method, params...)
+}
+
+// PRECISION is a free data retrieval call binding the contract method 0xaaf5eb68.
+//
+// Solidity: function PRECISION() view returns(uint256)
+func (_Governance *GovernanceCaller) PRECISION(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Governance.contract.Call(opts, &out, "PRECISION")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PRECISION is a free data retrieval call binding the contract method 0xaaf5eb68.
+//
+// Solidity: function PRECISION() view returns(uint256)
+func (_Governance *GovernanceSession) PRECISION() (*big.Int, error) {
+	return _Governance.Contract.PRECISION(&_Governance.CallOpts)
+}
+
+// PRECISION is a free data retrieval call binding the contract method 0xaaf5eb68.
+//
+// Solidity: function PRECISION() view returns(uint256)
+func (_Governance *GovernanceCallerSession) PRECISION() (*big.Int, error) {
+	return _Governance.Contract.PRECISION(&_Governance.CallOpts)
+}
+
+// GetConfig is a free data retrieval call binding the contract method 0xc3f909d4.
+//
+// Solidity: function getConfig() view returns((uint256,uint256,uint256,uint256,uint256))
+func (_Governance *GovernanceCaller) GetConfig(opts *bind.CallOpts) (GovernanceConfig, error) {
+	var out []interface{}
+	err := _Governance.contract.Call(opts, &out, "getConfig")
+
+	if err != nil {
+		return *new(GovernanceConfig), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(GovernanceConfig)).(*GovernanceConfig)
+
+	return out0, err
+
+}
+
+// GetConfig is a free data retrieval call binding the contract method 0xc3f909d4.
+//
+// Solidity: function getConfig() view returns((uint256,uint256,uint256,uint256,uint256))
+func (_Governance *GovernanceSession) GetConfig() (GovernanceConfig, error) {
+	return _Governance.Contract.GetConfig(&_Governance.CallOpts)
+}
+
+// GetConfig is a free data retrieval call binding the contract method 0xc3f909d4.
+//
+// Solidity: function getConfig() view returns((uint256,uint256,uint256,uint256,uint256))
+func (_Governance *GovernanceCallerSession) GetConfig() (GovernanceConfig, error) {
+	return _Governance.Contract.GetConfig(&_Governance.CallOpts)
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xc7f758a8.
+//
+// Solidity: function getProposal(uint256 _id) view returns((address,string,address[],bytes[],uint256,uint256,uint256,uint256,uint256,uint8))
+func (_Governance *GovernanceCaller) GetProposal(opts *bind.CallOpts, _id *big.Int) (GovernanceProposal, error) {
+	var out []interface{}
+	err := _Governance.contract.Call(opts, &out, "getProposal", _id)
+
+	if err != nil {
+		return *new(GovernanceProposal), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(GovernanceProposal)).(*GovernanceProposal)
+
+	return out0, err
+
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xc7f758a8.
+//
+// Solidity: function getProposal(uint256 _id) view returns((address,string,address[],bytes[],uint256,uint256,uint256,uint256,uint256,uint8))
+func (_Governance *GovernanceSession) GetProposal(_id *big.Int) (GovernanceProposal, error) {
+	return _Governance.Contract.GetProposal(&_Governance.CallOpts, _id)
+}
+
+// GetProposal is a free data retrieval call binding the contract method 0xc7f758a8.
+//
+// Solidity: function getProposal(uint256 _id) view returns((address,string,address[],bytes[],uint256,uint256,uint256,uint256,uint256,uint8))
+func (_Governance *GovernanceCallerSession) GetProposal(_id *big.Int) (GovernanceProposal, error) {
+	return _Governance.Contract.GetProposal(&_Governance.CallOpts, _id)
+}
+
+// GetProposalCount is a free data retrieval call binding the contract method 0xc08cc02d.
+//
+// Solidity: function getProposalCount() view returns(uint256)
+func (_Governance *GovernanceCaller) GetProposalCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Governance.contract.Call(opts, &out, "getProposalCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetProposalCount is a free data retrieval call binding the contract method 0xc08cc02d.
+//
+// Solidity: function getProposalCount() view returns(uint256)
+func (_Governance *GovernanceSession) GetProposalCount() (*big.Int, error) {
+	return _Governance.Contract.GetProposalCount(&_Governance.CallOpts)
+}
+
+// GetProposalCount is a free data retrieval call binding the contract method 0xc08cc02d.
+//
+// Solidity: function getProposalCount() view returns(uint256)
+func (_Governance *GovernanceCallerSession) GetProposalCount() (*big.Int, error) {
+	return _Governance.Contract.GetProposalCount(&_Governance.CallOpts)
+}
+
+// GetVote is a free data retrieval call binding the contract method 0xbc3f931f.
+//
+// Solidity: function getVote(uint256 _id, address _account) view returns((uint8,uint256,address[],uint256[]))
+func (_Governance *GovernanceCaller) GetVote(opts *bind.CallOpts, _id *big.Int, _account common.Address) (GovernanceVote, error) {
+	var out []interface{}
+	err := _Governance.contract.Call(opts, &out, "getVote", _id, _account)
+
+	if err != nil {
+		return *new(GovernanceVote), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(GovernanceVote)).(*GovernanceVote)
+
+	return out0, err
+
+}
+
+// GetVote is a free data retrieval call binding the contract method 0xbc3f931f.
+//
+// Solidity: function getVote(uint256 _id, address _account) view returns((uint8,uint256,address[],uint256[]))
+func (_Governance *GovernanceSession) GetVote(_id *big.Int, _account common.Address) (GovernanceVote, error) {
+	return _Governance.Contract.GetVote(&_Governance.CallOpts, _id, _account)
+}
+
+// GetVote is a free data retrieval call binding the contract method 0xbc3f931f.
+//
+// Solidity: function getVote(uint256 _id, address _account) view returns((uint8,uint256,address[],uint256[]))
+func (_Governance *GovernanceCallerSession) GetVote(_id *big.Int, _account common.Address) (GovernanceVote, error) {
+	return _Governance.Contract.GetVote(&_Governance.CallOpts, _id, _account)
+}
+
+// GetVoters is a free data retrieval call binding the contract method 0x86b646f2.
+//
+// Solidity: function getVoters(uint256 _id) view returns(address[])
+func (_Governance *GovernanceCaller) GetVoters(opts *bind.CallOpts, _id *big.Int) ([]common.Address, error) {
+	var out []interface{}
+	err := _Governance.contract.Call(opts, &out, "getVoters", _id)
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// GetVoters is a free data retrieval call binding the contract method 0x86b646f2.
+//
+// Solidity: function getVoters(uint256 _id) view returns(address[])
+func (_Governance *GovernanceSession) GetVoters(_id *big.Int) ([]common.Address, error) {
+	return _Governance.Contract.GetVoters(&_Governance.CallOpts, _id)
+}
+
+// GetVoters is a free data retrieval call binding the contract method 0x86b646f2.
+//
+// Solidity: function getVoters(uint256 _id) view returns(address[])
+func (_Governance *GovernanceCallerSession) GetVoters(_id *big.Int) ([]common.Address, error) {
+	return _Governance.Contract.GetVoters(&_Governance.CallOpts, _id)
+}
+
+// VotingPower is a free data retrieval call binding the contract method 0x9f30ff30.
+//
+// Solidity: function votingPower(uint256 _id, address _account, address[] _validators) view returns(uint256 _power)
+func (_Governance *GovernanceCaller) VotingPower(opts *bind.CallOpts, _id *big.Int, _account common.Address, _validators []common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Governance.contract.Call(opts, &out, "votingPower", _id, _account, _validators)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// VotingPower is a free data retrieval call binding the contract method 0x9f30ff30.
+//
+// Solidity: function votingPower(uint256 _id, address _account, address[] _validators) view returns(uint256 _power)
+func (_Governance *GovernanceSession) VotingPower(_id *big.Int, _account common.Address, _validators []common.Address) (*big.Int, error) {
+	return _Governance.Contract.VotingPower(&_Governance.CallOpts, _id, _account, _validators)
+}
+
+// VotingPower is a free data retrieval call binding the contract method 0x9f30ff30.
+//
+// Solidity: function votingPower(uint256 _id, address _account, address[] _validators) view returns(uint256 _power)
+func (_Governance *GovernanceCallerSession) VotingPower(_id *big.Int, _account common.Address, _validators []common.Address) (*big.Int, error) {
+	return _Governance.Contract.VotingPower(&_Governance.CallOpts, _id, _account, _validators)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0x40e58ee5.
+//
+// Solidity: function cancel(uint256 _id) returns()
+func (_Governance *GovernanceTransactor) Cancel(opts *bind.TransactOpts, _id *big.Int) (*types.Transaction, error) {
+	return _Governance.contract.Transact(opts, "cancel", _id)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0x40e58ee5.
+//
+// Solidity: function cancel(uint256 _id) returns()
+func (_Governance *GovernanceSession) Cancel(_id *big.Int) (*types.Transaction, error) {
+	return _Governance.Contract.Cancel(&_Governance.TransactOpts, _id)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0x40e58ee5.
+//
+// Solidity: function cancel(uint256 _id) returns()
+func (_Governance *GovernanceTransactorSession) Cancel(_id *big.Int) (*types.Transaction, error) {
+	return _Governance.Contract.Cancel(&_Governance.TransactOpts, _id)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xfe0d94c1.
+//
+// Solidity: function execute(uint256 _id) returns()
+func (_Governance *GovernanceTransactor) Execute(opts *bind.TransactOpts, _id *big.Int) (*types.Transaction, error) {
+	return _Governance.contract.Transact(opts, "execute", _id)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xfe0d94c1.
+//
+// Solidity: function execute(uint256 _id) returns()
+func (_Governance *GovernanceSession) Execute(_id *big.Int) (*types.Transaction, error) {
+	return _Governance.Contract.Execute(&_Governance.TransactOpts, _id)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0xfe0d94c1.
+//
+// Solidity: function execute(uint256 _id) returns()
+func (_Governance *GovernanceTransactorSession) Execute(_id *big.Int) (*types.Transaction, error) {
+	return _Governance.Contract.Execute(&_Governance.TransactOpts, _id)
+}
+
+// Propose is a paid mutator transaction binding the contract method 0x159bf8cf.
+//
+// Solidity: function propose(address[] _targets, bytes[] _calldatas, string _description) returns(uint256)
+func (_Governance *GovernanceTransactor) Propose(opts *bind.TransactOpts, _targets []common.Address, _calldatas [][]byte, _description string) (*types.Transaction, error) {
+	return _Governance.contract.Transact(opts, "propose", _targets, _calldatas, _description)
+}
+
+// Propose is a paid mutator transaction binding the contract method 0x159bf8cf.
+//
+// Solidity: function propose(address[] _targets, bytes[] _calldatas, string _description) returns(uint256)
+func (_Governance *GovernanceSession) Propose(_targets []common.Address, _calldatas [][]byte, _description string) (*types.Transaction, error) {
+	return _Governance.Contract.Propose(&_Governance.TransactOpts, _targets, _calldatas, _description)
+}
+
+// Propose is a paid mutator transaction binding the contract method 0x159bf8cf.
+//
+// Solidity: function propose(address[] _targets, bytes[] _calldatas, string _description) returns(uint256)
+func (_Governance *GovernanceTransactorSession) Propose(_targets []common.Address, _calldatas [][]byte, _description string) (*types.Transaction, error) {
+	return _Governance.Contract.Propose(&_Governance.TransactOpts, _targets, _calldatas, _description)
+}
+
+// Release is a paid mutator transaction binding the contract method 0x37bdc99b.
+//
+// Solidity: function release(uint256 _id) returns()
+func (_Governance *GovernanceTransactor) Release(opts *bind.TransactOpts, _id *big.Int) (*types.Transaction, error) {
+	return _Governance.contract.Transact(opts, "release", _id)
+}
+
+// Release is a paid mutator transaction binding the contract method 0x37bdc99b.
+//
+// Solidity: function release(uint256 _id) returns()
+func (_Governance *GovernanceSession) Release(_id *big.Int) (*types.Transaction, error) {
+	return _Governance.Contract.Release(&_Governance.TransactOpts, _id)
+}
+
+// Release is a paid mutator transaction binding the contract method 0x37bdc99b.
+//
+// Solidity: function release(uint256 _id) returns()
+func (_Governance *GovernanceTransactorSession) Release(_id *big.Int) (*types.Transaction, error) {
+	return _Governance.Contract.Release(&_Governance.TransactOpts, _id)
+}
+
+// SetConfig is a paid mutator transaction binding the contract method 0xe7556912.
+//
+// Solidity: function setConfig((uint256,uint256,uint256,uint256,uint256) _config) returns()
+func (_Governance *GovernanceTransactor) SetConfig(opts *bind.TransactOpts, _config GovernanceConfig) (*types.Transaction, error) {
+	return _Governance.contract.Transact(opts, "setConfig", _config)
+}
+
+// SetConfig is a paid mutator transaction binding the contract method 0xe7556912.
+//
+// Solidity: function setConfig((uint256,uint256,uint256,uint256,uint256) _config) returns()
+func (_Governance *GovernanceSession) SetConfig(_config GovernanceConfig) (*types.Transaction, error) {
+	return _Governance.Contract.SetConfig(&_Governance.TransactOpts, _config)
+}
+
+// SetConfig is a paid mutator transaction binding the contract method 0xe7556912.
+//
+// Solidity: function setConfig((uint256,uint256,uint256,uint256,uint256) _config) returns()
+func (_Governance *GovernanceTransactorSession) SetConfig(_config GovernanceConfig) (*types.Transaction, error) {
+	return _Governance.Contract.SetConfig(&_Governance.TransactOpts, _config)
+}
+
+// Tally is a paid mutator transaction binding the contract method 0xed8b6b31.
+//
+// Solidity: function tally(uint256 _id) returns()
+func (_Governance *GovernanceTransactor) Tally(opts *bind.TransactOpts, _id *big.Int) (*types.Transaction, error) {
+	return _Governance.contract.Transact(opts, "tally", _id)
+}
+
+// Tally is a paid mutator transaction binding the contract method 0xed8b6b31.
+//
+// Solidity: function tally(uint256 _id) returns()
+func (_Governance *GovernanceSession) Tally(_id *big.Int) (*types.Transaction, error) {
+	return _Governance.Contract.Tally(&_Governance.TransactOpts, _id)
+}
+
+// Tally is a paid mutator transaction binding the contract method 0xed8b6b31.
+//
+// Solidity: function tally(uint256 _id) returns()
+func (_Governance *GovernanceTransactorSession) Tally(_id *big.Int) (*types.Transaction, error) {
+	return _Governance.Contract.Tally(&_Governance.TransactOpts, _id)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x86f05678.
+//
+// Solidity: function vote(uint256 _id, bool _support, address[] _validators) returns()
+func (_Governance *GovernanceTransactor) Vote(opts *bind.TransactOpts, _id *big.Int, _support bool, _validators []common.Address) (*types.Transaction, error) {
+	return _Governance.contract.Transact(opts, "vote", _id, _support, _validators)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x86f05678.
+//
+// Solidity: function vote(uint256 _id, bool _support, address[] _validators) returns()
+func (_Governance *GovernanceSession) Vote(_id *big.Int, _support bool, _validators []common.Address) (*types.Transaction, error) {
+	return _Governance.Contract.Vote(&_Governance.TransactOpts, _id, _support, _validators)
+}
+
+// Vote is a paid mutator transaction binding the contract method 0x86f05678.
+//
+// Solidity: function vote(uint256 _id, bool _support, address[] _validators) returns()
+func (_Governance *GovernanceTransactorSession) Vote(_id *big.Int, _support bool, _validators []common.Address) (*types.Transaction, error) {
+	return _Governance.Contract.Vote(&_Governance.TransactOpts, _id, _support, _validators)
+}
+
+// GovernanceConfigUpdatedIterator is returned from FilterConfigUpdated and is used to iterate over the raw logs and unpacked data for ConfigUpdated events raised by the Governance contract.
+type GovernanceConfigUpdatedIterator struct {
+	Event *GovernanceConfigUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceConfigUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceConfigUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceConfigUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceConfigUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceConfigUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceConfigUpdated represents a ConfigUpdated event raised by the Governance contract.
+type GovernanceConfigUpdated struct {
+	VotingPeriod   *big.Int
+	Quorum         *big.Int
+	Threshold      *big.Int
+	Timelock       *big.Int
+	MinVotingPower *big.Int
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterConfigUpdated is a free log retrieval operation binding the contract event 0x2ba978126f856e763cfc2e7694871d83c30f5c931c5e16443db1e38c63dafd21.
+//
+// Solidity: event ConfigUpdated(uint256 votingPeriod, uint256 quorum, uint256 threshold, uint256 timelock, uint256 minVotingPower)
+func (_Governance *GovernanceFilterer) FilterConfigUpdated(opts *bind.FilterOpts) (*GovernanceConfigUpdatedIterator, error) {
+
+	logs, sub, err := _Governance.contract.FilterLogs(opts, "ConfigUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceConfigUpdatedIterator{contract: _Governance.contract, event: "ConfigUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchConfigUpdated is a free log subscription operation binding the contract event 0x2ba978126f856e763cfc2e7694871d83c30f5c931c5e16443db1e38c63dafd21.
+//
+// Solidity: event ConfigUpdated(uint256 votingPeriod, uint256 quorum, uint256 threshold, uint256 timelock, uint256 minVotingPower)
+func (_Governance *GovernanceFilterer) WatchConfigUpdated(opts *bind.WatchOpts, sink chan<- *GovernanceConfigUpdated) (event.Subscription, error) {
+
+	logs, sub, err := _Governance.contract.WatchLogs(opts, "ConfigUpdated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovernanceConfigUpdated)
+				if err := _Governance.contract.UnpackLog(event, "ConfigUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseConfigUpdated is a log parse operation binding the contract event 0x2ba978126f856e763cfc2e7694871d83c30f5c931c5e16443db1e38c63dafd21.
+//
+// Solidity: event ConfigUpdated(uint256 votingPeriod, uint256 quorum, uint256 threshold, uint256 timelock, uint256 minVotingPower)
+func (_Governance *GovernanceFilterer) ParseConfigUpdated(log types.Log) (*GovernanceConfigUpdated, error) {
+	event := new(GovernanceConfigUpdated)
+	if err := _Governance.contract.UnpackLog(event, "ConfigUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovernanceProposalCancelledIterator is returned from FilterProposalCancelled and is used to iterate over the raw logs and unpacked data for ProposalCancelled events raised by the Governance contract.
+type GovernanceProposalCancelledIterator struct {
+	Event *GovernanceProposalCancelled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceProposalCancelledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceProposalCancelled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceProposalCancelled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceProposalCancelledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceProposalCancelledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceProposalCancelled represents a ProposalCancelled event raised by the Governance contract.
+type GovernanceProposalCancelled struct {
+	Id  *big.Int
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalCancelled is a free log retrieval operation binding the contract event 0x416e669c63d9a3a5e36ee7cc7e2104b8db28ccd286aa18966e98fa230c73b08c.
+//
+// Solidity: event ProposalCancelled(uint256 indexed id)
+func (_Governance *GovernanceFilterer) FilterProposalCancelled(opts *bind.FilterOpts, id []*big.Int) (*GovernanceProposalCancelledIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _Governance.contract.FilterLogs(opts, "ProposalCancelled", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceProposalCancelledIterator{contract: _Governance.contract, event: "ProposalCancelled", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalCancelled is a free log subscription operation binding the contract event 0x416e669c63d9a3a5e36ee7cc7e2104b8db28ccd286aa18966e98fa230c73b08c.
+//
+// Solidity: event ProposalCancelled(uint256 indexed id)
+func (_Governance *GovernanceFilterer) WatchProposalCancelled(opts *bind.WatchOpts, sink chan<- *GovernanceProposalCancelled, id []*big.Int) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _Governance.contract.WatchLogs(opts, "ProposalCancelled", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovernanceProposalCancelled)
+				if err := _Governance.contract.UnpackLog(event, "ProposalCancelled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalCancelled is a log parse operation binding the contract event 0x416e669c63d9a3a5e36ee7cc7e2104b8db28ccd286aa18966e98fa230c73b08c.
+//
+// Solidity: event ProposalCancelled(uint256 indexed id)
+func (_Governance *GovernanceFilterer) ParseProposalCancelled(log types.Log) (*GovernanceProposalCancelled, error) {
+	event := new(GovernanceProposalCancelled)
+	if err := _Governance.contract.UnpackLog(event, "ProposalCancelled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovernanceProposalCreatedIterator is returned from FilterProposalCreated and is used to iterate over the raw logs and unpacked data for ProposalCreated events raised by the Governance contract.
+type GovernanceProposalCreatedIterator struct {
+	Event *GovernanceProposalCreated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceProposalCreatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceProposalCreated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceProposalCreated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceProposalCreatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceProposalCreatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceProposalCreated represents a ProposalCreated event raised by the Governance contract.
+type GovernanceProposalCreated struct {
+	Id          *big.Int
+	Proposer    common.Address
+	Targets     []common.Address
+	Calldatas   [][]byte
+	Description string
+	EndBlock    *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalCreated is a free log retrieval operation binding the contract event 0x3cb586fc92d2dd2c453581211155b0ef86a580179e1bffd96b3590ceb5945b65.
+//
+// Solidity: event ProposalCreated(uint256 indexed id, address indexed proposer, address[] targets, bytes[] calldatas, string description, uint256 endBlock)
+func (_Governance *GovernanceFilterer) FilterProposalCreated(opts *bind.FilterOpts, id []*big.Int, proposer []common.Address) (*GovernanceProposalCreatedIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var proposerRule []interface{}
+	for _, proposerItem := range proposer {
+		proposerRule = append(proposerRule, proposerItem)
+	}
+
+	logs, sub, err := _Governance.contract.FilterLogs(opts, "ProposalCreated", idRule, proposerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceProposalCreatedIterator{contract: _Governance.contract, event: "ProposalCreated", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalCreated is a free log subscription operation binding the contract event 0x3cb586fc92d2dd2c453581211155b0ef86a580179e1bffd96b3590ceb5945b65.
+//
+// Solidity: event ProposalCreated(uint256 indexed id, address indexed proposer, address[] targets, bytes[] calldatas, string description, uint256 endBlock)
+func (_Governance *GovernanceFilterer) WatchProposalCreated(opts *bind.WatchOpts, sink chan<- *GovernanceProposalCreated, id []*big.Int, proposer []common.Address) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var proposerRule []interface{}
+	for _, proposerItem := range proposer {
+		proposerRule = append(proposerRule, proposerItem)
+	}
+
+	logs, sub, err := _Governance.contract.WatchLogs(opts, "ProposalCreated", idRule, proposerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovernanceProposalCreated)
+				if err := _Governance.contract.UnpackLog(event, "ProposalCreated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalCreated is a log parse operation binding the contract event 0x3cb586fc92d2dd2c453581211155b0ef86a580179e1bffd96b3590ceb5945b65.
+//
+// Solidity: event ProposalCreated(uint256 indexed id, address indexed proposer, address[] targets, bytes[] calldatas, string description, uint256 endBlock)
+func (_Governance *GovernanceFilterer) ParseProposalCreated(log types.Log) (*GovernanceProposalCreated, error) {
+	event := new(GovernanceProposalCreated)
+	if err := _Governance.contract.UnpackLog(event, "ProposalCreated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovernanceProposalExecutedIterator is returned from FilterProposalExecuted and is used to iterate over the raw logs and unpacked data for ProposalExecuted events raised by the Governance contract.
+type GovernanceProposalExecutedIterator struct {
+	Event *GovernanceProposalExecuted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceProposalExecutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceProposalExecuted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceProposalExecuted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceProposalExecutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceProposalExecutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceProposalExecuted represents a ProposalExecuted event raised by the Governance contract.
+type GovernanceProposalExecuted struct {
+	Id  *big.Int
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalExecuted is a free log retrieval operation binding the contract event 0x712ae1383f79ac853f8d882153778e0260ef8f03b504e2866e0593e04d2b291f.
+//
+// Solidity: event ProposalExecuted(uint256 indexed id)
+func (_Governance *GovernanceFilterer) FilterProposalExecuted(opts *bind.FilterOpts, id []*big.Int) (*GovernanceProposalExecutedIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _Governance.contract.FilterLogs(opts, "ProposalExecuted", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceProposalExecutedIterator{contract: _Governance.contract, event: "ProposalExecuted", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalExecuted is a free log subscription operation binding the contract event 0x712ae1383f79ac853f8d882153778e0260ef8f03b504e2866e0593e04d2b291f.
+//
+// Solidity: event ProposalExecuted(uint256 indexed id)
+func (_Governance *GovernanceFilterer) WatchProposalExecuted(opts *bind.WatchOpts, sink chan<- *GovernanceProposalExecuted, id []*big.Int) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _Governance.contract.WatchLogs(opts, "ProposalExecuted", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovernanceProposalExecuted)
+				if err := _Governance.contract.UnpackLog(event, "ProposalExecuted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalExecuted is a log parse operation binding the contract event 0x712ae1383f79ac853f8d882153778e0260ef8f03b504e2866e0593e04d2b291f.
+//
+// Solidity: event ProposalExecuted(uint256 indexed id)
+func (_Governance *GovernanceFilterer) ParseProposalExecuted(log types.Log) (*GovernanceProposalExecuted, error) {
+	event := new(GovernanceProposalExecuted)
+	if err := _Governance.contract.UnpackLog(event, "ProposalExecuted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovernanceProposalTalliedIterator is returned from FilterProposalTallied and is used to iterate over the raw logs and unpacked data for ProposalTallied events raised by the Governance contract.
+type GovernanceProposalTalliedIterator struct {
+	Event *GovernanceProposalTallied // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceProposalTalliedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceProposalTallied)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceProposalTallied)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceProposalTalliedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceProposalTalliedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceProposalTallied represents a ProposalTallied event raised by the Governance contract.
+type GovernanceProposalTallied struct {
+	Id       *big.Int
+	YesVotes *big.Int
+	NoVotes  *big.Int
+	Accepted bool
+	Eta      *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterProposalTallied is a free log retrieval operation binding the contract event 0x462a743cfc5478a80328a625e4961bcae9b1cf71b711c00bfc4d1ec7dbb79d43.
+//
+// Solidity: event ProposalTallied(uint256 indexed id, uint256 yesVotes, uint256 noVotes, bool accepted, uint256 eta)
+func (_Governance *GovernanceFilterer) FilterProposalTallied(opts *bind.FilterOpts, id []*big.Int) (*GovernanceProposalTalliedIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _Governance.contract.FilterLogs(opts, "ProposalTallied", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceProposalTalliedIterator{contract: _Governance.contract, event: "ProposalTallied", logs: logs, sub: sub}, nil
+}
+
+// WatchProposalTallied is a free log subscription operation binding the contract event 0x462a743cfc5478a80328a625e4961bcae9b1cf71b711c00bfc4d1ec7dbb79d43.
+//
+// Solidity: event ProposalTallied(uint256 indexed id, uint256 yesVotes, uint256 noVotes, bool accepted, uint256 eta)
+func (_Governance *GovernanceFilterer) WatchProposalTallied(opts *bind.WatchOpts, sink chan<- *GovernanceProposalTallied, id []*big.Int) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _Governance.contract.WatchLogs(opts, "ProposalTallied", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(GovernanceProposalTallied)
+				if err := _Governance.contract.UnpackLog(event, "ProposalTallied", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseProposalTallied is a log parse operation binding the contract event 0x462a743cfc5478a80328a625e4961bcae9b1cf71b711c00bfc4d1ec7dbb79d43.
+//
+// Solidity: event ProposalTallied(uint256 indexed id, uint256 yesVotes, uint256 noVotes, bool accepted, uint256 eta)
+func (_Governance *GovernanceFilterer) ParseProposalTallied(log types.Log) (*GovernanceProposalTallied, error) {
+	event := new(GovernanceProposalTallied)
+	if err := _Governance.contract.UnpackLog(event, "ProposalTallied", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// GovernanceVoteCastIterator is returned from FilterVoteCast and is used to iterate over the raw logs and unpacked data for VoteCast events raised by the Governance contract.
+type GovernanceVoteCastIterator struct {
+	Event *GovernanceVoteCast // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *GovernanceVoteCastIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(GovernanceVoteCast)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(GovernanceVoteCast)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *GovernanceVoteCastIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *GovernanceVoteCastIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// GovernanceVoteCast represents a VoteCast event raised by the Governance contract.
+type GovernanceVoteCast struct {
+	Id      *big.Int
+	Voter   common.Address
+	Support bool
+	Power   *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterVoteCast is a free log retrieval operation binding the contract event 0xcbdf6214089cba887ecbf35a0b6a734589959c9763342c756bb2a80ca2bc9f6e.
+//
+// Solidity: event VoteCast(uint256 indexed id, address indexed voter, bool support, uint256 power)
+func (_Governance *GovernanceFilterer) FilterVoteCast(opts *bind.FilterOpts, id []*big.Int, voter []common.Address) (*GovernanceVoteCastIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
 	}
 
-	logs, sub, err := _AutonityUpgradeTest.contract.FilterLogs(opts, "Rewarded", addrRule)
+	logs, sub, err := _Governance.contract.FilterLogs(opts, "VoteCast", idRule, voterRule)
 	if err != nil {
 		return nil, err
 	}
-	return &AutonityUpgradeTestRewardedIterator{contract: _AutonityUpgradeTest.contract, event: "Rewarded", logs: logs, sub: sub}, nil
+	return &GovernanceVoteCastIterator{contract: _Governance.contract, event: "VoteCast", logs: logs, sub: sub}, nil
 }
 
-// WatchRewarded is a free log subscription operation binding the contract event 0xb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe563.
+// WatchVoteCast is a free log subscription operation binding the contract event 0xcbdf6214089cba887ecbf35a0b6a734589959c9763342c756bb2a80ca2bc9f6e.
 //
-// Solidity: event Rewarded(address indexed addr, uint256 amount)
-func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchRewarded(opts *bind.WatchOpts, sink chan<- *AutonityUpgradeTestRewarded, addr []common.Address) (event.Subscription, error) {
+// Solidity: event VoteCast(uint256 indexed id, address indexed voter, bool support, uint256 power)
+func (_Governance *GovernanceFilterer) WatchVoteCast(opts *bind.WatchOpts, sink chan<- *GovernanceVoteCast, id []*big.Int, voter []common.Address) (event.Subscription, error) {
 
-	var addrRule []interface{}
-	for _, addrItem := range addr {
-		addrRule = append(addrRule, addrItem)
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
 	}
 
-	logs, sub, err := _AutonityUpgradeTest.contract.WatchLogs(opts, "Rewarded", addrRule)
+	logs, sub, err := _Governance.contract.WatchLogs(opts, "VoteCast", idRule, voterRule)
 	if err != nil {
 		return nil, err
 	}
@@ -10199,8 +12420,8 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchRewarded(opts *bin
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(AutonityUpgradeTestRewarded)
-				if err := _AutonityUpgradeTest.contract.UnpackLog(event, "Rewarded", log); err != nil {
+				event := new(GovernanceVoteCast)
+				if err := _Governance.contract.UnpackLog(event, "VoteCast", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -10221,21 +12442,21 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchRewarded(opts *bin
 	}), nil
 }
 
-// ParseRewarded is a log parse operation binding the contract event 0xb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe563.
+// ParseVoteCast is a log parse operation binding the contract event 0xcbdf6214089cba887ecbf35a0b6a734589959c9763342c756bb2a80ca2bc9f6e.
 //
-// Solidity: event Rewarded(address indexed addr, uint256 amount)
-func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) ParseRewarded(log types.Log) (*AutonityUpgradeTestRewarded, error) {
-	event := new(AutonityUpgradeTestRewarded)
-	if err := _AutonityUpgradeTest.contract.UnpackLog(event, "Rewarded", log); err != nil {
+// Solidity: event VoteCast(uint256 indexed id, address indexed voter, bool support, uint256 power)
+func (_Governance *GovernanceFilterer) ParseVoteCast(log types.Log) (*GovernanceVoteCast, error) {
+	event := new(GovernanceVoteCast)
+	if err := _Governance.contract.UnpackLog(event, "VoteCast", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// AutonityUpgradeTestTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the AutonityUpgradeTest contract.
-type AutonityUpgradeTestTransferIterator struct {
-	Event *AutonityUpgradeTestTransfer // Event containing the contract specifics and raw log
+// GovernanceVoteReleasedIterator is returned from FilterVoteReleased and is used to iterate over the raw logs and unpacked data for VoteReleased events raised by the Governance contract.
+type GovernanceVoteReleasedIterator struct {
+	Event *GovernanceVoteReleased // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -10249,7 +12470,7 @@ type AutonityUpgradeTestTransferIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *AutonityUpgradeTestTransferIterator) Next() bool {
+func (it *GovernanceVoteReleasedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -10258,7 +12479,7 @@ func (it *AutonityUpgradeTestTransferIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(AutonityUpgradeTestTransfer)
+			it.Event = new(GovernanceVoteReleased)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -10273,7 +12494,7 @@ func (it *AutonityUpgradeTestTransferIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(AutonityUpgradeTestTransfer)
+		it.Event = new(GovernanceVoteReleased)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -10289,61 +12510,60 @@ func (it *AutonityUpgradeTestTransferIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *AutonityUpgradeTestTransferIterator) Error() error {
+func (it *GovernanceVoteReleasedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *AutonityUpgradeTestTransferIterator) Close() error {
+func (it *GovernanceVoteReleasedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// AutonityUpgradeTestTransfer represents a Transfer event raised by the AutonityUpgradeTest contract.
-type AutonityUpgradeTestTransfer struct {
-	From  common.Address
-	To    common.Address
-	Value *big.Int
+// GovernanceVoteReleased represents a VoteReleased event raised by the Governance contract.
+type GovernanceVoteReleased struct {
+	Id    *big.Int
+	Voter common.Address
 	Raw   types.Log // Blockchain specific contextual infos
 }
 
-// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// FilterVoteReleased is a free log retrieval operation binding the contract event 0xf2d1b6805ed805e90676fe890865e6e22992435036a1637c2e19ca60ef1716b2.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*AutonityUpgradeTestTransferIterator, error) {
+// Solidity: event VoteReleased(uint256 indexed id, address indexed voter)
+func (_Governance *GovernanceFilterer) FilterVoteReleased(opts *bind.FilterOpts, id []*big.Int, voter []common.Address) (*GovernanceVoteReleasedIterator, error) {
 
-	var fromRule []interface{}
-	for _, fromItem := range from {
-		fromRule = append(fromRule, fromItem)
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
 	}
-	var toRule []interface{}
-	for _, toItem := range to {
-		toRule = append(toRule, toItem)
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
 	}
 
-	logs, sub, err := _AutonityUpgradeTest.contract.FilterLogs(opts, "Transfer", fromRule, toRule)
+	logs, sub, err := _Governance.contract.FilterLogs(opts, "VoteReleased", idRule, voterRule)
 	if err != nil {
 		return nil, err
 	}
-	return &AutonityUpgradeTestTransferIterator{contract: _AutonityUpgradeTest.contract, event: "Transfer", logs: logs, sub: sub}, nil
+	return &GovernanceVoteReleasedIterator{contract: _Governance.contract, event: "VoteReleased", logs: logs, sub: sub}, nil
 }
 
-// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// WatchVoteReleased is a free log subscription operation binding the contract event 0xf2d1b6805ed805e90676fe890865e6e22992435036a1637c2e19ca60ef1716b2.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *AutonityUpgradeTestTransfer, from []common.Address, to []common.Address) (event.Subscription, error) {
+// Solidity: event VoteReleased(uint256 indexed id, address indexed voter)
+func (_Governance *GovernanceFilterer) WatchVoteReleased(opts *bind.WatchOpts, sink chan<- *GovernanceVoteReleased, id []*big.Int, voter []common.Address) (event.Subscription, error) {
 
-	var fromRule []interface{}
-	for _, fromItem := range from {
-		fromRule = append(fromRule, fromItem)
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
 	}
-	var toRule []interface{}
-	for _, toItem := range to {
-		toRule = append(toRule, toItem)
+	var voterRule []interface{}
+	for _, voterItem := range voter {
+		voterRule = append(voterRule, voterItem)
 	}
 
-	logs, sub, err := _AutonityUpgradeTest.contract.WatchLogs(opts, "Transfer", fromRule, toRule)
+	logs, sub, err := _Governance.contract.WatchLogs(opts, "VoteReleased", idRule, voterRule)
 	if err != nil {
 		return nil, err
 	}
@@ -10353,8 +12573,8 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchTransfer(opts *bin
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(AutonityUpgradeTestTransfer)
-				if err := _AutonityUpgradeTest.contract.UnpackLog(event, "Transfer", log); err != nil {
+				event := new(GovernanceVoteReleased)
+				if err := _Governance.contract.UnpackLog(event, "VoteReleased", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -10375,191 +12595,18 @@ func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) WatchTransfer(opts *bin
 	}), nil
 }
 
-// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+// ParseVoteReleased is a log parse operation binding the contract event 0xf2d1b6805ed805e90676fe890865e6e22992435036a1637c2e19ca60ef1716b2.
 //
-// Solidity: event Transfer(address indexed from, address indexed to, uint256 value)
-func (_AutonityUpgradeTest *AutonityUpgradeTestFilterer) ParseTransfer(log types.Log) (*AutonityUpgradeTestTransfer, error) {
-	event := new(AutonityUpgradeTestTransfer)
-	if err := _AutonityUpgradeTest.contract.UnpackLog(event, "Transfer", log); err != nil {
+// Solidity: event VoteReleased(uint256 indexed id, address indexed voter)
+func (_Governance *GovernanceFilterer) ParseVoteReleased(log types.Log) (*GovernanceVoteReleased, error) {
+	event := new(GovernanceVoteReleased)
+	if err := _Governance.contract.UnpackLog(event, "VoteReleased", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// BytesLibMetaData contains all meta data concerning the BytesLib contract.
-var BytesLibMetaData = &bind.MetaData{
-	ABI: "[]",
-	Bin: "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea2646970667358221220831cbf1d093207c822ca0bb8903ee29676712d9a6abe4b340d9c5562c8a851e464736f6c63430008150033",
-}
-
-// BytesLibABI is the input ABI used to generate the binding from.
-// Deprecated: Use BytesLibMetaData.ABI instead.
-var BytesLibABI = BytesLibMetaData.ABI
-
-// BytesLibBin is the compiled bytecode used for deploying new contracts.
-// Deprecated: Use BytesLibMetaData.Bin instead.
-var BytesLibBin = BytesLibMetaData.Bin
-
-// DeployBytesLib deploys a new Ethereum contract, binding an instance of BytesLib to it.
-func DeployBytesLib(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *BytesLib, error) {
-	parsed, err := BytesLibMetaData.GetAbi()
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-	if parsed == nil {
-		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
-	}
-
-	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(BytesLibBin), backend)
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-	return address, tx, &BytesLib{BytesLibCaller: BytesLibCaller{contract: contract}, BytesLibTransactor: BytesLibTransactor{contract: contract}, BytesLibFilterer: BytesLibFilterer{contract: contract}}, nil
-}
-
-// BytesLib is an auto generated Go binding around an Ethereum contract.
-type BytesLib struct {
-	BytesLibCaller     // Read-only binding to the contract
-	BytesLibTransactor // Write-only binding to the contract
-	BytesLibFilterer   // Log filterer for contract events
-}
-
-// BytesLibCaller is an auto generated read-only Go binding around an Ethereum contract.
-type BytesLibCaller struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
-}
-
-// BytesLibTransactor is an auto generated write-only Go binding around an Ethereum contract.
-type BytesLibTransactor struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
-}
-
-// BytesLibFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
-type BytesLibFilterer struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
-}
-
-// BytesLibSession is an auto generated Go binding around an Ethereum contract,
-// with pre-set call and transact options.
-type BytesLibSession struct {
-	Contract     *BytesLib         // Generic contract binding to set the session for
-	CallOpts     bind.CallOpts     // Call options to use throughout this session
-	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
-}
-
-// BytesLibCallerSession is an auto generated read-only Go binding around an Ethereum contract,
-// with pre-set call options.
-type BytesLibCallerSession struct {
-	Contract *BytesLibCaller // Generic contract caller binding to set the session for
-	CallOpts bind.CallOpts   // Call options to use throughout this session
-}
-
-// BytesLibTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
-// with pre-set transact options.
-type BytesLibTransactorSession struct {
-	Contract     *BytesLibTransactor // Generic contract transactor binding to set the session for
-	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
-}
-
-// BytesLibRaw is an auto generated low-level Go binding around an Ethereum contract.
-type BytesLibRaw struct {
-	Contract *BytesLib // Generic contract binding to access the raw methods on
-}
-
-// BytesLibCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
-type BytesLibCallerRaw struct {
-	Contract *BytesLibCaller // Generic read-only contract binding to access the raw methods on
-}
-
-// BytesLibTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
-type BytesLibTransactorRaw struct {
-	Contract *BytesLibTransactor // Generic write-only contract binding to access the raw methods on
-}
-
-// NewBytesLib creates a new instance of BytesLib, bound to a specific deployed contract.
-func NewBytesLib(address common.Address, backend bind.ContractBackend) (*BytesLib, error) {
-	contract, err := bindBytesLib(address, backend, backend, backend)
-	if err != nil {
-		return nil, err
-	}
-	return &BytesLib{BytesLibCaller: BytesLibCaller{contract: contract}, BytesLibTransactor: BytesLibTransactor{contract: contract}, BytesLibFilterer: BytesLibFilterer{contract: contract}}, nil
-}
-
-// NewBytesLibCaller creates a new read-only instance of BytesLib, bound to a specific deployed contract.
-func NewBytesLibCaller(address common.Address, caller bind.ContractCaller) (*BytesLibCaller, error) {
-	contract, err := bindBytesLib(address, caller, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	return &BytesLibCaller{contract: contract}, nil
-}
-
-// NewBytesLibTransactor creates a new write-only instance of BytesLib, bound to a specific deployed contract.
-func NewBytesLibTransactor(address common.Address, transactor bind.ContractTransactor) (*BytesLibTransactor, error) {
-	contract, err := bindBytesLib(address, nil, transactor, nil)
-	if err != nil {
-		return nil, err
-	}
-	return &BytesLibTransactor{contract: contract}, nil
-}
-
-// NewBytesLibFilterer creates a new log filterer instance of BytesLib, bound to a specific deployed contract.
-func NewBytesLibFilterer(address common.Address, filterer bind.ContractFilterer) (*BytesLibFilterer, error) {
-	contract, err := bindBytesLib(address, nil, nil, filterer)
-	if err != nil {
-		return nil, err
-	}
-	return &BytesLibFilterer{contract: contract}, nil
-}
-
-// bindBytesLib binds a generic wrapper to an already deployed contract.
-func bindBytesLib(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
-	parsed, err := abi.JSON(strings.NewReader(BytesLibABI))
-	if err != nil {
-		return nil, err
-	}
-	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
-}
-
-// Call invokes the (constant) contract method with params as input values and
-// sets the output to result. The result type might be a single field for simple
-// returns, a slice of interfaces for anonymous returns and a struct for named
-// returns.
-func (_BytesLib *BytesLibRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _BytesLib.Contract.BytesLibCaller.contract.Call(opts, result, method, params...)
-}
-
-// Transfer initiates a plain transaction to move funds to the contract, calling
-// its default method if one is available.
-func (_BytesLib *BytesLibRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _BytesLib.Contract.BytesLibTransactor.contract.Transfer(opts)
-}
-
-// Transact invokes the (paid) contract method with params as input values.
-func (_BytesLib *BytesLibRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _BytesLib.Contract.BytesLibTransactor.contract.Transact(opts, method, params...)
-}
-
-// Call invokes the (constant) contract method with params as input values and
-// sets the output to result. The result type might be a single field for simple
-// returns, a slice of interfaces for anonymous returns and a struct for named
-// returns.
-func (_BytesLib *BytesLibCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _BytesLib.Contract.contract.Call(opts, result, method, params...)
-}
-
-// Transfer initiates a plain transaction to move funds to the contract, calling
-// its default method if one is available.
-func (_BytesLib *BytesLibTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _BytesLib.Contract.contract.Transfer(opts)
-}
-
-// Transact invokes the (paid) contract method with params as input values.
-func (_BytesLib *BytesLibTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _BytesLib.Contract.contract.Transact(opts, method, params...)
-}
-
 // HelpersMetaData contains all meta data concerning the Helpers contract.
 var HelpersMetaData = &bind.MetaData{
 	ABI: "[]",
@@ -14350,7 +16397,7 @@ var LiquidMetaData = &bind.MetaData{
 		"84955c88": "unlockedBalanceOf(address)",
 		"3a5381b5": "validator()",
 	},
-	Bin: "0x60806040523480156200001157600080fd5b506040516200153138038062001531833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61115980620003d86000396000f3fe60806040526004361061014b5760003560e01c806359355736116100b6578063949813b81161006f578063949813b8146103c557806395d89b41146103e55780639dc29fac146103fa578063a9059cbb1461041a578063dd62ed3e1461043a578063fb489a7b1461048057600080fd5b806359355736146102e35780635ea1d6f81461031957806361d027b31461032f57806370a082311461034f5780637eee288d1461038557806384955c88146103a557600080fd5b8063282d3fdf11610108578063282d3fdf146102245780632f2c3f2e14610244578063313ce5671461025a578063372500ab146102765780633a5381b51461028b57806340c10f19146102c357600080fd5b806306fdde0314610150578063095ea7b31461017b57806318160ddd146101ab578063187cf4d7146101ca57806319fac8fd146101e257806323b872dd14610204575b600080fd5b34801561015c57600080fd5b50610165610488565b6040516101729190610ece565b60405180910390f35b34801561018757600080fd5b5061019b610196366004610f38565b61051a565b6040519015158152602001610172565b3480156101b757600080fd5b506004545b604051908152602001610172565b3480156101d657600080fd5b506101bc633b9aca0081565b3480156101ee57600080fd5b506102026101fd366004610f62565b610531565b005b34801561021057600080fd5b5061019b61021f366004610f7b565b610569565b34801561023057600080fd5b5061020261023f366004610f38565b61065c565b34801561025057600080fd5b506101bc61271081565b34801561026657600080fd5b5060405160128152602001610172565b34801561028257600080fd5b50610202610741565b34801561029757600080fd5b50600a546102ab906001600160a01b031681565b6040516001600160a01b039091168152602001610172565b3480156102cf57600080fd5b506102026102de366004610f38565b6107ef565b3480156102ef57600080fd5b506101bc6102fe366004610fb7565b6001600160a01b031660009081526002602052604090205490565b34801561032557600080fd5b506101bc600c5481565b34801561033b57600080fd5b50600b546102ab906001600160a01b031681565b34801561035b57600080fd5b506101bc61036a366004610fb7565b6001600160a01b031660009081526001602052604090205490565b34801561039157600080fd5b506102026103a0366004610f38565b610857565b3480156103b157600080fd5b506101bc6103c0366004610fb7565b61091d565b3480156103d157600080fd5b506101bc6103e0366004610fb7565b61094b565b3480156103f157600080fd5b50610165610979565b34801561040657600080fd5b50610202610415366004610f38565b610988565b34801561042657600080fd5b5061019b610435366004610f38565b6109e8565b34801561044657600080fd5b506101bc610455366004610fd9565b6001600160a01b03918216600090815260036020908152604080832093909416825291909152205490565b6101bc610a35565b6060600880546104979061100c565b80601f01602080910402602001604051908101604052809291908181526020018280546104c39061100c565b80156105105780601f106104e557610100808354040283529160200191610510565b820191906000526020600020905b8154815290600101906020018083116104f357829003601f168201915b5050505050905090565b6000610527338484610b9d565b5060015b92915050565b6000546001600160a01b031633146105645760405162461bcd60e51b815260040161055b90611046565b60405180910390fd5b600c55565b6001600160a01b0383166000908152600360209081526040808320338452909152812054828110156105ee5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b606482015260840161055b565b61060285336105fd86856110a4565b610b9d565b61060c8584610cc1565b6106168484610da9565b836001600160a01b0316856001600160a01b03166000805160206111048339815191528560405161064991815260200190565b60405180910390a3506001949350505050565b6000546001600160a01b031633146106865760405162461bcd60e51b815260040161055b90611046565b6001600160a01b03821660009081526002602090815260408083205460019092529091205482916106b6916110a4565b10156107105760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110b7565b90915550505050565b600061074c33610df4565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461079e576040519150601f19603f3d011682016040523d82523d6000602084013e6107a3565b606091505b50509050806107eb5760405162461bcd60e51b81526020600482015260146024820152732330b4b632b2103a379039b2b7321022ba3432b960611b604482015260640161055b565b5050565b6000546001600160a01b031633146108195760405162461bcd60e51b815260040161055b90611046565b6108238282610da9565b6040518181526001600160a01b03831690600090600080516020611104833981519152906020015b60405180910390a35050565b6000546001600160a01b031633146108815760405162461bcd60e51b815260040161055b90611046565b6001600160a01b0382166000908152600260205260409020548111156108f55760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110a4565b6001600160a01b038116600090815260026020908152604080832054600190925282205461052b91906110a4565b600061095682610e59565b6001600160a01b03831660009081526005602052604090205461052b91906110b7565b6060600980546104979061100c565b6000546001600160a01b031633146109b25760405162461bcd60e51b815260040161055b90611046565b6109bc8282610cc1565b6040518181526000906001600160a01b038416906000805160206111048339815191529060200161084b565b60006109f43383610cc1565b6109fe8383610da9565b6040518281526001600160a01b0384169033906000805160206111048339815191529060200160405180910390a350600192915050565b600080546001600160a01b03163314610a605760405162461bcd60e51b815260040161055b90611046565b600c54349060009061271090610a7690846110ca565b610a8091906110e1565b905081811115610ad25760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f72207265776172640000000000000000604482015260640161055b565b610adc81836110a4565b600b546040519193506001600160a01b0316906108fc9083906000818181858888f193505050503d8060008114610b2f576040519150601f19603f3d011682016040523d82523d6000602084013e610b34565b606091505b505060045460009150610b4b633b9aca00856110ca565b610b5591906110e1565b905080600754610b6591906110b7565b600755600454600090633b9aca0090610b7e90846110ca565b610b8891906110e1565b9050610b9481846110b7565b94505050505090565b6001600160a01b038316610bff5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840161055b565b6001600160a01b038216610c605760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840161055b565b6001600160a01b0383811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b610cca82610df4565b506001600160a01b038216600090815260016020908152604080832054600290925290912054610cfa90826110a4565b821115610d495760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e64730000000000604482015260640161055b565b610d5382826110a4565b6001600160a01b038416600090815260016020526040902055808203610d8d576001600160a01b0383166000908152600660205260408120555b8160046000828254610d9f91906110a4565b9091555050505050565b610db282610df4565b506001600160a01b03821660009081526001602052604081208054839290610ddb9084906110b7565b92505081905550806004600082825461073891906110b7565b600080610e0083610e59565b6001600160a01b038416600090815260056020526040902054909150610e279082906110b7565b6001600160a01b0390931660009081526005602090815260408083208690556007546006909252909120555090919050565b6001600160a01b038116600090815260016020526040812054808203610e825750600092915050565b6001600160a01b038316600090815260066020526040812054600754610ea891906110a4565b90506000633b9aca00610ebb84846110ca565b610ec591906110e1565b95945050505050565b600060208083528351808285015260005b81811015610efb57858101830151858201604001528201610edf565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b0381168114610f3357600080fd5b919050565b60008060408385031215610f4b57600080fd5b610f5483610f1c565b946020939093013593505050565b600060208284031215610f7457600080fd5b5035919050565b600080600060608486031215610f9057600080fd5b610f9984610f1c565b9250610fa760208501610f1c565b9150604084013590509250925092565b600060208284031215610fc957600080fd5b610fd282610f1c565b9392505050565b60008060408385031215610fec57600080fd5b610ff583610f1c565b915061100360208401610f1c565b90509250929050565b600181811c9082168061102057607f821691505b60208210810361104057634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561052b5761052b61108e565b8082018082111561052b5761052b61108e565b808202811582820484141761052b5761052b61108e565b6000826110fe57634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa26469706673582212201289c3ecaa1bb9a23311896b9eb4649d18bd05371b1d553ca14b0a83d5f2471f64736f6c63430008150033",
+	Bin: "0x60806040523480156200001157600080fd5b506040516200153138038062001531833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61115980620003d86000396000f3fe60806040526004361061014b5760003560e01c806359355736116100b6578063949813b81161006f578063949813b8146103c557806395d89b41146103e55780639dc29fac146103fa578063a9059cbb1461041a578063dd62ed3e1461043a578063fb489a7b1461048057600080fd5b806359355736146102e35780635ea1d6f81461031957806361d027b31461032f57806370a082311461034f5780637eee288d1461038557806384955c88146103a557600080fd5b8063282d3fdf11610108578063282d3fdf146102245780632f2c3f2e14610244578063313ce5671461025a578063372500ab146102765780633a5381b51461028b57806340c10f19146102c357600080fd5b806306fdde0314610150578063095ea7b31461017b57806318160ddd146101ab578063187cf4d7146101ca57806319fac8fd146101e257806323b872dd14610204575b600080fd5b34801561015c57600080fd5b50610165610488565b6040516101729190610ece565b60405180910390f35b34801561018757600080fd5b5061019b610196366004610f38565b61051a565b6040519015158152602001610172565b3480156101b757600080fd5b506004545b604051908152602001610172565b3480156101d657600080fd5b506101bc633b9aca0081565b3480156101ee57600080fd5b506102026101fd366004610f62565b610531565b005b34801561021057600080fd5b5061019b61021f366004610f7b565b610569565b34801561023057600080fd5b5061020261023f366004610f38565b61065c565b34801561025057600080fd5b506101bc61271081565b34801561026657600080fd5b5060405160128152602001610172565b34801561028257600080fd5b50610202610741565b34801561029757600080fd5b50600a546102ab906001600160a01b031681565b6040516001600160a01b039091168152602001610172565b3480156102cf57600080fd5b506102026102de366004610f38565b6107ef565b3480156102ef57600080fd5b506101bc6102fe366004610fb7565b6001600160a01b031660009081526002602052604090205490565b34801561032557600080fd5b506101bc600c5481565b34801561033b57600080fd5b50600b546102ab906001600160a01b031681565b34801561035b57600080fd5b506101bc61036a366004610fb7565b6001600160a01b031660009081526001602052604090205490565b34801561039157600080fd5b506102026103a0366004610f38565b610857565b3480156103b157600080fd5b506101bc6103c0366004610fb7565b61091d565b3480156103d157600080fd5b506101bc6103e0366004610fb7565b61094b565b3480156103f157600080fd5b50610165610979565b34801561040657600080fd5b50610202610415366004610f38565b610988565b34801561042657600080fd5b5061019b610435366004610f38565b6109e8565b34801561044657600080fd5b506101bc610455366004610fd9565b6001600160a01b03918216600090815260036020908152604080832093909416825291909152205490565b6101bc610a35565b6060600880546104979061100c565b80601f01602080910402602001604051908101604052809291908181526020018280546104c39061100c565b80156105105780601f106104e557610100808354040283529160200191610510565b820191906000526020600020905b8154815290600101906020018083116104f357829003601f168201915b5050505050905090565b6000610527338484610b9d565b5060015b92915050565b6000546001600160a01b031633146105645760405162461bcd60e51b815260040161055b90611046565b60405180910390fd5b600c55565b6001600160a01b0383166000908152600360209081526040808320338452909152812054828110156105ee5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e74206578636565647320616044820152676c6c6f77616e636560c01b606482015260840161055b565b61060285336105fd86856110a4565b610b9d565b61060c8584610cc1565b6106168484610da9565b836001600160a01b0316856001600160a01b03166000805160206111048339815191528560405161064991815260200190565b60405180910390a3506001949350505050565b6000546001600160a01b031633146106865760405162461bcd60e51b815260040161055b90611046565b6001600160a01b03821660009081526002602090815260408083205460019092529091205482916106b6916110a4565b10156107105760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201526361626c6560e01b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110b7565b90915550505050565b600061074c33610df4565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461079e576040519150601f19603f3d011682016040523d82523d6000602084013e6107a3565b606091505b50509050806107eb5760405162461bcd60e51b81526020600482015260146024820152732330b4b632b2103a379039b2b7321022ba3432b960611b604482015260640161055b565b5050565b6000546001600160a01b031633146108195760405162461bcd60e51b815260040161055b90611046565b6108238282610da9565b6040518181526001600160a01b03831690600090600080516020611104833981519152906020015b60405180910390a35050565b6000546001600160a01b031633146108815760405162461bcd60e51b815260040161055b90611046565b6001600160a01b0382166000908152600260205260409020548111156108f55760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f636044820152621ad95960ea1b606482015260840161055b565b6001600160a01b038216600090815260026020526040812080548392906107389084906110a4565b6001600160a01b038116600090815260026020908152604080832054600190925282205461052b91906110a4565b600061095682610e59565b6001600160a01b03831660009081526005602052604090205461052b91906110b7565b6060600980546104979061100c565b6000546001600160a01b031633146109b25760405162461bcd60e51b815260040161055b90611046565b6109bc8282610cc1565b6040518181526000906001600160a01b038416906000805160206111048339815191529060200161084b565b60006109f43383610cc1565b6109fe8383610da9565b6040518281526001600160a01b0384169033906000805160206111048339815191529060200160405180910390a350600192915050565b600080546001600160a01b03163314610a605760405162461bcd60e51b815260040161055b90611046565b600c54349060009061271090610a7690846110ca565b610a8091906110e1565b905081811115610ad25760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f72207265776172640000000000000000604482015260640161055b565b610adc81836110a4565b600b546040519193506001600160a01b0316906108fc9083906000818181858888f193505050503d8060008114610b2f576040519150601f19603f3d011682016040523d82523d6000602084013e610b34565b606091505b505060045460009150610b4b633b9aca00856110ca565b610b5591906110e1565b905080600754610b6591906110b7565b600755600454600090633b9aca0090610b7e90846110ca565b610b8891906110e1565b9050610b9481846110b7565b94505050505090565b6001600160a01b038316610bff5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b606482015260840161055b565b6001600160a01b038216610c605760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b606482015260840161055b565b6001600160a01b0383811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b610cca82610df4565b506001600160a01b038216600090815260016020908152604080832054600290925290912054610cfa90826110a4565b821115610d495760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e64730000000000604482015260640161055b565b610d5382826110a4565b6001600160a01b038416600090815260016020526040902055808203610d8d576001600160a01b0383166000908152600660205260408120555b8160046000828254610d9f91906110a4565b9091555050505050565b610db282610df4565b506001600160a01b03821660009081526001602052604081208054839290610ddb9084906110b7565b92505081905550806004600082825461073891906110b7565b600080610e0083610e59565b6001600160a01b038416600090815260056020526040902054909150610e279082906110b7565b6001600160a01b0390931660009081526005602090815260408083208690556007546006909252909120555090919050565b6001600160a01b038116600090815260016020526040812054808203610e825750600092915050565b6001600160a01b038316600090815260066020526040812054600754610ea891906110a4565b90506000633b9aca00610ebb84846110ca565b610ec591906110e1565b95945050505050565b600060208083528351808285015260005b81811015610efb57858101830151858201604001528201610edf565b506000604082860101526040601f19601f8301168501019250505092915050565b80356001600160a01b0381168114610f3357600080fd5b919050565b60008060408385031215610f4b57600080fd5b610f5483610f1c565b946020939093013593505050565b600060208284031215610f7457600080fd5b5035919050565b600080600060608486031215610f9057600080fd5b610f9984610f1c565b9250610fa760208501610f1c565b9150604084013590509250925092565b600060208284031215610fc957600080fd5b610fd282610f1c565b9392505050565b60008060408385031215610fec57600080fd5b610ff583610f1c565b915061100360208401610f1c565b90509250929050565b600181811c9082168061102057607f821691505b60208210810361104057634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526028908201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060408201526710dbdb9d1c9858dd60c21b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561052b5761052b61108e565b8082018082111561052b5761052b61108e565b808202811582820484141761052b5761052b61108e565b6000826110fe57634e487b7160e01b600052601260045260246000fd5b50049056feddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3efa264697066735822122017fd2bd0de854f2773d08a39cedadcc044801e593b5b7f2ef205669d0552eb2964736f6c63430008150033",
 }
 
 // LiquidABI is the input ABI used to generate the binding from.
@@ -16757,10 +18804,11 @@ func (_Oracle *OracleFilterer) ParseVoted(log types.Log) (*OracleVoted, error) {
 
 // PrecompiledMetaData contains all meta data concerning the Precompiled contract.
 var PrecompiledMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[],\"name\":\"ACCUSATION_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"COMPUTE_COMMITTEE_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ENODE_VERIFIER_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"INNOCENCE_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MISBEHAVIOUR_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"POP_VERIFIER_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"SUCCESS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPGRADER_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[],\"name\":\"ACCUSATION_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"COMPUTE_COMMITTEE_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"CONSENSUS_ENDPOINTS_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"ENODE_VERIFIER_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"INNOCENCE_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MISBEHAVIOUR_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"POP_VERIFIER_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"SUCCESS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"UPGRADER_CONTRACT\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
 	Sigs: map[string]string{
 		"4dc925d3": "ACCUSATION_CONTRACT()",
 		"2090a442": "COMPUTE_COMMITTEE_CONTRACT()",
+		"6c9cfd2d": "CONSENSUS_ENDPOINTS_CONTRACT()",
 		"c13974e1": "ENODE_VERIFIER_CONTRACT()",
 		"8e153dc3": "INNOCENCE_CONTRACT()",
 		"925c5492": "MISBEHAVIOUR_CONTRACT()",
@@ -16768,7 +18816,7 @@ var PrecompiledMetaData = &bind.MetaData{
 		"d0a6d1a6": "SUCCESS()",
 		"a4ad5d91": "UPGRADER_CONTRACT()",
 	},
-	Bin: "0x61012561003a600b82828239805160001a60731461002d57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe730000000000000000000000000000000000000000301460806040526004361060885760003560e01c8063925c549211605f578063925c54921460c6578063a4ad5d911460cd578063c13974e11460d4578063d0a6d1a61460db57600080fd5b80632090a44214608d5780634dc925d31460b157806350d937201460b85780638e153dc31460bf575b600080fd5b609460fa81565b6040516001600160a01b0390911681526020015b60405180910390f35b609460fc81565b609460fb81565b609460fd81565b609460fe81565b609460f981565b609460ff81565b60e2600181565b60405190815260200160a856fea2646970667358221220a470277bc4c1e7b278582ab14c86b1a0c084269fa795894c29ef5f41b896f86e64736f6c63430008150033",
+	Bin: "0x61014b61003a600b82828239805160001a60731461002d57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe730000000000000000000000000000000000000000301460806040526004361061009d5760003560e01c80638e153dc3116100705780638e153dc3146100df578063925c5492146100e7578063a4ad5d91146100ef578063c13974e1146100f7578063d0a6d1a6146100ff57600080fd5b80632090a442146100a25780634dc925d3146100c757806350d93720146100cf5780636c9cfd2d146100d7575b600080fd5b6100aa60fa81565b6040516001600160a01b0390911681526020015b60405180910390f35b6100aa60fc81565b6100aa60fb81565b6100aa60f881565b6100aa60fd81565b6100aa60fe81565b6100aa60f981565b6100aa60ff81565b610107600181565b6040519081526020016100be56fea26469706673582212203d5e5ea032a03812d4fa3bff7252511350638bd8130b3f195ffe490c0d21d6ad64736f6c63430008150033",
 }
 
 // PrecompiledABI is the input ABI used to generate the binding from.
@@ -17004,6 +19052,37 @@ func (_Precompiled *PrecompiledCallerSession) COMPUTECOMMITTEECONTRACT() (common
 	return _Precompiled.Contract.COMPUTECOMMITTEECONTRACT(&_Precompiled.CallOpts)
 }
 
+// CONSENSUSENDPOINTSCONTRACT is a free data retrieval call binding the contract method 0x6c9cfd2d.
+//
+// Solidity: function CONSENSUS_ENDPOINTS_CONTRACT() view returns(address)
+func (_Precompiled *PrecompiledCaller) CONSENSUSENDPOINTSCONTRACT(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Precompiled.contract.Call(opts, &out, "CONSENSUS_ENDPOINTS_CONTRACT")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// CONSENSUSENDPOINTSCONTRACT is a free data retrieval call binding the contract method 0x6c9cfd2d.
+//
+// Solidity: function CONSENSUS_ENDPOINTS_CONTRACT() view returns(address)
+func (_Precompiled *PrecompiledSession) CONSENSUSENDPOINTSCONTRACT() (common.Address, error) {
+	return _Precompiled.Contract.CONSENSUSENDPOINTSCONTRACT(&_Precompiled.CallOpts)
+}
+
+// CONSENSUSENDPOINTSCONTRACT is a free data retrieval call binding the contract method 0x6c9cfd2d.
+//
+// Solidity: function CONSENSUS_ENDPOINTS_CONTRACT() view returns(address)
+func (_Precompiled *PrecompiledCallerSession) CONSENSUSENDPOINTSCONTRACT() (common.Address, error) {
+	return _Precompiled.Contract.CONSENSUSENDPOINTSCONTRACT(&_Precompiled.CallOpts)
+}
+
 // ENODEVERIFIERCONTRACT is a free data retrieval call binding the contract method 0xc13974e1.
 //
 // Solidity: function ENODE_VERIFIER_CONTRACT() view returns(address)
@@ -19475,7 +21554,7 @@ var TestBaseMetaData = &bind.MetaData{
 	Sigs: map[string]string{
 		"bfb4ebcf": "Foo()",
 	},
-	Bin: "0x608060405234801561001057600080fd5b5060405161041238038061041283398101604081905261002f91610058565b600061003b82826101aa565b5050610269565b634e487b7160e01b600052604160045260246000fd5b6000602080838503121561006b57600080fd5b82516001600160401b038082111561008257600080fd5b818501915085601f83011261009657600080fd5b8151818111156100a8576100a8610042565b604051601f8201601f19908116603f011681019083821181831017156100d0576100d0610042565b8160405282815288868487010111156100e857600080fd5b600093505b8284101561010a57848401860151818501870152928501926100ed565b600086848301015280965050505050505092915050565b600181811c9082168061013557607f821691505b60208210810361015557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156101a557600081815260208120601f850160051c810160208610156101825750805b601f850160051c820191505b818110156101a15782815560010161018e565b5050505b505050565b81516001600160401b038111156101c3576101c3610042565b6101d7816101d18454610121565b8461015b565b602080601f83116001811461020c57600084156101f45750858301515b600019600386901b1c1916600185901b1785556101a1565b600085815260208120601f198616915b8281101561023b5788860151825594840194600190910190840161021c565b50858210156102595787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61019a806102786000396000f3fe608060405234801561001057600080fd5b506004361061002b5760003560e01c8063bfb4ebcf14610030575b600080fd5b61003861004e565b60405161004591906100dc565b60405180910390f35b6000805461005b9061012a565b80601f01602080910402602001604051908101604052809291908181526020018280546100879061012a565b80156100d45780601f106100a9576101008083540402835291602001916100d4565b820191906000526020600020905b8154815290600101906020018083116100b757829003601f168201915b505050505081565b600060208083528351808285015260005b81811015610109578581018301518582016040015282016100ed565b506000604082860101526040601f19601f8301168501019250505092915050565b600181811c9082168061013e57607f821691505b60208210810361015e57634e487b7160e01b600052602260045260246000fd5b5091905056fea264697066735822122040044b57a2e954cf801148e1060412693900423bdae203d16262ba898f6064bf64736f6c63430008150033",
+	Bin: "0x608060405234801561001057600080fd5b5060405161041238038061041283398101604081905261002f91610058565b600061003b82826101aa565b5050610269565b634e487b7160e01b600052604160045260246000fd5b6000602080838503121561006b57600080fd5b82516001600160401b038082111561008257600080fd5b818501915085601f83011261009657600080fd5b8151818111156100a8576100a8610042565b604051601f8201601f19908116603f011681019083821181831017156100d0576100d0610042565b8160405282815288868487010111156100e857600080fd5b600093505b8284101561010a57848401860151818501870152928501926100ed565b600086848301015280965050505050505092915050565b600181811c9082168061013557607f821691505b60208210810361015557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156101a557600081815260208120601f850160051c810160208610156101825750805b601f850160051c820191505b818110156101a15782815560010161018e565b5050505b505050565b81516001600160401b038111156101c3576101c3610042565b6101d7816101d18454610121565b8461015b565b602080601f83116001811461020c57600084156101f45750858301515b600019600386901b1c1916600185901b1785556101a1565b600085815260208120601f198616915b8281101561023b5788860151825594840194600190910190840161021c565b50858210156102595787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61019a806102786000396000f3fe608060405234801561001057600080fd5b506004361061002b5760003560e01c8063bfb4ebcf14610030575b600080fd5b61003861004e565b60405161004591906100dc565b60405180910390f35b6000805461005b9061012a565b80601f01602080910402602001604051908101604052809291908181526020018280546100879061012a565b80156100d45780601f106100a9576101008083540402835291602001916100d4565b820191906000526020600020905b8154815290600101906020018083116100b757829003601f168201915b505050505081565b600060208083528351808285015260005b81811015610109578581018301518582016040015282016100ed565b506000604082860101526040601f19601f8301168501019250505092915050565b600181811c9082168061013e57607f821691505b60208210810361015e57634e487b7160e01b600052602260045260246000fd5b5091905056fea26469706673582212204590faabc26fa3d9f80ab8584d21db7cc6aafae4d0294025993c244e75426df864736f6c63430008150033",
 }
 
 // TestBaseABI is the input ABI used to generate the binding from.
@@ -19688,7 +21767,7 @@ var TestUpgradedMetaData = &bind.MetaData{
 		"bfb4ebcf": "Foo()",
 		"1e4f3395": "FooBar(string)",
 	},
-	Bin: "0x608060405234801561001057600080fd5b5060405161068838038061068883398101604081905261002f9161010e565b80600061003c82826101fa565b506001905061004b83826101fa565b5050506102b9565b634e487b7160e01b600052604160045260246000fd5b600082601f83011261007a57600080fd5b81516001600160401b038082111561009457610094610053565b604051601f8301601f19908116603f011681019082821181831017156100bc576100bc610053565b816040528381526020925086838588010111156100d857600080fd5b600091505b838210156100fa57858201830151818301840152908201906100dd565b600093810190920192909252949350505050565b6000806040838503121561012157600080fd5b82516001600160401b038082111561013857600080fd5b61014486838701610069565b9350602085015191508082111561015a57600080fd5b5061016785828601610069565b9150509250929050565b600181811c9082168061018557607f821691505b6020821081036101a557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156101f557600081815260208120601f850160051c810160208610156101d25750805b601f850160051c820191505b818110156101f1578281556001016101de565b5050505b505050565b81516001600160401b0381111561021357610213610053565b610227816102218454610171565b846101ab565b602080601f83116001811461025c57600084156102445750858301515b600019600386901b1c1916600185901b1785556101f1565b600085815260208120601f198616915b8281101561028b5788860151825594840194600190910190840161026c565b50858210156102a95787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6103c0806102c86000396000f3fe608060405234801561001057600080fd5b50600436106100415760003560e01c80631e4f339514610046578063b0a378b01461005b578063bfb4ebcf14610079575b600080fd5b610059610054366004610142565b610081565b005b610063610091565b60405161007091906101f3565b60405180910390f35b61006361011f565b600061008d82826102ca565b5050565b6001805461009e90610241565b80601f01602080910402602001604051908101604052809291908181526020018280546100ca90610241565b80156101175780601f106100ec57610100808354040283529160200191610117565b820191906000526020600020905b8154815290600101906020018083116100fa57829003601f168201915b505050505081565b6000805461009e90610241565b634e487b7160e01b600052604160045260246000fd5b60006020828403121561015457600080fd5b813567ffffffffffffffff8082111561016c57600080fd5b818401915084601f83011261018057600080fd5b8135818111156101925761019261012c565b604051601f8201601f19908116603f011681019083821181831017156101ba576101ba61012c565b816040528281528760208487010111156101d357600080fd5b826020860160208301376000928101602001929092525095945050505050565b600060208083528351808285015260005b8181101561022057858101830151858201604001528201610204565b506000604082860101526040601f19601f8301168501019250505092915050565b600181811c9082168061025557607f821691505b60208210810361027557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156102c557600081815260208120601f850160051c810160208610156102a25750805b601f850160051c820191505b818110156102c1578281556001016102ae565b5050505b505050565b815167ffffffffffffffff8111156102e4576102e461012c565b6102f8816102f28454610241565b8461027b565b602080601f83116001811461032d57600084156103155750858301515b600019600386901b1c1916600185901b1785556102c1565b600085815260208120601f198616915b8281101561035c5788860151825594840194600190910190840161033d565b508582101561037a5787850151600019600388901b60f8161c191681555b5050505050600190811b0190555056fea2646970667358221220d0c4c5fb8b6a61364c41c70cb38249f717ffe8a4b1049a4c37edcef0b74ae30e64736f6c63430008150033",
+	Bin: "0x608060405234801561001057600080fd5b5060405161068838038061068883398101604081905261002f9161010e565b80600061003c82826101fa565b506001905061004b83826101fa565b5050506102b9565b634e487b7160e01b600052604160045260246000fd5b600082601f83011261007a57600080fd5b81516001600160401b038082111561009457610094610053565b604051601f8301601f19908116603f011681019082821181831017156100bc576100bc610053565b816040528381526020925086838588010111156100d857600080fd5b600091505b838210156100fa57858201830151818301840152908201906100dd565b600093810190920192909252949350505050565b6000806040838503121561012157600080fd5b82516001600160401b038082111561013857600080fd5b61014486838701610069565b9350602085015191508082111561015a57600080fd5b5061016785828601610069565b9150509250929050565b600181811c9082168061018557607f821691505b6020821081036101a557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156101f557600081815260208120601f850160051c810160208610156101d25750805b601f850160051c820191505b818110156101f1578281556001016101de565b5050505b505050565b81516001600160401b0381111561021357610213610053565b610227816102218454610171565b846101ab565b602080601f83116001811461025c57600084156102445750858301515b600019600386901b1c1916600185901b1785556101f1565b600085815260208120601f198616915b8281101561028b5788860151825594840194600190910190840161026c565b50858210156102a95787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6103c0806102c86000396000f3fe608060405234801561001057600080fd5b50600436106100415760003560e01c80631e4f339514610046578063b0a378b01461005b578063bfb4ebcf14610079575b600080fd5b610059610054366004610142565b610081565b005b610063610091565b60405161007091906101f3565b60405180910390f35b61006361011f565b600061008d82826102ca565b5050565b6001805461009e90610241565b80601f01602080910402602001604051908101604052809291908181526020018280546100ca90610241565b80156101175780601f106100ec57610100808354040283529160200191610117565b820191906000526020600020905b8154815290600101906020018083116100fa57829003601f168201915b505050505081565b6000805461009e90610241565b634e487b7160e01b600052604160045260246000fd5b60006020828403121561015457600080fd5b813567ffffffffffffffff8082111561016c57600080fd5b818401915084601f83011261018057600080fd5b8135818111156101925761019261012c565b604051601f8201601f19908116603f011681019083821181831017156101ba576101ba61012c565b816040528281528760208487010111156101d357600080fd5b826020860160208301376000928101602001929092525095945050505050565b600060208083528351808285015260005b8181101561022057858101830151858201604001528201610204565b506000604082860101526040601f19601f8301168501019250505092915050565b600181811c9082168061025557607f821691505b60208210810361027557634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156102c557600081815260208120601f850160051c810160208610156102a25750805b601f850160051c820191505b818110156102c1578281556001016102ae565b5050505b505050565b815167ffffffffffffffff8111156102e4576102e461012c565b6102f8816102f28454610241565b8461027b565b602080601f83116001811461032d57600084156103155750858301515b600019600386901b1c1916600185901b1785556102c1565b600085815260208120601f198616915b8281101561035c5788860151825594840194600190910190840161033d565b508582101561037a5787850151600019600388901b60f8161c191681555b5050505050600190811b0190555056fea2646970667358221220aa137b5300dd6f49b885a747790fe97b8f7ac27eba5c662b1a83c1820cd7987f64736f6c63430008150033",
 }
 
 // TestUpgradedABI is the input ABI used to generate the binding from.
@@ -19954,7 +22033,7 @@ var UpgradeManagerMetaData = &bind.MetaData{
 		"b3ab15fb": "setOperator(address)",
 		"6e3d9ff0": "upgrade(address,string)",
 	},
-	Bin: "0x608060405234801561001057600080fd5b5060405161044338038061044383398101604081905261002f9161007c565b600080546001600160a01b039384166001600160a01b031991821617909155600180549290931691161790556100af565b80516001600160a01b038116811461007757600080fd5b919050565b6000806040838503121561008f57600080fd5b61009883610060565b91506100a660208401610060565b90509250929050565b610385806100be6000396000f3fe608060405234801561001057600080fd5b506004361061004c5760003560e01c806355463ceb14610051578063570ca735146100805780636e3d9ff014610093578063b3ab15fb146100a8575b600080fd5b600054610064906001600160a01b031681565b6040516001600160a01b03909116815260200160405180910390f35b600154610064906001600160a01b031681565b6100a66100a1366004610220565b6100bb565b005b6100a66100b63660046102e2565b610166565b6001546001600160a01b0316331461011a5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f7200000000000060448201526064015b60405180910390fd5b60405160f9906000906101339085908590602001610304565b6040516020818303038152906040529050600080825160208401855af43d6000803e808015610161573d6000f35b3d6000fd5b6000546001600160a01b031633146101cc5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f7420746865204175746f6e69747920636f6e74726044820152621858dd60ea1b6064820152608401610111565b600180546001600160a01b0319166001600160a01b0392909216919091179055565b80356001600160a01b038116811461020557600080fd5b919050565b634e487b7160e01b600052604160045260246000fd5b6000806040838503121561023357600080fd5b61023c836101ee565b9150602083013567ffffffffffffffff8082111561025957600080fd5b818501915085601f83011261026d57600080fd5b81358181111561027f5761027f61020a565b604051601f8201601f19908116603f011681019083821181831017156102a7576102a761020a565b816040528281528860208487010111156102c057600080fd5b8260208601602083013760006020848301015280955050505050509250929050565b6000602082840312156102f457600080fd5b6102fd826101ee565b9392505050565b6bffffffffffffffffffffffff198360601b1681526000825160005b8181101561033d5760208186018101516014868401015201610320565b5060009201601401918252509291505056fea26469706673582212207cb99b8c663b7b3f7201f7c37a4ee5d8f83506bfd644debc45c05e5883ef0c7064736f6c63430008150033",
+	Bin: "0x608060405234801561001057600080fd5b5060405161044338038061044383398101604081905261002f9161007c565b600080546001600160a01b039384166001600160a01b031991821617909155600180549290931691161790556100af565b80516001600160a01b038116811461007757600080fd5b919050565b6000806040838503121561008f57600080fd5b61009883610060565b91506100a660208401610060565b90509250929050565b610385806100be6000396000f3fe608060405234801561001057600080fd5b506004361061004c5760003560e01c806355463ceb14610051578063570ca735146100805780636e3d9ff014610093578063b3ab15fb146100a8575b600080fd5b600054610064906001600160a01b031681565b6040516001600160a01b03909116815260200160405180910390f35b600154610064906001600160a01b031681565b6100a66100a1366004610220565b6100bb565b005b6100a66100b63660046102e2565b610166565b6001546001600160a01b0316331461011a5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f7200000000000060448201526064015b60405180910390fd5b60405160f9906000906101339085908590602001610304565b6040516020818303038152906040529050600080825160208401855af43d6000803e808015610161573d6000f35b3d6000fd5b6000546001600160a01b031633146101cc5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f7420746865204175746f6e69747920636f6e74726044820152621858dd60ea1b6064820152608401610111565b600180546001600160a01b0319166001600160a01b0392909216919091179055565b80356001600160a01b038116811461020557600080fd5b919050565b634e487b7160e01b600052604160045260246000fd5b6000806040838503121561023357600080fd5b61023c836101ee565b9150602083013567ffffffffffffffff8082111561025957600080fd5b818501915085601f83011261026d57600080fd5b81358181111561027f5761027f61020a565b604051601f8201601f19908116603f011681019083821181831017156102a7576102a761020a565b816040528281528860208487010111156102c057600080fd5b8260208601602083013760006020848301015280955050505050509250929050565b6000602082840312156102f457600080fd5b6102fd826101ee565b9392505050565b6bffffffffffffffffffffffff198360601b1681526000825160005b8181101561033d5760208186018101516014868401015201610320565b5060009201601401918252509291505056fea264697066735822122074a8cad5bd060c6fa6601f348b58714a8c0be7730333e065fd904f95bfecae7b64736f6c63430008150033",
 }
 
 // UpgradeManagerABI is the input ABI used to generate the binding from.
@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"reflect"
 
+	"github.com/autonity/autonity/accounts/abi"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/math"
 	"github.com/autonity/autonity/core/types"
@@ -47,6 +48,34 @@ func DeployContracts(genesisConfig *params.ChainConfig, genesisBonds GenesisBond
 	if err := DeployUpgradeManagerContract(genesisConfig, evmContracts); err != nil {
 		return fmt.Errorf("error when deploying the upgrade manager contract: %w", err)
 	}
+	if genesisConfig.GovernanceConfig != nil {
+		if err := DeployGovernanceContract(genesisConfig, evmContracts); err != nil {
+			return fmt.Errorf("error when deploying the governance contract: %w", err)
+		}
+	}
+	return nil
+}
+
+// DeployGovernanceContract deploys the Governance contract, the operator
+// account of the protocol contracts.
+func DeployGovernanceContract(config *params.ChainConfig, evmContracts *GenesisEVMContracts) error {
+	governance := config.GovernanceConfig
+	if err := governance.Validate(); err != nil {
+		return err
+	}
+	governanceConfig := GovernanceConfig{
+		VotingPeriod:   new(big.Int).SetUint64(governance.VotingPeriod),
+		Quorum:         new(big.Int).SetUint64(governance.Quorum),
+		Threshold:      new(big.Int).SetUint64(governance.Threshold),
+		Timelock:       new(big.Int).SetUint64(governance.Timelock),
+		MinVotingPower: (*big.Int)(governance.MinVotingPower),
+	}
+	err := evmContracts.DeployGovernanceContract(params.AutonityContractAddress, governanceConfig, governance.Bytecode)
+	if err != nil {
+		log.Error("DeployGovernanceContract failed", "err", err)
+		return fmt.Errorf("failed to deploy Governance contract: %w", err)
+	}
+	log.Info("Deployed Governance contract", "address", params.GovernanceContractAddress)
 	return nil
 }
 
@@ -285,10 +314,14 @@ func DeployOracleContract(genesisConfig *params.ChainConfig, evmContracts *Genes
 }
 
 func (c *EVMContract) replaceAutonityBytecode(header *types.Header, statedb vm.StateDB, bytecode []byte) error {
+	return c.replaceContractBytecode(header, statedb, params.AutonityContractAddress, bytecode)
+}
+
+func (c *EVMContract) replaceContractBytecode(header *types.Header, statedb vm.StateDB, target common.Address, bytecode []byte) error {
 	evm := c.evmProvider(header, params.DeployerAddress, statedb)
-	_, _, _, vmerr := evm.Replace(vm.AccountRef(params.DeployerAddress), bytecode, params.AutonityContractAddress)
+	_, _, _, vmerr := evm.Replace(vm.AccountRef(params.DeployerAddress), bytecode, target)
 	if vmerr != nil {
-		log.Error("replaceAutonityBytecode evm.Create", "err", vmerr)
+		log.Error("replaceContractBytecode evm.Replace", "target", target, "err", vmerr)
 		return vmerr
 	}
 	return nil
@@ -403,6 +436,78 @@ func (c *AutonityContract) callGetMinimumBaseFee(state vm.StateDB, header *types
 	return minBaseFee, nil
 }
 
+func (c *AutonityContract) callGetLiquidContracts(state vm.StateDB, header *types.Header) ([]common.Address, error) {
+	var validators []common.Address
+	if err := c.AutonityContractCall(state, header, "getValidators", &validators); err != nil {
+		return nil, err
+	}
+	liquids := make([]common.Address, 0, len(validators))
+	for _, addr := range validators {
+		validator, err := c.callGetValidator(state, header, addr)
+		if err != nil {
+			return nil, err
+		}
+		liquids = append(liquids, validator.LiquidContract)
+	}
+	return liquids, nil
+}
+
+func (c *AutonityContract) callGetValidator(state vm.StateDB, header *types.Header, addr common.Address) (*AutonityValidator, error) {
+	var ret raw
+	if err := c.AutonityContractCall(state, header, "getValidator", &ret, addr); err != nil {
+		return nil, err
+	}
+	out, err := c.contractABI.Unpack("getValidator", ret)
+	if err != nil {
+		return nil, err
+	}
+	return abi.ConvertType(out[0], new(AutonityValidator)).(*AutonityValidator), nil
+}
+
+func (c *AutonityContract) callEpochID(state vm.StateDB, header *types.Header) (uint64, error) {
+	epochID := new(big.Int)
+	if err := c.AutonityContractCall(state, header, "epochID", &epochID); err != nil {
+		return 0, err
+	}
+	return epochID.Uint64(), nil
+}
+
+func (c *AutonityContract) callGetEpochPeriod(state vm.StateDB, header *types.Header) (uint64, error) {
+	period := new(big.Int)
+	if err := c.AutonityContractCall(state, header, "getEpochPeriod", &period); err != nil {
+		return 0, err
+	}
+	return period.Uint64(), nil
+}
+
+func (c *AutonityContract) callGetEpochFromBlock(state vm.StateDB, header *types.Header, block uint64) (uint64, error) {
+	epoch := new(big.Int)
+	if err := c.AutonityContractCall(state, header, "getEpochFromBlock", &epoch, new(big.Int).SetUint64(block)); err != nil {
+		return 0, err
+	}
+	return epoch.Uint64(), nil
+}
+
+func (c *AutonityContract) callLiquidBalanceOf(state vm.StateDB, header *types.Header, liquid common.Address, account common.Address) (*big.Int, error) {
+	liquidABI, err := LiquidMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	packedArgs, err := liquidABI.Pack("balanceOf", account)
+	if err != nil {
+		return nil, err
+	}
+	ret, _, err := c.EVMContract.CallContractFunc(state, header, liquid, packedArgs)
+	if err != nil {
+		return nil, err
+	}
+	balance := new(big.Int)
+	if err := liquidABI.UnpackIntoInterface(&balance, "balanceOf", ret); err != nil {
+		return nil, err
+	}
+	return balance, nil
+}
+
 func (c *AutonityContract) callFinalize(state vm.StateDB, header *types.Header) (bool, types.Committee, error) {
 	var updateReady bool
 	var committee types.Committee
@@ -0,0 +1,169 @@
+package autonity
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/autonity/autonity/accounts/abi"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/params/generated"
+)
+
+const liquidContractName = "Liquid"
+
+// ProtocolEvent is a log of a protocol contract decoded with the ABI of the
+// contract.
+type ProtocolEvent struct {
+	Contract string         `json:"contract"`
+	Event    string         `json:"event"`
+	Fields   map[string]any `json:"fields"`
+}
+
+// protocolContract is a protocol contract whose logs can be decoded.
+type protocolContract struct {
+	name string
+	abi  *abi.ABI
+}
+
+// protocolContracts returns the protocol contracts deployed at genesis, using
+// the given, possibly upgraded, ABI for the Autonity contract.
+func protocolContracts(autonityABI *abi.ABI) map[common.Address]protocolContract {
+	return map[common.Address]protocolContract{
+		params.AutonityContractAddress:       {"Autonity", autonityABI},
+		params.AccountabilityContractAddress: {"Accountability", &generated.AccountabilityAbi},
+		params.OracleContractAddress:         {"Oracle", &generated.OracleAbi},
+		params.ACUContractAddress:            {"ACU", &generated.ACUAbi},
+		params.SupplyControlContractAddress:  {"SupplyControl", &generated.SupplyControlAbi},
+		params.StabilizationContractAddress:  {"Stabilization", &generated.StabilizationAbi},
+		params.UpgradeManagerContractAddress: {"UpgradeManager", &generated.UpgradeManagerAbi},
+	}
+}
+
+// EventDecoder decodes the logs emitted by the protocol contracts, including
+// the Liquid contracts of the validators. The Liquid contracts are learned
+// from the RegisteredValidator events decoded along the way, on top of the ones
+// added explicitly.
+type EventDecoder struct {
+	autonityABI func() *abi.ABI
+	contracts   map[common.Address]protocolContract
+	liquidABI   *abi.ABI
+
+	liquids map[common.Address]struct{}
+	lock    sync.RWMutex
+}
+
+// NewEventDecoder creates a decoder of the protocol contract logs. The ABI of
+// the Autonity contract is retrieved from the given function for every log, so
+// that the events added by its upgrades are decoded.
+func NewEventDecoder(autonityABI func() *abi.ABI) (*EventDecoder, error) {
+	liquidABI, err := LiquidMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &EventDecoder{
+		autonityABI: autonityABI,
+		contracts:   protocolContracts(nil),
+		liquidABI:   liquidABI,
+		liquids:     make(map[common.Address]struct{}),
+	}, nil
+}
+
+// AddLiquidContract registers the Liquid contract of a validator.
+func (d *EventDecoder) AddLiquidContract(addr common.Address) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.liquids[addr] = struct{}{}
+}
+
+// contract returns the protocol contract deployed at the given address.
+func (d *EventDecoder) contract(addr common.Address) (protocolContract, bool) {
+	if addr == params.AutonityContractAddress {
+		// the ABI of the Autonity contract is the current one, not the one at creation
+		return protocolContract{"Autonity", d.autonityABI()}, true
+	}
+	if contract, ok := d.contracts[addr]; ok {
+		return contract, true
+	}
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	if _, ok := d.liquids[addr]; ok {
+		return protocolContract{liquidContractName, d.liquidABI}, true
+	}
+	return protocolContract{}, false
+}
+
+// Decode decodes a log with the ABI of the protocol contract which emitted it.
+// Nil is returned for logs which are not emitted by a protocol contract.
+func (d *EventDecoder) Decode(log *types.Log) (*ProtocolEvent, error) {
+	contract, ok := d.contract(log.Address)
+	if !ok {
+		return nil, nil
+	}
+	if len(log.Topics) == 0 {
+		return nil, errors.New("anonymous protocol event")
+	}
+	event, err := contract.abi.EventByID(log.Topics[0])
+	if err != nil {
+		return nil, fmt.Errorf("unknown %s event %x", contract.name, log.Topics[0])
+	}
+	fields := make(map[string]any)
+	if err := event.Inputs.NonIndexed().UnpackIntoMap(fields, log.Data); err != nil {
+		return nil, fmt.Errorf("invalid %s %s data: %v", contract.name, event.Name, err)
+	}
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(fields, indexed, log.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("invalid %s %s topics: %v", contract.name, event.Name, err)
+	}
+	if log.Address == params.AutonityContractAddress && event.Name == "RegisteredValidator" {
+		if liquid, ok := fields["liquidContract"].(common.Address); ok {
+			d.AddLiquidContract(liquid)
+		}
+	}
+	for name, value := range fields {
+		fields[name] = normalizeEventField(value)
+	}
+	return &ProtocolEvent{Contract: contract.name, Event: event.Name, Fields: fields}, nil
+}
+
+// normalizeEventField converts the decoded integers and byte sequences into
+// their hex encoded RPC representation.
+func normalizeEventField(value any) any {
+	switch v := value.(type) {
+	case *big.Int:
+		return (*hexutil.Big)(v)
+	case []byte:
+		return hexutil.Bytes(v)
+	case common.Address, common.Hash:
+		return v
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			blob := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(blob), rv)
+			return hexutil.Bytes(blob)
+		}
+		fallthrough
+	case reflect.Slice:
+		items := make([]any, rv.Len())
+		for i := range items {
+			items[i] = normalizeEventField(rv.Index(i).Interface())
+		}
+		return items
+	}
+	return value
+}
@@ -0,0 +1,95 @@
+package autonity
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/accounts/abi"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/params/generated"
+)
+
+func TestEventDecoder(t *testing.T) {
+	decoder, err := NewEventDecoder(func() *abi.ABI { return &generated.AutonityAbi })
+	require.NoError(t, err)
+
+	var (
+		validator = common.Address{0x01}
+		treasury  = common.Address{0x02}
+		liquid    = common.Address{0x03}
+	)
+	// Liquid contracts are unknown until registered
+	liquidABI, err := LiquidMetaData.GetAbi()
+	require.NoError(t, err)
+	transfer := liquidABI.Events["Transfer"]
+	data, err := transfer.Inputs.NonIndexed().Pack(big.NewInt(7))
+	require.NoError(t, err)
+	transferLog := &types.Log{
+		Address: liquid,
+		Topics:  []common.Hash{transfer.ID, common.BytesToHash(treasury.Bytes()), common.BytesToHash(validator.Bytes())},
+		Data:    data,
+	}
+	event, err := decoder.Decode(transferLog)
+	require.NoError(t, err)
+	require.Nil(t, event)
+
+	registered := generated.AutonityAbi.Events["RegisteredValidator"]
+	data, err = registered.Inputs.NonIndexed().Pack(treasury, validator, common.Address{}, "enode://", liquid)
+	require.NoError(t, err)
+	event, err = decoder.Decode(&types.Log{
+		Address: params.AutonityContractAddress,
+		Topics:  []common.Hash{registered.ID},
+		Data:    data,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Autonity", event.Contract)
+	require.Equal(t, "RegisteredValidator", event.Event)
+	require.Equal(t, validator, event.Fields["addr"])
+	require.Equal(t, liquid, event.Fields["liquidContract"])
+
+	// The Liquid contract is learned from the registration
+	event, err = decoder.Decode(transferLog)
+	require.NoError(t, err)
+	require.Equal(t, "Liquid", event.Contract)
+	require.Equal(t, "Transfer", event.Event)
+	require.Equal(t, treasury, event.Fields["from"])
+	require.Equal(t, validator, event.Fields["to"])
+	require.Equal(t, (*hexutil.Big)(big.NewInt(7)), event.Fields["value"])
+
+	// Unknown events of protocol contracts are rejected
+	_, err = decoder.Decode(&types.Log{Address: params.OracleContractAddress, Topics: []common.Hash{{0xff}}})
+	require.Error(t, err)
+}
+
+func TestEventDecoderAutonityUpgrade(t *testing.T) {
+	current := &generated.AutonityAbi
+	decoder, err := NewEventDecoder(func() *abi.ABI { return current })
+	require.NoError(t, err)
+
+	upgraded, err := abi.JSON(strings.NewReader(`[{"type":"event","name":"Upgraded","anonymous":false,"inputs":[{"name":"version","type":"uint256","indexed":false}]}]`))
+	require.NoError(t, err)
+	upgradedEvent := upgraded.Events["Upgraded"]
+	data, err := upgradedEvent.Inputs.Pack(big.NewInt(2))
+	require.NoError(t, err)
+	upgradedLog := &types.Log{
+		Address: params.AutonityContractAddress,
+		Topics:  []common.Hash{upgradedEvent.ID},
+		Data:    data,
+	}
+	_, err = decoder.Decode(upgradedLog)
+	require.Error(t, err)
+
+	// The events of the upgraded contract are decoded once its ABI is swapped
+	current = &upgraded
+	event, err := decoder.Decode(upgradedLog)
+	require.NoError(t, err)
+	require.Equal(t, "Autonity", event.Contract)
+	require.Equal(t, "Upgraded", event.Event)
+	require.Equal(t, (*hexutil.Big)(big.NewInt(2)), event.Fields["version"])
+}
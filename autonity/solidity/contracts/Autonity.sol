@@ -193,6 +193,7 @@ contract Autonity is IAutonity, IERC20, Upgradeable {
     event RegisteredValidator(address treasury, address addr, address oracleAddress, string enode, address liquidContract);
     event PausedValidator(address indexed treasury, address indexed addr, uint256 effectiveBlock);
     event ActivatedValidator(address indexed treasury, address indexed addr, uint256 effectiveBlock);
+    event ConsensusEndpointsUpdated(address indexed addr);
     event Rewarded(address indexed addr, uint256 amount);
     event EpochPeriodUpdated(uint256 period);
     event NewEpoch(uint256 epoch);
@@ -358,6 +359,19 @@ contract Autonity is IAutonity, IERC20, Upgradeable {
         _pauseValidator(_address);
     }
 
+    /**
+    * @notice Publish the consensus endpoints of a validator running behind sentry nodes, which the rest of
+    * the committee connects to instead of the validator itself. Only accessible to the validator treasury.
+    * @param _record RLP encoded endpoints record, signed by the validator node key.
+    * @dev emit a {ConsensusEndpointsUpdated} event.
+    */
+    function updateConsensusEndpoints(bytes memory _record) public virtual {
+        address _address = Precompiled.updateConsensusEndpoints(_record);
+        require(validators[_address].nodeAddress == _address, "validator must be registered");
+        require(validators[_address].treasury == msg.sender, "require caller to be validator treasury account");
+        emit ConsensusEndpointsUpdated(_address);
+    }
+
     /**
     * @notice Re-activate the specified validator.
     * @param _address address to be enabled.
@@ -558,6 +572,29 @@ contract Autonity is IAutonity, IERC20, Upgradeable {
         emit BurnedStake(_addr, _amount);
     }
 
+    /**
+    * @notice Lock Liquid Newton of a delegator, which can no longer be transferred nor unbonded until unlocked.
+    * Restricted to the Operator account, the governance contract locks the Liquid Newton voting for a proposal.
+    * @param _validator the validator of the Liquid Newton.
+    * @param _delegator the account holding the Liquid Newton.
+    * @param _amount the amount of Liquid Newton to lock.
+    */
+    function lockLiquid(address _validator, address _delegator, uint256 _amount) public virtual onlyOperator {
+        require(validators[_validator].nodeAddress == _validator, "validator must be registered");
+        validators[_validator].liquidContract.lock(_delegator, _amount);
+    }
+
+    /**
+    * @notice Unlock Liquid Newton locked with {lockLiquid}. Restricted to the Operator account.
+    * @param _validator the validator of the Liquid Newton.
+    * @param _delegator the account holding the Liquid Newton.
+    * @param _amount the amount of Liquid Newton to unlock.
+    */
+    function unlockLiquid(address _validator, address _delegator, uint256 _amount) public virtual onlyOperator {
+        require(validators[_validator].nodeAddress == _validator, "validator must be registered");
+        validators[_validator].liquidContract.unlock(_delegator, _amount);
+    }
+
     /**
     * @notice Moves `amount` NTN stake tokens from the caller's account to `recipient`.
     *
@@ -0,0 +1,361 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity ^0.8.19;
+
+import "./Autonity.sol";
+import "./Liquid.sol";
+
+/**
+* @title Governance Contract
+* @notice Stake-weighted on-chain governance of the protocol contracts. Set as the operator account of the Autonity
+* contract, it changes the protocol parameters by executing the calls of the accepted proposals through the existing
+* operator setters.
+* @dev The stake of the validators is snapshotted when a proposal is created. The voting power of an account is the
+* self-bonded stake of the validators whose treasury it is, plus the delegated stake of the Liquid Newton it holds, valued
+* at the snapshot. The Liquid Newton voting is locked until the voting period is over, so that it can't be transferred
+* and vote again, and it is released by its holder. The quorum is evaluated against the total bonded stake of the
+* snapshot.
+*/
+contract Governance {
+    uint256 public constant PRECISION = 10_000;
+
+    enum ProposalState {active, defeated, queued, executed, cancelled}
+
+    struct Config {
+        // Number of blocks during which a proposal can be voted.
+        uint256 votingPeriod;
+        // Share of the total bonded stake which must vote for a proposal to be valid, in PRECISION.
+        uint256 quorum;
+        // Share of the voting power cast which must approve a proposal for it to pass, in PRECISION.
+        uint256 threshold;
+        // Number of blocks between the tally of an accepted proposal and its execution.
+        uint256 timelock;
+        // Minimum voting power required to propose and to vote, bounding the number of votes.
+        uint256 minVotingPower;
+    }
+
+    struct Proposal {
+        address proposer;
+        string description;
+        address[] targets;
+        bytes[] calldatas;
+        uint256 endBlock;
+        uint256 totalStake;
+        uint256 eta;
+        uint256 yesVotes;
+        uint256 noVotes;
+        ProposalState state;
+    }
+
+    struct Vote {
+        // 0 for accounts which didn't vote, 1 for approvals and 2 for rejections
+        uint8 choice;
+        uint256 power;
+        // validators of the Liquid Newton locked by the vote, along with the locked amounts
+        address[] validators;
+        uint256[] locked;
+    }
+
+    struct Stake {
+        // zero for the validators registered after the proposal
+        Liquid liquidContract;
+        address treasury;
+        uint256 selfBondedStake;
+        uint256 delegatedStake;
+        uint256 liquidSupply;
+        // Liquid Newton which voted, bounded by the supply of the snapshot
+        uint256 liquidVoted;
+    }
+
+    Autonity internal autonity;
+    Config internal config;
+
+    Proposal[] internal proposals;
+    mapping(uint256 => address[]) internal voters;
+    mapping(uint256 => mapping(address => Vote)) internal votes;
+    mapping(uint256 => mapping(address => Stake)) internal stakes;
+
+    event ProposalCreated(uint256 indexed id, address indexed proposer, address[] targets, bytes[] calldatas, string description, uint256 endBlock);
+    event VoteCast(uint256 indexed id, address indexed voter, bool support, uint256 power);
+    event ProposalTallied(uint256 indexed id, uint256 yesVotes, uint256 noVotes, bool accepted, uint256 eta);
+    event ProposalExecuted(uint256 indexed id);
+    event ProposalCancelled(uint256 indexed id);
+    event VoteReleased(uint256 indexed id, address indexed voter);
+    event ConfigUpdated(uint256 votingPeriod, uint256 quorum, uint256 threshold, uint256 timelock, uint256 minVotingPower);
+
+    constructor(address payable _autonity, Config memory _config) {
+        autonity = Autonity(_autonity);
+        _setConfig(_config);
+    }
+
+    /**
+    * @notice Submit a proposal executing the given calls once accepted, snapshotting the stake of the validators. The
+    * proposer must hold the minimum voting power.
+    * @param _targets the contracts to call, usually protocol contracts.
+    * @param _calldatas the ABI encoded calls to the setters of the targets.
+    * @param _description a description of the proposal.
+    * @return the identifier of the proposal.
+    */
+    function propose(address[] memory _targets, bytes[] memory _calldatas, string memory _description) external returns (uint256) {
+        require(_targets.length > 0, "empty proposal");
+        require(_targets.length == _calldatas.length, "calls length mismatch");
+
+        uint256 _id = proposals.length;
+        Proposal storage _proposal = proposals.push();
+        uint256 _power;
+        address[] memory _validators = autonity.getValidators();
+        for (uint256 i = 0; i < _validators.length; i++) {
+            Autonity.Validator memory _validator = autonity.getValidator(_validators[i]);
+            Stake storage _stake = stakes[_id][_validators[i]];
+            _stake.liquidContract = _validator.liquidContract;
+            _stake.treasury = _validator.treasury;
+            _stake.selfBondedStake = _validator.selfBondedStake;
+            _stake.delegatedStake = _validator.bondedStake - _validator.selfBondedStake;
+            _stake.liquidSupply = _validator.liquidSupply;
+            _proposal.totalStake += _validator.bondedStake;
+
+            (, uint256 _liquidPower) = _liquidVotingPower(_stake, _validator.liquidContract.unlockedBalanceOf(msg.sender));
+            _power += _liquidPower;
+            if (_validator.treasury == msg.sender) {
+                _power += _validator.selfBondedStake;
+            }
+        }
+        require(_power > 0 && _power >= config.minVotingPower, "insufficient voting power");
+
+        _proposal.proposer = msg.sender;
+        _proposal.description = _description;
+        _proposal.targets = _targets;
+        _proposal.calldatas = _calldatas;
+        _proposal.endBlock = block.number + config.votingPeriod;
+        _proposal.state = ProposalState.active;
+
+        emit ProposalCreated(_id, msg.sender, _targets, _calldatas, _description, _proposal.endBlock);
+        return _id;
+    }
+
+    /**
+    * @notice Vote for or against an active proposal with the stake of the given validators, valued at the snapshot of
+    * the proposal. The unlocked Liquid Newton of the voter for these validators is locked until the voting period is
+    * over. The voter must hold the minimum voting power.
+    * @param _id the identifier of the proposal.
+    * @param _support true to approve the proposal, false to reject it.
+    * @param _validators the validators the voter holds Liquid Newton of or is the treasury of, in ascending order.
+    */
+    function vote(uint256 _id, bool _support, address[] memory _validators) external {
+        Proposal storage _proposal = _activeProposal(_id);
+        require(block.number <= _proposal.endBlock, "voting period is over");
+        Vote storage _vote = votes[_id][msg.sender];
+        require(_vote.choice == 0, "already voted");
+
+        uint256 _power;
+        for (uint256 i = 0; i < _validators.length; i++) {
+            // the ascending order prevents counting the stake of a validator twice
+            require(i == 0 || _validators[i] > _validators[i - 1], "validators not in ascending order");
+            Stake storage _stake = stakes[_id][_validators[i]];
+            require(address(_stake.liquidContract) != address(0), "validator not in the snapshot");
+            if (_stake.treasury == msg.sender) {
+                _power += _stake.selfBondedStake;
+            }
+            (uint256 _counted, uint256 _liquidPower) = _liquidVotingPower(_stake, _stake.liquidContract.unlockedBalanceOf(msg.sender));
+            if (_counted == 0) {
+                continue;
+            }
+            autonity.lockLiquid(_validators[i], msg.sender, _counted);
+            _stake.liquidVoted += _counted;
+            _vote.validators.push(_validators[i]);
+            _vote.locked.push(_counted);
+            _power += _liquidPower;
+        }
+        require(_power > 0 && _power >= config.minVotingPower, "insufficient voting power");
+
+        _vote.choice = _support ? 1 : 2;
+        _vote.power = _power;
+        voters[_id].push(msg.sender);
+        if (_support) {
+            _proposal.yesVotes += _power;
+        } else {
+            _proposal.noVotes += _power;
+        }
+        emit VoteCast(_id, msg.sender, _support, _power);
+    }
+
+    /**
+    * @notice Unlock the Liquid Newton locked by the vote of the caller, once the voting period of the proposal is over
+    * or the proposal is cancelled.
+    * @param _id the identifier of the proposal.
+    */
+    function release(uint256 _id) external {
+        require(_id < proposals.length, "unknown proposal");
+        Proposal storage _proposal = proposals[_id];
+        require(_proposal.state == ProposalState.cancelled || block.number > _proposal.endBlock, "voting period is not over");
+        Vote storage _vote = votes[_id][msg.sender];
+        require(_vote.validators.length > 0, "no locked liquid newton");
+
+        for (uint256 i = 0; i < _vote.validators.length; i++) {
+            autonity.unlockLiquid(_vote.validators[i], msg.sender, _vote.locked[i]);
+        }
+        delete _vote.validators;
+        delete _vote.locked;
+        emit VoteReleased(_id, msg.sender);
+    }
+
+    /**
+    * @notice Tally the votes of a proposal whose voting period is over. An accepted proposal is queued for execution
+    * after the timelock, a rejected one is defeated. The votes are counted as they are cast, so the outcome doesn't
+    * depend on when the tally happens.
+    * @param _id the identifier of the proposal.
+    */
+    function tally(uint256 _id) external {
+        Proposal storage _proposal = _activeProposal(_id);
+        require(block.number > _proposal.endBlock, "voting period is not over");
+
+        uint256 _cast = _proposal.yesVotes + _proposal.noVotes;
+        bool _accepted = _cast > 0 &&
+            _cast * PRECISION >= _proposal.totalStake * config.quorum &&
+            _proposal.yesVotes * PRECISION >= _cast * config.threshold;
+        if (_accepted) {
+            _proposal.state = ProposalState.queued;
+            _proposal.eta = block.number + config.timelock;
+        } else {
+            _proposal.state = ProposalState.defeated;
+        }
+        emit ProposalTallied(_id, _proposal.yesVotes, _proposal.noVotes, _accepted, _proposal.eta);
+    }
+
+    /**
+    * @notice Execute the calls of an accepted proposal once its timelock is over. The whole execution reverts if any
+    * call fails.
+    * @param _id the identifier of the proposal.
+    */
+    function execute(uint256 _id) external {
+        require(_id < proposals.length, "unknown proposal");
+        Proposal storage _proposal = proposals[_id];
+        require(_proposal.state == ProposalState.queued, "proposal is not queued");
+        require(block.number >= _proposal.eta, "proposal is timelocked");
+
+        _proposal.state = ProposalState.executed;
+        for (uint256 i = 0; i < _proposal.targets.length; i++) {
+            (bool _success, bytes memory _result) = _proposal.targets[i].call(_proposal.calldatas[i]);
+            if (!_success) {
+                assembly {
+                    revert(add(_result, 32), mload(_result))
+                }
+            }
+        }
+        emit ProposalExecuted(_id);
+    }
+
+    /**
+    * @notice Cancel an active proposal. Restricted to the proposer.
+    * @param _id the identifier of the proposal.
+    */
+    function cancel(uint256 _id) external {
+        Proposal storage _proposal = _activeProposal(_id);
+        require(_proposal.proposer == msg.sender, "caller is not the proposer");
+
+        _proposal.state = ProposalState.cancelled;
+        emit ProposalCancelled(_id);
+    }
+
+    /**
+    * @notice Set the governance parameters. Restricted to the execution of proposals.
+    * @param _config the new parameters.
+    */
+    function setConfig(Config memory _config) external onlyGovernance {
+        _setConfig(_config);
+    }
+
+    /**
+    * @notice Returns the voting power an account would vote a proposal with, given the validators it holds Liquid
+    * Newton of or is the treasury of.
+    */
+    function votingPower(uint256 _id, address _account, address[] memory _validators) external view returns (uint256 _power) {
+        require(_id < proposals.length, "unknown proposal");
+        for (uint256 i = 0; i < _validators.length; i++) {
+            require(i == 0 || _validators[i] > _validators[i - 1], "validators not in ascending order");
+            Stake storage _stake = stakes[_id][_validators[i]];
+            if (address(_stake.liquidContract) == address(0)) {
+                continue;
+            }
+            if (_stake.treasury == _account) {
+                _power += _stake.selfBondedStake;
+            }
+            (, uint256 _liquidPower) = _liquidVotingPower(_stake, _stake.liquidContract.unlockedBalanceOf(_account));
+            _power += _liquidPower;
+        }
+    }
+
+    /**
+    * @notice Returns the proposal with the given identifier.
+    */
+    function getProposal(uint256 _id) external view returns (Proposal memory) {
+        require(_id < proposals.length, "unknown proposal");
+        return proposals[_id];
+    }
+
+    /**
+    * @notice Returns the number of proposals submitted.
+    */
+    function getProposalCount() external view returns (uint256) {
+        return proposals.length;
+    }
+
+    /**
+    * @notice Returns the accounts which voted for a proposal.
+    */
+    function getVoters(uint256 _id) external view returns (address[] memory) {
+        return voters[_id];
+    }
+
+    /**
+    * @notice Returns the vote of an account for a proposal: its choice, 0 if it didn't vote, 1 for an approval and 2
+    * for a rejection, the voting power it held when voting, and the Liquid Newton it still has locked.
+    */
+    function getVote(uint256 _id, address _account) external view returns (Vote memory) {
+        return votes[_id][_account];
+    }
+
+    /**
+    * @notice Returns the governance parameters.
+    */
+    function getConfig() external view returns (Config memory) {
+        return config;
+    }
+
+    function _setConfig(Config memory _config) internal {
+        require(_config.votingPeriod > 0, "invalid voting period");
+        require(_config.quorum <= PRECISION, "invalid quorum");
+        require(_config.threshold > 0 && _config.threshold <= PRECISION, "invalid threshold");
+        config = _config;
+        emit ConfigUpdated(_config.votingPeriod, _config.quorum, _config.threshold, _config.timelock, _config.minVotingPower);
+    }
+
+    function _activeProposal(uint256 _id) internal view returns (Proposal storage) {
+        require(_id < proposals.length, "unknown proposal");
+        Proposal storage _proposal = proposals[_id];
+        require(_proposal.state == ProposalState.active, "proposal is not active");
+        return _proposal;
+    }
+
+    /**
+    * @dev Returns the Liquid Newton of the balance which can be counted, that is the part of the supply of the snapshot
+    * which didn't vote yet, along with its voting power. Liquid Newton minted after the snapshot is only counted in place
+    * of Liquid Newton which doesn't vote.
+    */
+    function _liquidVotingPower(Stake storage _stake, uint256 _balance) internal view returns (uint256 _counted, uint256 _power) {
+        _counted = _stake.liquidSupply - _stake.liquidVoted;
+        if (_balance < _counted) {
+            _counted = _balance;
+        }
+        if (_counted > 0) {
+            _power = _counted * _stake.delegatedStake / _stake.liquidSupply;
+        }
+    }
+
+    /**
+    * @dev Modifier that checks if the caller is the Governance contract itself, executing a proposal.
+    */
+    modifier onlyGovernance {
+        require(msg.sender == address(this), "caller is not the governance");
+        _;
+    }
+}
@@ -5,6 +5,7 @@ pragma solidity ^0.8.3;
 // how to write and use precompiled contracts https://blog.qtum.org/precompiled-contracts-and-confidential-assets-55f2b47b231d
 library Precompiled {
     uint256 constant public SUCCESS = 1;
+    address constant public CONSENSUS_ENDPOINTS_CONTRACT = address(0xf8);
     address constant public UPGRADER_CONTRACT = address(0xf9);
     address constant public COMPUTE_COMMITTEE_CONTRACT = address(0xfa);
     address constant public POP_VERIFIER_CONTRACT = address(0xfb);
@@ -27,6 +28,21 @@ library Precompiled {
         return (addr, p[1]);
     }
 
+    // @dev store the consensus endpoints of a validator in the precompiled registry.
+    // @param _record is the RLP encoded endpoints record, signed by the validator node key.
+    // @return the node address of the validator which signed the record.
+    function updateConsensusEndpoints(bytes memory _record) internal returns (address) {
+        uint256[1] memory retVal;
+        address to = CONSENSUS_ENDPOINTS_CONTRACT;
+        assembly {
+            //call(gasLimit, to, value, inputOffset, inputSize, outputOffset, outputSize)
+            if iszero(call(gas(), to, 0, add(_record, 32), mload(_record), retVal, 32)) {
+                revert(0, 0)
+            }
+        }
+        return address(uint160(retVal[0]));
+    }
+
     /**
      * @dev Sends necessary slots to precompiled contract.
      * Committee selection and storing the committee and writing it in persistent storage are done in precompiled contract
@@ -159,7 +159,8 @@ func (r *runner) waitNextEpoch() { //nolint
 	require.NoError(r.t, err)
 	nextEpochBlock := new(big.Int).Add(epochPeriod, lastEpochBlock)
 	diff := new(big.Int).Sub(nextEpochBlock, r.evm.Context.BlockNumber)
-	r.waitNBlocks(int(diff.Uint64()))
+	// the last block of the epoch must be finalized too
+	r.waitNBlocks(int(diff.Uint64()) + 1)
 }
 
 func (r *runner) sendAUT(sender, recipient common.Address, value *big.Int) { //nolint
@@ -177,6 +178,7 @@ func initalizeEVM() (*vm.EVM, error) {
 		Transfer:    func(vm.StateDB, common.Address, common.Address, *big.Int) {},
 		CanTransfer: func(vm.StateDB, common.Address, *big.Int) bool { return true },
 		BlockNumber: common.Big0,
+		Time:        common.Big0,
 	}
 	txContext := vm.TxContext{
 		Origin:   common.Address{},
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/core/vm"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/p2p/enode"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/rlp"
+)
+
+func TestUpdateConsensusEndpoints(t *testing.T) {
+	nodeKey, err := crypto.HexToECDSA(params.TestNodeKeys[0])
+	require.NoError(t, err)
+	validator := params.TestAutonityContractConfig.Validators[0]
+	treasury := &runOptions{origin: validator.Treasury}
+
+	sentryKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sentry := enode.NewV4(&sentryKey.PublicKey, nil, 30303, 30303).URLv4()
+	signRecord := func(r *runner, sequence uint64, endpoints ...string) []byte {
+		record, err := vm.SignConsensusEndpoints(nodeKey, sequence, endpoints)
+		require.NoError(r.t, err)
+		data, err := rlp.EncodeToBytes(record)
+		require.NoError(r.t, err)
+		return data
+	}
+
+	r := setup(t, nil)
+	r.run("validator treasury publishes the endpoints", func(r *runner) {
+		_, err := r.autonity.UpdateConsensusEndpoints(treasury, signRecord(r, 1, sentry))
+		require.NoError(r.t, err)
+		require.Equal(r.t, []string{sentry}, vm.ConsensusEndpoints(r.evm.StateDB, *validator.NodeAddress))
+		// an empty record clears the endpoints
+		_, err = r.autonity.UpdateConsensusEndpoints(treasury, signRecord(r, 2))
+		require.NoError(r.t, err)
+		require.Empty(r.t, vm.ConsensusEndpoints(r.evm.StateDB, *validator.NodeAddress))
+	})
+	r.run("restricted to the validator treasury", func(r *runner) {
+		_, err := r.autonity.UpdateConsensusEndpoints(&runOptions{origin: user}, signRecord(r, 1, sentry))
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted)
+		require.Empty(r.t, vm.ConsensusEndpoints(r.evm.StateDB, *validator.NodeAddress))
+	})
+	r.run("sequence must increase", func(r *runner) {
+		_, err := r.autonity.UpdateConsensusEndpoints(treasury, signRecord(r, 2, sentry))
+		require.NoError(r.t, err)
+		_, err = r.autonity.UpdateConsensusEndpoints(treasury, signRecord(r, 2))
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted)
+		require.Equal(r.t, []string{sentry}, vm.ConsensusEndpoints(r.evm.StateDB, *validator.NodeAddress))
+	})
+	r.run("unregistered validator is rejected", func(r *runner) {
+		record, err := vm.SignConsensusEndpoints(sentryKey, 1, []string{sentry})
+		require.NoError(r.t, err)
+		data, err := rlp.EncodeToBytes(record)
+		require.NoError(r.t, err)
+		_, err = r.autonity.UpdateConsensusEndpoints(treasury, data)
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted)
+	})
+}
@@ -0,0 +1,198 @@
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/vm"
+	"github.com/autonity/autonity/params"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	proposalActive uint8 = iota
+	proposalDefeated
+	proposalQueued
+	proposalExecuted
+	proposalCancelled
+)
+
+var (
+	governanceConfig = GovernanceConfig{
+		VotingPeriod:   big.NewInt(10),
+		Quorum:         big.NewInt(3000),
+		Threshold:      big.NewInt(5000),
+		Timelock:       big.NewInt(5),
+		MinVotingPower: common.Big1,
+	}
+	delegated = params.Ntn10000
+)
+
+func TestGovernance(t *testing.T) {
+	r := setup(t, nil)
+	validator := *params.TestAutonityContractConfig.Validators[0].NodeAddress
+	treasury := params.TestAutonityContractConfig.Validators[0].Treasury
+	// the user delegates stake to the first validator and receives its liquid newton
+	_, err := r.autonity.Mint(operator, user, delegated)
+	require.NoError(t, err)
+	_, err = r.autonity.Bond(&runOptions{origin: user}, validator, delegated)
+	require.NoError(t, err)
+	r.waitNextEpoch()
+	info, _, err := r.autonity.GetValidator(nil, validator)
+	require.NoError(t, err)
+	liquidAbi, err := LiquidMetaData.GetAbi()
+	require.NoError(t, err)
+	liquid := &Liquid{&contract{info.LiquidContract, liquidAbi, r}}
+	balance, _, err := liquid.BalanceOf(nil, user)
+	require.NoError(t, err)
+	require.Equal(t, delegated, balance)
+	// the governance contract operates the protocol contracts
+	_, _, governance, err := r.deployGovernance(nil, r.autonity.address, governanceConfig)
+	require.NoError(t, err)
+	_, err = r.autonity.SetOperatorAccount(operator, governance.address)
+	require.NoError(t, err)
+
+	newTreasuryFee := big.NewInt(42)
+	calldata, err := r.autonity.abi.Pack("setTreasuryFee", newTreasuryFee)
+	require.NoError(t, err)
+	propose := func(r *runner, proposer common.Address) *big.Int {
+		id, _, err := governance.GetProposalCount(nil)
+		require.NoError(r.t, err)
+		_, err = governance.Propose(&runOptions{origin: proposer}, []common.Address{r.autonity.address}, [][]byte{calldata}, "lower the treasury fee")
+		require.NoError(r.t, err)
+		return id
+	}
+	state := func(r *runner, id *big.Int) uint8 {
+		proposal, _, err := governance.GetProposal(nil, id)
+		require.NoError(r.t, err)
+		return proposal.State
+	}
+	validators := []common.Address{validator}
+
+	r.run("propose, vote, tally and execute", func(r *runner) {
+		id := propose(r, treasury)
+		proposal, _, err := governance.GetProposal(nil, id)
+		require.NoError(r.t, err)
+		require.Equal(r.t, proposalActive, proposal.State)
+		require.Equal(r.t, new(big.Int).Add(info.BondedStake, new(big.Int).Mul(big.NewInt(3), info.SelfBondedStake)), proposal.TotalStake)
+
+		_, err = governance.Vote(&runOptions{origin: treasury}, id, true, validators)
+		require.NoError(r.t, err)
+		_, err = governance.Vote(&runOptions{origin: user}, id, true, validators)
+		require.NoError(r.t, err)
+		_, err = governance.Tally(nil, id)
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted, "the voting period is not over")
+
+		r.waitNBlocks(int(governanceConfig.VotingPeriod.Int64()) + 1)
+		_, err = governance.Vote(&runOptions{origin: params.TestAutonityContractConfig.Validators[1].Treasury}, id, false,
+			[]common.Address{*params.TestAutonityContractConfig.Validators[1].NodeAddress})
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted, "the voting period is over")
+		_, err = governance.Tally(nil, id)
+		require.NoError(r.t, err)
+		require.Equal(r.t, proposalQueued, state(r, id))
+		_, err = governance.Execute(nil, id)
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted, "the proposal is timelocked")
+
+		r.waitNBlocks(int(governanceConfig.Timelock.Int64()))
+		_, err = governance.Execute(nil, id)
+		require.NoError(r.t, err)
+		require.Equal(r.t, proposalExecuted, state(r, id))
+		treasuryFee, _, err := r.autonity.GetTreasuryFee(nil)
+		require.NoError(r.t, err)
+		require.Equal(r.t, newTreasuryFee, treasuryFee)
+		_, err = governance.Execute(nil, id)
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted, "the proposal is already executed")
+	})
+
+	r.run("defeated without quorum", func(r *runner) {
+		id := propose(r, treasury)
+		_, err := governance.Vote(&runOptions{origin: treasury}, id, true, validators)
+		require.NoError(r.t, err)
+		r.waitNBlocks(int(governanceConfig.VotingPeriod.Int64()) + 1)
+		_, err = governance.Tally(nil, id)
+		require.NoError(r.t, err)
+		require.Equal(r.t, proposalDefeated, state(r, id))
+		_, err = governance.Execute(nil, id)
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted)
+	})
+
+	r.run("liquid newton is counted once", func(r *runner) {
+		other := common.HexToAddress("0x98")
+		half := new(big.Int).Div(delegated, common.Big2)
+		_, err := liquid.Transfer(&runOptions{origin: user}, other, half)
+		require.NoError(r.t, err)
+		id := propose(r, treasury)
+		power, _, err := governance.VotingPower(nil, id, user, validators)
+		require.NoError(r.t, err)
+		require.Equal(r.t, half, power)
+
+		_, err = governance.Vote(&runOptions{origin: user}, id, true, validators)
+		require.NoError(r.t, err)
+		locked, _, err := liquid.LockedBalanceOf(nil, user)
+		require.NoError(r.t, err)
+		require.Equal(r.t, half, locked)
+		// the voted liquid newton can't be moved to vote again
+		_, err = liquid.Transfer(&runOptions{origin: user}, other, half)
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted)
+		_, err = governance.Vote(&runOptions{origin: user}, id, true, validators)
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted, "already voted")
+		_, err = governance.Vote(&runOptions{origin: other}, id, true, validators)
+		require.NoError(r.t, err)
+		vote, _, err := governance.GetVote(nil, id, other)
+		require.NoError(r.t, err)
+		require.Equal(r.t, half, vote.Power)
+		proposal, _, err := governance.GetProposal(nil, id)
+		require.NoError(r.t, err)
+		require.Equal(r.t, delegated, proposal.YesVotes)
+
+		_, err = governance.Release(&runOptions{origin: user}, id)
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted, "the voting period is not over")
+		r.waitNBlocks(int(governanceConfig.VotingPeriod.Int64()) + 1)
+		_, err = governance.Release(&runOptions{origin: user}, id)
+		require.NoError(r.t, err)
+		locked, _, err = liquid.LockedBalanceOf(nil, user)
+		require.NoError(r.t, err)
+		require.Zero(r.t, locked.Sign())
+		_, err = liquid.Transfer(&runOptions{origin: user}, other, half)
+		require.NoError(r.t, err)
+		_, err = governance.Release(&runOptions{origin: user}, id)
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted, "already released")
+	})
+
+	r.run("self-bonded stake is counted once", func(r *runner) {
+		id := propose(r, treasury)
+		_, err := governance.Vote(&runOptions{origin: treasury}, id, true, []common.Address{validator, validator})
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted, "validators not in ascending order")
+		_, err = governance.Vote(&runOptions{origin: treasury}, id, true, []common.Address{user})
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted, "validator not in the snapshot")
+		_, err = governance.Vote(&runOptions{origin: treasury}, id, true, validators)
+		require.NoError(r.t, err)
+		vote, _, err := governance.GetVote(nil, id, treasury)
+		require.NoError(r.t, err)
+		require.Equal(r.t, info.SelfBondedStake, vote.Power)
+	})
+
+	r.run("cancel releases the votes", func(r *runner) {
+		id := propose(r, treasury)
+		_, err := governance.Vote(&runOptions{origin: user}, id, false, validators)
+		require.NoError(r.t, err)
+		_, err = governance.Cancel(&runOptions{origin: user}, id)
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted, "caller is not the proposer")
+		_, err = governance.Cancel(&runOptions{origin: treasury}, id)
+		require.NoError(r.t, err)
+		require.Equal(r.t, proposalCancelled, state(r, id))
+		_, err = governance.Release(&runOptions{origin: user}, id)
+		require.NoError(r.t, err)
+		_, err = governance.Tally(nil, id)
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted)
+	})
+
+	r.run("protocol contracts are operated by governance", func(r *runner) {
+		_, err := r.autonity.SetTreasuryFee(operator, newTreasuryFee)
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted)
+		_, err = governance.SetConfig(operator, governanceConfig)
+		require.ErrorIs(r.t, err, vm.ErrExecutionReverted)
+	})
+}
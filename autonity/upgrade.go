@@ -0,0 +1,397 @@
+package autonity
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/autonity/autonity/accounts/abi"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/state"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/core/vm"
+	"github.com/autonity/autonity/params"
+)
+
+// nodeMethods are the methods of the Autonity contract called by the node,
+// which an upgrade must keep.
+var nodeMethods = map[string]struct{}{
+	"finalize":           {},
+	"getNewContract":     {},
+	"getCommittee":       {},
+	"getCommitteeEnodes": {},
+	"getMinimumBaseFee":  {},
+	"getValidator":       {},
+	"getValidators":      {},
+}
+
+// StorageLayout is the storage layout of a contract as output by solc with
+// --storage-layout.
+type StorageLayout struct {
+	Storage []StorageVariable      `json:"storage"`
+	Types   map[string]StorageType `json:"types"`
+}
+
+// StorageVariable is a state variable, or a struct member, of a storage layout.
+type StorageVariable struct {
+	Label  string `json:"label"`
+	Offset uint64 `json:"offset"`
+	Slot   string `json:"slot"`
+	Type   string `json:"type"`
+}
+
+// StorageType is a type of a storage layout.
+type StorageType struct {
+	Encoding      string            `json:"encoding"`
+	Label         string            `json:"label"`
+	NumberOfBytes string            `json:"numberOfBytes"`
+	Base          string            `json:"base,omitempty"`
+	Key           string            `json:"key,omitempty"`
+	Value         string            `json:"value,omitempty"`
+	Members       []StorageVariable `json:"members,omitempty"`
+}
+
+// UpgradeSimulation is the outcome of a protocol contract upgrade simulated on
+// top of a block.
+type UpgradeSimulation struct {
+	Target common.Address `json:"target"`
+	// Block is the number of the block whose finalization is simulated.
+	Block uint64 `json:"block"`
+	// Error is the error of the replacement or of the finalization.
+	Error            string   `json:"error,omitempty"`
+	ABIIssues        []string `json:"abiIssues"`
+	StorageIssues    []string `json:"storageIssues"`
+	BrokenInvariants []string `json:"brokenInvariants"`
+}
+
+// Compatible reports whether the simulated upgrade raised no issue.
+func (s *UpgradeSimulation) Compatible() bool {
+	return s.Error == "" && len(s.ABIIssues) == 0 && len(s.StorageIssues) == 0 && len(s.BrokenInvariants) == 0
+}
+
+// protocolInvariants is the protocol state which must not be affected by an
+// upgrade.
+type protocolInvariants struct {
+	committee   types.Committee
+	totalSupply *big.Int
+	totalBonded *big.Int
+	validators  []common.Address
+	stakes      []*big.Int
+	operator    common.Address
+}
+
+// SimulateUpgrade simulates the replacement of the bytecode of a protocol
+// contract on top of the given block, followed by the finalization of the next
+// block. The protocol invariants are compared with the ones of the same
+// finalization without the upgrade. The new ABI, if any, is checked against the
+// current one of the contract, and the storage layouts, if both given, are
+// checked for compatibility. The state is left untouched.
+func (c *AutonityContract) SimulateUpgrade(parent *types.Header, statedb *state.StateDB, target common.Address, bytecode []byte, newABI *abi.ABI, oldLayout, newLayout *StorageLayout) *UpgradeSimulation {
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		GasLimit:   parent.GasLimit,
+		Time:       parent.Time + c.chainConfig.AutonityContractConfig.BlockPeriod,
+		Coinbase:   parent.Coinbase,
+		BaseFee:    parent.BaseFee,
+	}
+	result := &UpgradeSimulation{
+		Target:           target,
+		Block:            header.Number.Uint64(),
+		ABIIssues:        []string{},
+		StorageIssues:    []string{},
+		BrokenInvariants: []string{},
+	}
+	contract, ok := protocolContracts(c.contractABI)[target]
+	if !ok {
+		result.Error = fmt.Sprintf("%v is not a protocol contract", target)
+		return result
+	}
+	if newABI != nil {
+		result.ABIIssues = CompareABIs(contract.abi, newABI, target == params.AutonityContractAddress)
+	}
+	if oldLayout != nil && newLayout != nil {
+		result.StorageIssues = CompareStorageLayouts(oldLayout, newLayout)
+	}
+
+	// The baseline is the finalization of the next block without the upgrade
+	want, err := c.simulateFinalize(header, statedb.Copy(), c.contractABI)
+	if err != nil {
+		result.Error = fmt.Sprintf("finalize failed without the upgrade: %v", err)
+		return result
+	}
+	upgraded := statedb.Copy()
+	balance := upgraded.GetBalance(target)
+	if err := c.replaceContractBytecode(header, upgraded, target, bytecode); err != nil {
+		result.Error = fmt.Sprintf("replacement failed: %v", err)
+		return result
+	}
+	if have := upgraded.GetBalance(target); have.Cmp(balance) != 0 {
+		result.BrokenInvariants = append(result.BrokenInvariants, fmt.Sprintf("contract balance mismatch: have %v, want %v", have, balance))
+	}
+	autonityABI := c.contractABI
+	if target == params.AutonityContractAddress && newABI != nil {
+		autonityABI = newABI
+	}
+	have, err := c.simulateFinalize(header, upgraded, autonityABI)
+	if err != nil {
+		result.Error = fmt.Sprintf("finalize failed after the upgrade: %v", err)
+		return result
+	}
+	result.BrokenInvariants = append(result.BrokenInvariants, compareInvariants(have, want)...)
+	return result
+}
+
+// simulateFinalize finalizes the block with the given ABI of the Autonity
+// contract and returns the resulting protocol invariants.
+func (c *AutonityContract) simulateFinalize(header *types.Header, statedb vm.StateDB, autonityABI *abi.ABI) (*protocolInvariants, error) {
+	contract := &AutonityContract{
+		EVMContract: EVMContract{
+			evmProvider: c.evmProvider,
+			contractABI: autonityABI,
+			db:          c.db,
+			chainConfig: c.chainConfig,
+		},
+	}
+	_, committee, err := contract.callFinalize(statedb, header)
+	if err != nil {
+		return nil, err
+	}
+	invariants := &protocolInvariants{committee: committee}
+	if err := contract.AutonityContractCall(statedb, header, "totalSupply", &invariants.totalSupply); err != nil {
+		return nil, fmt.Errorf("totalSupply: %w", err)
+	}
+	if err := contract.AutonityContractCall(statedb, header, "epochTotalBondedStake", &invariants.totalBonded); err != nil {
+		return nil, fmt.Errorf("epochTotalBondedStake: %w", err)
+	}
+	if err := contract.AutonityContractCall(statedb, header, "getValidators", &invariants.validators); err != nil {
+		return nil, fmt.Errorf("getValidators: %w", err)
+	}
+	for _, addr := range invariants.validators {
+		validator, err := contract.callGetValidator(statedb, header, addr)
+		if err != nil {
+			return nil, fmt.Errorf("getValidator: %w", err)
+		}
+		invariants.stakes = append(invariants.stakes, validator.BondedStake)
+	}
+	if err := contract.AutonityContractCall(statedb, header, "getOperator", &invariants.operator); err != nil {
+		return nil, fmt.Errorf("getOperator: %w", err)
+	}
+	return invariants, nil
+}
+
+// compareInvariants describes the differences between the protocol invariants
+// after the upgrade and the expected ones.
+func compareInvariants(have, want *protocolInvariants) []string {
+	var broken []string
+	if len(have.committee) != len(want.committee) {
+		broken = append(broken, fmt.Sprintf("committee size mismatch: have %d, want %d", len(have.committee), len(want.committee)))
+	} else {
+		for i := range want.committee {
+			h, w := have.committee[i], want.committee[i]
+			if h.Address != w.Address || h.VotingPower.Cmp(w.VotingPower) != 0 || !bytes.Equal(h.ConsensusKey, w.ConsensusKey) {
+				broken = append(broken, fmt.Sprintf("committee member %d mismatch: have %v with power %v, want %v with power %v", i, h.Address, h.VotingPower, w.Address, w.VotingPower))
+			}
+		}
+	}
+	if have.totalSupply.Cmp(want.totalSupply) != 0 {
+		broken = append(broken, fmt.Sprintf("total supply mismatch: have %v, want %v", have.totalSupply, want.totalSupply))
+	}
+	if have.totalBonded.Cmp(want.totalBonded) != 0 {
+		broken = append(broken, fmt.Sprintf("epoch total bonded stake mismatch: have %v, want %v", have.totalBonded, want.totalBonded))
+	}
+	if len(have.validators) != len(want.validators) {
+		broken = append(broken, fmt.Sprintf("validator count mismatch: have %d, want %d", len(have.validators), len(want.validators)))
+	} else {
+		for i := range want.validators {
+			if have.validators[i] != want.validators[i] {
+				broken = append(broken, fmt.Sprintf("validator %d mismatch: have %v, want %v", i, have.validators[i], want.validators[i]))
+			} else if have.stakes[i].Cmp(want.stakes[i]) != 0 {
+				broken = append(broken, fmt.Sprintf("validator %v bonded stake mismatch: have %v, want %v", have.validators[i], have.stakes[i], want.stakes[i]))
+			}
+		}
+	}
+	if have.operator != want.operator {
+		broken = append(broken, fmt.Sprintf("operator mismatch: have %v, want %v", have.operator, want.operator))
+	}
+	return broken
+}
+
+// CompareABIs describes the methods and events of the old ABI which are removed
+// or changed in the new one. The methods called by the node are reported as
+// such if protocol is set.
+func CompareABIs(old, new *abi.ABI, protocol bool) []string {
+	issues := []string{}
+	methods := maps.Keys(old.Methods)
+	sort.Strings(methods)
+	for _, name := range methods {
+		method := old.Methods[name]
+		kind := "method"
+		if _, ok := nodeMethods[name]; ok && protocol {
+			kind = "method used by the node"
+		}
+		updated, ok := new.Methods[name]
+		switch {
+		case !ok:
+			issues = append(issues, fmt.Sprintf("%s %s removed", kind, method.Sig))
+		case updated.Sig != method.Sig:
+			issues = append(issues, fmt.Sprintf("%s %s changed to %s", kind, method.Sig, updated.Sig))
+		case argumentTypes(updated.Outputs) != argumentTypes(method.Outputs):
+			issues = append(issues, fmt.Sprintf("%s %s outputs changed from (%s) to (%s)", kind, method.Sig, argumentTypes(method.Outputs), argumentTypes(updated.Outputs)))
+		}
+	}
+	events := maps.Keys(old.Events)
+	sort.Strings(events)
+	for _, name := range events {
+		event := old.Events[name]
+		updated, ok := new.Events[name]
+		switch {
+		case !ok:
+			issues = append(issues, fmt.Sprintf("event %s removed", event.Sig))
+		case updated.ID != event.ID:
+			issues = append(issues, fmt.Sprintf("event %s changed to %s", event.Sig, updated.Sig))
+		}
+	}
+	return issues
+}
+
+// CompareStorageLayouts describes the incompatibilities of the new storage
+// layout of a contract with the old one: the state variables must keep their
+// slot, offset and type, and the new ones must not overlap them.
+func CompareStorageLayouts(old, new *StorageLayout) []string {
+	issues := []string{}
+	variables := make(map[string]StorageVariable, len(new.Storage))
+	for _, variable := range new.Storage {
+		variables[variable.Label] = variable
+	}
+	known := make(map[string]struct{}, len(old.Storage))
+	for _, variable := range old.Storage {
+		known[variable.Label] = struct{}{}
+		updated, ok := variables[variable.Label]
+		if !ok {
+			issues = append(issues, fmt.Sprintf("variable %s removed", variable.Label))
+			continue
+		}
+		if updated.Slot != variable.Slot || updated.Offset != variable.Offset {
+			issues = append(issues, fmt.Sprintf("variable %s moved from slot %s offset %d to slot %s offset %d", variable.Label, variable.Slot, variable.Offset, updated.Slot, updated.Offset))
+			continue
+		}
+		issues = append(issues, compareStorageTypes(variable.Label, old, new, variable.Type, updated.Type, false)...)
+	}
+	for _, variable := range new.Storage {
+		if _, ok := known[variable.Label]; ok {
+			continue
+		}
+		for _, previous := range old.Storage {
+			if storageOverlaps(new, variable, old, previous) {
+				issues = append(issues, fmt.Sprintf("new variable %s overlaps variable %s", variable.Label, previous.Label))
+			}
+		}
+	}
+	return issues
+}
+
+// compareStorageTypes describes the incompatibilities between the old and new
+// types of a variable. The types are compared structurally since their
+// identifiers embed compiler generated ids. If fixedSize is set, the size of
+// the type must be kept since other values are stored right after it.
+func compareStorageTypes(path string, old, new *StorageLayout, oldID, newID string, fixedSize bool) []string {
+	oldType, ok := old.Types[oldID]
+	if !ok {
+		return []string{fmt.Sprintf("%s: unknown type %s in old layout", path, oldID)}
+	}
+	newType, ok := new.Types[newID]
+	if !ok {
+		return []string{fmt.Sprintf("%s: unknown type %s in new layout", path, newID)}
+	}
+	if oldType.Encoding != newType.Encoding {
+		return []string{fmt.Sprintf("%s: encoding changed from %s to %s", path, oldType.Encoding, newType.Encoding)}
+	}
+	var issues []string
+	if oldType.NumberOfBytes != newType.NumberOfBytes && (fixedSize || len(oldType.Members) == 0) {
+		issues = append(issues, fmt.Sprintf("%s: size changed from %s to %s bytes", path, oldType.NumberOfBytes, newType.NumberOfBytes))
+	}
+	switch {
+	case oldType.Encoding == "mapping":
+		issues = append(issues, compareStorageTypes(path+"[key]", old, new, oldType.Key, newType.Key, true)...)
+		issues = append(issues, compareStorageTypes(path+"[value]", old, new, oldType.Value, newType.Value, false)...)
+	case oldType.Base != "":
+		issues = append(issues, compareStorageTypes(path+"[]", old, new, oldType.Base, newType.Base, true)...)
+	case len(oldType.Members) > 0:
+		members := make(map[string]StorageVariable, len(newType.Members))
+		for _, member := range newType.Members {
+			members[member.Label] = member
+		}
+		for _, member := range oldType.Members {
+			memberPath := path + "." + member.Label
+			updated, ok := members[member.Label]
+			if !ok {
+				issues = append(issues, fmt.Sprintf("%s removed", memberPath))
+				continue
+			}
+			if updated.Slot != member.Slot || updated.Offset != member.Offset {
+				issues = append(issues, fmt.Sprintf("%s moved from slot %s offset %d to slot %s offset %d", memberPath, member.Slot, member.Offset, updated.Slot, updated.Offset))
+				continue
+			}
+			issues = append(issues, compareStorageTypes(memberPath, old, new, member.Type, updated.Type, false)...)
+		}
+	case oldType.Label != newType.Label && !sameReferenceKind(oldType.Label, newType.Label):
+		issues = append(issues, fmt.Sprintf("%s: type changed from %s to %s", path, oldType.Label, newType.Label))
+	}
+	return issues
+}
+
+// sameReferenceKind reports whether both type labels are contracts or both are
+// enums, whose renaming doesn't change the storage.
+func sameReferenceKind(old, new string) bool {
+	for _, prefix := range []string{"contract ", "enum "} {
+		if len(old) > len(prefix) && old[:len(prefix)] == prefix && len(new) > len(prefix) && new[:len(prefix)] == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+// storageOverlaps reports whether two variables of the given layouts share
+// storage bytes.
+func storageOverlaps(layout *StorageLayout, variable StorageVariable, otherLayout *StorageLayout, other StorageVariable) bool {
+	start, end, ok := storageRange(layout, variable)
+	if !ok {
+		return false
+	}
+	otherStart, otherEnd, ok := storageRange(otherLayout, other)
+	if !ok {
+		return false
+	}
+	return start < otherEnd && otherStart < end
+}
+
+// storageRange returns the storage bytes occupied in place by a variable.
+func storageRange(layout *StorageLayout, variable StorageVariable) (uint64, uint64, bool) {
+	slot, err := strconv.ParseUint(variable.Slot, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	size, err := strconv.ParseUint(layout.Types[variable.Type].NumberOfBytes, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	start := slot*32 + variable.Offset
+	return start, start + size, true
+}
+
+// argumentTypes returns the comma separated types of the arguments.
+func argumentTypes(args abi.Arguments) string {
+	var types string
+	for i, arg := range args {
+		if i > 0 {
+			types += ","
+		}
+		types += arg.Type.String()
+	}
+	return types
+}
@@ -0,0 +1,129 @@
+package autonity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/accounts/abi"
+	"github.com/autonity/autonity/params/generated"
+)
+
+func TestCompareABIs(t *testing.T) {
+	require.Empty(t, CompareABIs(&generated.AutonityAbi, &generated.AutonityAbi, true))
+
+	newABI, err := abi.JSON(strings.NewReader(`[
+		{"type":"function","name":"finalize","inputs":[{"name":"x","type":"uint256"}],"outputs":[]},
+		{"type":"function","name":"foo","inputs":[],"outputs":[{"name":"","type":"bool"}]},
+		{"type":"function","name":"bar","inputs":[],"outputs":[]},
+		{"type":"event","name":"Ev","inputs":[{"name":"a","type":"address","indexed":true}]}
+	]`))
+	require.NoError(t, err)
+	oldABI, err := abi.JSON(strings.NewReader(`[
+		{"type":"function","name":"finalize","inputs":[],"outputs":[]},
+		{"type":"function","name":"foo","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+		{"type":"function","name":"bar","inputs":[],"outputs":[]},
+		{"type":"function","name":"baz","inputs":[],"outputs":[]},
+		{"type":"event","name":"Ev","inputs":[{"name":"a","type":"address","indexed":true}]},
+		{"type":"event","name":"Gone","inputs":[]}
+	]`))
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"method baz() removed",
+		"method used by the node finalize() changed to finalize(uint256)",
+		"method foo() outputs changed from (uint256) to (bool)",
+		"event Gone() removed",
+	}, CompareABIs(&oldABI, &newABI, true))
+	require.Equal(t, "method finalize() changed to finalize(uint256)", CompareABIs(&oldABI, &newABI, false)[1])
+}
+
+func TestCompareStorageLayouts(t *testing.T) {
+	types := map[string]StorageType{
+		"t_uint256": {Encoding: "inplace", Label: "uint256", NumberOfBytes: "32"},
+		"t_address": {Encoding: "inplace", Label: "address", NumberOfBytes: "20"},
+		"t_bool":    {Encoding: "inplace", Label: "bool", NumberOfBytes: "1"},
+		"t_struct(S)1": {
+			Encoding:      "inplace",
+			Label:         "struct S",
+			NumberOfBytes: "64",
+			Members: []StorageVariable{
+				{Label: "a", Slot: "0", Type: "t_uint256"},
+				{Label: "b", Slot: "1", Type: "t_address"},
+			},
+		},
+		"t_mapping(t_address,t_struct(S)1)": {Encoding: "mapping", Label: "mapping(address => struct S)", NumberOfBytes: "32", Key: "t_address", Value: "t_struct(S)1"},
+	}
+	old := &StorageLayout{
+		Storage: []StorageVariable{
+			{Label: "supply", Slot: "0", Type: "t_uint256"},
+			{Label: "operator", Slot: "1", Type: "t_address"},
+			{Label: "paused", Slot: "1", Offset: 20, Type: "t_bool"},
+			{Label: "validators", Slot: "2", Type: "t_mapping(t_address,t_struct(S)1)"},
+		},
+		Types: types,
+	}
+	require.Empty(t, CompareStorageLayouts(old, old))
+
+	// Appending variables and struct members behind a mapping is compatible
+	newTypes := make(map[string]StorageType, len(types))
+	for id, typ := range types {
+		newTypes[id] = typ
+	}
+	newTypes["t_struct(S)2"] = StorageType{
+		Encoding:      "inplace",
+		Label:         "struct S",
+		NumberOfBytes: "96",
+		Members: []StorageVariable{
+			{Label: "a", Slot: "0", Type: "t_uint256"},
+			{Label: "b", Slot: "1", Type: "t_address"},
+			{Label: "c", Slot: "2", Type: "t_uint256"},
+		},
+	}
+	newTypes["t_mapping(t_address,t_struct(S)2)"] = StorageType{Encoding: "mapping", Label: "mapping(address => struct S)", NumberOfBytes: "32", Key: "t_address", Value: "t_struct(S)2"}
+	appended := &StorageLayout{
+		Storage: []StorageVariable{
+			{Label: "supply", Slot: "0", Type: "t_uint256"},
+			{Label: "operator", Slot: "1", Type: "t_address"},
+			{Label: "paused", Slot: "1", Offset: 20, Type: "t_bool"},
+			{Label: "validators", Slot: "2", Type: "t_mapping(t_address,t_struct(S)2)"},
+			{Label: "extra", Slot: "3", Type: "t_uint256"},
+		},
+		Types: newTypes,
+	}
+	require.Empty(t, CompareStorageLayouts(old, appended))
+
+	// Inserting a variable shifts the following ones
+	inserted := &StorageLayout{
+		Storage: []StorageVariable{
+			{Label: "supply", Slot: "0", Type: "t_uint256"},
+			{Label: "extra", Slot: "1", Type: "t_uint256"},
+			{Label: "operator", Slot: "2", Type: "t_address"},
+			{Label: "paused", Slot: "2", Offset: 20, Type: "t_bool"},
+			{Label: "validators", Slot: "3", Type: "t_mapping(t_address,t_struct(S)1)"},
+		},
+		Types: types,
+	}
+	require.Equal(t, []string{
+		"variable operator moved from slot 1 offset 0 to slot 2 offset 0",
+		"variable paused moved from slot 1 offset 20 to slot 2 offset 20",
+		"variable validators moved from slot 2 offset 0 to slot 3 offset 0",
+		"new variable extra overlaps variable operator",
+		"new variable extra overlaps variable paused",
+	}, CompareStorageLayouts(old, inserted))
+
+	// Changing the type of a variable in place
+	retyped := &StorageLayout{
+		Storage: []StorageVariable{
+			{Label: "supply", Slot: "0", Type: "t_uint256"},
+			{Label: "operator", Slot: "1", Type: "t_address"},
+			{Label: "paused", Slot: "1", Offset: 20, Type: "t_uint256"},
+		},
+		Types: types,
+	}
+	require.Equal(t, []string{
+		"paused: size changed from 1 to 32 bytes",
+		"paused: type changed from bool to uint256",
+		"variable validators removed",
+	}, CompareStorageLayouts(old, retyped))
+}
@@ -31,6 +31,13 @@ import (
 var (
 	consoleFlags = []cli.Flag{utils.JSpathFlag, utils.ExecFlag, utils.PreloadJSFlag}
 
+	// nodeEndpointFlag is the endpoint of the running node used by the commands
+	// interacting with it over RPC.
+	nodeEndpointFlag = cli.StringFlag{
+		Name:  "endpoint",
+		Usage: "IPC path or HTTP/WS URL of the running node (default = IPC endpoint in the datadir)",
+	}
+
 	consoleCommand = cli.Command{
 		Action:   utils.MigrateFlags(localConsole),
 		Name:     "console",
@@ -121,17 +128,7 @@ func remoteConsole(ctx *cli.Context) error {
 	// Attach to a remotely running autonity instance and start the JavaScript console
 	endpoint := ctx.Args().First()
 	if endpoint == "" {
-		path := node.DefaultDataDir()
-		if ctx.GlobalIsSet(utils.DataDirFlag.Name) {
-			path = ctx.GlobalString(utils.DataDirFlag.Name)
-		}
-		if path != "" && ctx.GlobalBool(utils.PiccadillyFlag.Name) {
-			path = filepath.Join(path, "piccadilly")
-		}
-		if path != "" && ctx.GlobalBool(utils.BakerlooFlag.Name) {
-			path = filepath.Join(path, "bakerloo")
-		}
-		endpoint = fmt.Sprintf("%s/autonity.ipc", path)
+		endpoint = defaultIPCEndpoint(ctx)
 	}
 	client, err := dialRPC(endpoint)
 	if err != nil {
@@ -160,6 +157,36 @@ func remoteConsole(ctx *cli.Context) error {
 	return nil
 }
 
+// defaultIPCEndpoint returns the IPC endpoint of the autonity instance running
+// in the data directory given on the command line.
+func defaultIPCEndpoint(ctx *cli.Context) string {
+	path := node.DefaultDataDir()
+	if ctx.GlobalIsSet(utils.DataDirFlag.Name) {
+		path = ctx.GlobalString(utils.DataDirFlag.Name)
+	}
+	if path != "" && ctx.GlobalBool(utils.PiccadillyFlag.Name) {
+		path = filepath.Join(path, "piccadilly")
+	}
+	if path != "" && ctx.GlobalBool(utils.BakerlooFlag.Name) {
+		path = filepath.Join(path, "bakerloo")
+	}
+	return fmt.Sprintf("%s/autonity.ipc", path)
+}
+
+// dialNode connects to the running node given by the endpoint flag, or to the
+// one running in the data directory.
+func dialNode(ctx *cli.Context) *rpc.Client {
+	endpoint := ctx.String(nodeEndpointFlag.Name)
+	if endpoint == "" {
+		endpoint = defaultIPCEndpoint(ctx)
+	}
+	client, err := dialRPC(endpoint)
+	if err != nil {
+		utils.Fatalf("Unable to attach to the running node: %v", err)
+	}
+	return client
+}
+
 // dialRPC returns a RPC client which connects to the given endpoint.
 // The check for empty endpoint implements the defaulting logic
 // for "geth attach" with no argument.
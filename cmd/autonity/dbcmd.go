@@ -50,6 +50,7 @@ var (
 		ArgsUsage: "",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
 		},
 		Category: "DATABASE COMMANDS",
 		Description: `
@@ -80,6 +81,7 @@ Remove blockchain and state databases`,
 		ArgsUsage: "<prefix> <start>",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
 			utils.AncientFlag,
 			utils.SyncModeFlag,
 			utils.PiccadillyFlag,
@@ -91,9 +93,10 @@ Remove blockchain and state databases`,
 	dbStatCmd = cli.Command{
 		Action: utils.MigrateFlags(dbStats),
 		Name:   "stats",
-		Usage:  "Print leveldb statistics",
+		Usage:  "Print database statistics",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
 			utils.SyncModeFlag,
 			utils.PiccadillyFlag,
 			utils.BakerlooFlag,
@@ -102,9 +105,10 @@ Remove blockchain and state databases`,
 	dbCompactCmd = cli.Command{
 		Action: utils.MigrateFlags(dbCompact),
 		Name:   "compact",
-		Usage:  "Compact leveldb database. WARNING: May take a very long time",
+		Usage:  "Compact the database. WARNING: May take a very long time",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
 			utils.SyncModeFlag,
 			utils.CacheFlag,
 			utils.CacheDatabaseFlag,
@@ -122,6 +126,7 @@ corruption if it is aborted during execution'!`,
 		ArgsUsage: "<hex-encoded key>",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
 			utils.SyncModeFlag,
 			utils.PiccadillyFlag,
 			utils.BakerlooFlag,
@@ -135,6 +140,7 @@ corruption if it is aborted during execution'!`,
 		ArgsUsage: "<hex-encoded key>",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
 			utils.SyncModeFlag,
 			utils.PiccadillyFlag,
 			utils.BakerlooFlag,
@@ -149,6 +155,7 @@ WARNING: This is a low-level operation which may cause database corruption!`,
 		ArgsUsage: "<hex-encoded key> <hex-encoded value>",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
 			utils.SyncModeFlag,
 			utils.PiccadillyFlag,
 			utils.BakerlooFlag,
@@ -163,6 +170,7 @@ WARNING: This is a low-level operation which may cause database corruption!`,
 		ArgsUsage: "<hex-encoded storage trie root> <hex-encoded start (optional)> <int max elements (optional)>",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
 			utils.SyncModeFlag,
 			utils.PiccadillyFlag,
 			utils.BakerlooFlag,
@@ -176,6 +184,7 @@ WARNING: This is a low-level operation which may cause database corruption!`,
 		ArgsUsage: "<type> <start (int)> <end (int)>",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
 			utils.SyncModeFlag,
 			utils.PiccadillyFlag,
 			utils.BakerlooFlag,
@@ -189,6 +198,7 @@ WARNING: This is a low-level operation which may cause database corruption!`,
 		ArgsUsage: "<dumpfile> <start (optional)",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
 			utils.SyncModeFlag,
 			utils.PiccadillyFlag,
 			utils.BakerlooFlag,
@@ -202,6 +212,7 @@ WARNING: This is a low-level operation which may cause database corruption!`,
 		ArgsUsage: "<type> <dumpfile>",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
 			utils.SyncModeFlag,
 			utils.PiccadillyFlag,
 			utils.BakerlooFlag,
@@ -214,6 +225,7 @@ WARNING: This is a low-level operation which may cause database corruption!`,
 		Usage:  "Shows metadata about the chain status.",
 		Flags: []cli.Flag{
 			utils.DataDirFlag,
+			utils.DBEngineFlag,
 			utils.SyncModeFlag,
 			utils.PiccadillyFlag,
 			utils.BakerlooFlag,
@@ -313,7 +325,13 @@ func inspect(ctx *cli.Context) error {
 	return rawdb.InspectDatabase(db, prefix, start)
 }
 
-func showLeveldbStats(db ethdb.Stater) {
+// showDatabaseStats prints the internal statistics of the key-value store, in the
+// format of its database engine.
+func showDatabaseStats(db ethdb.Stater) {
+	if stats, err := db.Stat("pebble.stats"); err == nil {
+		fmt.Println(stats)
+		return
+	}
 	if stats, err := db.Stat("leveldb.stats"); err != nil {
 		log.Warn("Failed to read database stats", "error", err)
 	} else {
@@ -333,7 +351,7 @@ func dbStats(ctx *cli.Context) error {
 	db := utils.MakeChainDatabase(ctx, stack, true)
 	defer db.Close()
 
-	showLeveldbStats(db)
+	showDatabaseStats(db)
 	return nil
 }
 
@@ -345,7 +363,7 @@ func dbCompact(ctx *cli.Context) error {
 	defer db.Close()
 
 	log.Info("Stats before compaction")
-	showLeveldbStats(db)
+	showDatabaseStats(db)
 
 	log.Info("Triggering compaction")
 	if err := db.Compact(nil, nil); err != nil {
@@ -353,7 +371,7 @@ func dbCompact(ctx *cli.Context) error {
 		return err
 	}
 	log.Info("Stats after compaction")
-	showLeveldbStats(db)
+	showDatabaseStats(db)
 	return nil
 }
 
@@ -477,7 +495,7 @@ func dbDumpTrie(ctx *cli.Context) error {
 			return err
 		}
 	}
-	theTrie, err := trie.New(stRoot, trie.NewDatabase(db))
+	theTrie, err := trie.New(stRoot, utils.MakeTrieDatabase(ctx, db, false, true))
 	if err != nil {
 		return err
 	}
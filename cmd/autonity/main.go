@@ -68,6 +68,7 @@ var (
 		utils.DataDirFlag,
 		utils.InitGenesisFlag,
 		utils.AncientFlag,
+		utils.DBEngineFlag,
 		utils.MinFreeDiskSpaceFlag,
 		utils.KeyStoreDirFlag,
 		utils.ExternalSignerFlag,
@@ -86,10 +87,14 @@ var (
 		utils.TxPoolGlobalQueueFlag,
 		utils.TxPoolLifetimeFlag,
 		utils.SyncModeFlag,
+		utils.SyncCheckpointFlag,
 		utils.ExitWhenSyncedFlag,
 		utils.GCModeFlag,
+		utils.StateSchemeFlag,
+		utils.StateHistoryFlag,
 		utils.SnapshotFlag,
 		utils.TxLookupLimitFlag,
+		utils.HistoryEpochsFlag,
 		utils.EthRequiredBlocksFlag,
 		utils.BloomFilterSizeFlag,
 		utils.CacheFlag,
@@ -140,6 +145,12 @@ var (
 		utils.BakerlooFlag,
 		utils.ConsensusListenPortFlag,
 		utils.ConsensusNATFlag,
+		utils.ConsensusTopologyFlag,
+		utils.ConsensusTopologyDiameterFlag,
+		utils.ConsensusTopologyMaxExtraFlag,
+		utils.ConsensusBlockPartsFlag,
+		utils.ConsensusSentriesFlag,
+		utils.ConsensusSentryValidatorFlag,
 		configFileFlag,
 	}
 
@@ -196,6 +207,8 @@ func init() {
 		// See chaincmd.go:
 		importCommand,
 		exportCommand,
+		importHistoryCommand,
+		exportHistoryCommand,
 		importPreimagesCommand,
 		exportPreimagesCommand,
 		removedbCommand,
@@ -219,6 +232,10 @@ func init() {
 		utils.ShowDeprecated,
 		// See snapshot.go
 		snapshotCommand,
+		// See validatorcmd.go
+		validatorCommand,
+		// See upgradecmd.go
+		upgradeCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
@@ -175,6 +175,23 @@ func genOwnershipProof(ctx *cli.Context) error {
 		utils.Fatalf(`Usage: autonity genOwnershipProof [options] <treasuryAddress>`)
 	}
 
+	nodePrivateKey, oraclePrivateKey, consensusKey := loadOwnershipKeys(ctx)
+	treasury := args[0]
+	signatures, err := crypto.AutonityPOPProof(nodePrivateKey, oraclePrivateKey, treasury, consensusKey)
+	if err != nil {
+		if err == hexutil.ErrMissingPrefix {
+			utils.Fatalf("Failed to decode: hex string without 0x prefix")
+		}
+		utils.Fatalf("Failed to generate Autonity POP: %v", err)
+	}
+
+	fmt.Println(hexutil.Encode(signatures))
+	return nil
+}
+
+// loadOwnershipKeys loads the node key, the consensus key and the oracle key of a node operator from the key files
+// or hex strings given on the command line. A consensus key is generated and appended to legacy node key files.
+func loadOwnershipKeys(ctx *cli.Context) (*ecdsa.PrivateKey, *ecdsa.PrivateKey, blst.SecretKey) {
 	var nodePrivateKey, oraclePrivateKey *ecdsa.PrivateKey
 	var consensusKey blst.SecretKey
 	var err error
@@ -235,18 +252,7 @@ func genOwnershipProof(ctx *cli.Context) error {
 	} else {
 		utils.Fatalf(`oracle key details are not provided`)
 	}
-
-	treasury := args[0]
-	signatures, err := crypto.AutonityPOPProof(nodePrivateKey, oraclePrivateKey, treasury, consensusKey)
-	if err != nil {
-		if err == hexutil.ErrMissingPrefix {
-			utils.Fatalf("Failed to decode: hex string without 0x prefix")
-		}
-		utils.Fatalf("Failed to generate Autonity POP: %v", err)
-	}
-
-	fmt.Println(hexutil.Encode(signatures))
-	return nil
+	return nodePrivateKey, oraclePrivateKey, consensusKey
 }
 
 // genAutonityKeys generates a node key, and append its derived BLS private key (the validator key) in the key file.
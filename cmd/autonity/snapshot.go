@@ -21,10 +21,12 @@ import (
 	"encoding/json"
 	"errors"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/autonity/autonity/cmd/utils"
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
 	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/state"
 	"github.com/autonity/autonity/core/state/pruner"
@@ -141,6 +143,53 @@ verification. The default checking target is the HEAD state. It's basically iden
 to traverse-state, but the check granularity is smaller. 
 
 It's also usable without snapshot enabled.
+`,
+			},
+			{
+				Name:      "export-state",
+				Usage:     "Export the state at a block into a portable state archive",
+				ArgsUsage: "<filename> [<blockNum>]",
+				Action:    utils.MigrateFlags(exportState),
+				Category:  "MISCELLANEOUS COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.AncientFlag,
+					utils.PiccadillyFlag,
+					utils.BakerlooFlag,
+					utils.StateSchemeFlag,
+				},
+				Description: `
+autonity snapshot export-state <filename> [<blockNum>]
+will write the accounts, storage slots and contract codes of the state at the
+given block, read from the state snapshot, into a state archive along with the
+block and the header of its parent. The default block is the HEAD block, the
+state snapshot of the block must be available.
+`,
+			},
+			{
+				Name:      "import-state",
+				Usage:     "Bootstrap an empty node from a portable state archive",
+				ArgsUsage: "<filename> [<blockHash>]",
+				Action:    utils.MigrateFlags(importState),
+				Category:  "MISCELLANEOUS COMMANDS",
+				Flags: []cli.Flag{
+					utils.DataDirFlag,
+					utils.AncientFlag,
+					utils.PiccadillyFlag,
+					utils.BakerlooFlag,
+					utils.StateSchemeFlag,
+					utils.SyncCheckpointFlag,
+				},
+				Description: `
+autonity snapshot import-state <filename> [<blockHash>]
+will verify that the block of the state archive, or its parent, matches the
+trusted block hash and that the block is sealed by the committee of its parent.
+The trusted hash is required, given either as argument or with --sync.checkpoint
+as a hash or a checkpoint file. It then rebuilds the
+state tries from the archive, checks their root against the block and anchors
+the chain at it, from where the node keeps on syncing. The headers below it
+are backfilled from the network, whereas their bodies and receipts are not.
+The node must not have synced any block yet.
 `,
 			},
 			{
@@ -210,7 +259,7 @@ func verifyState(ctx *cli.Context) error {
 		log.Error("Failed to load head block")
 		return errors.New("no head block")
 	}
-	snaptree, err := snapshot.New(chaindb, trie.NewDatabase(chaindb), 256, headBlock.Root(), false, false, false)
+	snaptree, err := snapshot.New(chaindb, utils.MakeTrieDatabase(ctx, chaindb, false, true), 256, headBlock.Root(), false, false, false)
 	if err != nil {
 		log.Error("Failed to open snapshot tree", "err", err)
 		return err
@@ -267,7 +316,7 @@ func traverseState(ctx *cli.Context) error {
 		root = headBlock.Root()
 		log.Info("Start traversing the state", "root", root, "number", headBlock.NumberU64())
 	}
-	triedb := trie.NewDatabase(chaindb)
+	triedb := utils.MakeTrieDatabase(ctx, chaindb, false, true)
 	t, err := trie.NewSecure(root, triedb)
 	if err != nil {
 		log.Error("Failed to open trie", "root", root, "err", err)
@@ -289,7 +338,7 @@ func traverseState(ctx *cli.Context) error {
 			return err
 		}
 		if acc.Root != emptyRoot {
-			storageTrie, err := trie.NewSecure(acc.Root, triedb)
+			storageTrie, err := trie.NewSecureWithOwner(common.BytesToHash(accIter.Key), acc.Root, triedb)
 			if err != nil {
 				log.Error("Failed to open storage trie", "root", acc.Root, "err", err)
 				return err
@@ -357,7 +406,7 @@ func traverseRawState(ctx *cli.Context) error {
 		root = headBlock.Root()
 		log.Info("Start traversing the state", "root", root, "number", headBlock.NumberU64())
 	}
-	triedb := trie.NewDatabase(chaindb)
+	triedb := utils.MakeTrieDatabase(ctx, chaindb, false, true)
 	t, err := trie.NewSecure(root, triedb)
 	if err != nil {
 		log.Error("Failed to open trie", "root", root, "err", err)
@@ -395,7 +444,7 @@ func traverseRawState(ctx *cli.Context) error {
 				return errors.New("invalid account")
 			}
 			if acc.Root != emptyRoot {
-				storageTrie, err := trie.NewSecure(acc.Root, triedb)
+				storageTrie, err := trie.NewSecureWithOwner(common.BytesToHash(accIter.LeafKey()), acc.Root, triedb)
 				if err != nil {
 					log.Error("Failed to open storage trie", "root", acc.Root, "err", err)
 					return errors.New("missing storage trie")
@@ -445,6 +494,76 @@ func traverseRawState(ctx *cli.Context) error {
 	return nil
 }
 
+// exportState writes the state at the given block into a state archive.
+func exportState(ctx *cli.Context) error {
+	if ctx.NArg() < 1 || ctx.NArg() > 2 {
+		utils.Fatalf("This command requires one or two arguments.")
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, db := utils.MakeChain(ctx, stack)
+	defer db.Close()
+	defer chain.Stop()
+
+	number := chain.CurrentBlock().NumberU64()
+	if ctx.NArg() == 2 {
+		n, err := strconv.ParseUint(ctx.Args().Get(1), 10, 64)
+		if err != nil {
+			log.Error("Failed to parse block number", "err", err)
+			return err
+		}
+		number = n
+	}
+	if err := utils.ExportState(chain, ctx.Args().First(), number); err != nil {
+		log.Error("Failed to export state", "number", number, "err", err)
+		return err
+	}
+	return nil
+}
+
+// importState bootstraps the node from a state archive.
+func importState(ctx *cli.Context) error {
+	if ctx.NArg() < 1 || ctx.NArg() > 2 {
+		utils.Fatalf("This command requires one or two arguments.")
+	}
+	var trusted common.Hash
+	switch checkpoint := ctx.GlobalString(utils.SyncCheckpointFlag.Name); {
+	case ctx.NArg() == 2:
+		hash, err := parseRoot(ctx.Args().Get(1))
+		if err != nil {
+			log.Error("Failed to parse block hash", "err", err)
+			return err
+		}
+		trusted = hash
+	case checkpoint != "":
+		if blob, err := hexutil.Decode(checkpoint); err == nil && len(blob) == common.HashLength {
+			trusted = common.BytesToHash(blob)
+			break
+		}
+		header, err := utils.ImportSyncCheckpoint(checkpoint)
+		if err != nil {
+			log.Error("Failed to read sync checkpoint", "err", err)
+			return err
+		}
+		trusted = header.Hash()
+	default:
+		utils.Fatalf("A trusted block hash is required, as argument or with --%s.", utils.SyncCheckpointFlag.Name)
+	}
+	stack, _ := makeConfigNode(ctx)
+	defer stack.Close()
+
+	chain, db := utils.MakeChain(ctx, stack)
+	defer db.Close()
+	defer chain.Stop()
+
+	if err := utils.ImportState(chain, db, ctx.Args().First(), trusted); err != nil {
+		log.Error("Failed to import state", "err", err)
+		return err
+	}
+	return nil
+}
+
 func parseRoot(input string) (common.Hash, error) {
 	var h common.Hash
 	if err := h.UnmarshalText([]byte(input)); err != nil {
@@ -461,7 +580,7 @@ func dumpState(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	snaptree, err := snapshot.New(db, trie.NewDatabase(db), 256, root, false, false, false)
+	snaptree, err := snapshot.New(db, utils.MakeTrieDatabase(ctx, db, false, true), 256, root, false, false, false)
 	if err != nil {
 		return err
 	}
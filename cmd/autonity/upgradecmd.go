@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	protocol "github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/cmd/utils"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/eth"
+	"github.com/autonity/autonity/params"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+var (
+	upgradeTargetFlag = cli.StringFlag{
+		Name:  "target",
+		Usage: "Address of the protocol contract to upgrade",
+		Value: params.AutonityContractAddress.Hex(),
+	}
+	upgradeABIFlag = cli.StringFlag{
+		Name:  "abi",
+		Usage: "File of the JSON ABI of the new contract, as output by solc --abi",
+	}
+	upgradeOldLayoutFlag = cli.StringFlag{
+		Name:  "old-layout",
+		Usage: "File of the storage layout of the current contract, as output by solc --storage-layout",
+	}
+	upgradeNewLayoutFlag = cli.StringFlag{
+		Name:  "new-layout",
+		Usage: "File of the storage layout of the new contract, as output by solc --storage-layout",
+	}
+
+	upgradeCommand = cli.Command{
+		Name:     "upgrade",
+		Usage:    "Check protocol contract upgrades against a running node",
+		Category: "MISCELLANEOUS COMMANDS",
+		Subcommands: []cli.Command{
+			{
+				Name:      "simulate",
+				Usage:     "Simulate a protocol contract upgrade at the current head",
+				ArgsUsage: "<bytecode file>",
+				Action:    utils.MigrateFlags(simulateUpgrade),
+				Flags: []cli.Flag{
+					upgradeTargetFlag,
+					upgradeABIFlag,
+					upgradeOldLayoutFlag,
+					upgradeNewLayoutFlag,
+					nodeEndpointFlag,
+					utils.DataDirFlag,
+					utils.PiccadillyFlag,
+					utils.BakerlooFlag,
+				},
+				Description: `
+autonity upgrade simulate <bytecode file>
+replaces the bytecode of the --target protocol contract with the hex encoded
+one of the given file, as output by solc --bin, on a copy of the state at the
+head of the running node, then finalizes the next block. The committee, total
+supply, bonded stakes and operator are compared with the ones of the same
+finalization without the upgrade. The methods and events removed or changed
+by the --abi of the new contract, and the state variables moved by its
+--new-layout compared to the --old-layout, are reported along with them. The
+command fails if any issue is found, leaving the chain untouched.`,
+			},
+		},
+	}
+)
+
+// simulateUpgrade simulates a protocol contract upgrade through the running
+// node and prints the issues found.
+func simulateUpgrade(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		utils.Fatalf("Usage: autonity upgrade simulate [options] <bytecode file>")
+	}
+	target := ctx.String(upgradeTargetFlag.Name)
+	if !common.IsHexAddress(target) {
+		utils.Fatalf("Invalid target address %q", target)
+	}
+	bytecode, err := os.ReadFile(ctx.Args().First())
+	if err != nil {
+		utils.Fatalf("Failed to read bytecode: %v", err)
+	}
+	args := eth.UpgradeArgs{
+		Target:   common.HexToAddress(target),
+		Bytecode: common.FromHex(strings.TrimSpace(string(bytecode))),
+	}
+	if len(args.Bytecode) == 0 {
+		utils.Fatalf("Invalid or empty bytecode file %s", ctx.Args().First())
+	}
+	if file := ctx.String(upgradeABIFlag.Name); file != "" {
+		if args.ABI, err = os.ReadFile(file); err != nil {
+			utils.Fatalf("Failed to read ABI: %v", err)
+		}
+	}
+	args.OldStorageLayout = readStorageLayout(ctx.String(upgradeOldLayoutFlag.Name))
+	args.NewStorageLayout = readStorageLayout(ctx.String(upgradeNewLayoutFlag.Name))
+
+	client := dialNode(ctx)
+	defer client.Close()
+
+	var result protocol.UpgradeSimulation
+	if err := client.CallContext(context.Background(), &result, "aut_simulateUpgrade", args); err != nil {
+		utils.Fatalf("Failed to simulate the upgrade: %v", err)
+	}
+	fmt.Printf("Simulated the upgrade of %s finalizing block #%d\n", result.Target.Hex(), result.Block)
+	if result.Error != "" {
+		fmt.Printf("Error: %s\n", result.Error)
+	}
+	printUpgradeIssues("ABI issues", result.ABIIssues)
+	printUpgradeIssues("Storage layout issues", result.StorageIssues)
+	printUpgradeIssues("Broken invariants", result.BrokenInvariants)
+	if !result.Compatible() {
+		utils.Fatalf("The upgrade is not compatible")
+	}
+	fmt.Println("No issue found")
+	return nil
+}
+
+// readStorageLayout reads the storage layout file, if any.
+func readStorageLayout(file string) *protocol.StorageLayout {
+	if file == "" {
+		return nil
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		utils.Fatalf("Failed to read storage layout: %v", err)
+	}
+	layout := new(protocol.StorageLayout)
+	if err := json.Unmarshal(data, layout); err != nil {
+		utils.Fatalf("Invalid storage layout %s: %v", file, err)
+	}
+	return layout
+}
+
+func printUpgradeIssues(title string, issues []string) {
+	if len(issues) == 0 {
+		return
+	}
+	fmt.Printf("%s:\n", title)
+	for _, issue := range issues {
+		fmt.Printf("  - %s\n", issue)
+	}
+}
@@ -38,17 +38,22 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.InitGenesisFlag,
 			utils.DataDirFlag,
 			utils.AncientFlag,
+			utils.DBEngineFlag,
 			utils.MinFreeDiskSpaceFlag,
 			utils.KeyStoreDirFlag,
 			utils.USBFlag,
 			utils.SmartCardDaemonPathFlag,
 			utils.NetworkIdFlag,
 			utils.SyncModeFlag,
+			utils.SyncCheckpointFlag,
 			utils.ExitWhenSyncedFlag,
 			utils.GCModeFlag,
+			utils.StateSchemeFlag,
+			utils.StateHistoryFlag,
 			utils.PiccadillyFlag,
 			utils.BakerlooFlag,
 			utils.TxLookupLimitFlag,
+			utils.HistoryEpochsFlag,
 			utils.EthStatsURLFlag,
 			utils.IdentityFlag,
 			utils.LightKDFFlag,
@@ -142,6 +147,12 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.OracleKeyHexFlag,
 			utils.ConsensusListenPortFlag,
 			utils.ConsensusNATFlag,
+			utils.ConsensusTopologyFlag,
+			utils.ConsensusTopologyDiameterFlag,
+			utils.ConsensusTopologyMaxExtraFlag,
+			utils.ConsensusBlockPartsFlag,
+			utils.ConsensusSentriesFlag,
+			utils.ConsensusSentryValidatorFlag,
 		},
 	},
 	{
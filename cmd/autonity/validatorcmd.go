@@ -0,0 +1,389 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/autonity/autonity/accounts"
+	"github.com/autonity/autonity/accounts/abi/bind"
+	"github.com/autonity/autonity/accounts/external"
+	"github.com/autonity/autonity/accounts/keystore"
+	protocol "github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/cmd/utils"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/common/math"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/ethclient"
+	"github.com/autonity/autonity/p2p/enode"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/rpc"
+	cli "gopkg.in/urfave/cli.v1"
+)
+
+// commissionRatePrecision is the commission rate of the Autonity contract
+// standing for 100%.
+const commissionRatePrecision = 10_000
+
+// validatorStates are the names of the validator states of the Autonity
+// contract, indexed by their value.
+var validatorStates = []string{"active", "paused", "jailed", "jailbound"}
+
+var (
+	validatorFromFlag = cli.StringFlag{
+		Name:  "from",
+		Usage: "Address of the account sending the transactions, the treasury of the validator",
+	}
+
+	// validatorFlags are the flags shared by the validator subcommands to reach
+	// the running node and sign the transactions.
+	validatorFlags = []cli.Flag{
+		nodeEndpointFlag,
+		validatorFromFlag,
+		configFileFlag,
+		utils.DataDirFlag,
+		utils.KeyStoreDirFlag,
+		utils.PasswordFileFlag,
+		utils.ExternalSignerFlag,
+		utils.PiccadillyFlag,
+		utils.BakerlooFlag,
+	}
+
+	validatorCommand = cli.Command{
+		Name:     "validator",
+		Usage:    "Manage a validator through a running node",
+		Category: "VALIDATOR COMMANDS",
+		Description: `
+The validator commands send the transactions of the validator lifecycle to the
+Autonity contract through a running node, reached over IPC or HTTP/WS with
+--endpoint. The transactions are sent from the --from account, which is the
+treasury of the validator. They are signed either with the keystore, unlocked
+with the password given by --password or prompted for, or with the external
+signer given by --signer, such as clef.`,
+		Subcommands: []cli.Command{
+			{
+				Name:      "register",
+				Usage:     "Register the node as a validator",
+				ArgsUsage: "[<enode>]",
+				Action:    utils.MigrateFlags(registerValidator),
+				Flags: append([]cli.Flag{
+					utils.AutonityKeysFileFlag,
+					utils.AutonityKeysHexFlag,
+					utils.OracleKeyFileFlag,
+					utils.OracleKeyHexFlag,
+				}, validatorFlags...),
+				Description: `
+autonity validator register [<enode>]
+registers the node as a validator whose treasury is the --from account. The
+ownership proof of the node key, consensus key and oracle key is generated from
+the keys given with --autonitykeys or --autonitykeyshex and --oraclekey or
+--oraclekeyhex. If the consensus key is missing from the node key file, a new
+one is generated and appended to the file, the node must then be restarted
+with it. The enode URL defaults to the one of the running node, and must match
+the node key.`,
+			},
+			{
+				Name:      "bond",
+				Usage:     "Bond stake to a validator",
+				ArgsUsage: "<validator> <amount>",
+				Action:    utils.MigrateFlags(bondValidator),
+				Flags:     validatorFlags,
+				Description: `
+autonity validator bond <validator> <amount>
+bonds the given amount of Newton, in its smallest unit, from the --from account
+to the validator of the given node address.`,
+			},
+			{
+				Name:      "unbond",
+				Usage:     "Unbond stake from a validator",
+				ArgsUsage: "<validator> <amount>",
+				Action:    utils.MigrateFlags(unbondValidator),
+				Flags:     validatorFlags,
+				Description: `
+autonity validator unbond <validator> <amount>
+unbonds the given amount of Liquid Newton, in its smallest unit, of the --from
+account from the validator of the given node address.`,
+			},
+			{
+				Name:      "pause",
+				Usage:     "Pause a validator",
+				ArgsUsage: "<validator>",
+				Action:    utils.MigrateFlags(pauseValidator),
+				Flags:     validatorFlags,
+				Description: `
+autonity validator pause <validator>
+pauses the validator of the given node address, which is no longer selected in
+the committee. The --from account must be the treasury of the validator.`,
+			},
+			{
+				Name:      "activate",
+				Usage:     "Activate a paused validator",
+				ArgsUsage: "<validator>",
+				Action:    utils.MigrateFlags(activateValidator),
+				Flags:     validatorFlags,
+				Description: `
+autonity validator activate <validator>
+activates the paused validator of the given node address. The --from account
+must be the treasury of the validator.`,
+			},
+			{
+				Name:      "set-commission",
+				Usage:     "Change the commission rate of a validator",
+				ArgsUsage: "<validator> <rate>",
+				Action:    utils.MigrateFlags(setValidatorCommission),
+				Flags:     validatorFlags,
+				Description: `
+autonity validator set-commission <validator> <rate>
+changes the commission rate of the validator of the given node address, in
+basis points: 10000 stands for 100%. The new rate applies after the unbonding
+period. The --from account must be the treasury of the validator.`,
+			},
+			{
+				Name:      "status",
+				Usage:     "Show the state of a validator",
+				ArgsUsage: "<validator>",
+				Action:    utils.MigrateFlags(validatorStatus),
+				Flags: []cli.Flag{
+					nodeEndpointFlag,
+					utils.DataDirFlag,
+					utils.PiccadillyFlag,
+					utils.BakerlooFlag,
+				},
+				Description: `
+autonity validator status <validator>
+shows the state, stake and commission rate of the validator of the given node
+address.`,
+			},
+		},
+	}
+)
+
+// dialValidatorNode connects to the running node given on the command line.
+func dialValidatorNode(ctx *cli.Context) (*rpc.Client, *ethclient.Client, *protocol.Autonity) {
+	rpcClient := dialNode(ctx)
+	client := ethclient.NewClient(rpcClient)
+	contract, err := protocol.NewAutonity(params.AutonityContractAddress, client)
+	if err != nil {
+		utils.Fatalf("Failed to bind the Autonity contract: %v", err)
+	}
+	return rpcClient, client, contract
+}
+
+// newValidatorTransactor returns the signer of the transactions sent from the
+// --from account, backed either by the external signer or by the keystore.
+func newValidatorTransactor(ctx *cli.Context, client *ethclient.Client) *bind.TransactOpts {
+	from := ctx.String(validatorFromFlag.Name)
+	if !common.IsHexAddress(from) {
+		utils.Fatalf("Invalid or missing --%s account address", validatorFromFlag.Name)
+	}
+	if signer := ctx.GlobalString(utils.ExternalSignerFlag.Name); signer != "" {
+		clef, err := external.NewExternalSigner(signer)
+		if err != nil {
+			utils.Fatalf("Failed to create the external signer: %v", err)
+		}
+		return bind.NewClefTransactor(clef, accounts.Account{Address: common.HexToAddress(from)})
+	}
+	cfg := autonityConfig{Node: defaultNodeConfig()}
+	if file := ctx.GlobalString(configFileFlag.Name); file != "" {
+		if err := loadConfig(file, &cfg); err != nil {
+			utils.Fatalf("%v", err)
+		}
+	}
+	utils.SetNodeConfig(ctx, &cfg.Node)
+	keydir, err := cfg.Node.KeyDirConfig()
+	if err != nil {
+		utils.Fatalf("Failed to read configuration: %v", err)
+	}
+	ks := keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP)
+	account, _ := unlockAccount(ks, from, 0, utils.MakePasswordList(ctx))
+
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		utils.Fatalf("Failed to retrieve the chain ID: %v", err)
+	}
+	opts, err := bind.NewKeyStoreTransactorWithChainID(ks, account, chainID)
+	if err != nil {
+		utils.Fatalf("Failed to create the keystore signer: %v", err)
+	}
+	return opts
+}
+
+// sendValidatorTransaction signs and sends a transaction to the Autonity
+// contract, then waits for it to be included in a block.
+func sendValidatorTransaction(ctx *cli.Context, send func(*protocol.Autonity, *bind.TransactOpts) (*types.Transaction, error)) {
+	_, client, contract := dialValidatorNode(ctx)
+	defer client.Close()
+
+	tx, err := send(contract, newValidatorTransactor(ctx, client))
+	receipt := waitValidatorTransaction(client, tx, err)
+	fmt.Printf("Transaction %s included in block #%d\n", tx.Hash().Hex(), receipt.BlockNumber)
+}
+
+// waitValidatorTransaction waits for a sent transaction to be included in a
+// block, failing if it could not be sent or reverted.
+func waitValidatorTransaction(client *ethclient.Client, tx *types.Transaction, err error) *types.Receipt {
+	if err != nil {
+		utils.Fatalf("Failed to send transaction: %v", err)
+	}
+	fmt.Printf("Sent transaction %s, waiting for it to be mined\n", tx.Hash().Hex())
+
+	receipt, err := bind.WaitMined(context.Background(), client, tx)
+	if err != nil {
+		utils.Fatalf("Failed to wait for transaction: %v", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		utils.Fatalf("Transaction %s failed in block #%d", tx.Hash().Hex(), receipt.BlockNumber)
+	}
+	return receipt
+}
+
+// validatorArgs parses the node address of the validator and the optional
+// integer following it on the command line.
+func validatorArgs(ctx *cli.Context, usage string, withValue bool) (common.Address, *big.Int) {
+	args := ctx.Args()
+	if (withValue && len(args) != 2) || (!withValue && len(args) != 1) {
+		utils.Fatalf("Usage: autonity validator %s", usage)
+	}
+	if !common.IsHexAddress(args[0]) {
+		utils.Fatalf("Invalid validator address %q", args[0])
+	}
+	validator := common.HexToAddress(args[0])
+	if !withValue {
+		return validator, nil
+	}
+	value, ok := math.ParseBig256(args[1])
+	if !ok {
+		utils.Fatalf("Invalid amount %q", args[1])
+	}
+	return validator, value
+}
+
+// checkValidatorEnode ensures the enode URL to register is the one of the node
+// key.
+func checkValidatorEnode(enodeURL string, nodeKey *ecdsa.PrivateKey) {
+	node, err := enode.ParseV4(enodeURL)
+	if err != nil {
+		utils.Fatalf("Invalid enode %q: %v", enodeURL, err)
+	}
+	if crypto.PubkeyToAddress(*node.Pubkey()) != crypto.PubkeyToAddress(nodeKey.PublicKey) {
+		utils.Fatalf("Enode %s doesn't match the node key", enodeURL)
+	}
+}
+
+// registerValidator registers the node as a validator, generating the ownership
+// proof of its keys on the fly.
+func registerValidator(ctx *cli.Context) error {
+	if len(ctx.Args()) > 1 {
+		utils.Fatalf("Usage: autonity validator register [options] [<enode>]")
+	}
+	nodeKey, oracleKey, consensusKey := loadOwnershipKeys(ctx)
+	enodeURL := ctx.Args().First()
+	if enodeURL != "" {
+		checkValidatorEnode(enodeURL, nodeKey)
+	}
+	rpcClient, client, contract := dialValidatorNode(ctx)
+	defer client.Close()
+
+	if enodeURL == "" {
+		var info struct {
+			Enode string `json:"enode"`
+		}
+		if err := rpcClient.Call(&info, "admin_nodeInfo"); err != nil {
+			utils.Fatalf("Failed to retrieve the enode of the running node, pass it explicitly: %v", err)
+		}
+		enodeURL = info.Enode
+		checkValidatorEnode(enodeURL, nodeKey)
+	}
+	opts := newValidatorTransactor(ctx, client)
+
+	pop, err := crypto.AutonityPOPProof(nodeKey, oracleKey, opts.From.Hex(), consensusKey)
+	if err != nil {
+		utils.Fatalf("Failed to generate Autonity POP: %v", err)
+	}
+	oracle := crypto.PubkeyToAddress(oracleKey.PublicKey)
+	tx, err := contract.RegisterValidator(opts, enodeURL, oracle, consensusKey.PublicKey().Marshal(), pop)
+	receipt := waitValidatorTransaction(client, tx, err)
+	fmt.Printf("Registered validator %s in block #%d\n", crypto.PubkeyToAddress(nodeKey.PublicKey).Hex(), receipt.BlockNumber)
+	return nil
+}
+
+func bondValidator(ctx *cli.Context) error {
+	validator, amount := validatorArgs(ctx, "bond [options] <validator> <amount>", true)
+	sendValidatorTransaction(ctx, func(contract *protocol.Autonity, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.Bond(opts, validator, amount)
+	})
+	return nil
+}
+
+func unbondValidator(ctx *cli.Context) error {
+	validator, amount := validatorArgs(ctx, "unbond [options] <validator> <amount>", true)
+	sendValidatorTransaction(ctx, func(contract *protocol.Autonity, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.Unbond(opts, validator, amount)
+	})
+	return nil
+}
+
+func pauseValidator(ctx *cli.Context) error {
+	validator, _ := validatorArgs(ctx, "pause [options] <validator>", false)
+	sendValidatorTransaction(ctx, func(contract *protocol.Autonity, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.PauseValidator(opts, validator)
+	})
+	return nil
+}
+
+func activateValidator(ctx *cli.Context) error {
+	validator, _ := validatorArgs(ctx, "activate [options] <validator>", false)
+	sendValidatorTransaction(ctx, func(contract *protocol.Autonity, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.ActivateValidator(opts, validator)
+	})
+	return nil
+}
+
+func setValidatorCommission(ctx *cli.Context) error {
+	validator, rate := validatorArgs(ctx, "set-commission [options] <validator> <rate>", true)
+	if rate.Cmp(big.NewInt(commissionRatePrecision)) > 0 {
+		utils.Fatalf("Commission rate %v exceeds %d basis points", rate, commissionRatePrecision)
+	}
+	sendValidatorTransaction(ctx, func(contract *protocol.Autonity, opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.ChangeCommissionRate(opts, validator, rate)
+	})
+	return nil
+}
+
+// validatorStatus prints the state of a validator as recorded by the Autonity
+// contract at the head of the running node.
+func validatorStatus(ctx *cli.Context) error {
+	validator, _ := validatorArgs(ctx, "status [options] <validator>", false)
+
+	_, client, contract := dialValidatorNode(ctx)
+	defer client.Close()
+
+	info, err := contract.GetValidator(nil, validator)
+	if err != nil {
+		utils.Fatalf("Failed to retrieve validator %s: %v", validator.Hex(), err)
+	}
+	state := fmt.Sprintf("unknown (%d)", info.State)
+	if int(info.State) < len(validatorStates) {
+		state = validatorStates[info.State]
+	}
+	fmt.Printf("Validator:            %s\n", info.NodeAddress.Hex())
+	fmt.Printf("State:                %s\n", state)
+	fmt.Printf("Treasury:             %s\n", info.Treasury.Hex())
+	fmt.Printf("Oracle:               %s\n", info.OracleAddress.Hex())
+	fmt.Printf("Enode:                %s\n", info.Enode)
+	fmt.Printf("Consensus key:        %s\n", hexutil.Encode(info.ConsensusKey))
+	fmt.Printf("Liquid contract:      %s\n", info.LiquidContract.Hex())
+	fmt.Printf("Commission rate:      %v/%d\n", info.CommissionRate, commissionRatePrecision)
+	fmt.Printf("Bonded stake:         %v\n", info.BondedStake)
+	fmt.Printf("Self bonded stake:    %v\n", info.SelfBondedStake)
+	fmt.Printf("Unbonding stake:      %v\n", info.UnbondingStake)
+	fmt.Printf("Liquid supply:        %v\n", info.LiquidSupply)
+	fmt.Printf("Total slashed:        %v\n", info.TotalSlashed)
+	fmt.Printf("Provable faults:      %v\n", info.ProvableFaultCount)
+	fmt.Printf("Jail release block:   %v\n", info.JailReleaseBlock)
+	fmt.Printf("Registration block:   %v\n", info.RegistrationBlock)
+	return nil
+}
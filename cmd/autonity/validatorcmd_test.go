@@ -0,0 +1,232 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/accounts/keystore"
+	protocol "github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/crypto/blst"
+	"github.com/autonity/autonity/ethclient"
+	"github.com/autonity/autonity/p2p/enode"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/rpc"
+)
+
+func TestValidatorCommandArgs(t *testing.T) {
+	const (
+		validator = "0x850c1eb8d190e05845ad7f84ac95a318c8aab07f"
+		nodeKey   = "f1ab65d8d07ab6a7a2ab8419fa5bbaf8938f45556387d43f3f15967bc599a5793cca398a63081b656790184794b9997073620e5d862750fd61b6e7fec3399ce3"
+		oracleKey = "198227888008a50b57bfb4d70ef5c4a3ef085538b148842fe3628b9005d66301"
+		// enode of another node key
+		otherEnode = "enode://a979fb575495b8d6db44f750317d0f4622bf4c2aa3365d6af7c284339968eef29b69ad0dce72a4d8db5ebb4968de0e3bec910127f134779fbcb0cb6d3331163c@127.0.0.1:30303"
+	)
+	tests := []struct {
+		name   string
+		args   []string
+		output string
+	}{
+		{
+			name:   "missing amount",
+			args:   []string{"bond", validator},
+			output: "Fatal: Usage: autonity validator bond [options] <validator> <amount>\n",
+		},
+		{
+			name:   "invalid validator",
+			args:   []string{"pause", "0x850c"},
+			output: "Fatal: Invalid validator address \"0x850c\"\n",
+		},
+		{
+			name:   "invalid amount",
+			args:   []string{"unbond", validator, "1.5"},
+			output: "Fatal: Invalid amount \"1.5\"\n",
+		},
+		{
+			name:   "commission rate above 100%",
+			args:   []string{"set-commission", validator, "10001"},
+			output: "Fatal: Commission rate 10001 exceeds 10000 basis points\n",
+		},
+		{
+			name:   "enode of another node",
+			args:   []string{"register", "--autonitykeyshex", nodeKey, "--oraclekeyhex", oracleKey, otherEnode},
+			output: "Fatal: Enode " + otherEnode + " doesn't match the node key\n",
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			autonity := runAutonity(t, append([]string{"validator"}, test.args...)...)
+			defer autonity.ExpectExit()
+			autonity.Expect(test.output)
+		})
+	}
+}
+
+// validatorGenesis is the genesis of a network whose single validator, of the
+// given keys, has its treasury funded with Newton.
+const validatorGenesis = `{
+	"alloc": {
+		"%[1]s": {"balance": "0x3635c9adc5dea00000", "newtonBalance": "1000000"}
+	},
+	"difficulty": "0x0",
+	"gasLimit": "0x1fffffffffffff",
+	"mixhash": "0x63746963616c2062797a616e74696e65206661756c7420746f6c6572616e6365",
+	"config": {
+		"chainId": 65111111,
+		"autonity": {
+			"minBaseFee": 5000,
+			"blockPeriod": 1,
+			"treasury": "0xCd7231d14b391e1E4b1e6A5F6a6062969088aF8D",
+			"treasuryFee": 150000000,
+			"unbondingPeriod": 120,
+			"delegationRate": 1000,
+			"maxCommitteeSize": 7,
+			"epochPeriod": 30,
+			"validators": [
+				{
+					"enode": "%[2]s",
+					"treasury": "%[1]s",
+					"consensusKey": "%[3]s",
+					"oracleAddress": "0x342dbBAF7e5E41078Cb83905793CC28D3B7f5AD9",
+					"bondedStake": 10000
+				}
+			]
+		},
+		"accountability": {
+			"innocenceProofSubmissionWindow": 30,
+			"baseSlashingRateLow": 500,
+			"baseSlashingRateMid": 1000,
+			"collusionFactor": 550,
+			"historyFactor": 750,
+			"jailFactor": 60,
+			"slashingRatePrecision": 10000
+		},
+		"oracle": {
+			"votePeriod": 30
+		}
+	}
+}`
+
+// runValidatorCommand runs a validator subcommand to completion and returns its
+// output.
+func runValidatorCommand(t *testing.T, args ...string) string {
+	autonity := runAutonity(t, append([]string{"validator"}, args...)...)
+	output := autonity.Output()
+	autonity.WaitExit()
+	if autonity.Cleanup != nil {
+		autonity.Cleanup()
+	}
+	if autonity.ExitStatus() != 0 {
+		t.Fatalf("validator %s failed:\n%s%s", args[0], output, autonity.StderrText())
+	}
+	return string(output)
+}
+
+// Tests the validator lifecycle driven by the validator commands through the
+// IPC endpoint of a running single-validator node.
+func TestValidatorCommands(t *testing.T) {
+	nodeKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	consensusKey, err := blst.RandKey()
+	require.NoError(t, err)
+	treasuryKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	treasury := crypto.PubkeyToAddress(treasuryKey.PublicKey)
+
+	datadir := tmpdir(t)
+	defer os.RemoveAll(datadir)
+	require.NoError(t, os.MkdirAll(filepath.Join(datadir, "autonity"), 0700))
+	require.NoError(t, crypto.SaveAutonityKeys(filepath.Join(datadir, "autonity", "autonitykeys"), nodeKey, consensusKey))
+	ks := keystore.NewKeyStore(filepath.Join(datadir, "keystore"), keystore.LightScryptN, keystore.LightScryptP)
+	_, err = ks.ImportECDSA(treasuryKey, "foo")
+	require.NoError(t, err)
+	password := filepath.Join(datadir, "password")
+	require.NoError(t, os.WriteFile(password, []byte("foo\n"), 0600))
+
+	nodeEnode := enode.NewV4(&nodeKey.PublicKey, net.ParseIP("127.0.0.1"), 30303, 30303).URLv4()
+	genesisFile := filepath.Join(datadir, "genesis.json")
+	genesisJSON := fmt.Sprintf(validatorGenesis, treasury.Hex(), nodeEnode, hexutil.Encode(consensusKey.PublicKey().Marshal()))
+	require.NoError(t, os.WriteFile(genesisFile, []byte(genesisJSON), 0600))
+
+	ipc := filepath.Join(datadir, "autonity.ipc")
+	node := runMinimalAutonity(t, "--datadir", datadir, "--genesis", genesisFile, "--ipcpath", ipc, "--consensus.port", "0", "--consensus.nat", "none", "--mine")
+	defer func() {
+		node.Interrupt()
+		node.ExpectExit()
+	}()
+	waitForEndpoint(t, ipc, 10*time.Second)
+
+	rpcClient, err := rpc.Dial(ipc)
+	require.NoError(t, err)
+	client := ethclient.NewClient(rpcClient)
+	defer client.Close()
+	contract, err := protocol.NewAutonity(params.AutonityContractAddress, client)
+	require.NoError(t, err)
+	validator := crypto.PubkeyToAddress(nodeKey.PublicKey)
+	status := func(address common.Address) string {
+		return runValidatorCommand(t, "status", "--endpoint", ipc, address.Hex())
+	}
+	// the transactions are signed with the keystore of the datadir
+	send := func(command string, args ...string) string {
+		flags := []string{command, "--endpoint", ipc, "--datadir", datadir, "--password", password, "--from", treasury.Hex()}
+		return runValidatorCommand(t, append(flags, args...)...)
+	}
+
+	output := status(validator)
+	require.Contains(t, output, "State:                active\n")
+	require.Contains(t, output, "Treasury:             "+treasury.Hex()+"\n")
+	require.Contains(t, output, "Self bonded stake:    10000\n")
+
+	// the bonded Newton leaves the account as soon as the bonding is requested
+	require.Regexp(t, `Transaction 0x[0-9a-f]{64} included in block #\d+\n`, send("bond", validator.Hex(), "100"))
+	balance, err := contract.BalanceOf(nil, treasury)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1_000_000-100), balance)
+
+	// the self-bonded stake being unbonded is locked until the unbonding is applied
+	send("unbond", validator.Hex(), "10")
+	info, err := contract.GetValidator(nil, validator)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10), info.SelfUnbondingStakeLocked)
+
+	send("set-commission", validator.Hex(), "500")
+	send("pause", validator.Hex())
+	require.Contains(t, status(validator), "State:                paused\n")
+	send("activate", validator.Hex())
+	require.Contains(t, status(validator), "State:                active\n")
+
+	// a legacy node key file gets a consensus key appended on registration,
+	// whose ownership is proven along with the node and oracle keys
+	newNodeKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	newNodeKeyFile := filepath.Join(datadir, "newnodekey")
+	require.NoError(t, crypto.SaveECDSA(newNodeKeyFile, newNodeKey))
+	oracleKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	newValidator := crypto.PubkeyToAddress(newNodeKey.PublicKey)
+	newEnode := enode.NewV4(&newNodeKey.PublicKey, net.ParseIP("127.0.0.1"), 30304, 30304).URLv4()
+	output = send("register", "--autonitykeys", newNodeKeyFile, "--oraclekeyhex", hex.EncodeToString(crypto.FromECDSA(oracleKey)), newEnode)
+	require.Regexp(t, `Registered validator `+newValidator.Hex()+` in block #\d+\n`, output)
+
+	savedNodeKey, newConsensusKey, err := crypto.LoadAutonityKeys(newNodeKeyFile)
+	require.NoError(t, err)
+	require.Equal(t, newNodeKey.D, savedNodeKey.D)
+	info, err = contract.GetValidator(nil, newValidator)
+	require.NoError(t, err)
+	require.Equal(t, newValidator, info.NodeAddress)
+	require.Equal(t, treasury, info.Treasury)
+	require.Equal(t, crypto.PubkeyToAddress(oracleKey.PublicKey), info.OracleAddress)
+	require.Equal(t, newConsensusKey.PublicKey().Marshal(), info.ConsensusKey)
+}
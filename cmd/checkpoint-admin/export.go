@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/autonity/autonity/cmd/utils"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/ethclient"
+	"github.com/autonity/autonity/rlp"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var commandExport = cli.Command{
+	Name:  "export",
+	Usage: "Exports a committed header of the remote node into a sync checkpoint file",
+	Flags: []cli.Flag{
+		nodeURLFlag,
+		numberFlag,
+		outputFlag,
+	},
+	Action: utils.MigrateFlags(export),
+}
+
+// export retrieves a committed header along with its committee from the remote
+// node and writes it into a checkpoint file to snap sync new nodes from.
+func export(ctx *cli.Context) error {
+	client := newRPCClient(ctx.GlobalString(nodeURLFlag.Name))
+
+	var number uint64
+	if ctx.GlobalIsSet(numberFlag.Name) {
+		number = ctx.GlobalUint64(numberFlag.Name)
+	} else {
+		head, err := ethclient.NewClient(client).BlockNumber(context.Background())
+		if err != nil {
+			utils.Fatalf("Failed to retrieve the head block number: %v", err)
+		}
+		number = head
+	}
+	// The header is retrieved in its RLP encoding, its JSON form omitting some
+	// of the committee fields it is hashed over
+	var blob hexutil.Bytes
+	if err := client.Call(&blob, "debug_getHeaderRlp", number); err != nil {
+		utils.Fatalf("Failed to retrieve header #%d, please ensure the debug API is exposed: %v", number, err)
+	}
+	header := new(types.Header)
+	if err := rlp.DecodeBytes(blob, header); err != nil {
+		utils.Fatalf("Invalid header #%d: %v", number, err)
+	}
+	if len(header.Committee) == 0 || len(header.CommittedSeals) == 0 {
+		utils.Fatalf("Header #%d is not a committed header", number)
+	}
+	if err := utils.ExportSyncCheckpoint(header, ctx.GlobalString(outputFlag.Name)); err != nil {
+		utils.Fatalf("Failed to write sync checkpoint: %v", err)
+	}
+	fmt.Printf("Checkpoint #%d => %s\n", number, header.Hash().Hex())
+	fmt.Printf("Written to %s\n", ctx.GlobalString(outputFlag.Name))
+	return nil
+}
@@ -43,6 +43,7 @@ func init() {
 		commandDeploy,
 		commandSign,
 		commandPublish,
+		commandExport,
 	}
 	app.Flags = []cli.Flag{
 		oracleFlag,
@@ -61,6 +62,15 @@ var (
 		Name:  "hash",
 		Usage: "Checkpoint hash (query latest from remote node if not specified)",
 	}
+	numberFlag = cli.Uint64Flag{
+		Name:  "number",
+		Usage: "Number of the committed header to export (latest if not specified)",
+	}
+	outputFlag = cli.StringFlag{
+		Name:  "output",
+		Value: "checkpoint.json",
+		Usage: "Path of the sync checkpoint file to write",
+	}
 	oracleFlag = cli.StringFlag{
 		Name:  "oracle",
 		Usage: "Checkpoint oracle address (query from remote node if not specified)",
@@ -19,18 +19,25 @@ package utils
 
 import (
 	"bufio"
+	"bytes"
 	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"syscall"
 	"time"
 
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
 	"github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/types"
@@ -38,6 +45,7 @@ import (
 	"github.com/autonity/autonity/eth/ethconfig"
 	"github.com/autonity/autonity/ethdb"
 	"github.com/autonity/autonity/internal/debug"
+	"github.com/autonity/autonity/internal/era"
 	"github.com/autonity/autonity/log"
 	"github.com/autonity/autonity/node"
 	"github.com/autonity/autonity/rlp"
@@ -287,6 +295,268 @@ func ExportAppendChain(blockchain *core.BlockChain, fn string, first uint64, las
 	return nil
 }
 
+// ExportHistory exports the blocks in the given range, along with their receipts
+// and total difficulties, into era files in the specified directory. The file of
+// epoch n holds the blocks n*step to (n+1)*step-1 within the range. A checksums file listing the sha256 digest of every era file is
+// written alongside them.
+func ExportHistory(bc *core.BlockChain, dir string, first, last, step uint64) error {
+	log.Info("Exporting blockchain history", "dir", dir, "first", first, "last", last)
+	if head := bc.CurrentBlock().NumberU64(); last > head {
+		return fmt.Errorf("export range beyond the chain head: #%d > #%d", last, head)
+	}
+	if step == 0 || step > era.MaxEraBatchSize {
+		return fmt.Errorf("invalid era size %d", step)
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	var (
+		network   = bc.Config().ChainID.String()
+		checksums []string
+		start     = time.Now()
+		reported  = time.Now()
+	)
+	// The file boundaries are aligned to the era size, the first file being
+	// shortened if the range doesn't start at a boundary
+	for from := first; from <= last; from = (from/step + 1) * step {
+		to := (from/step+1)*step - 1
+		if to > last {
+			to = last
+		}
+		name, sum, err := exportEra(bc, dir, network, int(from/step), from, to)
+		if err != nil {
+			return err
+		}
+		checksums = append(checksums, fmt.Sprintf("%x %s", sum, name))
+		if time.Since(reported) >= 8*time.Second {
+			log.Info("Exporting blocks", "exported", to-first+1, "elapsed", common.PrettyDuration(time.Since(start)))
+			reported = time.Now()
+		}
+	}
+	if err := os.WriteFile(filepath.Join(dir, historyChecksumsFile), []byte(strings.Join(checksums, "\n")+"\n"), 0644); err != nil {
+		return err
+	}
+	log.Info("Exported blockchain history", "dir", dir, "files", len(checksums), "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// historyChecksumsFile is the file in the era directory holding the sha256
+// digests of the era files.
+const historyChecksumsFile = "checksums.txt"
+
+// exportEra writes the blocks in the given range into a single era file,
+// returning its name and sha256 digest.
+func exportEra(bc *core.BlockChain, dir, network string, epoch int, from, to uint64) (string, []byte, error) {
+	f, err := os.CreateTemp(dir, ".era-*")
+	if err != nil {
+		return "", nil, err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	var (
+		hasher = sha256.New()
+		buf    = bufio.NewWriter(io.MultiWriter(f, hasher))
+		b      = era.NewBuilder(buf)
+	)
+	for n := from; n <= to; n++ {
+		if err := bc.CheckHistory(n); err != nil {
+			return "", nil, err
+		}
+		block := bc.GetBlockByNumber(n)
+		if block == nil {
+			return "", nil, fmt.Errorf("export failed on #%d: not found", n)
+		}
+		td := bc.GetTd(block.Hash(), n)
+		if td == nil {
+			return "", nil, fmt.Errorf("export failed on #%d: total difficulty not found", n)
+		}
+		if err := b.Add(block, bc.GetReceiptsByHash(block.Hash()), td); err != nil {
+			return "", nil, fmt.Errorf("export failed on #%d: %v", n, err)
+		}
+	}
+	root, err := b.Finalize()
+	if err != nil {
+		return "", nil, err
+	}
+	if err := buf.Flush(); err != nil {
+		return "", nil, err
+	}
+	if err := f.Close(); err != nil {
+		return "", nil, err
+	}
+	name := era.Filename(network, epoch, root)
+	if err := os.Rename(f.Name(), filepath.Join(dir, name)); err != nil {
+		return "", nil, err
+	}
+	return name, hasher.Sum(nil), nil
+}
+
+// ImportHistory imports the era files of the given network found in the
+// specified directory. Every file is checked against its checksum and its
+// accumulator, and the committed seals of every BFT header are verified against
+// the committee of its parent before the blocks are inserted. The receipts and
+// total difficulties produced by the import must match the archived ones.
+func ImportHistory(chain *core.BlockChain, db ethdb.Database, dir string, network string) error {
+	files, err := era.ReadDir(dir, network)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no era files of network %s found in %s", network, dir)
+	}
+	checksums, err := readHistoryChecksums(filepath.Join(dir, historyChecksumsFile))
+	if err != nil {
+		return err
+	}
+	var (
+		start    = time.Now()
+		reported = time.Now()
+		imported uint64
+	)
+	for _, name := range files {
+		want, ok := checksums[name]
+		if !ok {
+			return fmt.Errorf("no checksum for era file %s", name)
+		}
+		path := filepath.Join(dir, name)
+		if have, err := fileChecksum(path); err != nil {
+			return err
+		} else if have != want {
+			return fmt.Errorf("checksum mismatch for era file %s: have %s, want %s", name, have, want)
+		}
+		n, err := importEra(chain, db, path)
+		if err != nil {
+			return fmt.Errorf("era file %s: %v", name, err)
+		}
+		imported += n
+		if time.Since(reported) >= 8*time.Second {
+			log.Info("Importing era files", "head", chain.CurrentBlock().NumberU64(), "imported", imported, "elapsed", common.PrettyDuration(time.Since(start)))
+			reported = time.Now()
+		}
+	}
+	log.Info("Imported blockchain history", "dir", dir, "files", len(files), "blocks", imported, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// importEra verifies and inserts the blocks of a single era file, returning the
+// number of blocks inserted.
+func importEra(chain *core.BlockChain, db ethdb.Database, path string) (uint64, error) {
+	e, err := era.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer e.Close()
+
+	var (
+		blocks = make([]*types.Block, 0, e.Count())
+		hashes = make([]common.Hash, 0, e.Count())
+		tds    = make([]*big.Int, 0, e.Count())
+		parent *types.Header
+	)
+	for n := e.Start(); n < e.Start()+e.Count(); n++ {
+		block, err := e.GetBlockByNumber(n)
+		if err != nil {
+			return 0, err
+		}
+		td, err := e.GetTotalDifficultyByNumber(n)
+		if err != nil {
+			return 0, err
+		}
+		header := block.Header()
+		if header.Number.Uint64() != n {
+			return 0, fmt.Errorf("block #%d indexed at #%d", header.Number.Uint64(), n)
+		}
+		if n == 0 {
+			if genesis := chain.Genesis(); block.Hash() != genesis.Hash() {
+				return 0, fmt.Errorf("genesis mismatch: have %x, want %x", block.Hash(), genesis.Hash())
+			}
+		} else {
+			if parent == nil {
+				if parent = chain.GetHeader(header.ParentHash, n-1); parent == nil {
+					return 0, fmt.Errorf("unknown parent of block #%d [%x]", n, header.ParentHash)
+				}
+			} else if header.ParentHash != parent.Hash() {
+				return 0, fmt.Errorf("block #%d not linked to its parent: have %x, want %x", n, header.ParentHash, parent.Hash())
+			}
+			if header.MixDigest == types.BFTDigest {
+				if err := finality.VerifySeals(header, parent.Committee); err != nil {
+					return 0, fmt.Errorf("invalid committed seals of block #%d: %v", n, err)
+				}
+			}
+			blocks = append(blocks, block)
+		}
+		hashes, tds = append(hashes, block.Hash()), append(tds, td)
+		parent = header
+	}
+	have, err := era.ComputeAccumulator(hashes, tds)
+	if err != nil {
+		return 0, err
+	}
+	if want, err := e.Accumulator(); err != nil {
+		return 0, err
+	} else if have != want {
+		return 0, fmt.Errorf("accumulator mismatch: have %x, want %x", have, want)
+	}
+	missing := missingBlocks(chain, blocks)
+	if len(missing) > 0 {
+		if _, err := chain.InsertChain(missing); err != nil {
+			return 0, err
+		}
+	}
+	// The execution results must match the archived ones
+	for i, hash := range hashes {
+		n := e.Start() + uint64(i)
+		if td := chain.GetTd(hash, n); td == nil || td.Cmp(tds[i]) != 0 {
+			return 0, fmt.Errorf("total difficulty mismatch of block #%d: have %v, want %v", n, td, tds[i])
+		}
+		if n == 0 {
+			continue
+		}
+		want, err := e.GetRawReceiptsByNumber(n)
+		if err != nil {
+			return 0, err
+		}
+		if have := rawdb.ReadReceiptsRLP(db, hash, n); !bytes.Equal(have, want) {
+			return 0, fmt.Errorf("receipts mismatch of block #%d", n)
+		}
+	}
+	return uint64(len(missing)), nil
+}
+
+// readHistoryChecksums loads the sha256 digests of the era files, keyed by the
+// file name.
+func readHistoryChecksums(fn string) (map[string]string, error) {
+	blob, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, err
+	}
+	checksums := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSpace(string(blob)), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("malformed checksum line: %q", line)
+		}
+		checksums[fields[1]] = fields[0]
+	}
+	return checksums, nil
+}
+
+// fileChecksum computes the hex encoded sha256 digest of a file.
+func fileChecksum(fn string) (string, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", hasher.Sum(nil)), nil
+}
+
 // ImportPreimages imports a batch of exported hash preimages into the database.
 // It's a part of the deprecated functionality, should be removed in the future.
 func ImportPreimages(db ethdb.Database, fn string) error {
@@ -568,3 +838,54 @@ func ExportChaindata(fn string, kind string, iter ChainDataIterator, interrupt c
 		"elapsed", common.PrettyDuration(time.Since(start)))
 	return nil
 }
+
+// syncCheckpoint is the content of a sync checkpoint file, holding a trusted
+// committed header along with its committee. The header is stored in its RLP
+// encoding to retain all the fields it is hashed over.
+type syncCheckpoint struct {
+	Number hexutil.Uint64 `json:"number"`
+	Hash   common.Hash    `json:"hash"`
+	Header hexutil.Bytes  `json:"header"`
+}
+
+// ExportSyncCheckpoint writes the given committed header into a sync checkpoint
+// file, truncating any data already present in the file.
+func ExportSyncCheckpoint(header *types.Header, fn string) error {
+	enc, err := rlp.EncodeToBytes(header)
+	if err != nil {
+		return err
+	}
+	blob, err := json.MarshalIndent(&syncCheckpoint{
+		Number: hexutil.Uint64(header.Number.Uint64()),
+		Hash:   header.Hash(),
+		Header: enc,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fn, blob, 0644)
+}
+
+// ImportSyncCheckpoint reads the committed header from a sync checkpoint file,
+// ensuring that it matches the hash and number recorded along.
+func ImportSyncCheckpoint(fn string) (*types.Header, error) {
+	blob, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, err
+	}
+	var checkpoint syncCheckpoint
+	if err := json.Unmarshal(blob, &checkpoint); err != nil {
+		return nil, err
+	}
+	header := new(types.Header)
+	if err := rlp.DecodeBytes(checkpoint.Header, header); err != nil {
+		return nil, err
+	}
+	if hash := header.Hash(); hash != checkpoint.Hash {
+		return nil, fmt.Errorf("checkpoint hash mismatch: have %x, want %x", hash, checkpoint.Hash)
+	}
+	if number := header.Number.Uint64(); number != uint64(checkpoint.Number) {
+		return nil, fmt.Errorf("checkpoint number mismatch: have %d, want %d", number, checkpoint.Number)
+	}
+	return header, nil
+}
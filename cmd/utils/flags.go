@@ -48,9 +48,11 @@ import (
 	"github.com/autonity/autonity/accounts/keystore"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/fdlimit"
+	"github.com/autonity/autonity/common/hexutil"
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/ethash"
 	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/vm"
 	"github.com/autonity/autonity/crypto"
 	"github.com/autonity/autonity/eth"
@@ -73,6 +75,7 @@ import (
 	"github.com/autonity/autonity/p2p/nat"
 	"github.com/autonity/autonity/p2p/netutil"
 	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/trie"
 )
 
 func init() {
@@ -137,6 +140,11 @@ var (
 		Name:  "datadir.ancient",
 		Usage: "Data directory for ancient chain segments (default = inside chaindata)",
 	}
+	DBEngineFlag = cli.StringFlag{
+		Name:  "db.engine",
+		Usage: "Backing database implementation to use ('leveldb' or 'pebble'), defaults to the engine of an existing database or leveldb",
+		Value: "",
+	}
 	MinFreeDiskSpaceFlag = DirectoryFlag{
 		Name:  "datadir.minfreedisk",
 		Usage: "Minimum free disk space in MB, once reached triggers auto shut down (default = --cache.gc converted to MB, 0 = disabled)",
@@ -212,6 +220,10 @@ var (
 		Usage: `Blockchain sync mode ("snap", "full" or "light")`,
 		Value: &defaultSyncMode,
 	}
+	SyncCheckpointFlag = cli.StringFlag{
+		Name:  "sync.checkpoint",
+		Usage: "Hash of a trusted committed header, or path of a checkpoint file holding it, to snap sync an empty chain from",
+	}
 	GCModeFlag = cli.StringFlag{
 		Name:  "gcmode",
 		Usage: `Blockchain garbage collection mode ("full", "archive")`,
@@ -221,11 +233,25 @@ var (
 		Name:  "snapshot",
 		Usage: `Enables snapshot-database mode (default = enable)`,
 	}
+	StateSchemeFlag = cli.StringFlag{
+		Name:  "state.scheme",
+		Usage: "Scheme to use for storing the state trie nodes ('hash' or 'path'), defaults to the scheme of an existing database or hash",
+		Value: "",
+	}
+	StateHistoryFlag = cli.Uint64Flag{
+		Name:  "state.history",
+		Usage: "Number of recent blocks to maintain state history for, allowing the state to be rolled back (path-based scheme only, 0 = entire chain)",
+		Value: ethconfig.Defaults.StateHistory,
+	}
 	TxLookupLimitFlag = cli.Uint64Flag{
 		Name:  "txlookuplimit",
 		Usage: "Number of recent blocks to maintain transactions index for (default = about one year, 0 = entire chain)",
 		Value: ethconfig.Defaults.TxLookupLimit,
 	}
+	HistoryEpochsFlag = cli.Uint64Flag{
+		Name:  "history.epochs",
+		Usage: "Number of recent epochs to keep block bodies and receipts for, older ones being pruned (0 = entire chain)",
+	}
 	LightKDFFlag = cli.BoolFlag{
 		Name:  "lightkdf",
 		Usage: "Reduce key-derivation RAM & CPU usage at some expense of KDF strength",
@@ -606,6 +632,34 @@ var (
 		Usage: "NAT port mapping mechanism for consensus channel (any|none|upnp|pmp|extip:<IP>)",
 		Value: "any",
 	}
+	ConsensusTopologyFlag = cli.StringFlag{
+		Name:  "consensus.topology",
+		Usage: `Topology used to connect to the other committee members ("mesh", "graph" or "latency")`,
+		Value: ethconfig.Defaults.ConsensusTopology,
+	}
+	ConsensusTopologyDiameterFlag = cli.UintFlag{
+		Name:  "consensus.topology.diameter",
+		Usage: "Target diameter of the graph and latency topologies",
+		Value: ethconfig.Defaults.ConsensusTopologyDiameter,
+	}
+	ConsensusTopologyMaxExtraFlag = cli.IntFlag{
+		Name:  "consensus.topology.maxextra",
+		Usage: "Maximum number of additional lowest latency committee peers of the latency topology",
+		Value: ethconfig.Defaults.ConsensusTopologyMaxExtra,
+	}
+	ConsensusBlockPartsFlag = cli.Uint64Flag{
+		Name:  "consensus.blockparts",
+		Usage: "Minimum size in bytes of the local proposals propagated as erasure-coded block parts (0 = disabled)",
+		Value: ethconfig.Defaults.ConsensusBlockParts,
+	}
+	ConsensusSentriesFlag = cli.StringFlag{
+		Name:  "consensus.sentries",
+		Usage: "Comma separated enode URLs of the sentry nodes the validator exclusively connects to on the consensus channel",
+	}
+	ConsensusSentryValidatorFlag = cli.StringFlag{
+		Name:  "consensus.sentry.validator",
+		Usage: "Enode URL of the validator on whose behalf this node relays consensus messages, as a sentry node",
+	}
 	// Network Settings
 	MaxPeersFlag = cli.IntFlag{
 		Name:  "maxpeers",
@@ -1228,6 +1282,7 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	setWS(ctx, cfg)
 	setNodeUserIdent(ctx, cfg)
 	setDataDir(ctx, cfg)
+	setDBEngine(ctx, cfg)
 	setSmartCard(ctx, cfg)
 
 	if ctx.GlobalIsSet(ExternalSignerFlag.Name) {
@@ -1288,6 +1343,23 @@ func setDataDir(ctx *cli.Context, cfg *node.Config) {
 	}
 }
 
+func setDBEngine(ctx *cli.Context, cfg *node.Config) {
+	if !ctx.GlobalIsSet(DBEngineFlag.Name) {
+		return
+	}
+	engine := ctx.GlobalString(DBEngineFlag.Name)
+	switch engine {
+	case rawdb.DBLeveldb:
+	case rawdb.DBPebble:
+		if !rawdb.PebbleEnabled {
+			Fatalf("Pebble database engine is not supported on this platform")
+		}
+	default:
+		Fatalf("Invalid choice for db.engine '%s', allowed 'leveldb' or 'pebble'", engine)
+	}
+	cfg.DBEngine = engine
+}
+
 func setGPO(ctx *cli.Context, cfg *gasprice.Config, light bool) {
 	// If we are running the light client, apply another group
 	// settings for gas oracle.
@@ -1399,6 +1471,27 @@ func setRequiredBlocks(ctx *cli.Context, cfg *ethconfig.Config) {
 	}
 }
 
+// setSyncCheckpoint applies the trusted sync checkpoint, given either as a header
+// hash or as the path of a checkpoint file, to the config.
+func setSyncCheckpoint(ctx *cli.Context, cfg *ethconfig.Config) {
+	checkpoint := ctx.GlobalString(SyncCheckpointFlag.Name)
+	if checkpoint == "" {
+		return
+	}
+	if cfg.SyncMode != downloader.SnapSync {
+		Fatalf("--%s requires --%s=snap", SyncCheckpointFlag.Name, SyncModeFlag.Name)
+	}
+	if blob, err := hexutil.Decode(checkpoint); err == nil && len(blob) == common.HashLength {
+		cfg.SyncCheckpoint = common.BytesToHash(blob)
+		return
+	}
+	header, err := ImportSyncCheckpoint(checkpoint)
+	if err != nil {
+		Fatalf("Invalid sync checkpoint %s: %v", checkpoint, err)
+	}
+	cfg.SyncCheckpoint, cfg.SyncCheckpointHeader = header.Hash(), header
+}
+
 // CheckExclusive verifies that only a single instance of the provided flags was
 // set by the user. Each flag might optionally be followed by a string type to
 // specialize it further.
@@ -1445,10 +1538,14 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	// Avoid conflicting network flags
 	CheckExclusive(ctx, LightServeFlag, SyncModeFlag, "light")
 	CheckExclusive(ctx, PiccadillyFlag, BakerlooFlag, DeveloperFlag)
+	CheckExclusive(ctx, ConsensusSentriesFlag, ConsensusSentryValidatorFlag)
 	if ctx.GlobalString(GCModeFlag.Name) == "archive" && ctx.GlobalUint64(TxLookupLimitFlag.Name) != 0 {
 		ctx.GlobalSet(TxLookupLimitFlag.Name, "0")
 		log.Warn("Disable transaction unindexing for archive node")
 	}
+	if ctx.GlobalString(GCModeFlag.Name) == "archive" && ctx.GlobalUint64(HistoryEpochsFlag.Name) != 0 {
+		Fatalf("--%s is not compatible with --%s=archive", HistoryEpochsFlag.Name, GCModeFlag.Name)
+	}
 	if ctx.GlobalIsSet(LightServeFlag.Name) && ctx.GlobalUint64(TxLookupLimitFlag.Name) != 0 {
 		log.Warn("LES server cannot serve old transaction status and cannot connect below les/4 protocol version if transaction lookup index is limited")
 	}
@@ -1486,6 +1583,7 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.GlobalIsSet(SyncModeFlag.Name) {
 		cfg.SyncMode = *GlobalTextMarshaler(ctx, SyncModeFlag.Name).(*downloader.SyncMode)
 	}
+	setSyncCheckpoint(ctx, cfg)
 	if ctx.GlobalIsSet(NetworkIdFlag.Name) {
 		cfg.NetworkID = ctx.GlobalUint64(NetworkIdFlag.Name)
 	}
@@ -1506,6 +1604,12 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.GlobalIsSet(CacheNoPrefetchFlag.Name) {
 		cfg.NoPrefetch = ctx.GlobalBool(CacheNoPrefetchFlag.Name)
 	}
+	if ctx.GlobalIsSet(StateSchemeFlag.Name) {
+		cfg.StateScheme = ctx.GlobalString(StateSchemeFlag.Name)
+	}
+	if ctx.GlobalIsSet(StateHistoryFlag.Name) {
+		cfg.StateHistory = ctx.GlobalUint64(StateHistoryFlag.Name)
+	}
 	// Read the value from the flag no matter if it's set or not.
 	cfg.Preimages = ctx.GlobalBool(CachePreimagesFlag.Name)
 	if cfg.NoPruning && !cfg.Preimages {
@@ -1515,6 +1619,9 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	if ctx.GlobalIsSet(TxLookupLimitFlag.Name) {
 		cfg.TxLookupLimit = ctx.GlobalUint64(TxLookupLimitFlag.Name)
 	}
+	if ctx.GlobalIsSet(HistoryEpochsFlag.Name) {
+		cfg.HistoryEpochs = ctx.GlobalUint64(HistoryEpochsFlag.Name)
+	}
 	if ctx.GlobalIsSet(CacheFlag.Name) || ctx.GlobalIsSet(CacheTrieFlag.Name) {
 		cfg.TrieCleanCache = ctx.GlobalInt(CacheFlag.Name) * ctx.GlobalInt(CacheTrieFlag.Name) / 100
 	}
@@ -1539,6 +1646,33 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 			cfg.SnapshotCache = 0 // Disabled
 		}
 	}
+	if ctx.GlobalIsSet(ConsensusTopologyFlag.Name) {
+		switch topology := ctx.GlobalString(ConsensusTopologyFlag.Name); topology {
+		case ethconfig.MeshTopology, ethconfig.GraphTopology, ethconfig.LatencyTopology:
+			cfg.ConsensusTopology = topology
+		default:
+			Fatalf("--%s must be either '%s', '%s' or '%s'", ConsensusTopologyFlag.Name,
+				ethconfig.MeshTopology, ethconfig.GraphTopology, ethconfig.LatencyTopology)
+		}
+	}
+	if ctx.GlobalIsSet(ConsensusTopologyDiameterFlag.Name) {
+		cfg.ConsensusTopologyDiameter = ctx.GlobalUint(ConsensusTopologyDiameterFlag.Name)
+		if cfg.ConsensusTopologyDiameter == 0 {
+			Fatalf("--%s must be at least 1", ConsensusTopologyDiameterFlag.Name)
+		}
+	}
+	if ctx.GlobalIsSet(ConsensusTopologyMaxExtraFlag.Name) {
+		cfg.ConsensusTopologyMaxExtra = ctx.GlobalInt(ConsensusTopologyMaxExtraFlag.Name)
+	}
+	if ctx.GlobalIsSet(ConsensusBlockPartsFlag.Name) {
+		cfg.ConsensusBlockParts = ctx.GlobalUint64(ConsensusBlockPartsFlag.Name)
+	}
+	if ctx.GlobalIsSet(ConsensusSentriesFlag.Name) {
+		cfg.ConsensusSentries = SplitAndTrim(ctx.GlobalString(ConsensusSentriesFlag.Name))
+	}
+	if ctx.GlobalIsSet(ConsensusSentryValidatorFlag.Name) {
+		cfg.ConsensusSentryValidator = ctx.GlobalString(ConsensusSentryValidatorFlag.Name)
+	}
 	if ctx.GlobalIsSet(DocRootFlag.Name) {
 		cfg.DocRoot = ctx.GlobalString(DocRootFlag.Name)
 	}
@@ -1665,7 +1799,9 @@ func RegisterEthService(stack *node.Node, cfg *ethconfig.Config) (ethapi.Backend
 }
 
 func RegisterConsensusService(stack *node.Node, backend *eth.Ethereum, netID uint64) {
-	acn.New(stack, backend, netID)
+	if err := acn.New(stack, backend, netID); err != nil {
+		Fatalf("Failed to register the consensus service: %v", err)
+	}
 }
 
 // RegisterEthStatsService configures the Ethereum Stats daemon and adds it to
@@ -1781,6 +1917,29 @@ func MakeChainDatabase(ctx *cli.Context, stack *node.Node, readonly bool) ethdb.
 	return chainDb
 }
 
+// ParseStateScheme resolves the scheme to use for storing the state in the
+// given database, out of the one requested on the command line and the one the
+// database already uses.
+func ParseStateScheme(ctx *cli.Context, disk ethdb.Database) string {
+	scheme, err := rawdb.ParseStateScheme(ctx.GlobalString(StateSchemeFlag.Name), disk)
+	if err != nil {
+		Fatalf("%v", err)
+	}
+	return scheme
+}
+
+// MakeTrieDatabase constructs a trie database using the state scheme of the
+// given database. A read-only database never modifies the persisted state.
+func MakeTrieDatabase(ctx *cli.Context, disk ethdb.Database, preimages bool, readOnly bool) *trie.Database {
+	config := &trie.Config{Preimages: preimages}
+	if scheme := ParseStateScheme(ctx, disk); scheme == rawdb.PathScheme {
+		config.Scheme = scheme
+		config.StateHistory = ctx.GlobalUint64(StateHistoryFlag.Name)
+		config.ReadOnly = readOnly
+	}
+	return trie.NewDatabaseWithConfig(disk, config)
+}
+
 func MakeGenesis(ctx *cli.Context) *core.Genesis {
 	if ctx.GlobalBool(PiccadillyFlag.Name) {
 		return core.DefaultPiccadillyGenesisBlock()
@@ -1795,7 +1954,8 @@ func MakeGenesis(ctx *cli.Context) *core.Genesis {
 func MakeChain(ctx *cli.Context, stack *node.Node) (chain *core.BlockChain, chainDb ethdb.Database) {
 	var err error
 	chainDb = MakeChainDatabase(ctx, stack, false) // TODO(rjl493456442) support read-only database
-	config, _, err := core.SetupGenesisBlock(chainDb, MakeGenesis(ctx))
+	scheme := ParseStateScheme(ctx, chainDb)
+	config, _, err := core.SetupGenesisBlockWithOverride(chainDb, MakeGenesis(ctx), scheme, nil, nil)
 	if err != nil {
 		Fatalf("%v", err)
 	}
@@ -1826,6 +1986,8 @@ func MakeChain(ctx *cli.Context, stack *node.Node) (chain *core.BlockChain, chai
 		TrieTimeLimit:       ethconfig.Defaults.TrieTimeout,
 		SnapshotLimit:       ethconfig.Defaults.SnapshotCache,
 		Preimages:           ctx.GlobalBool(CachePreimagesFlag.Name),
+		StateScheme:         scheme,
+		StateHistory:        ctx.GlobalUint64(StateHistoryFlag.Name),
 	}
 	if cache.TrieDirtyDisabled && !cache.Preimages {
 		cache.Preimages = true
@@ -0,0 +1,121 @@
+package utils
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/autonity/autonity/accounts/abi/bind/backends"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/ethash"
+	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/core/vm"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/ethdb"
+	"github.com/autonity/autonity/internal/era"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/params"
+)
+
+var (
+	historyKey, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	historyAddress = crypto.PubkeyToAddress(historyKey.PublicKey)
+)
+
+// newHistoryChain creates a blockchain on a fresh database sharing the genesis
+// of the history tests.
+func newHistoryChain(t *testing.T) (*core.BlockChain, ethdb.Database) {
+	db := rawdb.NewMemoryDatabase()
+	genesis := &core.Genesis{
+		Config:  params.TestChainConfig,
+		BaseFee: big.NewInt(params.InitialBaseFee),
+		Alloc:   core.GenesisAlloc{historyAddress: {Balance: big.NewInt(params.Ether)}},
+	}
+	genesis.MustCommit(db)
+	chain, err := core.NewBlockChain(db, nil, params.TestChainConfig, ethash.NewFaker(), vm.Config{}, nil, &core.TxSenderCacher{}, nil, backends.NewInternalBackend(nil), log.Root())
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	return chain, db
+}
+
+// Tests that the history exported into era files is imported into a fresh node,
+// and that tampered era files are rejected.
+func TestHistoryExportImport(t *testing.T) {
+	src, srcDb := newHistoryChain(t)
+	defer src.Stop()
+
+	signer := types.LatestSigner(params.TestChainConfig)
+	blocks, _ := core.GenerateChain(params.TestChainConfig, src.Genesis(), ethash.NewFaker(), srcDb, 20, func(i int, b *core.BlockGen) {
+		tx, err := types.SignTx(types.NewTransaction(b.TxNonce(historyAddress), common.Address{0x01}, big.NewInt(1000), params.TxGas, b.BaseFee(), nil), signer, historyKey)
+		if err != nil {
+			t.Fatalf("failed to sign transaction: %v", err)
+		}
+		b.AddTx(tx)
+	})
+	if _, err := src.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	dir := t.TempDir()
+	if err := ExportHistory(src, dir, 0, 20, 8); err != nil {
+		t.Fatalf("failed to export history: %v", err)
+	}
+	network := params.TestChainConfig.ChainID.String()
+	files, err := era.ReadDir(dir, network)
+	if err != nil || len(files) != 3 {
+		t.Fatalf("era files mismatch: have %v (%v), want 3 files", files, err)
+	}
+	dst, dstDb := newHistoryChain(t)
+	defer dst.Stop()
+
+	if err := ImportHistory(dst, dstDb, dir, network); err != nil {
+		t.Fatalf("failed to import history: %v", err)
+	}
+	if head := dst.CurrentBlock(); head.Hash() != blocks[len(blocks)-1].Hash() {
+		t.Fatalf("head mismatch: have #%d [%x], want #%d", head.NumberU64(), head.Hash(), len(blocks))
+	}
+	// Importing again is a no-op
+	if err := ImportHistory(dst, dstDb, dir, network); err != nil {
+		t.Fatalf("failed to reimport history: %v", err)
+	}
+	// Tampered files fail their checksum
+	path := filepath.Join(dir, files[1])
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blob[len(blob)/2] ^= 0xff
+	if err := os.WriteFile(path, blob, 0644); err != nil {
+		t.Fatal(err)
+	}
+	fresh, freshDb := newHistoryChain(t)
+	defer fresh.Stop()
+
+	if err := ImportHistory(fresh, freshDb, dir, network); err == nil {
+		t.Fatalf("imported tampered era file")
+	}
+	if head := fresh.CurrentBlock().NumberU64(); head != 7 {
+		t.Fatalf("head mismatch after failed import: have #%d, want #7", head)
+	}
+	// Ranges starting off an era boundary shorten the first file
+	unaligned := t.TempDir()
+	if err := ExportHistory(src, unaligned, 5, 20, 8); err != nil {
+		t.Fatalf("failed to export history: %v", err)
+	}
+	if files, err = era.ReadDir(unaligned, network); err != nil || len(files) != 3 {
+		t.Fatalf("era files mismatch: have %v (%v), want 3 files", files, err)
+	}
+	for i, want := range []struct{ start, count uint64 }{{5, 3}, {8, 8}, {16, 5}} {
+		e, err := era.Open(filepath.Join(unaligned, files[i]))
+		if err != nil {
+			t.Fatalf("failed to open era file %s: %v", files[i], err)
+		}
+		if e.Start() != want.start || e.Count() != want.count {
+			t.Errorf("era file %s range mismatch: have %d+%d, want %d+%d", files[i], e.Start(), e.Count(), want.start, want.count)
+		}
+		e.Close()
+	}
+}
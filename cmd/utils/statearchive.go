@@ -0,0 +1,464 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
+	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/core/state/snapshot"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/ethdb"
+	"github.com/autonity/autonity/internal/era/e2store"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/rlp"
+	"github.com/autonity/autonity/trie"
+)
+
+// A state archive is an e2store holding the whole state at a given block along
+// with the block itself, so that a node can be bootstrapped from it:
+//
+//	Meta | Header | Parent | Body | (Account Storage* Code?)* | End
+//
+// The accounts are ordered by hash, each followed by its storage slots ordered
+// by hash and, if not already present earlier in the archive, by its code. The
+// accounts and slots are stored in their snapshot encoding, prefixed with their
+// hash. The end entry holds the number of accounts, slots and codes, which must
+// match the ones read to detect truncated archives.
+const (
+	stateArchiveMeta     uint16 = 0x5374
+	stateArchiveHeader   uint16 = 0x01
+	stateArchiveParent   uint16 = 0x02
+	stateArchiveBody     uint16 = 0x03
+	stateArchiveAccount  uint16 = 0x10
+	stateArchiveStorage  uint16 = 0x11
+	stateArchiveCode     uint16 = 0x12
+	stateArchiveEnd      uint16 = 0x1f
+	stateArchiveVersion         = 1
+	stateArchiveLogCycle        = 8 * time.Second
+)
+
+var (
+	errStateArchiveOrder = errors.New("state archive entries out of order")
+
+	// emptyCodeHash is the known hash of the empty EVM bytecode.
+	emptyCodeHash = crypto.Keccak256Hash(nil)
+)
+
+// stateArchiveInfo describes the content of a state archive.
+type stateArchiveInfo struct {
+	Version uint64
+	ChainID *big.Int
+	Number  uint64
+	Hash    common.Hash
+	Root    common.Hash
+}
+
+// stateArchiveStats is the content of the closing entry of a state archive.
+type stateArchiveStats struct {
+	Accounts uint64
+	Slots    uint64
+	Codes    uint64
+}
+
+// ExportState writes the state at the given block, taken from the state
+// snapshot, into a state archive along with the block, its parent header and
+// thus the committee which sealed it.
+func ExportState(chain *core.BlockChain, fn string, number uint64) error {
+	snaps := chain.Snapshots()
+	if snaps == nil {
+		return errors.New("state snapshot disabled")
+	}
+	block := chain.GetBlockByNumber(number)
+	if block == nil || number == 0 {
+		return fmt.Errorf("block #%d not available", number)
+	}
+	parent := chain.GetHeader(block.ParentHash(), number-1)
+	if parent == nil {
+		return fmt.Errorf("parent of block #%d not available", number)
+	}
+	root := block.Root()
+	if snaps.Snapshot(root) == nil {
+		return fmt.Errorf("state snapshot of block #%d [%x] not available", number, root)
+	}
+	log.Info("Exporting state", "file", fn, "number", number, "hash", block.Hash(), "root", root)
+
+	fh, err := os.OpenFile(fn, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+
+	var (
+		buf      = bufio.NewWriter(fh)
+		w        = e2store.NewWriter(buf)
+		stats    stateArchiveStats
+		codes    = make(map[common.Hash]struct{})
+		start    = time.Now()
+		reported = time.Now()
+	)
+	write := func(typ uint16, value interface{}) error {
+		blob, ok := value.([]byte)
+		if !ok {
+			var err error
+			if blob, err = rlp.EncodeToBytes(value); err != nil {
+				return err
+			}
+		}
+		_, err := w.Write(typ, blob)
+		return err
+	}
+	info := &stateArchiveInfo{
+		Version: stateArchiveVersion,
+		ChainID: chain.Config().ChainID,
+		Number:  number,
+		Hash:    block.Hash(),
+		Root:    root,
+	}
+	for _, entry := range []struct {
+		typ   uint16
+		value interface{}
+	}{
+		{stateArchiveMeta, info},
+		{stateArchiveHeader, block.Header()},
+		{stateArchiveParent, parent},
+		{stateArchiveBody, block.Body()},
+	} {
+		if err := write(entry.typ, entry.value); err != nil {
+			return err
+		}
+	}
+	accIt, err := snaps.AccountIterator(root, common.Hash{})
+	if err != nil {
+		return err
+	}
+	defer accIt.Release()
+
+	for accIt.Next() {
+		hash, blob := accIt.Hash(), accIt.Account()
+		if err := write(stateArchiveAccount, append(hash.Bytes(), blob...)); err != nil {
+			return err
+		}
+		stats.Accounts++
+
+		account, err := snapshot.FullAccount(blob)
+		if err != nil {
+			return err
+		}
+		if common.BytesToHash(account.Root) != types.EmptyRootHash {
+			storageIt, err := snaps.StorageIterator(root, hash, common.Hash{})
+			if err != nil {
+				return err
+			}
+			for storageIt.Next() {
+				if err := write(stateArchiveStorage, append(storageIt.Hash().Bytes(), storageIt.Slot()...)); err != nil {
+					storageIt.Release()
+					return err
+				}
+				stats.Slots++
+			}
+			storageIt.Release()
+			if err := storageIt.Error(); err != nil {
+				return err
+			}
+		}
+		if codeHash := common.BytesToHash(account.CodeHash); codeHash != emptyCodeHash {
+			if _, ok := codes[codeHash]; !ok {
+				code, err := chain.StateCache().ContractCode(hash, codeHash)
+				if err != nil {
+					return fmt.Errorf("missing code %x of account %x: %v", codeHash, hash, err)
+				}
+				if err := write(stateArchiveCode, code); err != nil {
+					return err
+				}
+				codes[codeHash] = struct{}{}
+				stats.Codes++
+			}
+		}
+		if time.Since(reported) >= stateArchiveLogCycle {
+			log.Info("Exporting state", "accounts", stats.Accounts, "slots", stats.Slots, "codes", stats.Codes, "elapsed", common.PrettyDuration(time.Since(start)))
+			reported = time.Now()
+		}
+	}
+	if err := accIt.Error(); err != nil {
+		return err
+	}
+	if err := write(stateArchiveEnd, &stats); err != nil {
+		return err
+	}
+	if err := buf.Flush(); err != nil {
+		return err
+	}
+	log.Info("Exported state", "file", fn, "accounts", stats.Accounts, "slots", stats.Slots, "codes", stats.Codes, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// ImportState bootstraps an empty chain from a state archive. The trusted hash,
+// which is required, must be the one of the archived block or of its parent,
+// and the block must be sealed by the committee of the archived parent. The
+// state tries are rebuilt from the archive and their root checked against the
+// block before the chain is anchored at it, from where the node keeps on syncing.
+func ImportState(chain *core.BlockChain, db ethdb.Database, fn string, trusted common.Hash) error {
+	if trusted == (common.Hash{}) {
+		return errors.New("a trusted block hash is required to import a state archive")
+	}
+	if chain.CurrentHeader().Number.Sign() != 0 || chain.CurrentFastBlock().NumberU64() != 0 {
+		return fmt.Errorf("%w: chain not empty", core.ErrCheckpointConflict)
+	}
+	fh, err := os.Open(fn)
+	if err != nil {
+		return err
+	}
+	defer fh.Close()
+
+	var (
+		r   = e2store.NewReader(fh)
+		off int64
+	)
+	read := func(typ uint16, value interface{}) error {
+		blob, n, err := r.ReadTypeAt(typ, off)
+		if err != nil {
+			return err
+		}
+		off += n
+		return rlp.DecodeBytes(blob, value)
+	}
+	var (
+		info   stateArchiveInfo
+		header types.Header
+		parent types.Header
+		body   types.Body
+	)
+	if err := read(stateArchiveMeta, &info); err != nil {
+		return fmt.Errorf("invalid state archive: %v", err)
+	}
+	if info.Version != stateArchiveVersion {
+		return fmt.Errorf("unsupported state archive version %d", info.Version)
+	}
+	if info.ChainID == nil || info.ChainID.Cmp(chain.Config().ChainID) != 0 {
+		return fmt.Errorf("state archive of chain %v, want %v", info.ChainID, chain.Config().ChainID)
+	}
+	if err := read(stateArchiveHeader, &header); err != nil {
+		return fmt.Errorf("invalid archived header: %v", err)
+	}
+	if err := read(stateArchiveParent, &parent); err != nil {
+		return fmt.Errorf("invalid archived parent header: %v", err)
+	}
+	if err := read(stateArchiveBody, &body); err != nil {
+		return fmt.Errorf("invalid archived body: %v", err)
+	}
+	if err := verifyStateArchiveBlock(&info, &header, &parent, &body, trusted); err != nil {
+		return err
+	}
+	log.Info("Importing state", "file", fn, "number", info.Number, "hash", info.Hash, "root", info.Root)
+
+	root, err := importStateArchive(r, off, db, chain.StateCache().TrieDB().Scheme())
+	if err != nil {
+		return err
+	}
+	if root != info.Root {
+		return fmt.Errorf("state root mismatch: have %x, want %x", root, info.Root)
+	}
+	// The state is in place, anchor the chain at the archived block
+	if err := chain.InitCheckpoint(&header); err != nil {
+		return err
+	}
+	batch := db.NewBatch()
+	rawdb.WriteBody(batch, info.Hash, info.Number, &body)
+	rawdb.WriteHeadFastBlockHash(batch, info.Hash)
+	rawdb.WriteHeadBlockHash(batch, info.Hash)
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	return chain.SnapSyncCommitHead(info.Hash)
+}
+
+// verifyStateArchiveBlock checks that the archived block is the one described
+// by the archive, that it or its parent is the trusted one, and that it was
+// committed by the committee of its parent.
+func verifyStateArchiveBlock(info *stateArchiveInfo, header, parent *types.Header, body *types.Body, trusted common.Hash) error {
+	hash := header.Hash()
+	if hash != info.Hash || header.Number.Uint64() != info.Number || header.Root != info.Root {
+		return fmt.Errorf("archived header #%d [%x] doesn't match archive #%d [%x]", header.Number, hash, info.Number, info.Hash)
+	}
+	if info.Number == 0 || header.ParentHash != parent.Hash() {
+		return fmt.Errorf("archived parent %x doesn't match block parent %x", parent.Hash(), header.ParentHash)
+	}
+	// The parent, whose committee seals the block, is linked to the trusted
+	// hash either directly or by the block itself
+	if hash != trusted && header.ParentHash != trusted {
+		return fmt.Errorf("archived block #%d [%x] nor its parent [%x] match the trusted hash %x", info.Number, hash, header.ParentHash, trusted)
+	}
+	if header.MixDigest != types.BFTDigest {
+		return fmt.Errorf("archived block #%d isn't a BFT block", info.Number)
+	}
+	if err := finality.VerifySeals(header, parent.Committee); err != nil {
+		return fmt.Errorf("invalid committed seals of block #%d: %v", info.Number, err)
+	}
+	if txHash := types.DeriveSha(types.Transactions(body.Transactions), trie.NewStackTrie(nil)); txHash != header.TxHash {
+		return fmt.Errorf("archived transactions root mismatch: have %x, want %x", txHash, header.TxHash)
+	}
+	if uncleHash := types.CalcUncleHash(body.Uncles); uncleHash != header.UncleHash {
+		return fmt.Errorf("archived uncles hash mismatch: have %x, want %x", uncleHash, header.UncleHash)
+	}
+	return nil
+}
+
+// importStateArchive rebuilds the account and storage tries, along with the
+// contract codes, from the state entries of an archive starting at the given
+// offset. The root of the rebuilt account trie is returned.
+func importStateArchive(r *e2store.Reader, off int64, db ethdb.Database, scheme string) (common.Hash, error) {
+	var (
+		batch    = db.NewBatch()
+		accTrie  = newStateArchiveTrie(batch, scheme, common.Hash{})
+		stats    stateArchiveStats
+		codes    = make(map[common.Hash]bool) // Written codes, false if only referenced
+		start    = time.Now()
+		reported = time.Now()
+
+		// Account being imported, inserted once all its slots are in
+		accHash     common.Hash
+		account     *snapshot.Account
+		storageTrie *trie.StackTrie
+		lastSlot    common.Hash
+	)
+	// flushAccount checks the storage root of the pending account and inserts
+	// it into the account trie.
+	flushAccount := func() error {
+		if account == nil {
+			return nil
+		}
+		want := common.BytesToHash(account.Root)
+		have := types.EmptyRootHash
+		if storageTrie != nil {
+			var err error
+			if have, err = storageTrie.Commit(); err != nil {
+				return err
+			}
+		}
+		if have != want {
+			return fmt.Errorf("storage root mismatch of account %x: have %x, want %x", accHash, have, want)
+		}
+		full, err := rlp.EncodeToBytes(account)
+		if err != nil {
+			return err
+		}
+		account, storageTrie = nil, nil
+		return accTrie.TryUpdate(accHash[:], full)
+	}
+	for {
+		entry, n, err := r.ReadAt(off)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("invalid state archive at offset %d: %v", off, err)
+		}
+		off += n
+
+		switch entry.Type {
+		case stateArchiveAccount:
+			if len(entry.Value) < common.HashLength {
+				return common.Hash{}, fmt.Errorf("invalid account entry at offset %d", off-n)
+			}
+			hash := common.BytesToHash(entry.Value[:common.HashLength])
+			if stats.Accounts > 0 && bytes.Compare(hash[:], accHash[:]) <= 0 {
+				return common.Hash{}, fmt.Errorf("%w: account %x after %x", errStateArchiveOrder, hash, accHash)
+			}
+			if err := flushAccount(); err != nil {
+				return common.Hash{}, err
+			}
+			acc, err := snapshot.FullAccount(entry.Value[common.HashLength:])
+			if err != nil {
+				return common.Hash{}, fmt.Errorf("invalid account %x: %v", hash, err)
+			}
+			rawdb.WriteAccountSnapshot(batch, hash, entry.Value[common.HashLength:])
+			if codeHash := common.BytesToHash(acc.CodeHash); codeHash != emptyCodeHash && !codes[codeHash] {
+				codes[codeHash] = false
+			}
+			accHash, account, lastSlot = hash, &acc, common.Hash{}
+			stats.Accounts++
+
+		case stateArchiveStorage:
+			if account == nil || len(entry.Value) < common.HashLength {
+				return common.Hash{}, fmt.Errorf("invalid storage entry at offset %d", off-n)
+			}
+			slot := common.BytesToHash(entry.Value[:common.HashLength])
+			if storageTrie == nil {
+				storageTrie = newStateArchiveTrie(batch, scheme, accHash)
+			} else if bytes.Compare(slot[:], lastSlot[:]) <= 0 {
+				return common.Hash{}, fmt.Errorf("%w: slot %x after %x of account %x", errStateArchiveOrder, slot, lastSlot, accHash)
+			}
+			if err := storageTrie.TryUpdate(slot[:], entry.Value[common.HashLength:]); err != nil {
+				return common.Hash{}, err
+			}
+			rawdb.WriteStorageSnapshot(batch, accHash, slot, entry.Value[common.HashLength:])
+			lastSlot = slot
+			stats.Slots++
+
+		case stateArchiveCode:
+			hash := crypto.Keccak256Hash(entry.Value)
+			if written, ok := codes[hash]; !ok || written {
+				return common.Hash{}, fmt.Errorf("unexpected code %x at offset %d", hash, off-n)
+			}
+			rawdb.WriteCode(batch, hash, entry.Value)
+			codes[hash] = true
+			stats.Codes++
+
+		case stateArchiveEnd:
+			var want stateArchiveStats
+			if err := rlp.DecodeBytes(entry.Value, &want); err != nil {
+				return common.Hash{}, fmt.Errorf("invalid state archive end: %v", err)
+			}
+			if want != stats {
+				return common.Hash{}, fmt.Errorf("state archive content mismatch: have %+v, want %+v", stats, want)
+			}
+			if err := flushAccount(); err != nil {
+				return common.Hash{}, err
+			}
+			for hash, written := range codes {
+				if !written {
+					return common.Hash{}, fmt.Errorf("missing code %x", hash)
+				}
+			}
+			root, err := accTrie.Commit()
+			if err != nil {
+				return common.Hash{}, err
+			}
+			if err := batch.Write(); err != nil {
+				return common.Hash{}, err
+			}
+			log.Info("Imported state", "root", root, "accounts", stats.Accounts, "slots", stats.Slots, "codes", stats.Codes, "elapsed", common.PrettyDuration(time.Since(start)))
+			return root, nil
+
+		default:
+			return common.Hash{}, fmt.Errorf("unexpected state archive entry %#x at offset %d", entry.Type, off-n)
+		}
+		if batch.ValueSize() > ethdb.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return common.Hash{}, err
+			}
+			batch.Reset()
+		}
+		if time.Since(reported) >= stateArchiveLogCycle {
+			log.Info("Importing state", "accounts", stats.Accounts, "slots", stats.Slots, "codes", stats.Codes, "elapsed", common.PrettyDuration(time.Since(start)))
+			reported = time.Now()
+		}
+	}
+}
+
+// newStateArchiveTrie creates a stack trie persisting its nodes into the given
+// batch according to the state scheme.
+func newStateArchiveTrie(batch ethdb.Batch, scheme string, owner common.Hash) *trie.StackTrie {
+	if scheme != rawdb.PathScheme {
+		return trie.NewStackTrie(batch)
+	}
+	return trie.NewStackTrieWithOwner(func(owner common.Hash, path []byte, hash common.Hash, blob []byte) {
+		rawdb.WritePathTrieNode(batch, owner, path, blob)
+	}, owner)
+}
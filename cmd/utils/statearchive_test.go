@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus"
+	"github.com/autonity/autonity/consensus/ethash"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/state"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/params"
+)
+
+// Tests that a node is bootstrapped from the state archive of another one, and
+// that archives not matching the trusted block are rejected.
+func TestStateExportImport(t *testing.T) {
+	src, srcDb := newHistoryChain(t)
+	defer src.Stop()
+
+	var (
+		signer   = types.LatestSigner(params.TestChainConfig)
+		contract = crypto.CreateAddress(historyAddress, 0)
+		// Stores 42 in slot 0 and deploys a single STOP opcode
+		initCode = common.FromHex("602a60005560016000f3")
+	)
+	committeeKey, _ := crypto.GenerateKey()
+	engine := bftFaker{
+		Engine:    ethash.NewFaker(),
+		committee: types.Committee{{Address: crypto.PubkeyToAddress(committeeKey.PublicKey), VotingPower: big.NewInt(1)}},
+	}
+	blocks, _ := core.GenerateChain(params.TestChainConfig, src.Genesis(), engine, srcDb, 4, func(i int, b *core.BlockGen) {
+		var tx *types.Transaction
+		if i == 0 {
+			tx = types.NewContractCreation(b.TxNonce(historyAddress), nil, 100000, b.BaseFee(), initCode)
+		} else {
+			tx = types.NewTransaction(b.TxNonce(historyAddress), common.Address{0x01}, big.NewInt(1000), params.TxGas, b.BaseFee(), nil)
+		}
+		signed, err := types.SignTx(tx, signer, historyKey)
+		if err != nil {
+			t.Fatalf("failed to sign transaction: %v", err)
+		}
+		b.AddTx(signed)
+	})
+	// Seal the head block by the committee of its parent
+	header := blocks[len(blocks)-1].Header()
+	seal, err := crypto.Sign(message.PrepareCommittedSeal(header.Hash(), 0, header.Number).Bytes(), committeeKey)
+	if err != nil {
+		t.Fatalf("failed to sign committed seal: %v", err)
+	}
+	header.CommittedSeals = [][]byte{seal}
+	blocks[len(blocks)-1] = types.NewBlockWithHeader(header).WithBody(blocks[len(blocks)-1].Transactions(), nil)
+
+	if _, err := src.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	head, parent := blocks[len(blocks)-1], blocks[len(blocks)-2]
+	fn := filepath.Join(t.TempDir(), "state.archive")
+	if err := ExportState(src, fn, head.NumberU64()); err != nil {
+		t.Fatalf("failed to export state: %v", err)
+	}
+	// Archives not matching the trusted hash are rejected
+	dst, dstDb := newHistoryChain(t)
+	defer dst.Stop()
+
+	if err := ImportState(dst, dstDb, fn, common.Hash{}); err == nil {
+		t.Fatalf("imported state archive without trusted block")
+	}
+	if err := ImportState(dst, dstDb, fn, common.Hash{0x01}); err == nil {
+		t.Fatalf("imported state archive of untrusted block")
+	}
+	if err := ImportState(dst, dstDb, fn, parent.Hash()); err != nil {
+		t.Fatalf("failed to import state: %v", err)
+	}
+	if current := dst.CurrentBlock(); current.Hash() != head.Hash() {
+		t.Fatalf("head mismatch: have #%d [%x], want #%d [%x]", current.NumberU64(), current.Hash(), head.NumberU64(), head.Hash())
+	}
+	statedb, err := dst.State()
+	if err != nil {
+		t.Fatalf("imported state not available: %v", err)
+	}
+	if have := statedb.GetState(contract, common.Hash{}); have != common.BigToHash(big.NewInt(42)) {
+		t.Fatalf("contract storage mismatch: have %x, want 42", have)
+	}
+	if have := statedb.GetCode(contract); len(have) != 1 {
+		t.Fatalf("contract code mismatch: have %x, want 00", have)
+	}
+	want, _ := src.State()
+	if have, want := statedb.GetBalance(historyAddress), want.GetBalance(historyAddress); have.Cmp(want) != 0 {
+		t.Fatalf("balance mismatch: have %v, want %v", have, want)
+	}
+	// Bootstrapped chains can't be bootstrapped again
+	if err := ImportState(dst, dstDb, fn, head.Hash()); !errors.Is(err, core.ErrCheckpointConflict) {
+		t.Fatalf("reimport error mismatch: have %v, want %v", err, core.ErrCheckpointConflict)
+	}
+}
+
+// Tests that the archived block must be sealed by a quorum of the committee of
+// its parent, and be a BFT block.
+func TestVerifyStateArchiveBlock(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	parent := &types.Header{
+		Number:    big.NewInt(1),
+		MixDigest: types.BFTDigest,
+		Committee: types.Committee{{Address: crypto.PubkeyToAddress(key.PublicKey), VotingPower: big.NewInt(1)}},
+	}
+	block := func(digest common.Hash, sealed bool) (*stateArchiveInfo, *types.Header) {
+		header := &types.Header{
+			ParentHash: parent.Hash(),
+			Number:     big.NewInt(2),
+			MixDigest:  digest,
+			TxHash:     types.EmptyRootHash,
+			UncleHash:  types.EmptyUncleHash,
+		}
+		if sealed {
+			seal, err := crypto.Sign(message.PrepareCommittedSeal(header.Hash(), 0, header.Number).Bytes(), key)
+			if err != nil {
+				t.Fatalf("failed to sign committed seal: %v", err)
+			}
+			header.CommittedSeals = [][]byte{seal}
+		}
+		return &stateArchiveInfo{Number: 2, Hash: header.Hash()}, header
+	}
+	info, header := block(types.BFTDigest, true)
+	if err := verifyStateArchiveBlock(info, header, parent, &types.Body{}, info.Hash); err != nil {
+		t.Fatalf("failed to verify block trusted by hash: %v", err)
+	}
+	if err := verifyStateArchiveBlock(info, header, parent, &types.Body{}, parent.Hash()); err != nil {
+		t.Fatalf("failed to verify block trusted by parent hash: %v", err)
+	}
+	if info, header := block(types.BFTDigest, false); verifyStateArchiveBlock(info, header, parent, &types.Body{}, info.Hash) == nil {
+		t.Fatalf("verified block without committed seals")
+	}
+	if info, header := block(common.Hash{}, true); verifyStateArchiveBlock(info, header, parent, &types.Body{}, info.Hash) == nil {
+		t.Fatalf("verified non BFT block")
+	}
+}
+
+// bftFaker is a fake engine assembling BFT blocks elected by the given
+// committee.
+type bftFaker struct {
+	consensus.Engine
+	committee types.Committee
+}
+
+func (e bftFaker) FinalizeAndAssemble(chain consensus.ChainReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header, receipts *[]*types.Receipt) (*types.Block, error) {
+	header.MixDigest = types.BFTDigest
+	header.Committee = e.committee
+	return e.Engine.FinalizeAndAssemble(chain, header, state, txs, uncles, receipts)
+}
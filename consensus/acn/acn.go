@@ -17,9 +17,15 @@
 package acn
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"math"
 	"sync"
+	"sync/atomic"
+
+	lru "github.com/hashicorp/golang-lru"
+	"golang.org/x/exp/slices"
 
 	"github.com/autonity/autonity/consensus/acn/protocol"
 	"github.com/autonity/autonity/eth"
@@ -29,6 +35,7 @@ import (
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/core/forkid"
+	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/crypto"
 	"github.com/autonity/autonity/log"
 	"github.com/autonity/autonity/node"
@@ -36,6 +43,11 @@ import (
 	"github.com/autonity/autonity/p2p/enode"
 )
 
+var errConsensusKeyMismatch = errors.New("consensus key mismatch")
+
+// committees smaller than this are always fully connected, whatever the configured topology
+const minGraphNodes = 20
+
 type ACN struct {
 	networkID  uint64
 	peers      *peerSet
@@ -46,10 +58,30 @@ type ACN struct {
 	log        log.Logger
 	address    common.Address
 	cancel     context.CancelFunc
+	topology   eth.Topology
+
+	credentials *protocol.Credentials // local keys proven to the peers during the handshake
+	committee   atomic.Pointer[types.Committee]
+	endpoints   atomic.Pointer[consensusEndpoints] // endpoints published by the committee members
+
+	sentries  []*enode.Node // sentry nodes the local validator exclusively connects to
+	protected *enode.Node   // validator on whose behalf the local sentry node relays messages
+	relayed   *lru.Cache    // recently relayed messages
 }
 
-func New(stack *node.Node, backend *eth.Ethereum, netID uint64) {
-	nodeKey, _ := stack.Config().AutonityKeys()
+func New(stack *node.Node, backend *eth.Ethereum, netID uint64) error {
+	nodeKey, consensusKey := stack.Config().AutonityKeys()
+	cfg := backend.Config()
+	topology, err := eth.NewTopology(cfg.ConsensusTopology, cfg.ConsensusTopologyDiameter, minGraphNodes,
+		cfg.ConsensusTopologyMaxExtra, stack.ConsensusServer())
+	if err != nil {
+		return err
+	}
+	sentries, protected, err := parseSentryConfig(cfg)
+	if err != nil {
+		return err
+	}
+	relayed, _ := lru.New(inmemoryRelayedMessages)
 	acn := &ACN{
 		peers:      newPeerSet(),
 		chain:      backend.BlockChain(),
@@ -58,6 +90,14 @@ func New(stack *node.Node, backend *eth.Ethereum, netID uint64) {
 		server:     stack.ConsensusServer(),
 		log:        log.New(),
 		address:    crypto.PubkeyToAddress(nodeKey.PublicKey),
+		topology:   topology,
+		credentials: &protocol.Credentials{
+			NodeKey:      nodeKey,
+			ConsensusKey: consensusKey,
+		},
+		sentries:  sentries,
+		protected: protected,
+		relayed:   relayed,
 	}
 
 	acn.server.MaxPeers = math.MaxInt
@@ -68,6 +108,7 @@ func New(stack *node.Node, backend *eth.Ethereum, netID uint64) {
 	}
 	// once p2p protocol handler is initialized, set it for accountability module for the off-chain accountability protocol.
 	backend.FD().SetBroadcaster(acn)
+	return nil
 }
 
 func (acn *ACN) Start() error {
@@ -83,6 +124,13 @@ func (acn *ACN) Protocols() []p2p.Protocol {
 }
 
 func (acn *ACN) FindPeers(targets map[common.Address]struct{}) map[common.Address]autonity.Peer {
+	// a validator behind sentries reaches any committee member through all of them
+	if len(acn.sentries) > 0 {
+		if len(targets) == 0 {
+			return nil
+		}
+		return acn.peers.all()
+	}
 	return acn.peers.find(targets)
 }
 
@@ -94,10 +142,14 @@ func (acn *ACN) runConsensusPeer(peer *protocol.Peer, handler protocol.HandlerFu
 
 	genesis := acn.chain.Genesis()
 	forkID := forkid.NewID(acn.chain.Config(), acn.chain.Genesis().Hash(), acn.chain.CurrentHeader().Number.Uint64())
-	if err := peer.Handshake(acn.networkID, genesis.Hash(), forkID, acn.forkFilter); err != nil {
+	if err := peer.Handshake(acn.networkID, genesis.Hash(), forkID, acn.forkFilter, acn.credentials); err != nil {
 		peer.Log().Debug("Consensus handshake failed", "err", err)
 		return err
 	}
+	if err := authenticate(peer, acn.currentCommittee(), acn.representatives()); err != nil {
+		peer.Log().Debug("Consensus peer authentication failed", "err", err)
+		return err
+	}
 
 	if err := acn.peers.register(peer); err != nil {
 		peer.Log().Error("peer registration failed", "err", err)
@@ -107,6 +159,72 @@ func (acn *ACN) runConsensusPeer(peer *protocol.Peer, handler protocol.HandlerFu
 	return handler(peer)
 }
 
+// currentCommittee returns the committee at the current head, along with the consensus
+// keys of its members.
+func (acn *ACN) currentCommittee() types.Committee {
+	if committee := acn.committee.Load(); committee != nil {
+		return *committee
+	}
+	committee, err := acn.loadCommittee(acn.chain.CurrentHeader())
+	if err != nil {
+		acn.log.Error("Could not retrieve committee at head block", "err", err)
+	}
+	return committee
+}
+
+// loadCommittee takes the committee from the given header, which carries the consensus
+// keys of its members. The state is only read again when the committee changes or when
+// one of its members publishes new endpoints.
+func (acn *ACN) loadCommittee(header *types.Header) (types.Committee, error) {
+	committee := header.Committee
+	if previous := acn.committee.Load(); previous == nil || !sameMembers(*previous, committee) || endpointsUpdated(header) {
+		state, err := acn.chain.StateAt(header.Root)
+		if err != nil {
+			return nil, err
+		}
+		acn.loadEndpoints(committee, state)
+	}
+	acn.committee.Store(&committee)
+	return committee, nil
+}
+
+func sameMembers(a, b types.Committee) bool {
+	return slices.EqualFunc(a, b, func(x, y types.CommitteeMember) bool { return x.Address == y.Address })
+}
+
+// authenticate checks the peer against the given committee. Committee members must
+// have proven to own their registered consensus key, sentry nodes stand for the
+// validator they represent. Other peers are kept connected as unauthenticated peers,
+// whose messages are rate limited. ACNv1 peers can't prove their consensus key, the
+// committee members among them are only identified by the node key of the connection.
+func authenticate(peer *protocol.Peer, committee types.Committee, representatives map[enode.ID]common.Address) error {
+	if member := committeeMember(committee, peer.Address()); member != nil {
+		if peer.Version() >= protocol.ACNv2 && !bytes.Equal(member.ConsensusKey, peer.ConsensusKey()) {
+			peer.SetValidator(common.Address{}, nil)
+			return errConsensusKeyMismatch
+		}
+		peer.SetValidator(member.Address, member.VotingPower)
+		return nil
+	}
+	if validator, ok := representatives[peer.Node().ID()]; ok {
+		if member := committeeMember(committee, validator); member != nil {
+			peer.SetValidator(member.Address, member.VotingPower)
+			return nil
+		}
+	}
+	peer.SetValidator(common.Address{}, nil)
+	return nil
+}
+
+func committeeMember(committee types.Committee, address common.Address) *types.CommitteeMember {
+	for i := range committee {
+		if committee[i].Address == address {
+			return &committee[i]
+		}
+	}
+	return nil
+}
+
 func (acn *ACN) Stop() error {
 	// Disconnect existing sessions.
 	// This also closes the gate for any new registrations on the peer set.
@@ -5,6 +5,7 @@ import (
 
 	"github.com/autonity/autonity/core"
 	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
 )
 
 // TODO: watch for epoch rotation event instead
@@ -25,12 +26,29 @@ func (acn *ACN) watchCommittee(ctx context.Context) {
 			acn.log.Error("Could not retrieve consensus whitelist at head block", "err", err)
 			return
 		}
-		acn.server.UpdateConsensusEnodes(enodesList.List, enodesList.List)
+		nodes := acn.resolveEndpoints(enodesList.List)
+		subset := acn.topology.RequestSubset(nodes, acn.server.LocalNode())
+		if acn.protected != nil {
+			subset = append(subset, acn.protected)
+			nodes = append(nodes, acn.protected)
+		}
+		acn.server.UpdateConsensusEnodes(subset, nodes)
+	}
+
+	// a sentry node follows the committee membership of its validator, while a
+	// validator behind sentries only ever connects to them.
+	validator := acn.address
+	if acn.protected != nil {
+		validator = crypto.PubkeyToAddress(*acn.protected.Pubkey())
+	}
+	if len(acn.sentries) > 0 {
+		acn.server.UpdateConsensusEnodes(acn.sentries, acn.sentries)
+		updateConsensusEnodes = func(*types.Block) {}
 	}
 
 	wasValidating := false
 	currentBlock := acn.chain.CurrentBlock()
-	if currentBlock.Header().CommitteeMember(acn.address) != nil {
+	if currentBlock.Header().CommitteeMember(validator) != nil {
 		updateConsensusEnodes(currentBlock)
 		wasValidating = true
 	}
@@ -42,12 +60,17 @@ func (acn *ACN) watchCommittee(ctx context.Context) {
 			select {
 			case ev := <-chainHeadCh:
 				header := ev.Block.Header()
+				if committee, err := acn.loadCommittee(header); err != nil {
+					acn.log.Error("Could not retrieve committee at head block", "err", err)
+				} else {
+					acn.peers.authenticate(committee, acn.representatives())
+				}
 				// check if the local node belongs to the consensus committee.
-				if header.CommitteeMember(acn.address) == nil {
+				if header.CommitteeMember(validator) == nil {
 					// if the local node was part of the committee set for the previous block
 					// there is no longer the need to retain the full connections and the
 					// consensus engine enabled.
-					if wasValidating {
+					if wasValidating && len(acn.sentries) == 0 {
 						acn.server.UpdateConsensusEnodes(nil, nil)
 						wasValidating = false
 					}
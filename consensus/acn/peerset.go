@@ -24,7 +24,9 @@ import (
 	"github.com/autonity/autonity/consensus/acn/protocol"
 
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/p2p"
+	"github.com/autonity/autonity/p2p/enode"
 )
 
 var (
@@ -93,14 +95,42 @@ func (ps *peerSet) find(targets map[common.Address]struct{}) map[common.Address]
 	defer ps.RUnlock()
 	m := make(map[common.Address]autonity.Peer)
 	for _, p := range ps.peers {
-		addr := p.Address()
+		addr := p.Validator()
 		if _, ok := targets[addr]; ok {
-			m[addr] = p
+			// prefer a direct connection to the validator over its sentries
+			if _, found := m[addr]; !found || p.Address() == addr {
+				m[addr] = p
+			}
 		}
 	}
 	return m
 }
 
+// all retrieves every registered peer, keyed by its own address.
+func (ps *peerSet) all() map[common.Address]autonity.Peer {
+	ps.RLock()
+	defer ps.RUnlock()
+	m := make(map[common.Address]autonity.Peer, len(ps.peers))
+	for _, p := range ps.peers {
+		m[p.Address()] = p
+	}
+	return m
+}
+
+// broadcast sends an encoded message to every registered peer but the excluded one.
+func (ps *peerSet) broadcast(code uint64, payload []byte, exclude string) {
+	ps.RLock()
+	defer ps.RUnlock()
+	for id, p := range ps.peers {
+		if id == exclude {
+			continue
+		}
+		if err := p.SendRaw(code, payload); err != nil {
+			p.Log().Debug("Failed to relay consensus message", "err", err)
+		}
+	}
+}
+
 // peer retrieves the registered peer with the given id.
 func (ps *peerSet) peer(id string) *protocol.Peer {
 	ps.RLock()
@@ -109,6 +139,20 @@ func (ps *peerSet) peer(id string) *protocol.Peer {
 	return ps.peers[id]
 }
 
+// authenticate refreshes the committee membership of all peers against the given committee,
+// and disconnects the committee members which don't own their registered consensus key.
+func (ps *peerSet) authenticate(committee types.Committee, representatives map[enode.ID]common.Address) {
+	ps.RLock()
+	defer ps.RUnlock()
+
+	for _, p := range ps.peers {
+		if err := authenticate(p, committee, representatives); err != nil {
+			p.Log().Debug("Consensus peer authentication failed", "err", err)
+			p.Disconnect(p2p.DiscUnexpectedIdentity)
+		}
+	}
+}
+
 // close disconnects all peers.
 func (ps *peerSet) close() {
 	ps.Lock()
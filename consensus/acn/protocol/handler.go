@@ -1,7 +1,10 @@
 package protocol
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"time"
 
@@ -14,6 +17,8 @@ import (
 	"github.com/autonity/autonity/params"
 )
 
+var unauthenticatedDropMeter = metrics.NewRegisteredMeter("acn/unauthenticated/dropped", nil)
+
 // HandlerFunc is a callback to invoke from an outside runner after the boilerplate
 // exchanges have passed.
 type HandlerFunc func(peer *Peer) error
@@ -32,6 +37,13 @@ type Backend interface {
 
 	// PeerInfo retrieves all known `acn` information about a peer.
 	PeerInfo(id enode.ID) interface{}
+
+	// Relaying returns whether the local node is a sentry, relaying the consensus
+	// messages between its validator and the rest of the committee.
+	Relaying() bool
+
+	// Relay forwards a consensus message received from the given peer.
+	Relay(peer *Peer, code uint64, payload []byte)
 }
 
 // NodeInfo represents a short summary of the `ACN` protocol metadata
@@ -66,7 +78,9 @@ func MakeProtocols(backend Backend, network uint64) []p2p.Protocol {
 			Version: version,
 			Length:  protocolLengths[version],
 			Run: func(p *p2p.Peer, rw p2p.MsgReadWriter) error {
-				peer := NewPeer(version, p, rw)
+				peer := NewPeer(version, p, rw, func() uint64 {
+					return backend.Chain().CurrentHeader().Number.Uint64()
+				})
 				defer peer.Close()
 
 				return backend.RunPeer(peer, func(peer *Peer) error {
@@ -131,8 +145,39 @@ func handleMessage(backend Backend, peer *Peer, errCh chan<- error) error {
 			metrics.GetOrRegisterHistogramLazy(h, nil, sampler).Update(time.Since(start).Microseconds())
 		}(time.Now())
 	}
+	// messages from peers outside of the committee are rate limited. Committee members
+	// are exempted even before their membership is refreshed, they must not lose votes.
+	if !peer.Authenticated() && backend.Chain().CurrentHeader().CommitteeMember(peer.Address()) == nil && !peer.limiter.Allow() {
+		unauthenticatedDropMeter.Mark(1)
+		return nil
+	}
+	// messages which reach us through a sentry, or which we relay as a sentry, are
+	// authenticated first and attributed to their signer rather than to the sentry.
+	sender := peer.Validator()
+	if sender != peer.Address() || backend.Relaying() {
+		payload, err := io.ReadAll(msg.Payload)
+		if err != nil {
+			return err
+		}
+		msg.Payload = bytes.NewReader(payload)
+		if verifier, ok := backend.Chain().Engine().(consensus.MsgVerifier); ok {
+			signer, err := verifier.VerifyMsg(msg.Code, payload)
+			switch {
+			case err == nil:
+				sender = signer
+			case errors.Is(err, consensus.ErrUnverifiableMsg):
+				// past heights are left to the sync of the consensus engine
+				return nil
+			case !errors.Is(err, consensus.ErrUnsignedMsg):
+				return err
+			}
+		}
+		if backend.Relaying() {
+			backend.Relay(peer, msg.Code, payload)
+		}
+	}
 	if handler, ok := backend.Chain().Engine().(consensus.Handler); ok {
-		if handled, err := handler.HandleMsg(peer.address, msg, errCh); handled {
+		if handled, err := handler.HandleMsg(sender, msg, errCh); handled {
 			return err
 		}
 	}
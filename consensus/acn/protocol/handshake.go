@@ -17,11 +17,14 @@
 package protocol
 
 import (
+	"crypto/rand"
 	"fmt"
 	"time"
 
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/core/forkid"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/crypto/blst"
 	"github.com/autonity/autonity/p2p"
 )
 
@@ -31,34 +34,72 @@ const (
 	handshakeTimeout = 5 * time.Second
 )
 
-// Handshake executes the eth protocol handshake, negotiating version number,
-// network IDs, difficulties, head and genesis blocks.
-func (p *Peer) Handshake(network uint64, genesis common.Hash, forkID forkid.ID, forkFilter forkid.Filter) error {
-	// Send out own handshake in a new thread
-	errc := make(chan error, 2)
-
-	var status StatusPacket // safe to read after two values have been received from errc
+// Handshake executes the acn protocol handshake, negotiating version number,
+// network IDs, head and genesis blocks, and then authenticates the remote peer:
+// both sides sign the random challenge received in the status message with their
+// node key and consensus key. ACNv1 peers don't prove their consensus key.
+func (p *Peer) Handshake(network uint64, genesis common.Hash, forkID forkid.ID, forkFilter forkid.Filter, credentials *Credentials) error {
+	var nonce common.Hash
+	if p.version >= ACNv2 {
+		if _, err := rand.Read(nonce[:]); err != nil {
+			return err
+		}
+	}
+	timeout := time.NewTimer(handshakeTimeout)
+	defer timeout.Stop()
 
-	go func() {
-		errc <- p2p.Send(p.rw, StatusMsg, &StatusPacket{
+	var status StatusPacket // safe to read once the exchange succeeded
+	err := p.exchange(timeout.C, func() error {
+		return p2p.Send(p.rw, StatusMsg, &StatusPacket{
 			ProtocolVersion: uint32(p.version),
 			NetworkID:       network,
 			Genesis:         genesis,
 			ForkID:          forkID,
+			Nonce:           nonce,
 		})
+	}, func() error {
+		return p.readStatus(network, &status, genesis, forkFilter)
+	})
+	if err != nil {
+		return err
+	}
+	if p.version < ACNv2 {
+		return nil
+	}
+
+	var auth AuthPacket
+	err = p.exchange(timeout.C, func() error {
+		packet, err := newAuthPacket(credentials, genesis, status.Nonce)
+		if err != nil {
+			return err
+		}
+		return p2p.Send(p.rw, AuthMsg, packet)
+	}, func() error {
+		return p.readAuth(&auth, genesis, nonce)
+	})
+	if err != nil {
+		return err
+	}
+	p.consensusKey = auth.ConsensusKey
+	return nil
+}
+
+// exchange sends our handshake message in a new thread while reading the remote one.
+func (p *Peer) exchange(timeout <-chan time.Time, send func() error, read func() error) error {
+	errc := make(chan error, 2)
+	go func() {
+		errc <- send()
 	}()
 	go func() {
-		errc <- p.readStatus(network, &status, genesis, forkFilter)
+		errc <- read()
 	}()
-	timeout := time.NewTimer(handshakeTimeout)
-	defer timeout.Stop()
 	for i := 0; i < 2; i++ {
 		select {
 		case err := <-errc:
 			if err != nil {
 				return err
 			}
-		case <-timeout.C:
+		case <-timeout:
 			return p2p.DiscReadTimeout
 		}
 	}
@@ -95,3 +136,66 @@ func (p *Peer) readStatus(network uint64, status *StatusPacket, genesis common.H
 	}
 	return nil
 }
+
+// readAuth reads the remote authentication message and checks that it answers our challenge.
+func (p *Peer) readAuth(auth *AuthPacket, genesis common.Hash, nonce common.Hash) error {
+	msg, err := p.rw.ReadMsg()
+	if err != nil {
+		return err
+	}
+	if msg.Code != AuthMsg {
+		return fmt.Errorf("%w: second msg has code %x (!= %x)", errNoAuthMsg, msg.Code, AuthMsg)
+	}
+	if msg.Size > MaxMessageSize {
+		return fmt.Errorf("%w: %v > %v", errMsgTooLarge, msg.Size, MaxMessageSize)
+	}
+	if err := msg.Decode(auth); err != nil {
+		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+	}
+	return verifyAuthPacket(auth, genesis, nonce, p.address)
+}
+
+// authChallenge is the hash signed by a peer to answer the given challenge. It is bound
+// to the network and to the signer, so that it can't be replayed by another peer.
+func authChallenge(genesis common.Hash, nonce common.Hash, signer common.Address) common.Hash {
+	return crypto.Keccak256Hash([]byte(ProtocolName), genesis.Bytes(), nonce.Bytes(), signer.Bytes())
+}
+
+// newAuthPacket answers the challenge of the remote peer with the local keys.
+func newAuthPacket(credentials *Credentials, genesis common.Hash, nonce common.Hash) (*AuthPacket, error) {
+	challenge := authChallenge(genesis, nonce, crypto.PubkeyToAddress(credentials.NodeKey.PublicKey))
+	nodeSignature, err := crypto.Sign(challenge.Bytes(), credentials.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+	return &AuthPacket{
+		ConsensusKey:       credentials.ConsensusKey.PublicKey().Marshal(),
+		NodeSignature:      nodeSignature,
+		ConsensusSignature: credentials.ConsensusKey.Sign(challenge.Bytes()).Marshal(),
+	}, nil
+}
+
+// verifyAuthPacket checks that the packet answers our challenge and is signed by
+// both the node key of the given address and the consensus key it carries.
+func verifyAuthPacket(auth *AuthPacket, genesis common.Hash, nonce common.Hash, address common.Address) error {
+	challenge := authChallenge(genesis, nonce, address)
+	pubKey, err := crypto.SigToPub(challenge.Bytes(), auth.NodeSignature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errAuthFailed, err)
+	}
+	if crypto.PubkeyToAddress(*pubKey) != address {
+		return fmt.Errorf("%w: node signature mismatch", errAuthFailed)
+	}
+	consensusKey, err := blst.PublicKeyFromBytes(auth.ConsensusKey)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errAuthFailed, err)
+	}
+	signature, err := blst.SignatureFromBytes(auth.ConsensusSignature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errAuthFailed, err)
+	}
+	if !signature.Verify(consensusKey, challenge.Bytes()) {
+		return fmt.Errorf("%w: consensus signature mismatch", errAuthFailed)
+	}
+	return nil
+}
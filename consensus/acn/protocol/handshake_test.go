@@ -0,0 +1,102 @@
+package protocol
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/forkid"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/crypto/blst"
+	"github.com/autonity/autonity/p2p"
+	"github.com/autonity/autonity/rlp"
+)
+
+func newCredentials(t *testing.T) *Credentials {
+	nodeKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	consensusKey, err := blst.RandKey()
+	require.NoError(t, err)
+	return &Credentials{NodeKey: nodeKey, ConsensusKey: consensusKey}
+}
+
+func acceptAllForks(forkid.ID) error { return nil }
+
+// handshake runs the handshake between two peers with the given credentials, where
+// the remote peer claims the given address.
+func handshake(t *testing.T, version uint, local, remote *Credentials, remoteAddress common.Address) (*Peer, error, error) {
+	localRW, remoteRW := p2p.MsgPipe()
+	defer localRW.Close()
+	localPeer := &Peer{rw: localRW, version: version, address: remoteAddress}
+	remotePeer := &Peer{rw: remoteRW, version: version, address: crypto.PubkeyToAddress(local.NodeKey.PublicKey)}
+
+	genesis := common.Hash{0x01}
+	errc := make(chan error, 1)
+	go func() {
+		errc <- remotePeer.Handshake(1, genesis, forkid.ID{}, acceptAllForks, remote)
+	}()
+	localErr := localPeer.Handshake(1, genesis, forkid.ID{}, acceptAllForks, local)
+	if localErr != nil {
+		localRW.Close()
+	}
+	return localPeer, localErr, <-errc
+}
+
+func TestHandshakeAuthentication(t *testing.T) {
+	local, remote := newCredentials(t), newCredentials(t)
+
+	peer, err, remoteErr := handshake(t, ACNv2, local, remote, crypto.PubkeyToAddress(remote.NodeKey.PublicKey))
+	require.NoError(t, err)
+	require.NoError(t, remoteErr)
+	require.Equal(t, remote.ConsensusKey.PublicKey().Marshal(), peer.ConsensusKey())
+	require.False(t, peer.Authenticated())
+
+	// the remote peer signs with a node key which is not the one of the connection
+	_, err, _ = handshake(t, ACNv2, local, remote, common.Address{0xaa})
+	require.True(t, errors.Is(err, errAuthFailed))
+}
+
+func TestHandshakeACNv1(t *testing.T) {
+	local, remote := newCredentials(t), newCredentials(t)
+
+	// no consensus key is proven, the peer is identified by its node key only
+	peer, err, remoteErr := handshake(t, ACNv1, local, remote, crypto.PubkeyToAddress(remote.NodeKey.PublicKey))
+	require.NoError(t, err)
+	require.NoError(t, remoteErr)
+	require.Nil(t, peer.ConsensusKey())
+
+	// the status message of ACNv1 carries no challenge
+	data, err := rlp.EncodeToBytes(&StatusPacket{ProtocolVersion: ACNv1, NetworkID: 1, Genesis: common.Hash{0x01}})
+	require.NoError(t, err)
+	var legacy struct {
+		ProtocolVersion uint32
+		NetworkID       uint64
+		Genesis         common.Hash
+		ForkID          forkid.ID
+	}
+	require.NoError(t, rlp.DecodeBytes(data, &legacy))
+}
+
+func TestVerifyAuthPacket(t *testing.T) {
+	credentials := newCredentials(t)
+	address := crypto.PubkeyToAddress(credentials.NodeKey.PublicKey)
+	genesis, nonce := common.Hash{0x01}, common.Hash{0x02}
+
+	packet, err := newAuthPacket(credentials, genesis, nonce)
+	require.NoError(t, err)
+	require.NoError(t, verifyAuthPacket(packet, genesis, nonce, address))
+
+	// replayed against another challenge
+	require.ErrorIs(t, verifyAuthPacket(packet, genesis, common.Hash{0x03}, address), errAuthFailed)
+
+	// consensus key not owned by the peer
+	forged := *packet
+	forged.ConsensusKey = newCredentials(t).ConsensusKey.PublicKey().Marshal()
+	require.ErrorIs(t, verifyAuthPacket(&forged, genesis, nonce, address), errAuthFailed)
+
+	forged = *packet
+	forged.ConsensusSignature = []byte{0x01}
+	require.ErrorIs(t, verifyAuthPacket(&forged, genesis, nonce, address), errAuthFailed)
+}
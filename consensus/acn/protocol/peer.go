@@ -1,12 +1,25 @@
 package protocol
 
 import (
+	"math/big"
+	"sync"
+
+	"golang.org/x/time/rate"
+
 	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/rlp"
 
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/p2p"
 )
 
+const (
+	// maximum rate and burst of the messages handled from a peer which is not an
+	// authenticated committee member, any message above it is dropped.
+	unauthenticatedMsgRate  = 10
+	unauthenticatedMsgBurst = 20
+)
+
 // Peer is a collection of relevant information we have about a `acn` peer.
 type Peer struct {
 	id      string // Unique ID for the peer, cached
@@ -15,31 +28,47 @@ type Peer struct {
 	*p2p.Peer                   // The embedded P2P package peer
 	rw        p2p.MsgReadWriter // Input/output streams for snap
 	version   uint              // Protocol version negotiated
+	queue     *sendQueue        // Prioritised outgoing messages
+
+	consensusKey []byte        // BLS key proven by the peer during the handshake
+	limiter      *rate.Limiter // Limits the messages handled while the peer is not a committee member
 
+	validator   common.Address // Committee member the peer stands for
+	votingPower *big.Int       // Voting power of the peer, nil if not an authenticated committee member
+	lock        sync.RWMutex
 }
 
 // peerInfo represents a short summary of the `acn` protocol metadata known
 // about a connected peer.
 type peerInfo struct {
-	Version uint `json:"version"` // Acn protocol version negotiated
+	Version uint           `json:"version"` // Acn protocol version negotiated
+	Queues  map[string]int `json:"queues"`  // Number of pending outgoing messages per priority
+
+	Validator   *common.Address `json:"validator,omitempty"`   // Authenticated committee member address
+	VotingPower *big.Int        `json:"votingPower,omitempty"` // Voting power of the committee member
 }
 
 // NewPeer create a wrapper for a network connection and negotiated  protocol
-// version.
-func NewPeer(version uint, p *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
+// version. The head function returns the current chain head number, outgoing
+// messages bound to a height up to it are considered stale and never sent.
+func NewPeer(version uint, p *p2p.Peer, rw p2p.MsgReadWriter, head func() uint64) *Peer {
 	peer := &Peer{
 		id:      p.ID().String(),
 		address: crypto.PubkeyToAddress(*p.Node().Pubkey()),
 		Peer:    p,
 		rw:      rw,
 		version: version,
+		limiter: rate.NewLimiter(unauthenticatedMsgRate, unauthenticatedMsgBurst),
 	}
+	peer.queue = newSendQueue(rw, head, func(err error) {
+		peer.Log().Debug("Failed to send consensus message", "err", err)
+	})
 	return peer
 }
 
-// Close can be used to do peer related clean up, nothing for now
+// Close stops the outgoing message writer and discards the pending messages.
 func (p *Peer) Close() {
-	// nothing to do
+	p.queue.close()
 }
 
 // ID retrieves the peer's unique identifier.
@@ -52,12 +81,64 @@ func (p *Peer) Address() common.Address {
 	return p.address
 }
 
+// ConsensusKey returns the BLS public key the peer proved to own during the handshake,
+// nil for an ACNv1 peer.
+func (p *Peer) ConsensusKey() []byte {
+	return p.consensusKey
+}
+
+// SetValidator records the committee member the peer has been authenticated for, along
+// with its voting power. The peer is either the member itself or one of its sentries.
+// A nil power marks the peer as unauthenticated.
+func (p *Peer) SetValidator(validator common.Address, power *big.Int) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.validator = validator
+	p.votingPower = power
+}
+
+// Validator returns the address of the committee member the peer stands for, which
+// is the peer address unless it is a sentry node.
+func (p *Peer) Validator() common.Address {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if p.votingPower == nil {
+		return p.address
+	}
+	return p.validator
+}
+
+// VotingPower returns the voting power of the peer, or nil if the peer is not an
+// authenticated committee member.
+func (p *Peer) VotingPower() *big.Int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.votingPower
+}
+
+// Authenticated returns whether the peer is an authenticated committee member.
+func (p *Peer) Authenticated() bool {
+	return p.VotingPower() != nil
+}
+
+// Send encodes and schedules a message to be sent, according to its priority.
 func (p *Peer) Send(msgcode uint64, data interface{}) error {
-	return p2p.Send(p.rw, msgcode, data)
+	payload, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		return err
+	}
+	return p.queue.enqueue(msgcode, payload)
 }
 
+// SendRaw schedules an already encoded message to be sent, according to its priority.
+// Votes are written before proposals, which are written before any other message.
 func (p *Peer) SendRaw(msgcode uint64, data []byte) error {
-	return p2p.SendRaw(p.rw, msgcode, data)
+	return p.queue.enqueue(msgcode, data)
+}
+
+// QueueLen returns the number of outgoing messages of the given priority waiting to be sent.
+func (p *Peer) QueueLen(prio Priority) int {
+	return p.queue.len(prio)
 }
 
 // Version retrieves the peer's negoatiated `acn` protocol version.
@@ -67,7 +148,18 @@ func (p *Peer) Version() uint {
 
 // ConsensusPeerInfo gathers and returns some `acn` protocol metadata known about a peer.
 func (p *Peer) ConsensusPeerInfo() *peerInfo {
-	return &peerInfo{
+	queues := make(map[string]int, numPriorities)
+	for prio, name := range priorityNames {
+		queues[name] = p.queue.len(Priority(prio))
+	}
+	info := &peerInfo{
 		Version: p.Version(),
+		Queues:  queues,
+	}
+	if power := p.VotingPower(); power != nil {
+		validator := p.Validator()
+		info.Validator = &validator
+		info.VotingPower = new(big.Int).Set(power)
 	}
+	return info
 }
@@ -1,15 +1,18 @@
 package protocol
 
 import (
+	"crypto/ecdsa"
 	"errors"
 
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/core/forkid"
+	"github.com/autonity/autonity/crypto/blst"
 )
 
 // Constants to match up protocol versions and messages
 const (
 	ACNv1 = 1
+	ACNv2 = 2 // authenticates the peers with the AuthMsg following the status message, adds the block parts
 )
 
 // ProtocolName is the official short name of the autonity consensus network protocol used during
@@ -17,24 +20,28 @@ const (
 const ProtocolName = "acn"
 
 // ProtocolVersions are the supported versions of the `snap` protocol (first
-// is primary).
-var ProtocolVersions = []uint{ACNv1}
+// is primary). ACNv1 is kept until a fork requires the consensus key proof, so that
+// the committee keeps its quorum while its members upgrade one after the other.
+var ProtocolVersions = []uint{ACNv2, ACNv1}
 
-// todo(piyush): length for ACN should be 6 because of 1 status message(0x00) and
-// and 5 protocol message which have legacy codes(staring from 0x11) i.e. length 22 for now.
+// todo(piyush): length for ACN should be 7 because of 1 status message(0x00) and
+// and 6 protocol message which have legacy codes(staring from 0x11) i.e. length 23 for now.
 // protocolLengths are the number of implemented message corresponding to
 // different protocol versions.
-var protocolLengths = map[uint]uint64{ACNv1: 22}
+var protocolLengths = map[uint]uint64{ACNv2: 23, ACNv1: 22}
 
 // MaxMessageSize is the maximum cap on the size of a consensus protocol message.
 const MaxMessageSize = 10 * 1024 * 1024
 
 const (
 	StatusMsg = 0x00
+	AuthMsg   = 0x01
 )
 
 var (
 	errNoStatusMsg             = errors.New("no status message")
+	errNoAuthMsg               = errors.New("no authentication message")
+	errAuthFailed              = errors.New("peer authentication failed")
 	errMsgTooLarge             = errors.New("message too long")
 	errDecode                  = errors.New("invalid message")
 	errInvalidMsgCode          = errors.New("invalid message code")
@@ -44,10 +51,25 @@ var (
 	errForkIDRejected          = errors.New("fork ID rejected")
 )
 
+// Credentials are the local keys used to authenticate the node during the handshake.
+type Credentials struct {
+	NodeKey      *ecdsa.PrivateKey
+	ConsensusKey blst.SecretKey
+}
+
 // StatusPacket is the network packet for the status message for eth/64 and later.
 type StatusPacket struct {
 	ProtocolVersion uint32
 	NetworkID       uint64
 	Genesis         common.Hash
 	ForkID          forkid.ID
+	Nonce           common.Hash `rlp:"optional"` // random challenge to be signed by the remote peer, from ACNv2
+}
+
+// AuthPacket is the network packet proving that the peer controls both its node key
+// and the consensus key it claims, by signing the challenge sent in our status message.
+type AuthPacket struct {
+	ConsensusKey       []byte // BLS public key of the peer
+	NodeSignature      []byte // challenge signed with the node key
+	ConsensusSignature []byte // challenge signed with the consensus key
 }
@@ -0,0 +1,248 @@
+package protocol
+
+import (
+	"container/list"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/autonity/autonity/consensus/tendermint/backend"
+	"github.com/autonity/autonity/metrics"
+	"github.com/autonity/autonity/p2p"
+	"github.com/autonity/autonity/rlp"
+)
+
+// Priority is the send priority of an outgoing consensus message. Messages of a
+// higher priority are always written to the wire before any lower priority one.
+type Priority int
+
+const (
+	VotePriority     Priority = iota // prevotes and precommits
+	ProposalPriority                 // proposals, which carry full blocks
+	SyncPriority                     // sync requests, accountability and everything else
+	numPriorities
+)
+
+// queueSizes are the maximum number of pending messages per priority and per peer.
+var queueSizes = [numPriorities]int{
+	VotePriority:     1024,
+	ProposalPriority: 32,
+	SyncPriority:     256,
+}
+
+var priorityNames = [numPriorities]string{
+	VotePriority:     "vote",
+	ProposalPriority: "proposal",
+	SyncPriority:     "sync",
+}
+
+var errQueueFull = errors.New("send queue full")
+
+var (
+	queueDepthGauges  [numPriorities]metrics.Gauge
+	queueLatencyTimer [numPriorities]metrics.Timer
+	queueDropMeters   [numPriorities]metrics.Meter
+	queueStaleMeters  [numPriorities]metrics.Meter
+)
+
+func init() {
+	for i, name := range priorityNames {
+		queueDepthGauges[i] = metrics.NewRegisteredGauge("acn/queue/"+name+"/depth", nil)
+		queueLatencyTimer[i] = metrics.NewRegisteredTimer("acn/queue/"+name+"/latency", nil)
+		queueDropMeters[i] = metrics.NewRegisteredMeter("acn/queue/"+name+"/dropped", nil)
+		queueStaleMeters[i] = metrics.NewRegisteredMeter("acn/queue/"+name+"/stale", nil)
+	}
+}
+
+// msgPriority returns the priority of the given message code.
+func msgPriority(code uint64) Priority {
+	switch code {
+	case backend.PrevoteNetworkMsg, backend.PrecommitNetworkMsg:
+		return VotePriority
+	case backend.ProposeNetworkMsg, backend.BlockPartNetworkMsg:
+		return ProposalPriority
+	default:
+		return SyncPriority
+	}
+}
+
+// msgHeight returns the consensus height a message is bound to, or zero if the
+// message is not bound to any height. All the tendermint messages are encoded
+// as a list starting with the code, the round and the height. Block parts start
+// with the proposal parts announcement, which follows the same layout.
+func msgHeight(code uint64, payload []byte) uint64 {
+	if msgPriority(code) == SyncPriority {
+		return 0
+	}
+	content, _, err := rlp.SplitList(payload)
+	if err != nil {
+		return 0
+	}
+	if code == backend.BlockPartNetworkMsg {
+		if content, _, err = rlp.SplitList(content); err != nil {
+			return 0
+		}
+	}
+	var height uint64
+	for i := 0; i < 3; i++ {
+		if height, content, err = rlp.SplitUint64(content); err != nil {
+			return 0
+		}
+	}
+	return height
+}
+
+type queuedMsg struct {
+	code     uint64
+	payload  []byte
+	height   uint64
+	enqueued time.Time
+}
+
+// sendQueue holds the outgoing messages of a peer in bounded per-priority FIFO
+// queues, which are drained by a single writer in priority order. Messages bound
+// to an already decided height are dropped rather than sent.
+type sendQueue struct {
+	rw     p2p.MsgReadWriter
+	head   func() uint64 // current chain head number, heights up to it are decided
+	onFail func(error)
+
+	mu     sync.Mutex
+	queues [numPriorities]*list.List
+	wake   chan struct{}
+	closed chan struct{}
+	once   sync.Once
+}
+
+func newSendQueue(rw p2p.MsgReadWriter, head func() uint64, onFail func(error)) *sendQueue {
+	q := &sendQueue{
+		rw:     rw,
+		head:   head,
+		onFail: onFail,
+		wake:   make(chan struct{}, 1),
+		closed: make(chan struct{}),
+	}
+	for i := range q.queues {
+		q.queues[i] = list.New()
+	}
+	go q.loop()
+	return q
+}
+
+// enqueue schedules a message to be sent. If the queue of the message priority
+// is full, stale messages are evicted first and the new message is dropped if no
+// room could be made.
+func (q *sendQueue) enqueue(code uint64, payload []byte) error {
+	select {
+	case <-q.closed:
+		return p2p.ErrShuttingDown
+	default:
+	}
+	prio := msgPriority(code)
+	msg := &queuedMsg{code: code, payload: payload, height: msgHeight(code, payload), enqueued: time.Now()}
+	if q.isStale(msg) {
+		queueStaleMeters[prio].Mark(1)
+		return nil
+	}
+	q.mu.Lock()
+	queue := q.queues[prio]
+	if queue.Len() >= queueSizes[prio] {
+		q.evictStale(prio)
+	}
+	if queue.Len() >= queueSizes[prio] {
+		q.mu.Unlock()
+		queueDropMeters[prio].Mark(1)
+		return errQueueFull
+	}
+	queue.PushBack(msg)
+	q.mu.Unlock()
+	queueDepthGauges[prio].Inc(1)
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (q *sendQueue) isStale(msg *queuedMsg) bool {
+	return msg.height != 0 && q.head != nil && msg.height <= q.head()
+}
+
+// evictStale removes the messages bound to decided heights from the given queue.
+// The caller must hold the lock.
+func (q *sendQueue) evictStale(prio Priority) {
+	queue := q.queues[prio]
+	for e := queue.Front(); e != nil; {
+		next := e.Next()
+		if q.isStale(e.Value.(*queuedMsg)) {
+			queue.Remove(e)
+			queueDepthGauges[prio].Dec(1)
+			queueStaleMeters[prio].Mark(1)
+		}
+		e = next
+	}
+}
+
+// pop returns the oldest message of the highest non-empty priority.
+func (q *sendQueue) pop() (*queuedMsg, Priority) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for prio, queue := range q.queues {
+		if front := queue.Front(); front != nil {
+			queue.Remove(front)
+			queueDepthGauges[prio].Dec(1)
+			return front.Value.(*queuedMsg), Priority(prio)
+		}
+	}
+	return nil, numPriorities
+}
+
+func (q *sendQueue) loop() {
+	for {
+		select {
+		case <-q.wake:
+		case <-q.closed:
+			return
+		}
+		for msg, prio := q.pop(); msg != nil; msg, prio = q.pop() {
+			if q.isStale(msg) {
+				queueStaleMeters[prio].Mark(1)
+				continue
+			}
+			queueLatencyTimer[prio].UpdateSince(msg.enqueued)
+			if err := p2p.SendRaw(q.rw, msg.code, msg.payload); err != nil {
+				if q.onFail != nil {
+					q.onFail(err)
+				}
+				q.close()
+				return
+			}
+			select {
+			case <-q.closed:
+				return
+			default:
+			}
+		}
+	}
+}
+
+// len returns the number of pending messages of the given priority.
+func (q *sendQueue) len(prio Priority) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.queues[prio].Len()
+}
+
+// close stops the writer and discards the pending messages.
+func (q *sendQueue) close() {
+	q.once.Do(func() {
+		close(q.closed)
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		for prio, queue := range q.queues {
+			queueDepthGauges[prio].Dec(int64(queue.Len()))
+			queue.Init()
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package protocol
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/backend"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/p2p"
+	"github.com/autonity/autonity/rlp"
+)
+
+func votePayload(t *testing.T, code uint8, height uint64) []byte {
+	payload, err := rlp.EncodeToBytes([]any{code, uint64(0), height, common.Hash{}, []byte{}})
+	require.NoError(t, err)
+	return payload
+}
+
+func waitEmpty(t *testing.T, q *sendQueue, prio Priority) {
+	require.Eventually(t, func() bool { return q.len(prio) == 0 }, time.Second, time.Millisecond)
+}
+
+func TestMsgHeight(t *testing.T) {
+	require.Equal(t, uint64(42), msgHeight(backend.PrevoteNetworkMsg, votePayload(t, message.PrevoteCode, 42)))
+	require.Equal(t, uint64(0), msgHeight(backend.SyncNetworkMsg, votePayload(t, message.PrevoteCode, 42)))
+	require.Equal(t, uint64(0), msgHeight(backend.PrecommitNetworkMsg, []byte{0x01}))
+}
+
+func TestSendQueuePriority(t *testing.T) {
+	local, remote := p2p.MsgPipe()
+	defer local.Close()
+	q := newSendQueue(local, func() uint64 { return 0 }, nil)
+	defer q.close()
+
+	// the first message blocks the writer until it is read
+	require.NoError(t, q.enqueue(backend.SyncNetworkMsg, []byte{0xc0}))
+	waitEmpty(t, q, SyncPriority)
+
+	require.NoError(t, q.enqueue(backend.AccountabilityNetworkMsg, []byte{0xc0}))
+	require.NoError(t, q.enqueue(backend.ProposeNetworkMsg, votePayload(t, message.ProposalCode, 1)))
+	require.NoError(t, q.enqueue(backend.PrevoteNetworkMsg, votePayload(t, message.PrevoteCode, 1)))
+	require.NoError(t, q.enqueue(backend.PrecommitNetworkMsg, votePayload(t, message.PrecommitCode, 1)))
+
+	for _, code := range []uint64{
+		backend.SyncNetworkMsg,
+		backend.PrevoteNetworkMsg,
+		backend.PrecommitNetworkMsg,
+		backend.ProposeNetworkMsg,
+		backend.AccountabilityNetworkMsg,
+	} {
+		msg, err := remote.ReadMsg()
+		require.NoError(t, err)
+		require.Equal(t, code, msg.Code)
+		require.NoError(t, msg.Discard())
+	}
+}
+
+func TestSendQueueStaleHeights(t *testing.T) {
+	local, remote := p2p.MsgPipe()
+	defer local.Close()
+	var head atomic.Uint64
+	head.Store(10)
+	q := newSendQueue(local, head.Load, nil)
+	defer q.close()
+
+	// messages for decided heights are never queued
+	require.NoError(t, q.enqueue(backend.PrevoteNetworkMsg, votePayload(t, message.PrevoteCode, 10)))
+	require.Equal(t, 0, q.len(VotePriority))
+
+	require.NoError(t, q.enqueue(backend.SyncNetworkMsg, []byte{0xc0}))
+	waitEmpty(t, q, SyncPriority)
+	for i := 0; i < queueSizes[VotePriority]; i++ {
+		require.NoError(t, q.enqueue(backend.PrevoteNetworkMsg, votePayload(t, message.PrevoteCode, 11)))
+	}
+	require.ErrorIs(t, q.enqueue(backend.PrecommitNetworkMsg, votePayload(t, message.PrecommitCode, 11)), errQueueFull)
+
+	// once height 11 is decided, the queued votes are evicted to make room for the new ones
+	head.Store(11)
+	require.NoError(t, q.enqueue(backend.PrecommitNetworkMsg, votePayload(t, message.PrecommitCode, 12)))
+	require.Equal(t, 1, q.len(VotePriority))
+
+	for _, code := range []uint64{backend.SyncNetworkMsg, backend.PrecommitNetworkMsg} {
+		msg, err := remote.ReadMsg()
+		require.NoError(t, err)
+		require.Equal(t, code, msg.Code)
+		require.NoError(t, msg.Discard())
+	}
+}
+
+func TestSendQueueClose(t *testing.T) {
+	local, _ := p2p.MsgPipe()
+	q := newSendQueue(local, nil, nil)
+	q.close()
+	require.ErrorIs(t, q.enqueue(backend.SyncNetworkMsg, []byte{0xc0}), p2p.ErrShuttingDown)
+}
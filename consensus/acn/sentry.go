@@ -0,0 +1,189 @@
+package acn
+
+import (
+	"errors"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/acn/protocol"
+	"github.com/autonity/autonity/consensus/tendermint/backend"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/core/vm"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/eth/ethconfig"
+	"github.com/autonity/autonity/p2p/enode"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/params/generated"
+	"github.com/autonity/autonity/rlp"
+)
+
+// number of relayed messages remembered by a sentry node, to relay every message only once
+const inmemoryRelayedMessages = 4096
+
+var errSentryConfig = errors.New("a node can't both be a sentry and run behind sentries")
+
+// topic of the event emitted by the Autonity contract when a validator publishes its endpoints
+var endpointsUpdatedTopic = generated.AutonityAbi.Events["ConsensusEndpointsUpdated"].ID
+
+// consensusEndpoints are the sentry nodes published on-chain by the committee members
+// hiding behind them.
+type consensusEndpoints struct {
+	raw             map[common.Address][]string
+	nodes           map[common.Address][]*enode.Node
+	representatives map[enode.ID]common.Address // committee member represented by each sentry
+}
+
+// parseSentryConfig returns the sentry nodes of the local validator, or the validator
+// protected by the local sentry node.
+func parseSentryConfig(cfg *ethconfig.Config) ([]*enode.Node, *enode.Node, error) {
+	if len(cfg.ConsensusSentries) > 0 && cfg.ConsensusSentryValidator != "" {
+		return nil, nil, errSentryConfig
+	}
+	var sentries []*enode.Node
+	for _, url := range cfg.ConsensusSentries {
+		node, err := enode.ParseACNV4(url)
+		if err != nil {
+			return nil, nil, err
+		}
+		sentries = append(sentries, node)
+	}
+	if cfg.ConsensusSentryValidator == "" {
+		return sentries, nil, nil
+	}
+	protected, err := enode.ParseACNV4(cfg.ConsensusSentryValidator)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, protected, nil
+}
+
+// loadEndpoints reads the endpoints published by the committee members in the given
+// state. Endpoints are only parsed again when a member publishes new ones.
+func (acn *ACN) loadEndpoints(committee types.Committee, state vm.StateDB) {
+	previous := acn.endpoints.Load()
+	endpoints := &consensusEndpoints{
+		raw:             make(map[common.Address][]string),
+		nodes:           make(map[common.Address][]*enode.Node),
+		representatives: make(map[enode.ID]common.Address),
+	}
+	for _, sentry := range acn.sentries {
+		endpoints.representatives[sentry.ID()] = acn.address
+	}
+	for _, member := range committee {
+		raw := vm.ConsensusEndpoints(state, member.Address)
+		if len(raw) == 0 {
+			continue
+		}
+		endpoints.raw[member.Address] = raw
+		nodes := make([]*enode.Node, 0, len(raw))
+		if previous != nil && slices.Equal(previous.raw[member.Address], raw) {
+			nodes = previous.nodes[member.Address]
+		} else {
+			for _, url := range raw {
+				node, err := enode.ParseACNV4(url)
+				if err != nil {
+					acn.log.Debug("Invalid consensus endpoint", "validator", member.Address, "endpoint", url, "err", err)
+					continue
+				}
+				nodes = append(nodes, node)
+			}
+		}
+		endpoints.nodes[member.Address] = nodes
+		for _, node := range nodes {
+			endpoints.representatives[node.ID()] = member.Address
+		}
+	}
+	acn.endpoints.Store(endpoints)
+}
+
+// endpointsUpdated returns whether a committee member may have published new endpoints
+// in the block of the given header.
+func endpointsUpdated(header *types.Header) bool {
+	return types.BloomLookup(header.Bloom, params.AutonityContractAddress) && types.BloomLookup(header.Bloom, endpointsUpdatedTopic)
+}
+
+// representatives returns the committee member each known sentry node stands for.
+func (acn *ACN) representatives() map[enode.ID]common.Address {
+	if endpoints := acn.endpoints.Load(); endpoints != nil {
+		return endpoints.representatives
+	}
+	representatives := make(map[enode.ID]common.Address, len(acn.sentries))
+	for _, sentry := range acn.sentries {
+		representatives[sentry.ID()] = acn.address
+	}
+	return representatives
+}
+
+// resolveEndpoints replaces the committee members hiding behind sentries by their
+// published endpoints. A sentry node connects to its own validator directly.
+func (acn *ACN) resolveEndpoints(committee []*enode.Node) []*enode.Node {
+	endpoints := acn.endpoints.Load()
+	resolved := make([]*enode.Node, 0, len(committee))
+	for _, node := range committee {
+		if acn.protected != nil && node.ID() == acn.protected.ID() {
+			continue
+		}
+		if endpoints != nil {
+			if nodes := endpoints.nodes[crypto.PubkeyToAddress(*node.Pubkey())]; len(nodes) > 0 {
+				resolved = append(resolved, nodes...)
+				continue
+			}
+		}
+		resolved = append(resolved, node)
+	}
+	return resolved
+}
+
+// Relaying implements protocol.Backend.Relaying.
+func (acn *ACN) Relaying() bool {
+	return acn.protected != nil
+}
+
+// Relay implements protocol.Backend.Relay. A sentry forwards the messages of its
+// validator to the rest of the committee, and the messages of the committee to its
+// validator.
+func (acn *ACN) Relay(peer *protocol.Peer, code uint64, payload []byte) {
+	if found, _ := acn.relayed.ContainsOrAdd(crypto.Keccak256Hash(payload), true); found {
+		return
+	}
+	validatorID := acn.protected.ID().String()
+	if peer.ID() == validatorID {
+		if code == backend.BlockPartNetworkMsg && acn.relayBlockPart(payload, validatorID) {
+			return
+		}
+		acn.peers.broadcast(code, payload, validatorID)
+		return
+	}
+	if validator := acn.peers.peer(validatorID); validator != nil {
+		if err := validator.SendRaw(code, payload); err != nil {
+			validator.Log().Debug("Failed to relay consensus message", "err", err)
+		}
+	}
+}
+
+// relayBlockPart forwards a block part of the validator to the committee members it
+// is assigned to only, as the validator would if it were directly connected to them.
+// It returns false if none of them is connected, the part is then broadcast instead.
+func (acn *ACN) relayBlockPart(payload []byte, validatorID string) bool {
+	part := new(message.BlockPart)
+	if err := rlp.DecodeBytes(payload, part); err != nil || part.Parts == nil {
+		return false
+	}
+	total := int(part.Parts.DataShards + part.Parts.ParityShards)
+	receivers := backend.BlockPartReceivers(acn.currentCommittee(), part.Parts.Sender(), part.Index, total)
+	relayed := false
+	for _, p := range acn.peers.find(receivers) {
+		peer := p.(*protocol.Peer)
+		if peer.ID() == validatorID {
+			continue
+		}
+		if err := peer.SendRaw(backend.BlockPartNetworkMsg, payload); err != nil {
+			peer.Log().Debug("Failed to relay block part", "err", err)
+			continue
+		}
+		relayed = true
+	}
+	return relayed
+}
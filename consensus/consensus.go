@@ -149,6 +149,21 @@ type Handler interface {
 	Protocol() (protocolName string, extraMsgCodes uint64)
 }
 
+// MsgVerifier is implemented by the engines able to authenticate a consensus message
+// without handling it, for the messages relayed by sentry nodes.
+type MsgVerifier interface {
+	// VerifyMsg returns the committee member which signed the message.
+	VerifyMsg(code uint64, payload []byte) (common.Address, error)
+}
+
+// SealVerifier is implemented by the engines finalizing the blocks with the
+// committed seals of a quorum of their committee.
+type SealVerifier interface {
+	// VerifyCommittedSeals checks that the committed seals of header come from
+	// a quorum of the committee of its parent.
+	VerifyCommittedSeals(header, parent *types.Header) error
+}
+
 // PoW is a consensus engine based on proof-of-work.
 type PoW interface {
 	Engine
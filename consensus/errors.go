@@ -41,4 +41,12 @@ var (
 	// ErrCommitteeMemberNotFound is returned if the committee member is missing from
 	// the committee set.
 	ErrCommitteeMemberNotFound = errors.New("committee member not found")
+
+	// ErrUnsignedMsg is returned when verifying a consensus message which is not
+	// signed by a committee member, and can't be authenticated on its own.
+	ErrUnsignedMsg = errors.New("unsigned consensus message")
+
+	// ErrUnverifiableMsg is returned when verifying a consensus message for a height
+	// whose committee can't be checked.
+	ErrUnverifiableMsg = errors.New("unverifiable consensus message")
 )
@@ -217,7 +217,11 @@ func (c *MisbehaviourVerifier) validateFault(p *Proof) []byte {
 		valid = c.validMisbehaviourOfC(p)
 	case autonity.InvalidProposer:
 		if lightProposal, ok := p.Message.(*message.LightProposal); ok {
-			valid = !isProposerValid(c.chain, lightProposal)
+			if beaconElected(c.chain, lightProposal) {
+				valid = errors.Is(checkProposer(c.chain, lightProposal), errProposer)
+			} else {
+				valid = !isProposerValid(c.chain, lightProposal)
+			}
 		}
 	case autonity.Equivocation:
 		valid = errors.Is(checkEquivocation(p.Message, p.Evidences), errEquivocation)
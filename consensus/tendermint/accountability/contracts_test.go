@@ -723,6 +723,30 @@ func TestMisbehaviourVerifier(t *testing.T) {
 	})
 }
 
+func TestInvalidProposerRandomBeaconFork(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	height := uint64(100)
+	chainMock := NewMockChainContext(ctrl)
+	// the proposer can't be elected without the parent header
+	chainMock.EXPECT().GetHeaderByNumber(height - 1).AnyTimes().Return(nil)
+
+	proposal := newProposalMessage(height, 1, -1, signer, committee, nil).MustVerify(stubVerifier)
+	p := &Proof{Rule: autonity.InvalidProposer, Message: proposal.ToLight()}
+	mv := MisbehaviourVerifier{chain: chainMock}
+
+	t.Run("before the fork, an unknown election proves the fault", func(t *testing.T) {
+		chainMock.EXPECT().Config().Return(&params.ChainConfig{ChainID: common.Big1})
+		assert.Equal(t, validReturn(p.Message, p.Rule), mv.validateFault(p))
+	})
+
+	t.Run("after the fork, an unknown election doesn't prove the fault", func(t *testing.T) {
+		chainMock.EXPECT().Config().Return(&params.ChainConfig{ChainID: common.Big1, RandomBeaconBlock: common.Big0})
+		assert.Equal(t, failureReturn, mv.validateFault(p))
+	})
+}
+
 func TestInnocenceVerifier(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
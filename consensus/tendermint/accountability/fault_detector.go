@@ -1283,9 +1283,16 @@ func (fd *FaultDetector) checkSelfIncriminatingProposal(proposal *message.Propos
 	}
 
 	// account for wrong proposer.
-	if !isProposerValid(fd.blockchain, proposal) {
-		fd.submitMisbehavior(message.NewLightProposal(proposal), nil, errProposer)
-		return errProposer
+	if !beaconElected(fd.blockchain, proposal) {
+		if !isProposerValid(fd.blockchain, proposal) {
+			fd.submitMisbehavior(message.NewLightProposal(proposal), nil, errProposer)
+			return errProposer
+		}
+	} else if err := checkProposer(fd.blockchain, proposal); err != nil {
+		if errors.Is(err, errProposer) {
+			fd.submitMisbehavior(message.NewLightProposal(proposal), nil, errProposer)
+		}
+		return err
 	}
 
 	// account for equivocation
@@ -1372,3 +1379,25 @@ func isProposerValid(chain ChainContext, m message.Msg) bool {
 	}
 	return m.Sender() == proposer
 }
+
+// beaconElected reports whether the proposer of the message height is elected
+// from the random beacon of the parent header.
+func beaconElected(chain ChainContext, m message.Msg) bool {
+	return chain.Config().IsRandomBeacon(new(big.Int).SetUint64(m.H() - 1))
+}
+
+// checkProposer returns errProposer if the sender of the message is not the
+// elected proposer of its height and round. After the random beacon fork the
+// election depends on the beacon of the parent header, a proposer cannot be
+// blamed while that header is unknown, so lookup failures are returned as is.
+func checkProposer(chain ChainContext, m message.Msg) error {
+	proposer, err := getProposer(chain, m.H(), m.R())
+	if err != nil {
+		log.Error("get proposer err", "err", err)
+		return err
+	}
+	if m.Sender() != proposer {
+		return errProposer
+	}
+	return nil
+}
@@ -17,15 +17,27 @@
 package backend
 
 import (
+	"errors"
+
 	"github.com/autonity/autonity/accounts/abi"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/params"
 	"github.com/autonity/autonity/rpc"
 )
 
+// maxFinalityProofRange is the maximum number of headers scanned for committee
+// changes by a finality proof. Verifiers further behind chain several proofs,
+// each one trusting the header proven by the previous one.
+var maxFinalityProofRange uint64 = 8192
+
+// errFinalityProofRange is returned if the trusted block of a finality proof is
+// more than maxFinalityProofRange blocks before the proven block.
+var errFinalityProofRange = errors.New("trusted block too far behind")
+
 // API is a user facing RPC API to dump BFT state
 type API struct {
 	chain        consensus.ChainReader
@@ -59,6 +71,57 @@ func (api *API) GetCommitteeAtHash(hash common.Hash) (types.Committee, error) {
 	return committee, nil
 }
 
+// GetFinalityProof returns the proof that the block at the given number was
+// finalised: its header, carrying the committed seals, and the committee which
+// signed them. If a trusted block is given, the proof also holds the headers
+// changing the committee since the committee of the trusted block, so that
+// the proof can be checked from that committee with the finality package. The
+// trusted block can be at most maxFinalityProofRange blocks before the block.
+func (api *API) GetFinalityProof(number rpc.BlockNumber, trusted *rpc.BlockNumber) (*finality.Proof, error) {
+	header := api.headerByNumber(number)
+	if header == nil || header.IsGenesis() {
+		return nil, errUnknownBlock
+	}
+	committee, err := api.getCommittee(header, api.chain)
+	if err != nil {
+		return nil, err
+	}
+	proof := &finality.Proof{Header: header, Committee: committee, Epochs: []*types.Header{}}
+	if trusted == nil {
+		return proof, nil
+	}
+	trustedHeader := api.headerByNumber(*trusted)
+	if trustedHeader == nil || trustedHeader.Number.Cmp(header.Number) >= 0 {
+		return nil, errUnknownBlock
+	}
+	if header.Number.Uint64()-trustedHeader.Number.Uint64() > maxFinalityProofRange {
+		return nil, errFinalityProofRange
+	}
+	// the committee changes with the headers ending an epoch, each one signed
+	// by the committee of the previous epoch
+	previous := trustedHeader
+	for n := trustedHeader.Number.Uint64() + 1; n < header.Number.Uint64(); n++ {
+		epoch := api.chain.GetHeaderByNumber(n)
+		if epoch == nil {
+			return nil, errUnknownBlock
+		}
+		if !epoch.Committee.Equal(previous.Committee) {
+			proof.Epochs = append(proof.Epochs, epoch)
+			previous = epoch
+		}
+	}
+	return proof, nil
+}
+
+// headerByNumber returns the header at the given number, the current one for
+// the latest and pending blocks.
+func (api *API) headerByNumber(number rpc.BlockNumber) *types.Header {
+	if number < 0 {
+		return api.chain.CurrentHeader()
+	}
+	return api.chain.GetHeaderByNumber(uint64(number))
+}
+
 // Get Autonity contract address
 func (api *API) GetContractAddress() common.Address {
 	return params.AutonityContractAddress
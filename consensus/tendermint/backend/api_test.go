@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"crypto/ecdsa"
 	"math/big"
 	"testing"
 
@@ -9,7 +10,9 @@ import (
 
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
 	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
 	"github.com/autonity/autonity/params"
 	"github.com/autonity/autonity/params/generated"
 	"github.com/autonity/autonity/rpc"
@@ -113,3 +116,84 @@ func TestAPIGetContractAddress(t *testing.T) {
 	got := API.GetContractAddress()
 	assert.Equal(t, want, got)
 }
+
+func TestGetFinalityProof(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newCommittee := func() (types.Committee, *ecdsa.PrivateKey) {
+		key, err := crypto.GenerateKey()
+		assert.NoError(t, err)
+		return types.Committee{{Address: crypto.PubkeyToAddress(key.PublicKey), VotingPower: common.Big1}}, key
+	}
+	trusted, trustedKey := newCommittee()
+	next, nextKey := newCommittee()
+
+	// the committee changes with the header 3, the following ones are signed
+	// by the new committee
+	headers := make([]*types.Header, 6)
+	for i := range headers {
+		header := &types.Header{Number: big.NewInt(int64(i)), Committee: trusted}
+		key := trustedKey
+		if i >= 3 {
+			header.Committee = next
+		}
+		if i > 3 {
+			key = nextKey
+		}
+		seal, err := crypto.Sign(finality.SealHash(header.Hash(), int64(header.Round), header.Number).Bytes(), key)
+		assert.NoError(t, err)
+		header.CommittedSeals = [][]byte{seal}
+		headers[i] = header
+	}
+	c := consensus.NewMockChainReader(ctrl)
+	c.EXPECT().GetHeaderByNumber(gomock.Any()).DoAndReturn(func(n uint64) *types.Header {
+		if n < uint64(len(headers)) {
+			return headers[n]
+		}
+		return nil
+	}).AnyTimes()
+	c.EXPECT().CurrentHeader().Return(headers[5]).AnyTimes()
+	api := &API{
+		chain: c,
+		getCommittee: func(header *types.Header, _ consensus.ChainReader) (types.Committee, error) {
+			return headers[header.Number.Uint64()-1].Committee, nil
+		},
+	}
+
+	proof, err := api.GetFinalityProof(rpc.LatestBlockNumber, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, headers[5], proof.Header)
+	assert.Equal(t, next, proof.Committee)
+	assert.Empty(t, proof.Epochs)
+	assert.NoError(t, finality.Verify(proof, next))
+
+	trustedNumber := rpc.BlockNumber(1)
+	proof, err = api.GetFinalityProof(5, &trustedNumber)
+	assert.NoError(t, err)
+	assert.Equal(t, []*types.Header{headers[3]}, proof.Epochs)
+	assert.NoError(t, finality.Verify(proof, trusted))
+
+	// no committee change before the header 3
+	proof, err = api.GetFinalityProof(3, &trustedNumber)
+	assert.NoError(t, err)
+	assert.Empty(t, proof.Epochs)
+	assert.NoError(t, finality.Verify(proof, trusted))
+
+	_, err = api.GetFinalityProof(0, nil)
+	assert.Equal(t, errUnknownBlock, err)
+	_, err = api.GetFinalityProof(6, nil)
+	assert.Equal(t, errUnknownBlock, err)
+	trustedNumber = 3
+	_, err = api.GetFinalityProof(3, &trustedNumber)
+	assert.Equal(t, errUnknownBlock, err)
+
+	// the headers scanned for committee changes are bounded
+	defer func(limit uint64) { maxFinalityProofRange = limit }(maxFinalityProofRange)
+	maxFinalityProofRange = 3
+	trustedNumber = 1
+	_, err = api.GetFinalityProof(5, &trustedNumber)
+	assert.Equal(t, errFinalityProofRange, err)
+	_, err = api.GetFinalityProof(4, &trustedNumber)
+	assert.NoError(t, err)
+}
@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"errors"
 	"sync"
@@ -23,6 +24,7 @@ import (
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/core/vm"
 	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/crypto/blst"
 	"github.com/autonity/autonity/event"
 	"github.com/autonity/autonity/log"
 )
@@ -43,6 +45,7 @@ var (
 
 // New creates an Ethereum Backend for BFT core engine.
 func New(privateKey *ecdsa.PrivateKey,
+	consensusKey blst.SecretKey,
 	vmConfig *vm.Config,
 	services *interfaces.Services,
 	evMux *event.TypeMux,
@@ -51,18 +54,23 @@ func New(privateKey *ecdsa.PrivateKey,
 
 	recentMessages, _ := lru.NewARC(inmemoryPeers)
 	knownMessages, _ := lru.NewARC(inmemoryMessages)
+	blockParts, _ := lru.New(inmemoryBlockParts)
+	partedProposals, _ := lru.New(inmemoryPartedProposals)
 
 	backend := &Backend{
-		eventMux:       event.NewTypeMuxSilent(evMux, log),
-		privateKey:     privateKey,
-		address:        crypto.PubkeyToAddress(privateKey.PublicKey),
-		logger:         log,
-		coreStarted:    false,
-		recentMessages: recentMessages,
-		knownMessages:  knownMessages,
-		vmConfig:       vmConfig,
-		MsgStore:       ms,
-		jailed:         make(map[common.Address]uint64),
+		eventMux:        event.NewTypeMuxSilent(evMux, log),
+		privateKey:      privateKey,
+		consensusKey:    consensusKey,
+		address:         crypto.PubkeyToAddress(privateKey.PublicKey),
+		logger:          log,
+		coreStarted:     false,
+		recentMessages:  recentMessages,
+		knownMessages:   knownMessages,
+		blockParts:      blockParts,
+		partedProposals: partedProposals,
+		vmConfig:        vmConfig,
+		MsgStore:        ms,
+		jailed:          make(map[common.Address]uint64),
 	}
 
 	backend.pendingMessages.SetCapacity(ringCapacity)
@@ -81,6 +89,7 @@ func New(privateKey *ecdsa.PrivateKey,
 type Backend struct {
 	eventMux     *event.TypeMuxSilent
 	privateKey   *ecdsa.PrivateKey
+	consensusKey blst.SecretKey // signs the random beacon of the proposed blocks
 	address      common.Address
 	logger       log.Logger
 	blockchain   *core.BlockChain
@@ -110,6 +119,10 @@ type Backend struct {
 	recentMessages *lru.ARCCache // the cache of peer's messages
 	knownMessages  *lru.ARCCache // the cache of self messages
 
+	blockPartsMinSize uint64     // minimum size of the local proposals propagated as block parts, 0 if disabled
+	blockParts        *lru.Cache // erasure-coded proposals being rebuilt
+	partedProposals   *lru.Cache // proposals propagated as block parts
+
 	contractsMu sync.RWMutex //todo(youssef): is that necessary?
 	vmConfig    *vm.Config
 
@@ -128,12 +141,14 @@ func (sb *Backend) Address() common.Address {
 }
 
 // Broadcast implements tendermint.Backend.Broadcast
-func (sb *Backend) Broadcast(committee types.Committee, message message.Msg) {
-	// send to others
-	sb.Gossip(committee, message)
+func (sb *Backend) Broadcast(committee types.Committee, msg message.Msg) {
+	// send to others, large proposals might be erasure coded
+	if proposal, ok := msg.(*message.Propose); !ok || !sb.gossipBlockParts(committee, proposal) {
+		sb.Gossip(committee, msg)
+	}
 	// send to self
 	go sb.Post(events.MessageEvent{
-		Message: message,
+		Message: msg,
 	})
 }
 
@@ -143,6 +158,10 @@ func (sb *Backend) AskSync(header *types.Header) {
 
 // Gossip implements tendermint.Backend.Gossip
 func (sb *Backend) Gossip(committee types.Committee, msg message.Msg) {
+	// proposals propagated as block parts are already relayed part by part
+	if _, ok := msg.(*message.Propose); ok && sb.partedProposals.Contains(msg.Hash()) {
+		return
+	}
 	sb.gossiper.Gossip(committee, msg)
 }
 
@@ -298,7 +317,8 @@ func (sb *Backend) VerifyProposal(proposal *types.Block) (time.Duration, error)
 
 		for i := range committee {
 			if header.Committee[i].Address != committee[i].Address ||
-				header.Committee[i].VotingPower.Cmp(committee[i].VotingPower) != 0 {
+				header.Committee[i].VotingPower.Cmp(committee[i].VotingPower) != 0 ||
+				!bytes.Equal(header.Committee[i].ConsensusKey, committee[i].ConsensusKey) {
 				sb.logger.Error("wrong committee member in the set",
 					"index", i,
 					"currentVerifier", sb.address.String(),
@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"os"
 	"reflect"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -166,6 +167,69 @@ func TestGossip(t *testing.T) {
 	}
 }
 
+func TestGossipBlockParts(t *testing.T) {
+	header := newTestHeader(7)
+	committee := header.Committee
+	proposer := committee[2].Address
+	parts := make([]*message.BlockPart, 4)
+	for i := range parts {
+		parts[i] = &message.BlockPart{Index: uint32(i)}
+	}
+	targets := make(map[common.Address]struct{})
+	for _, val := range committee {
+		if val.Address != proposer {
+			targets[val.Address] = struct{}{}
+		}
+	}
+	gossip := func(t *testing.T, peers map[common.Address]ethereum.Peer) {
+		ctrl := gomock.NewController(t)
+		broadcaster := consensus.NewMockBroadcaster(ctrl)
+		broadcaster.EXPECT().FindPeers(targets).Return(peers)
+		gossiper := NewGossiper(nil, nil, proposer, log.New(), make(chan struct{}))
+		gossiper.SetBroadcaster(broadcaster)
+		gossiper.GossipBlockParts(committee, parts)
+	}
+
+	t.Run("direct peers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		var sent sync.WaitGroup
+		peers := make(map[common.Address]ethereum.Peer)
+		i := 0
+		for _, val := range committee {
+			if val.Address == proposer {
+				continue
+			}
+			// the members past the last part are assigned the parts again from the first one
+			require.Contains(t, BlockPartReceivers(committee, proposer, uint32(i%len(parts)), len(parts)), val.Address)
+			peer := tendermint.NewMockPeer(ctrl)
+			peer.EXPECT().Send(BlockPartNetworkMsg, parts[i%len(parts)]).Do(func(_, _ any) { sent.Done() }).Times(1)
+			peers[val.Address] = peer
+			sent.Add(1)
+			i++
+		}
+		gossip(t, peers)
+		sent.Wait()
+	})
+
+	t.Run("sentried proposer", func(t *testing.T) {
+		// the peers of a validator behind sentries are its sentries, which don't stand
+		// for any committee member and get every part to forward.
+		ctrl := gomock.NewController(t)
+		var sent sync.WaitGroup
+		peers := make(map[common.Address]ethereum.Peer)
+		for i := 0; i < 2; i++ {
+			peer := tendermint.NewMockPeer(ctrl)
+			for _, part := range parts {
+				peer.EXPECT().Send(BlockPartNetworkMsg, part).Do(func(_, _ any) { sent.Done() }).Times(1)
+				sent.Add(1)
+			}
+			peers[common.Address{byte(i + 1)}] = peer
+		}
+		gossip(t, peers)
+		sent.Wait()
+	})
+}
+
 func TestVerifyProposal(t *testing.T) {
 	blockchain, backend := newBlockChain(1)
 	blocks := make([]*types.Block, 5)
@@ -474,7 +538,7 @@ func newBlockChain(n int) (*core.BlockChain, *Backend) {
 	memDB := rawdb.NewMemoryDatabase()
 	msgStore := new(tdmcore.MsgStore)
 	// Use the first key as private key
-	b := New(nodeKeys[0], &vm.Config{}, nil, new(event.TypeMux), msgStore, log.Root())
+	b := New(nodeKeys[0], nil, &vm.Config{}, nil, new(event.TypeMux), msgStore, log.Root())
 	log.Root().SetHandler(log.LvlFilterHandler(log.LvlTrace, log.StreamHandler(os.Stderr, log.TerminalFormat(true))))
 
 	genesis.MustCommit(memDB)
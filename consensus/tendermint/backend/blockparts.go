@@ -0,0 +1,124 @@
+package backend
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/consensus/tendermint/erasure"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/p2p"
+)
+
+const (
+	// number of erasure-coded proposals which can be rebuilt at the same time
+	inmemoryBlockParts = 64
+	// number of proposals propagated as block parts remembered, to avoid gossiping them in full
+	inmemoryPartedProposals = 128
+)
+
+// partsSet collects the shards of an erasure-coded proposal until it can be rebuilt.
+type partsSet struct {
+	sync.Mutex
+	parts  *message.ProposalParts
+	shards [][]byte
+	count  int
+	done   bool
+}
+
+// EnableBlockParts makes the local node propagate its proposals as erasure-coded
+// block parts when their encoded size is at least minSize bytes. Zero disables it.
+// Block parts sent by other proposers are always accepted.
+func (sb *Backend) EnableBlockParts(minSize uint64) {
+	sb.blockPartsMinSize = minSize
+}
+
+// gossipBlockParts propagates the local proposal as erasure-coded block parts, and
+// returns false if the proposal should be gossiped in full instead.
+func (sb *Backend) gossipBlockParts(committee types.Committee, proposal *message.Propose) bool {
+	if sb.blockPartsMinSize == 0 || uint64(len(proposal.Payload())) < sb.blockPartsMinSize {
+		return false
+	}
+	dataShards, parityShards := erasure.ShardsFor(len(committee) - 1)
+	// with less than two data shards erasure coding does not save any bandwidth
+	if dataShards < 2 {
+		return false
+	}
+	parts, err := message.NewBlockParts(proposal, dataShards, parityShards, sb.Sign)
+	if err != nil {
+		sb.logger.Error("Failed to erasure code proposal", "err", err)
+		return false
+	}
+	sb.partedProposals.Add(proposal.Hash(), true)
+	sb.gossiper.GossipBlockParts(committee, parts)
+	return true
+}
+
+// proposers returns the proposer election for the height following the given header.
+func (sb *Backend) proposers(header *types.Header) func(height uint64, round int64) common.Address {
+	return func(_ uint64, round int64) common.Address {
+		return sb.blockchain.ProtocolContracts().Proposer(header, nil, header.Number.Uint64(), round)
+	}
+}
+
+// handleBlockPart collects a block part of a current height proposal. Parts coming
+// from the proposer are relayed to the rest of the committee and the proposal is
+// handled as any other once enough parts have been collected.
+func (sb *Backend) handleBlockPart(sender common.Address, part *message.BlockPart) error {
+	if part.Parts == nil {
+		return errDecodeFailed
+	}
+	header := sb.currentBlock().Header()
+	if part.Parts.Height != header.Number.Uint64()+1 {
+		// old parts are useless and future ones can't be verified yet.
+		return nil
+	}
+	key := part.Parts.Hash()
+	var set *partsSet
+	if cached, ok := sb.blockParts.Get(key); ok {
+		set = cached.(*partsSet)
+	} else {
+		if err := part.Parts.Validate(sb.proposers(header)); err != nil {
+			return err
+		}
+		set = &partsSet{
+			parts:  part.Parts,
+			shards: make([][]byte, part.Parts.DataShards+part.Parts.ParityShards),
+		}
+		if previous, ok, _ := sb.blockParts.PeekOrAdd(key, set); ok {
+			set = previous.(*partsSet)
+		}
+	}
+	set.Lock()
+	defer set.Unlock()
+	part.Parts = set.parts
+	if err := part.Verify(); err != nil {
+		return err
+	}
+	if set.done || set.shards[part.Index] != nil {
+		return nil
+	}
+	set.shards[part.Index] = part.Data
+	set.count++
+	if sender == set.parts.Sender() {
+		sb.gossiper.RelayBlockPart(header.Committee, part)
+	}
+	if set.count < int(set.parts.DataShards) {
+		return nil
+	}
+	set.done = true
+	proposal, err := message.DecodeBlockParts(set.parts, set.shards)
+	set.shards = nil
+	if err != nil {
+		// the shards were signed by the proposer, the relaying peer is not at fault.
+		sb.logger.Warn("Failed to rebuild proposal from block parts", "proposer", set.parts.Sender(), "err", err)
+		return nil
+	}
+	sb.partedProposals.Add(proposal.Hash(), true)
+	payload := proposal.Payload()
+	msg := p2p.Msg{Code: ProposeNetworkMsg, Size: uint32(len(payload)), Payload: bytes.NewReader(payload)}
+	// the proposer is the one to blame if the proposal turns out to be invalid, not the relaying peer.
+	_, err = handleConsensusMsg[message.Propose](sb, set.parts.Sender(), msg, nil)
+	return err
+}
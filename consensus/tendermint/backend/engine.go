@@ -7,15 +7,13 @@ import (
 	"math/big"
 	"time"
 
-	"github.com/autonity/autonity/consensus/tendermint"
-	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
 	"github.com/autonity/autonity/crypto"
 
 	"github.com/autonity/autonity/autonity"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/misc"
-	"github.com/autonity/autonity/consensus/tendermint/bft"
 	"github.com/autonity/autonity/consensus/tendermint/core/constants"
 	"github.com/autonity/autonity/consensus/tendermint/events"
 	"github.com/autonity/autonity/core"
@@ -58,6 +56,9 @@ var (
 	errInvalidTimestamp = errors.New("invalid timestamp")
 	// errInvalidRound is returned if the round exceed maximum round number.
 	errInvalidRound = errors.New("invalid round")
+	// errMissingConsensusKey is returned when proposing a block after the random
+	// beacon fork without a consensus key to sign the beacon.
+	errMissingConsensusKey = errors.New("missing consensus key")
 )
 var (
 	defaultDifficulty             = big.NewInt(1)
@@ -145,11 +146,11 @@ func (sb *Backend) verifyHeader(chain consensus.ChainHeaderReader, header, paren
 	if parent == nil {
 		return errUnknownBlock
 	}
-	return sb.verifyHeaderAgainstParent(header, parent)
+	return sb.verifyHeaderAgainstParent(chain.Config(), header, parent)
 }
 
 // verifyHeaderAgainstParent verifies that the given header is valid with respect to its parent.
-func (sb *Backend) verifyHeaderAgainstParent(header, parent *types.Header) error {
+func (sb *Backend) verifyHeaderAgainstParent(config *params.ChainConfig, header, parent *types.Header) error {
 	if parent.Number.Uint64() != header.Number.Uint64()-1 || parent.Hash() != header.ParentHash {
 		return consensus.ErrUnknownAncestor
 	}
@@ -160,6 +161,9 @@ func (sb *Backend) verifyHeaderAgainstParent(header, parent *types.Header) error
 	if err := sb.verifySigner(header, parent); err != nil {
 		return err
 	}
+	if err := verifyBeacon(config, header, parent); err != nil {
+		return err
+	}
 
 	return sb.verifyCommittedSeals(header, parent)
 }
@@ -217,58 +221,32 @@ func (sb *Backend) verifySigner(header, parent *types.Header) error {
 	return errUnauthorized
 }
 
+// verifyBeacon checks that headers carry a valid random beacon from the
+// random beacon fork on, and none before.
+func verifyBeacon(config *params.ChainConfig, header, parent *types.Header) error {
+	if !config.IsRandomBeacon(header.Number) {
+		if len(header.Beacon) != 0 {
+			return types.ErrInvalidBeacon
+		}
+		return nil
+	}
+	return header.VerifyBeacon(parent)
+}
+
 // verifyCommittedSeals validates that the committed seals for header come from
 // committee members and that the voting power of the committed seals constitutes
 // a quorum.
 func (sb *Backend) verifyCommittedSeals(header, parent *types.Header) error {
-	// The length of Committed seals should be larger than 0
-	if len(header.CommittedSeals) == 0 {
-		return types.ErrEmptyCommittedSeals
-	}
-
-	// Setup map to track votes made by committee members
-	votes := make(map[common.Address]int, len(parent.Committee))
-
-	// Calculate total voting power
-	committeeVotingPower := new(big.Int)
-	for _, member := range parent.Committee {
-		committeeVotingPower.Add(committeeVotingPower, member.VotingPower)
-	}
-
-	// Total Voting power for this block
-	power := new(big.Int)
-	// The data that was signed over for this block
-	headerSeal := message.PrepareCommittedSeal(header.Hash(), int64(header.Round), header.Number)
-
-	// 1. Get committed seals from current header
-	for _, signedSeal := range header.CommittedSeals {
-		// 2. Get the address from signature
-		addr, err := tendermint.SigToAddr(headerSeal, signedSeal)
-		if err != nil {
-			sb.logger.Error("not a valid address", "err", err)
-			return types.ErrInvalidSignature
-		}
-
-		member := parent.CommitteeMember(addr)
-		if member == nil {
-			sb.logger.Error(fmt.Sprintf("block had seal from non committee member %q", addr))
-			return types.ErrInvalidCommittedSeals
-		}
-
-		votes[member.Address]++
-		if votes[member.Address] > 1 {
-			sb.logger.Error(fmt.Sprintf("committee member %q had multiple seals on block", addr))
-			return types.ErrInvalidCommittedSeals
-		}
-		power.Add(power, member.VotingPower)
-	}
-
-	// We need at least a quorum for the block to be considered valid
-	if power.Cmp(bft.Quorum(committeeVotingPower)) < 0 {
-		return types.ErrInvalidCommittedSeals
+	err := finality.VerifySeals(header, parent.Committee)
+	if err != nil && !errors.Is(err, types.ErrEmptyCommittedSeals) {
+		sb.logger.Error("Invalid committed seals", "number", header.Number, "hash", header.Hash(), "err", err)
 	}
+	return err
+}
 
-	return nil
+// VerifyCommittedSeals implements consensus.SealVerifier.
+func (sb *Backend) VerifyCommittedSeals(header, parent *types.Header) error {
+	return finality.VerifySeals(header, parent.Committee)
 }
 
 // Prepare initializes the consensus fields of a block header according to the
@@ -294,6 +272,14 @@ func (sb *Backend) Prepare(chain consensus.ChainHeaderReader, header *types.Head
 	if int64(header.Time) < time.Now().Unix() {
 		header.Time = uint64(time.Now().Unix())
 	}
+	// sign the beacon of the parent, the next proposers are elected from it
+	header.Beacon = nil
+	if chain.Config().IsRandomBeacon(header.Number) {
+		if sb.consensusKey == nil {
+			return errMissingConsensusKey
+		}
+		header.Beacon = sb.consensusKey.Sign(parent.BeaconMessage()).Marshal()
+	}
 	return nil
 }
 
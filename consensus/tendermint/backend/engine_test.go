@@ -18,6 +18,8 @@ import (
 	"github.com/autonity/autonity/consensus/tendermint/core/interfaces"
 	"github.com/autonity/autonity/consensus/tendermint/events"
 	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto/blst"
+	"github.com/autonity/autonity/params"
 )
 
 func TestPrepare(t *testing.T) {
@@ -34,6 +36,108 @@ func TestPrepare(t *testing.T) {
 	}
 }
 
+func TestPrepareBeacon(t *testing.T) {
+	chain, engine := newBlockChain(1)
+	header := makeHeader(chain.Genesis(), chain)
+	config := *chain.Config()
+	config.RandomBeaconBlock = common.Big1
+	beaconChain := &configChain{ChainHeaderReader: chain, config: &config}
+	if err := engine.Prepare(beaconChain, header); err != errMissingConsensusKey {
+		t.Errorf("error mismatch: have %v, want %v", err, errMissingConsensusKey)
+	}
+	key, err := blst.RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	engine.consensusKey = key
+	if err := engine.Prepare(beaconChain, header); err != nil {
+		t.Fatalf("error mismatch: have %v, want nil", err)
+	}
+	signature, err := blst.SignatureFromBytes(header.Beacon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !signature.Verify(key.PublicKey(), chain.Genesis().Header().BeaconMessage()) {
+		t.Errorf("beacon is not signed over the parent beacon seed")
+	}
+}
+
+func TestVerifyBeacon(t *testing.T) {
+	key, err := blst.RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposer := common.HexToAddress("0x01")
+	parent := &types.Header{
+		Number: common.Big1,
+		Committee: types.Committee{{
+			Address:      proposer,
+			VotingPower:  common.Big1,
+			ConsensusKey: key.PublicKey().Marshal(),
+		}},
+	}
+	header := &types.Header{Number: common.Big2, Coinbase: proposer}
+	config := &params.ChainConfig{RandomBeaconBlock: big.NewInt(3)}
+
+	// no beacon before the fork
+	if err := verifyBeacon(config, header, parent); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	header.Beacon = key.Sign(parent.BeaconMessage()).Marshal()
+	if err := verifyBeacon(config, header, parent); err != types.ErrInvalidBeacon {
+		t.Errorf("error mismatch: have %v, want %v", err, types.ErrInvalidBeacon)
+	}
+
+	config.RandomBeaconBlock = common.Big2
+	if err := verifyBeacon(config, header, parent); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	// the beacon of a block is the seed of the next one
+	child := &types.Header{Number: big.NewInt(3), Coinbase: proposer, Beacon: key.Sign(header.BeaconMessage()).Marshal()}
+	header.Committee = parent.Committee
+	if err := verifyBeacon(config, child, header); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	if err := verifyBeacon(config, child, parent); err != types.ErrInvalidBeacon {
+		t.Errorf("error mismatch: have %v, want %v", err, types.ErrInvalidBeacon)
+	}
+
+	// missing beacon
+	beacon := header.Beacon
+	header.Beacon = nil
+	if err := verifyBeacon(config, header, parent); err != types.ErrInvalidBeacon {
+		t.Errorf("error mismatch: have %v, want %v", err, types.ErrInvalidBeacon)
+	}
+	// beacon signed with another key
+	other, err := blst.RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	header.Beacon = other.Sign(parent.BeaconMessage()).Marshal()
+	if err := verifyBeacon(config, header, parent); err != types.ErrInvalidBeacon {
+		t.Errorf("error mismatch: have %v, want %v", err, types.ErrInvalidBeacon)
+	}
+	// signature over the seed without the beacon domain tag
+	header.Beacon = key.Sign(parent.BeaconSeed().Bytes()).Marshal()
+	if err := verifyBeacon(config, header, parent); err != types.ErrInvalidBeacon {
+		t.Errorf("error mismatch: have %v, want %v", err, types.ErrInvalidBeacon)
+	}
+	// proposer outside of the parent committee
+	header.Beacon = beacon
+	header.Coinbase = common.HexToAddress("0x02")
+	if err := verifyBeacon(config, header, parent); err != types.ErrInvalidBeacon {
+		t.Errorf("error mismatch: have %v, want %v", err, types.ErrInvalidBeacon)
+	}
+}
+
+// configChain overrides the chain config of a header chain.
+type configChain struct {
+	consensus.ChainHeaderReader
+	config *params.ChainConfig
+}
+
+func (c *configChain) Config() *params.ChainConfig { return c.config }
+
 func TestSealCommittedOtherHash(t *testing.T) {
 	chain, engine := newBlockChain(4)
 
@@ -6,6 +6,7 @@ import (
 
 	lru "github.com/hashicorp/golang-lru"
 
+	ethereum "github.com/autonity/autonity"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/tendermint/bft"
@@ -89,6 +90,76 @@ func (g *Gossiper) Gossip(committee types.Committee, message message.Msg) {
 	}
 }
 
+// GossipBlockParts sends a different block part to every other committee member,
+// following the committee order. If there are more members than parts, the parts
+// are assigned again from the first one. The peers which don't stand for a single
+// member are the sentries of the local node: every part is sent to them, and they
+// forward it to the members it is assigned to.
+func (g *Gossiper) GossipBlockParts(committee types.Committee, parts []*message.BlockPart) {
+	if g.broadcaster == nil || len(parts) == 0 {
+		return
+	}
+	targets := make(map[common.Address]struct{})
+	for _, val := range committee {
+		if val.Address != g.address {
+			targets[val.Address] = struct{}{}
+		}
+	}
+	ps := g.broadcaster.FindPeers(targets)
+	for _, part := range parts {
+		for addr := range BlockPartReceivers(committee, g.address, part.Index, len(parts)) {
+			if p, ok := ps[addr]; ok {
+				go p.Send(BlockPartNetworkMsg, part) //nolint
+			}
+		}
+	}
+	for addr, p := range ps {
+		if _, ok := targets[addr]; ok {
+			continue
+		}
+		go func(p ethereum.Peer) {
+			for _, part := range parts {
+				p.Send(BlockPartNetworkMsg, part) //nolint
+			}
+		}(p)
+	}
+}
+
+// BlockPartReceivers returns the committee members the proposer sends the block
+// part of the given index to, out of total parts. The members but the proposer are
+// assigned the parts in committee order, starting again from the first part if
+// there are more members than parts.
+func BlockPartReceivers(committee types.Committee, proposer common.Address, index uint32, total int) map[common.Address]struct{} {
+	receivers := make(map[common.Address]struct{})
+	i := 0
+	for _, val := range committee {
+		if val.Address == proposer {
+			continue
+		}
+		if i%total == int(index) {
+			receivers[val.Address] = struct{}{}
+		}
+		i++
+	}
+	return receivers
+}
+
+// RelayBlockPart forwards a block part received from the proposer to the rest of the committee.
+func (g *Gossiper) RelayBlockPart(committee types.Committee, part *message.BlockPart) {
+	targets := make(map[common.Address]struct{})
+	for _, val := range committee {
+		if val.Address != g.address && val.Address != part.Parts.Sender() {
+			targets[val.Address] = struct{}{}
+		}
+	}
+	if g.broadcaster == nil || len(targets) == 0 {
+		return
+	}
+	for _, p := range g.broadcaster.FindPeers(targets) {
+		go p.Send(BlockPartNetworkMsg, part) //nolint
+	}
+}
+
 func (g *Gossiper) AskSync(header *types.Header) {
 
 	targets := make(map[common.Address]struct{})
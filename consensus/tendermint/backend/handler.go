@@ -12,8 +12,10 @@ import (
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
 	"github.com/autonity/autonity/consensus/tendermint/events"
+	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/crypto"
 	"github.com/autonity/autonity/p2p"
+	"github.com/autonity/autonity/rlp"
 )
 
 const (
@@ -22,6 +24,7 @@ const (
 	PrecommitNetworkMsg      uint64 = 0x13
 	SyncNetworkMsg           uint64 = 0x14
 	AccountabilityNetworkMsg uint64 = 0x15
+	BlockPartNetworkMsg      uint64 = 0x16
 )
 
 type UnhandledMsg struct {
@@ -41,7 +44,7 @@ var (
 
 // Protocol implements consensus.Handler.Protocol
 func (sb *Backend) Protocol() (protocolName string, extraMsgCodes uint64) {
-	return "tendermint", 5 //nolint
+	return "tendermint", 6 //nolint
 }
 
 func (sb *Backend) HandleUnhandledMsgs(ctx context.Context) {
@@ -63,7 +66,7 @@ func (sb *Backend) HandleUnhandledMsgs(ctx context.Context) {
 
 // HandleMsg implements consensus.Handler.HandleMsg
 func (sb *Backend) HandleMsg(addr common.Address, msg p2p.Msg, errCh chan<- error) (bool, error) {
-	if msg.Code < ProposeNetworkMsg || msg.Code > AccountabilityNetworkMsg {
+	if msg.Code < ProposeNetworkMsg || msg.Code > BlockPartNetworkMsg {
 		return false, nil
 	}
 
@@ -98,6 +101,16 @@ func (sb *Backend) HandleMsg(addr common.Address, msg p2p.Msg, errCh chan<- erro
 		// post the off chain accountability msg to the event handler, let the event handler to handle DoS attack vectors.
 		sb.logger.Debug("Received Accountability Msg", "from", addr)
 		go sb.Post(events.AccountabilityEvent{Sender: addr, Payload: data, ErrCh: errCh})
+	case BlockPartNetworkMsg:
+		if !sb.coreStarted {
+			sb.logger.Debug("Block part received but core not running")
+			return true, nil // we return nil as we don't want to shut down the connection if core is stopped
+		}
+		part := new(message.BlockPart)
+		if err := msg.Decode(part); err != nil {
+			return true, errDecodeFailed
+		}
+		return true, sb.handleBlockPart(addr, part)
 	default:
 		return false, nil
 	}
@@ -105,6 +118,56 @@ func (sb *Backend) HandleMsg(addr common.Address, msg p2p.Msg, errCh chan<- erro
 	return true, nil
 }
 
+// VerifyMsg implements consensus.MsgVerifier.VerifyMsg. The messages of the next heights
+// are authenticated against the committee of the current head, which is the right one
+// for the next height, and the latest known for the following ones. Block parts can
+// only be authenticated for the next height, as they are bound to its proposer.
+func (sb *Backend) VerifyMsg(code uint64, payload []byte) (common.Address, error) {
+	header := sb.currentBlock().Header()
+	switch code {
+	case ProposeNetworkMsg:
+		return verifyConsensusMsg[message.Propose](header, payload)
+	case PrevoteNetworkMsg:
+		return verifyConsensusMsg[message.Prevote](header, payload)
+	case PrecommitNetworkMsg:
+		return verifyConsensusMsg[message.Precommit](header, payload)
+	case BlockPartNetworkMsg:
+		part := new(message.BlockPart)
+		if err := rlp.DecodeBytes(payload, part); err != nil || part.Parts == nil {
+			return common.Address{}, errDecodeFailed
+		}
+		if part.Parts.Height != header.Number.Uint64()+1 {
+			return common.Address{}, consensus.ErrUnverifiableMsg
+		}
+		if err := part.Parts.Validate(sb.proposers(header)); err != nil {
+			return common.Address{}, err
+		}
+		if err := part.Verify(); err != nil {
+			return common.Address{}, err
+		}
+		return part.Parts.Sender(), nil
+	default:
+		return common.Address{}, consensus.ErrUnsignedMsg
+	}
+}
+
+func verifyConsensusMsg[T any, PT interface {
+	*T
+	message.Msg
+}](header *types.Header, payload []byte) (common.Address, error) {
+	msg := PT(new(T))
+	if err := rlp.DecodeBytes(payload, msg); err != nil {
+		return common.Address{}, errDecodeFailed
+	}
+	if msg.H() <= header.Number.Uint64() {
+		return common.Address{}, consensus.ErrUnverifiableMsg
+	}
+	if err := msg.Validate(header.CommitteeMember); err != nil {
+		return common.Address{}, err
+	}
+	return msg.Sender(), nil
+}
+
 func handleConsensusMsg[T any, PT interface {
 	*T
 	message.Msg
@@ -5,10 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/tendermint/core/message"
 	"github.com/autonity/autonity/consensus/tendermint/events"
 
 	"github.com/hashicorp/golang-lru"
+	"github.com/stretchr/testify/require"
 
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/event"
@@ -107,7 +109,7 @@ func TestProtocol(t *testing.T) {
 	if name != "tendermint" {
 		t.Fatalf("expected 'tendermint', got %v", name)
 	}
-	if code != 5 {
+	if code != 6 {
 		t.Fatalf("expected 2, got %v", code)
 	}
 }
@@ -139,3 +141,25 @@ func makeMsg(msgcode uint64, data interface{}) p2p.Msg {
 	size, r, _ := rlp.EncodeToReader(data)
 	return p2p.Msg{Code: msgcode, Size: uint32(size), Payload: r}
 }
+
+func TestVerifyMsg(t *testing.T) {
+	_, backend := newBlockChain(1)
+
+	prevote := message.NewPrevote(0, 1, common.Hash{}, backend.Sign)
+	signer, err := backend.VerifyMsg(PrevoteNetworkMsg, prevote.Payload())
+	require.NoError(t, err)
+	require.Equal(t, backend.Address(), signer)
+
+	outsider := message.NewPrecommit(0, 1, common.Hash{}, testSigner)
+	_, err = backend.VerifyMsg(PrecommitNetworkMsg, outsider.Payload())
+	require.ErrorIs(t, err, message.ErrUnauthorizedAddress)
+
+	// future messages are checked against the latest committee
+	future := message.NewPrevote(0, 3, common.Hash{}, backend.Sign)
+	signer, err = backend.VerifyMsg(PrevoteNetworkMsg, future.Payload())
+	require.NoError(t, err)
+	require.Equal(t, backend.Address(), signer)
+
+	_, err = backend.VerifyMsg(SyncNetworkMsg, nil)
+	require.ErrorIs(t, err, consensus.ErrUnsignedMsg)
+}
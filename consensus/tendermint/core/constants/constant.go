@@ -2,4 +2,6 @@ package constants
 
 const (
 	MaxRound = 99 // consequence of backlog priority
+
+	MaxProposalSize = 10 * 1024 * 1024 // matches the maximum size of a consensus network message
 )
@@ -11,6 +11,8 @@ import (
 
 type Gossiper interface {
 	Gossip(committee types.Committee, message message.Msg)
+	GossipBlockParts(committee types.Committee, parts []*message.BlockPart)
+	RelayBlockPart(committee types.Committee, part *message.BlockPart)
 	AskSync(header *types.Header)
 	SetBroadcaster(broadcaster consensus.Broadcaster)
 	Broadcaster() consensus.Broadcaster
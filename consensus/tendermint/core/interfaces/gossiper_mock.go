@@ -95,6 +95,18 @@ func (mr *MockGossiperMockRecorder) Gossip(committee, message any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Gossip", reflect.TypeOf((*MockGossiper)(nil).Gossip), committee, message)
 }
 
+// GossipBlockParts mocks base method.
+func (m *MockGossiper) GossipBlockParts(committee types.Committee, parts []*message.BlockPart) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GossipBlockParts", committee, parts)
+}
+
+// GossipBlockParts indicates an expected call of GossipBlockParts.
+func (mr *MockGossiperMockRecorder) GossipBlockParts(committee, parts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GossipBlockParts", reflect.TypeOf((*MockGossiper)(nil).GossipBlockParts), committee, parts)
+}
+
 // KnownMessages mocks base method.
 func (m *MockGossiper) KnownMessages() *lru.ARCCache {
 	m.ctrl.T.Helper()
@@ -123,6 +135,18 @@ func (mr *MockGossiperMockRecorder) RecentMessages() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecentMessages", reflect.TypeOf((*MockGossiper)(nil).RecentMessages))
 }
 
+// RelayBlockPart mocks base method.
+func (m *MockGossiper) RelayBlockPart(committee types.Committee, part *message.BlockPart) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RelayBlockPart", committee, part)
+}
+
+// RelayBlockPart indicates an expected call of RelayBlockPart.
+func (mr *MockGossiperMockRecorder) RelayBlockPart(committee, part any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayBlockPart", reflect.TypeOf((*MockGossiper)(nil).RelayBlockPart), committee, part)
+}
+
 // SetBroadcaster mocks base method.
 func (m *MockGossiper) SetBroadcaster(broadcaster consensus.Broadcaster) {
 	m.ctrl.T.Helper()
@@ -206,3 +206,14 @@ func FuzzFromPayload(f *testing.F) {
 	})
 
 }
+
+func TestPrepareCommittedSeal(t *testing.T) {
+	// the committed seals are the signatures of the precommits for the block
+	header := &types.Header{Number: big.NewInt(12), Round: 3}
+	var signed common.Hash
+	NewPrecommit(3, 12, header.Hash(), func(hash common.Hash) ([]byte, common.Address) {
+		signed = hash
+		return signer(hash)
+	})
+	require.Equal(t, signed, PrepareCommittedSeal(header.Hash(), int64(header.Round), header.Number))
+}
@@ -0,0 +1,152 @@
+package message
+
+import (
+	"errors"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint"
+	"github.com/autonity/autonity/consensus/tendermint/core/constants"
+	"github.com/autonity/autonity/consensus/tendermint/erasure"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/rlp"
+)
+
+// ProposalPartsCode is the code of the signed light proposal announcing an
+// erasure-coded proposal. It is never processed by the tendermint core directly.
+const ProposalPartsCode uint8 = 4
+
+var ErrInvalidBlockPart = errors.New("invalid block part")
+
+// ProposalParts is a light proposal extended with the Merkle root of the shards of
+// an erasure-coded Propose message. It is signed by the proposer, so the shards can
+// be authenticated one by one before the whole proposal is rebuilt.
+type ProposalParts struct {
+	Code         uint8
+	Round        uint64
+	Height       uint64
+	BlockHash    common.Hash
+	PartsRoot    common.Hash // Merkle root of the data and parity shards
+	DataShards   uint32
+	ParityShards uint32
+	Size         uint64 // size of the encoded Propose message
+	Signature    []byte
+
+	sender common.Address
+}
+
+func (p *ProposalParts) signatureInput() common.Hash {
+	data, _ := rlp.EncodeToBytes([]any{ProposalPartsCode, p.Round, p.Height, p.BlockHash, p.PartsRoot,
+		p.DataShards, p.ParityShards, p.Size})
+	return crypto.Hash(data)
+}
+
+// Sender returns the proposer address, available once the parts have been validated.
+func (p *ProposalParts) Sender() common.Address {
+	return p.sender
+}
+
+// Hash identifies the erasure-coded proposal.
+func (p *ProposalParts) Hash() common.Hash {
+	return p.signatureInput()
+}
+
+// Validate checks the consistency of the parts announcement and recovers its signer,
+// which must be the proposer elected for the height and round of the parts.
+func (p *ProposalParts) Validate(proposer func(height uint64, round int64) common.Address) error {
+	if p.Code != ProposalPartsCode || p.Height == 0 || p.Round > constants.MaxRound {
+		return constants.ErrInvalidMessage
+	}
+	if p.DataShards == 0 || p.DataShards+p.ParityShards > erasure.MaxShards || p.Size == 0 || p.Size > constants.MaxProposalSize {
+		return constants.ErrInvalidMessage
+	}
+	addr, err := tendermint.SigToAddr(p.signatureInput(), p.Signature)
+	if err != nil {
+		return ErrBadSignature
+	}
+	if addr != proposer(p.Height, int64(p.Round)) {
+		return ErrUnauthorizedAddress
+	}
+	p.sender = addr
+	return nil
+}
+
+// Codec returns the erasure codec of the proposal.
+func (p *ProposalParts) Codec() (*erasure.Codec, error) {
+	return erasure.New(int(p.DataShards), int(p.ParityShards))
+}
+
+// BlockPart carries a single shard of an erasure-coded Propose message, with the
+// proof that it belongs to the parts announced by the proposer.
+type BlockPart struct {
+	Parts *ProposalParts
+	Index uint32
+	Data  []byte
+	Proof []common.Hash
+}
+
+// Verify checks the shard against the Merkle root of the validated proposal parts.
+func (b *BlockPart) Verify() error {
+	if b.Parts == nil || b.Index >= b.Parts.DataShards+b.Parts.ParityShards {
+		return ErrInvalidBlockPart
+	}
+	shardSize := (b.Parts.Size + uint64(b.Parts.DataShards) - 1) / uint64(b.Parts.DataShards)
+	if uint64(len(b.Data)) != shardSize {
+		return ErrInvalidBlockPart
+	}
+	if !erasure.VerifyProof(b.Parts.PartsRoot, int(b.Index), b.Data, b.Proof) {
+		return ErrInvalidBlockPart
+	}
+	return nil
+}
+
+// NewBlockParts erasure codes the given proposal and returns one block part per shard.
+// Any dataShards of them are enough to rebuild the proposal.
+func NewBlockParts(proposal *Propose, dataShards, parityShards int, signer Signer) ([]*BlockPart, error) {
+	codec, err := erasure.New(dataShards, parityShards)
+	if err != nil {
+		return nil, err
+	}
+	payload := proposal.Payload()
+	shards, err := codec.Encode(payload)
+	if err != nil {
+		return nil, err
+	}
+	parts := &ProposalParts{
+		Code:         ProposalPartsCode,
+		Round:        uint64(proposal.R()),
+		Height:       proposal.H(),
+		BlockHash:    proposal.Block().Hash(),
+		PartsRoot:    erasure.MerkleRoot(shards),
+		DataShards:   uint32(dataShards),
+		ParityShards: uint32(parityShards),
+		Size:         uint64(len(payload)),
+	}
+	parts.Signature, parts.sender = signer(parts.signatureInput())
+	proofs := erasure.MerkleProofs(shards)
+	blockParts := make([]*BlockPart, len(shards))
+	for i, shard := range shards {
+		blockParts[i] = &BlockPart{Parts: parts, Index: uint32(i), Data: shard, Proof: proofs[i]}
+	}
+	return blockParts, nil
+}
+
+// DecodeBlockParts rebuilds the Propose message from the given shards, indexed by
+// their position. Missing shards are nil.
+func DecodeBlockParts(parts *ProposalParts, shards [][]byte) (*Propose, error) {
+	codec, err := parts.Codec()
+	if err != nil {
+		return nil, err
+	}
+	payload, err := codec.Decode(shards, int(parts.Size))
+	if err != nil {
+		return nil, err
+	}
+	proposal := new(Propose)
+	if err := rlp.DecodeBytes(payload, proposal); err != nil {
+		return nil, err
+	}
+	if proposal.H() != parts.Height || uint64(proposal.R()) != parts.Round || proposal.Block().Hash() != parts.BlockHash {
+		return nil, ErrInvalidBlockPart
+	}
+	return proposal, nil
+}
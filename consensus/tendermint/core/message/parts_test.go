@@ -0,0 +1,116 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/rlp"
+)
+
+func TestBlockParts(t *testing.T) {
+	header := &types.Header{Number: common.Big2, Extra: make([]byte, 4096)}
+	block := types.NewBlockWithHeader(header)
+	proposal := NewPropose(1, 2, -1, block, defaultSigner)
+
+	parts, err := NewBlockParts(proposal, 4, 2, defaultSigner)
+	require.NoError(t, err)
+	require.Len(t, parts, 6)
+
+	proposer := func(height uint64, round int64) common.Address {
+		if height == 2 && round == 1 {
+			return testAddr
+		}
+		return common.Address{}
+	}
+	announcement := parts[0].Parts
+	require.NoError(t, announcement.Validate(proposer))
+	require.Equal(t, testAddr, announcement.Sender())
+	require.Equal(t, block.Hash(), announcement.BlockHash)
+
+	t.Run("round trip through the wire", func(t *testing.T) {
+		for _, part := range parts {
+			payload, err := rlp.EncodeToBytes(part)
+			require.NoError(t, err)
+			decoded := new(BlockPart)
+			require.NoError(t, rlp.DecodeBytes(payload, decoded))
+			require.NoError(t, decoded.Parts.Validate(proposer))
+			require.NoError(t, decoded.Verify())
+		}
+	})
+
+	t.Run("rebuild with missing shards", func(t *testing.T) {
+		shards := make([][]byte, len(parts))
+		for _, i := range []int{1, 3, 4, 5} {
+			shards[i] = parts[i].Data
+		}
+		rebuilt, err := DecodeBlockParts(announcement, shards)
+		require.NoError(t, err)
+		require.Equal(t, proposal.Hash(), rebuilt.Hash())
+		require.Equal(t, block.Hash(), rebuilt.Block().Hash())
+
+		shards[1] = nil
+		_, err = DecodeBlockParts(announcement, shards)
+		require.Error(t, err)
+	})
+
+	t.Run("tampered parts", func(t *testing.T) {
+		tampered := &BlockPart{Parts: announcement, Index: 0, Data: append([]byte{}, parts[0].Data...), Proof: parts[0].Proof}
+		tampered.Data[0] ^= 0xff
+		require.ErrorIs(t, tampered.Verify(), ErrInvalidBlockPart)
+
+		wrongIndex := &BlockPart{Parts: announcement, Index: 1, Data: parts[0].Data, Proof: parts[0].Proof}
+		require.ErrorIs(t, wrongIndex.Verify(), ErrInvalidBlockPart)
+
+		outOfRange := &BlockPart{Parts: announcement, Index: 6, Data: parts[0].Data, Proof: parts[0].Proof}
+		require.ErrorIs(t, outOfRange.Verify(), ErrInvalidBlockPart)
+
+		forged := *announcement
+		forged.PartsRoot = common.Hash{0x01}
+		require.ErrorIs(t, forged.Validate(proposer), ErrUnauthorizedAddress)
+	})
+
+	t.Run("not the proposer", func(t *testing.T) {
+		otherRound := *announcement
+		otherRound.Round = 2
+		require.ErrorIs(t, otherRound.Validate(proposer), ErrUnauthorizedAddress)
+
+		otherProposer := func(uint64, int64) common.Address { return common.Address{0x01} }
+		require.ErrorIs(t, announcement.Validate(otherProposer), ErrUnauthorizedAddress)
+	})
+}
+
+func BenchmarkNewBlockParts(b *testing.B) {
+	header := &types.Header{Number: common.Big2, Extra: make([]byte, 1<<20)}
+	proposal := NewPropose(1, 2, -1, types.NewBlockWithHeader(header), defaultSigner)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewBlockParts(proposal, 67, 33, defaultSigner); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeBlockParts(b *testing.B) {
+	header := &types.Header{Number: common.Big2, Extra: make([]byte, 1<<20)}
+	proposal := NewPropose(1, 2, -1, types.NewBlockWithHeader(header), defaultSigner)
+	parts, err := NewBlockParts(proposal, 67, 33, defaultSigner)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// rebuild from the first data shards missing, the worst case for the decoder
+		shards := make([][]byte, len(parts))
+		for j := 33; j < len(parts); j++ {
+			shards[j] = parts[j].Data
+		}
+		if _, err := DecodeBlockParts(parts[0].Parts, shards); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
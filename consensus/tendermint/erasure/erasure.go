@@ -0,0 +1,161 @@
+// Package erasure implements a systematic Reed-Solomon erasure code over GF(2^8)
+// and the Merkle tree used to authenticate the individual shards.
+//
+// Data is split into dataShards equally sized shards, to which parityShards
+// parity shards are appended. Any dataShards shards out of the total are enough
+// to rebuild the original data. The parity rows of the encoding matrix form a
+// Cauchy matrix, which guarantees that every square sub-matrix of the encoding
+// matrix is invertible.
+package erasure
+
+import (
+	"errors"
+)
+
+// MaxShards is the maximum number of data and parity shards supported by the field size.
+const MaxShards = 256
+
+var (
+	errInvalidShardCount = errors.New("invalid number of shards")
+	errTooFewShards      = errors.New("too few shards to reconstruct the data")
+	errShardSize         = errors.New("shards have inconsistent sizes")
+	errInvalidDataSize   = errors.New("invalid data size")
+	errSingularMatrix    = errors.New("singular matrix")
+)
+
+// Codec encodes and decodes data with a fixed number of data and parity shards.
+type Codec struct {
+	dataShards   int
+	parityShards int
+	parity       matrix // parityShards x dataShards Cauchy matrix
+}
+
+// New returns a codec with the given number of data and parity shards.
+func New(dataShards, parityShards int) (*Codec, error) {
+	if dataShards <= 0 || parityShards < 0 || dataShards+parityShards > MaxShards {
+		return nil, errInvalidShardCount
+	}
+	parity := newMatrix(parityShards, dataShards)
+	for i := range parity {
+		for j := range parity[i] {
+			// x_i = dataShards + i and y_j = j are all distinct, so x_i + y_j is never zero.
+			parity[i][j] = galInv(byte(dataShards+i) ^ byte(j))
+		}
+	}
+	return &Codec{dataShards: dataShards, parityShards: parityShards, parity: parity}, nil
+}
+
+// ShardsFor returns the number of data and parity shards to split data into for
+// the given number of receivers, one shard each. The parity shards are enough to
+// rebuild the data even if less than a third of the receivers withholds its shard.
+func ShardsFor(receivers int) (dataShards, parityShards int) {
+	if receivers > MaxShards {
+		receivers = MaxShards
+	}
+	parityShards = (receivers - 1) / 3
+	return receivers - parityShards, parityShards
+}
+
+// DataShards returns the number of shards required to rebuild the data.
+func (c *Codec) DataShards() int { return c.dataShards }
+
+// TotalShards returns the number of data and parity shards.
+func (c *Codec) TotalShards() int { return c.dataShards + c.parityShards }
+
+// ShardSize returns the size of every shard for data of the given size.
+func (c *Codec) ShardSize(size int) int {
+	return (size + c.dataShards - 1) / c.dataShards
+}
+
+// Encode splits the data into data shards, zero padding the last one, and computes
+// the parity shards.
+func (c *Codec) Encode(data []byte) ([][]byte, error) {
+	if len(data) == 0 {
+		return nil, errInvalidDataSize
+	}
+	shardSize := c.ShardSize(len(data))
+	padded := make([]byte, shardSize*c.TotalShards())
+	copy(padded, data)
+	shards := make([][]byte, c.TotalShards())
+	for i := range shards {
+		shards[i] = padded[i*shardSize : (i+1)*shardSize : (i+1)*shardSize]
+	}
+	for i, row := range c.parity {
+		out := shards[c.dataShards+i]
+		for j, coefficient := range row {
+			galMulSliceXor(coefficient, shards[j], out)
+		}
+	}
+	return shards, nil
+}
+
+// Decode rebuilds the original data of the given size from the available shards.
+// Missing shards must be nil; at least DataShards shards must be present.
+func (c *Codec) Decode(shards [][]byte, size int) ([]byte, error) {
+	if len(shards) != c.TotalShards() {
+		return nil, errInvalidShardCount
+	}
+	if size <= 0 {
+		return nil, errInvalidDataSize
+	}
+	shardSize := c.ShardSize(size)
+	// select the first dataShards available shards, preferring data shards
+	// which don't require any computation.
+	present := make([]int, 0, c.dataShards)
+	for i, shard := range shards {
+		if shard == nil {
+			continue
+		}
+		if len(shard) != shardSize {
+			return nil, errShardSize
+		}
+		if len(present) < c.dataShards {
+			present = append(present, i)
+		}
+	}
+	if len(present) < c.dataShards {
+		return nil, errTooFewShards
+	}
+	data := make([]byte, shardSize*c.dataShards)
+	missing := false
+	for i := 0; i < c.dataShards; i++ {
+		if shards[i] == nil {
+			missing = true
+			continue
+		}
+		copy(data[i*shardSize:], shards[i])
+	}
+	if missing {
+		sub := newMatrix(c.dataShards, c.dataShards)
+		for row, idx := range present {
+			copy(sub[row], c.row(idx))
+		}
+		inverse, err := sub.invert()
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < c.dataShards; i++ {
+			if shards[i] != nil {
+				continue
+			}
+			out := data[i*shardSize : (i+1)*shardSize]
+			for j, idx := range present {
+				galMulSliceXor(inverse[i][j], shards[idx], out)
+			}
+		}
+	}
+	if size > len(data) {
+		return nil, errInvalidDataSize
+	}
+	return data[:size], nil
+}
+
+// row returns the encoding matrix row of the given shard.
+func (c *Codec) row(idx int) []byte {
+	if idx < c.dataShards {
+		r := make([]byte, c.dataShards)
+		r[idx] = 1
+		return r
+	}
+	return c.parity[idx-c.dataShards]
+}
@@ -0,0 +1,140 @@
+package erasure
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	for _, tc := range []struct{ data, parity, size int }{
+		{1, 0, 10},
+		{3, 1, 100},
+		{7, 3, 1000},
+		{14, 6, 12345},
+		{170, 85, 65536},
+	} {
+		codec, err := New(tc.data, tc.parity)
+		require.NoError(t, err)
+		data := make([]byte, tc.size)
+		rand.Read(data)
+		shards, err := codec.Encode(data)
+		require.NoError(t, err)
+		require.Len(t, shards, tc.data+tc.parity)
+
+		// drop a random subset of parity shards at most
+		for trial := 0; trial < 5; trial++ {
+			available := make([][]byte, len(shards))
+			for _, idx := range rand.Perm(len(shards))[:tc.data] {
+				available[idx] = append([]byte{}, shards[idx]...)
+			}
+			decoded, err := codec.Decode(available, tc.size)
+			require.NoError(t, err)
+			require.Equal(t, data, decoded)
+		}
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	codec, err := New(4, 2)
+	require.NoError(t, err)
+	shards, err := codec.Encode([]byte("some proposal payload"))
+	require.NoError(t, err)
+
+	available := make([][]byte, len(shards))
+	copy(available, shards[:3])
+	_, err = codec.Decode(available, 21)
+	require.ErrorIs(t, err, errTooFewShards)
+
+	available[3] = []byte{0x01}
+	_, err = codec.Decode(available, 21)
+	require.ErrorIs(t, err, errShardSize)
+
+	_, err = New(200, 57)
+	require.ErrorIs(t, err, errInvalidShardCount)
+}
+
+func TestMerkleProofs(t *testing.T) {
+	for _, n := range []int{1, 2, 5, 8, 33} {
+		shards := make([][]byte, n)
+		for i := range shards {
+			shards[i] = []byte{byte(i), 0xaa}
+		}
+		root := MerkleRoot(shards)
+		proofs := MerkleProofs(shards)
+		for i, shard := range shards {
+			require.True(t, VerifyProof(root, i, shard, proofs[i]))
+			require.False(t, VerifyProof(root, i, []byte{0xff}, proofs[i]))
+			if n > 1 {
+				require.False(t, VerifyProof(root, (i+1)%n, shard, proofs[i]))
+			}
+		}
+	}
+}
+
+func TestShardsFor(t *testing.T) {
+	for receivers := 1; receivers <= MaxShards+10; receivers++ {
+		dataShards, parityShards := ShardsFor(receivers)
+		require.Equal(t, min(receivers, MaxShards), dataShards+parityShards)
+		// the shards of less than a third of the receivers can be withheld
+		require.Equal(t, (min(receivers, MaxShards)-1)/3, parityShards)
+	}
+}
+
+// benchmarkCommittees are the committee sizes the block parts are benchmarked for,
+// the proposer sending one shard to every other member.
+var benchmarkCommittees = []int{21, 100, MaxShards + 1}
+
+// benchmarkSizes are the proposal sizes the block parts are benchmarked for, up
+// to the maximum proposal size.
+var benchmarkSizes = []int{256 << 10, 1 << 20, 10 << 20}
+
+func benchmarkShards(b *testing.B, run func(b *testing.B, codec *Codec, data []byte)) {
+	for _, committee := range benchmarkCommittees {
+		for _, size := range benchmarkSizes {
+			dataShards, parityShards := ShardsFor(committee - 1)
+			b.Run(fmt.Sprintf("committee=%d/shards=%d+%d/size=%dKiB", committee, dataShards, parityShards, size>>10), func(b *testing.B) {
+				codec, err := New(dataShards, parityShards)
+				if err != nil {
+					b.Fatal(err)
+				}
+				data := make([]byte, size)
+				rand.Read(data)
+				b.SetBytes(int64(size))
+				b.ReportAllocs()
+				run(b, codec, data)
+			})
+		}
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	benchmarkShards(b, func(b *testing.B, codec *Codec, data []byte) {
+		for i := 0; i < b.N; i++ {
+			if _, err := codec.Encode(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func BenchmarkReconstruct(b *testing.B) {
+	benchmarkShards(b, func(b *testing.B, codec *Codec, data []byte) {
+		shards, err := codec.Encode(data)
+		if err != nil {
+			b.Fatal(err)
+		}
+		// the worst case: every parity shard replaces a missing data shard
+		parity := codec.TotalShards() - codec.DataShards()
+		available := make([][]byte, len(shards))
+		copy(available[parity:], shards[parity:])
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := codec.Decode(available, len(data)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package erasure
+
+// Arithmetic over GF(2^8) with the reducing polynomial x^8 + x^4 + x^3 + x^2 + 1.
+
+const fieldPolynomial = 0x11d
+
+var (
+	expTable [510]byte
+	logTable [256]byte
+)
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		expTable[i] = byte(x)
+		expTable[i+255] = byte(x)
+		logTable[x] = byte(i)
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= fieldPolynomial
+		}
+	}
+}
+
+func galMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return expTable[int(logTable[a])+int(logTable[b])]
+}
+
+func galInv(a byte) byte {
+	if a == 0 {
+		panic("erasure: inverse of zero")
+	}
+	return expTable[255-int(logTable[a])]
+}
+
+// galMulSliceXor computes out[i] ^= c * in[i].
+func galMulSliceXor(c byte, in, out []byte) {
+	if c == 0 {
+		return
+	}
+	logC := int(logTable[c])
+	for i, v := range in {
+		if v != 0 {
+			out[i] ^= expTable[logC+int(logTable[v])]
+		}
+	}
+}
+
+type matrix [][]byte
+
+func newMatrix(rows, cols int) matrix {
+	m := make(matrix, rows)
+	for i := range m {
+		m[i] = make([]byte, cols)
+	}
+	return m
+}
+
+// invert returns the inverse of the square matrix m, using Gauss-Jordan elimination.
+func (m matrix) invert() (matrix, error) {
+	n := len(m)
+	work := newMatrix(n, 2*n)
+	for i := range m {
+		copy(work[i], m[i])
+		work[i][n+i] = 1
+	}
+	for col := 0; col < n; col++ {
+		pivot := -1
+		for row := col; row < n; row++ {
+			if work[row][col] != 0 {
+				pivot = row
+				break
+			}
+		}
+		if pivot == -1 {
+			return nil, errSingularMatrix
+		}
+		work[col], work[pivot] = work[pivot], work[col]
+		if inv := galInv(work[col][col]); inv != 1 {
+			for j := range work[col] {
+				work[col][j] = galMul(work[col][j], inv)
+			}
+		}
+		for row := 0; row < n; row++ {
+			if row != col && work[row][col] != 0 {
+				galMulSliceXor(work[row][col], work[col], work[row])
+			}
+		}
+	}
+	inverse := newMatrix(n, n)
+	for i := range inverse {
+		copy(inverse[i], work[i][n:])
+	}
+	return inverse, nil
+}
@@ -0,0 +1,77 @@
+package erasure
+
+import (
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/crypto"
+)
+
+// The shards are authenticated by a binary Merkle tree whose leaves are the
+// hashes of the shards, padded with empty hashes up to the next power of two.
+
+func leafHash(shard []byte) common.Hash {
+	return crypto.Keccak256Hash([]byte{0x00}, shard)
+}
+
+func nodeHash(left, right common.Hash) common.Hash {
+	return crypto.Keccak256Hash([]byte{0x01}, left.Bytes(), right.Bytes())
+}
+
+func merkleLevels(shards [][]byte) [][]common.Hash {
+	width := 1
+	for width < len(shards) {
+		width *= 2
+	}
+	level := make([]common.Hash, width)
+	for i, shard := range shards {
+		level[i] = leafHash(shard)
+	}
+	levels := [][]common.Hash{level}
+	for len(level) > 1 {
+		next := make([]common.Hash, len(level)/2)
+		for i := range next {
+			next[i] = nodeHash(level[2*i], level[2*i+1])
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return levels
+}
+
+// MerkleRoot returns the root of the Merkle tree built over the shards.
+func MerkleRoot(shards [][]byte) common.Hash {
+	levels := merkleLevels(shards)
+	return levels[len(levels)-1][0]
+}
+
+// MerkleProofs returns the inclusion proof of every shard.
+func MerkleProofs(shards [][]byte) [][]common.Hash {
+	levels := merkleLevels(shards)
+	proofs := make([][]common.Hash, len(shards))
+	for i := range shards {
+		idx := i
+		proof := make([]common.Hash, 0, len(levels)-1)
+		for _, level := range levels[:len(levels)-1] {
+			proof = append(proof, level[idx^1])
+			idx /= 2
+		}
+		proofs[i] = proof
+	}
+	return proofs
+}
+
+// VerifyProof checks that the shard at the given index belongs to the tree with the given root.
+func VerifyProof(root common.Hash, index int, shard []byte, proof []common.Hash) bool {
+	if index < 0 || index >= 1<<len(proof) {
+		return false
+	}
+	h := leafHash(shard)
+	for _, sibling := range proof {
+		if index%2 == 0 {
+			h = nodeHash(h, sibling)
+		} else {
+			h = nodeHash(sibling, h)
+		}
+		index /= 2
+	}
+	return h == root
+}
@@ -0,0 +1,119 @@
+// Package finality verifies that blocks were finalised by their committee.
+// It holds the committed seals verification of the consensus engine, so that
+// relayers of cross-chain bridges can check the finality proofs exported by the
+// tendermint RPC API without running a node. It only depends on the message
+// encoding of the engine, but the block header types bring in the params, abi
+// and enode packages.
+package finality
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/consensus/tendermint"
+	"github.com/autonity/autonity/consensus/tendermint/bft"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/core/types"
+)
+
+var (
+	// ErrNoCommittedSeals is returned if a header has no committed seals.
+	ErrNoCommittedSeals = types.ErrEmptyCommittedSeals
+	// ErrInvalidSeal is returned if a committed seal is not a valid signature.
+	ErrInvalidSeal = fmt.Errorf("%w: committed seal", types.ErrInvalidSignature)
+	// ErrUnknownSigner is returned if a committed seal is not signed by a
+	// member of the committee.
+	ErrUnknownSigner = fmt.Errorf("%w: signed outside of the committee", types.ErrInvalidCommittedSeals)
+	// ErrDuplicatedSeal is returned if a member signed several committed seals.
+	ErrDuplicatedSeal = fmt.Errorf("%w: duplicated", types.ErrInvalidCommittedSeals)
+	// ErrNoQuorum is returned if the committed seals do not hold a quorum of
+	// the committee voting power.
+	ErrNoQuorum = fmt.Errorf("%w: below quorum", types.ErrInvalidCommittedSeals)
+	// ErrEpochOrder is returned if the epoch headers of a proof are not in
+	// increasing order before the proven header.
+	ErrEpochOrder = errors.New("epoch headers out of order")
+	// ErrCommitteeMismatch is returned if the committee of a proof is not the
+	// one resulting from its epoch headers.
+	ErrCommitteeMismatch = errors.New("committee mismatch")
+)
+
+// Proof proves that a header was finalised, starting from a committee trusted
+// by the verifier.
+type Proof struct {
+	// Header is the finalised header, carrying its committed seals.
+	Header *types.Header `json:"header"`
+	// Committee is the committee which finalised the header, the one carried
+	// by its parent.
+	Committee types.Committee `json:"committee"`
+	// Epochs are the headers changing the committee between the trusted one
+	// and the proven header, in increasing order. Each one is finalised by the
+	// committee of the previous one, the first by the trusted committee.
+	Epochs []*types.Header `json:"epochs"`
+}
+
+// SealHash returns the hash signed by the committee members in the committed
+// seals of the block with the given hash: the hash of their precommit for it.
+func SealHash(hash common.Hash, round int64, height *big.Int) common.Hash {
+	return message.PrepareCommittedSeal(hash, round, height)
+}
+
+// VerifySeals checks that the committed seals of the header are signed by
+// distinct members of the committee holding a quorum of its voting power.
+func VerifySeals(header *types.Header, committee types.Committee) error {
+	if len(header.CommittedSeals) == 0 {
+		return ErrNoCommittedSeals
+	}
+	members := make(map[common.Address]*big.Int, len(committee))
+	for _, member := range committee {
+		members[member.Address] = member.VotingPower
+	}
+	var (
+		hash  = SealHash(header.Hash(), int64(header.Round), header.Number)
+		votes = make(map[common.Address]struct{}, len(committee))
+		power = new(big.Int)
+	)
+	for _, seal := range header.CommittedSeals {
+		signer, err := tendermint.SigToAddr(hash, seal)
+		if err != nil {
+			return ErrInvalidSeal
+		}
+		votingPower, ok := members[signer]
+		if !ok {
+			return ErrUnknownSigner
+		}
+		if _, ok := votes[signer]; ok {
+			return ErrDuplicatedSeal
+		}
+		votes[signer] = struct{}{}
+		power.Add(power, votingPower)
+	}
+	if power.Cmp(bft.Quorum(committee.TotalVotingPower())) < 0 {
+		return ErrNoQuorum
+	}
+	return nil
+}
+
+// Verify checks that the header of the proof was finalised, the verifier
+// trusting the given committee. The committee is followed through the epoch
+// headers of the proof up to the one which finalised the header.
+func Verify(proof *Proof, trusted types.Committee) error {
+	committee := trusted
+	for i, epoch := range proof.Epochs {
+		if i > 0 && epoch.Number.Cmp(proof.Epochs[i-1].Number) <= 0 {
+			return ErrEpochOrder
+		}
+		if err := VerifySeals(epoch, committee); err != nil {
+			return err
+		}
+		committee = epoch.Committee
+	}
+	if len(proof.Epochs) > 0 && proof.Epochs[len(proof.Epochs)-1].Number.Cmp(proof.Header.Number) >= 0 {
+		return ErrEpochOrder
+	}
+	if !committee.Equal(proof.Committee) {
+		return ErrCommitteeMismatch
+	}
+	return VerifySeals(proof.Header, committee)
+}
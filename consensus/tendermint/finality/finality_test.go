@@ -0,0 +1,119 @@
+package finality
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
+)
+
+func newCommittee(t *testing.T, powers ...int64) (types.Committee, []*ecdsa.PrivateKey) {
+	committee := make(types.Committee, len(powers))
+	keys := make([]*ecdsa.PrivateKey, len(powers))
+	for i, power := range powers {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		committee[i] = types.CommitteeMember{Address: crypto.PubkeyToAddress(key.PublicKey), VotingPower: big.NewInt(power)}
+	}
+	return committee, keys
+}
+
+func seal(t *testing.T, header *types.Header, keys ...*ecdsa.PrivateKey) *types.Header {
+	header.CommittedSeals = nil
+	hash := SealHash(header.Hash(), int64(header.Round), header.Number)
+	for _, key := range keys {
+		signature, err := crypto.Sign(hash.Bytes(), key)
+		require.NoError(t, err)
+		header.CommittedSeals = append(header.CommittedSeals, signature)
+	}
+	return header
+}
+
+func TestVerifySeals(t *testing.T) {
+	committee, keys := newCommittee(t, 1, 1, 1, 1)
+	header := &types.Header{Number: big.NewInt(5), Round: 1}
+
+	require.NoError(t, VerifySeals(seal(t, header, keys[0], keys[1], keys[2]), committee))
+	require.ErrorIs(t, VerifySeals(seal(t, header, keys[0], keys[1]), committee), ErrNoQuorum)
+	require.ErrorIs(t, VerifySeals(seal(t, header), committee), ErrNoCommittedSeals)
+	// the engine matches the errors of the header types
+	require.ErrorIs(t, VerifySeals(seal(t, header, keys[0], keys[1]), committee), types.ErrInvalidCommittedSeals)
+	require.ErrorIs(t, VerifySeals(seal(t, header, keys[0], keys[1], keys[1]), committee), ErrDuplicatedSeal)
+
+	outsider, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	require.ErrorIs(t, VerifySeals(seal(t, header, keys[0], keys[1], outsider), committee), ErrUnknownSigner)
+
+	seal(t, header, keys[0], keys[1], keys[2])
+	header.CommittedSeals[2] = []byte{1, 2, 3}
+	require.ErrorIs(t, VerifySeals(header, committee), ErrInvalidSeal)
+
+	// the seals are bound to the round of the header
+	seal(t, header, keys[0], keys[1], keys[2])
+	header.Round = 2
+	require.ErrorIs(t, VerifySeals(header, committee), ErrUnknownSigner)
+}
+
+func TestVerify(t *testing.T) {
+	trusted, trustedKeys := newCommittee(t, 1, 1, 1)
+	next, nextKeys := newCommittee(t, 2, 1)
+	last, lastKeys := newCommittee(t, 1)
+
+	first := seal(t, &types.Header{Number: big.NewInt(10), Committee: next}, trustedKeys...)
+	second := seal(t, &types.Header{Number: big.NewInt(20), Committee: last}, nextKeys...)
+	header := seal(t, &types.Header{Number: big.NewInt(25)}, lastKeys...)
+
+	// without committee change
+	proof := &Proof{Header: seal(t, &types.Header{Number: big.NewInt(5)}, trustedKeys...), Committee: trusted}
+	require.NoError(t, Verify(proof, trusted))
+	require.ErrorIs(t, Verify(proof, next), ErrCommitteeMismatch)
+
+	proof = &Proof{Header: header, Committee: last, Epochs: []*types.Header{first, second}}
+	require.NoError(t, Verify(proof, trusted))
+
+	// a missing epoch header
+	proof.Epochs = []*types.Header{second}
+	require.ErrorIs(t, Verify(proof, trusted), ErrUnknownSigner)
+	proof.Epochs = []*types.Header{first}
+	require.ErrorIs(t, Verify(proof, trusted), ErrCommitteeMismatch)
+
+	// epoch headers out of order
+	proof.Epochs = []*types.Header{first, second}
+	proof.Header = seal(t, &types.Header{Number: big.NewInt(20)}, lastKeys...)
+	require.ErrorIs(t, Verify(proof, trusted), ErrEpochOrder)
+
+	// an epoch header without quorum
+	proof.Header = header
+	proof.Epochs = []*types.Header{first, seal(t, &types.Header{Number: big.NewInt(20), Committee: last}, nextKeys[1])}
+	require.ErrorIs(t, Verify(proof, trusted), ErrNoQuorum)
+}
+
+func TestVerifyConsensusKeys(t *testing.T) {
+	trusted, trustedKeys := newCommittee(t, 1, 1, 1)
+	next, nextKeys := newCommittee(t, 1, 1, 1)
+	for i := range next {
+		next[i].ConsensusKey = []byte{byte(i + 1)}
+	}
+	// from the random beacon fork, the headers commit to the consensus keys of their committee
+	epoch := seal(t, &types.Header{Number: big.NewInt(10), MixDigest: types.BFTDigest, Beacon: []byte{0x01}, Committee: next}, trustedKeys...)
+	header := seal(t, &types.Header{Number: big.NewInt(15), MixDigest: types.BFTDigest}, nextKeys...)
+	proof := &Proof{Header: header, Committee: next, Epochs: []*types.Header{epoch}}
+	require.NoError(t, Verify(proof, trusted))
+
+	// keys substituted in the proven committee
+	forged := append(types.Committee{}, next...)
+	forged[0].ConsensusKey = []byte{0xff}
+	proof.Committee = forged
+	require.ErrorIs(t, Verify(proof, trusted), ErrCommitteeMismatch)
+
+	// keys substituted in the epoch header
+	forgedEpoch := types.CopyHeader(epoch)
+	forgedEpoch.Committee = forged
+	proof.Epochs = []*types.Header{forgedEpoch}
+	require.ErrorIs(t, Verify(proof, trusted), ErrUnknownSigner)
+}
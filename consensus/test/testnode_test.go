@@ -76,7 +76,9 @@ func (validator *testNode) startNode(forceMining bool) error {
 		return err
 	}
 
-	acn.New(validator.node, validator.service, validator.ethConfig.NetworkID)
+	if err = acn.New(validator.node, validator.service, validator.ethConfig.NetworkID); err != nil {
+		return err
+	}
 
 	if err := validator.node.Start(); err != nil {
 		return fmt.Errorf("cannot start a node %s", err)
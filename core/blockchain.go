@@ -80,6 +80,7 @@ var (
 	blockWriteBg      = metrics.NewRegisteredBufferedGauge("chain/write.bg", nil)
 
 	blockReorgMeter         = metrics.NewRegisteredMeter("chain/reorg/executes", nil)
+	blockReorgRefusedMeter  = metrics.NewRegisteredMeter("chain/reorg/refused", nil)
 	blockReorgAddMeter      = metrics.NewRegisteredMeter("chain/reorg/add", nil)
 	blockReorgDropMeter     = metrics.NewRegisteredMeter("chain/reorg/drop", nil)
 	blockReorgInvalidatedTx = metrics.NewRegisteredMeter("chain/reorg/invalidTx", nil)
@@ -138,6 +139,9 @@ type CacheConfig struct {
 	TrieTimeLimit       time.Duration // Time limit after which to flush the current in-memory trie to disk
 	SnapshotLimit       int           // Memory allowance (MB) to use for caching snapshot entries in memory
 	Preimages           bool          // Whether to store preimage of trie key to the disk
+	StateScheme         string        // Scheme used to store the trie nodes on disk (hash or path)
+	StateHistory        uint64        // Number of recent state transitions whose reverse diffs are kept (path-based scheme)
+	HistoryEpochs       uint64        // Number of recent epochs whose bodies and receipts are kept (0 = entire chain)
 
 	SnapshotWait bool // Wait for snapshot construction on startup. TODO(karalabe): This is a dirty hack for testing, nuke it
 }
@@ -229,6 +233,10 @@ type BlockChain struct {
 // NewBlockChain returns a fully initialised block chain using information
 // available in the database. It initialises the default Ethereum Validator and
 // Processor.
+//
+// The shouldPreserve callback is not consulted by the fork choice: blocks are
+// decided by the BFT consensus and never replaced by a sibling of equal weight,
+// so there is no miner preference to break ties with.
 func NewBlockChain(db ethdb.Database,
 	cacheConfig *CacheConfig,
 	chainConfig *params.ChainConfig,
@@ -255,9 +263,11 @@ func NewBlockChain(db ethdb.Database,
 		db:          db,
 		triegc:      prque.New(nil),
 		stateCache: state.NewDatabaseWithConfig(db, &trie.Config{
-			Cache:     cacheConfig.TrieCleanLimit,
-			Journal:   cacheConfig.TrieCleanJournal,
-			Preimages: cacheConfig.Preimages,
+			Cache:        cacheConfig.TrieCleanLimit,
+			Journal:      cacheConfig.TrieCleanJournal,
+			Preimages:    cacheConfig.Preimages,
+			Scheme:       cacheConfig.StateScheme,
+			StateHistory: cacheConfig.StateHistory,
 		}),
 		quit:          make(chan struct{}),
 		chainmu:       syncx.NewClosableMutex(),
@@ -272,7 +282,7 @@ func NewBlockChain(db ethdb.Database,
 		senderCacher:  senderCacher,
 		log:           log,
 	}
-	bc.forker = NewForkChoice(bc, shouldPreserve)
+	bc.forker = NewForkChoice(bc)
 	bc.validator = NewBlockValidator(chainConfig, bc, engine)
 	bc.prefetcher = newStatePrefetcher(chainConfig, bc, engine)
 	bc.processor = NewStateProcessor(chainConfig, bc, engine)
@@ -420,11 +430,21 @@ func NewBlockChain(db ethdb.Database,
 	// Start tx indexer/unindexer.
 	if txLookupLimit != nil {
 		bc.txLookupLimit = *txLookupLimit
+		if limit := bc.historyLimit(bc.CurrentHeader()); limit != 0 && (bc.txLookupLimit == 0 || bc.txLookupLimit > limit) {
+			bc.log.Warn("Limiting transaction index to the retained history", "provided", bc.txLookupLimit, "updated", limit)
+			bc.txLookupLimit = limit
+		}
 
 		bc.wg.Add(1)
 		go bc.maintainTxIndex(txIndexBlock)
 	}
 
+	// Start the pruner of the ancient bodies and receipts.
+	if bc.cacheConfig.HistoryEpochs != 0 {
+		bc.wg.Add(1)
+		go bc.maintainHistory()
+	}
+
 	// If periodic cache journal is required, spin it up.
 	if bc.cacheConfig.TrieCleanRejournal > 0 {
 		if bc.cacheConfig.TrieCleanRejournal < time.Minute {
@@ -561,6 +581,14 @@ func (bc *BlockChain) setHeadBeyondRoot(head uint64, root common.Hash, repair bo
 					if root != (common.Hash{}) && !beyondRoot && newHeadBlock.Root() == root {
 						beyondRoot, rootNumber = true, newHeadBlock.NumberU64()
 					}
+					// With the path-based scheme, older states are rebuilt by
+					// rolling the persistent state back, as long as the
+					// reverse diffs are retained.
+					if triedb := bc.stateCache.TrieDB(); !bc.HasState(newHeadBlock.Root()) && triedb.Recoverable(newHeadBlock.Root()) {
+						if err := triedb.Recover(newHeadBlock.Root()); err != nil {
+							bc.log.Error("Failed to roll back state", "number", newHeadBlock.NumberU64(), "hash", newHeadBlock.Hash(), "err", err)
+						}
+					}
 					if _, err := state.New(newHeadBlock.Root(), bc.stateCache, bc.snaps); err != nil {
 						bc.log.Trace("Block state missing, rewinding further", "number", newHeadBlock.NumberU64(), "hash", newHeadBlock.Hash())
 						if pivot == nil || newHeadBlock.NumberU64() > *pivot {
@@ -672,6 +700,12 @@ func (bc *BlockChain) SnapSyncCommitHead(hash common.Hash) error {
 	if block == nil {
 		return fmt.Errorf("non existent block [%x..]", hash[:4])
 	}
+	// The synced state replaces the persistent one of the path-based scheme
+	if triedb := bc.stateCache.TrieDB(); triedb.Scheme() == rawdb.PathScheme {
+		if err := triedb.Enable(block.Root()); err != nil {
+			return err
+		}
+	}
 	if _, err := trie.NewSecure(block.Root(), bc.stateCache.TrieDB()); err != nil {
 		return err
 	}
@@ -730,6 +764,114 @@ func (bc *BlockChain) ResetWithGenesisBlock(genesis *types.Block) error {
 	return nil
 }
 
+// InitCheckpoint anchors an empty chain at the given trusted header, so that it
+// can be synced from there instead of from genesis. The headers below it are
+// backfilled later on through BackfillHeaders, whereas their bodies and receipts
+// are never retrieved.
+//
+// As BFT blocks all carry the same difficulty, the total difficulty of the
+// checkpoint is derived from its number.
+func (bc *BlockChain) InitCheckpoint(header *types.Header) error {
+	if !bc.chainmu.TryLock() {
+		return errChainStopped
+	}
+	defer bc.chainmu.Unlock()
+
+	hash, number := header.Hash(), header.Number.Uint64()
+	if checkpoint, _ := rawdb.ReadSyncCheckpoint(bc.db); checkpoint != (common.Hash{}) {
+		if checkpoint == hash {
+			return nil
+		}
+		return fmt.Errorf("%w: chain already anchored at %x", ErrCheckpointConflict, checkpoint)
+	}
+	if number == 0 {
+		return fmt.Errorf("%w: genesis can't be a checkpoint", ErrCheckpointConflict)
+	}
+	if bc.CurrentHeader().Number.Sign() != 0 || bc.CurrentFastBlock().NumberU64() != 0 {
+		return fmt.Errorf("%w: chain not empty", ErrCheckpointConflict)
+	}
+	td := new(big.Int).Mul(header.Difficulty, header.Number)
+	td.Add(td, bc.genesisBlock.Difficulty())
+
+	batch := bc.db.NewBatch()
+	rawdb.WriteHeader(batch, header)
+	rawdb.WriteTd(batch, hash, number, td)
+	rawdb.WriteCanonicalHash(batch, hash, number)
+	rawdb.WriteHeadHeaderHash(batch, hash)
+	rawdb.WriteSyncCheckpoint(batch, hash, number)
+	rawdb.WriteHeaderBackfillTail(batch, number)
+	if err := batch.Write(); err != nil {
+		bc.log.Crit("Failed to write sync checkpoint", "err", err)
+	}
+	bc.hc.SetCurrentHeader(header)
+
+	bc.log.Info("Anchored chain at sync checkpoint", "number", number, "hash", hash, "td", td)
+	return nil
+}
+
+// BackfillHeaders inserts a contiguous batch of canonical headers directly
+// below the header backfill tail. The headers are trusted by virtue of being
+// linked by hash to the sync checkpoint, so they are not verified any further.
+func (bc *BlockChain) BackfillHeaders(headers []*types.Header) error {
+	if len(headers) == 0 {
+		return nil
+	}
+	if !bc.chainmu.TryLock() {
+		return errChainStopped
+	}
+	defer bc.chainmu.Unlock()
+
+	tail := rawdb.ReadHeaderBackfillTail(bc.db)
+	if tail == nil {
+		return nil
+	}
+	child := bc.GetHeaderByNumber(*tail)
+	if child == nil {
+		return fmt.Errorf("missing backfill tail header #%d", *tail)
+	}
+	for i := 1; i < len(headers); i++ {
+		if headers[i].Number.Uint64() != headers[i-1].Number.Uint64()+1 || headers[i].ParentHash != headers[i-1].Hash() {
+			return fmt.Errorf("%w: non contiguous headers #%d and #%d", ErrInvalidBackfill, headers[i-1].Number, headers[i].Number)
+		}
+	}
+	first, last := headers[0], headers[len(headers)-1]
+	if last.Number.Uint64()+1 != *tail || last.Hash() != child.ParentHash {
+		return fmt.Errorf("%w: header #%d [%x..] isn't the parent of #%d", ErrInvalidBackfill, last.Number, last.Hash().Bytes()[:4], *tail)
+	}
+	if first.Number.Uint64() == 1 && first.ParentHash != bc.genesisBlock.Hash() {
+		return fmt.Errorf("%w: header #1 isn't a child of genesis", ErrInvalidBackfill)
+	}
+	td := bc.GetTd(child.Hash(), *tail)
+	if td == nil {
+		return fmt.Errorf("missing backfill tail td #%d", *tail)
+	}
+	td = new(big.Int).Set(td)
+
+	batch := bc.db.NewBatch()
+	for i := len(headers) - 1; i >= 0; i-- {
+		td.Sub(td, child.Difficulty)
+		hash, number := headers[i].Hash(), headers[i].Number.Uint64()
+		rawdb.WriteHeader(batch, headers[i])
+		rawdb.WriteTd(batch, hash, number, td)
+		rawdb.WriteCanonicalHash(batch, hash, number)
+		child = headers[i]
+	}
+	if first.Number.Uint64() == 1 {
+		rawdb.DeleteHeaderBackfillTail(batch)
+	} else {
+		rawdb.WriteHeaderBackfillTail(batch, first.Number.Uint64())
+	}
+	if err := batch.Write(); err != nil {
+		bc.log.Crit("Failed to write backfilled headers", "err", err)
+	}
+	if first.Number.Uint64() == 1 {
+		bc.log.Info("Finished header backfill", "checkpoint", bc.Checkpoint().Number)
+	} else {
+		bc.log.Debug("Backfilled headers", "count", len(headers), "tail", first.Number)
+	}
+	return nil
+}
+
 // Export writes the active chain to the given writer.
 func (bc *BlockChain) Export(w io.Writer) error {
 	return bc.ExportN(w, uint64(0), bc.CurrentBlock().NumberU64())
@@ -828,7 +970,12 @@ func (bc *BlockChain) Stop() {
 
 	// Ensure the latest state is stored to disk before exiting.
 	// With BFT types protocol, no reorgs are possible, so only the HEAD state is required.
-	if !bc.cacheConfig.TrieDirtyDisabled {
+	if triedb := bc.stateCache.TrieDB(); triedb.Scheme() == rawdb.PathScheme {
+		// The diff layers are journaled instead, to be restored at startup
+		if err := triedb.Journal(bc.CurrentBlock().Root()); err != nil {
+			bc.log.Error("Failed to journal state trie", "err", err)
+		}
+	} else if !bc.cacheConfig.TrieDirtyDisabled {
 		triedb := bc.stateCache.TrieDB()
 
 		offset := uint64(0)
@@ -1232,6 +1379,15 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 	}
 	triedb := bc.stateCache.TrieDB()
 
+	// The path-based scheme bounds the number of diff layers by itself, only
+	// the memory allowance has to be enforced.
+	if triedb.Scheme() == rawdb.PathScheme {
+		limit := common.StorageSize(bc.cacheConfig.TrieDirtyLimit) * 1024 * 1024
+		if nodes, _ := triedb.Size(); nodes > limit {
+			return triedb.Cap(limit - ethdb.IdealBatchSize)
+		}
+		return nil
+	}
 	// If we're running an archive node, always flush
 	if bc.cacheConfig.TrieDirtyDisabled {
 		return triedb.Commit(root, false, nil)
@@ -2021,6 +2177,20 @@ func (bc *BlockChain) reorg(oldBlock, newBlock *types.Block) error {
 			return fmt.Errorf("invalid new chain")
 		}
 	}
+	// Committed blocks are final, refuse to replace any of them and keep
+	// the conflicting chain as evidence of the safety violation.
+	for i := len(oldChain) - 1; i >= 0; i-- {
+		if bc.hc.committed(oldChain[i].Header()) {
+			if len(newChain) > 0 {
+				conflicting := make([]*types.Header, 0, len(newChain))
+				for j := len(newChain) - 1; j >= 0; j-- {
+					conflicting = append(conflicting, newChain[j].Header())
+				}
+				bc.hc.reportFork(oldChain[i].Header(), conflicting)
+			}
+			return fmt.Errorf("%w: number %d, hash %x", ErrCommittedReorg, oldChain[i].Number(), oldChain[i].Hash())
+		}
+	}
 	// Ensure the user sees large reorgs
 	if len(oldChain) > 0 && len(newChain) > 0 {
 		logFn := bc.log.Info
@@ -2215,6 +2385,10 @@ func (bc *BlockChain) maintainTxIndex(ancients uint64) {
 		if bc.txLookupLimit != 0 && ancients > bc.txLookupLimit {
 			from = ancients - bc.txLookupLimit
 		}
+		// Bodies below the history tail are pruned, nothing to index there
+		if tail := rawdb.ReadHistoryTail(bc.db); from < tail {
+			from = tail
+		}
 		rawdb.IndexTransactions(bc.db, from, ancients, bc.quit)
 	}
 
@@ -2227,10 +2401,14 @@ func (bc *BlockChain) maintainTxIndex(ancients uint64) {
 		if tail == nil {
 			if bc.txLookupLimit == 0 || head < bc.txLookupLimit {
 				// Nothing to delete, write the tail and return
-				rawdb.WriteTxIndexTail(bc.db, 0)
+				rawdb.WriteTxIndexTail(bc.db, rawdb.ReadHistoryTail(bc.db))
 			} else {
 				// Prune all stale tx indices and record the tx index tail
-				rawdb.UnindexTransactions(bc.db, 0, head-bc.txLookupLimit+1, bc.quit)
+				if from := rawdb.ReadHistoryTail(bc.db); from < head-bc.txLookupLimit+1 {
+					rawdb.UnindexTransactions(bc.db, from, head-bc.txLookupLimit+1, bc.quit)
+				} else {
+					rawdb.WriteTxIndexTail(bc.db, from)
+				}
 			}
 			return
 		}
@@ -2244,7 +2422,10 @@ func (bc *BlockChain) maintainTxIndex(ancients uint64) {
 				if end > head+1 {
 					end = head + 1
 				}
-				rawdb.IndexTransactions(bc.db, 0, end, bc.quit)
+				// Bodies below the history tail are gone, nothing to index there
+				if from := rawdb.ReadHistoryTail(bc.db); from < end {
+					rawdb.IndexTransactions(bc.db, from, end, bc.quit)
+				}
 			}
 			return
 		}
@@ -2288,6 +2469,89 @@ func (bc *BlockChain) maintainTxIndex(ancients uint64) {
 	}
 }
 
+// historyLimit returns the number of recent blocks whose bodies and receipts are
+// kept, for the epoch period in effect at the given header. The epoch period can
+// be updated by the operator, so it is read from the state rather than the genesis.
+func (bc *BlockChain) historyLimit(header *types.Header) uint64 {
+	if bc.cacheConfig.HistoryEpochs == 0 {
+		return 0
+	}
+	state, err := bc.StateAt(header.Root)
+	if err != nil {
+		bc.log.Error("Failed to retrieve state for the epoch period", "number", header.Number, "err", err)
+		return 0
+	}
+	period, err := bc.protocolContracts.EpochPeriod(header, state)
+	if err != nil {
+		bc.log.Error("Failed to retrieve the epoch period", "number", header.Number, "err", err)
+		return 0
+	}
+	return bc.cacheConfig.HistoryEpochs * period
+}
+
+// maintainHistory is responsible for the deletion of the bodies and receipts of
+// the ancient blocks beyond the configured history limit, their headers being
+// kept. Decided blocks are final, so no reorg can ever require them again.
+func (bc *BlockChain) maintainHistory() {
+	defer bc.wg.Done()
+
+	// pruneBlocks moves the history tail to HEAD-limit, without overtaking the
+	// transaction index tail which needs the bodies for unindexing.
+	pruneBlocks := func(header *types.Header, done chan struct{}) {
+		defer func() { done <- struct{}{} }()
+
+		head, limit := header.Number.Uint64(), bc.historyLimit(header)
+		if limit == 0 || head+1 <= limit {
+			return
+		}
+		target := head + 1 - limit
+		if bc.txLookupLimit != 0 {
+			if tail := rawdb.ReadTxIndexTail(bc.db); tail != nil && *tail < target {
+				target = *tail
+			}
+		}
+		prev := rawdb.ReadHistoryTail(bc.db)
+		if target <= prev {
+			return
+		}
+		tail, err := rawdb.PruneHistory(bc.db, target)
+		if err != nil {
+			bc.log.Error("Failed to prune chain history", "target", target, "err", err)
+			return
+		}
+		if tail > prev {
+			bc.log.Info("Pruned chain history", "tail", tail, "pruned", tail-prev)
+		}
+	}
+	var (
+		done   chan struct{}                  // Non-nil if background pruning routine is active.
+		headCh = make(chan ChainHeadEvent, 1) // Buffered to avoid locking up the event feed
+	)
+	sub := bc.SubscribeChainHeadEvent(headCh)
+	if sub == nil {
+		return
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case head := <-headCh:
+			if done == nil {
+				done = make(chan struct{})
+				go pruneBlocks(head.Block.Header(), done)
+			}
+		case <-done:
+			done = nil
+		case <-bc.quit:
+			if done != nil {
+				bc.log.Info("Waiting background history pruner to exit")
+				<-done
+			}
+			return
+		}
+	}
+}
+
 // reportBlock logs a bad block error.
 func (bc *BlockChain) reportBlock(block *types.Block, receipts types.Receipts, err error) {
 	rawdb.WriteBadBlock(bc.db, block)
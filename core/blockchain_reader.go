@@ -17,6 +17,7 @@
 package core
 
 import (
+    "fmt"
     "math/big"
 
     "github.com/autonity/autonity/common"
@@ -43,6 +44,19 @@ func (bc *BlockChain) CurrentBlock() *types.Block {
     return bc.currentBlock.Load().(*types.Block)
 }
 
+// CurrentFinalizedBlock retrieves the latest final block of the canonical chain.
+// Blocks are only imported once decided by a quorum of the committee, with
+// their committed seals, so the head block is final as soon as it is written.
+func (bc *BlockChain) CurrentFinalizedBlock() *types.Block {
+    return bc.CurrentBlock()
+}
+
+// CurrentSafeBlock retrieves the latest block of the canonical chain which is
+// safe from reorgs, which is the finalized block with BFT consensus.
+func (bc *BlockChain) CurrentSafeBlock() *types.Block {
+    return bc.CurrentFinalizedBlock()
+}
+
 // CurrentFastBlock retrieves the current fast-sync head block of the canonical
 // chain. The block is retrieved from the blockchain's internal cache.
 func (bc *BlockChain) CurrentFastBlock() *types.Block {
@@ -361,6 +375,37 @@ func (bc *BlockChain) TxLookupLimit() uint64 {
     return bc.txLookupLimit
 }
 
+// HistoryTail retrieves the number of the oldest block whose body and receipts
+// are retained, the older ones having been pruned or skipped by checkpoint sync.
+func (bc *BlockChain) HistoryTail() uint64 {
+    return rawdb.ReadHistoryTail(bc.db)
+}
+
+// Checkpoint retrieves the trusted header the chain was synced from, or nil if
+// it was synced from genesis.
+func (bc *BlockChain) Checkpoint() *types.Header {
+    hash, number := rawdb.ReadSyncCheckpoint(bc.db)
+    if hash == (common.Hash{}) {
+        return nil
+    }
+    return bc.GetHeader(hash, number)
+}
+
+// HeaderBackfillTail retrieves the number of the oldest header present below
+// the sync checkpoint, or nil if all headers down to genesis are present.
+func (bc *BlockChain) HeaderBackfillTail() *uint64 {
+    return rawdb.ReadHeaderBackfillTail(bc.db)
+}
+
+// CheckHistory returns ErrHistoryPruned if the body and receipts of the block
+// with the given number are no longer retained.
+func (bc *BlockChain) CheckHistory(number uint64) error {
+    if tail := bc.HistoryTail(); number < tail {
+        return fmt.Errorf("%w: block #%d is below the history tail #%d", ErrHistoryPruned, number, tail)
+    }
+    return nil
+}
+
 // SubscribeRemovedLogsEvent registers a subscription of RemovedLogsEvent.
 func (bc *BlockChain) SubscribeRemovedLogsEvent(ch chan<- RemovedLogsEvent) event.Subscription {
     return bc.scope.Track(bc.rmLogsFeed.Subscribe(ch))
@@ -381,6 +426,12 @@ func (bc *BlockChain) SubscribeChainSideEvent(ch chan<- ChainSideEvent) event.Su
     return bc.scope.Track(bc.chainSideFeed.Subscribe(ch))
 }
 
+// ForkEvidence retrieves the chains seen conflicting with committed blocks,
+// most recently detected first.
+func (bc *BlockChain) ForkEvidence() []*types.ForkEvidence {
+    return rawdb.ReadAllForkEvidence(bc.db)
+}
+
 // SubscribeLogsEvent registers a subscription of []*types.Log.
 func (bc *BlockChain) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscription {
     return bc.scope.Track(bc.logsFeed.Subscribe(ch))
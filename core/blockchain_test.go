@@ -31,6 +31,8 @@ import (
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/ethash"
+	"github.com/autonity/autonity/consensus/tendermint/core/message"
+	"github.com/autonity/autonity/consensus/tendermint/finality"
 	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/state"
 	"github.com/autonity/autonity/core/types"
@@ -1291,6 +1293,142 @@ func checkLogEvents(t *testing.T, logsCh <-chan []*types.Log, rmLogsCh <-chan Re
 	}
 }
 
+// sealVerifierFaker is a fake engine which verifies the committed seals of the
+// headers as the BFT engine does.
+type sealVerifierFaker struct {
+	consensus.Engine
+}
+
+func (sealVerifierFaker) VerifyCommittedSeals(header, parent *types.Header) error {
+	return finality.VerifySeals(header, parent.Committee)
+}
+
+// Tests that a reorg replacing a block which carries the committed seals of a
+// quorum of its committee is refused, and the conflicting chain is recorded as
+// fork evidence.
+func TestReorgCommittedHeaders(t *testing.T) { testReorgCommitted(t, false) }
+func TestReorgCommittedBlocks(t *testing.T)  { testReorgCommitted(t, true) }
+
+func testReorgCommitted(t *testing.T, full bool) {
+	engine := sealVerifierFaker{ethash.NewFaker()}
+	db, blockchain, err := newCanonical(t, engine, 0, full)
+	if err != nil {
+		t.Fatalf("failed to create pristine chain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	key, _ := crypto.GenerateKey()
+	committee := types.Committee{{Address: crypto.PubkeyToAddress(key.PublicKey), VotingPower: big.NewInt(1)}}
+
+	// Create an easy chain whose second block is committed by the committee
+	// of the first one, and a heavier chain conflicting with it.
+	easy, _ := GenerateChain(params.TestChainConfig, blockchain.CurrentBlock(), engine, db, 3, func(i int, b *BlockGen) {
+		b.OffsetTime(60)
+		b.header.MixDigest = types.BFTDigest
+		b.header.Committee = committee
+	})
+	header := easy[1].Header()
+	seal, err := crypto.Sign(message.PrepareCommittedSeal(header.Hash(), 0, header.Number).Bytes(), key)
+	if err != nil {
+		t.Fatalf("failed to sign committed seal: %v", err)
+	}
+	header.CommittedSeals = [][]byte{seal}
+	easy[1] = types.NewBlockWithHeader(header).WithBody(easy[1].Transactions(), easy[1].Uncles())
+
+	heavy, _ := GenerateChain(params.TestChainConfig, blockchain.CurrentBlock(), engine, db, 3, func(i int, b *BlockGen) {
+		b.OffsetTime(-9)
+		b.header.MixDigest = types.BFTDigest
+	})
+	if full {
+		if _, err := blockchain.InsertChain(easy); err != nil {
+			t.Fatalf("failed to insert easy chain: %v", err)
+		}
+		if _, err := blockchain.InsertChain(heavy); !errors.Is(err, ErrCommittedReorg) {
+			t.Fatalf("heavy chain insertion error mismatch: have %v, want %v", err, ErrCommittedReorg)
+		}
+	} else {
+		headers := func(blocks types.Blocks) []*types.Header {
+			headers := make([]*types.Header, len(blocks))
+			for i, block := range blocks {
+				headers[i] = block.Header()
+			}
+			return headers
+		}
+		if _, err := blockchain.InsertHeaderChain(headers(easy), 1); err != nil {
+			t.Fatalf("failed to insert easy chain: %v", err)
+		}
+		if _, err := blockchain.InsertHeaderChain(headers(heavy), 1); !errors.Is(err, ErrCommittedReorg) {
+			t.Fatalf("heavy chain insertion error mismatch: have %v, want %v", err, ErrCommittedReorg)
+		}
+	}
+	if head := blockchain.CurrentHeader(); head.Hash() != easy[2].Hash() {
+		t.Fatalf("head header mismatch: have #%d [%x], want #%d [%x]", head.Number, head.Hash(), easy[2].Number(), easy[2].Hash())
+	}
+	evidence := blockchain.ForkEvidence()
+	if len(evidence) != 1 {
+		t.Fatalf("fork evidence count mismatch: have %d, want 1", len(evidence))
+	}
+	if have, want := evidence[0].Committed.Hash(), easy[1].Hash(); have != want {
+		t.Errorf("committed block mismatch: have %x, want %x", have, want)
+	}
+	if have, want := evidence[0].Conflicting[0].Hash(), heavy[0].Hash(); have != want {
+		t.Errorf("conflicting chain mismatch: have %x, want %x", have, want)
+	}
+}
+
+// Tests that a chain anchored at a sync checkpoint only accepts the headers
+// linking up to it as backfill.
+func TestBackfillHeaders(t *testing.T) {
+	db, blockchain, err := newCanonical(t, ethash.NewFaker(), 0, true)
+	if err != nil {
+		t.Fatalf("failed to create pristine chain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	blocks, _ := GenerateChain(params.TestChainConfig, blockchain.Genesis(), ethash.NewFaker(), db, 8, nil)
+	headers := make([]*types.Header, len(blocks))
+	for i, block := range blocks {
+		headers[i] = block.Header()
+	}
+	checkpoint := headers[5]
+	if err := blockchain.InitCheckpoint(checkpoint); err != nil {
+		t.Fatalf("failed to anchor chain: %v", err)
+	}
+	if err := blockchain.InitCheckpoint(checkpoint); err != nil {
+		t.Fatalf("failed to anchor chain again: %v", err)
+	}
+	if err := blockchain.InitCheckpoint(headers[6]); !errors.Is(err, ErrCheckpointConflict) {
+		t.Fatalf("conflicting checkpoint error mismatch: have %v, want %v", err, ErrCheckpointConflict)
+	}
+	if head := blockchain.CurrentHeader(); head.Hash() != checkpoint.Hash() {
+		t.Fatalf("head header mismatch: have #%d, want #%d", head.Number, checkpoint.Number)
+	}
+	if err := blockchain.CheckHistory(checkpoint.Number.Uint64()); !errors.Is(err, ErrHistoryPruned) {
+		t.Fatalf("checkpoint history error mismatch: have %v, want %v", err, ErrHistoryPruned)
+	}
+	// Headers not directly below the backfill tail are rejected
+	if err := blockchain.BackfillHeaders(headers[0:3]); !errors.Is(err, ErrInvalidBackfill) {
+		t.Fatalf("detached backfill error mismatch: have %v, want %v", err, ErrInvalidBackfill)
+	}
+	if err := blockchain.BackfillHeaders(headers[2:5]); err != nil {
+		t.Fatalf("failed to backfill headers: %v", err)
+	}
+	if tail := blockchain.HeaderBackfillTail(); tail == nil || *tail != 3 {
+		t.Fatalf("backfill tail mismatch: have %v, want 3", tail)
+	}
+	if err := blockchain.BackfillHeaders(headers[0:2]); err != nil {
+		t.Fatalf("failed to backfill headers: %v", err)
+	}
+	if tail := blockchain.HeaderBackfillTail(); tail != nil {
+		t.Fatalf("backfill tail left: %d", *tail)
+	}
+	for _, header := range headers[:6] {
+		if have := blockchain.GetHeaderByNumber(header.Number.Uint64()); have == nil || have.Hash() != header.Hash() {
+			t.Fatalf("backfilled header #%d mismatch", header.Number)
+		}
+	}
+}
+
 func TestReorgSideEvent(t *testing.T) {
 	var (
 		db      = rawdb.NewMemoryDatabase()
@@ -3492,3 +3630,27 @@ func TestEIP1559Transition(t *testing.T) {
 		t.Fatalf("sender balance incorrect: expected %d, got %d", expected, actual)
 	}
 }
+
+// Tests that the retained history is sized with the epoch period of the state,
+// which can differ from the one of the genesis configuration.
+func TestHistoryLimit(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	(&Genesis{BaseFee: big.NewInt(params.InitialBaseFee), Config: params.TestChainConfig}).MustCommit(db)
+
+	config := *params.TestChainConfig
+	autonityConfig := *config.AutonityContractConfig
+	autonityConfig.EpochPeriod *= 2
+	config.AutonityContractConfig = &autonityConfig
+
+	cacheConfig := *defaultCacheConfig
+	cacheConfig.HistoryEpochs = 3
+	chain, err := NewBlockChain(db, &cacheConfig, &config, ethash.NewFaker(), vm.Config{}, nil, NewTxSenderCacher(), nil, FakeContractBackendProvider(t), log.Root())
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+	defer chain.Stop()
+
+	if have, want := chain.historyLimit(chain.CurrentHeader()), 3*params.TestChainConfig.AutonityContractConfig.EpochPeriod; have != want {
+		t.Fatalf("history limit mismatch: have %d, want %d", have, want)
+	}
+}
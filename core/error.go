@@ -32,6 +32,22 @@ var (
     // ErrNoGenesis is returned when there is no Genesis Block.
     ErrNoGenesis = errors.New("genesis not found in chain")
 
+    // ErrHistoryPruned is returned when the body or receipts of a block are
+    // requested, but they have been pruned from the local chain history.
+    ErrHistoryPruned = errors.New("block history pruned")
+
+    // ErrCommittedReorg is returned if a chain reorganisation would replace a
+    // block carrying the committed seals of a quorum of its committee.
+    ErrCommittedReorg = errors.New("reorg of committed block")
+
+    // ErrCheckpointConflict is returned if the chain can't be anchored at a sync
+    // checkpoint, as it already holds a different chain.
+    ErrCheckpointConflict = errors.New("sync checkpoint conflict")
+
+    // ErrInvalidBackfill is returned if backfilled headers don't link up to the
+    // sync checkpoint.
+    ErrInvalidBackfill = errors.New("invalid header backfill")
+
     errSideChainReceipts = errors.New("side blocks can't be accepted as ancient chain data")
 )
 
@@ -54,10 +54,12 @@ func NewEVMBlockContext(header *types.Header, chain ChainContext, author *common
 	if header.Difficulty.Cmp(common.Big0) == 0 {
 		random = &header.MixDigest
 	}
+	getHash := GetHashFn(header, chain)
 	return vm.BlockContext{
 		CanTransfer: CanTransfer,
 		Transfer:    Transfer,
-		GetHash:     GetHashFn(header, chain),
+		GetHash:     getHash,
+		GetHeader:   GetHeaderFn(chain, getHash),
 		Coinbase:    beneficiary,
 		BlockNumber: new(big.Int).Set(header.Number),
 		Time:        new(big.Int).SetUint64(header.Time),
@@ -71,10 +73,12 @@ func NewEVMBlockContext(header *types.Header, chain ChainContext, author *common
 // Used by the Autonity Contract
 func GetDefaultEVM(chain *BlockChain) func(header *types.Header, origin common.Address, statedb vm.StateDB) *vm.EVM {
 	return func(header *types.Header, origin common.Address, statedb vm.StateDB) *vm.EVM {
+		getHash := GetHashFn(header, chain)
 		evmContext := vm.BlockContext{
 			CanTransfer: CanTransfer,
 			Transfer:    Transfer,
-			GetHash:     GetHashFn(header, chain),
+			GetHash:     getHash,
+			GetHeader:   GetHeaderFn(chain, getHash),
 			Coinbase:    header.Coinbase,
 			BlockNumber: new(big.Int).Set(header.Number),
 			Time:        new(big.Int).SetUint64(header.Time),
@@ -147,6 +151,18 @@ func GetHashFn(ref *types.Header, chain ChainContext) func(n uint64) common.Hash
 	}
 }
 
+// GetHeaderFn returns a GetHeaderFunc which retrieves the headers of the chain
+// by number, resolving their hashes with the given GetHashFunc.
+func GetHeaderFn(chain ChainContext, getHash vm.GetHashFunc) vm.GetHeaderFunc {
+	return func(n uint64) *types.Header {
+		hash := getHash(n)
+		if hash == (common.Hash{}) {
+			return nil
+		}
+		return chain.GetHeader(hash, n)
+	}
+}
+
 // CanTransfer checks whether there are enough funds in the address' account to make a transfer.
 // This does not take the necessary gas in to account to make the transfer valid.
 func CanTransfer(db vm.StateDB, addr common.Address, amount *big.Int) bool {
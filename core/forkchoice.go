@@ -17,63 +17,37 @@
 package core
 
 import (
-    crand "crypto/rand"
     "errors"
     "math/big"
-    mrand "math/rand"
 
     "github.com/autonity/autonity/common"
-    "github.com/autonity/autonity/common/math"
     "github.com/autonity/autonity/core/types"
-    "github.com/autonity/autonity/log"
-    "github.com/autonity/autonity/params"
 )
 
 // ChainReader defines a small collection of methods needed to access the local
-// blockchain during header verification. It's implemented by both blockchain
-// and lightchain.
+// blockchain during fork choice. It's implemented by both blockchain and
+// lightchain.
 type ChainReader interface {
-    // Config retrieves the header chain's chain configuration.
-    Config() *params.ChainConfig
-
     // GetTd returns the total difficulty of a local block.
     GetTd(common.Hash, uint64) *big.Int
 }
 
-// ForkChoice is the fork chooser based on the highest total difficulty of the
-// chain(the fork choice used in the eth1) and the external fork choice (the fork
-// choice used in the eth2). This main goal of this ForkChoice is not only for
-// offering fork choice during the eth1/2 merge phase, but also keep the compatibility
-// for all other proof-of-work networks.
+// ForkChoice is the fork chooser of a chain decided by the BFT consensus. Blocks
+// are only imported with the committed seals of a quorum of the committee, which
+// makes them final on commit: the canonical chain is extended by the heaviest
+// chain, but a block is never replaced by a sibling of the same weight, and
+// there is no random or miner-preference tie-breaking as in proof-of-work.
 type ForkChoice struct {
     chain ChainReader
-    rand  *mrand.Rand
-
-    // preserve is a helper function used in td fork choice.
-    // Miners will prefer to choose the local mined block if the
-    // local td is equal to the extern one. It can be nil for light
-    // client
-    preserve func(header *types.Header) bool
 }
 
-func NewForkChoice(chainReader ChainReader, preserve func(header *types.Header) bool) *ForkChoice {
-    // Seed a fast but crypto originating random generator
-    seed, err := crand.Int(crand.Reader, big.NewInt(math.MaxInt64))
-    if err != nil {
-        log.Crit("Failed to initialize random seed", "err", err)
-    }
-    return &ForkChoice{
-        chain:    chainReader,
-        rand:     mrand.New(mrand.NewSource(seed.Int64())),
-        preserve: preserve,
-    }
+func NewForkChoice(chainReader ChainReader) *ForkChoice {
+    return &ForkChoice{chain: chainReader}
 }
 
-// ReorgNeeded returns whether the reorg should be applied
-// based on the given external header and local canonical chain.
-// In the td mode, the new head is chosen if the corresponding
-// total difficulty is higher. In the extern mode, the trusted
-// header is always selected as the head.
+// ReorgNeeded returns whether the reorg should be applied based on the given
+// external header and local canonical chain. The new head is only chosen if
+// its total difficulty is strictly higher than the current head's one.
 func (f *ForkChoice) ReorgNeeded(current *types.Header, header *types.Header) (bool, error) {
     var (
         localTD  = f.chain.GetTd(current.Hash(), current.Number.Uint64())
@@ -82,27 +56,5 @@ func (f *ForkChoice) ReorgNeeded(current *types.Header, header *types.Header) (b
     if localTD == nil || externTd == nil {
         return false, errors.New("missing td")
     }
-    // Accept the new header as the chain head if the transition
-    // is already triggered. We assume all the headers after the
-    // transition come from the trusted consensus layer.
-    if ttd := f.chain.Config().TerminalTotalDifficulty; ttd != nil && ttd.Cmp(externTd) <= 0 {
-        return true, nil
-    }
-    // If the total difficulty is higher than our known, add it to the canonical chain
-    // Second clause in the if statement reduces the vulnerability to selfish mining.
-    // Please refer to http://www.cs.cornell.edu/~ie53/publications/btcProcFC.pdf
-    reorg := externTd.Cmp(localTD) > 0
-    if !reorg && externTd.Cmp(localTD) == 0 {
-        number, headNumber := header.Number.Uint64(), current.Number.Uint64()
-        if number < headNumber {
-            reorg = true
-        } else if number == headNumber {
-            var currentPreserve, externPreserve bool
-            if f.preserve != nil {
-                currentPreserve, externPreserve = f.preserve(current), f.preserve(header)
-            }
-            reorg = !currentPreserve && (externPreserve || f.rand.Float64() < 0.5)
-        }
-    }
-    return reorg, nil
+    return externTd.Cmp(localTD) > 0, nil
 }
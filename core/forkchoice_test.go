@@ -0,0 +1,62 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
+)
+
+type testTdReader map[common.Hash]*big.Int
+
+func (r testTdReader) GetTd(hash common.Hash, _ uint64) *big.Int {
+	return r[hash]
+}
+
+func TestForkChoiceReorgNeeded(t *testing.T) {
+	header := func(number int64, extra byte) *types.Header {
+		return &types.Header{Number: big.NewInt(number), Extra: []byte{extra}}
+	}
+	current := header(10, 0)
+	sibling := header(10, 1)
+	longer := header(11, 0)
+	shorter := header(9, 0)
+	unknown := header(12, 0)
+
+	tds := testTdReader{
+		current.Hash(): big.NewInt(10),
+		sibling.Hash(): big.NewInt(10),
+		longer.Hash():  big.NewInt(11),
+		shorter.Hash(): big.NewInt(10),
+	}
+	forker := NewForkChoice(tds)
+
+	tests := []struct {
+		name   string
+		header *types.Header
+		reorg  bool
+	}{
+		{"heavier chain", longer, true},
+		{"sibling of equal weight", sibling, false},
+		{"shorter chain of equal weight", shorter, false},
+		{"current head", current, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// repeat the decision, which must not be randomised
+			for i := 0; i < 16; i++ {
+				reorg, err := forker.ReorgNeeded(current, test.header)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if reorg != test.reorg {
+					t.Fatalf("reorg mismatch: have %v, want %v", reorg, test.reorg)
+				}
+			}
+		})
+	}
+	if _, err := forker.ReorgNeeded(current, unknown); err == nil {
+		t.Fatal("expected an error for a header without total difficulty")
+	}
+}
@@ -178,10 +178,13 @@ func (e *GenesisMismatchError) Error() string {
 //
 // The returned chain configuration is never nil.
 func SetupGenesisBlock(db ethdb.Database, genesis *Genesis) (*params.ChainConfig, common.Hash, error) {
-	return SetupGenesisBlockWithOverride(db, genesis, nil, nil)
+	return SetupGenesisBlockWithOverride(db, genesis, rawdb.HashScheme, nil, nil)
 }
 
-func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, overrideArrowGlacier, overrideTerminalTotalDifficulty *big.Int) (*params.ChainConfig, common.Hash, error) {
+// SetupGenesisBlockWithOverride is SetupGenesisBlock with the genesis state
+// stored with the given trie node scheme, and optional overrides of the chain
+// configuration.
+func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, scheme string, overrideArrowGlacier, overrideTerminalTotalDifficulty *big.Int) (*params.ChainConfig, common.Hash, error) {
 	if genesis != nil && genesis.Config == nil {
 		return params.AllEthashProtocolChanges, common.Hash{}, errGenesisNoConfig
 	}
@@ -194,7 +197,7 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 		} else {
 			log.Info("Writing custom genesis block")
 		}
-		block, err := genesis.Commit(db)
+		block, err := genesis.CommitWithScheme(db, scheme)
 		if err != nil {
 			return genesis.Config, common.Hash{}, err
 		}
@@ -203,7 +206,7 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 	// We have the genesis block in database(perhaps in ancient database)
 	// but the corresponding state is missing.
 	header := rawdb.ReadHeader(db, stored, 0)
-	if _, err := state.New(header.Root, state.NewDatabaseWithConfig(db, nil), nil); err != nil {
+	if !hasGenesisState(db, header.Root, scheme) {
 		if genesis == nil {
 			genesis = DefaultGenesisBlock()
 		}
@@ -216,7 +219,7 @@ func SetupGenesisBlockWithOverride(db ethdb.Database, genesis *Genesis, override
 		if hash != stored {
 			return genesis.Config, hash, &GenesisMismatchError{stored, hash}
 		}
-		block, err := genesis.Commit(db)
+		block, err := genesis.CommitWithScheme(db, scheme)
 		if err != nil {
 			return genesis.Config, hash, err
 		}
@@ -289,9 +292,28 @@ func (g *Genesis) configOrDefault(ghash common.Hash) *params.ChainConfig {
 	}
 }
 
+// hasGenesisState reports whether the state of the genesis block, with the
+// given root, is present in the database.
+func hasGenesisState(db ethdb.Database, root common.Hash, scheme string) bool {
+	if scheme == rawdb.PathScheme {
+		// Only the latest state is stored with the path-based scheme, so the
+		// genesis state has been committed if there is any state at all.
+		blob, _ := rawdb.ReadAccountTrieNode(db, nil)
+		return len(blob) > 0
+	}
+	_, err := state.New(root, state.NewDatabaseWithConfig(db, nil), nil)
+	return err == nil
+}
+
 // ToBlock creates the genesis block and writes state of a genesis specification
 // to the given database (or discards it if nil).
 func (g *Genesis) ToBlock(db ethdb.Database) (*types.Block, error) {
+	return g.toBlockWithScheme(db, rawdb.HashScheme)
+}
+
+// toBlockWithScheme is ToBlock with the state stored with the given trie node
+// scheme.
+func (g *Genesis) toBlockWithScheme(db ethdb.Database, scheme string) (*types.Block, error) {
 	if g.Config.AutonityContractConfig == nil {
 		return nil, fmt.Errorf("autonity config section missing in genesis")
 	}
@@ -299,6 +321,13 @@ func (g *Genesis) ToBlock(db ethdb.Database) (*types.Block, error) {
 	if err := g.Config.AutonityContractConfig.Prepare(); err != nil {
 		return nil, err
 	}
+	if g.Config.GovernanceConfig != nil {
+		if err := g.Config.GovernanceConfig.SetDefaults(); err != nil {
+			return nil, err
+		}
+		// the Governance contract operates the protocol contracts
+		g.Config.AutonityContractConfig.Operator = params.GovernanceContractAddress
+	}
 	if g.Difficulty == nil {
 		g.Difficulty = params.GenesisDifficulty
 	}
@@ -308,7 +337,7 @@ func (g *Genesis) ToBlock(db ethdb.Database) (*types.Block, error) {
 	if db == nil {
 		db = rawdb.NewMemoryDatabase()
 	}
-	statedb, err := state.New(common.Hash{}, state.NewDatabase(db), nil)
+	statedb, err := state.New(common.Hash{}, state.NewDatabaseWithConfig(db, &trie.Config{Scheme: scheme}), nil)
 	if err != nil {
 		panic(err)
 	}
@@ -364,8 +393,12 @@ func (g *Genesis) ToBlock(db ethdb.Database) (*types.Block, error) {
 			head.BaseFee = new(big.Int).SetUint64(params.InitialBaseFee)
 		}
 	}
-	statedb.Commit(false)
-	statedb.Database().TrieDB().Commit(root, true, nil)
+	if _, err := statedb.Commit(false); err != nil {
+		return nil, err
+	}
+	if err := statedb.Database().TrieDB().Commit(root, true, nil); err != nil {
+		return nil, err
+	}
 
 	return types.NewBlock(head, nil, nil, nil, trie.NewStackTrie(nil)), nil
 }
@@ -391,6 +424,12 @@ func genesisEVM(genesis *Genesis, statedb vm.StateDB) *vm.EVM {
 // Commit writes the block and state of a genesis specification to the database.
 // The block is committed as the canonical head block.
 func (g *Genesis) Commit(db ethdb.Database) (*types.Block, error) {
+	return g.CommitWithScheme(db, rawdb.HashScheme)
+}
+
+// CommitWithScheme is Commit with the genesis state stored with the given trie
+// node scheme.
+func (g *Genesis) CommitWithScheme(db ethdb.Database, scheme string) (*types.Block, error) {
 	if g.Config == nil {
 		g.Config = params.TestChainConfig
 	}
@@ -399,7 +438,7 @@ func (g *Genesis) Commit(db ethdb.Database) (*types.Block, error) {
 		return nil, err
 	}
 
-	block, err := g.ToBlock(db)
+	block, err := g.toBlockWithScheme(db, scheme)
 	if err != nil {
 		return nil, err
 	}
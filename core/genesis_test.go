@@ -24,12 +24,16 @@ import (
 	"github.com/autonity/autonity/crypto"
 	"github.com/autonity/autonity/log"
 
+	"github.com/autonity/autonity/accounts/abi"
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/math"
 	"github.com/autonity/autonity/consensus/ethash"
 	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/core/state"
 	"github.com/autonity/autonity/core/vm"
 	"github.com/autonity/autonity/ethdb"
 	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/params/generated"
 	"github.com/davecgh/go-spew/spew"
 )
 
@@ -253,3 +257,71 @@ func TestGenesis_InitialNewton_Bindings(t *testing.T) {
 	}
 
 }
+
+func TestGenesis_GovernanceContract(t *testing.T) {
+	config := *params.TestChainConfig
+	autonityConfig := *config.AutonityContractConfig
+	config.AutonityContractConfig = &autonityConfig
+	config.GovernanceConfig = &params.GovernanceGenesis{
+		VotingPeriod:   100,
+		Quorum:         2_000,
+		Threshold:      5_000,
+		Timelock:       10,
+		MinVotingPower: (*math.HexOrDecimal256)(big.NewInt(1)),
+	}
+	genesis := &Genesis{
+		BaseFee: big.NewInt(params.InitialBaseFee),
+		Config:  &config,
+	}
+	db := rawdb.NewMemoryDatabase()
+	block, err := genesis.Commit(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	statedb, err := state.New(block.Root(), state.NewDatabase(db), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statedb.GetCode(params.GovernanceContractAddress)) == 0 {
+		t.Fatal("governance contract not deployed")
+	}
+
+	// The Governance contract is the operator of the protocol contracts
+	call := func(address common.Address, contractABI *abi.ABI, method string) []any {
+		input, err := contractABI.Pack(method)
+		if err != nil {
+			t.Fatal(err)
+		}
+		output, _, err := genesisEVM(genesis, statedb).Call(vm.AccountRef(common.Address{}), address, input, math.MaxUint64, common.Big0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result, err := contractABI.Unpack(method, output)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+	if operator := call(params.AutonityContractAddress, &generated.AutonityAbi, "getOperator")[0]; operator != params.GovernanceContractAddress {
+		t.Fatalf("autonity operator mismatch: have %v, want %v", operator, params.GovernanceContractAddress)
+	}
+	if operator := call(params.UpgradeManagerContractAddress, &generated.UpgradeManagerAbi, "operator")[0]; operator != params.GovernanceContractAddress {
+		t.Fatalf("upgrade manager operator mismatch: have %v, want %v", operator, params.GovernanceContractAddress)
+	}
+	governanceConfig := call(params.GovernanceContractAddress, &generated.GovernanceAbi, "getConfig")[0].(struct {
+		VotingPeriod   *big.Int `json:"votingPeriod"`
+		Quorum         *big.Int `json:"quorum"`
+		Threshold      *big.Int `json:"threshold"`
+		Timelock       *big.Int `json:"timelock"`
+		MinVotingPower *big.Int `json:"minVotingPower"`
+	})
+	if governanceConfig.VotingPeriod.Uint64() != 100 || governanceConfig.Quorum.Uint64() != 2_000 {
+		t.Fatalf("governance config mismatch: %+v", governanceConfig)
+	}
+
+	// An invalid configuration fails the genesis
+	config.GovernanceConfig.Threshold = 0
+	if _, err := genesis.Commit(rawdb.NewMemoryDatabase()); err == nil {
+		t.Fatal("expected an error for an invalid governance configuration")
+	}
+}
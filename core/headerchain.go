@@ -206,6 +206,77 @@ func (hc *HeaderChain) Reorg(headers []*types.Header) error {
 	return nil
 }
 
+// checkReorg ensures that switching the head to the given chain of headers
+// does not replace any committed header of the local canonical chain. The
+// conflicting chain is recorded as fork evidence otherwise.
+func (hc *HeaderChain) checkReorg(headers []*types.Header) error {
+	// Skip the headers already on the canonical chain
+	for len(headers) > 0 && hc.GetCanonicalHash(headers[0].Number.Uint64()) == headers[0].Hash() {
+		headers = headers[1:]
+	}
+	if len(headers) == 0 || headers[0].ParentHash == hc.currentHeaderHash {
+		return nil
+	}
+	// Find the fork point, collecting the known headers of the new chain above it
+	conflicting := append([]*types.Header{}, headers...)
+	for header := headers[0]; ; {
+		number := header.Number.Uint64() - 1
+		if hc.GetCanonicalHash(number) == header.ParentHash {
+			break
+		}
+		if header = hc.GetHeader(header.ParentHash, number); header == nil {
+			return consensus.ErrUnknownAncestor
+		}
+		conflicting = append([]*types.Header{header}, conflicting...)
+	}
+	ancestor := conflicting[0].Number.Uint64() - 1
+	for old := hc.CurrentHeader(); old != nil && old.Number.Uint64() > ancestor; old = hc.GetHeader(old.ParentHash, old.Number.Uint64()-1) {
+		if hc.committed(old) {
+			hc.reportFork(old, conflicting)
+			return fmt.Errorf("%w: number %d, hash %x", ErrCommittedReorg, old.Number, old.Hash())
+		}
+	}
+	return nil
+}
+
+// committed reports whether the header carries the committed seals of a quorum
+// of the committee of its parent, as verified by the consensus engine.
+func (hc *HeaderChain) committed(header *types.Header) bool {
+	verifier, ok := hc.engine.(consensus.SealVerifier)
+	if !ok || header.Number.Sign() == 0 || len(header.CommittedSeals) == 0 {
+		return false
+	}
+	parent := hc.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil {
+		return false
+	}
+	return verifier.VerifyCommittedSeals(header, parent) == nil
+}
+
+// reportFork records the chain conflicting with the committed header as fork
+// evidence and logs it loudly. The conflicting chain is given from its fork
+// point upwards.
+func (hc *HeaderChain) reportFork(committed *types.Header, conflicting []*types.Header) {
+	evidence := &types.ForkEvidence{
+		Committed:   committed,
+		Conflicting: conflicting,
+		Time:        uint64(time.Now().Unix()),
+	}
+	rawdb.WriteForkEvidence(hc.chainDb, evidence)
+	blockReorgRefusedMeter.Mark(1)
+
+	log.Error(fmt.Sprintf(`
+########## FORK OF COMMITTED BLOCK #########
+Number: %v
+Committed: 0x%x
+Conflicting: 0x%x (%d blocks)
+
+The committed block was not replaced: either the safety of the consensus
+was violated or the conflicting chain was imported from a faulty source.
+############################################
+`, committed.Number, committed.Hash(), conflicting[0].Hash(), len(conflicting)))
+}
+
 // WriteHeaders writes a chain of headers into the local chain, given that the
 // parents are already known. The chain head header won't be updated in this
 // function, the additional setChainHead is expected in order to finish the entire
@@ -301,6 +372,10 @@ func (hc *HeaderChain) writeHeadersAndSetHead(headers []*types.Header, forker *F
 	if hc.GetCanonicalHash(lastHeader.Number.Uint64()) == lastHash && lastHeader.Number.Uint64() <= hc.CurrentHeader().Number.Uint64() {
 		return result, nil
 	}
+	// Committed headers are final, refuse to replace any of them
+	if err := hc.checkReorg(headers); err != nil {
+		return nil, err
+	}
 	// Apply the reorg operation
 	if err := hc.Reorg(headers); err != nil {
 		return nil, err
@@ -283,6 +283,88 @@ func WriteTxIndexTail(db ethdb.KeyValueWriter, number uint64) {
 	}
 }
 
+// ReadSyncCheckpoint retrieves the number and hash of the trusted header the
+// chain was synced from. A zero hash is returned if the chain was synced from
+// genesis.
+func ReadSyncCheckpoint(db ethdb.KeyValueReader) (common.Hash, uint64) {
+	data, _ := db.Get(syncCheckpointKey)
+	if len(data) != 8+common.HashLength {
+		return common.Hash{}, 0
+	}
+	return common.BytesToHash(data[8:]), binary.BigEndian.Uint64(data[:8])
+}
+
+// WriteSyncCheckpoint stores the number and hash of the trusted header the
+// chain is synced from.
+func WriteSyncCheckpoint(db ethdb.KeyValueWriter, hash common.Hash, number uint64) {
+	if err := db.Put(syncCheckpointKey, append(encodeBlockNumber(number), hash.Bytes()...)); err != nil {
+		log.Crit("Failed to store the sync checkpoint", "err", err)
+	}
+}
+
+// ReadHeaderBackfillTail retrieves the number of the oldest header backfilled
+// below the sync checkpoint. If the corresponding entry is non-existent in
+// database it means the backfilling has been finished.
+func ReadHeaderBackfillTail(db ethdb.KeyValueReader) *uint64 {
+	data, _ := db.Get(headerBackfillTailKey)
+	if len(data) != 8 {
+		return nil
+	}
+	number := binary.BigEndian.Uint64(data)
+	return &number
+}
+
+// WriteHeaderBackfillTail stores the number of the oldest backfilled header
+// into database.
+func WriteHeaderBackfillTail(db ethdb.KeyValueWriter, number uint64) {
+	if err := db.Put(headerBackfillTailKey, encodeBlockNumber(number)); err != nil {
+		log.Crit("Failed to store the header backfill tail", "err", err)
+	}
+}
+
+// DeleteHeaderBackfillTail removes the header backfill tail once all headers
+// down to genesis are present.
+func DeleteHeaderBackfillTail(db ethdb.KeyValueWriter) {
+	if err := db.Delete(headerBackfillTailKey); err != nil {
+		log.Crit("Failed to delete the header backfill tail", "err", err)
+	}
+}
+
+// ReadHistoryTail retrieves the number of the oldest block whose body and
+// receipts are both retained, the older ones having been pruned from the
+// ancient store or never downloaded when syncing from a checkpoint.
+func ReadHistoryTail(db ethdb.Reader) uint64 {
+	tail := readAncientHistoryTail(db)
+	if hash, number := ReadSyncCheckpoint(db); hash != (common.Hash{}) && number+1 > tail {
+		tail = number + 1
+	}
+	return tail
+}
+
+// readAncientHistoryTail retrieves the highest tail of the ancient bodies and
+// receipts tables.
+func readAncientHistoryTail(db ethdb.AncientReader) uint64 {
+	var tail uint64
+	for _, kind := range []string{freezerBodiesTable, freezerReceiptTable} {
+		if number, err := db.AncientTail(kind); err == nil && number > tail {
+			tail = number
+		}
+	}
+	return tail
+}
+
+// PruneHistory deletes the bodies and receipts of the ancient blocks below the
+// given number, keeping their headers. As the ancient store deletes data in
+// chunks, the returned new history tail might be lower than requested.
+func PruneHistory(db ethdb.AncientStore, number uint64) (uint64, error) {
+	for _, kind := range []string{freezerBodiesTable, freezerReceiptTable} {
+		if _, err := db.TruncateTail(kind, number); err != nil {
+			return 0, err
+		}
+	}
+	return readAncientHistoryTail(db), nil
+}
+
 // ReadFastTxLookupLimit retrieves the tx lookup limit used in fast sync.
 func ReadFastTxLookupLimit(db ethdb.KeyValueReader) *uint64 {
 	data, _ := db.Get(fastTxLookupLimitKey)
@@ -350,6 +432,10 @@ func ReadHeaderRange(db ethdb.Reader, number uint64, count uint64) []rlp.RawValu
 	if err == nil && uint64(len(data)) == count {
 		// the data is on the order [h, h+1, .., n] -- reordering needed
 		for i := range data {
+			// Stop at the placeholders of the headers frozen before being backfilled
+			if len(data[len(data)-1-i]) == 0 {
+				break
+			}
 			rlpHeaders = append(rlpHeaders, data[len(data)-1-i])
 		}
 	}
@@ -988,6 +1074,54 @@ func DeleteBadBlocks(db ethdb.KeyValueWriter) {
 	}
 }
 
+const forkEvidenceToKeep = 16
+
+// ReadAllForkEvidence retrieves all the fork evidence in the database, most
+// recently detected first.
+func ReadAllForkEvidence(db ethdb.Reader) []*types.ForkEvidence {
+	blob, err := db.Get(forkEvidenceKey)
+	if err != nil {
+		return nil
+	}
+	var evidence []*types.ForkEvidence
+	if err := rlp.DecodeBytes(blob, &evidence); err != nil {
+		return nil
+	}
+	return evidence
+}
+
+// WriteForkEvidence serializes the fork evidence into the database. If the
+// cumulated evidence exceeds the limitation, the oldest will be dropped.
+func WriteForkEvidence(db ethdb.KeyValueStore, evidence *types.ForkEvidence) {
+	blob, err := db.Get(forkEvidenceKey)
+	if err != nil {
+		log.Warn("Failed to load old fork evidence", "error", err)
+	}
+	var list []*types.ForkEvidence
+	if len(blob) > 0 {
+		if err := rlp.DecodeBytes(blob, &list); err != nil {
+			log.Crit("Failed to decode old fork evidence", "error", err)
+		}
+	}
+	for _, e := range list {
+		if e.Committed.Hash() == evidence.Committed.Hash() && e.Conflicting[0].Hash() == evidence.Conflicting[0].Hash() {
+			log.Info("Skip duplicated fork evidence", "number", evidence.Committed.Number, "hash", evidence.Committed.Hash())
+			return
+		}
+	}
+	list = append([]*types.ForkEvidence{evidence}, list...)
+	if len(list) > forkEvidenceToKeep {
+		list = list[:forkEvidenceToKeep]
+	}
+	data, err := rlp.EncodeToBytes(list)
+	if err != nil {
+		log.Crit("Failed to encode fork evidence", "err", err)
+	}
+	if err := db.Put(forkEvidenceKey, data); err != nil {
+		log.Crit("Failed to write fork evidence", "err", err)
+	}
+}
+
 // FindCommonAncestor returns the last common ancestor of two block headers
 func FindCommonAncestor(db ethdb.Reader, a, b *types.Header) *types.Header {
 	for bn := b.Number.Uint64(); a.Number.Uint64() > bn; {
@@ -501,6 +501,69 @@ func TestAncientStorage(t *testing.T) {
 	}
 }
 
+// Tests that pruning the chain history deletes the ancient bodies and receipts,
+// but keeps the headers.
+func TestHistoryPruning(t *testing.T) {
+	frdir, err := os.MkdirTemp("", "")
+	if err != nil {
+		t.Fatalf("failed to create temp freezer dir: %v", err)
+	}
+	defer os.RemoveAll(frdir)
+
+	// Use small data files, so that pruning has something to delete
+	f, err := newFreezer(frdir, "", false, 2049, FreezerNoSnappy)
+	if err != nil {
+		t.Fatalf("failed to create freezer: %v", err)
+	}
+	db := &freezerdb{KeyValueStore: NewMemoryDatabase(), AncientStore: f}
+	defer db.Close()
+
+	blocks, receipts := makeTestBlocks(100, 2), makeTestReceipts(100, 2)
+	if _, err := WriteAncientBlocks(db, blocks, receipts, big.NewInt(100)); err != nil {
+		t.Fatalf("failed to write ancient blocks: %v", err)
+	}
+	if tail := ReadHistoryTail(db); tail != 0 {
+		t.Fatalf("unexpected history tail before pruning: %d", tail)
+	}
+	tail, err := PruneHistory(db, 50)
+	if err != nil {
+		t.Fatalf("failed to prune history: %v", err)
+	}
+	if tail == 0 || tail > 50 {
+		t.Fatalf("unexpected history tail: %d", tail)
+	}
+	if have := ReadHistoryTail(db); have != tail {
+		t.Fatalf("history tail mismatch: have %d, want %d", have, tail)
+	}
+	// The tables are pruned by whole data files, the history tail being the
+	// highest of their tails
+	bodiesTail, _ := db.AncientTail(freezerBodiesTable)
+	receiptsTail, _ := db.AncientTail(freezerReceiptTable)
+	if bodiesTail != tail && receiptsTail != tail {
+		t.Fatalf("history tail %d mismatch: bodies %d, receipts %d", tail, bodiesTail, receiptsTail)
+	}
+	for _, block := range blocks {
+		hash, number := block.Hash(), block.NumberU64()
+		if ReadHeader(db, hash, number) == nil {
+			t.Fatalf("header #%d missing", number)
+		}
+		if body := ReadBodyRLP(db, hash, number); (len(body) == 0) != (number < bodiesTail) {
+			t.Fatalf("body #%d availability mismatch: tail %d", number, bodiesTail)
+		}
+		if receipts := ReadReceiptsRLP(db, hash, number); (len(receipts) == 0) != (number < receiptsTail) {
+			t.Fatalf("receipts #%d availability mismatch: tail %d", number, receiptsTail)
+		}
+	}
+	// The ancient store keeps growing after pruning
+	more, moreReceipts := makeTestBlocks(101, 2)[100:], makeTestReceipts(1, 2)
+	if _, err := WriteAncientBlocks(db, more, moreReceipts, big.NewInt(100)); err != nil {
+		t.Fatalf("failed to write ancient blocks after pruning: %v", err)
+	}
+	if ReadBodyRLP(db, more[0].Hash(), 100) == nil {
+		t.Fatalf("body appended after pruning missing")
+	}
+}
+
 func TestCanonicalHashIteration(t *testing.T) {
 	var cases = []struct {
 		from, to uint64
@@ -620,6 +683,140 @@ func BenchmarkWriteAncientBlocks(b *testing.B) {
 	b.SetBytes(totalSize / int64(b.N))
 }
 
+// Tests that the blocks up to the sync checkpoint are frozen without their
+// bodies and receipts, which are never retrieved when syncing from there.
+func TestFreezeSyncCheckpoint(t *testing.T) {
+	frdir, err := os.MkdirTemp("", "")
+	if err != nil {
+		t.Fatalf("failed to create temp freezer dir: %v", err)
+	}
+	defer os.RemoveAll(frdir)
+
+	f, err := newFreezer(frdir, "", false, freezerTableSize, FreezerNoSnappy)
+	if err != nil {
+		t.Fatalf("failed to create freezer: %v", err)
+	}
+	kvdb := NewMemoryDatabase()
+	db := &freezerdb{KeyValueStore: kvdb, AncientStore: f}
+	defer db.Close()
+
+	const checkpoint = 9
+	blocks, receipts := makeTestBlocks(20, 2), makeTestReceipts(20, 2)
+	for i, block := range blocks {
+		hash, number := block.Hash(), block.NumberU64()
+		WriteHeader(db, block.Header())
+		WriteCanonicalHash(db, hash, number)
+		WriteTd(db, hash, number, big.NewInt(int64(number+1)))
+		if number > checkpoint || number == 0 {
+			WriteBody(db, hash, number, block.Body())
+			WriteReceipts(db, hash, number, receipts[i])
+		}
+	}
+	nfdb := &nofreezedb{KeyValueStore: kvdb}
+	if _, err := f.freezeRange(nfdb, 0, 19); err == nil {
+		t.Fatalf("froze blocks with missing bodies")
+	}
+	WriteSyncCheckpoint(db, blocks[checkpoint].Hash(), checkpoint)
+	if tail := ReadHistoryTail(db); tail != checkpoint+1 {
+		t.Fatalf("history tail mismatch: have %d, want %d", tail, checkpoint+1)
+	}
+	hashes, err := f.freezeRange(nfdb, 0, 19)
+	if err != nil {
+		t.Fatalf("failed to freeze blocks: %v", err)
+	}
+	if len(hashes) != len(blocks) {
+		t.Fatalf("frozen blocks mismatch: have %d, want %d", len(hashes), len(blocks))
+	}
+	// Read the blocks from the ancient store only
+	ancients := &freezerdb{KeyValueStore: NewMemoryDatabase(), AncientStore: f}
+	for _, block := range blocks {
+		hash, number := block.Hash(), block.NumberU64()
+		if ReadHeader(ancients, hash, number) == nil {
+			t.Fatalf("frozen header #%d missing", number)
+		}
+		missing := number > 0 && number <= checkpoint
+		if body := ReadBody(ancients, hash, number); (body == nil) != missing {
+			t.Fatalf("frozen body #%d availability mismatch", number)
+		}
+		if receipts := ReadReceiptsRLP(ancients, hash, number); (len(receipts) == 0) != missing {
+			t.Fatalf("frozen receipts #%d availability mismatch", number)
+		}
+	}
+}
+
+// Tests that the blocks above the header backfill tail keep being frozen, with
+// placeholders for the headers yet to be backfilled which are read back from
+// the key-value store once present.
+func TestFreezeHeaderBackfill(t *testing.T) {
+	frdir, err := os.MkdirTemp("", "")
+	if err != nil {
+		t.Fatalf("failed to create temp freezer dir: %v", err)
+	}
+	defer os.RemoveAll(frdir)
+
+	f, err := newFreezer(frdir, "", false, freezerTableSize, FreezerNoSnappy)
+	if err != nil {
+		t.Fatalf("failed to create freezer: %v", err)
+	}
+	kvdb := NewMemoryDatabase()
+	db := &freezerdb{KeyValueStore: kvdb, AncientStore: f}
+	defer db.Close()
+
+	const (
+		checkpoint = 9
+		tail       = 5
+	)
+	blocks, receipts := makeTestBlocks(20, 2), makeTestReceipts(20, 2)
+	for i, block := range blocks {
+		hash, number := block.Hash(), block.NumberU64()
+		if number > 0 && number < tail {
+			continue
+		}
+		WriteHeader(db, block.Header())
+		WriteCanonicalHash(db, hash, number)
+		WriteTd(db, hash, number, big.NewInt(int64(number+1)))
+		if number > checkpoint || number == 0 {
+			WriteBody(db, hash, number, block.Body())
+			WriteReceipts(db, hash, number, receipts[i])
+		}
+	}
+	WriteSyncCheckpoint(db, blocks[checkpoint].Hash(), checkpoint)
+	WriteHeaderBackfillTail(db, tail)
+
+	hashes, err := f.freezeRange(&nofreezedb{KeyValueStore: kvdb}, 0, 19)
+	if err != nil {
+		t.Fatalf("failed to freeze blocks: %v", err)
+	}
+	for i, hash := range hashes {
+		want := blocks[i].Hash()
+		if i > 0 && i < tail {
+			want = common.Hash{}
+		}
+		if hash != want {
+			t.Fatalf("frozen hash #%d mismatch: have %x, want %x", i, hash, want)
+		}
+	}
+	// Backfill the missing headers into the key-value store
+	for _, block := range blocks[1:tail] {
+		WriteHeader(db, block.Header())
+		WriteCanonicalHash(db, block.Hash(), block.NumberU64())
+		WriteTd(db, block.Hash(), block.NumberU64(), big.NewInt(int64(block.NumberU64()+1)))
+	}
+	DeleteHeaderBackfillTail(db)
+	for _, block := range blocks {
+		hash, number := block.Hash(), block.NumberU64()
+		if have := ReadCanonicalHash(db, number); have != hash {
+			t.Fatalf("canonical hash #%d mismatch: have %x, want %x", number, have, hash)
+		}
+		if ReadHeader(db, hash, number) == nil {
+			t.Fatalf("header #%d missing", number)
+		}
+		if ReadTd(db, hash, number) == nil {
+			t.Fatalf("td #%d missing", number)
+		}
+	}
+}
+
 // makeTestBlocks creates fake blocks for the ancient write benchmark.
 func makeTestBlocks(nblock int, txsPerBlock int) []*types.Block {
 	key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
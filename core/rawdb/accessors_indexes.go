@@ -18,6 +18,7 @@ package rawdb
 
 import (
 	"bytes"
+	"encoding/binary"
 	"math/big"
 
 	"github.com/autonity/autonity/common"
@@ -175,3 +176,21 @@ func DeleteBloombits(db ethdb.Database, bit uint, from uint64, to uint64) {
 		log.Crit("Failed to delete bloom bits", "err", it.Error())
 	}
 }
+
+// ReadEpochEnd retrieves the number of the block ending the given epoch, whose
+// finalization distributes the rewards of the epoch.
+func ReadEpochEnd(db ethdb.KeyValueReader, epoch uint64) *uint64 {
+	data, _ := db.Get(epochEndKey(epoch))
+	if len(data) != 8 {
+		return nil
+	}
+	number := binary.BigEndian.Uint64(data)
+	return &number
+}
+
+// WriteEpochEnd stores the number of the block ending the given epoch.
+func WriteEpochEnd(db ethdb.KeyValueWriter, epoch uint64, number uint64) {
+	if err := db.Put(epochEndKey(epoch), encodeBlockNumber(number)); err != nil {
+		log.Crit("Failed to store epoch end", "err", err)
+	}
+}
@@ -177,3 +177,17 @@ func TestDeleteBloomBits(t *testing.T) {
 	check(1, 1, params.MainnetGenesisHash, true)
 	check(1, 1, params.RinkebyGenesisHash, true)
 }
+
+func TestEpochEndStorage(t *testing.T) {
+	db := NewMemoryDatabase()
+	if number := ReadEpochEnd(db, 3); number != nil {
+		t.Fatalf("non existent epoch returned: %d", *number)
+	}
+	WriteEpochEnd(db, 3, 120)
+	if number := ReadEpochEnd(db, 3); number == nil || *number != 120 {
+		t.Fatalf("epoch end mismatch: have %v, want 120", number)
+	}
+	if number := ReadEpochEnd(db, 4); number != nil {
+		t.Fatalf("non existent epoch returned: %d", *number)
+	}
+}
@@ -55,6 +55,10 @@ func InitDatabaseFromFreezer(db ethdb.Database) {
 			log.Crit("Failed to init database from freezer", "err", err)
 		}
 		for j, h := range data {
+			// Skip the placeholders of the headers frozen before being backfilled
+			if len(h) == 0 {
+				continue
+			}
 			number := i + uint64(j)
 			hash = common.BytesToHash(h)
 			WriteHeaderNumber(batch, hash, number)
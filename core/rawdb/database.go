@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"sync/atomic"
 	"time"
 
@@ -104,6 +105,11 @@ func (db *nofreezedb) AncientSize(kind string) (uint64, error) {
 	return 0, errNotSupported
 }
 
+// AncientTail returns an error as we don't have a backing chain freezer.
+func (db *nofreezedb) AncientTail(kind string) (uint64, error) {
+	return 0, errNotSupported
+}
+
 // ModifyAncients is not supported.
 func (db *nofreezedb) ModifyAncients(func(ethdb.AncientWriteOp) error) (int64, error) {
 	return 0, errNotSupported
@@ -114,6 +120,11 @@ func (db *nofreezedb) TruncateAncients(items uint64) error {
 	return errNotSupported
 }
 
+// TruncateTail returns an error as we don't have a backing chain freezer.
+func (db *nofreezedb) TruncateTail(kind string, tail uint64) (uint64, error) {
+	return 0, errNotSupported
+}
+
 // Sync returns an error as we don't have a backing chain freezer.
 func (db *nofreezedb) Sync() error {
 	return errNotSupported
@@ -145,6 +156,11 @@ func NewDatabase(db ethdb.KeyValueStore) ethdb.Database {
 // value data store with a freezer moving immutable chain segments into cold
 // storage.
 func NewDatabaseWithFreezer(db ethdb.KeyValueStore, freezer string, namespace string, readonly bool) (ethdb.Database, error) {
+	// Refuse to attach a freezer to a key-value store which doesn't match the engine
+	// of the data directory it was opened from.
+	if err := checkDatabaseEngine(db); err != nil {
+		return nil, err
+	}
 	// Create the idle freezer instance
 	frdb, err := newFreezer(freezer, namespace, readonly, freezerTableSize, FreezerNoSnappy)
 	if err != nil {
@@ -187,8 +203,10 @@ func NewDatabaseWithFreezer(db ethdb.KeyValueStore, freezer string, namespace st
 			// are contiguous, otherwise we might end up with a non-functional freezer.
 			if kvhash, _ := db.Get(headerHashKey(frozen)); len(kvhash) == 0 {
 				// Subsequent header after the freezer limit is missing from the database.
-				// Reject startup is the database has a more recent head.
-				if *ReadHeaderNumber(db, ReadHeadHeaderHash(db)) > frozen-1 {
+				// Reject startup is the database has a more recent head, unless the
+				// header is yet to be backfilled below a sync checkpoint.
+				tail := ReadHeaderBackfillTail(db)
+				if *ReadHeaderNumber(db, ReadHeadHeaderHash(db)) > frozen-1 && (tail == nil || frozen >= *tail) {
 					return nil, fmt.Errorf("gap (#%d) in the chain between ancients and leveldb", frozen)
 				}
 				// Database contains only older data than the freezer, this happens if the
@@ -266,6 +284,115 @@ func NewLevelDBDatabaseWithFreezer(file string, cache int, handles int, freezer
 	return frdb, nil
 }
 
+const (
+	// DBLeveldb is the identifier of the LevelDB key-value database engine.
+	DBLeveldb = "leveldb"
+	// DBPebble is the identifier of the Pebble key-value database engine.
+	DBPebble = "pebble"
+)
+
+// errDatabaseEngine is returned when a data directory is opened with a different
+// key-value database engine than the one which created it.
+var errDatabaseEngine = errors.New("database engine mismatch")
+
+// PreexistingDatabase checks the given data directory whether a database is already
+// instantiated at that location, and if so, returns the type of database (or the
+// empty string).
+func PreexistingDatabase(path string) string {
+	if _, err := os.Stat(filepath.Join(path, "CURRENT")); err != nil {
+		return "" // No pre-existing db
+	}
+	if matches, err := filepath.Glob(filepath.Join(path, "OPTIONS*")); len(matches) > 0 || err != nil {
+		if err != nil {
+			panic(err) // only possible if the pattern is malformed
+		}
+		return DBPebble
+	}
+	return DBLeveldb
+}
+
+// databaseEngine returns the engine of the given key-value store, or the empty
+// string if it isn't backed by a data directory.
+func databaseEngine(db ethdb.KeyValueStore) string {
+	switch db.(type) {
+	case *leveldb.Database:
+		return DBLeveldb
+	}
+	if isPebble(db) {
+		return DBPebble
+	}
+	return ""
+}
+
+// checkDatabaseEngine ensures that the given key-value store was opened with the
+// engine of the data directory backing it.
+func checkDatabaseEngine(db ethdb.KeyValueStore) error {
+	store, ok := db.(interface{ Path() string })
+	if !ok {
+		return nil
+	}
+	engine, existing := databaseEngine(db), PreexistingDatabase(store.Path())
+	if engine != "" && existing != "" && engine != existing {
+		return fmt.Errorf("%w: %s database opened as %s", errDatabaseEngine, existing, engine)
+	}
+	return nil
+}
+
+// OpenOptions contains the options to apply when opening a database.
+// OBS: If AncientsDirectory is empty, it indicates that no freezer is to be used.
+type OpenOptions struct {
+	Type              string // "leveldb" | "pebble", empty to use the existing engine or the default one
+	Directory         string // the datadir
+	AncientsDirectory string // the ancients-dir
+	Namespace         string // the namespace for database relevant metrics
+	Cache             int    // the capacity(in megabytes) of the data caching
+	Handles           int    // number of files to be open simultaneously
+	ReadOnly          bool
+}
+
+// openKeyValueDatabase opens a disk-based key-value database, e.g. leveldb or pebble.
+//
+//	                      type == null          type != null
+//	                   +----------------------------------------
+//	db is non-existent |  leveldb default  |  specified type
+//	db is existent     |  from db          |  specified type (if compatible)
+func openKeyValueDatabase(o OpenOptions) (ethdb.KeyValueStore, error) {
+	existingDb := PreexistingDatabase(o.Directory)
+	if len(existingDb) != 0 && len(o.Type) != 0 && o.Type != existingDb {
+		return nil, fmt.Errorf("%w: db.engine choice was %v but found pre-existing %v database in specified data directory", errDatabaseEngine, o.Type, existingDb)
+	}
+	if o.Type == DBPebble || existingDb == DBPebble {
+		log.Info("Using pebble as the backing database")
+		return newPebbleDB(o.Directory, o.Cache, o.Handles, o.Namespace, o.ReadOnly)
+	}
+	if o.Type != "" && o.Type != DBLeveldb {
+		return nil, fmt.Errorf("unknown db.engine %v", o.Type)
+	}
+	log.Info("Using leveldb as the backing database")
+	return leveldb.New(o.Directory, o.Cache, o.Handles, o.Namespace, o.ReadOnly)
+}
+
+// Open opens both a disk-based key-value database such as leveldb or pebble, but also
+// integrates it with a freezer database -- if the AncientDir option has been
+// set on the provided OpenOptions.
+// The passed o.AncientDir indicates the path of root ancient directory where
+// the chain freezer can be opened.
+func Open(o OpenOptions) (ethdb.Database, error) {
+	kvdb, err := openKeyValueDatabase(o)
+	if err != nil {
+		return nil, err
+	}
+	if len(o.AncientsDirectory) == 0 {
+		return NewDatabase(kvdb), nil
+	}
+	frdb, err := NewDatabaseWithFreezer(kvdb, o.AncientsDirectory, o.Namespace, o.ReadOnly)
+	if err != nil {
+		kvdb.Close()
+		return nil, err
+	}
+	return frdb, nil
+}
+
 type counter uint64
 
 func (c counter) String() string {
@@ -296,6 +423,30 @@ func (s *stat) Count() string {
 	return s.count.String()
 }
 
+// isPathTrieNode reports whether a database entry is an account or storage
+// trie node of the path-based scheme. The node path has to consist of nibbles,
+// to tell the nodes apart from the hash-based ones with the same key length.
+func isPathTrieNode(key []byte, storage bool) bool {
+	var (
+		ok   bool
+		path []byte
+	)
+	if storage {
+		ok, _, path = IsStorageTrieNode(key)
+	} else {
+		ok, path = IsAccountTrieNode(key)
+	}
+	if !ok {
+		return false
+	}
+	for _, nibble := range path {
+		if nibble >= 16 {
+			return false
+		}
+	}
+	return true
+}
+
 // InspectDatabase traverses the entire database and checks the size
 // of all different categories of data.
 func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
@@ -315,12 +466,17 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		numHashPairings stat
 		hashNumPairings stat
 		tries           stat
+		accountTries    stat
+		storageTries    stat
+		stateHistories  stat
+		stateLookups    stat
 		codes           stat
 		txLookups       stat
 		accountSnaps    stat
 		storageSnaps    stat
 		preimages       stat
 		bloomBits       stat
+		epochEnds       stat
 
 		// Ancient store statistics
 		ancientHeadersSize  common.StorageSize
@@ -360,6 +516,14 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 			numHashPairings.Add(size)
 		case bytes.HasPrefix(key, headerNumberPrefix) && len(key) == (len(headerNumberPrefix)+common.HashLength):
 			hashNumPairings.Add(size)
+		case isPathTrieNode(key, false):
+			accountTries.Add(size)
+		case isPathTrieNode(key, true):
+			storageTries.Add(size)
+		case bytes.HasPrefix(key, StateHistoryPrefix) && len(key) == len(StateHistoryPrefix)+8:
+			stateHistories.Add(size)
+		case bytes.HasPrefix(key, stateIDPrefix) && len(key) == len(stateIDPrefix)+common.HashLength:
+			stateLookups.Add(size)
 		case len(key) == common.HashLength:
 			tries.Add(size)
 		case bytes.HasPrefix(key, CodePrefix) && len(key) == len(CodePrefix)+common.HashLength:
@@ -378,6 +542,10 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 			bloomBits.Add(size)
 		case bytes.HasPrefix(key, BloomBitsIndexPrefix):
 			bloomBits.Add(size)
+		case bytes.HasPrefix(key, epochEndPrefix) && len(key) == (len(epochEndPrefix)+8):
+			epochEnds.Add(size)
+		case bytes.HasPrefix(key, EpochIndexPrefix):
+			epochEnds.Add(size)
 		case bytes.HasPrefix(key, []byte("cht-")) ||
 			bytes.HasPrefix(key, []byte("chtIndexV2-")) ||
 			bytes.HasPrefix(key, []byte("chtRootV2-")): // Canonical hash trie
@@ -392,7 +560,8 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 				databaseVersionKey, headHeaderKey, headBlockKey, headFastBlockKey, lastPivotKey,
 				fastTrieProgressKey, snapshotDisabledKey, SnapshotRootKey, snapshotJournalKey,
 				snapshotGeneratorKey, snapshotRecoveryKey, txIndexTailKey, fastTxLookupLimitKey,
-				uncleanShutdownKey, badBlockKey, transitionStatusKey,
+				syncCheckpointKey, headerBackfillTailKey, uncleanShutdownKey, badBlockKey, forkEvidenceKey,
+				transitionStatusKey, persistentStateIDKey, trieJournalKey,
 			} {
 				if bytes.Equal(key, meta) {
 					metadata.Add(size)
@@ -423,6 +592,14 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 	if count, err := db.Ancients(); err == nil {
 		ancients = counter(count)
 	}
+	// Bodies and receipts might have been pruned from the tail
+	var ancientBodies, ancientReceipts = ancients, ancients
+	if tail, err := db.AncientTail(freezerBodiesTable); err == nil && uint64(ancients) > tail {
+		ancientBodies = ancients - counter(tail)
+	}
+	if tail, err := db.AncientTail(freezerReceiptTable); err == nil && uint64(ancients) > tail {
+		ancientReceipts = ancients - counter(tail)
+	}
 	// Display the database statistic.
 	stats := [][]string{
 		{"Key-Value store", "Headers", headers.Size(), headers.Count()},
@@ -433,15 +610,20 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		{"Key-Value store", "Block hash->number", hashNumPairings.Size(), hashNumPairings.Count()},
 		{"Key-Value store", "Transaction index", txLookups.Size(), txLookups.Count()},
 		{"Key-Value store", "Bloombit index", bloomBits.Size(), bloomBits.Count()},
+		{"Key-Value store", "Epoch index", epochEnds.Size(), epochEnds.Count()},
 		{"Key-Value store", "Contract codes", codes.Size(), codes.Count()},
 		{"Key-Value store", "Trie nodes", tries.Size(), tries.Count()},
+		{"Key-Value store", "Path trie account nodes", accountTries.Size(), accountTries.Count()},
+		{"Key-Value store", "Path trie storage nodes", storageTries.Size(), storageTries.Count()},
+		{"Key-Value store", "State histories", stateHistories.Size(), stateHistories.Count()},
+		{"Key-Value store", "State id lookups", stateLookups.Size(), stateLookups.Count()},
 		{"Key-Value store", "Trie preimages", preimages.Size(), preimages.Count()},
 		{"Key-Value store", "Account snapshot", accountSnaps.Size(), accountSnaps.Count()},
 		{"Key-Value store", "Storage snapshot", storageSnaps.Size(), storageSnaps.Count()},
 		{"Key-Value store", "Singleton metadata", metadata.Size(), metadata.Count()},
 		{"Ancient store", "Headers", ancientHeadersSize.String(), ancients.String()},
-		{"Ancient store", "Bodies", ancientBodiesSize.String(), ancients.String()},
-		{"Ancient store", "Receipt lists", ancientReceiptsSize.String(), ancients.String()},
+		{"Ancient store", "Bodies", ancientBodiesSize.String(), ancientBodies.String()},
+		{"Ancient store", "Receipt lists", ancientReceiptsSize.String(), ancientReceipts.String()},
 		{"Ancient store", "Difficulties", ancientTdsSize.String(), ancients.String()},
 		{"Ancient store", "Block number->hash", ancientHashesSize.String(), ancients.String()},
 		{"Light client", "CHT trie nodes", chtTrieNodes.Size(), chtTrieNodes.Count()},
@@ -15,3 +15,45 @@
 // along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
 
 package rawdb
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestOpenDatabaseEngine(t *testing.T) {
+	for _, engine := range []string{DBLeveldb, DBPebble} {
+		if engine == DBPebble && !PebbleEnabled {
+			continue
+		}
+		dir := t.TempDir()
+		options := OpenOptions{
+			Type:              engine,
+			Directory:         dir,
+			AncientsDirectory: filepath.Join(dir, "ancient"),
+		}
+		db, err := Open(options)
+		if err != nil {
+			t.Fatalf("%s: failed to create database: %v", engine, err)
+		}
+		db.Close()
+		if have := PreexistingDatabase(dir); have != engine {
+			t.Fatalf("%s: detected engine mismatch: have %q", engine, have)
+		}
+		// the engine of an existing database is detected when none is requested
+		options.Type = ""
+		if db, err = Open(options); err != nil {
+			t.Fatalf("%s: failed to reopen database: %v", engine, err)
+		}
+		db.Close()
+		// a data directory is never opened with another engine
+		options.Type = DBLeveldb
+		if engine == DBLeveldb {
+			options.Type = DBPebble
+		}
+		if _, err = Open(options); !errors.Is(err, errDatabaseEngine) {
+			t.Fatalf("%s: opened with %s engine, err %v", engine, options.Type, err)
+		}
+	}
+}
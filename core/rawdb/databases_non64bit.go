@@ -0,0 +1,44 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+//go:build !((arm64 || amd64) && !openbsd)
+
+package rawdb
+
+import (
+	"errors"
+
+	"github.com/autonity/autonity/ethdb"
+)
+
+// PebbleEnabled reports whether the pebble database engine is supported on this platform.
+const PebbleEnabled = false
+
+var errPebbleUnsupported = errors.New("pebble is not supported on this platform")
+
+// NewPebbleDBDatabase creates a persistent key-value database without a freezer
+// moving immutable chain segments into cold storage.
+func NewPebbleDBDatabase(file string, cache int, handles int, namespace string, readonly bool) (ethdb.Database, error) {
+	return nil, errPebbleUnsupported
+}
+
+func newPebbleDB(file string, cache int, handles int, namespace string, readonly bool) (ethdb.KeyValueStore, error) {
+	return nil, errPebbleUnsupported
+}
+
+func isPebble(db ethdb.KeyValueStore) bool {
+	return false
+}
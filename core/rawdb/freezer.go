@@ -239,6 +239,15 @@ func (f *freezer) AncientSize(kind string) (uint64, error) {
 	return 0, errUnknownTable
 }
 
+// AncientTail returns the number of the first item retained in the specified
+// category, the older ones having been deleted.
+func (f *freezer) AncientTail(kind string) (uint64, error) {
+	if table := f.tables[kind]; table != nil {
+		return table.tail(), nil
+	}
+	return 0, errUnknownTable
+}
+
 // ReadAncients runs the given read operation while ensuring that no writes take place
 // on the underlying freezer.
 func (f *freezer) ReadAncients(fn func(ethdb.AncientReader) error) (err error) {
@@ -301,6 +310,23 @@ func (f *freezer) TruncateAncients(items uint64) error {
 	return nil
 }
 
+// TruncateTail discards the data of the specified category below the provided
+// tail number. The data is deleted by whole data files, so the returned new tail
+// might be lower than requested.
+func (f *freezer) TruncateTail(kind string, tail uint64) (uint64, error) {
+	if f.readonly {
+		return 0, errReadOnly
+	}
+	f.writeLock.Lock()
+	defer f.writeLock.Unlock()
+
+	table := f.tables[kind]
+	if table == nil {
+		return 0, errUnknownTable
+	}
+	return table.truncateTail(tail)
+}
+
 // Sync flushes all data tables to disk.
 func (f *freezer) Sync() error {
 	var errs []error
@@ -451,8 +477,9 @@ func (f *freezer) freeze(db ethdb.KeyValueStore) {
 		// Wipe out all data from the active database
 		batch := db.NewBatch()
 		for i := 0; i < len(ancients); i++ {
-			// Always keep the genesis block in active database
-			if first+uint64(i) != 0 {
+			// Always keep the genesis block in active database, and the headers
+			// backfilled after freezing their placeholders
+			if first+uint64(i) != 0 && ancients[i] != (common.Hash{}) {
 				DeleteBlockWithoutNumber(batch, ancients[i], first+uint64(i))
 				DeleteCanonicalHash(batch, first+uint64(i))
 			}
@@ -466,12 +493,14 @@ func (f *freezer) freeze(db ethdb.KeyValueStore) {
 		var dangling []common.Hash
 		for number := first; number < f.frozen; number++ {
 			// Always keep the genesis block in active database
-			if number != 0 {
+			if number != 0 && ancients[number-first] != (common.Hash{}) {
 				dangling = ReadAllHashes(db, number)
 				for _, hash := range dangling {
 					log.Trace("Deleting side chain", "number", number, "hash", hash)
 					DeleteBlock(batch, hash, number)
 				}
+			} else {
+				dangling = nil
 			}
 		}
 		if err := batch.Write(); err != nil {
@@ -532,8 +561,25 @@ func (f *freezer) freeze(db ethdb.KeyValueStore) {
 func (f *freezer) freezeRange(nfdb *nofreezedb, number, limit uint64) (hashes []common.Hash, err error) {
 	hashes = make([]common.Hash, 0, limit-number)
 
+	// Blocks up to the sync checkpoint were never downloaded, store empty
+	// bodies and receipts for them which read back as missing. The headers
+	// below the backfill tail aren't downloaded yet either: empty placeholders
+	// are frozen in their place, so that the blocks above keep being frozen,
+	// and the headers are read back from the key-value store once backfilled.
+	checkpoint, cpNumber := ReadSyncCheckpoint(nfdb)
+	backfillTail := ReadHeaderBackfillTail(nfdb)
+
 	_, err = f.ModifyAncients(func(op ethdb.AncientWriteOp) error {
 		for ; number <= limit; number++ {
+			if backfillTail != nil && number > 0 && number < *backfillTail {
+				for _, kind := range []string{freezerHashTable, freezerHeaderTable, freezerBodiesTable, freezerReceiptTable, freezerDifficultyTable} {
+					if err := op.AppendRaw(kind, number, nil); err != nil {
+						return fmt.Errorf("can't write placeholder to freezer: %v", err)
+					}
+				}
+				hashes = append(hashes, common.Hash{})
+				continue
+			}
 			// Retrieve all the components of the canonical block.
 			hash := ReadCanonicalHash(nfdb, number)
 			if hash == (common.Hash{}) {
@@ -543,12 +589,13 @@ func (f *freezer) freezeRange(nfdb *nofreezedb, number, limit uint64) (hashes []
 			if len(header) == 0 {
 				return fmt.Errorf("block header missing, can't freeze block %d", number)
 			}
+			pruned := checkpoint != (common.Hash{}) && number <= cpNumber
 			body := ReadBodyRLP(nfdb, hash, number)
-			if len(body) == 0 {
+			if len(body) == 0 && !pruned {
 				return fmt.Errorf("block body missing, can't freeze block %d", number)
 			}
 			receipts := ReadReceiptsRLP(nfdb, hash, number)
-			if len(receipts) == 0 {
+			if len(receipts) == 0 && !pruned {
 				return fmt.Errorf("block receipts missing, can't freeze block %d", number)
 			}
 			td := ReadTdRLP(nfdb, hash, number)
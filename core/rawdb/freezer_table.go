@@ -24,6 +24,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -44,6 +45,10 @@ var (
 
 	// errNotSupported is returned if the database doesn't support the required operation.
 	errNotSupported = errors.New("this operation is not supported")
+
+	// errTruncateBelowTail is returned if the freezer table is asked to truncate
+	// its head below the items already deleted from the tail.
+	errTruncateBelowTail = errors.New("truncation below the tail")
 )
 
 // indexEntry contains the number/id of the file that the data resides in, aswell as the
@@ -263,6 +268,10 @@ func (t *freezerTable) repair() error {
 
 	t.index.ReadAt(buffer, offsetsSize-indexEntrySize)
 	lastIndex.unmarshalBinary(buffer)
+	if offsetsSize == indexEntrySize {
+		// The first entry carries the tail, the table holds no data
+		lastIndex = indexEntry{filenum: t.tailId, offset: 0}
+	}
 	if t.readonly {
 		t.head, err = t.openFile(lastIndex.filenum, openFreezerFileForReadOnly)
 	} else {
@@ -298,6 +307,9 @@ func (t *freezerTable) repair() error {
 			t.index.ReadAt(buffer, offsetsSize-indexEntrySize)
 			var newLastIndex indexEntry
 			newLastIndex.unmarshalBinary(buffer)
+			if offsetsSize == indexEntrySize {
+				newLastIndex = indexEntry{filenum: t.tailId, offset: 0}
+			}
 			// We might have slipped back into an earlier head-file here
 			if newLastIndex.filenum != lastIndex.filenum {
 				// Release earlier opened file
@@ -371,6 +383,10 @@ func (t *freezerTable) truncate(items uint64) error {
 	if existing <= items {
 		return nil
 	}
+	// Items deleted from the tail can't be brought back
+	if items < uint64(t.itemOffset) {
+		return fmt.Errorf("%w: %d < %d", errTruncateBelowTail, items, t.itemOffset)
+	}
 	// We need to truncate, save the old size for metrics tracking
 	oldSize, err := t.sizeNolock()
 	if err != nil {
@@ -382,16 +398,21 @@ func (t *freezerTable) truncate(items uint64) error {
 		log = t.logger.Warn // Only loud warn if we delete multiple items
 	}
 	log("Truncating freezer table", "items", existing, "limit", items)
-	if err := truncateFreezerFile(t.index, int64(items+1)*indexEntrySize); err != nil {
+	relative := items - uint64(t.itemOffset)
+	if err := truncateFreezerFile(t.index, int64(relative+1)*indexEntrySize); err != nil {
 		return err
 	}
 	// Calculate the new expected size of the data file and truncate it
 	buffer := make([]byte, indexEntrySize)
-	if _, err := t.index.ReadAt(buffer, int64(items*indexEntrySize)); err != nil {
+	if _, err := t.index.ReadAt(buffer, int64(relative*indexEntrySize)); err != nil {
 		return err
 	}
 	var expected indexEntry
 	expected.unmarshalBinary(buffer)
+	if relative == 0 {
+		// The first index entry carries the tail, not the end of an item
+		expected = indexEntry{filenum: t.tailId, offset: 0}
+	}
 
 	// We might need to truncate back to older files
 	if expected.filenum != t.headId {
@@ -425,6 +446,122 @@ func (t *freezerTable) truncate(items uint64) error {
 	return nil
 }
 
+// truncateTail discards the data files holding only items below the provided
+// tail number. Deletion happens at data file granularity, so the new tail, which
+// is returned, might be lower than requested.
+func (t *freezerTable) truncateTail(tail uint64) (uint64, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.index == nil || t.head == nil {
+		return 0, errClosed
+	}
+	items := atomic.LoadUint64(&t.items)
+	if tail > items {
+		tail = items
+	}
+	if tail <= uint64(t.itemOffset) {
+		return uint64(t.itemOffset), nil
+	}
+	// fileOf returns the data file holding the item with the given number, the
+	// one following the last item residing in the head file.
+	buffer := make([]byte, indexEntrySize)
+	fileOf := func(item uint64) (uint32, error) {
+		if item == items {
+			return t.headId, nil
+		}
+		if _, err := t.index.ReadAt(buffer, int64(item-uint64(t.itemOffset)+1)*indexEntrySize); err != nil {
+			return 0, err
+		}
+		var entry indexEntry
+		entry.unmarshalBinary(buffer)
+		return entry.filenum, nil
+	}
+	tailId, err := fileOf(tail)
+	if err != nil {
+		return 0, err
+	}
+	if tailId == t.tailId {
+		return uint64(t.itemOffset), nil
+	}
+	// Find the first item of the new tail file, the file numbers of the items
+	// being monotonic.
+	var searchErr error
+	first := uint64(t.itemOffset) + uint64(sort.Search(int(tail-uint64(t.itemOffset)), func(i int) bool {
+		id, err := fileOf(uint64(t.itemOffset) + uint64(i))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return id >= tailId
+	}))
+	if searchErr != nil {
+		return 0, searchErr
+	}
+	oldSize, err := t.sizeNolock()
+	if err != nil {
+		return 0, err
+	}
+	// Write the new index next to the old one, with a first entry carrying the
+	// new tail file and item offset, and atomically replace it.
+	stat, err := t.index.Stat()
+	if err != nil {
+		return 0, err
+	}
+	entries := make([]byte, stat.Size()-int64(first-uint64(t.itemOffset)+1)*indexEntrySize)
+	if _, err := t.index.ReadAt(entries, int64(first-uint64(t.itemOffset)+1)*indexEntrySize); err != nil {
+		return 0, err
+	}
+	head := indexEntry{filenum: tailId, offset: uint32(first)}
+	name := t.index.Name()
+	t.index.Close()
+	werr := writeFreezerFileAtomic(name, append(head.append(nil), entries...))
+	if t.index, err = openFreezerFileForAppend(name); err != nil {
+		return 0, err
+	}
+	if werr != nil {
+		return 0, werr
+	}
+	// Drop the data files holding nothing but deleted items
+	for id := t.tailId; id < tailId; id++ {
+		if f, exist := t.files[id]; exist {
+			delete(t.files, id)
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}
+	t.tailId, t.itemOffset = tailId, uint32(first)
+
+	newSize, err := t.sizeNolock()
+	if err != nil {
+		return 0, err
+	}
+	t.sizeGauge.Dec(int64(oldSize - newSize))
+	t.logger.Debug("Truncated freezer table tail", "tail", first, "file", tailId)
+	return first, nil
+}
+
+// writeFreezerFileAtomic replaces the content of the given file, writing it
+// into a temporary file first.
+func writeFreezerFileAtomic(name string, content []byte) error {
+	f, err := os.OpenFile(name+".tmp", os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
+
 // Close closes all opened files.
 func (t *freezerTable) Close() error {
 	t.lock.Lock()
@@ -673,7 +810,19 @@ func (t *freezerTable) retrieveItems(start, count, maxBytes uint64) ([]byte, []i
 // has returns an indicator whether the specified number data
 // exists in the freezer table.
 func (t *freezerTable) has(number uint64) bool {
-	return atomic.LoadUint64(&t.items) > number
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return atomic.LoadUint64(&t.items) > number && uint64(t.itemOffset) <= number
+}
+
+// tail returns the number of the first item stored in the freezer table, the
+// older ones having been deleted.
+func (t *freezerTable) tail() uint64 {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+
+	return uint64(t.itemOffset)
 }
 
 // size returns the total data size in the freezer table.
@@ -18,6 +18,7 @@ package rawdb
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
@@ -400,6 +401,84 @@ func TestFreezerTruncate(t *testing.T) {
 
 // TestFreezerRepairFirstFile tests a head file with the very first item only half-written.
 // That will rewind the index, and _should_ truncate the head file
+// TestFreezerTruncateTail tests the deletion of the data files holding the
+// oldest items, and that the table remains usable after reopening.
+func TestFreezerTruncateTail(t *testing.T) {
+	t.Parallel()
+	rm, wm, sg := metrics.NewMeter(), metrics.NewMeter(), metrics.NewGauge()
+	fname := fmt.Sprintf("truncate-tail-%d", rand.Uint64())
+
+	// Fill table with 30 items of 15 bytes, three of them per file
+	{
+		f, err := newTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		writeChunks(t, f, 30, 15)
+
+		// Item 10 resides in the file starting with item 9
+		tail, err := f.truncateTail(10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tail != 9 || f.tail() != 9 {
+			t.Fatalf("tail mismatch: have %d/%d, want %d", tail, f.tail(), 9)
+		}
+		checkRetrieveError(t, f, map[uint64]error{0: errOutOfBounds, 8: errOutOfBounds})
+		checkRetrieve(t, f, map[uint64][]byte{9: getChunk(15, 9), 10: getChunk(15, 10), 29: getChunk(15, 29)})
+		if f.has(8) || !f.has(9) {
+			t.Fatalf("unexpected item availability")
+		}
+		// Deleting below the tail is a noop
+		if tail, err := f.truncateTail(5); err != nil || tail != 9 {
+			t.Fatalf("unexpected tail %d, err %v", tail, err)
+		}
+		f.Close()
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := os.Stat(filepath.Join(os.TempDir(), fmt.Sprintf("%v.%04d.rdat", fname, i))); !os.IsNotExist(err) {
+			t.Fatalf("data file %d not deleted: %v", i, err)
+		}
+	}
+	// Reopen, append and truncate the head
+	{
+		f, err := newTable(os.TempDir(), fname, rm, wm, sg, 50, true, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		if f.items != 30 || f.tail() != 9 {
+			t.Fatalf("unexpected items %d, tail %d", f.items, f.tail())
+		}
+		checkRetrieveError(t, f, map[uint64]error{8: errOutOfBounds})
+		checkRetrieve(t, f, map[uint64][]byte{9: getChunk(15, 9), 29: getChunk(15, 29)})
+
+		batch := f.newBatch()
+		require.NoError(t, batch.AppendRaw(30, getChunk(15, 30)))
+		require.NoError(t, batch.commit())
+		checkRetrieve(t, f, map[uint64][]byte{30: getChunk(15, 30)})
+
+		if err := f.truncate(5); !errors.Is(err, errTruncateBelowTail) {
+			t.Fatalf("unexpected error truncating below the tail: %v", err)
+		}
+		if err := f.truncate(20); err != nil {
+			t.Fatal(err)
+		}
+		checkRetrieve(t, f, map[uint64][]byte{19: getChunk(15, 19)})
+		checkRetrieveError(t, f, map[uint64]error{20: errOutOfBounds})
+
+		// Deleting everything keeps the head file
+		tail, err := f.truncateTail(20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tail != 18 {
+			t.Fatalf("tail mismatch: have %d, want %d", tail, 18)
+		}
+		checkRetrieve(t, f, map[uint64][]byte{18: getChunk(15, 18), 19: getChunk(15, 19)})
+	}
+}
+
 func TestFreezerRepairFirstFile(t *testing.T) {
 	t.Parallel()
 	rm, wm, sg := metrics.NewMeter(), metrics.NewMeter(), metrics.NewGauge()
@@ -92,6 +92,7 @@ type (
 	resetObjectChange struct {
 		prev         *stateObject
 		prevdestruct bool
+		prevwipe     bool
 	}
 	suicideChange struct {
 		account     *common.Address
@@ -154,6 +155,9 @@ func (ch resetObjectChange) revert(s *StateDB) {
 	if !ch.prevdestruct && s.snap != nil {
 		delete(s.snapDestructs, ch.prev.addrHash)
 	}
+	if !ch.prevwipe {
+		delete(s.storageWipes, ch.prev.addrHash)
+	}
 }
 
 func (ch resetObjectChange) dirtied() *common.Address {
@@ -79,6 +79,10 @@ type StateDB struct {
 	stateObjectsPending map[common.Address]struct{} // State objects finalized but not yet written to the trie
 	stateObjectsDirty   map[common.Address]struct{} // State objects modified in the current execution
 
+	// Accounts deleted or recreated since the last commit, whose previous
+	// storage trie has to be wiped with the path-based trie scheme.
+	storageWipes map[common.Hash]struct{}
+
 	// DB error.
 	// State objects are used by the consensus core and VM which are
 	// unable to deal with database-level errors. Any error that occurs
@@ -138,6 +142,7 @@ func New(root common.Hash, db Database, snaps *snapshot.Tree) (*StateDB, error)
 		stateObjects:        make(map[common.Address]*stateObject),
 		stateObjectsPending: make(map[common.Address]struct{}),
 		stateObjectsDirty:   make(map[common.Address]struct{}),
+		storageWipes:        make(map[common.Hash]struct{}),
 		logs:                make(map[common.Hash][]*types.Log),
 		preimages:           make(map[common.Hash][]byte),
 		journal:             newJournal(),
@@ -575,18 +580,22 @@ func (s *StateDB) GetOrNewStateObject(addr common.Address) *stateObject {
 func (s *StateDB) createObject(addr common.Address) (newobj, prev *stateObject) {
 	prev = s.getDeletedStateObject(addr) // Note, prev might have been deleted, we need that!
 
-	var prevdestruct bool
+	var prevdestruct, prevwipe bool
 	if s.snap != nil && prev != nil {
 		_, prevdestruct = s.snapDestructs[prev.addrHash]
 		if !prevdestruct {
 			s.snapDestructs[prev.addrHash] = struct{}{}
 		}
 	}
+	if prev != nil {
+		_, prevwipe = s.storageWipes[prev.addrHash]
+		s.storageWipes[prev.addrHash] = struct{}{}
+	}
 	newobj = newObject(s, addr, types.StateAccount{})
 	if prev == nil {
 		s.journal.append(createObjectChange{account: &addr})
 	} else {
-		s.journal.append(resetObjectChange{prev: prev, prevdestruct: prevdestruct})
+		s.journal.append(resetObjectChange{prev: prev, prevdestruct: prevdestruct, prevwipe: prevwipe})
 	}
 	s.setStateObject(newobj)
 	if prev != nil && !prev.deleted {
@@ -651,6 +660,7 @@ func (s *StateDB) Copy() *StateDB {
 		stateObjects:        make(map[common.Address]*stateObject, len(s.journal.dirties)),
 		stateObjectsPending: make(map[common.Address]struct{}, len(s.stateObjectsPending)),
 		stateObjectsDirty:   make(map[common.Address]struct{}, len(s.journal.dirties)),
+		storageWipes:        make(map[common.Hash]struct{}, len(s.storageWipes)),
 		refund:              s.refund,
 		logs:                make(map[common.Hash][]*types.Log, len(s.logs)),
 		logSize:             s.logSize,
@@ -689,6 +699,9 @@ func (s *StateDB) Copy() *StateDB {
 		}
 		state.stateObjectsDirty[addr] = struct{}{}
 	}
+	for addrHash := range s.storageWipes {
+		state.storageWipes[addrHash] = struct{}{}
+	}
 	for hash, logs := range s.logs {
 		cpy := make([]*types.Log, len(logs))
 		for i, l := range logs {
@@ -788,6 +801,7 @@ func (s *StateDB) Finalise(deleteEmptyObjects bool) {
 		}
 		if obj.suicided || (deleteEmptyObjects && obj.empty()) {
 			obj.deleted = true
+			s.storageWipes[obj.addrHash] = struct{}{}
 
 			// If state snapshotting is active, also mark the destruction there.
 			// Note, we can't do this only at the end of a block because multiple
@@ -949,6 +963,16 @@ func (s *StateDB) Commit(deleteEmptyObjects bool) (common.Hash, error) {
 	if err != nil {
 		return common.Hash{}, err
 	}
+	// With the path-based scheme, the nodes committed above only form a new
+	// state once it is linked to its parent.
+	if triedb := s.db.TrieDB(); triedb.Scheme() == rawdb.PathScheme {
+		if err := triedb.Update(root, s.originalRoot, s.storageWipes); err != nil {
+			return common.Hash{}, err
+		}
+		s.originalRoot = root
+	}
+	s.storageWipes = make(map[common.Hash]struct{})
+
 	if metrics.EnabledExpensive {
 		s.AccountCommits += time.Since(start)
 
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"math/big"
 
 	"github.com/autonity/autonity/common"
@@ -37,3 +38,18 @@ func (c Committee) TotalVotingPower() *big.Int {
 	}
 	return total
 }
+
+// Equal tells whether both committees have the same members with the same
+// voting power and consensus key, in the same order.
+func (c Committee) Equal(other Committee) bool {
+	if len(c) != len(other) {
+		return false
+	}
+	for i := range c {
+		if c[i].Address != other[i].Address || c[i].VotingPower.Cmp(other[i].VotingPower) != 0 ||
+			!bytes.Equal(c[i].ConsensusKey, other[i].ConsensusKey) {
+			return false
+		}
+	}
+	return true
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/crypto/blst"
 	"golang.org/x/crypto/blake2b"
 	"strings"
 
@@ -34,6 +35,9 @@ var (
 	ErrEmptyCommittedSeals = errors.New("zero committed seals")
 	// ErrNegativeRound is returned if the round field is negative
 	ErrNegativeRound = errors.New("negative round")
+	// ErrInvalidBeacon is returned if the random beacon of a header is not the
+	// signature of its proposer over the beacon seed of the parent header.
+	ErrInvalidBeacon = errors.New("invalid random beacon")
 )
 
 // BFTFilteredHeader returns a filtered header which some information (like seal, committed seals)
@@ -46,9 +50,62 @@ func BFTFilteredHeader(h *Header, keepSeal bool) *Header {
 	newHeader.CommittedSeals = [][]byte{}
 	newHeader.Round = 0
 	newHeader.Extra = []byte{}
+	// the consensus keys of the committee are part of the hash from the random beacon
+	// fork on, the beacon of the next header is verified against them.
+	if len(h.Beacon) == 0 {
+		for i := range newHeader.Committee {
+			newHeader.Committee[i].ConsensusKey = nil
+		}
+	}
 	return newHeader
 }
 
+// BeaconSeed returns the seed of the random beacon at the given header, from
+// which the proposers of the next height are elected and over which the
+// proposer of the next block signs its beacon. It is the hash of the beacon,
+// or of the header itself before the random beacon fork.
+func (h *Header) BeaconSeed() common.Hash {
+	if len(h.Beacon) == 0 {
+		return h.Hash()
+	}
+	return crypto.Keccak256Hash(h.Beacon)
+}
+
+// beaconDomain tags the messages signed as beacons, so that no other signature
+// made with a consensus key, such as a vote, can be passed off as a beacon.
+var beaconDomain = []byte("AUTONITY_RANDOM_BEACON")
+
+// BeaconMessage returns the message the proposer of the child of the header
+// signs as its beacon: the beacon seed of the header, prefixed by a domain tag.
+func (h *Header) BeaconMessage() []byte {
+	return append(append([]byte{}, beaconDomain...), h.BeaconSeed().Bytes()...)
+}
+
+// VerifyBeacon checks that the beacon of the header is the signature of its
+// proposer over the beacon message of the parent, made with the consensus key
+// the proposer has in the committee of the parent. The keys are authenticated by
+// the hash of the parent if it carries a beacon itself. The keys of the last
+// header before the random beacon fork are not, they are only checked against the
+// state when the block is verified by the engine.
+func (h *Header) VerifyBeacon(parent *Header) error {
+	member := parent.CommitteeMember(h.Coinbase)
+	if member == nil {
+		return ErrInvalidBeacon
+	}
+	key, err := blst.PublicKeyFromBytes(member.ConsensusKey)
+	if err != nil {
+		return err
+	}
+	signature, err := blst.SignatureFromBytes(h.Beacon)
+	if err != nil {
+		return ErrInvalidBeacon
+	}
+	if !signature.Verify(key, parent.BeaconMessage()) {
+		return ErrInvalidBeacon
+	}
+	return nil
+}
+
 // SigHash returns the hash which is used as input for the BFT
 // signing. It is the hash of the entire header apart from the 65 byte signature
 // contained at the end of the extra data.
@@ -143,3 +200,13 @@ func (c Committee) Swap(i, j int) {
 func (m CommitteeMember) String() string {
 	return m.Address.String()
 }
+
+// ForkEvidence records a chain that conflicted with a committed block of the
+// local canonical chain. With BFT consensus two conflicting blocks can only
+// both carry a quorum of committed seals if the safety of the protocol was
+// violated, so the evidence is kept for the operators to investigate.
+type ForkEvidence struct {
+	Committed   *Header   `json:"committed"`   // Committed canonical block replaced by the conflicting chain
+	Conflicting []*Header `json:"conflicting"` // Conflicting chain, from its fork point upwards
+	Time        uint64    `json:"time"`        // Unix time at which the conflict was detected
+}
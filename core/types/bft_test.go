@@ -5,6 +5,8 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/autonity/autonity/common"
 )
 
@@ -120,3 +122,20 @@ func setExtra(h Header, hExtra headerExtra) Header {
 	h.CommittedSeals = hExtra.CommittedSeals
 	return h
 }
+
+func TestHeaderHashConsensusKeys(t *testing.T) {
+	header := &Header{Number: big.NewInt(1), MixDigest: BFTDigest, Committee: Committee{
+		{Address: common.Address{0x01}, VotingPower: big.NewInt(1), ConsensusKey: []byte{0x01}},
+	}}
+	withKey := func(h *Header, key []byte) *Header {
+		h = CopyHeader(h)
+		h.Committee[0].ConsensusKey = key
+		return h
+	}
+	// the keys are not committed to before the random beacon fork
+	require.Equal(t, header.Hash(), withKey(header, []byte{0x02}).Hash())
+
+	header.Beacon = []byte{0x01}
+	require.NotEqual(t, header.Hash(), withKey(header, []byte{0x02}).Hash())
+	require.Equal(t, header.Hash(), withKey(header, []byte{0x01}).Hash())
+}
@@ -99,6 +99,10 @@ type Header struct {
 	ProposerSeal   []byte   `json:"proposerSeal"        gencodec:"required"`
 	Round          uint64   `json:"round"               gencodec:"required"`
 	CommittedSeals [][]byte `json:"committedSeals"      gencodec:"required"`
+
+	// Beacon is the BLS signature of the proposer over the beacon seed of the
+	// parent header, set from the random beacon fork on.
+	Beacon []byte `json:"beacon,omitempty"`
 }
 
 type CommitteeMember struct {
@@ -142,6 +146,7 @@ type headerExtra struct {
 	ProposerSeal   []byte    `json:"proposerSeal"        gencodec:"required"`
 	Round          uint64    `json:"round"               gencodec:"required"`
 	CommittedSeals [][]byte  `json:"committedSeals"      gencodec:"required"`
+	Beacon         []byte    `json:"beacon"              rlp:"optional"`
 }
 
 // headerMarshaling is used by gencodec (which can be invoked bu running go
@@ -165,6 +170,7 @@ type headerMarshaling struct {
 	ProposerSeal   hexutil.Bytes
 	Round          hexutil.Uint64
 	CommittedSeals []hexutil.Bytes
+	Beacon         hexutil.Bytes
 }
 
 // Hash returns the block hash of the header, which is simply the keccak256 hash of its
@@ -233,6 +239,7 @@ func (h *Header) DecodeRLP(s *rlp.Stream) error {
 		h.Committee = hExtra.Committee
 		h.ProposerSeal = hExtra.ProposerSeal
 		h.Round = hExtra.Round
+		h.Beacon = hExtra.Beacon
 	} else {
 		h.Extra = origin.Extra
 	}
@@ -272,6 +279,7 @@ func (h *Header) EncodeRLP(w io.Writer) error {
 		ProposerSeal:   h.ProposerSeal,
 		Round:          h.Round,
 		CommittedSeals: h.CommittedSeals,
+		Beacon:         h.Beacon,
 	}
 
 	original := h.original()
@@ -429,8 +437,9 @@ func CopyHeader(h *Header) *Header {
 	committee := make([]CommitteeMember, len(h.Committee))
 	for i, val := range h.Committee {
 		committee[i] = CommitteeMember{
-			Address:     val.Address,
-			VotingPower: new(big.Int).Set(val.VotingPower),
+			Address:      val.Address,
+			VotingPower:  new(big.Int).Set(val.VotingPower),
+			ConsensusKey: common.CopyBytes(val.ConsensusKey),
 		}
 	}
 
@@ -470,6 +479,7 @@ func CopyHeader(h *Header) *Header {
 		BaseFee:        baseFee,
 		Round:          h.Round,
 		CommittedSeals: committedSeals,
+		Beacon:         common.CopyBytes(h.Beacon),
 	}
 	return cpy
 }
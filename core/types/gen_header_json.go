@@ -14,8 +14,9 @@ var _ = (*headerMarshaling)(nil)
 // MarshalJSON marshals as JSON.
 func (h Header) MarshalJSON() ([]byte, error) {
 	type MarshalledMember struct {
-		Address     common.Address `json:"address"            gencodec:"required"`
-		VotingPower *hexutil.Big   `json:"votingPower"  	  gencodec:"required"`
+		Address      common.Address `json:"address"            gencodec:"required"`
+		VotingPower  *hexutil.Big   `json:"votingPower"  	  gencodec:"required"`
+		ConsensusKey hexutil.Bytes  `json:"consensusKey"`
 	}
 	type Header struct {
 		ParentHash     common.Hash        `json:"parentHash"       gencodec:"required"`
@@ -38,6 +39,7 @@ func (h Header) MarshalJSON() ([]byte, error) {
 		Round          hexutil.Uint64     `json:"round"               gencodec:"required"`
 		CommittedSeals []hexutil.Bytes    `json:"committedSeals"      gencodec:"required"`
 		BaseFee        *hexutil.Big       `json:"baseFeePerGas" rlp:"optional"`
+		Beacon         hexutil.Bytes      `json:"beacon,omitempty"`
 		Hash           common.Hash        `json:"hash"`
 	}
 	var enc Header
@@ -59,6 +61,7 @@ func (h Header) MarshalJSON() ([]byte, error) {
 	enc.BaseFee = (*hexutil.Big)(h.BaseFee)
 	enc.ProposerSeal = h.ProposerSeal
 	enc.Round = hexutil.Uint64(h.Round)
+	enc.Beacon = h.Beacon
 	if h.CommittedSeals != nil {
 		enc.CommittedSeals = make([]hexutil.Bytes, len(h.CommittedSeals))
 		for k, v := range h.CommittedSeals {
@@ -69,8 +72,9 @@ func (h Header) MarshalJSON() ([]byte, error) {
 		enc.Committee = make([]MarshalledMember, len(h.Committee))
 		for k, v := range h.Committee {
 			enc.Committee[k] = MarshalledMember{
-				Address:     v.Address,
-				VotingPower: (*hexutil.Big)(v.VotingPower),
+				Address:      v.Address,
+				VotingPower:  (*hexutil.Big)(v.VotingPower),
+				ConsensusKey: v.ConsensusKey,
 			}
 		}
 	}
@@ -81,8 +85,9 @@ func (h Header) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON unmarshals from JSON.
 func (h *Header) UnmarshalJSON(input []byte) error {
 	type MarshalledMember struct {
-		Address     common.Address `json:"address"            gencodec:"required"`
-		VotingPower *hexutil.Big   `json:"votingPower"  	  gencodec:"required"`
+		Address      common.Address `json:"address"            gencodec:"required"`
+		VotingPower  *hexutil.Big   `json:"votingPower"  	  gencodec:"required"`
+		ConsensusKey hexutil.Bytes  `json:"consensusKey"`
 	}
 	type Header struct {
 		ParentHash     *common.Hash       `json:"parentHash"       gencodec:"required"`
@@ -105,6 +110,7 @@ func (h *Header) UnmarshalJSON(input []byte) error {
 		ProposerSeal   *hexutil.Bytes     `json:"proposerSeal"        gencodec:"required"`
 		Round          *hexutil.Uint64    `json:"round"               gencodec:"required"`
 		CommittedSeals []hexutil.Bytes    `json:"committedSeals"      gencodec:"required"`
+		Beacon         *hexutil.Bytes     `json:"beacon,omitempty"`
 	}
 	var dec Header
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -177,8 +183,9 @@ func (h *Header) UnmarshalJSON(input []byte) error {
 	h.Committee = make(Committee, len(dec.Committee))
 	for k, v := range dec.Committee {
 		h.Committee[k] = CommitteeMember{
-			Address:     v.Address,
-			VotingPower: (*big.Int)(v.VotingPower),
+			Address:      v.Address,
+			VotingPower:  (*big.Int)(v.VotingPower),
+			ConsensusKey: v.ConsensusKey,
 		}
 	}
 	if dec.ProposerSeal == nil {
@@ -196,5 +203,8 @@ func (h *Header) UnmarshalJSON(input []byte) error {
 	for k, v := range dec.CommittedSeals {
 		h.CommittedSeals[k] = v
 	}
+	if dec.Beacon != nil {
+		h.Beacon = *dec.Beacon
+	}
 	return nil
 }
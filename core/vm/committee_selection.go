@@ -0,0 +1,134 @@
+package vm
+
+import (
+	"encoding/binary"
+	"math/big"
+	"slices"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/params"
+)
+
+// selectCommittee chooses at most committeeSize members among the validators
+// with the algorithm of the given committee selection. The validators are in
+// the order of the validator list of the Autonity contract, the committee is
+// sorted by descending voting power.
+func selectCommittee(
+	selection *params.CommitteeSelectionFork, validators []*types.CommitteeMember, committeeSize int, seed common.Hash,
+) []*types.CommitteeMember {
+	switch selection.Algorithm {
+	case params.WeightedRandomCommitteeSelection:
+		return selectWeightedRandom(validators, committeeSize, seed)
+	case params.CappedVotingPowerCommitteeSelection:
+		return selectCappedVotingPower(validators, committeeSize, selection.MaxVotingPowerShare)
+	default:
+		return selectTopStake(validators, committeeSize)
+	}
+}
+
+// sortByVotingPower sorts the members according to their voting power in
+// descending order, stable sort keeps the original order of equal elements.
+func sortByVotingPower(members []*types.CommitteeMember) {
+	slices.SortStableFunc(members, func(a, b *types.CommitteeMember) int {
+		return b.VotingPower.Cmp(a.VotingPower)
+	})
+}
+
+// selectTopStake picks the committeeSize validators with the highest stake.
+func selectTopStake(validators []*types.CommitteeMember, committeeSize int) []*types.CommitteeMember {
+	committee := slices.Clone(validators)
+	sortByVotingPower(committee)
+	return committee[:min(len(committee), committeeSize)]
+}
+
+// selectWeightedRandom samples committeeSize validators without replacement,
+// each draw picking a remaining validator with a probability proportional to
+// its stake. The i-th draw is keccak256(seed, i) modulo the stake left, matched
+// against the cumulative stake of the remaining validators.
+//
+// The proposer election of the WeightedRandomSamplingCommittee is not reused:
+// it draws a single proposer per round, with replacement, among the committee
+// of the parent header and through the state of the Autonity contract, while
+// the committee is drawn here without replacement among the validators given to
+// the precompile. Its package also depends on core, which core/vm can't import.
+func selectWeightedRandom(validators []*types.CommitteeMember, committeeSize int, seed common.Hash) []*types.CommitteeMember {
+	if len(validators) <= committeeSize {
+		return selectTopStake(validators, committeeSize)
+	}
+	remaining := slices.Clone(validators)
+	totalStake := new(big.Int)
+	for _, v := range remaining {
+		totalStake.Add(totalStake, v.VotingPower)
+	}
+	committee := make([]*types.CommitteeMember, 0, committeeSize)
+	draw := make([]byte, 2*DataLen)
+	copy(draw, seed.Bytes())
+	for i := 0; i < committeeSize; i++ {
+		binary.BigEndian.PutUint64(draw[2*DataLen-8:], uint64(i))
+		value := new(big.Int).Mod(crypto.Keccak256Hash(draw).Big(), totalStake)
+		picked := len(remaining) - 1
+		for j, v := range remaining {
+			if value.Cmp(v.VotingPower) < 0 {
+				picked = j
+				break
+			}
+			value.Sub(value, v.VotingPower)
+		}
+		committee = append(committee, remaining[picked])
+		totalStake.Sub(totalStake, remaining[picked].VotingPower)
+		remaining = slices.Delete(remaining, picked, picked+1)
+	}
+	sortByVotingPower(committee)
+	return committee
+}
+
+// selectCappedVotingPower picks the committeeSize validators with the highest
+// stake, then lowers the voting power of the largest ones so that no member
+// holds more than maxShare basis points of the committee voting power.
+//
+// The k largest members are capped to c = maxShare * R / (1 - k * maxShare),
+// R being the voting power of the uncapped ones, for the smallest k such that
+// the (k+1)-th member is not above c. If no such k exists, which happens when
+// the committee is too small for the share, all members get the voting power
+// of the smallest one.
+func selectCappedVotingPower(validators []*types.CommitteeMember, committeeSize int, maxShare uint64) []*types.CommitteeMember {
+	committee := selectTopStake(validators, committeeSize)
+	votingPowers := make([]*big.Int, len(committee))
+	uncapped := new(big.Int)
+	for i, member := range committee {
+		votingPowers[i] = member.VotingPower
+		uncapped.Add(uncapped, member.VotingPower)
+	}
+	share := new(big.Int).SetUint64(maxShare)
+	capped := false
+	for k := 0; k < len(committee) && !capped; k++ {
+		denominator := int64(params.VotingPowerSharePrecision) - int64(k)*int64(maxShare)
+		if denominator <= 0 {
+			break
+		}
+		limit := new(big.Int).Mul(share, uncapped)
+		limit.Div(limit, big.NewInt(denominator))
+		if committee[k].VotingPower.Cmp(limit) <= 0 {
+			for i := 0; i < k; i++ {
+				votingPowers[i] = limit
+			}
+			capped = true
+		}
+		uncapped.Sub(uncapped, committee[k].VotingPower)
+	}
+	if !capped && len(committee) > 0 {
+		for i := range votingPowers {
+			votingPowers[i] = committee[len(committee)-1].VotingPower
+		}
+	}
+	// the members are copied as their voting power is no longer their stake
+	for i, member := range committee {
+		committee[i] = &types.CommitteeMember{
+			Address:     member.Address,
+			VotingPower: new(big.Int).Set(votingPowers[i]),
+		}
+	}
+	return committee
+}
@@ -0,0 +1,149 @@
+package vm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/params"
+)
+
+func testValidators(stakes ...int64) []*types.CommitteeMember {
+	validators := make([]*types.CommitteeMember, len(stakes))
+	for i, stake := range stakes {
+		validators[i] = &types.CommitteeMember{
+			Address:     common.BytesToAddress([]byte{byte(i + 1)}),
+			VotingPower: big.NewInt(stake),
+		}
+	}
+	return validators
+}
+
+func committeeAddresses(committee []*types.CommitteeMember) []common.Address {
+	addresses := make([]common.Address, len(committee))
+	for i, member := range committee {
+		addresses[i] = member.Address
+	}
+	return addresses
+}
+
+func TestSelectTopStake(t *testing.T) {
+	validators := testValidators(10, 30, 20, 30, 5)
+	committee := selectTopStake(validators, 3)
+	// equal stakes keep the validator list order
+	require.Equal(t, []common.Address{
+		common.BytesToAddress([]byte{2}),
+		common.BytesToAddress([]byte{4}),
+		common.BytesToAddress([]byte{3}),
+	}, committeeAddresses(committee))
+	// the validator list is left untouched
+	require.Equal(t, common.BytesToAddress([]byte{1}), validators[0].Address)
+	require.Len(t, selectTopStake(validators, 10), 5)
+}
+
+func TestSelectWeightedRandom(t *testing.T) {
+	validators := testValidators(100, 200, 300, 400, 500, 600, 700, 800)
+	seed := crypto.Keccak256Hash([]byte("seed"))
+
+	committee := selectWeightedRandom(validators, 4, seed)
+	require.Len(t, committee, 4)
+	require.Equal(t, committee, selectWeightedRandom(validators, 4, seed), "selection is not deterministic")
+	seen := make(map[common.Address]bool)
+	for i, member := range committee {
+		require.False(t, seen[member.Address], "duplicate member %v", member.Address)
+		seen[member.Address] = true
+		if i > 0 {
+			require.True(t, committee[i-1].VotingPower.Cmp(member.VotingPower) >= 0, "committee not sorted")
+		}
+	}
+	// all validators are picked if they fit in the committee
+	require.Equal(t, selectTopStake(validators, 8), selectWeightedRandom(validators, 10, seed))
+
+	// the probability of each validator to be drawn first is proportional to its stake
+	validators = testValidators(100, 200, 300, 400)
+	const draws = 20000
+	counts := make(map[common.Address]int)
+	for i := 0; i < draws; i++ {
+		committee := selectWeightedRandom(validators, 1, crypto.Keccak256Hash(big.NewInt(int64(i)).Bytes()))
+		counts[committee[0].Address]++
+	}
+	for _, v := range validators {
+		expected := float64(v.VotingPower.Int64()) / 1000
+		require.InDelta(t, expected, float64(counts[v.Address])/draws, 0.015, "unfair selection of %v", v.Address)
+	}
+
+	// a validator holding half of the stake sits in a committee of two more
+	// often than the smaller ones, but not always, and all get a chance
+	validators = testValidators(1000, 100, 100, 100, 100, 100, 100, 100, 100, 100)
+	included := make(map[common.Address]int)
+	for i := 0; i < draws/10; i++ {
+		for _, member := range selectWeightedRandom(validators, 2, crypto.Keccak256Hash(big.NewInt(int64(i)).Bytes())) {
+			included[member.Address]++
+		}
+	}
+	require.Greater(t, included[validators[0].Address], included[validators[1].Address])
+	require.Less(t, included[validators[0].Address], draws/10)
+	for _, v := range validators[1:] {
+		require.Greater(t, included[v.Address], 0, "validator %v never selected", v.Address)
+	}
+}
+
+func TestSelectCappedVotingPower(t *testing.T) {
+	share := func(committee []*types.CommitteeMember, i int) float64 {
+		total := new(big.Int)
+		for _, member := range committee {
+			total.Add(total, member.VotingPower)
+		}
+		return float64(committee[i].VotingPower.Int64()) / float64(total.Int64())
+	}
+
+	// no member above the cap, voting powers are the stakes
+	validators := testValidators(100, 200, 300, 400)
+	require.Equal(t, selectTopStake(validators, 4), selectCappedVotingPower(validators, 4, 5000))
+
+	// a dominant validator is capped
+	validators = testValidators(9000, 500, 300, 200)
+	committee := selectCappedVotingPower(validators, 4, 3334)
+	require.Equal(t, committeeAddresses(selectTopStake(validators, 4)), committeeAddresses(committee))
+	require.LessOrEqual(t, share(committee, 0), 0.3334)
+	require.Equal(t, big.NewInt(500), committee[1].VotingPower)
+	require.Equal(t, big.NewInt(300), committee[2].VotingPower)
+	require.Equal(t, big.NewInt(200), committee[3].VotingPower)
+	// the stakes are left untouched
+	require.Equal(t, big.NewInt(9000), validators[0].VotingPower)
+
+	// capping the largest member may require capping the following ones
+	validators = testValidators(5000, 4000, 3000, 100, 100, 100, 100, 100)
+	committee = selectCappedVotingPower(validators, 8, 2000)
+	for i := range committee {
+		require.LessOrEqual(t, share(committee, i), 0.2, "member %d above the cap", i)
+	}
+	require.Equal(t, committee[0].VotingPower, committee[1].VotingPower)
+	require.Equal(t, committee[1].VotingPower, committee[2].VotingPower)
+	require.Equal(t, big.NewInt(100), committee[7].VotingPower)
+	for i := 1; i < len(committee); i++ {
+		require.True(t, committee[i-1].VotingPower.Cmp(committee[i].VotingPower) >= 0, "committee not sorted")
+	}
+
+	// a committee too small for the cap gets equal voting powers
+	validators = testValidators(400, 300, 200)
+	committee = selectCappedVotingPower(validators, 3, 2500)
+	for _, member := range committee {
+		require.Equal(t, big.NewInt(200), member.VotingPower)
+	}
+}
+
+func TestSelectCommittee(t *testing.T) {
+	validators := testValidators(9000, 500, 300, 200)
+	seed := crypto.Keccak256Hash([]byte("seed"))
+	require.Equal(t, selectTopStake(validators, 3),
+		selectCommittee(&params.CommitteeSelectionFork{Algorithm: params.TopStakeCommitteeSelection}, validators, 3, seed))
+	require.Equal(t, selectWeightedRandom(validators, 3, seed),
+		selectCommittee(&params.CommitteeSelectionFork{Algorithm: params.WeightedRandomCommitteeSelection}, validators, 3, seed))
+	require.Equal(t, selectCappedVotingPower(validators, 3, 5000),
+		selectCommittee(&params.CommitteeSelectionFork{Algorithm: params.CappedVotingPowerCommitteeSelection, MaxVotingPowerShare: 5000}, validators, 3, seed))
+}
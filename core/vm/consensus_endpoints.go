@@ -0,0 +1,206 @@
+package vm
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/p2p/enode"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/rlp"
+)
+
+// ConsensusEndpointsAddress is the address of the registry of the consensus endpoints
+// published by the validators which hide behind sentry nodes.
+var ConsensusEndpointsAddress = common.BytesToAddress([]byte{248})
+
+const (
+	// MaxConsensusEndpoints is the maximum number of endpoints a validator can publish.
+	MaxConsensusEndpoints = 16
+	// maxConsensusEndpointsSize is the maximum size of the encoded endpoints list.
+	maxConsensusEndpointsSize = 4 * KB
+)
+
+var (
+	errEndpointsSignature = errors.New("invalid consensus endpoints signature")
+	errEndpointsSequence  = errors.New("consensus endpoints sequence too low")
+	errEndpointsTooMany   = errors.New("too many consensus endpoints")
+)
+
+// ConsensusEndpointsRecord is the list of enodes, with their ACN address, that other
+// committee members should connect to in order to reach a validator. It is signed by
+// the validator node key and submitted through the Autonity contract, which checks
+// that its sender is the validator treasury. The sequence number must increase with
+// every update.
+type ConsensusEndpointsRecord struct {
+	Validator common.Address
+	Sequence  uint64
+	Endpoints []string
+	Signature []byte
+}
+
+func (r *ConsensusEndpointsRecord) sigHash() common.Hash {
+	data, _ := rlp.EncodeToBytes([]any{r.Validator, r.Sequence, r.Endpoints})
+	return crypto.Keccak256Hash(data)
+}
+
+// SignConsensusEndpoints creates a record of the consensus endpoints of the validator
+// running with the given node key.
+func SignConsensusEndpoints(nodeKey *ecdsa.PrivateKey, sequence uint64, endpoints []string) (*ConsensusEndpointsRecord, error) {
+	record := &ConsensusEndpointsRecord{
+		Validator: crypto.PubkeyToAddress(nodeKey.PublicKey),
+		Sequence:  sequence,
+		Endpoints: endpoints,
+	}
+	signature, err := crypto.Sign(record.sigHash().Bytes(), nodeKey)
+	if err != nil {
+		return nil, err
+	}
+	record.Signature = signature
+	return record, nil
+}
+
+// data returns the encoded endpoints stored in the registry, nil to clear them.
+func (r *ConsensusEndpointsRecord) data() ([]byte, error) {
+	if len(r.Endpoints) == 0 {
+		return nil, nil
+	}
+	data, err := rlp.EncodeToBytes(r.Endpoints)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxConsensusEndpointsSize {
+		return nil, errEndpointsTooMany
+	}
+	return data, nil
+}
+
+// Verify checks that the record is well-formed and signed by its validator.
+func (r *ConsensusEndpointsRecord) Verify() error {
+	if len(r.Endpoints) > MaxConsensusEndpoints {
+		return errEndpointsTooMany
+	}
+	for _, endpoint := range r.Endpoints {
+		if _, err := enode.ParseV4NoResolve(endpoint); err != nil {
+			return err
+		}
+	}
+	pubKey, err := crypto.SigToPub(r.sigHash().Bytes(), r.Signature)
+	if err != nil || crypto.PubkeyToAddress(*pubKey) != r.Validator {
+		return errEndpointsSignature
+	}
+	return nil
+}
+
+// The registry stores, for each validator, the sequence number of its last record at
+// keccak(validator), the size of the RLP encoded endpoints in the following slot, and
+// the encoded endpoints in the slots starting at keccak(keccak(validator) + 1).
+func endpointsSlots(validator common.Address) (sequence, size, data *big.Int) {
+	sequence = crypto.Keccak256Hash(validator.Bytes()).Big()
+	size = new(big.Int).Add(sequence, common.Big1)
+	data = crypto.Keccak256Hash(common.BigToHash(size).Bytes()).Big()
+	return sequence, size, data
+}
+
+// ConsensusEndpoints returns the endpoints published by the given validator, nil if it
+// didn't publish any.
+func ConsensusEndpoints(db StateDB, validator common.Address) []string {
+	_, sizeSlot, dataSlot := endpointsSlots(validator)
+	size := db.GetState(ConsensusEndpointsAddress, common.BigToHash(sizeSlot)).Big().Uint64()
+	if size == 0 || size > maxConsensusEndpointsSize {
+		return nil
+	}
+	data := make([]byte, 0, size+DataLen)
+	for offset := uint64(0); offset < size; offset += DataLen {
+		data = append(data, db.GetState(ConsensusEndpointsAddress, common.BigToHash(dataSlot)).Bytes()...)
+		dataSlot.Add(dataSlot, common.Big1)
+	}
+	var endpoints []string
+	if err := rlp.DecodeBytes(data[:size], &endpoints); err != nil {
+		return nil
+	}
+	return endpoints
+}
+
+// dataSlots returns the number of storage slots needed to store size bytes.
+func dataSlots(size uint64) uint64 {
+	return (size + DataLen - 1) / DataLen
+}
+
+// consensusEndpoints is the registry of the consensus endpoints, only accessible to
+// the Autonity contract. Its input is a RLP encoded ConsensusEndpointsRecord and it
+// returns the address of the validator which signed it.
+type consensusEndpoints struct{}
+
+// RequiredGas covers the verification of the record and the update of the sequence
+// number and size slots.
+func (c *consensusEndpoints) RequiredGas(_ []byte) uint64 {
+	return params.AutonityEnodeCheckGas + 2*params.SstoreSetGasEIP2200
+}
+
+// RequiredStateGas charges every slot of endpoints written, and every slot of the
+// previous endpoints which gets cleared.
+func (c *consensusEndpoints) RequiredStateGas(input []byte, evm *EVM) uint64 {
+	record := new(ConsensusEndpointsRecord)
+	if err := rlp.DecodeBytes(input, record); err != nil {
+		return 0
+	}
+	data, err := record.data()
+	if err != nil {
+		return 0
+	}
+	_, sizeSlot, _ := endpointsSlots(record.Validator)
+	written := dataSlots(uint64(len(data)))
+	cleared := dataSlots(evm.StateDB.GetState(ConsensusEndpointsAddress, common.BigToHash(sizeSlot)).Big().Uint64())
+	if cleared > written {
+		cleared -= written
+	} else {
+		cleared = 0
+	}
+	return written*params.SstoreSetGasEIP2200 + cleared*params.SstoreResetGasEIP2200
+}
+
+func (c *consensusEndpoints) Run(input []byte, _ uint64, evm *EVM, caller common.Address) ([]byte, error) {
+	// skip auth check if run in test mode
+	if !evm.chainConfig.TestMode && caller != params.AutonityContractAddress {
+		return nil, errUnauthorized
+	}
+	if evm.interpreter.readOnly {
+		return nil, ErrWriteProtection
+	}
+	record := new(ConsensusEndpointsRecord)
+	if err := rlp.DecodeBytes(input, record); err != nil {
+		return nil, errBadInput
+	}
+	if err := record.Verify(); err != nil {
+		return nil, err
+	}
+	data, err := record.data()
+	if err != nil {
+		return nil, err
+	}
+
+	db := evm.StateDB
+	sequenceSlot, sizeSlot, dataSlot := endpointsSlots(record.Validator)
+	if sequence := db.GetState(ConsensusEndpointsAddress, common.BigToHash(sequenceSlot)).Big(); sequence.Uint64() >= record.Sequence {
+		return nil, errEndpointsSequence
+	}
+	// the registry account must not be empty, otherwise it would be deleted along with its storage.
+	if db.GetNonce(ConsensusEndpointsAddress) == 0 {
+		db.SetNonce(ConsensusEndpointsAddress, 1)
+	}
+	oldSize := db.GetState(ConsensusEndpointsAddress, common.BigToHash(sizeSlot)).Big().Uint64()
+	db.SetState(ConsensusEndpointsAddress, common.BigToHash(sequenceSlot), common.BigToHash(new(big.Int).SetUint64(record.Sequence)))
+	db.SetState(ConsensusEndpointsAddress, common.BigToHash(sizeSlot), common.BigToHash(big.NewInt(int64(len(data)))))
+	for offset := 0; offset < len(data) || uint64(offset) < oldSize; offset += DataLen {
+		var chunk common.Hash
+		if offset < len(data) {
+			copy(chunk[:], data[offset:])
+		}
+		db.SetState(ConsensusEndpointsAddress, common.BigToHash(dataSlot), chunk)
+		dataSlot.Add(dataSlot, common.Big1)
+	}
+	return common.LeftPadBytes(record.Validator.Bytes(), 32), nil
+}
@@ -0,0 +1,102 @@
+package vm
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/core/state"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/rlp"
+)
+
+const (
+	sentry1 = "enode://a979fb575495b8d6db44f750317d0f4622bf4c2aa3365d6af7c284339968eef29b69ad0dce72a4d8db5ebb4968de0e3bec910127f134779fbcb0cb6d3331163c@52.16.188.185:30303?acn=52.16.188.185:20203"
+	sentry2 = "enode://3f1d12044546b76342d59d4a05532c14b85aa669704bfe1f864fe079415aa2c02d743e03218e57a33fb94523adb54032871a6c51b2cc5514cb7c7e35b3ed0a99@13.93.211.84:30303?acn=13.93.211.84:20203"
+)
+
+func TestConsensusEndpoints(t *testing.T) {
+	nodeKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	validator := crypto.PubkeyToAddress(nodeKey.PublicKey)
+	sender := params.AutonityContractAddress
+
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	evm := NewEVM(BlockContext{}, TxContext{Origin: common.Address{0x01}}, statedb, params.TestChainConfig, Config{})
+	registry := &consensusEndpoints{}
+	encode := func(sequence uint64, endpoints []string) []byte {
+		record, err := SignConsensusEndpoints(nodeKey, sequence, endpoints)
+		require.NoError(t, err)
+		input, err := rlp.EncodeToBytes(record)
+		require.NoError(t, err)
+		return input
+	}
+	publish := func(sequence uint64, endpoints []string) error {
+		output, err := registry.Run(encode(sequence, endpoints), 0, evm, sender)
+		if err == nil {
+			require.Equal(t, common.LeftPadBytes(validator.Bytes(), 32), output)
+		}
+		return err
+	}
+
+	require.Nil(t, ConsensusEndpoints(statedb, validator))
+	require.NoError(t, publish(1, []string{sentry1, sentry2}))
+	require.Equal(t, []string{sentry1, sentry2}, ConsensusEndpoints(statedb, validator))
+
+	// the slots written and cleared are charged
+	both, err := rlp.EncodeToBytes([]string{sentry1, sentry2})
+	require.NoError(t, err)
+	one, err := rlp.EncodeToBytes([]string{sentry2})
+	require.NoError(t, err)
+	bothSlots, oneSlots := dataSlots(uint64(len(both))), dataSlots(uint64(len(one)))
+	require.Equal(t, bothSlots*params.SstoreResetGasEIP2200, registry.RequiredStateGas(encode(2, nil), evm))
+	require.Equal(t, oneSlots*params.SstoreSetGasEIP2200+(bothSlots-oneSlots)*params.SstoreResetGasEIP2200,
+		registry.RequiredStateGas(encode(2, []string{sentry2}), evm))
+
+	// older records can't be replayed
+	require.ErrorIs(t, publish(1, []string{sentry1}), errEndpointsSequence)
+	require.NoError(t, publish(2, []string{sentry2}))
+	require.Equal(t, []string{sentry2}, ConsensusEndpoints(statedb, validator))
+	require.NoError(t, publish(3, nil))
+	require.Nil(t, ConsensusEndpoints(statedb, validator))
+
+	// the registry survives the state finalisation
+	require.NoError(t, publish(4, []string{sentry1}))
+	statedb.Finalise(true)
+	require.Equal(t, []string{sentry1}, ConsensusEndpoints(statedb, validator))
+
+	// records signed by another key are rejected
+	record, err := SignConsensusEndpoints(nodeKey, 5, []string{sentry2})
+	require.NoError(t, err)
+	record.Validator = common.Address{0x02}
+	input, err := rlp.EncodeToBytes(record)
+	require.NoError(t, err)
+	_, err = registry.Run(input, 0, evm, sender)
+	require.ErrorIs(t, err, errEndpointsSignature)
+
+	// only the Autonity contract can modify the registry
+	_, err = registry.Run(encode(5, []string{sentry2}), 0, evm, common.Address{0x03})
+	require.ErrorIs(t, err, errUnauthorized)
+	require.Equal(t, big.NewInt(4), statedb.GetState(ConsensusEndpointsAddress, common.BigToHash(crypto.Keccak256Hash(validator.Bytes()).Big())).Big())
+}
+
+func TestConsensusEndpointsFork(t *testing.T) {
+	config := *params.TestChainConfig
+	config.ConsensusEndpointsBlock = big.NewInt(10)
+	for _, tc := range []struct {
+		block   int64
+		enabled bool
+	}{{9, false}, {10, true}, {11, true}} {
+		evm := NewEVM(BlockContext{BlockNumber: big.NewInt(tc.block)}, TxContext{}, nil, &config, Config{})
+		_, ok := evm.precompile(ConsensusEndpointsAddress)
+		require.Equal(t, tc.enabled, ok)
+		require.Equal(t, tc.enabled, slices.Contains(ActivePrecompiles(evm.chainRules), ConsensusEndpointsAddress))
+	}
+	// the addresses of the precompiles of the Ethereum releases are left untouched
+	require.NotContains(t, PrecompiledAddressesBerlin, ConsensusEndpointsAddress)
+}
@@ -22,7 +22,6 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"slices"
 	"sync"
 
 	"github.com/autonity/autonity/common"
@@ -155,11 +154,40 @@ var PrecompiledContractsBLS = map[common.Address]PrecompiledContract{
 	common.BytesToAddress([]byte{255}): &checkEnode{},
 }
 
+// PrecompiledContractsConsensusEndpoints contains the precompiled contracts enabled by
+// the consensus endpoints fork, on top of the ones of the Ethereum release in effect.
+var PrecompiledContractsConsensusEndpoints = map[common.Address]PrecompiledContract{
+	ConsensusEndpointsAddress: &consensusEndpoints{},
+}
+
+// PrecompiledContractsBLSSignatures contains the precompiled contracts enabled by
+// the BLS signatures fork.
+var PrecompiledContractsBLSSignatures = map[common.Address]PrecompiledContract{
+	common.BytesToAddress([]byte{242}): &BLSAggregatePublicKeys{},
+	common.BytesToAddress([]byte{243}): &BLSAggregateVerify{},
+	common.BytesToAddress([]byte{244}): &BLSVerify{},
+}
+
+// PrecompiledContractsRandomBeacon contains the precompiled contracts enabled by
+// the random beacon fork.
+var PrecompiledContractsRandomBeacon = map[common.Address]PrecompiledContract{
+	common.BytesToAddress([]byte{247}): &RandomnessBeacon{},
+}
+
 var (
 	PrecompiledAddressesBerlin    []common.Address
 	PrecompiledAddressesIstanbul  []common.Address
 	PrecompiledAddressesByzantium []common.Address
 	PrecompiledAddressesHomestead []common.Address
+
+	// the addresses of the precompiled contracts enabled by the Autonity forks, in a fixed order
+	PrecompiledAddressesBLSSignatures = []common.Address{
+		common.BytesToAddress([]byte{242}),
+		common.BytesToAddress([]byte{243}),
+		common.BytesToAddress([]byte{244}),
+	}
+	PrecompiledAddressesRandomBeacon       = []common.Address{common.BytesToAddress([]byte{247})}
+	PrecompiledAddressesConsensusEndpoints = []common.Address{ConsensusEndpointsAddress}
 )
 
 func init() {
@@ -179,16 +207,39 @@ func init() {
 
 // ActivePrecompiles returns the precompiles enabled with the current configuration.
 func ActivePrecompiles(rules params.Rules) []common.Address {
+	var addresses []common.Address
 	switch {
 	case rules.IsBerlin:
-		return PrecompiledAddressesBerlin
+		addresses = PrecompiledAddressesBerlin
 	case rules.IsIstanbul:
-		return PrecompiledAddressesIstanbul
+		addresses = PrecompiledAddressesIstanbul
 	case rules.IsByzantium:
-		return PrecompiledAddressesByzantium
+		addresses = PrecompiledAddressesByzantium
 	default:
-		return PrecompiledAddressesHomestead
+		addresses = PrecompiledAddressesHomestead
+	}
+	if rules.IsBLSSignatures {
+		addresses = appendPrecompiles(addresses, PrecompiledAddressesBLSSignatures)
+	}
+	if rules.IsRandomBeacon {
+		addresses = appendPrecompiles(addresses, PrecompiledAddressesRandomBeacon)
 	}
+	if rules.IsConsensusEndpoints {
+		addresses = appendPrecompiles(addresses, PrecompiledAddressesConsensusEndpoints)
+	}
+	return addresses
+}
+
+// appendPrecompiles returns the given precompiled contract addresses added to a copy
+// of the list of addresses, which is shared.
+func appendPrecompiles(addresses []common.Address, precompiles []common.Address) []common.Address {
+	return append(addresses[:len(addresses):len(addresses)], precompiles...)
+}
+
+// statefulPrecompiledContract is implemented by the precompiled contracts whose cost
+// depends on the state they modify, on top of their input.
+type statefulPrecompiledContract interface {
+	RequiredStateGas(input []byte, evm *EVM) uint64
 }
 
 // RunPrecompiledContract runs and evaluates the output of a precompiled contract.
@@ -200,6 +251,9 @@ func RunPrecompiledContract(
 	p PrecompiledContract, input []byte, suppliedGas uint64, blockNumber uint64, evm *EVM, caller common.Address,
 ) (ret []byte, remainingGas uint64, err error) {
 	gasCost := p.RequiredGas(input)
+	if stateful, ok := p.(statefulPrecompiledContract); ok {
+		gasCost += stateful.RequiredStateGas(input, evm)
+	}
 	if suppliedGas < gasCost {
 		return nil, 0, ErrOutOfGas
 	}
@@ -232,6 +286,12 @@ var (
 	errBadConsensusKeyLen = errors.New("invalid consensus key length")
 )
 
+// error variables for the randomness beacon
+var (
+	errBeaconOutOfHistory = errors.New("block out of the randomness beacon history")
+	errNoBeacon           = errors.New("no random beacon for the block")
+)
+
 type Upgrader struct{}
 
 func (u *Upgrader) RequiredGas(_ []byte) uint64 {
@@ -265,7 +325,7 @@ func (a *CommitteeSelector) RequiredGas(input []byte) uint64 {
 	return params.ProtocolOnlyBaseGas
 }
 
-func (a *CommitteeSelector) Run(input []byte, _ uint64, evm *EVM, caller common.Address) ([]byte, error) {
+func (a *CommitteeSelector) Run(input []byte, blockNumber uint64, evm *EVM, caller common.Address) ([]byte, error) {
 	// skip auth check if run in test mode
 	if !evm.chainConfig.TestMode && caller != params.AutonityContractAddress {
 		return nil, errUnauthorized
@@ -322,13 +382,16 @@ func (a *CommitteeSelector) Run(input []byte, _ uint64, evm *EVM, caller common.
 	}
 
 	committeeSize := min(len(validators), int(inputs.maxCommitteeSize))
-	// sort validators according to their voting power in descending order
-	// stable sort keeps the original order of equal elements
-	slices.SortStableFunc(validators, func(a, b *types.CommitteeMember) int {
-		return b.VotingPower.Cmp(a.VotingPower)
-	})
+	// the selection algorithm is scheduled in the chain config, the randomized
+	// ones are seeded by the hash of the previous block
+	selection := evm.chainConfig.CommitteeSelectionAt(new(big.Int).SetUint64(blockNumber))
+	var seed common.Hash
+	if blockNumber > 0 && evm.Context.GetHash != nil {
+		seed = evm.Context.GetHash(blockNumber - 1)
+	}
+	committee := selectCommittee(selection, validators, committeeSize, seed)
 
-	err := a.updateCommittee(inputs, validators, committeeSize, caller, stateDB)
+	err := a.updateCommittee(inputs, committee, len(committee), caller, stateDB)
 	return nil, err
 }
 
@@ -1402,3 +1465,151 @@ func (b *POPVerifier) Run(input []byte, _ uint64, _ *EVM, _ common.Address) ([]b
 	}
 	return successResult, nil
 }
+
+// BLSVerify verifies a BLS signature over a message, with the key and signature
+// encoding of the consensus keys. The input is the compressed public key (48
+// bytes), the compressed signature (96 bytes) and the message. It returns 1 for
+// a valid signature and 0 otherwise, malformed keys and signatures are errors.
+type BLSVerify struct{}
+
+func (b *BLSVerify) RequiredGas(input []byte) uint64 {
+	return params.BLSVerifyBaseGas + uint64(len(input)+31)/32*params.BLSVerifyPerWordGas
+}
+
+func (b *BLSVerify) Run(input []byte, _ uint64, _ *EVM, _ common.Address) ([]byte, error) {
+	if len(input) < blst.BLSPubkeyLength+blst.BLSSignatureLength {
+		return nil, errBadInput
+	}
+	key, err := blst.PublicKeyFromBytes(input[:blst.BLSPubkeyLength])
+	if err != nil {
+		return nil, err
+	}
+	sig, err := blst.SignatureFromBytes(input[blst.BLSPubkeyLength : blst.BLSPubkeyLength+blst.BLSSignatureLength])
+	if err != nil {
+		return nil, err
+	}
+	if !sig.Verify(key, input[blst.BLSPubkeyLength+blst.BLSSignatureLength:]) {
+		return failure32Byte, nil
+	}
+	return successResult, nil
+}
+
+// BLSAggregateVerify verifies an aggregated BLS signature over a message per
+// public key. The input is the compressed signature (96 bytes) followed by
+// pairs of a compressed public key (48 bytes) and a 32 bytes message. The keys
+// must come with a proof of possession, as the consensus keys of the
+// validators do, to prevent rogue key attacks. It returns 1 for a valid
+// signature and 0 otherwise, malformed keys and signatures are errors.
+type BLSAggregateVerify struct{}
+
+const blsAggregateVerifyPairLen = blst.BLSPubkeyLength + common.HashLength
+
+func (b *BLSAggregateVerify) RequiredGas(input []byte) uint64 {
+	pairs := uint64(0)
+	if len(input) > blst.BLSSignatureLength {
+		pairs = uint64(len(input)-blst.BLSSignatureLength) / blsAggregateVerifyPairLen
+	}
+	return params.BLSAggregateVerifyBaseGas + pairs*params.BLSAggregateVerifyPerPairGas
+}
+
+func (b *BLSAggregateVerify) Run(input []byte, _ uint64, _ *EVM, _ common.Address) ([]byte, error) {
+	if len(input) <= blst.BLSSignatureLength || (len(input)-blst.BLSSignatureLength)%blsAggregateVerifyPairLen != 0 {
+		return nil, errBadInput
+	}
+	sig, err := blst.SignatureFromBytes(input[:blst.BLSSignatureLength])
+	if err != nil {
+		return nil, err
+	}
+	pairs := (len(input) - blst.BLSSignatureLength) / blsAggregateVerifyPairLen
+	keys := make([]blst.PublicKey, pairs)
+	msgs := make([][32]byte, pairs)
+	for i := 0; i < pairs; i++ {
+		offset := blst.BLSSignatureLength + i*blsAggregateVerifyPairLen
+		if keys[i], err = blst.PublicKeyFromBytes(input[offset : offset+blst.BLSPubkeyLength]); err != nil {
+			return nil, err
+		}
+		copy(msgs[i][:], input[offset+blst.BLSPubkeyLength:offset+blsAggregateVerifyPairLen])
+	}
+	if !sig.AggregateVerify(keys, msgs) {
+		return failure32Byte, nil
+	}
+	return successResult, nil
+}
+
+// BLSAggregatePublicKeys aggregates compressed BLS public keys (48 bytes each)
+// into the compressed key verifying the aggregated signatures of their owners
+// over a same message, such as the committed seals of a committee.
+type BLSAggregatePublicKeys struct{}
+
+func (b *BLSAggregatePublicKeys) RequiredGas(input []byte) uint64 {
+	return params.BLSAggregatePublicKeysBaseGas + uint64(len(input)/blst.BLSPubkeyLength)*params.BLSAggregatePublicKeysPerKeyGas
+}
+
+func (b *BLSAggregatePublicKeys) Run(input []byte, _ uint64, _ *EVM, _ common.Address) ([]byte, error) {
+	if len(input) == 0 || len(input)%blst.BLSPubkeyLength != 0 {
+		return nil, errBadInput
+	}
+	keys := make([][]byte, len(input)/blst.BLSPubkeyLength)
+	for i := range keys {
+		keys[i] = input[i*blst.BLSPubkeyLength : (i+1)*blst.BLSPubkeyLength]
+	}
+	aggregated, err := blst.AggregatePublicKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+	return aggregated.Marshal(), nil
+}
+
+// RandomnessBeacon returns the randomness of one of the recent blocks, which is
+// the hash of the aggregate of the random beacons of the block and of the ones
+// preceding it, RandomnessBeaconWindow in total. Each beacon is signed by the
+// proposer of its block and verified again against its consensus key in the
+// committee of the parent block.
+//
+// The committed seals are not used: they are ECDSA signatures, which the
+// signers can grind, and each node assembles its own quorum of them, so they
+// differ from one node to another. The beacons are unique BLS signatures agreed
+// on in the headers, and aggregating several of them, made by successive
+// proposers, leaves the randomness unpredictable as long as one of these
+// proposers is honest.
+type RandomnessBeacon struct{}
+
+func (r *RandomnessBeacon) RequiredGas(_ []byte) uint64 {
+	return params.RandomnessBeaconGas * params.RandomnessBeaconWindow
+}
+
+// Run takes the block number as input, it must be one of the
+// RandomnessBeaconHistory blocks preceding the current one.
+func (r *RandomnessBeacon) Run(input []byte, blockNumber uint64, evm *EVM, _ common.Address) ([]byte, error) {
+	if len(input) != DataLen {
+		return nil, errBadInput
+	}
+	number := new(big.Int).SetBytes(input)
+	if !number.IsUint64() || number.Uint64() >= blockNumber || number.Uint64()+params.RandomnessBeaconHistory < blockNumber {
+		return nil, errBeaconOutOfHistory
+	}
+	if number.Uint64() < params.RandomnessBeaconWindow || evm.Context.GetHeader == nil {
+		return nil, errNoBeacon
+	}
+	parent := evm.Context.GetHeader(number.Uint64() - params.RandomnessBeaconWindow)
+	if parent == nil {
+		return nil, errNoBeacon
+	}
+	beacons := make([]blst.Signature, 0, params.RandomnessBeaconWindow)
+	for n := parent.Number.Uint64() + 1; n <= number.Uint64(); n++ {
+		header := evm.Context.GetHeader(n)
+		if header == nil || len(header.Beacon) == 0 {
+			return nil, errNoBeacon
+		}
+		if err := header.VerifyBeacon(parent); err != nil {
+			return nil, err
+		}
+		beacon, err := blst.SignatureFromBytes(header.Beacon)
+		if err != nil {
+			return nil, err
+		}
+		beacons = append(beacons, beacon)
+		parent = header
+	}
+	return crypto.Keccak256(blst.AggregateSignatures(beacons).Marshal()), nil
+}
@@ -21,12 +21,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/crypto"
 	"github.com/autonity/autonity/crypto/blst"
+	"github.com/autonity/autonity/params"
 	"github.com/stretchr/testify/require"
 )
 
@@ -68,6 +71,9 @@ var allPrecompiles = map[common.Address]PrecompiledContract{
 	common.BytesToAddress([]byte{16}):   &bls12381Pairing{},
 	common.BytesToAddress([]byte{17}):   &bls12381MapG1{},
 	common.BytesToAddress([]byte{18}):   &bls12381MapG2{},
+	common.BytesToAddress([]byte{0xf2}): &BLSAggregatePublicKeys{},
+	common.BytesToAddress([]byte{0xf3}): &BLSAggregateVerify{},
+	common.BytesToAddress([]byte{0xf4}): &BLSVerify{},
 }
 
 // EIP-152 test vectors
@@ -428,3 +434,280 @@ func TestPOPVerifier(t *testing.T) {
 	require.NotNil(t, err)
 	require.Equal(t, failure32Byte, ret)
 }
+
+func TestRandomnessBeacon(t *testing.T) {
+	key, err := blst.RandKey()
+	require.NoError(t, err)
+	proposer := common.HexToAddress("0x01")
+	committee := types.Committee{{Address: proposer, VotingPower: common.Big1, ConsensusKey: key.PublicKey().Marshal()}}
+
+	// the first header carries no beacon, the next ones sign the beacon seed of their parent
+	headers := []*types.Header{{Number: big.NewInt(0), Committee: committee}}
+	for i := 1; i < 300; i++ {
+		parent := headers[i-1]
+		headers = append(headers, &types.Header{
+			Number:    big.NewInt(int64(i)),
+			Coinbase:  proposer,
+			Committee: committee,
+			Beacon:    key.Sign(parent.BeaconMessage()).Marshal(),
+		})
+	}
+	evm := &EVM{Context: BlockContext{GetHeader: func(n uint64) *types.Header { return headers[n] }}}
+	beacon := &RandomnessBeacon{}
+	run := func(number uint64, current uint64) ([]byte, error) {
+		return beacon.Run(common.BigToHash(new(big.Int).SetUint64(number)).Bytes(), current, evm, common.Address{})
+	}
+
+	// the randomness of a block aggregates its beacon with the preceding ones
+	randomness := func(number int) []byte {
+		var beacons []blst.Signature
+		for n := number - int(params.RandomnessBeaconWindow) + 1; n <= number; n++ {
+			beacon, err := blst.SignatureFromBytes(headers[n].Beacon)
+			require.NoError(t, err)
+			beacons = append(beacons, beacon)
+		}
+		return crypto.Keccak256(blst.AggregateSignatures(beacons).Marshal())
+	}
+	ret, err := run(298, 299)
+	require.NoError(t, err)
+	require.Equal(t, randomness(298), ret)
+	ret, err = run(43, 299)
+	require.NoError(t, err)
+	require.Equal(t, randomness(43), ret)
+	require.NotEqual(t, randomness(42), ret)
+
+	// the history window excludes the current block and the blocks too old
+	_, err = run(299, 299)
+	require.ErrorIs(t, err, errBeaconOutOfHistory)
+	_, err = run(42, 299)
+	require.ErrorIs(t, err, errBeaconOutOfHistory)
+	_, err = run(0, 10)
+	require.ErrorIs(t, err, errNoBeacon)
+	// the window of the first blocks reaches the header without a beacon
+	_, err = run(params.RandomnessBeaconWindow-1, 10)
+	require.ErrorIs(t, err, errNoBeacon)
+	_, err = beacon.Run([]byte{1}, 299, evm, common.Address{})
+	require.ErrorIs(t, err, errBadInput)
+
+	// a beacon not matching the committee keys is rejected
+	other, err := blst.RandKey()
+	require.NoError(t, err)
+	headers[200].Beacon = other.Sign(headers[199].BeaconMessage()).Marshal()
+	_, err = run(200, 299)
+	require.ErrorIs(t, err, types.ErrInvalidBeacon)
+	// which invalidates the randomness of the blocks aggregating it
+	_, err = run(200+params.RandomnessBeaconWindow-1, 299)
+	require.ErrorIs(t, err, types.ErrInvalidBeacon)
+	_, err = run(200+params.RandomnessBeaconWindow+1, 299)
+	require.NoError(t, err)
+
+	// the precompiled contract is only enabled from the random beacon fork
+	address := common.BytesToAddress([]byte{247})
+	require.NotContains(t, ActivePrecompiles(params.Rules{IsBerlin: true}), address)
+	require.Contains(t, ActivePrecompiles(params.Rules{IsBerlin: true, IsRandomBeacon: true}), address)
+	evm = &EVM{chainRules: params.Rules{IsBerlin: true}}
+	_, ok := evm.precompile(address)
+	require.False(t, ok)
+	evm.chainRules.IsRandomBeacon = true
+	_, ok = evm.precompile(address)
+	require.True(t, ok)
+}
+
+// blsTestKeys returns deterministic BLS keys for the BLS precompile tests.
+func blsTestKeys(t testing.TB, n int) []blst.SecretKey {
+	keys := make([]blst.SecretKey, n)
+	for i := range keys {
+		key, err := blst.SecretKeyFromBytes(common.LeftPadBytes(big.NewInt(int64(i+1)).Bytes(), 32))
+		require.NoError(t, err)
+		keys[i] = key
+	}
+	return keys
+}
+
+// blsVerifyInput returns the input of the BLS verify precompile.
+func blsVerifyInput(key blst.SecretKey, msg []byte) []byte {
+	return append(append(key.PublicKey().Marshal(), key.Sign(msg).Marshal()...), msg...)
+}
+
+// blsAggregateVerifyInput returns the input of the BLS aggregate verify
+// precompile, each key signing a different message.
+func blsAggregateVerifyInput(keys []blst.SecretKey) []byte {
+	signatures := make([]blst.Signature, len(keys))
+	var pairs []byte
+	for i, key := range keys {
+		msg := crypto.Keccak256(big.NewInt(int64(i)).Bytes())
+		signatures[i] = key.Sign(msg)
+		pairs = append(append(pairs, key.PublicKey().Marshal()...), msg...)
+	}
+	return append(blst.AggregateSignatures(signatures).Marshal(), pairs...)
+}
+
+func TestBLSVerify(t *testing.T) {
+	keys := blsTestKeys(t, 2)
+	verifier := &BLSVerify{}
+	msg := []byte("autonity finality")
+
+	ret, err := verifier.Run(blsVerifyInput(keys[0], msg), 0, nil, common.Address{})
+	require.NoError(t, err)
+	require.Equal(t, successResult, ret)
+
+	// signature of another message or key
+	input := blsVerifyInput(keys[0], msg)
+	ret, err = verifier.Run(append(input[:len(input)-1:len(input)-1], 'x'), 0, nil, common.Address{})
+	require.NoError(t, err)
+	require.Equal(t, failure32Byte, ret)
+	input = blsVerifyInput(keys[0], msg)
+	copy(input, keys[1].PublicKey().Marshal())
+	ret, err = verifier.Run(input, 0, nil, common.Address{})
+	require.NoError(t, err)
+	require.Equal(t, failure32Byte, ret)
+
+	// malformed inputs
+	_, err = verifier.Run(input[:blst.BLSPubkeyLength+blst.BLSSignatureLength-1], 0, nil, common.Address{})
+	require.ErrorIs(t, err, errBadInput)
+	_, err = verifier.Run(make([]byte, blst.BLSPubkeyLength+blst.BLSSignatureLength), 0, nil, common.Address{})
+	require.Error(t, err)
+}
+
+func TestBLSAggregateVerify(t *testing.T) {
+	keys := blsTestKeys(t, 4)
+	verifier := &BLSAggregateVerify{}
+
+	input := blsAggregateVerifyInput(keys)
+	ret, err := verifier.Run(input, 0, nil, common.Address{})
+	require.NoError(t, err)
+	require.Equal(t, successResult, ret)
+	require.Equal(t, params.BLSAggregateVerifyBaseGas+4*params.BLSAggregateVerifyPerPairGas, verifier.RequiredGas(input))
+
+	// a message not signed by its key
+	input[len(input)-1] ^= 1
+	ret, err = verifier.Run(input, 0, nil, common.Address{})
+	require.NoError(t, err)
+	require.Equal(t, failure32Byte, ret)
+
+	// a missing pair
+	input = blsAggregateVerifyInput(keys)
+	ret, err = verifier.Run(input[:len(input)-blsAggregateVerifyPairLen], 0, nil, common.Address{})
+	require.NoError(t, err)
+	require.Equal(t, failure32Byte, ret)
+
+	// malformed inputs
+	_, err = verifier.Run(input[:blst.BLSSignatureLength], 0, nil, common.Address{})
+	require.ErrorIs(t, err, errBadInput)
+	_, err = verifier.Run(input[:len(input)-1], 0, nil, common.Address{})
+	require.ErrorIs(t, err, errBadInput)
+}
+
+func TestBLSAggregatePublicKeys(t *testing.T) {
+	keys := blsTestKeys(t, 3)
+	aggregator := &BLSAggregatePublicKeys{}
+	msg := []byte("committee message")
+
+	var input []byte
+	signatures := make([]blst.Signature, len(keys))
+	for i, key := range keys {
+		input = append(input, key.PublicKey().Marshal()...)
+		signatures[i] = key.Sign(msg)
+	}
+	aggregated, err := aggregator.Run(input, 0, nil, common.Address{})
+	require.NoError(t, err)
+	require.Len(t, aggregated, blst.BLSPubkeyLength)
+
+	// the aggregated key verifies the aggregated signature of the committee
+	verifyInput := append(append(aggregated, blst.AggregateSignatures(signatures).Marshal()...), msg...)
+	ret, err := (&BLSVerify{}).Run(verifyInput, 0, nil, common.Address{})
+	require.NoError(t, err)
+	require.Equal(t, successResult, ret)
+
+	_, err = aggregator.Run(nil, 0, nil, common.Address{})
+	require.ErrorIs(t, err, errBadInput)
+	_, err = aggregator.Run(input[:len(input)-1], 0, nil, common.Address{})
+	require.ErrorIs(t, err, errBadInput)
+}
+
+func TestBLSPrecompilesFork(t *testing.T) {
+	// the precompiled contracts are only enabled from the BLS signatures fork
+	for _, address := range []common.Address{
+		common.BytesToAddress([]byte{242}),
+		common.BytesToAddress([]byte{243}),
+		common.BytesToAddress([]byte{244}),
+	} {
+		require.NotContains(t, ActivePrecompiles(params.Rules{IsBerlin: true}), address)
+		require.Contains(t, ActivePrecompiles(params.Rules{IsBerlin: true, IsBLSSignatures: true}), address)
+		evm := &EVM{chainRules: params.Rules{IsBerlin: true}}
+		_, ok := evm.precompile(address)
+		require.False(t, ok)
+		evm.chainRules.IsBLSSignatures = true
+		_, ok = evm.precompile(address)
+		require.True(t, ok)
+	}
+}
+
+// Benchmarks the BLS precompiles, their gas costs are set for a throughput
+// close to the one of the ECRECOVER precompile.
+func BenchmarkPrecompiledBLSVerify(bench *testing.B) {
+	key := blsTestKeys(bench, 1)[0]
+	for _, size := range []int{32, 1024} {
+		benchmarkPrecompiled("f4", precompiledTest{
+			Input:    common.Bytes2Hex(blsVerifyInput(key, make([]byte, size))),
+			Expected: common.Bytes2Hex(successResult),
+			Name:     fmt.Sprintf("msg-%d", size),
+		}, bench)
+	}
+}
+
+func BenchmarkPrecompiledBLSAggregateVerify(bench *testing.B) {
+	keys := blsTestKeys(bench, 64)
+	for _, n := range []int{1, 8, 64} {
+		benchmarkPrecompiled("f3", precompiledTest{
+			Input:    common.Bytes2Hex(blsAggregateVerifyInput(keys[:n])),
+			Expected: common.Bytes2Hex(successResult),
+			Name:     fmt.Sprintf("pairs-%d", n),
+		}, bench)
+	}
+}
+
+// The keys hit the public key cache after the first run, the per key gas of the
+// aggregation covers the decoding and group check of keys not in the cache.
+func BenchmarkPrecompiledBLSAggregatePublicKeys(bench *testing.B) {
+	keys := blsTestKeys(bench, 100)
+	for _, n := range []int{1, 10, 100} {
+		var input []byte
+		for _, key := range keys[:n] {
+			input = append(input, key.PublicKey().Marshal()...)
+		}
+		aggregated, err := (&BLSAggregatePublicKeys{}).Run(input, 0, nil, common.Address{})
+		require.NoError(bench, err)
+		benchmarkPrecompiled("f2", precompiledTest{
+			Input:    common.Bytes2Hex(input),
+			Expected: common.Bytes2Hex(aggregated),
+			Name:     fmt.Sprintf("keys-%d", n),
+		}, bench)
+	}
+}
+
+func TestActivePrecompilesOrder(t *testing.T) {
+	// the precompiled contracts of the Autonity forks follow the Ethereum ones, in the
+	// order of their address lists, and each of them is enabled by its fork.
+	rules := params.Rules{IsBerlin: true, IsBLSSignatures: true, IsRandomBeacon: true, IsConsensusEndpoints: true}
+	var expected []common.Address
+	expected = append(expected, PrecompiledAddressesBerlin...)
+	expected = append(expected, PrecompiledAddressesBLSSignatures...)
+	expected = append(expected, PrecompiledAddressesRandomBeacon...)
+	expected = append(expected, PrecompiledAddressesConsensusEndpoints...)
+	for i := 0; i < 10; i++ {
+		require.Equal(t, expected, ActivePrecompiles(rules))
+	}
+	require.Equal(t, PrecompiledAddressesBerlin, ActivePrecompiles(params.Rules{IsBerlin: true}))
+
+	for addresses, contracts := range map[*[]common.Address]map[common.Address]PrecompiledContract{
+		&PrecompiledAddressesBLSSignatures:      PrecompiledContractsBLSSignatures,
+		&PrecompiledAddressesRandomBeacon:       PrecompiledContractsRandomBeacon,
+		&PrecompiledAddressesConsensusEndpoints: PrecompiledContractsConsensusEndpoints,
+	} {
+		require.Len(t, *addresses, len(contracts))
+		for _, address := range *addresses {
+			require.Contains(t, contracts, address)
+		}
+	}
+}
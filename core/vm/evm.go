@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/crypto"
 	"github.com/autonity/autonity/params"
 	"github.com/holiman/uint256"
@@ -40,6 +41,9 @@ type (
 	// GetHashFunc returns the n'th block hash in the blockchain
 	// and is used by the BLOCKHASH EVM op code.
 	GetHashFunc func(uint64) common.Hash
+	// GetHeaderFunc returns the n'th header in the blockchain
+	// and is used by the randomness beacon precompiled contract.
+	GetHeaderFunc func(uint64) *types.Header
 )
 
 func (evm *EVM) precompile(addr common.Address) (PrecompiledContract, bool) {
@@ -57,6 +61,15 @@ func (evm *EVM) precompile(addr common.Address) (PrecompiledContract, bool) {
 		precompiles = PrecompiledContractsHomestead
 	}
 	p, ok := precompiles[addr]
+	if !ok && evm.chainRules.IsBLSSignatures {
+		p, ok = PrecompiledContractsBLSSignatures[addr]
+	}
+	if !ok && evm.chainRules.IsRandomBeacon {
+		p, ok = PrecompiledContractsRandomBeacon[addr]
+	}
+	if !ok && evm.chainRules.IsConsensusEndpoints {
+		p, ok = PrecompiledContractsConsensusEndpoints[addr]
+	}
 	return p, ok
 }
 
@@ -70,6 +83,8 @@ type BlockContext struct {
 	Transfer TransferFunc
 	// GetHash returns the hash corresponding to n
 	GetHash GetHashFunc
+	// GetHeader returns the header corresponding to n
+	GetHeader GetHeaderFunc
 
 	// Block information
 	Coinbase    common.Address // Provides information for COINBASE
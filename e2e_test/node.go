@@ -194,7 +194,9 @@ func (n *Node) Start() error {
 		return fmt.Errorf("cannot create new eth: %w", err)
 	}
 
-	acn.New(n.Node, n.Eth, ethconfig.Defaults.NetworkID)
+	if err = acn.New(n.Node, n.Eth, ethconfig.Defaults.NetworkID); err != nil {
+		return fmt.Errorf("cannot create acn: %w", err)
+	}
 	if err = n.Node.Start(); err != nil {
 		return fmt.Errorf("failed to start a node: %w", err)
 	}
@@ -600,10 +602,18 @@ func (p *pipeDialer) Dial(_ context.Context, dest *enode.Node) (conn net.Conn, e
 }
 
 func NewInMemoryNetwork(t *testing.T, validators []*gengen.Validator, start bool, options ...gengen.GenesisOption) (Network, error) {
+	return NewInMemoryNetworkWithObservers(t, validators, nil, start, options...)
+}
+
+// NewInMemoryNetworkWithObservers creates an in-memory network of the given validators,
+// extended with observer nodes which are not part of the genesis committee. The
+// observers are the last nodes of the returned network.
+func NewInMemoryNetworkWithObservers(t *testing.T, validators, observers []*gengen.Validator, start bool, options ...gengen.GenesisOption) (Network, error) {
 	g, err := Genesis(validators, options...)
 	if err != nil {
 		return nil, fmt.Errorf("failed the genesis: %w", err)
 	}
+	validators = append(validators[:len(validators):len(validators)], observers...)
 	network := make([]*Node, len(validators))
 	executionManager := newPipeManager(p2p.Execution)
 	consensusManager := newPipeManager(p2p.Consensus)
@@ -629,19 +639,19 @@ func NewInMemoryNetwork(t *testing.T, validators []*gengen.Validator, start bool
 	}
 	wg.Wait()
 
-	startCh := make(chan error)
 	if start {
+		startCh := make(chan error)
 		for _, n := range network {
 			n := n
 			go func() {
 				startCh <- n.Start()
 			}()
 		}
-	}
-	for range network {
-		err := <-startCh
-		if err != nil {
-			t.Fatalf("failed to start node with error %v", err)
+		for range network {
+			err := <-startCh
+			if err != nil {
+				t.Fatalf("failed to start node with error %v", err)
+			}
 		}
 	}
 
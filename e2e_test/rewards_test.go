@@ -0,0 +1,57 @@
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/common/hexutil"
+	ccore "github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/eth"
+)
+
+// This test checks that the rewards distributed at the end of the epochs are
+// served by the rewards API, for the validators and for their delegators.
+func TestStakingRewards(t *testing.T) {
+	validators, err := Validators(t, 2, "10e18,v,10000000000000000000,0.0.0.0:%s,%s,%s,%s")
+	require.NoError(t, err)
+	network, err := NewNetworkFromValidators(t, validators, true, func(genesis *ccore.Genesis) {
+		genesis.Config.AutonityContractConfig.EpochPeriod = 5
+	})
+	require.NoError(t, err)
+	defer network.Shutdown()
+
+	// transaction fees are redistributed at the end of the epoch
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	require.NoError(t, network[0].SendAUTtracked(ctx, network[1].Address, 10))
+	require.NoError(t, network.WaitForHeight(12, 60))
+
+	validator := network[0].Address
+	treasury := crypto.PubkeyToAddress(validators[0].TreasuryKey.PublicKey)
+	client, err := network[0].Attach()
+	require.NoError(t, err)
+	defer client.Close()
+
+	var rewards []*eth.EpochReward
+	require.NoError(t, client.Call(&rewards, "aut_validatorRewards", validator, hexutil.Uint64(0), hexutil.Uint64(10), treasury))
+	require.NotEmpty(t, rewards)
+	var rewarded bool
+	for _, reward := range rewards {
+		require.Equal(t, uint64(reward.Epoch)*5+5, uint64(reward.EndBlock))
+		require.Equal(t, uint64(reward.Epoch)*5, uint64(reward.StartBlock))
+		// the whole stake is self-bonded by the treasury
+		require.Equal(t, reward.Stake, reward.Delegator.Stake)
+		require.Equal(t, reward.Reward, reward.Delegator.Reward)
+		rewarded = rewarded || reward.Reward.ToInt().Sign() > 0
+	}
+	require.True(t, rewarded)
+
+	var apy eth.APYEstimate
+	require.NoError(t, client.Call(&apy, "aut_estimatedApy", validator, treasury))
+	require.NotNil(t, apy.Delegator)
+	require.Equal(t, apy.Validator, *apy.Delegator)
+}
@@ -0,0 +1,112 @@
+package e2e
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/accounts/abi/bind"
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/cmd/gengen/gengen"
+	"github.com/autonity/autonity/core/vm"
+	"github.com/autonity/autonity/p2p/enode"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/rlp"
+)
+
+func acnEnode(v *gengen.Validator) string {
+	e := enode.NewV4(&v.NodeKey.PublicKey, v.NodeIP, v.NodePort, v.NodePort)
+	return enode.AppendConsensusEndpoint(v.AcnIP.String(), strconv.Itoa(v.AcnPort), e.String())
+}
+
+// startSentriedNetwork starts a network of four validators, the first of which
+// hides behind two sentry nodes, and publishes the sentries as the consensus
+// endpoints of the first validator. The proposals of the first validator are
+// propagated as block parts if blockParts is not zero.
+func startSentriedNetwork(t *testing.T, blockParts uint64) (Network, int, []string) {
+	validators, err := Validators(t, 4, "10e18,v,100,127.0.0.1:%s,%s,%s,%s")
+	require.NoError(t, err)
+	sentries, err := Validators(t, 2, "10e18,v,100,127.0.0.1:%s,%s,%s,%s")
+	require.NoError(t, err)
+
+	network, err := NewInMemoryNetworkWithObservers(t, validators, sentries, false)
+	require.NoError(t, err)
+	t.Cleanup(network.Shutdown)
+
+	sentryEnodes := []string{acnEnode(sentries[0]), acnEnode(sentries[1])}
+	network[0].EthConfig.ConsensusSentries = sentryEnodes
+	network[0].EthConfig.ConsensusBlockParts = blockParts
+	for _, n := range network[len(validators):] {
+		n.EthConfig.ConsensusSentryValidator = acnEnode(validators[0])
+	}
+	for _, n := range network {
+		require.NoError(t, n.Start())
+	}
+	require.NoError(t, network.WaitToMineNBlocks(3, 60, false))
+
+	// publish the sentries as the consensus endpoints of the first validator.
+	record, err := vm.SignConsensusEndpoints(network[0].Key, 1, sentryEnodes)
+	require.NoError(t, err)
+	data, err := rlp.EncodeToBytes(record)
+	require.NoError(t, err)
+	autonityContract, err := autonity.NewAutonity(params.AutonityContractAddress, network[0].WsClient)
+	require.NoError(t, err)
+	transactOpts, err := bind.NewKeyedTransactorWithChainID(validators[0].TreasuryKey, network[0].EthConfig.Genesis.Config.ChainID)
+	require.NoError(t, err)
+	tx, err := autonityContract.UpdateConsensusEndpoints(transactOpts, data)
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	require.NoError(t, network[:len(validators)].AwaitTransactions(ctx, tx))
+
+	state, err := network[1].Eth.BlockChain().State()
+	require.NoError(t, err)
+	require.Equal(t, sentryEnodes, vm.ConsensusEndpoints(state, network[0].Address))
+	return network, len(validators), sentryEnodes
+}
+
+// The first validator hides behind two sentry nodes. Once its consensus endpoints are
+// published, the rest of the committee reaches it through the sentries only, and it
+// keeps taking part in consensus.
+func TestSentryNodes(t *testing.T) {
+	network, _, sentryEnodes := startSentriedNetwork(t, 0)
+
+	// with one validator down, the network can only make progress if the first
+	// validator still takes part in consensus through its sentries.
+	require.NoError(t, network[1].Close(false))
+	require.NoError(t, network.WaitToMineNBlocks(5, 60, false))
+
+	sentryIDs := make(map[enode.ID]bool)
+	for _, url := range sentryEnodes {
+		sentry, err := enode.ParseACNV4(url)
+		require.NoError(t, err)
+		sentryIDs[sentry.ID()] = true
+	}
+	peers := network[0].ConsensusServer().Peers()
+	require.NotEmpty(t, peers)
+	for _, peer := range peers {
+		require.True(t, sentryIDs[peer.ID()], "validator connected to a node which is not one of its sentries")
+	}
+}
+
+// The proposals of the validator behind sentries are propagated as block parts,
+// which its sentries forward to the committee members they are assigned to. Its
+// proposals are decided in the first round, without falling back to another proposer.
+func TestSentryNodesBlockParts(t *testing.T) {
+	network, validators, _ := startSentriedNetwork(t, 1)
+	start := network[1].Eth.BlockChain().CurrentHeader().Number.Uint64()
+	require.NoError(t, network[:validators].WaitToMineNBlocks(12, 120, false))
+
+	chain := network[1].Eth.BlockChain()
+	proposed := 0
+	for number := start + 1; number <= chain.CurrentHeader().Number.Uint64(); number++ {
+		header := chain.GetHeaderByNumber(number)
+		if header.Coinbase == network[0].Address && header.Round == 0 {
+			proposed++
+		}
+	}
+	require.NotZero(t, proposed, "no proposal of the sentried validator decided in the first round")
+}
@@ -0,0 +1,27 @@
+package simulations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	e2e "github.com/autonity/autonity/e2e_test"
+)
+
+// every proposal is propagated as erasure-coded block parts, the network should
+// keep mining at the usual rate.
+func TestBlockPartsPropagation(t *testing.T) {
+	vals, err := e2e.Validators(t, 7, "10e18,v,100,0.0.0.0:%s,%s,%s,%s")
+	require.NoError(t, err)
+
+	network, err := e2e.NewNetworkFromValidators(t, vals, false)
+	require.NoError(t, err)
+	defer network.Shutdown()
+	for _, n := range network {
+		n.EthConfig.ConsensusBlockParts = 1
+		require.NoError(t, n.Start())
+	}
+
+	err = network.WaitToMineNBlocks(10, 60, false)
+	require.NoError(t, err)
+}
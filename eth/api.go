@@ -281,7 +281,7 @@ func (api *PublicDebugAPI) DumpBlock(blockNr rpc.BlockNumber) (state.Dump, error
 		return stateDb.RawDump(opts), nil
 	}
 	var block *types.Block
-	if blockNr == rpc.LatestBlockNumber {
+	if blockNr == rpc.LatestBlockNumber || blockNr.IsFinal() {
 		block = api.eth.blockchain.CurrentBlock()
 	} else {
 		block = api.eth.blockchain.GetBlockByNumber(uint64(blockNr))
@@ -353,6 +353,12 @@ func (api *PrivateDebugAPI) GetBadBlocks(ctx context.Context) ([]*BadBlockArgs,
 	return results, nil
 }
 
+// GetForkEvidence returns the last chains that the client has seen conflicting
+// with a committed block, and refused to reorganise onto.
+func (api *PrivateDebugAPI) GetForkEvidence(ctx context.Context) []*types.ForkEvidence {
+	return api.eth.blockchain.ForkEvidence()
+}
+
 // AccountRangeMaxResults is the maximum number of results to be returned per call
 const AccountRangeMaxResults = 256
 
@@ -369,7 +375,7 @@ func (api *PublicDebugAPI) AccountRange(blockNrOrHash rpc.BlockNumberOrHash, sta
 			_, stateDb = api.eth.miner.Pending()
 		} else {
 			var block *types.Block
-			if number == rpc.LatestBlockNumber {
+			if number == rpc.LatestBlockNumber || number.IsFinal() {
 				block = api.eth.blockchain.CurrentBlock()
 			} else {
 				block = api.eth.blockchain.GetBlockByNumber(uint64(number))
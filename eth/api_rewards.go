@@ -0,0 +1,399 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/params"
+)
+
+const (
+	// maxRewardEpochs is the maximum number of epochs served by a single
+	// rewards request.
+	maxRewardEpochs = 1024
+	// apyEpochs is the number of past epochs over which the APY is estimated.
+	apyEpochs = 30
+	// commissionRatePrecision is the denominator of the commission rates, see
+	// COMMISSION_RATE_PRECISION in Autonity.sol.
+	commissionRatePrecision = 10_000
+	secondsPerYear          = 365 * 24 * 60 * 60
+)
+
+var (
+	errEpochNotEnded    = errors.New("epoch not ended yet")
+	errEpochNotIndexed  = errors.New("epoch not indexed yet")
+	errInvalidEpochs    = errors.New("invalid epoch range")
+	errTooManyEpochs    = fmt.Errorf("epoch range exceeds %d epochs", maxRewardEpochs)
+	errNoRewardsHistory = errors.New("no rewards history")
+	errYieldOutOfRange  = errors.New("yield out of range")
+)
+
+// DelegatorReward is the share of the reward of a validator realised by one of
+// its delegators over an epoch.
+type DelegatorReward struct {
+	Stake  *hexutil.Big `json:"stake"`
+	Reward *hexutil.Big `json:"reward"`
+	Rate   float64      `json:"rate"`
+}
+
+// EpochReward is the reward realised by a committee member over an epoch.
+type EpochReward struct {
+	Epoch hexutil.Uint64 `json:"epoch"`
+	// StartBlock is the block ending the previous epoch, EndBlock the one
+	// ending this epoch, where the rewards are distributed.
+	StartBlock hexutil.Uint64 `json:"startBlock"`
+	EndBlock   hexutil.Uint64 `json:"endBlock"`
+	// Duration is the duration of the epoch in seconds.
+	Duration hexutil.Uint64 `json:"duration"`
+	// Stake is the voting power of the validator over the epoch, the one its
+	// reward is pro-rata to.
+	Stake          *hexutil.Big `json:"stake"`
+	Reward         *hexutil.Big `json:"reward"`
+	CommissionRate *hexutil.Big `json:"commissionRate"`
+	Commission     *hexutil.Big `json:"commission"`
+	Slashed        *hexutil.Big `json:"slashed"`
+	// Rate is the reward of the epoch per unit of stake.
+	Rate      float64          `json:"rate"`
+	Delegator *DelegatorReward `json:"delegator,omitempty"`
+}
+
+// APYEstimate is the annual percentage yield of a validator, and optionally of
+// one of its delegators, realised over the recent epochs. The yields are given
+// in percent.
+type APYEstimate struct {
+	FromEpoch hexutil.Uint64 `json:"fromEpoch"`
+	ToEpoch   hexutil.Uint64 `json:"toEpoch"`
+	Validator float64        `json:"validator"`
+	Delegator *float64       `json:"delegator,omitempty"`
+}
+
+// epochRewards are the rewards distributed at the end of an epoch.
+type epochRewards struct {
+	start *types.Header
+	// end is the header of the block ending the epoch. The rewards and the
+	// slashing penalties are applied by its finalization, with the epoch
+	// committee carried by its parent.
+	end     *types.Header
+	rewards map[common.Address]*big.Int
+	slashed map[common.Address]*big.Int
+}
+
+// RewardsAPI offers the rewards realised by the validators and by their
+// delegators over the past epochs. The blocks ending the epochs are found with
+// the epoch index. The Rewarded and SlashingEvent logs of their finalize
+// receipts are correlated with the committee and the stake of the validators
+// at the redistribution, which requires the state of these blocks.
+type RewardsAPI struct {
+	eth *Ethereum
+}
+
+// NewRewardsAPI creates the rewards API of the given chain.
+func NewRewardsAPI(eth *Ethereum) *RewardsAPI {
+	return &RewardsAPI{eth: eth}
+}
+
+// ValidatorRewards returns the rewards realised by the validator over the ended
+// epochs of the given range in which it was a committee member. If a delegator
+// is given, its share of the rewards is returned as well.
+func (api *RewardsAPI) ValidatorRewards(validator common.Address, fromEpoch hexutil.Uint64, toEpoch hexutil.Uint64, delegator *common.Address) ([]*EpochReward, error) {
+	if fromEpoch > toEpoch {
+		return nil, errInvalidEpochs
+	}
+	if toEpoch-fromEpoch >= maxRewardEpochs {
+		return nil, errTooManyEpochs
+	}
+	current, err := api.currentEpoch()
+	if err != nil {
+		return nil, err
+	}
+	if uint64(fromEpoch) >= current {
+		return nil, errEpochNotEnded
+	}
+	to := uint64(toEpoch)
+	if to >= current {
+		to = current - 1
+	}
+	var rewards []*EpochReward
+	for epoch := uint64(fromEpoch); epoch <= to; epoch++ {
+		reward, err := api.epochReward(epoch, validator, delegator)
+		if err != nil {
+			return nil, err
+		}
+		if reward != nil {
+			rewards = append(rewards, reward)
+		}
+	}
+	return rewards, nil
+}
+
+// EstimatedApy estimates the annual percentage yield of the validator, and of
+// the delegator if given, by compounding the rates realised over the last
+// epochs in which the validator was a committee member.
+func (api *RewardsAPI) EstimatedApy(validator common.Address, delegator *common.Address) (*APYEstimate, error) {
+	current, err := api.currentEpoch()
+	if err != nil {
+		return nil, err
+	}
+	var rewards []*EpochReward
+	for epoch := current; epoch > 0 && len(rewards) < apyEpochs && current-epoch < maxRewardEpochs; epoch-- {
+		reward, err := api.epochReward(epoch-1, validator, delegator)
+		if err != nil {
+			return nil, err
+		}
+		if reward != nil {
+			rewards = append(rewards, reward)
+		}
+	}
+	if len(rewards) == 0 {
+		return nil, errNoRewardsHistory
+	}
+	// rewards are sorted by decreasing epoch
+	estimate := &APYEstimate{
+		FromEpoch: rewards[len(rewards)-1].Epoch,
+		ToEpoch:   rewards[0].Epoch,
+	}
+	rates := make([]float64, len(rewards))
+	durations := make([]uint64, len(rewards))
+	for i, reward := range rewards {
+		rates[i] = reward.Rate
+		durations[i] = uint64(reward.Duration)
+	}
+	if estimate.Validator, err = annualYield(rates, durations); err != nil {
+		return nil, err
+	}
+	if delegator == nil {
+		return estimate, nil
+	}
+	rates, durations = rates[:0], durations[:0]
+	for _, reward := range rewards {
+		if reward.Delegator.Stake.ToInt().Sign() > 0 {
+			rates = append(rates, reward.Delegator.Rate)
+			durations = append(durations, uint64(reward.Duration))
+		}
+	}
+	if len(rates) > 0 {
+		apy, err := annualYield(rates, durations)
+		if err != nil {
+			return nil, err
+		}
+		estimate.Delegator = &apy
+	}
+	return estimate, nil
+}
+
+// currentEpoch returns the epoch of the head block.
+func (api *RewardsAPI) currentEpoch() (uint64, error) {
+	chain := api.eth.blockchain
+	head := chain.CurrentHeader()
+	statedb, err := chain.StateAt(head.Root)
+	if err != nil {
+		return 0, err
+	}
+	return chain.ProtocolContracts().EpochID(head, statedb)
+}
+
+// epochReward returns the reward realised by the validator, and by the
+// delegator if given, over an ended epoch. Nil is returned if the validator
+// was not a committee member.
+//
+// The validator is read in the state at the end of the transactions of the
+// block ending the epoch, before its finalization redistributes the rewards.
+// The redistribution happens after the slashing of the epoch, but the slashed
+// validators are jailed and not rewarded, and the commission rate changes are
+// only applied after it, so this state holds the stakes and the commission
+// rates of the rewarded validators at the redistribution.
+func (api *RewardsAPI) epochReward(epoch uint64, validator common.Address, delegator *common.Address) (*EpochReward, error) {
+	rewards, err := api.epochRewards(epoch)
+	if err != nil {
+		return nil, err
+	}
+	chain := api.eth.blockchain
+	parent := chain.GetHeaderByNumber(rewards.end.Number.Uint64() - 1)
+	if parent == nil {
+		return nil, fmt.Errorf("missing header %d", rewards.end.Number.Uint64()-1)
+	}
+	member := parent.CommitteeMember(validator)
+	if member == nil {
+		return nil, nil
+	}
+	block := chain.GetBlock(rewards.end.Hash(), rewards.end.Number.Uint64())
+	if block == nil {
+		return nil, fmt.Errorf("missing block %d", rewards.end.Number.Uint64())
+	}
+	_, _, statedb, err := api.eth.stateAtTransaction(block, len(block.Transactions()), 0)
+	if err != nil {
+		return nil, fmt.Errorf("state of block %d unavailable: %w", block.NumberU64(), err)
+	}
+	contracts := chain.ProtocolContracts()
+	val, err := contracts.Validator(rewards.end, statedb, validator)
+	if err != nil {
+		return nil, err
+	}
+	amount, ok := rewards.rewards[validator]
+	if !ok {
+		amount = new(big.Int)
+	}
+	slashed, ok := rewards.slashed[validator]
+	if !ok {
+		slashed = new(big.Int)
+	}
+	_, commission, _ := splitReward(val, amount)
+	reward := &EpochReward{
+		Epoch:          hexutil.Uint64(epoch),
+		StartBlock:     hexutil.Uint64(rewards.start.Number.Uint64()),
+		EndBlock:       hexutil.Uint64(rewards.end.Number.Uint64()),
+		Duration:       hexutil.Uint64(rewards.end.Time - rewards.start.Time),
+		Stake:          (*hexutil.Big)(member.VotingPower),
+		Reward:         (*hexutil.Big)(amount),
+		CommissionRate: (*hexutil.Big)(val.CommissionRate),
+		Commission:     (*hexutil.Big)(commission),
+		Slashed:        (*hexutil.Big)(slashed),
+		Rate:           rate(amount, member.VotingPower),
+	}
+	if delegator != nil {
+		balance, err := contracts.LiquidBalance(rewards.end, statedb, val.LiquidContract, *delegator)
+		if err != nil {
+			return nil, err
+		}
+		stake, share := delegatorReward(val, amount, *delegator, balance)
+		reward.Delegator = &DelegatorReward{
+			Stake:  (*hexutil.Big)(stake),
+			Reward: (*hexutil.Big)(share),
+			Rate:   rate(share, stake),
+		}
+	}
+	return reward, nil
+}
+
+// epochRewards returns the rewards distributed at the end of the given epoch,
+// decoded from the finalize receipt of the block ending the epoch.
+func (api *RewardsAPI) epochRewards(epoch uint64) (*epochRewards, error) {
+	end, err := api.epochEnd(epoch)
+	if err != nil {
+		return nil, err
+	}
+	start := api.eth.blockchain.Genesis().Header()
+	if epoch > 0 {
+		if start, err = api.epochEnd(epoch - 1); err != nil {
+			return nil, err
+		}
+	}
+	rewards := &epochRewards{start: start, end: end}
+	rewards.rewards, rewards.slashed = decodeEpochLogs(api.eth.blockchain.ProtocolContracts(), api.eth.blockchain.GetReceiptsByHash(end.Hash()))
+	return rewards, nil
+}
+
+// epochEnd returns the header of the block ending the given epoch, recorded in
+// the epoch index.
+func (api *RewardsAPI) epochEnd(epoch uint64) (*types.Header, error) {
+	number := rawdb.ReadEpochEnd(api.eth.chainDb, epoch)
+	if number == nil {
+		return nil, errEpochNotIndexed
+	}
+	header := api.eth.blockchain.GetHeaderByNumber(*number)
+	if header == nil {
+		return nil, fmt.Errorf("missing header %d", *number)
+	}
+	return header, nil
+}
+
+// decodeEpochLogs collects the rewards and the slashing penalties from the logs
+// of the receipts of a block ending an epoch.
+func decodeEpochLogs(contracts *autonity.ProtocolContracts, receipts types.Receipts) (rewards, slashed map[common.Address]*big.Int) {
+	rewards = make(map[common.Address]*big.Int)
+	slashed = make(map[common.Address]*big.Int)
+	for _, receipt := range receipts {
+		for _, l := range receipt.Logs {
+			switch l.Address {
+			case params.AutonityContractAddress:
+				if event, err := contracts.ParseRewarded(*l); err == nil {
+					addAmount(rewards, event.Addr, event.Amount)
+				}
+			case params.AccountabilityContractAddress:
+				if event, err := contracts.ParseSlashingEvent(*l); err == nil {
+					addAmount(slashed, event.Validator, event.Amount)
+				}
+			}
+		}
+	}
+	return rewards, slashed
+}
+
+func addAmount(amounts map[common.Address]*big.Int, addr common.Address, amount *big.Int) {
+	if total, ok := amounts[addr]; ok {
+		total.Add(total, amount)
+		return
+	}
+	amounts[addr] = new(big.Int).Set(amount)
+}
+
+// splitReward splits the reward of a validator as done by the Autonity and
+// Liquid contracts: the self-bonded stake earns its share directly, and the
+// commission is taken from the share of the delegators.
+func splitReward(validator *autonity.AutonityValidator, reward *big.Int) (self, commission, delegators *big.Int) {
+	self = new(big.Int)
+	if validator.BondedStake.Sign() > 0 {
+		self.Mul(reward, validator.SelfBondedStake)
+		self.Div(self, validator.BondedStake)
+	}
+	delegators = new(big.Int).Sub(reward, self)
+	commission = new(big.Int).Mul(delegators, validator.CommissionRate)
+	commission.Div(commission, big.NewInt(commissionRatePrecision))
+	delegators.Sub(delegators, commission)
+	return self, commission, delegators
+}
+
+// delegatorReward returns the stake of the delegator in the validator and its
+// share of the validator reward. The treasury of the validator earns the
+// self-bonded stake share and the commission, on top of its Liquid Newton.
+func delegatorReward(validator *autonity.AutonityValidator, reward *big.Int, delegator common.Address, liquidBalance *big.Int) (stake, share *big.Int) {
+	self, commission, delegators := splitReward(validator, reward)
+	stake, share = new(big.Int), new(big.Int)
+	if delegator == validator.Treasury {
+		stake.Set(validator.SelfBondedStake)
+		share.Add(self, commission)
+	}
+	if validator.LiquidSupply.Sign() > 0 && liquidBalance.Sign() > 0 {
+		delegated := new(big.Int).Sub(validator.BondedStake, validator.SelfBondedStake)
+		stake.Add(stake, delegated.Mul(delegated, liquidBalance).Div(delegated, validator.LiquidSupply))
+		share.Add(share, delegators.Mul(delegators, liquidBalance).Div(delegators, validator.LiquidSupply))
+	}
+	return stake, share
+}
+
+// rate returns the reward per unit of stake.
+func rate(reward, stake *big.Int) float64 {
+	if stake.Sign() == 0 {
+		return 0
+	}
+	r, _ := new(big.Float).Quo(new(big.Float).SetInt(reward), new(big.Float).SetInt(stake)).Float64()
+	return r
+}
+
+// annualYield compounds the rates realised over epochs of the given durations
+// into an annual percentage yield.
+func annualYield(rates []float64, durations []uint64) (float64, error) {
+	var (
+		growth   float64
+		duration uint64
+	)
+	for i, r := range rates {
+		growth += math.Log1p(r)
+		duration += durations[i]
+	}
+	if duration == 0 {
+		return 0, errNoRewardsHistory
+	}
+	apy := math.Expm1(growth*secondsPerYear/float64(duration)) * 100
+	if math.IsInf(apy, 0) || math.IsNaN(apy) {
+		return 0, errYieldOutOfRange
+	}
+	return apy, nil
+}
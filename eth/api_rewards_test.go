@@ -0,0 +1,54 @@
+package eth
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+)
+
+func TestDelegatorReward(t *testing.T) {
+	treasury := common.HexToAddress("0x1")
+	validator := &autonity.AutonityValidator{
+		Treasury:        treasury,
+		CommissionRate:  big.NewInt(1000), // 10%
+		BondedStake:     big.NewInt(400),
+		SelfBondedStake: big.NewInt(100),
+		LiquidSupply:    big.NewInt(600),
+	}
+	reward := big.NewInt(4000)
+
+	self, commission, delegators := splitReward(validator, reward)
+	require.Equal(t, big.NewInt(1000), self)
+	require.Equal(t, big.NewInt(300), commission)
+	require.Equal(t, big.NewInt(2700), delegators)
+
+	// a third of the Liquid Newton backs a third of the delegated stake
+	stake, share := delegatorReward(validator, reward, common.HexToAddress("0x2"), big.NewInt(200))
+	require.Equal(t, big.NewInt(100), stake)
+	require.Equal(t, big.NewInt(900), share)
+
+	// the treasury earns the self-bonded share and the commission
+	stake, share = delegatorReward(validator, reward, treasury, new(big.Int))
+	require.Equal(t, big.NewInt(100), stake)
+	require.Equal(t, big.NewInt(1300), share)
+
+	stake, share = delegatorReward(validator, reward, common.HexToAddress("0x3"), new(big.Int))
+	require.Zero(t, stake.Sign())
+	require.Zero(t, share.Sign())
+}
+
+func TestAnnualYield(t *testing.T) {
+	// two half-year epochs at 5% compound to 10.25%
+	apy, err := annualYield([]float64{0.05, 0.05}, []uint64{secondsPerYear / 2, secondsPerYear / 2})
+	require.NoError(t, err)
+	require.InDelta(t, 10.25, apy, 1e-9)
+
+	_, err = annualYield([]float64{0.05}, []uint64{0})
+	require.ErrorIs(t, err, errNoRewardsHistory)
+	_, err = annualYield([]float64{1}, []uint64{1})
+	require.ErrorIs(t, err, errYieldOutOfRange)
+}
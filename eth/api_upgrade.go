@@ -0,0 +1,60 @@
+package eth
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/autonity/autonity/accounts/abi"
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/hexutil"
+	"github.com/autonity/autonity/core"
+)
+
+// UpgradeArgs describes a protocol contract upgrade to simulate.
+type UpgradeArgs struct {
+	Target   common.Address `json:"target"`
+	Bytecode hexutil.Bytes  `json:"bytecode"`
+	// ABI is the JSON ABI of the new contract, as output by solc with --abi.
+	ABI json.RawMessage `json:"abi,omitempty"`
+	// OldStorageLayout and NewStorageLayout are the storage layouts of the
+	// current and new contracts, as output by solc with --storage-layout.
+	OldStorageLayout *autonity.StorageLayout `json:"oldStorageLayout,omitempty"`
+	NewStorageLayout *autonity.StorageLayout `json:"newStorageLayout,omitempty"`
+}
+
+// UpgradeAPI offers the simulation of the protocol contract upgrades, so that
+// the operator can check them before committing them.
+type UpgradeAPI struct {
+	chain *core.BlockChain
+}
+
+// NewUpgradeAPI creates the upgrade simulation API of the given chain.
+func NewUpgradeAPI(chain *core.BlockChain) *UpgradeAPI {
+	return &UpgradeAPI{chain: chain}
+}
+
+// SimulateUpgrade replaces the bytecode of a protocol contract on top of the
+// current head and finalizes the next block, reporting the ABI and storage
+// layout incompatibilities along with the broken protocol invariants.
+func (api *UpgradeAPI) SimulateUpgrade(args UpgradeArgs) (*autonity.UpgradeSimulation, error) {
+	if len(args.Bytecode) == 0 {
+		return nil, errors.New("missing bytecode")
+	}
+	var newABI *abi.ABI
+	if len(args.ABI) > 0 {
+		parsed, err := abi.JSON(bytes.NewReader(args.ABI))
+		if err != nil {
+			return nil, fmt.Errorf("invalid ABI: %v", err)
+		}
+		newABI = &parsed
+	}
+	head := api.chain.CurrentBlock().Header()
+	statedb, err := api.chain.StateAt(head.Root)
+	if err != nil {
+		return nil, err
+	}
+	return api.chain.ProtocolContracts().SimulateUpgrade(head, statedb, args.Target, args.Bytecode, newABI, args.OldStorageLayout, args.NewStorageLayout), nil
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/autonity/autonity/accounts"
 	"github.com/autonity/autonity/accounts/abi/bind/backends"
+	"github.com/autonity/autonity/autonity"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/hexutil"
 	"github.com/autonity/autonity/consensus"
@@ -92,6 +93,11 @@ type Ethereum struct {
 	bloomIndexer      *core.ChainIndexer             // Bloom indexer operating during block imports
 	closeBloomHandler chan struct{}
 
+	epochIndexer *core.ChainIndexer // Index of the blocks ending the epochs, operating during block imports
+
+	closeProtocolEvents chan struct{}  // Channel stopping the Liquid contract tracking of the protocol events API
+	protocolEventsWg    sync.WaitGroup // Wait group tracking the Liquid contract tracking of the protocol events API
+
 	APIBackend *EthAPIBackend
 
 	miner    *miner.Miner
@@ -102,7 +108,7 @@ type Ethereum struct {
 	netRPCService *ethapi.PublicNetAPI
 
 	p2pServer        *p2p.Server
-	topologySelector networkTopology
+	topologySelector Topology
 
 	lock sync.RWMutex // Protects the variadic fields (e.g. gas price and address)
 
@@ -146,7 +152,17 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 	if err != nil {
 		return nil, err
 	}
-	chainConfig, genesisHash, genesisErr := core.SetupGenesisBlockWithOverride(chainDb, config.Genesis, config.OverrideArrowGlacier, config.OverrideTerminalTotalDifficulty)
+	scheme, err := rawdb.ParseStateScheme(config.StateScheme, chainDb)
+	if err != nil {
+		return nil, err
+	}
+	if scheme == rawdb.PathScheme && config.NoPruning {
+		return nil, errors.New("archive mode is not supported by the path-based state scheme")
+	}
+	if config.HistoryEpochs != 0 && config.NoPruning {
+		return nil, errors.New("archive mode is not compatible with chain history pruning")
+	}
+	chainConfig, genesisHash, genesisErr := core.SetupGenesisBlockWithOverride(chainDb, config.Genesis, scheme, config.OverrideArrowGlacier, config.OverrideTerminalTotalDifficulty)
 	if _, ok := genesisErr.(*params.ConfigCompatError); genesisErr != nil && !ok {
 		return nil, genesisErr
 	}
@@ -164,6 +180,9 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 			TrieTimeLimit:       config.TrieTimeout,
 			SnapshotLimit:       config.SnapshotCache,
 			Preimages:           config.Preimages,
+			StateScheme:         scheme,
+			StateHistory:        config.StateHistory,
+			HistoryEpochs:       config.HistoryEpochs,
 		}
 	)
 	stack.Logger().Info("Initialised chain configuration", "config", chainConfig)
@@ -181,23 +200,30 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 	consensusEngine := ethconfig.CreateConsensusEngine(stack, chainConfig, config, config.Miner.Notify,
 		config.Miner.Noverify, &vmConfig, evMux, msgStore)
 
+	// the execution layer does not depend on the configured consensus topology
+	topologySelector, err := NewTopology(ethconfig.GraphTopology, 2, maxFullMeshPeers, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
 	nodeKey, _ := stack.Config().AutonityKeys()
 	eth := &Ethereum{
-		config:            config,
-		chainDb:           chainDb,
-		log:               stack.Logger(),
-		eventMux:          stack.EventMux(),
-		accountManager:    stack.AccountManager(),
-		engine:            consensusEngine,
-		closeBloomHandler: make(chan struct{}),
-		networkID:         config.NetworkID,
-		gasPrice:          config.Miner.GasPrice,
-		address:           crypto.PubkeyToAddress(nodeKey.PublicKey),
-		bloomRequests:     make(chan chan *bloombits.Retrieval),
-		bloomIndexer:      core.NewBloomIndexer(chainDb, params.BloomBitsBlocks, params.BloomConfirms),
-		p2pServer:         stack.ExecutionServer(),
-		topologySelector:  NewGraphTopology(2, maxFullMeshPeers),
-		shutdownTracker:   shutdowncheck.NewShutdownTracker(chainDb),
+		config:              config,
+		chainDb:             chainDb,
+		log:                 stack.Logger(),
+		eventMux:            stack.EventMux(),
+		accountManager:      stack.AccountManager(),
+		engine:              consensusEngine,
+		closeBloomHandler:   make(chan struct{}),
+		closeProtocolEvents: make(chan struct{}),
+		networkID:           config.NetworkID,
+		gasPrice:            config.Miner.GasPrice,
+		address:             crypto.PubkeyToAddress(nodeKey.PublicKey),
+		bloomRequests:       make(chan chan *bloombits.Retrieval),
+		bloomIndexer:        core.NewBloomIndexer(chainDb, params.BloomBitsBlocks, params.BloomConfirms),
+		p2pServer:           stack.ExecutionServer(),
+		topologySelector:    topologySelector,
+		shutdownTracker:     shutdowncheck.NewShutdownTracker(chainDb),
 	}
 
 	bcVersion := rawdb.ReadDatabaseVersion(chainDb)
@@ -238,7 +264,18 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 		eth.blockchain.SetHead(compat.RewindTo)
 		rawdb.WriteChainConfig(chainDb, genesisHash, chainConfig)
 	}
+	// Anchor an empty chain at the checkpoint loaded from file, the downloader
+	// retrieves the checkpoint header from the network otherwise
+	if header := config.SyncCheckpointHeader; header != nil {
+		if err := eth.blockchain.InitCheckpoint(header); err != nil {
+			eth.log.Warn("Ignoring sync checkpoint", "number", header.Number, "hash", header.Hash(), "err", err)
+		}
+	}
 	eth.bloomIndexer.Start(eth.blockchain)
+	if eth.epochIndexer, err = newEpochIndexer(chainDb); err != nil {
+		return nil, err
+	}
+	eth.epochIndexer.Start(eth.blockchain)
 
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = stack.ResolvePath(config.TxPool.Journal)
@@ -261,6 +298,7 @@ func New(stack *node.Node, config *Config) (*Ethereum, error) {
 		EventMux:       eth.eventMux,
 		Checkpoint:     checkpoint,
 		RequiredBlocks: config.RequiredBlocks,
+		SyncCheckpoint: config.SyncCheckpoint,
 	}); err != nil {
 		return nil, err
 	}
@@ -341,13 +379,34 @@ func (s *Ethereum) APIs() []rpc.API {
 	// Append any APIs exposed explicitly by the consensus engine
 	apis = append(apis, s.engine.APIs(s.BlockChain())...)
 
+	filterAPI := filters.NewPublicFilterAPI(s.APIBackend, false, 5*time.Minute)
 	if _, ok := s.engine.(consensus.BFT); ok {
 		apis = append(apis, rpc.API{
 			Namespace: "aut",
 			Version:   params.Version,
 			Service:   NewAutonityContractAPI(s.BlockChain(), s.BlockChain().ProtocolContracts()),
 			Public:    true,
+		}, rpc.API{
+			Namespace: "aut",
+			Version:   params.Version,
+			Service:   NewUpgradeAPI(s.BlockChain()),
+			Public:    true,
+		}, rpc.API{
+			Namespace: "aut",
+			Version:   params.Version,
+			Service:   NewRewardsAPI(s),
+			Public:    true,
 		})
+		if decoder, err := s.protocolEventDecoder(); err != nil {
+			log.Warn("Protocol events API disabled", "err", err)
+		} else {
+			apis = append(apis, rpc.API{
+				Namespace: "aut",
+				Version:   params.Version,
+				Service:   filters.NewProtocolEventsAPI(filterAPI, decoder, s.closeProtocolEvents, &s.protocolEventsWg),
+				Public:    true,
+			})
+		}
 	}
 
 	// Append all the local APIs and return
@@ -375,7 +434,7 @@ func (s *Ethereum) APIs() []rpc.API {
 		}, {
 			Namespace: "eth",
 			Version:   "1.0",
-			Service:   filters.NewPublicFilterAPI(s.APIBackend, false, 5*time.Minute),
+			Service:   filterAPI,
 			Public:    true,
 		}, {
 			Namespace: "admin",
@@ -399,6 +458,29 @@ func (s *Ethereum) APIs() []rpc.API {
 	}...)
 }
 
+// protocolEventDecoder creates the decoder of the protocol contract logs, aware
+// of the Liquid contracts of the validators registered at the head state.
+func (s *Ethereum) protocolEventDecoder() (*autonity.EventDecoder, error) {
+	contracts := s.blockchain.ProtocolContracts()
+	decoder, err := autonity.NewEventDecoder(contracts.ABI)
+	if err != nil {
+		return nil, err
+	}
+	head := s.blockchain.CurrentBlock().Header()
+	statedb, err := s.blockchain.StateAt(head.Root)
+	if err != nil {
+		return nil, err
+	}
+	liquids, err := contracts.LiquidContracts(head, statedb)
+	if err != nil {
+		return nil, err
+	}
+	for _, liquid := range liquids {
+		decoder.AddLiquidContract(liquid)
+	}
+	return decoder, nil
+}
+
 func (s *Ethereum) ResetWithGenesisBlock(gb *types.Block) {
 	s.blockchain.ResetWithGenesisBlock(gb)
 }
@@ -534,6 +616,7 @@ func (s *Ethereum) Downloader() *downloader.Downloader { return s.handler.downlo
 func (s *Ethereum) Synced() bool                       { return atomic.LoadUint32(&s.handler.acceptTxs) == 1 }
 func (s *Ethereum) SetSynced()                         { atomic.StoreUint32(&s.handler.acceptTxs, 1) }
 func (s *Ethereum) ArchiveMode() bool                  { return s.config.NoPruning }
+func (s *Ethereum) Config() *ethconfig.Config          { return s.config }
 func (s *Ethereum) BloomIndexer() *core.ChainIndexer   { return s.bloomIndexer }
 func (s *Ethereum) SyncMode() downloader.SyncMode {
 	mode, _ := s.handler.chainSync.modeAndLocalHead()
@@ -649,7 +732,12 @@ func (s *Ethereum) Stop() error {
 	s.handler.Stop()
 	// Then stop everything else.
 	s.bloomIndexer.Close()
+	s.epochIndexer.Close()
 	close(s.closeBloomHandler)
+	// The tracking unsubscribes from the event system, which stops along with
+	// the transaction pool and the blockchain.
+	close(s.closeProtocolEvents)
+	s.protocolEventsWg.Wait()
 	s.txPool.Stop()
 	s.miner.Close()
 	s.blockchain.Stop()
@@ -0,0 +1,113 @@
+package downloader
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/eth/protocols/eth"
+	"github.com/autonity/autonity/log"
+)
+
+// backfillRecheckInterval is the time to wait before retrying a header backfill
+// after a failure, or before checking whether the chain has been anchored yet.
+var backfillRecheckInterval = 3 * time.Second
+
+var errNoBackfillPeer = errors.New("no peer to backfill headers from")
+
+// backfiller is a background thread that retrieves the headers below the sync
+// checkpoint the chain was anchored at, until all headers down to genesis are
+// present in the local chain.
+func (d *Downloader) backfiller() {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-d.quitCh:
+			return
+		case <-timer.C:
+		}
+		tail := d.blockchain.HeaderBackfillTail()
+		if tail == nil {
+			if d.blockchain.Checkpoint() != nil {
+				return // All headers backfilled
+			}
+			// Chain not anchored yet, wait for the first sync cycle
+			timer.Reset(backfillRecheckInterval)
+			continue
+		}
+		if err := d.backfillHeaders(*tail); err != nil {
+			log.Debug("Header backfill failed", "tail", *tail, "err", err)
+			timer.Reset(backfillRecheckInterval)
+			continue
+		}
+		timer.Reset(0)
+	}
+}
+
+// backfillHeaders retrieves the batch of headers directly below the given tail
+// from a random peer and inserts it into the local chain.
+func (d *Downloader) backfillHeaders(tail uint64) error {
+	peers := d.peers.AllPeers()
+	if len(peers) == 0 {
+		return errNoBackfillPeer
+	}
+	p := peers[0] // Map iteration order is random
+
+	from, count := uint64(1), tail-1
+	if count > uint64(MaxHeaderFetch) {
+		from, count = tail-uint64(MaxHeaderFetch), uint64(MaxHeaderFetch)
+	}
+	headers, err := d.requestBackfill(p, from, int(count))
+	if err != nil {
+		return err
+	}
+	// Peers synced from a checkpoint themselves might not have the headers
+	if len(headers) == 0 {
+		return fmt.Errorf("peer %s has no headers below #%d", p.id, tail)
+	}
+	if err := d.blockchain.BackfillHeaders(headers); err != nil {
+		p.log.Warn("Invalid backfill headers", "from", from, "count", len(headers), "err", err)
+		d.dropPeer(p.id)
+		return err
+	}
+	return nil
+}
+
+// requestBackfill is a blocking header request for the backfiller, which is not
+// bound to the cancellation of the sync cycles.
+func (d *Downloader) requestBackfill(p *peerConnection, from uint64, count int) ([]*types.Header, error) {
+	start := time.Now()
+	resCh := make(chan *eth.Response)
+
+	req, err := p.peer.RequestHeadersByNumber(from, count, 0, false, resCh)
+	if err != nil {
+		return nil, err
+	}
+	defer req.Close()
+
+	ttl := d.peers.rates.TargetTimeout()
+
+	timeoutTimer := time.NewTimer(ttl)
+	defer timeoutTimer.Stop()
+
+	select {
+	case <-d.quitCh:
+		return nil, errCancelContentProcessing
+
+	case <-timeoutTimer.C:
+		p.log.Debug("Backfill header request timed out", "elapsed", ttl)
+		headerTimeoutMeter.Mark(1)
+
+		return nil, errTimeout
+
+	case res := <-resCh:
+		headerReqTimer.Update(time.Since(start))
+		headerInMeter.Mark(int64(len(*res.Res.(*eth.BlockHeadersPacket))))
+
+		res.Done <- nil
+		return *res.Res.(*eth.BlockHeadersPacket), nil
+	}
+}
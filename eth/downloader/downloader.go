@@ -77,6 +77,8 @@ var (
 	errCancelStateFetch        = errors.New("state data download canceled (requested)")
 	errCancelContentProcessing = errors.New("content processing canceled (requested)")
 	errCanceled                = errors.New("syncing canceled (requested)")
+	errCheckpointTooRecent     = errors.New("sync checkpoint too recent to snap sync from")
+	errUnknownCheckpoint       = errors.New("sync checkpoint unknown to peer")
 	errTooOld                  = errors.New("peer's protocol version too old")
 	errNoAncestorFound         = errors.New("no common ancestor found")
 )
@@ -95,10 +97,11 @@ type Downloader struct {
 	mode uint32         // Synchronisation mode defining the strategy used (per sync cycle), use d.getMode() to get the SyncMode
 	mux  *event.TypeMux // Event multiplexer to announce sync operation events
 
-	checkpoint uint64   // Checkpoint block number to enforce head against (e.g. snap sync)
-	genesis    uint64   // Genesis block number to limit sync to (e.g. light client CHT)
-	queue      *queue   // Scheduler for selecting the hashes to download
-	peers      *peerSet // Set of active peers from which download can proceed
+	checkpoint     uint64      // Checkpoint block number to enforce head against (e.g. snap sync)
+	syncCheckpoint common.Hash // Trusted committed header to anchor an empty chain at (snap sync)
+	genesis        uint64      // Genesis block number to limit sync to (e.g. light client CHT)
+	queue          *queue      // Scheduler for selecting the hashes to download
+	peers          *peerSet    // Set of active peers from which download can proceed
 
 	stateDB ethdb.Database // Database to state sync into (and deduplicate via)
 
@@ -198,17 +201,35 @@ type BlockChain interface {
 
 	// Snapshots returns the blockchain snapshot tree to paused it during sync.
 	Snapshots() *snapshot.Tree
+
+	// InitCheckpoint anchors an empty local chain at a trusted header.
+	InitCheckpoint(*types.Header) error
+
+	// Checkpoint retrieves the trusted header the local chain is anchored at.
+	Checkpoint() *types.Header
+
+	// HeaderBackfillTail retrieves the oldest header present below the checkpoint.
+	HeaderBackfillTail() *uint64
+
+	// BackfillHeaders inserts a batch of headers below the backfill tail.
+	BackfillHeaders([]*types.Header) error
 }
 
 // New creates a new downloader to fetch hashes and blocks from remote peers.
-func New(checkpoint uint64, stateDb ethdb.Database, mux *event.TypeMux, chain BlockChain, lightchain LightChain, dropPeer peerDropFn) *Downloader {
+func New(checkpoint uint64, syncCheckpoint common.Hash, stateDb ethdb.Database, mux *event.TypeMux, chain BlockChain, lightchain LightChain, dropPeer peerDropFn) *Downloader {
 	if lightchain == nil {
 		lightchain = chain
 	}
+	// The state is synced with the scheme the genesis state was stored with
+	scheme := rawdb.ReadStateScheme(stateDb)
+	if scheme == "" {
+		scheme = rawdb.HashScheme
+	}
 	dl := &Downloader{
 		stateDB:        stateDb,
 		mux:            mux,
 		checkpoint:     checkpoint,
+		syncCheckpoint: syncCheckpoint,
 		queue:          newQueue(blockCacheMaxItems, blockCacheInitialItems),
 		peers:          newPeerSet(),
 		blockchain:     chain,
@@ -216,10 +237,13 @@ func New(checkpoint uint64, stateDb ethdb.Database, mux *event.TypeMux, chain Bl
 		dropPeer:       dropPeer,
 		headerProcCh:   make(chan *headerTask, 1),
 		quitCh:         make(chan struct{}),
-		SnapSyncer:     snap.NewSyncer(stateDb),
+		SnapSyncer:     snap.NewSyncer(stateDb, scheme),
 		stateSyncStart: make(chan *stateSync),
 	}
 	go dl.stateFetcher()
+	if chain != nil && (syncCheckpoint != (common.Hash{}) || chain.HeaderBackfillTail() != nil) {
+		go dl.backfiller()
+	}
 	return dl
 }
 
@@ -436,6 +460,12 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 		log.Debug("Synchronisation terminated", "elapsed", common.PrettyDuration(time.Since(start)))
 	}(time.Now())
 
+	// Anchor an empty chain at the trusted checkpoint before syncing from there
+	if mode == SnapSync && d.syncCheckpoint != (common.Hash{}) {
+		if err := d.anchorCheckpoint(p); err != nil {
+			return err
+		}
+	}
 	// Look up the sync boundaries: the common ancestor and the target block
 	latest, pivot, err := d.fetchHead(p)
 	if err != nil {
@@ -461,6 +491,18 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 	d.syncStatsChainHeight = height
 	d.syncStatsLock.Unlock()
 
+	// Sync from the checkpoint if the chain is anchored and not past it yet,
+	// which requires the pivot to be above it
+	var checkpoint *types.Header
+	if mode == SnapSync {
+		checkpoint = d.blockchain.Checkpoint()
+	}
+	if checkpoint != nil && origin < checkpoint.Number.Uint64() {
+		if height <= uint64(fsMinFullBlocks) || pivot.Number.Uint64() <= checkpoint.Number.Uint64() {
+			return fmt.Errorf("%w: checkpoint #%d, pivot #%d", errCheckpointTooRecent, checkpoint.Number, pivot.Number)
+		}
+		origin = checkpoint.Number.Uint64()
+	}
 	// Ensure our origin point is below any snap sync pivot point
 	if mode == SnapSync {
 		if height <= uint64(fsMinFullBlocks) {
@@ -508,6 +550,11 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 		if origin >= frozen && frozen != 0 {
 			d.ancientLimit = 0
 			log.Info("Disabling direct-ancient mode", "origin", origin, "ancient", frozen-1)
+		} else if checkpoint != nil && frozen <= checkpoint.Number.Uint64() {
+			// The blocks below the checkpoint are frozen by the freezer once
+			// their headers are backfilled.
+			d.ancientLimit = 0
+			log.Info("Disabling direct-ancient mode", "origin", origin, "checkpoint", checkpoint.Number)
 		} else if d.ancientLimit > 0 {
 			log.Debug("Enabling direct-ancient mode", "ancient", d.ancientLimit)
 		}
@@ -541,6 +588,25 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td *big.I
 	return d.spawnSync(fetchers)
 }
 
+// anchorCheckpoint retrieves the trusted sync checkpoint header from the given
+// peer and anchors the local chain at it, if the chain is still empty.
+func (d *Downloader) anchorCheckpoint(p *peerConnection) error {
+	if d.blockchain.Checkpoint() != nil || d.blockchain.CurrentHeader().Number.Sign() != 0 {
+		return nil
+	}
+	headers, hashes, err := d.fetchHeadersByHash(p, d.syncCheckpoint, 1, 0, false)
+	if err != nil {
+		return err
+	}
+	switch {
+	case len(headers) == 0:
+		return fmt.Errorf("%w: %x", errUnknownCheckpoint, d.syncCheckpoint)
+	case len(headers) > 1 || hashes[0] != d.syncCheckpoint:
+		return fmt.Errorf("%w: non-requested checkpoint header", errBadPeer)
+	}
+	return d.blockchain.InitCheckpoint(headers[0])
+}
+
 // spawnSync runs d.process and all given fetcher functions to completion in
 // separate goroutines, returning the first error that appears.
 func (d *Downloader) spawnSync(fetchers []func() error) error {
@@ -57,6 +57,12 @@ type downloadTester struct {
 
 // newTester creates a new downloader test mocker.
 func newTester() *downloadTester {
+	return newTesterWithCheckpoint(common.Hash{})
+}
+
+// newTesterWithCheckpoint creates a new downloader test mocker syncing from the
+// given trusted checkpoint.
+func newTesterWithCheckpoint(checkpoint common.Hash) *downloadTester {
 	freezer, err := ioutil.TempDir("", "")
 	if err != nil {
 		panic(err)
@@ -76,7 +82,7 @@ func newTester() *downloadTester {
 		chain:   chain,
 		peers:   make(map[string]*downloadTesterPeer),
 	}
-	tester.downloader = New(0, db, new(event.TypeMux), tester.chain, nil, tester.dropPeer)
+	tester.downloader = New(0, checkpoint, db, new(event.TypeMux), tester.chain, nil, tester.dropPeer)
 	return tester
 }
 
@@ -1369,3 +1375,68 @@ func testCheckpointEnforcement(t *testing.T, protocol uint, mode SyncMode) {
 		assertOwnChain(t, tester, len(chain.blocks))
 	}
 }
+
+// Tests that snap sync can start from a trusted sync checkpoint, skipping the
+// bodies and receipts below it while backfilling its ancestor headers.
+func TestSyncCheckpoint66(t *testing.T) {
+	chain := testChainBase.shorten(blockCacheMaxItems - 15)
+	checkpoint := chain.blocks[len(chain.blocks)/2]
+
+	tester := newTesterWithCheckpoint(checkpoint.Hash())
+	defer tester.terminate()
+
+	tester.newPeer("peer", eth.ETH66, chain.blocks[1:])
+	if err := tester.sync("peer", nil, SnapSync); err != nil {
+		t.Fatalf("failed to synchronise blocks: %v", err)
+	}
+	assertOwnChain(t, tester, len(chain.blocks))
+
+	if have := tester.chain.Checkpoint(); have == nil || have.Hash() != checkpoint.Hash() {
+		t.Fatalf("sync checkpoint mismatch: have %v, want %x", have, checkpoint.Hash())
+	}
+	number := checkpoint.NumberU64()
+	if tester.chain.HasBlock(checkpoint.Hash(), number) {
+		t.Fatalf("checkpoint block #%d body retrieved", number)
+	}
+	if next := chain.blocks[number+1]; !tester.chain.HasBlock(next.Hash(), next.NumberU64()) {
+		t.Fatalf("block #%d after checkpoint missing", next.NumberU64())
+	}
+	if tail := tester.chain.HistoryTail(); tail != number+1 {
+		t.Fatalf("history tail mismatch: have %d, want %d", tail, number+1)
+	}
+	// Wait for the headers below the checkpoint to be backfilled
+	for start := time.Now(); tester.chain.HeaderBackfillTail() != nil; {
+		if time.Since(start) > 10*time.Second {
+			t.Fatalf("header backfill stuck at #%d", *tester.chain.HeaderBackfillTail())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	// The total difficulties are derived from the checkpoint, assuming a constant
+	// block difficulty, so only check that they add up
+	for _, block := range chain.blocks[1:number] {
+		header := tester.chain.GetHeaderByNumber(block.NumberU64())
+		if header == nil || header.Hash() != block.Hash() {
+			t.Fatalf("backfilled header #%d mismatch", block.NumberU64())
+		}
+		td := tester.chain.GetTd(block.Hash(), block.NumberU64())
+		child := chain.blocks[block.NumberU64()+1]
+		if want := new(big.Int).Sub(tester.chain.GetTd(child.Hash(), child.NumberU64()), child.Difficulty()); td == nil || td.Cmp(want) != 0 {
+			t.Fatalf("backfilled td #%d mismatch: have %v, want %v", block.NumberU64(), td, want)
+		}
+	}
+}
+
+// Tests that a sync checkpoint too close to the chain head, where there are no
+// blocks to snap sync above it, is refused.
+func TestSyncCheckpointTooRecent66(t *testing.T) {
+	chain := testChainBase.shorten(blockCacheMaxItems - 15)
+	checkpoint := chain.blocks[len(chain.blocks)-fsMinFullBlocks/2]
+
+	tester := newTesterWithCheckpoint(checkpoint.Hash())
+	defer tester.terminate()
+
+	tester.newPeer("peer", eth.ETH66, chain.blocks[1:])
+	if err := tester.sync("peer", nil, SnapSync); !errors.Is(err, errCheckpointTooRecent) {
+		t.Fatalf("sync error mismatch: have %v, want %v", err, errCheckpointTooRecent)
+	}
+}
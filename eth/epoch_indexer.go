@@ -0,0 +1,86 @@
+package eth
+
+import (
+	"context"
+
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/ethdb"
+	"github.com/autonity/autonity/params"
+)
+
+// epochIndexSection is the number of blocks in a section of the epoch index.
+// Sections of a single block index the epochs as soon as they end.
+const epochIndexSection = 1
+
+// epochIndexer implements core.ChainIndexerBackend, recording the block ending
+// each epoch from the NewEpoch event emitted by its finalization.
+type epochIndexer struct {
+	db       ethdb.Database             // database instance to write the index into
+	filterer *autonity.AutonityFilterer // decoder of the Autonity contract events
+	topic    common.Hash                // topic of the NewEpoch event
+	batch    ethdb.Batch                // batch of the section being processed
+}
+
+// newEpochIndexer returns a chain indexer that records the blocks ending the
+// epochs of the canonical chain.
+func newEpochIndexer(db ethdb.Database) (*core.ChainIndexer, error) {
+	contractABI, err := autonity.AutonityMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	filterer, err := autonity.NewAutonityFilterer(params.AutonityContractAddress, nil)
+	if err != nil {
+		return nil, err
+	}
+	backend := &epochIndexer{
+		db:       db,
+		filterer: filterer,
+		topic:    contractABI.Events["NewEpoch"].ID,
+	}
+	table := rawdb.NewTable(db, string(rawdb.EpochIndexPrefix))
+	return core.NewChainIndexer(db, table, backend, epochIndexSection, 0, 0, "epochs"), nil
+}
+
+// Reset implements core.ChainIndexerBackend, starting a new epoch index section.
+func (e *epochIndexer) Reset(ctx context.Context, section uint64, lastSectionHead common.Hash) error {
+	e.batch = e.db.NewBatch()
+	return nil
+}
+
+// Process implements core.ChainIndexerBackend, recording the epoch ended by the
+// header if any. The receipts are only read for the headers whose bloom matches
+// the NewEpoch event.
+func (e *epochIndexer) Process(ctx context.Context, header *types.Header) error {
+	if !types.BloomLookup(header.Bloom, params.AutonityContractAddress) || !types.BloomLookup(header.Bloom, e.topic) {
+		return nil
+	}
+	for _, receipt := range rawdb.ReadRawReceipts(e.db, header.Hash(), header.Number.Uint64()) {
+		for _, l := range receipt.Logs {
+			if l.Address != params.AutonityContractAddress || len(l.Topics) == 0 || l.Topics[0] != e.topic {
+				continue
+			}
+			event, err := e.filterer.ParseNewEpoch(*l)
+			if err != nil {
+				return err
+			}
+			// the event carries the epoch started by the block
+			rawdb.WriteEpochEnd(e.batch, event.Epoch.Uint64()-1, header.Number.Uint64())
+		}
+	}
+	return nil
+}
+
+// Commit implements core.ChainIndexerBackend, writing out the epochs ended in
+// the section.
+func (e *epochIndexer) Commit() error {
+	return e.batch.Write()
+}
+
+// Prune returns an empty error since we don't support pruning here.
+func (e *epochIndexer) Prune(threshold uint64) error {
+	return nil
+}
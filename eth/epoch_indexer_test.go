@@ -0,0 +1,63 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/params"
+)
+
+func TestEpochIndexer(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	contractABI, err := autonity.AutonityMetaData.GetAbi()
+	require.NoError(t, err)
+	newEpoch := contractABI.Events["NewEpoch"].ID
+	rewarded := contractABI.Events["Rewarded"].ID
+
+	// writes a block whose finalize receipt carries the given logs
+	block := func(number uint64, logs ...*types.Log) *types.Header {
+		receipts := types.Receipts{{Status: types.ReceiptStatusSuccessful, Logs: logs}}
+		header := &types.Header{Number: new(big.Int).SetUint64(number), Bloom: types.CreateBloom(receipts)}
+		rawdb.WriteReceipts(db, header.Hash(), number, receipts)
+		return header
+	}
+	epochLog := func(epoch int64) *types.Log {
+		return &types.Log{
+			Address: params.AutonityContractAddress,
+			Topics:  []common.Hash{newEpoch},
+			Data:    common.LeftPadBytes(big.NewInt(epoch).Bytes(), 32),
+		}
+	}
+	headers := []*types.Header{
+		block(9),
+		block(10, epochLog(3)),
+		block(11, &types.Log{Address: params.AutonityContractAddress, Topics: []common.Hash{rewarded, {}}, Data: make([]byte, 32)}),
+		// the event is only trusted from the Autonity contract
+		block(12, &types.Log{Address: common.HexToAddress("0x1"), Topics: []common.Hash{newEpoch}, Data: make([]byte, 32)}),
+	}
+
+	backend := &epochIndexer{db: db, topic: newEpoch}
+	backend.filterer, err = autonity.NewAutonityFilterer(params.AutonityContractAddress, nil)
+	require.NoError(t, err)
+	require.NoError(t, backend.Reset(context.Background(), 0, common.Hash{}))
+	for _, header := range headers {
+		require.NoError(t, backend.Process(context.Background(), header))
+	}
+	require.Nil(t, rawdb.ReadEpochEnd(db, 2), "epochs are written on commit")
+	require.NoError(t, backend.Commit())
+
+	// the block emitting the event ends the previous epoch
+	number := rawdb.ReadEpochEnd(db, 2)
+	require.NotNil(t, number)
+	require.Equal(t, uint64(10), *number)
+	for _, epoch := range []uint64{0, 1, 3} {
+		require.Nil(t, rawdb.ReadEpochEnd(db, epoch))
+	}
+}
@@ -34,12 +34,14 @@ import (
 	"github.com/autonity/autonity/consensus"
 	"github.com/autonity/autonity/consensus/ethash"
 	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/eth/downloader"
 	"github.com/autonity/autonity/eth/gasprice"
 	"github.com/autonity/autonity/log"
 	"github.com/autonity/autonity/miner"
 	"github.com/autonity/autonity/node"
 	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/trie"
 )
 
 // FullNodeGPO contains default gasprice oracle settings for full node.
@@ -62,6 +64,13 @@ var LightClientGPO = gasprice.Config{
 	IgnorePrice:      gasprice.DefaultIgnorePrice,
 }
 
+// Consensus network topology modes.
+const (
+	MeshTopology    = "mesh"    // connect to every committee member
+	GraphTopology   = "graph"   // generalised graph with a bounded diameter
+	LatencyTopology = "latency" // graph topology extended with the lowest latency peers
+)
+
 // Defaults contains default settings for use on the Ethereum main net.
 var Defaults = Config{
 	SyncMode: downloader.SnapSync,
@@ -74,17 +83,21 @@ var Defaults = Config{
 		DatasetsOnDisk:   2,
 		DatasetsLockMmap: false,
 	},
-	NetworkID:               65000000,
-	TxLookupLimit:           2350000,
-	LightPeers:              100,
-	UltraLightFraction:      75,
-	DatabaseCache:           512,
-	TrieCleanCache:          154,
-	TrieCleanCacheJournal:   "triecache",
-	TrieCleanCacheRejournal: 60 * time.Minute,
-	TrieDirtyCache:          256,
-	TrieTimeout:             60 * time.Minute,
-	SnapshotCache:           102,
+	NetworkID:                 65000000,
+	TxLookupLimit:             2350000,
+	LightPeers:                100,
+	UltraLightFraction:        75,
+	DatabaseCache:             512,
+	TrieCleanCache:            154,
+	TrieCleanCacheJournal:     "triecache",
+	TrieCleanCacheRejournal:   60 * time.Minute,
+	TrieDirtyCache:            256,
+	TrieTimeout:               60 * time.Minute,
+	SnapshotCache:             102,
+	StateHistory:              trie.DefaultStateHistory,
+	ConsensusTopology:         MeshTopology,
+	ConsensusTopologyDiameter: 2,
+	ConsensusTopologyMaxExtra: 4,
 	Miner: miner.Config{
 		GasCeil:  20_000_000,
 		GasPrice: big.NewInt(500_000_000),
@@ -130,6 +143,12 @@ type Config struct {
 	NetworkID uint64 // Network ID to use for selecting peers to connect to
 	SyncMode  downloader.SyncMode
 
+	// Trusted committed header to snap sync an empty chain from instead of
+	// genesis. The header is retrieved from the network by hash, unless it was
+	// loaded from a checkpoint file.
+	SyncCheckpoint       common.Hash   `toml:",omitempty"`
+	SyncCheckpointHeader *types.Header `toml:"-"`
+
 	// This can be set to list of enrtree:// URLs which will be queried for
 	// for nodes to connect to.
 	EthDiscoveryURLs  []string
@@ -139,6 +158,7 @@ type Config struct {
 	NoPrefetch bool // Whether to disable prefetching and only load state on demand
 
 	TxLookupLimit uint64 `toml:",omitempty"` // The maximum number of blocks from head whose tx indices are reserved.
+	HistoryEpochs uint64 `toml:",omitempty"` // The number of recent epochs whose block bodies and receipts are kept (0 = entire chain).
 
 	// map of required blocks (block numbers -> hash values) to accept
 	RequiredBlocks map[uint64]common.Hash `toml:"-"`
@@ -170,6 +190,16 @@ type Config struct {
 	TrieTimeout             time.Duration
 	SnapshotCache           int
 	Preimages               bool
+	StateScheme             string `toml:",omitempty"` // Scheme used to store the state trie nodes (hash or path), defaults to the stored one
+	StateHistory            uint64 `toml:",omitempty"` // Number of recent blocks whose state history is kept with the path-based scheme
+
+	// Consensus network options
+	ConsensusTopology         string   `toml:",omitempty"` // Topology used to connect to the other committee members
+	ConsensusTopologyDiameter uint     `toml:",omitempty"` // Target diameter of the graph based topologies
+	ConsensusTopologyMaxExtra int      `toml:",omitempty"` // Number of additional low latency peers of the latency topology
+	ConsensusBlockParts       uint64   `toml:",omitempty"` // Minimum size of the proposals propagated as erasure-coded parts, 0 disables it
+	ConsensusSentries         []string `toml:",omitempty"` // Sentry nodes the validator exclusively connects to on the consensus network
+	ConsensusSentryValidator  string   `toml:",omitempty"` // Validator on whose behalf the node relays consensus messages, if running as a sentry
 
 	// Mining options
 	Miner miner.Config
@@ -240,7 +270,8 @@ func CreateConsensusEngine(ctx *node.Node, chainConfig *params.ChainConfig, conf
 		}
 	}
 
-	nodeKey, _ := ctx.Config().AutonityKeys()
-	//todo: config consensus key to engine with the returned keys from ctx.Config().AutonityKeys()
-	return tendermintBackend.New(nodeKey, vmConfig, ctx.Config().TendermintServices(), evMux, ms, ctx.Logger())
+	nodeKey, consensusKey := ctx.Config().AutonityKeys()
+	engine := tendermintBackend.New(nodeKey, consensusKey, vmConfig, ctx.Config().TendermintServices(), evMux, ms, ctx.Logger())
+	engine.EnableBlockParts(config.ConsensusBlockParts)
+	return engine
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/consensus/ethash"
 	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/eth/downloader"
 	"github.com/autonity/autonity/eth/gasprice"
 	"github.com/autonity/autonity/miner"
@@ -21,11 +22,14 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		Genesis                         *core.Genesis `toml:",omitempty"`
 		NetworkId                       uint64
 		SyncMode                        downloader.SyncMode
+		SyncCheckpoint                  common.Hash   `toml:",omitempty"`
+		SyncCheckpointHeader            *types.Header `toml:"-"`
 		EthDiscoveryURLs                []string
 		SnapDiscoveryURLs               []string
 		NoPruning                       bool
 		NoPrefetch                      bool
 		TxLookupLimit                   uint64                 `toml:",omitempty"`
+		HistoryEpochs                   uint64                 `toml:",omitempty"`
 		RequiredBlocks                  map[uint64]common.Hash `toml:"-"`
 		LightServ                       int                    `toml:",omitempty"`
 		LightIngress                    int                    `toml:",omitempty"`
@@ -48,6 +52,14 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		TrieTimeout                     time.Duration
 		SnapshotCache                   int
 		Preimages                       bool
+		StateScheme                     string   `toml:",omitempty"`
+		StateHistory                    uint64   `toml:",omitempty"`
+		ConsensusTopology               string   `toml:",omitempty"`
+		ConsensusTopologyDiameter       uint     `toml:",omitempty"`
+		ConsensusTopologyMaxExtra       int      `toml:",omitempty"`
+		ConsensusBlockParts             uint64   `toml:",omitempty"`
+		ConsensusSentries               []string `toml:",omitempty"`
+		ConsensusSentryValidator        string   `toml:",omitempty"`
 		Miner                           miner.Config
 		Ethash                          ethash.Config
 		TxPool                          core.TxPoolConfig
@@ -66,11 +78,14 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.Genesis = c.Genesis
 	enc.NetworkId = c.NetworkID
 	enc.SyncMode = c.SyncMode
+	enc.SyncCheckpoint = c.SyncCheckpoint
+	enc.SyncCheckpointHeader = c.SyncCheckpointHeader
 	enc.EthDiscoveryURLs = c.EthDiscoveryURLs
 	enc.SnapDiscoveryURLs = c.SnapDiscoveryURLs
 	enc.NoPruning = c.NoPruning
 	enc.NoPrefetch = c.NoPrefetch
 	enc.TxLookupLimit = c.TxLookupLimit
+	enc.HistoryEpochs = c.HistoryEpochs
 	enc.RequiredBlocks = c.RequiredBlocks
 	enc.LightServ = c.LightServ
 	enc.LightIngress = c.LightIngress
@@ -93,6 +108,14 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.TrieTimeout = c.TrieTimeout
 	enc.SnapshotCache = c.SnapshotCache
 	enc.Preimages = c.Preimages
+	enc.StateScheme = c.StateScheme
+	enc.StateHistory = c.StateHistory
+	enc.ConsensusTopology = c.ConsensusTopology
+	enc.ConsensusTopologyDiameter = c.ConsensusTopologyDiameter
+	enc.ConsensusTopologyMaxExtra = c.ConsensusTopologyMaxExtra
+	enc.ConsensusBlockParts = c.ConsensusBlockParts
+	enc.ConsensusSentries = c.ConsensusSentries
+	enc.ConsensusSentryValidator = c.ConsensusSentryValidator
 	enc.Miner = c.Miner
 	enc.Ethash = c.Ethash
 	enc.TxPool = c.TxPool
@@ -115,11 +138,14 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		Genesis                         *core.Genesis `toml:",omitempty"`
 		NetworkId                       *uint64
 		SyncMode                        *downloader.SyncMode
+		SyncCheckpoint                  *common.Hash  `toml:",omitempty"`
+		SyncCheckpointHeader            *types.Header `toml:"-"`
 		EthDiscoveryURLs                []string
 		SnapDiscoveryURLs               []string
 		NoPruning                       *bool
 		NoPrefetch                      *bool
 		TxLookupLimit                   *uint64                `toml:",omitempty"`
+		HistoryEpochs                   *uint64                `toml:",omitempty"`
 		RequiredBlocks                  map[uint64]common.Hash `toml:"-"`
 		LightServ                       *int                   `toml:",omitempty"`
 		LightIngress                    *int                   `toml:",omitempty"`
@@ -142,6 +168,14 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		TrieTimeout                     *time.Duration
 		SnapshotCache                   *int
 		Preimages                       *bool
+		StateScheme                     *string  `toml:",omitempty"`
+		StateHistory                    *uint64  `toml:",omitempty"`
+		ConsensusTopology               *string  `toml:",omitempty"`
+		ConsensusTopologyDiameter       *uint    `toml:",omitempty"`
+		ConsensusTopologyMaxExtra       *int     `toml:",omitempty"`
+		ConsensusBlockParts             *uint64  `toml:",omitempty"`
+		ConsensusSentries               []string `toml:",omitempty"`
+		ConsensusSentryValidator        *string  `toml:",omitempty"`
 		Miner                           *miner.Config
 		Ethash                          *ethash.Config
 		TxPool                          *core.TxPoolConfig
@@ -169,6 +203,12 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.SyncMode != nil {
 		c.SyncMode = *dec.SyncMode
 	}
+	if dec.SyncCheckpoint != nil {
+		c.SyncCheckpoint = *dec.SyncCheckpoint
+	}
+	if dec.SyncCheckpointHeader != nil {
+		c.SyncCheckpointHeader = dec.SyncCheckpointHeader
+	}
 	if dec.EthDiscoveryURLs != nil {
 		c.EthDiscoveryURLs = dec.EthDiscoveryURLs
 	}
@@ -184,6 +224,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.TxLookupLimit != nil {
 		c.TxLookupLimit = *dec.TxLookupLimit
 	}
+	if dec.HistoryEpochs != nil {
+		c.HistoryEpochs = *dec.HistoryEpochs
+	}
 	if dec.RequiredBlocks != nil {
 		c.RequiredBlocks = dec.RequiredBlocks
 	}
@@ -250,6 +293,30 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.Preimages != nil {
 		c.Preimages = *dec.Preimages
 	}
+	if dec.StateScheme != nil {
+		c.StateScheme = *dec.StateScheme
+	}
+	if dec.StateHistory != nil {
+		c.StateHistory = *dec.StateHistory
+	}
+	if dec.ConsensusTopology != nil {
+		c.ConsensusTopology = *dec.ConsensusTopology
+	}
+	if dec.ConsensusTopologyDiameter != nil {
+		c.ConsensusTopologyDiameter = *dec.ConsensusTopologyDiameter
+	}
+	if dec.ConsensusTopologyMaxExtra != nil {
+		c.ConsensusTopologyMaxExtra = *dec.ConsensusTopologyMaxExtra
+	}
+	if dec.ConsensusBlockParts != nil {
+		c.ConsensusBlockParts = *dec.ConsensusBlockParts
+	}
+	if dec.ConsensusSentries != nil {
+		c.ConsensusSentries = dec.ConsensusSentries
+	}
+	if dec.ConsensusSentryValidator != nil {
+		c.ConsensusSentryValidator = *dec.ConsensusSentryValidator
+	}
 	if dec.Miner != nil {
 		c.Miner = *dec.Miner
 	}
@@ -135,11 +135,11 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 	}
 	head := header.Number.Uint64()
 
-	if f.begin == -1 {
+	if f.begin == -1 || rpc.BlockNumber(f.begin).IsFinal() {
 		f.begin = int64(head)
 	}
 	end := uint64(f.end)
-	if f.end == -1 {
+	if f.end == -1 || rpc.BlockNumber(f.end).IsFinal() {
 		end = head
 	}
 	// Gather all indexed logs, and finish with non indexed ones
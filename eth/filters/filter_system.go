@@ -199,6 +199,13 @@ func (es *EventSystem) SubscribeLogs(crit ethereum.FilterQuery, logs chan []*typ
 	} else {
 		to = rpc.BlockNumber(crit.ToBlock.Int64())
 	}
+	// final blocks are the latest blocks with BFT consensus
+	if from.IsFinal() {
+		from = rpc.LatestBlockNumber
+	}
+	if to.IsFinal() {
+		to = rpc.LatestBlockNumber
+	}
 
 	// only interested in pending logs
 	if from == rpc.PendingBlockNumber && to == rpc.PendingBlockNumber {
@@ -0,0 +1,142 @@
+package filters
+
+import (
+	"context"
+	"sync"
+
+	ethereum "github.com/autonity/autonity"
+	"github.com/autonity/autonity/autonity"
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/params"
+	"github.com/autonity/autonity/rpc"
+)
+
+// DecodedLog is a log emitted by a protocol contract along with its event
+// decoded with the ABI of the contract.
+type DecodedLog struct {
+	Log *types.Log `json:"log"`
+	autonity.ProtocolEvent
+}
+
+// ProtocolEventsAPI offers the logs of the protocol contracts decoded into
+// their events, so that clients don't need the contract ABIs.
+type ProtocolEventsAPI struct {
+	filters *PublicFilterAPI
+	decoder *autonity.EventDecoder
+}
+
+// NewProtocolEventsAPI creates the protocol events API on top of the event
+// system of the given filter API. The Liquid contracts of the validators
+// registered from now on are tracked by the decoder in the background, until
+// the quit channel is closed. The tracking is added to the wait group, which
+// must be waited on before stopping the event system.
+func NewProtocolEventsAPI(filters *PublicFilterAPI, decoder *autonity.EventDecoder, quit <-chan struct{}, wg *sync.WaitGroup) *ProtocolEventsAPI {
+	api := &ProtocolEventsAPI{
+		filters: filters,
+		decoder: decoder,
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		api.trackLiquidContracts(quit)
+	}()
+
+	return api
+}
+
+// trackLiquidContracts decodes the RegisteredValidator events of the Autonity
+// contract as they are emitted, registering the Liquid contracts of the new
+// validators with the decoder. It returns once the quit channel is closed.
+func (api *ProtocolEventsAPI) trackLiquidContracts(quit <-chan struct{}) {
+	logsCh := make(chan []*types.Log)
+	sub, err := api.filters.events.SubscribeLogs(ethereum.FilterQuery{
+		Addresses: []common.Address{params.AutonityContractAddress},
+	}, logsCh)
+	if err != nil {
+		log.Error("Failed to track Liquid contracts", "err", err)
+		return
+	}
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case logs := <-logsCh:
+			for _, l := range logs {
+				if !l.Removed {
+					api.decoder.Decode(l)
+				}
+			}
+		case <-sub.Err():
+			return
+		case <-quit:
+			return
+		}
+	}
+}
+
+// decode decodes the logs of the protocol contracts, skipping the other ones.
+func (api *ProtocolEventsAPI) decode(logs []*types.Log) []*DecodedLog {
+	decoded := make([]*DecodedLog, 0, len(logs))
+	for _, l := range logs {
+		event, err := api.decoder.Decode(l)
+		if err != nil {
+			log.Debug("Failed to decode protocol event", "address", l.Address, "tx", l.TxHash, "index", l.Index, "err", err)
+			continue
+		}
+		if event == nil {
+			continue
+		}
+		decoded = append(decoded, &DecodedLog{Log: l, ProtocolEvent: *event})
+	}
+	return decoded
+}
+
+// GetDecodedLogs returns the logs of the protocol contracts matching the given
+// criteria, decoded into their events. Logs of other contracts are omitted.
+func (api *ProtocolEventsAPI) GetDecodedLogs(ctx context.Context, crit FilterCriteria) ([]*DecodedLog, error) {
+	logs, err := api.filters.GetLogs(ctx, crit)
+	if err != nil {
+		return nil, err
+	}
+	return api.decode(logs), nil
+}
+
+// ProtocolEvents creates a subscription that fires for the new logs of the
+// protocol contracts matching the given criteria, decoded into their events.
+func (api *ProtocolEventsAPI) ProtocolEvents(ctx context.Context, crit FilterCriteria) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	var (
+		rpcSub      = notifier.CreateSubscription()
+		matchedLogs = make(chan []*types.Log)
+	)
+
+	logsSub, err := api.filters.events.SubscribeLogs(ethereum.FilterQuery(crit), matchedLogs)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		for {
+			select {
+			case logs := <-matchedLogs:
+				for _, decoded := range api.decode(logs) {
+					notifier.Notify(rpcSub.ID, decoded)
+				}
+			case <-rpcSub.Err(): // client send an unsubscribe request
+				logsSub.Unsubscribe()
+				return
+			case <-notifier.Closed(): // connection dropped
+				logsSub.Unsubscribe()
+				return
+			}
+		}
+	}()
+
+	return rpcSub, nil
+}
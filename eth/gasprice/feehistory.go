@@ -165,7 +165,7 @@ func (oracle *Oracle) resolveBlockRange(ctx context.Context, lastBlock rpc.Block
 			return nil, nil, 0, 0, err
 		}
 	}
-	if lastBlock == rpc.LatestBlockNumber {
+	if lastBlock == rpc.LatestBlockNumber || lastBlock.IsFinal() {
 		lastBlock = headBlock
 	} else if pendingBlock == nil && lastBlock > headBlock {
 		return nil, nil, 0, 0, fmt.Errorf("%w: requested %d, head %d", errRequestBeyondHead, lastBlock, headBlock)
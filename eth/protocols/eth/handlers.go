@@ -228,6 +228,10 @@ func ServiceGetBlockBodiesQuery(chain *core.BlockChain, query GetBlockBodiesPack
 		if data := chain.GetBodyRLP(hash); len(data) != 0 {
 			bodies = append(bodies, data)
 			bytes += len(data)
+		} else if historyPruned(chain, hash) {
+			// Bodies are matched in order, so report the remainder as unavailable
+			// instead of skipping over the pruned ones
+			break
 		}
 	}
 	return bodies
@@ -297,6 +301,11 @@ func ServiceGetReceiptsQuery(chain *core.BlockChain, query GetReceiptsPacket) []
 		results := chain.GetReceiptsByHash(hash)
 		if results == nil {
 			if header := chain.GetHeaderByHash(hash); header == nil || header.ReceiptHash != types.EmptyRootHash {
+				if historyPruned(chain, hash) {
+					// Receipts are matched in order, so report the remainder as
+					// unavailable instead of skipping over the pruned ones
+					break
+				}
 				continue
 			}
 		}
@@ -311,6 +320,13 @@ func ServiceGetReceiptsQuery(chain *core.BlockChain, query GetReceiptsPacket) []
 	return receipts
 }
 
+// historyPruned reports whether the body and receipts of the known block with
+// the given hash have been pruned from the local chain history.
+func historyPruned(chain *core.BlockChain, hash common.Hash) bool {
+	header := chain.GetHeaderByHash(hash)
+	return header != nil && chain.CheckHistory(header.Number.Uint64()) != nil
+}
+
 func handleNewBlockhashes(backend Backend, msg Decoder, peer *Peer) error {
 	// A batch of new block announcements just arrived
 	ann := new(NewBlockHashesPacket)
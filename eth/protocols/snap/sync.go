@@ -392,7 +392,8 @@ type SyncPeer interface {
 //   - The peer delivers a stale response after a previous timeout
 //   - The peer delivers a refusal to serve the requested state
 type Syncer struct {
-	db ethdb.KeyValueStore // Database to store the trie nodes into (and dedup)
+	db     ethdb.KeyValueStore // Database to store the trie nodes into (and dedup)
+	scheme string              // Node scheme used in node database
 
 	root    common.Hash    // Current state trie root being synced
 	tasks   []*accountTask // Current account task set being synced
@@ -453,9 +454,10 @@ type Syncer struct {
 
 // NewSyncer creates a new snapshot syncer to download the Ethereum state over the
 // snap protocol.
-func NewSyncer(db ethdb.KeyValueStore) *Syncer {
+func NewSyncer(db ethdb.KeyValueStore, scheme string) *Syncer {
 	return &Syncer{
-		db: db,
+		db:     db,
+		scheme: scheme,
 
 		peers:    make(map[string]SyncPeer),
 		peerJoin: new(event.Feed),
@@ -546,7 +548,7 @@ func (s *Syncer) Sync(root common.Hash, cancel chan struct{}) error {
 	s.lock.Lock()
 	s.root = root
 	s.healer = &healTask{
-		scheduler: state.NewStateSync(root, s.db, s.onHealState),
+		scheduler: state.NewStateSync(root, s.db, s.onHealState, s.scheme),
 		trieTasks: make(map[common.Hash]trie.SyncPath),
 		codeTasks: make(map[common.Hash]struct{}),
 	}
@@ -691,9 +693,9 @@ func (s *Syncer) loadSyncStatus() {
 						s.accountBytes += common.StorageSize(len(key) + len(value))
 					},
 				}
-				task.genTrie = trie.NewStackTrie(task.genBatch)
+				task.genTrie = s.newStackTrie(common.Hash{}, task.genBatch)
 
-				for _, subtasks := range task.SubTasks {
+				for account, subtasks := range task.SubTasks {
 					for _, subtask := range subtasks {
 						subtask.genBatch = ethdb.HookedBatch{
 							Batch: s.db.NewBatch(),
@@ -701,7 +703,7 @@ func (s *Syncer) loadSyncStatus() {
 								s.storageBytes += common.StorageSize(len(key) + len(value))
 							},
 						}
-						subtask.genTrie = trie.NewStackTrie(subtask.genBatch)
+						subtask.genTrie = s.newStackTrie(account, subtask.genBatch)
 					}
 				}
 			}
@@ -755,7 +757,7 @@ func (s *Syncer) loadSyncStatus() {
 			Last:     last,
 			SubTasks: make(map[common.Hash][]*storageTask),
 			genBatch: batch,
-			genTrie:  trie.NewStackTrie(batch),
+			genTrie:  s.newStackTrie(common.Hash{}, batch),
 		})
 		log.Debug("Created account sync task", "from", next, "last", last)
 		next = common.BigToHash(new(big.Int).Add(last.Big(), common.Big1))
@@ -1789,7 +1791,7 @@ func (s *Syncer) processAccountResponse(res *accountResponse) {
 		}
 		// Check if the account is a contract with an unknown storage trie
 		if account.Root != emptyRoot {
-			if ok, err := s.db.Has(account.Root[:]); err != nil || !ok {
+			if !s.hasStorageTrie(res.hashes[i], account.Root) {
 				// If there was a previous large state retrieval in progress,
 				// don't restart it from scratch. This happens if a sync cycle
 				// is interrupted and resumed later. However, *do* update the
@@ -1961,7 +1963,7 @@ func (s *Syncer) processStorageResponse(res *storageResponse) {
 						Last:     r.End(),
 						root:     acc.Root,
 						genBatch: batch,
-						genTrie:  trie.NewStackTrie(batch),
+						genTrie:  s.newStackTrie(account, batch),
 					})
 					for r.Next() {
 						batch := ethdb.HookedBatch{
@@ -1975,7 +1977,7 @@ func (s *Syncer) processStorageResponse(res *storageResponse) {
 							Last:     r.End(),
 							root:     acc.Root,
 							genBatch: batch,
-							genTrie:  trie.NewStackTrie(batch),
+							genTrie:  s.newStackTrie(account, batch),
 						})
 					}
 					for _, task := range tasks {
@@ -2020,7 +2022,7 @@ func (s *Syncer) processStorageResponse(res *storageResponse) {
 		slots += len(res.hashes[i])
 
 		if i < len(res.hashes)-1 || res.subTask == nil {
-			tr := trie.NewStackTrie(batch)
+			tr := s.newStackTrie(account, batch)
 			for j := 0; j < len(res.hashes[i]); j++ {
 				tr.Update(res.hashes[i][j][:], res.slots[i][j])
 			}
@@ -2790,6 +2792,28 @@ func (s *Syncer) onHealState(paths [][]byte, value []byte) error {
 	return nil
 }
 
+// newStackTrie creates a stack trie committing the nodes of the trie owned by
+// the given account (empty for the account trie) into the given batch, laid
+// out according to the node scheme of the syncer.
+func (s *Syncer) newStackTrie(owner common.Hash, batch ethdb.KeyValueWriter) *trie.StackTrie {
+	if s.scheme != rawdb.PathScheme {
+		return trie.NewStackTrie(batch)
+	}
+	return trie.NewStackTrieWithOwner(func(owner common.Hash, path []byte, hash common.Hash, blob []byte) {
+		rawdb.WritePathTrieNode(batch, owner, path, blob)
+	}, owner)
+}
+
+// hasStorageTrie reports whether the storage trie with the given root of the
+// given account is already present in the database.
+func (s *Syncer) hasStorageTrie(owner common.Hash, root common.Hash) bool {
+	if s.scheme == rawdb.PathScheme {
+		return rawdb.HasStorageTrieNode(s.db, owner, nil, root)
+	}
+	ok, err := s.db.Has(root[:])
+	return err == nil && ok
+}
+
 // hashSpace is the total size of the 256 bit hash space for accounts.
 var hashSpace = new(big.Int).Exp(common.Big2, common.Big256, nil)
 
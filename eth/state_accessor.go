@@ -23,6 +23,7 @@ import (
 
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/core"
+	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/state"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/core/vm"
@@ -52,6 +53,14 @@ func (eth *Ethereum) StateAtBlock(block *types.Block, reexec uint64, base *state
 		report   = true
 		origin   = block.NumberU64()
 	)
+	// The path-based scheme only keeps the recent states, all of them in the
+	// live database, and older states can't be regenerated from them.
+	if eth.blockchain.StateCache().TrieDB().Scheme() == rawdb.PathScheme {
+		if statedb, err = eth.blockchain.StateAt(block.Root()); err != nil {
+			return nil, fmt.Errorf("required historical state unavailable with the path-based state scheme: %w", err)
+		}
+		return statedb, nil
+	}
 	// Check the live database first if we have the state fully available, use that.
 	if checkLive {
 		statedb, err = eth.blockchain.StateAt(block.Root())
@@ -159,6 +168,8 @@ func (eth *Ethereum) StateAtBlock(block *types.Block, reexec uint64, base *state
 }
 
 // stateAtTransaction returns the execution environment of a certain transaction.
+// The index following the last transaction returns the state at the end of the
+// block, before its finalization, with no message.
 func (eth *Ethereum) stateAtTransaction(block *types.Block, txIndex int, reexec uint64) (core.Message, vm.BlockContext, *state.StateDB, error) {
 	// Short circuit if it's genesis block.
 	if block.NumberU64() == 0 {
@@ -175,9 +186,6 @@ func (eth *Ethereum) stateAtTransaction(block *types.Block, txIndex int, reexec
 	if err != nil {
 		return nil, vm.BlockContext{}, nil, err
 	}
-	if txIndex == 0 && len(block.Transactions()) == 0 {
-		return nil, vm.BlockContext{}, statedb, nil
-	}
 	// Recompute transactions up to the target index.
 	signer := types.MakeSigner(eth.blockchain.Config(), block.Number())
 	for idx, tx := range block.Transactions() {
@@ -198,5 +206,9 @@ func (eth *Ethereum) stateAtTransaction(block *types.Block, txIndex int, reexec
 		// Only delete empty objects if EIP158/161 (a.k.a Spurious Dragon) is in effect
 		statedb.Finalise(vmenv.ChainConfig().IsEIP158(block.Number()))
 	}
+	// The state at the end of the block, before its finalization, has no message
+	if txIndex == len(block.Transactions()) {
+		return nil, core.NewEVMBlockContext(block.Header(), eth.blockchain, nil), statedb, nil
+	}
 	return nil, vm.BlockContext{}, nil, fmt.Errorf("transaction index %d out of range for block %#x", txIndex, block.Hash())
 }
@@ -1,30 +1,83 @@
 package eth
 
 import (
-	"math"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
 
+	"github.com/autonity/autonity/eth/ethconfig"
 	"github.com/autonity/autonity/p2p/enode"
 )
 
+var errInvalidDiameter = errors.New("topology diameter must be at least 1")
+
+// Topology selects the subset of the committee nodes a local node should connect to.
+// Given that the order of the input array nodes is the same for every committee member,
+// the resulting connections must form a connected graph.
+type Topology interface {
+	RequestSubset(nodes []*enode.Node, localNode *enode.LocalNode) []*enode.Node
+}
+
+// LatencyOracle provides round trip time measurements to connected peers.
+type LatencyOracle interface {
+	PeerRTT(id enode.ID) (time.Duration, bool)
+}
+
+// NewTopology returns the topology for the given mode, one of ethconfig.MeshTopology,
+// ethconfig.GraphTopology or ethconfig.LatencyTopology. Committees smaller than minNodes
+// are always fully connected. The oracle and maxExtra are only used by the latency mode.
+// An empty mode selects the full mesh.
+func NewTopology(mode string, diameter uint, minNodes int, maxExtra int, oracle LatencyOracle) (Topology, error) {
+	switch mode {
+	case ethconfig.MeshTopology, "":
+		return fullMesh{}, nil
+	case ethconfig.GraphTopology:
+		g, err := NewGraphTopology(diameter, minNodes)
+		if err != nil {
+			return nil, err
+		}
+		return &g, nil
+	case ethconfig.LatencyTopology:
+		if oracle == nil {
+			return nil, errors.New("latency topology requires a latency oracle")
+		}
+		g, err := NewGraphTopology(diameter, minNodes)
+		if err != nil {
+			return nil, err
+		}
+		return newLatencyAwareTopology(g, oracle, maxExtra), nil
+	default:
+		return nil, fmt.Errorf("unknown topology mode %q", mode)
+	}
+}
+
+type fullMesh struct{}
+
+// RequestSubset returns all the nodes.
+func (fullMesh) RequestSubset(nodes []*enode.Node, _ *enode.LocalNode) []*enode.Node {
+	return nodes
+}
+
 type networkTopology struct {
 	diameter uint
 	minNodes int
 }
 
-func NewGraphTopology(diameter uint, minNodes int) networkTopology {
-	// Only diameter = 2, to support diameter > 2, ComputeBase function has to be modified
-	if diameter != 2 {
-		panic("diameter value must be 2")
+func NewGraphTopology(diameter uint, minNodes int) (networkTopology, error) {
+	if diameter == 0 {
+		return networkTopology{}, errInvalidDiameter
 	}
 	return networkTopology{
 		diameter: diameter,
 		minNodes: minNodes,
-	}
+	}, nil
 }
 
 func (g *networkTopology) SetDiameter(d uint) {
-	if d != 2 {
-		panic("diameter value must be 2")
+	if d == 0 {
+		panic(errInvalidDiameter)
 	}
 	g.diameter = d
 }
@@ -33,10 +86,6 @@ func (g *networkTopology) SetMinNodes(n int) {
 	g.minNodes = n
 }
 
-func (g *networkTopology) computeSquareRoot(n uint) uint {
-	return uint(math.Ceil(math.Sqrt(float64(n))))
-}
-
 // Returns the number of matching digits in i and j for g.diameter least significant digits
 // Both i and j are considered to be in b-base number system
 func (g *networkTopology) countMatchingDigits(i, j, b uint) uint {
@@ -54,25 +103,38 @@ func (g *networkTopology) countMatchingDigits(i, j, b uint) uint {
 }
 
 // compute b such that b^d >= n and (b-1)^d < n where d = g.diameter
-// for now only g.diameter = 2 is supported
 func (g *networkTopology) ComputeBase(n uint) uint {
-	return g.computeSquareRoot(n)
+	if n <= 1 {
+		return 1
+	}
+	// b = 2 covers up to 2^d nodes, n is bounded so the loop terminates quickly
+	b := uint(2)
+	for !g.covers(b, n) {
+		b++
+	}
+	return b
+}
+
+// covers returns whether b^d >= n, stopping early to avoid overflows.
+func (g *networkTopology) covers(b, n uint) bool {
+	p := uint(1)
+	for i := uint(0); i < g.diameter; i++ {
+		p *= b
+		if p >= n {
+			return true
+		}
+	}
+	return false
 }
 
 // Returns the list of adjacentNodes to connect with localNode. Given that the order of the input array nodes is same
 // for everyone, connecting to only adjacentNodes will create a connected graph with diameter = g.diameter
 func (g *networkTopology) RequestSubset(nodes []*enode.Node, localNode *enode.LocalNode) []*enode.Node {
-	if len(nodes) < g.minNodes {
+	if len(nodes) < g.minNodes || g.diameter == 1 {
 		// connect to all nodes
 		return nodes
 	}
-	myIdx := -1
-	for i, node := range nodes {
-		if node.ID() == localNode.ID() {
-			myIdx = i
-			break
-		}
-	}
+	myIdx := indexOf(nodes, localNode)
 	// If the node is not in committee, it has all slots available, so connect to all committee nodes
 	if myIdx == -1 {
 		return nodes
@@ -81,18 +143,133 @@ func (g *networkTopology) RequestSubset(nodes []*enode.Node, localNode *enode.Lo
 	// each node is represented as a number in b-base number system with exactly d digits
 	// which requires len(nodes) <= b^d. For example, if b = 2 and d = 2
 	// then we can support 4 nodes numbering 00, 01, 10, 11 (in binary).
-	// Two nodes i and j is connected if they have exactly 1 digit common
+	// Two nodes i and j is connected if they differ in exactly 1 digit
 	// So in above example, 00 is connected with 01 and 10; 01 is connected with 00 and 11;
 	// 10 is connected with 00 and 11; 11 is connected with 01 and 10.
+	// Any two nodes are then at most d hops apart: the path first lowers the digits which are
+	// greater than the destination's ones and then raises the others, so every intermediate
+	// number stays below max(i, j) and hence belongs to the graph.
 
-	// for now only diameter = 2 is supported
-	// b is chosen with the following property len(nodes) <= b*b and len(nodes) > (b-1)*(b-1)
+	// b is chosen with the following property len(nodes) <= b^d and len(nodes) > (b-1)^d
 	b := g.ComputeBase(uint(len(nodes)))
 	connections := make([]*enode.Node, 0, len(nodes))
 	for i, node := range nodes {
-		if g.countMatchingDigits(uint(i), uint(myIdx), b) == 1 {
+		if g.countMatchingDigits(uint(i), uint(myIdx), b) == g.diameter-1 {
 			connections = append(connections, node)
 		}
 	}
 	return connections
 }
+
+// latencyRefreshInterval is how long the latency topology keeps the subset
+// selected for a committee before ranking the extra peers again with the latest
+// round trip time measurements.
+const latencyRefreshInterval = 10 * time.Minute
+
+// latencyAwareTopology keeps the connectivity guarantees of the underlying graph
+// topology and additionally connects to the committee members with the lowest
+// measured round trip time. The subset is cached per committee and only refreshed
+// every latencyRefreshInterval, so that new measurements don't churn connections
+// at every block.
+type latencyAwareTopology struct {
+	graph    networkTopology
+	oracle   LatencyOracle
+	maxExtra int
+	now      func() time.Time
+
+	mu       sync.Mutex
+	rtt      map[enode.ID]time.Duration // last known measurements, kept across disconnections
+	members  []enode.ID                 // committee the cached subset was selected for
+	subset   []*enode.Node
+	selected time.Time
+}
+
+func newLatencyAwareTopology(graph networkTopology, oracle LatencyOracle, maxExtra int) *latencyAwareTopology {
+	return &latencyAwareTopology{
+		graph:    graph,
+		oracle:   oracle,
+		maxExtra: maxExtra,
+		now:      time.Now,
+		rtt:      make(map[enode.ID]time.Duration),
+	}
+}
+
+// RequestSubset returns the graph topology adjacent nodes, plus up to maxExtra other
+// committee members ordered by their round trip time. The remaining extra slots go to
+// members which have never been measured, so that they get connected and measured.
+func (l *latencyAwareTopology) RequestSubset(nodes []*enode.Node, localNode *enode.LocalNode) []*enode.Node {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, n := range nodes {
+		if rtt, ok := l.oracle.PeerRTT(n.ID()); ok {
+			l.rtt[n.ID()] = rtt
+		}
+	}
+	if l.subset == nil || !sameMembers(l.members, nodes) || l.now().Sub(l.selected) >= latencyRefreshInterval {
+		l.subset = l.selectSubset(nodes, localNode)
+		l.members = make([]enode.ID, len(nodes))
+		for i, n := range nodes {
+			l.members[i] = n.ID()
+		}
+		l.selected = l.now()
+	}
+	return append([]*enode.Node(nil), l.subset...)
+}
+
+// selectSubset computes the graph topology adjacent nodes along with the extra
+// members.
+func (l *latencyAwareTopology) selectSubset(nodes []*enode.Node, localNode *enode.LocalNode) []*enode.Node {
+	connections := l.graph.RequestSubset(nodes, localNode)
+	if len(connections) == len(nodes) || l.maxExtra <= 0 {
+		return connections
+	}
+	selected := make(map[enode.ID]struct{}, len(connections))
+	for _, n := range connections {
+		selected[n.ID()] = struct{}{}
+	}
+	// unmeasured members are probed starting after the local node, so that the
+	// committee members don't all probe the same ones.
+	offset := indexOf(nodes, localNode) + 1
+	var measured, unmeasured []*enode.Node
+	for i := range nodes {
+		n := nodes[(offset+i)%len(nodes)]
+		if _, ok := selected[n.ID()]; ok || n.ID() == localNode.ID() {
+			continue
+		}
+		if _, ok := l.rtt[n.ID()]; ok {
+			measured = append(measured, n)
+		} else {
+			unmeasured = append(unmeasured, n)
+		}
+	}
+	sort.SliceStable(measured, func(i, j int) bool {
+		return l.rtt[measured[i].ID()] < l.rtt[measured[j].ID()]
+	})
+	extra := append(measured, unmeasured...)
+	if len(extra) > l.maxExtra {
+		extra = extra[:l.maxExtra]
+	}
+	return append(connections, extra...)
+}
+
+func sameMembers(members []enode.ID, nodes []*enode.Node) bool {
+	if len(members) != len(nodes) {
+		return false
+	}
+	for i, n := range nodes {
+		if members[i] != n.ID() {
+			return false
+		}
+	}
+	return true
+}
+
+func indexOf(nodes []*enode.Node, localNode *enode.LocalNode) int {
+	for i, node := range nodes {
+		if node.ID() == localNode.ID() {
+			return i
+		}
+	}
+	return -1
+}
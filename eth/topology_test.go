@@ -1,15 +1,19 @@
 package eth
 
 import (
-	"crypto/ecdsa"
 	"fmt"
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/eth/ethconfig"
+	"github.com/autonity/autonity/node"
 	"github.com/autonity/autonity/p2p/enode"
+	"github.com/autonity/autonity/p2p/simulations"
+	"github.com/autonity/autonity/p2p/simulations/adapters"
 	"github.com/autonity/autonity/params"
 )
 
@@ -39,44 +43,54 @@ func bfs(
 	}
 }
 
+func generateNodes(t *testing.T, db *enode.DB, count int) ([]*enode.Node, map[*enode.Node]*enode.LocalNode) {
+	nodes := make([]*enode.Node, 0, count)
+	localNodes := make(map[*enode.Node]*enode.LocalNode)
+	for n := 0; n < count; n++ {
+		privateKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		newEnode := "enode://" + string(crypto.PubECDSAToHex(&privateKey.PublicKey)[2:]) + "@3.209.45.79:30303"
+		newNode, err := enode.ParseV4(newEnode)
+		require.NoError(t, err)
+		nodes = append(nodes, newNode)
+		// db is not used here, so a single db for all nodes
+		localNodes[newNode] = enode.NewLocalNode(db, privateKey, nil)
+	}
+	return nodes, localNodes
+}
+
 func TestEthExecutionLayerGraph(t *testing.T) {
-	const targetDiameter = 2
+	nodeCount := int(max(100, params.TestAutonityContractConfig.MaxCommitteeSize))
+	testGraphTopology(t, 2, nodeCount)
+}
+
+func TestGraphTopologyDiameters(t *testing.T) {
+	testGraphTopology(t, 1, 20)
+	testGraphTopology(t, 3, 70)
+	testGraphTopology(t, 4, 40)
+}
+
+func testGraphTopology(t *testing.T, targetDiameter uint, nodeCount int) {
 	tSelector := &networkTopology{}
 	tSelector.SetDiameter(targetDiameter)
 	tSelector.SetMinNodes(0)
 	db, err := enode.OpenDB("")
 	require.NoError(t, err)
-	nodeCount := int(max(100, params.TestAutonityContractConfig.MaxCommitteeSize))
-	nodes := make([]*enode.Node, 0, nodeCount)
-	localNodes := make(map[*enode.Node]*enode.LocalNode)
-	privateKeys := make(map[*ecdsa.PrivateKey]bool)
+	allNodes, localNodes := generateNodes(t, db, nodeCount)
 	for n := 1; n <= nodeCount; n++ {
 		fmt.Printf("\ngraph %v starts\n", n)
-		for {
-			privateKey, err := crypto.GenerateKey()
-			require.NoError(t, err)
-			if _, ok := privateKeys[privateKey]; !ok {
-				newEnode := "enode://" + string(crypto.PubECDSAToHex(&privateKey.PublicKey)[2:]) + "@3.209.45.79:30303"
-				newNode, err := enode.ParseV4(newEnode)
-				require.NoError(t, err)
-				require.NotEqual(t, nil, newNode)
-				nodes = append(nodes, newNode)
-				// related localNode
-				// db is not used here, so a single db for all nodes
-				localNode := enode.NewLocalNode(db, privateKey, nil)
-				localNodes[newNode] = localNode
-				privateKeys[privateKey] = true
-				require.Equal(t, newNode.ID(), localNode.ID())
-				break
-			}
-		}
+		nodes := allNodes[:n]
 		// check if graph connected and the diameter and degree properties hold
 		base := tSelector.ComputeBase(uint(len(nodes)))
 		require.True(
-			t, int(math.Pow(float64(base), targetDiameter)) >= len(nodes) &&
-				int(math.Pow(float64(base-1), targetDiameter)) < len(nodes),
+			t, int(math.Pow(float64(base), float64(targetDiameter))) >= len(nodes) &&
+				(len(nodes) == 1 || int(math.Pow(float64(base-1), float64(targetDiameter))) < len(nodes)),
 		)
-		maxDegree := targetDiameter * int(math.Pow(float64(base-1), targetDiameter-1))
+		maxDegree := int(targetDiameter) * int(base-1)
+		if targetDiameter == 1 {
+			// a single digit graph is the full mesh, including the local node
+			maxDegree = len(nodes)
+		}
 		for _, node := range nodes {
 			// check if max distance from node to any other node in the graph is targetDiameter
 			dis := make(map[*enode.Node]int)
@@ -84,10 +98,251 @@ func TestEthExecutionLayerGraph(t *testing.T) {
 			for _, peer := range nodes {
 				d, ok := dis[peer]
 				require.True(t, ok && d >= 0, "graph not connected")
-				require.True(t, d <= targetDiameter, "graph diameter more than desired")
+				require.True(t, d <= int(targetDiameter), "graph diameter more than desired")
 			}
 			connections := tSelector.RequestSubset(nodes, localNodes[node])
 			require.True(t, len(connections) <= maxDegree)
 		}
 	}
 }
+
+func TestNewTopology(t *testing.T) {
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	nodes, localNodes := generateNodes(t, db, 30)
+
+	mesh, err := NewTopology(ethconfig.MeshTopology, 0, 0, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, nodes, mesh.RequestSubset(nodes, localNodes[nodes[0]]))
+
+	// committees smaller than minNodes are fully connected
+	graph, err := NewTopology(ethconfig.GraphTopology, 2, len(nodes)+1, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, nodes, graph.RequestSubset(nodes, localNodes[nodes[0]]))
+
+	_, err = NewTopology(ethconfig.GraphTopology, 0, 0, 0, nil)
+	require.ErrorIs(t, err, errInvalidDiameter)
+	_, err = NewTopology(ethconfig.LatencyTopology, 2, 0, 1, nil)
+	require.Error(t, err)
+	_, err = NewTopology("unknown", 2, 0, 0, nil)
+	require.Error(t, err)
+}
+
+type fakeLatencyOracle map[enode.ID]time.Duration
+
+func (f fakeLatencyOracle) PeerRTT(id enode.ID) (time.Duration, bool) {
+	rtt, ok := f[id]
+	return rtt, ok
+}
+
+func TestLatencyAwareTopology(t *testing.T) {
+	const maxExtra = 3
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	nodes, localNodes := generateNodes(t, db, 50)
+	local := localNodes[nodes[0]]
+
+	oracle := make(fakeLatencyOracle)
+	topology, err := NewTopology(ethconfig.LatencyTopology, 2, 0, maxExtra, oracle)
+	require.NoError(t, err)
+	now := time.Now()
+	topology.(*latencyAwareTopology).now = func() time.Time { return now }
+
+	graph, err := NewGraphTopology(2, 0)
+	require.NoError(t, err)
+	base := graph.RequestSubset(nodes, local)
+
+	inBase := make(map[enode.ID]bool)
+	for _, n := range base {
+		inBase[n.ID()] = true
+	}
+	var outside []*enode.Node
+	for _, n := range nodes[1:] {
+		if !inBase[n.ID()] {
+			outside = append(outside, n)
+		}
+	}
+	require.Greater(t, len(outside), 2*maxExtra)
+
+	// without any measurement, the extra slots probe the members following the local node
+	connections := topology.RequestSubset(nodes, local)
+	require.Len(t, connections, len(base)+maxExtra)
+	require.Equal(t, base, connections[:len(base)])
+	for i := 0; i < maxExtra; i++ {
+		require.Equal(t, outside[i].ID(), connections[len(base)+i].ID())
+	}
+
+	// the last outside nodes are the closest ones, but the subset is kept for the committee
+	for i, n := range outside {
+		oracle[n.ID()] = time.Duration(len(outside)-i) * time.Millisecond
+	}
+	require.Equal(t, connections, topology.RequestSubset(nodes, local))
+
+	// the extra peers are ranked again once the refresh interval elapsed
+	now = now.Add(latencyRefreshInterval)
+	connections = topology.RequestSubset(nodes, local)
+	require.Len(t, connections, len(base)+maxExtra)
+	require.Equal(t, base, connections[:len(base)])
+	for i := 0; i < maxExtra; i++ {
+		require.Equal(t, outside[len(outside)-1-i].ID(), connections[len(base)+i].ID())
+	}
+
+	// measurements are remembered once the peers are disconnected, and a committee
+	// change selects the subset again right away
+	for id := range oracle {
+		delete(oracle, id)
+	}
+	smaller := nodes[:len(nodes)-1]
+	connections = topology.RequestSubset(smaller, local)
+	require.Equal(t, graph.RequestSubset(smaller, local), connections[:len(connections)-maxExtra])
+	for i := 0; i < maxExtra; i++ {
+		require.Equal(t, outside[len(outside)-2-i].ID(), connections[len(connections)-maxExtra+i].ID())
+	}
+}
+
+// newTopologyNetwork starts a simulated network of count nodes without any
+// connection, returning the node records in committee order along with the
+// local nodes of their p2p servers.
+func newTopologyNetwork(t *testing.T, count int) (*simulations.Network, []*enode.Node, map[*enode.Node]*enode.LocalNode) {
+	adapter := adapters.NewSimAdapter(adapters.LifecycleConstructors{
+		"noop": func(ctx *adapters.ServiceContext, stack *node.Node) (node.Lifecycle, error) {
+			return simulations.NewNoopService(nil), nil
+		},
+	})
+	network := simulations.NewNetwork(adapter, &simulations.NetworkConfig{DefaultService: "noop"})
+	t.Cleanup(network.Shutdown)
+
+	nodes := make([]*enode.Node, 0, count)
+	localNodes := make(map[*enode.Node]*enode.LocalNode)
+	for i := 0; i < count; i++ {
+		simNode, err := network.NewNodeWithConfig(adapters.RandomNodeConfig())
+		require.NoError(t, err)
+		require.NoError(t, network.Start(simNode.ID()))
+		server := simNode.Node.(*adapters.SimNode).Server()
+		nodes = append(nodes, server.Self())
+		localNodes[nodes[i]] = server.LocalNode()
+	}
+	return network, nodes, localNodes
+}
+
+// connectTopology connects every node of the simulated network to the subset
+// selected by its topology, and waits for all the connections to be up. The
+// resulting peers of every node are returned.
+func connectTopology(t *testing.T, network *simulations.Network, nodes []*enode.Node, localNodes map[*enode.Node]*enode.LocalNode,
+	topologies map[*enode.Node]Topology) map[*enode.Node][]*enode.Node {
+	peers := make(map[*enode.Node][]*enode.Node)
+	for _, n := range nodes {
+		for _, peer := range topologies[n].RequestSubset(nodes, localNodes[n]) {
+			if peer.ID() == n.ID() {
+				continue
+			}
+			if network.GetConn(n.ID(), peer.ID()) == nil {
+				require.NoError(t, network.Connect(n.ID(), peer.ID()))
+			}
+		}
+	}
+	for _, n := range nodes {
+		for _, peer := range topologies[n].RequestSubset(nodes, localNodes[n]) {
+			if peer.ID() == n.ID() {
+				continue
+			}
+			require.Eventually(t, func() bool {
+				conn := network.GetConn(n.ID(), peer.ID())
+				return conn != nil && conn.Up
+			}, 10*time.Second, 10*time.Millisecond, "connection not established")
+		}
+	}
+	// the connections of the network are undirected
+	for _, n := range nodes {
+		for _, peer := range nodes {
+			if conn := network.GetConn(n.ID(), peer.ID()); conn != nil && conn.Up {
+				peers[n] = append(peers[n], peer)
+			}
+		}
+	}
+	return peers
+}
+
+// networkDiameter returns the largest distance between two nodes over the
+// given peers, or -1 if the network isn't connected.
+func networkDiameter(nodes []*enode.Node, peers map[*enode.Node][]*enode.Node) int {
+	diameter := 0
+	for _, source := range nodes {
+		dis := map[*enode.Node]int{source: 0}
+		queue := []*enode.Node{source}
+		for len(queue) > 0 {
+			n := queue[0]
+			queue = queue[1:]
+			for _, peer := range peers[n] {
+				if _, ok := dis[peer]; !ok {
+					dis[peer] = dis[n] + 1
+					queue = append(queue, peer)
+				}
+			}
+		}
+		if len(dis) != len(nodes) {
+			return -1
+		}
+		for _, d := range dis {
+			diameter = max(diameter, d)
+		}
+	}
+	return diameter
+}
+
+func TestSimulatedMeshTopology(t *testing.T) {
+	network, nodes, localNodes := newTopologyNetwork(t, 8)
+	topologies := make(map[*enode.Node]Topology)
+	for _, n := range nodes {
+		topology, err := NewTopology(ethconfig.MeshTopology, 0, 0, 0, nil)
+		require.NoError(t, err)
+		topologies[n] = topology
+	}
+	peers := connectTopology(t, network, nodes, localNodes, topologies)
+	for _, n := range nodes {
+		require.Len(t, peers[n], len(nodes)-1)
+	}
+	require.Equal(t, 1, networkDiameter(nodes, peers))
+}
+
+func TestSimulatedLatencyTopology(t *testing.T) {
+	const (
+		count    = 16
+		maxExtra = 2
+	)
+	network, nodes, localNodes := newTopologyNetwork(t, count)
+	graph, err := NewGraphTopology(2, 0)
+	require.NoError(t, err)
+
+	// every node measures the lowest round trip time to the nodes right before it
+	topologies := make(map[*enode.Node]Topology)
+	closest := make(map[*enode.Node][]*enode.Node)
+	for i, n := range nodes {
+		oracle := make(fakeLatencyOracle)
+		for j := 1; j < count; j++ {
+			oracle[nodes[(i+count-j)%count].ID()] = time.Duration(j) * time.Millisecond
+		}
+		topology, err := NewTopology(ethconfig.LatencyTopology, 2, 0, maxExtra, oracle)
+		require.NoError(t, err)
+		topologies[n] = topology
+
+		inBase := make(map[enode.ID]bool)
+		for _, peer := range graph.RequestSubset(nodes, localNodes[n]) {
+			inBase[peer.ID()] = true
+		}
+		for j := 1; j < count && len(closest[n]) < maxExtra; j++ {
+			if peer := nodes[(i+count-j)%count]; !inBase[peer.ID()] {
+				closest[n] = append(closest[n], peer)
+			}
+		}
+	}
+	peers := connectTopology(t, network, nodes, localNodes, topologies)
+
+	// the graph topology guarantees are kept, and the closest nodes are peers
+	require.Equal(t, 2, networkDiameter(nodes, peers))
+	for _, n := range nodes {
+		for _, peer := range closest[n] {
+			require.Contains(t, peers[n], peer)
+		}
+	}
+}
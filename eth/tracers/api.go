@@ -23,11 +23,13 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"os"
 	"runtime"
 	"sync"
 	"time"
 
+	"github.com/autonity/autonity/autonity"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/hexutil"
 	"github.com/autonity/autonity/consensus"
@@ -191,8 +193,9 @@ type StdTraceConfig struct {
 
 // txTraceResult is the result of a single transaction trace.
 type txTraceResult struct {
-	Result interface{} `json:"result,omitempty"` // Trace results produced by the tracer
-	Error  string      `json:"error,omitempty"`  // Trace failure produced by the tracer
+	TxHash *common.Hash `json:"txHash,omitempty"` // Hash of the finalize() pseudo-transaction, unset for the transactions
+	Result interface{}  `json:"result,omitempty"` // Trace results produced by the tracer
+	Error  string       `json:"error,omitempty"`  // Trace failure produced by the tracer
 }
 
 // blockTraceTask represents a single block trace task when an entire chain is
@@ -274,6 +277,7 @@ func (api *API) traceChain(ctx context.Context, start, end *types.Block, config
 				signer := types.MakeSigner(api.backend.ChainConfig(), task.block.Number())
 				blockCtx := core.NewEVMBlockContext(task.block.Header(), api.chainContext(localctx), nil)
 				// Trace all the transactions contained within
+				succeeded := true
 				for i, tx := range task.block.Transactions() {
 					msg, _ := tx.AsMessage(signer, task.block.BaseFee())
 					txctx := &Context{
@@ -285,12 +289,17 @@ func (api *API) traceChain(ctx context.Context, start, end *types.Block, config
 					if err != nil {
 						task.results[i] = &txTraceResult{Error: err.Error()}
 						log.Warn("Tracing failed", "hash", tx.Hash(), "block", task.block.NumberU64(), "err", err)
+						succeeded = false
 						break
 					}
 					// Only delete empty objects if EIP158/161 (a.k.a Spurious Dragon) is in effect
 					task.statedb.Finalise(api.backend.ChainConfig().IsEIP158(task.block.Number()))
 					task.results[i] = &txTraceResult{Result: res}
 				}
+				// Trace the finalize() system call once all the transactions succeeded
+				if succeeded && api.finalizes(task.block) {
+					task.results = append(task.results, api.finalizeTraceResult(localctx, task.block, task.statedb, config))
+				}
 				// Stream the result back to the user or abort on teardown
 				select {
 				case results <- task:
@@ -593,6 +602,10 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 		threads = len(txs)
 	}
 	blockHash := block.Hash()
+	if api.finalizes(block) {
+		// The finalize() system call is traced last, on top of all transactions
+		results = append(results, nil)
+	}
 	for th := 0; th < threads; th++ {
 		pend.Add(1)
 		go func() {
@@ -641,16 +654,31 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 	if failed != nil {
 		return nil, failed
 	}
+	if api.finalizes(block) {
+		results[len(txs)] = api.finalizeTraceResult(ctx, block, statedb, config)
+	}
 	return results, nil
 }
 
+// finalizeTraceResult traces the finalize() system call of the block into the
+// result of its pseudo-transaction.
+func (api *API) finalizeTraceResult(ctx context.Context, block *types.Block, statedb *state.StateDB, config *TraceConfig) *txTraceResult {
+	hash := common.ACHash(block.Number())
+	res, err := api.traceFinalize(ctx, block, statedb, config)
+	if err != nil {
+		return &txTraceResult{TxHash: &hash, Error: err.Error()}
+	}
+	return &txTraceResult{TxHash: &hash, Result: res}
+}
+
 // standardTraceBlockToFile configures a new tracer which uses standard JSON output,
 // and traces either a full block or an individual transaction. The return value will
 // be one filename per transaction traced.
 func (api *API) standardTraceBlockToFile(ctx context.Context, block *types.Block, config *StdTraceConfig) ([]string, error) {
 	// If we're tracing a single transaction, make sure it's present
 	if config != nil && config.TxHash != (common.Hash{}) {
-		if !containsTx(block, config.TxHash) {
+		finalize := config.TxHash == common.ACHash(block.Number()) && api.finalizes(block)
+		if !finalize && !containsTx(block, config.TxHash) {
 			return nil, fmt.Errorf("transaction %#x not found in block", config.TxHash)
 		}
 	}
@@ -756,12 +784,51 @@ func (api *API) standardTraceBlockToFile(ctx context.Context, block *types.Block
 
 		// If we've traced the transaction we were looking for, abort
 		if tx.Hash() == txHash {
-			break
+			return dumps, nil
+		}
+	}
+	// Trace the finalize() system call on top of the transactions
+	if api.finalizes(block) {
+		dump, err := api.standardTraceFinalizeToFile(block, statedb, vmctx, chainConfig, &logConfig, canon)
+		if err != nil {
+			return dumps, err
 		}
+		dumps = append(dumps, dump)
 	}
 	return dumps, nil
 }
 
+// standardTraceFinalizeToFile traces the finalize() system call of the block
+// with the standard JSON logger, and returns the name of the file it is dumped
+// into.
+func (api *API) standardTraceFinalizeToFile(block *types.Block, statedb *state.StateDB, vmctx vm.BlockContext, chainConfig *params.ChainConfig, logConfig *logger.Config, canon bool) (string, error) {
+	hash := common.ACHash(block.Number())
+	prefix := fmt.Sprintf("block_%#x-%d-%#x-", block.Hash().Bytes()[:4], len(block.Transactions()), hash.Bytes()[:4])
+	if !canon {
+		prefix = fmt.Sprintf("%valt-", prefix)
+	}
+	dump, err := ioutil.TempFile(os.TempDir(), prefix)
+	if err != nil {
+		return "", err
+	}
+	defer dump.Close()
+
+	writer := bufio.NewWriter(dump)
+	vmConf := vm.Config{
+		Debug:                   true,
+		Tracer:                  logger.NewJSONLogger(logConfig, writer),
+		EnablePreimageRecording: true,
+	}
+	txContext := vm.TxContext{Origin: params.DeployerAddress, GasPrice: new(big.Int)}
+	vmenv := vm.NewEVM(vmctx, txContext, statedb, chainConfig, vmConf)
+	statedb.Prepare(hash, len(block.Transactions()))
+	// A failing finalize() is part of the trace, as for the transactions
+	_, _, _ = autonity.ApplyFinalize(vmenv)
+	writer.Flush()
+	log.Info("Wrote standard trace", "file", dump.Name())
+	return dump.Name(), nil
+}
+
 // containsTx reports whether the transaction with a certain hash
 // is contained within the specified block.
 func containsTx(block *types.Block, hash common.Hash) bool {
@@ -776,6 +843,9 @@ func containsTx(block *types.Block, hash common.Hash) bool {
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
 func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *TraceConfig) (interface{}, error) {
+	if ok, number := common.IsACHash(hash); ok {
+		return api.traceFinalizeTransaction(ctx, number, config)
+	}
 	_, blockHash, blockNumber, index, err := api.backend.GetTransaction(ctx, hash)
 	if err != nil {
 		return nil, err
@@ -804,6 +874,31 @@ func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *
 	return api.traceTx(ctx, msg, txctx, vmctx, statedb, config)
 }
 
+// traceFinalizeTransaction traces the finalize() pseudo-transaction of the
+// canonical block of the given number.
+func (api *API) traceFinalizeTransaction(ctx context.Context, number *big.Int, config *TraceConfig) (interface{}, error) {
+	if !number.IsInt64() {
+		return nil, fmt.Errorf("block #%v not found", number)
+	}
+	block, err := api.blockByNumber(ctx, rpc.BlockNumber(number.Int64()))
+	if err != nil {
+		return nil, err
+	}
+	if !api.finalizes(block) {
+		return nil, fmt.Errorf("block #%d has no finalize call", block.NumberU64())
+	}
+	reexec := defaultTraceReexec
+	if config != nil && config.Reexec != nil {
+		reexec = *config.Reexec
+	}
+	// Retrieve the state at the end of the block, before its finalization
+	_, _, statedb, err := api.backend.StateAtTransaction(ctx, block, len(block.Transactions()), reexec)
+	if err != nil {
+		return nil, err
+	}
+	return api.traceFinalize(ctx, block, statedb, config)
+}
+
 // TraceCall lets you trace a given eth_call. It collects the structured logs
 // created during the execution of EVM if the given transaction was added on
 // top of the provided block and returns them as a JSON object.
@@ -862,51 +957,97 @@ func (api *API) TraceCall(ctx context.Context, args ethapi.TransactionArgs, bloc
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
 func (api *API) traceTx(ctx context.Context, message core.Message, txctx *Context, vmctx vm.BlockContext, statedb *state.StateDB, config *TraceConfig) (interface{}, error) {
-	// Assemble the structured logger or the JavaScript tracer
+	tracer, cancel, err := newTracer(ctx, txctx, config)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	// Run the transaction with tracing enabled.
+	txContext := core.NewEVMTxContext(message)
+	vmenv := vm.NewEVM(vmctx, txContext, statedb, api.backend.ChainConfig(), vm.Config{Debug: true, Tracer: tracer, NoBaseFee: true})
+
+	// Call Prepare to clear out the statedb access list
+	statedb.Prepare(txctx.TxHash, txctx.TxIndex)
+
+	result, err := core.ApplyMessage(vmenv, message, new(core.GasPool).AddGas(message.Gas()))
+	if err != nil {
+		return nil, fmt.Errorf("tracing failed: %w", err)
+	}
+	return traceResult(tracer, result)
+}
+
+// finalizes reports whether the consensus engine ends the block with the
+// finalize() system call of the Autonity contract, as Tendermint does. Chains
+// configured for Ethash run it instead, even along an Autonity contract config.
+func (api *API) finalizes(block *types.Block) bool {
+	config := api.backend.ChainConfig()
+	return config.AutonityContractConfig != nil && config.Ethash == nil && block.NumberU64() > 0
+}
+
+// traceFinalize traces the finalize() system call of the Autonity contract ending
+// the block, on top of the state after its transactions. The call, along with the
+// calls it makes to the other protocol contracts, is traced as a pseudo-transaction
+// following the transactions of the block, whose hash is common.ACHash of the block
+// number, as for its receipt.
+func (api *API) traceFinalize(ctx context.Context, block *types.Block, statedb *state.StateDB, config *TraceConfig) (interface{}, error) {
+	txctx := &Context{
+		BlockHash: block.Hash(),
+		TxIndex:   len(block.Transactions()),
+		TxHash:    common.ACHash(block.Number()),
+	}
+	tracer, cancel, err := newTracer(ctx, txctx, config)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
 	var (
-		tracer    vm.EVMLogger
-		err       error
-		txContext = core.NewEVMTxContext(message)
+		vmctx     = core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
+		txContext = vm.TxContext{Origin: params.DeployerAddress, GasPrice: new(big.Int)}
+		vmenv     = vm.NewEVM(vmctx, txContext, statedb, api.backend.ChainConfig(), vm.Config{Debug: true, Tracer: tracer, NoBaseFee: true})
 	)
+	statedb.Prepare(txctx.TxHash, txctx.TxIndex)
+
+	ret, gasUsed, err := autonity.ApplyFinalize(vmenv)
+	return traceResult(tracer, &core.ExecutionResult{UsedGas: gasUsed, Err: err, ReturnData: ret})
+}
+
+// newTracer assembles the structured logger or the JavaScript tracer requested by
+// the configuration. The returned function releases the timeout of the tracer.
+func newTracer(ctx context.Context, txctx *Context, config *TraceConfig) (vm.EVMLogger, context.CancelFunc, error) {
 	switch {
 	case config == nil:
-		tracer = logger.NewStructLogger(nil)
+		return logger.NewStructLogger(nil), func() {}, nil
 	case config.Tracer != nil:
 		// Define a meaningful timeout of a single transaction trace
 		timeout := defaultTraceTimeout
 		if config.Timeout != nil {
+			var err error
 			if timeout, err = time.ParseDuration(*config.Timeout); err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		}
-		if t, err := New(*config.Tracer, txctx); err != nil {
-			return nil, err
-		} else {
-			deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
-			go func() {
-				<-deadlineCtx.Done()
-				if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
-					t.Stop(errors.New("execution timeout"))
-				}
-			}()
-			defer cancel()
-			tracer = t
+		t, err := New(*config.Tracer, txctx)
+		if err != nil {
+			return nil, nil, err
 		}
+		deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
+		go func() {
+			<-deadlineCtx.Done()
+			if errors.Is(deadlineCtx.Err(), context.DeadlineExceeded) {
+				t.Stop(errors.New("execution timeout"))
+			}
+		}()
+		return t, cancel, nil
 	default:
-		tracer = logger.NewStructLogger(config.Config)
-	}
-	// Run the transaction with tracing enabled.
-	vmenv := vm.NewEVM(vmctx, txContext, statedb, api.backend.ChainConfig(), vm.Config{Debug: true, Tracer: tracer, NoBaseFee: true})
-
-	// Call Prepare to clear out the statedb access list
-	statedb.Prepare(txctx.TxHash, txctx.TxIndex)
-
-	result, err := core.ApplyMessage(vmenv, message, new(core.GasPool).AddGas(message.Gas()))
-	if err != nil {
-		return nil, fmt.Errorf("tracing failed: %w", err)
+		return logger.NewStructLogger(config.Config), func() {}, nil
 	}
+}
 
-	// Depending on the tracer type, format and return the output.
+// traceResult formats the result of a traced execution depending on the tracer
+// type.
+func traceResult(tracer vm.EVMLogger, result *core.ExecutionResult) (interface{}, error) {
 	switch tracer := tracer.(type) {
 	case *logger.StructLogger:
 		// If the result contains a revert reason, return it.
@@ -157,9 +157,6 @@ func (b *testBackend) StateAtTransaction(ctx context.Context, block *types.Block
 	if err != nil {
 		return nil, vm.BlockContext{}, nil, errStateNotFound
 	}
-	if txIndex == 0 && len(block.Transactions()) == 0 {
-		return nil, vm.BlockContext{}, statedb, nil
-	}
 	// Recompute transactions up to the target index.
 	signer := types.MakeSigner(b.chainConfig, block.Number())
 	for idx, tx := range block.Transactions() {
@@ -175,6 +172,9 @@ func (b *testBackend) StateAtTransaction(ctx context.Context, block *types.Block
 		}
 		statedb.Finalise(vmenv.ChainConfig().IsEIP158(block.Number()))
 	}
+	if txIndex == len(block.Transactions()) {
+		return nil, core.NewEVMBlockContext(block.Header(), b.chain, nil), statedb, nil
+	}
 	return nil, vm.BlockContext{}, nil, fmt.Errorf("transaction index %d out of range for block %#x", txIndex, block.Hash())
 }
 
@@ -417,6 +417,58 @@ func TestTraceBlock(t *testing.T) {
 	}
 }
 
+func TestTraceFinalize(t *testing.T) {
+	t.Parallel()
+
+	accounts := newAccounts(2)
+	genesis := &core.Genesis{Alloc: core.GenesisAlloc{
+		accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+		accounts[1].addr: {Balance: big.NewInt(params.Ether)},
+	}}
+	signer := types.HomesteadSigner{}
+	generator := func(i int, b *core.BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(uint64(i), accounts[1].addr, big.NewInt(1000), params.TxGas, b.BaseFee(), nil), signer, accounts[0].key)
+		b.AddTx(tx)
+	}
+	backend := newTestBackend(t, 1, genesis, generator)
+	// Trace the blocks as if they were finalized by Tendermint
+	config := *backend.chainConfig
+	config.Ethash = nil
+	backend.chainConfig = &config
+	api := NewAPI(backend)
+
+	finalizeHash := common.ACHash(big.NewInt(1))
+	results, err := api.TraceBlockByNumber(context.Background(), 1, nil)
+	if err != nil {
+		t.Fatalf("failed to trace block: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("trace count mismatch: have %d, want 2", len(results))
+	}
+	if results[0].TxHash != nil {
+		t.Errorf("transaction trace has a hash: %v", results[0].TxHash)
+	}
+	if results[1].TxHash == nil || *results[1].TxHash != finalizeHash || results[1].Error != "" {
+		t.Fatalf("finalize trace mismatch: have hash %v error %q, want hash %v", results[1].TxHash, results[1].Error, finalizeHash)
+	}
+	finalize, ok := results[1].Result.(*ethapi.ExecutionResult)
+	if !ok || finalize.Failed || len(finalize.StructLogs) == 0 {
+		t.Fatalf("finalize execution mismatch: %+v", results[1].Result)
+	}
+	result, err := api.TraceTransaction(context.Background(), finalizeHash, nil)
+	if err != nil {
+		t.Fatalf("failed to trace finalize: %v", err)
+	}
+	if !reflect.DeepEqual(result, finalize) {
+		t.Error("finalize trace mismatch between block and transaction tracing")
+	}
+	// Without Tendermint, blocks have no finalize call
+	ethashAPI := NewAPI(newTestBackend(t, 1, genesis, generator))
+	if _, err := ethashAPI.TraceTransaction(context.Background(), finalizeHash, nil); err == nil {
+		t.Error("expected an error tracing finalize without Tendermint")
+	}
+}
+
 func TestTracingWithOverrides(t *testing.T) {
 	t.Parallel()
 	// Initialize test accounts
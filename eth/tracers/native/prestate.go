@@ -47,14 +47,20 @@ type prestateTracer struct {
 	prestate  prestate
 	create    bool
 	to        common.Address
+	system    bool   // Whether the traced call is a protocol system call
 	interrupt uint32 // Atomic flag to signal execution interruption
 	reason    error  // Textual reason for the interruption
 }
 
-func newPrestateTracer() tracers.Tracer {
+func newPrestateTracer(ctx *tracers.Context) tracers.Tracer {
 	// First callframe contains tx context info
 	// and is populated on start and end.
-	return &prestateTracer{prestate: prestate{}}
+	t := &prestateTracer{prestate: prestate{}}
+	if ctx != nil {
+		// The finalize() system call is keyed by the hash of the block number
+		t.system, _ = common.IsACHash(ctx.TxHash)
+	}
+	return t
 }
 
 // CaptureStart implements the EVMLogger interface to initialize the tracing operation.
@@ -79,6 +85,10 @@ func (t *prestateTracer) CaptureStart(env *vm.EVM, from common.Address, to commo
 	toBal = new(big.Int).Sub(toBal, value)
 	t.prestate[to].Balance = hexutil.EncodeBig(toBal)
 
+	// System calls neither buy gas nor increment the nonce of the sender.
+	if t.system {
+		return
+	}
 	// The sender balance is after reducing: value, gasLimit, intrinsicGas.
 	// We need to re-add them to get the pre-tx balance.
 	fromBal := hexutil.MustDecodeBig(t.prestate[from].Balance)
@@ -57,12 +57,16 @@ The go spec (https://golang.org/ref/spec#Package_initialization) says
 
 Hence, we cannot make the map in init, but must make it upon first use.
 */
-var ctors map[string]func() tracers.Tracer
+var ctors map[string]ctorFn
+
+// ctorFn is the constructor signature of a native tracer, given the context of
+// the traced transaction.
+type ctorFn func(*tracers.Context) tracers.Tracer
 
 // register is used by native tracers to register their presence.
-func register(name string, ctor func() tracers.Tracer) {
+func register(name string, ctor ctorFn) {
 	if ctors == nil {
-		ctors = make(map[string]func() tracers.Tracer)
+		ctors = make(map[string]ctorFn)
 	}
 	ctors[name] = ctor
 }
@@ -70,10 +74,10 @@ func register(name string, ctor func() tracers.Tracer) {
 // lookup returns a tracer, if one can be matched to the given name.
 func lookup(name string, ctx *tracers.Context) (tracers.Tracer, error) {
 	if ctors == nil {
-		ctors = make(map[string]func() tracers.Tracer)
+		ctors = make(map[string]ctorFn)
 	}
 	if ctor, ok := ctors[name]; ok {
-		return ctor(), nil
+		return ctor(ctx), nil
 	}
 	return nil, errors.New("no tracer found")
 }
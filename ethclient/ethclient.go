@@ -286,6 +286,24 @@ func (ec *Client) TransactionReceipt(ctx context.Context, txHash common.Hash) (*
 	return r, err
 }
 
+// BlockReceipts returns the receipts of the given block. The receipt of the finalize()
+// protocol call ending the block, if any, follows the ones of the transactions.
+func (ec *Client) BlockReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]*types.Receipt, error) {
+	var r []*types.Receipt
+	err := ec.c.CallContext(ctx, &r, "eth_getBlockReceipts", blockNrOrHash)
+	if err == nil && r == nil {
+		return nil, ethereum.NotFound
+	}
+	return r, err
+}
+
+// FinalizeReceipt returns the receipt of the finalize() protocol call ending the
+// block of the given number, which holds the events emitted by the protocol
+// contracts during the finalization.
+func (ec *Client) FinalizeReceipt(ctx context.Context, number *big.Int) (*types.Receipt, error) {
+	return ec.TransactionReceipt(ctx, common.ACHash(number))
+}
+
 // SyncProgress retrieves the current progress of the sync algorithm. If there's
 // no sync currently running, it returns nil.
 func (ec *Client) SyncProgress(ctx context.Context) (*ethereum.SyncProgress, error) {
@@ -555,6 +573,12 @@ func toBlockNumArg(number *big.Int) string {
 	if number.Cmp(pending) == 0 {
 		return "pending"
 	}
+	if number.Sign() < 0 && number.IsInt64() {
+		// The remaining meta block numbers, such as "finalized" and "safe".
+		if tag, err := rpc.BlockNumber(number.Int64()).MarshalText(); err == nil {
+			return string(tag)
+		}
+	}
 	return hexutil.EncodeBig(number)
 }
 
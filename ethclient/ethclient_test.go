@@ -297,6 +297,9 @@ func TestEthClient(t *testing.T) {
 		"TransactionSender": {
 			func(t *testing.T) { testTransactionSender(t, client) },
 		},
+		"BlockReceipts": {
+			func(t *testing.T) { testBlockReceipts(t, chain, client) },
+		},
 	}
 
 	t.Parallel()
@@ -695,6 +698,41 @@ func testTransactionSender(t *testing.T, client *rpc.Client) {
 	}
 }
 
+func testBlockReceipts(t *testing.T, chain []*types.Block, client *rpc.Client) {
+	ec := NewClient(client)
+	ctx := context.Background()
+
+	receipts, err := ec.BlockReceipts(ctx, rpc.BlockNumberOrHashWithNumber(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(receipts) != 2 {
+		t.Fatalf("wrong number of receipts %d, want 2", len(receipts))
+	}
+	for i, tx := range []*types.Transaction{testTx1, testTx2} {
+		if receipts[i].TxHash != tx.Hash() {
+			t.Fatalf("receipt %d: wrong tx hash %v, want %v", i, receipts[i].TxHash, tx.Hash())
+		}
+		if receipts[i].Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("receipt %d: wrong status %d", i, receipts[i].Status)
+		}
+	}
+	byHash, err := ec.BlockReceipts(ctx, rpc.BlockNumberOrHashWithHash(chain[2].Hash(), false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(byHash, receipts) {
+		t.Fatalf("receipts by hash differ from receipts by number")
+	}
+	if _, err := ec.BlockReceipts(ctx, rpc.BlockNumberOrHashWithNumber(10)); err != ethereum.NotFound {
+		t.Fatalf("unknown block: got error %v, want %v", err, ethereum.NotFound)
+	}
+	// The ethash test chain has no finalize call
+	if _, err := ec.FinalizeReceipt(ctx, big.NewInt(2)); err != ethereum.NotFound {
+		t.Fatalf("finalize receipt: got error %v, want %v", err, ethereum.NotFound)
+	}
+}
+
 func sendTransaction(ec *Client) error {
 	chainID, err := ec.ChainID(context.Background())
 	if err != nil {
@@ -88,6 +88,10 @@ type AncientReader interface {
 
 	// AncientSize returns the ancient size of the specified category.
 	AncientSize(kind string) (uint64, error)
+
+	// AncientTail returns the number of the first item retained in the ancient
+	// store for the specified category, the older ones having been pruned.
+	AncientTail(kind string) (uint64, error)
 }
 
 // AncientBatchReader is the interface for 'batched' or 'atomic' reading.
@@ -109,6 +113,11 @@ type AncientWriter interface {
 	// TruncateAncients discards all but the first n ancient data from the ancient store.
 	TruncateAncients(n uint64) error
 
+	// TruncateTail discards the ancient data of the specified category below the
+	// provided tail number, returning the new tail. It might be lower than the
+	// requested one, as the data is deleted in chunks.
+	TruncateTail(kind string, tail uint64) (uint64, error)
+
 	// Sync flushes all in-memory ancient store data to disk.
 	Sync() error
 }
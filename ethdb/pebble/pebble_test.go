@@ -0,0 +1,42 @@
+//go:build (arm64 || amd64) && !openbsd
+
+package pebble
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/autonity/autonity/ethdb"
+	"github.com/autonity/autonity/ethdb/dbtest"
+)
+
+func TestPebbleDB(t *testing.T) {
+	t.Run("DatabaseSuite", func(t *testing.T) {
+		dbtest.TestDatabaseSuite(t, func() ethdb.KeyValueStore {
+			db, err := New(t.TempDir(), 16, 16, "", false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { db.Close() })
+			return db
+		})
+	})
+}
+
+func TestPebbleDBReopen(t *testing.T) {
+	dir := t.TempDir()
+	db, err := New(dir, 16, 16, "", false)
+	require.NoError(t, err)
+	require.NoError(t, db.Put([]byte("key"), []byte("value")))
+	require.NoError(t, db.Close())
+
+	// the data survives the restart, and a read-only database rejects the writes
+	db, err = New(dir, 16, 16, "", true)
+	require.NoError(t, err)
+	defer db.Close()
+	value, err := db.Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	require.Error(t, db.Put([]byte("key"), []byte("other")))
+}
@@ -25,6 +25,7 @@ import (
 	"strconv"
 
 	"github.com/autonity/autonity"
+	protocol "github.com/autonity/autonity/autonity"
 	"github.com/autonity/autonity/common"
 	"github.com/autonity/autonity/common/hexutil"
 	"github.com/autonity/autonity/common/math"
@@ -32,6 +33,7 @@ import (
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/eth/filters"
 	"github.com/autonity/autonity/internal/ethapi"
+	"github.com/autonity/autonity/params"
 	"github.com/autonity/autonity/rpc"
 )
 
@@ -171,16 +173,52 @@ func (at *AccessTuple) StorageKeys(ctx context.Context) []common.Hash {
 // Transaction represents an Ethereum transaction.
 // backend and hash are mandatory; all others will be fetched when required.
 type Transaction struct {
-	backend ethapi.Backend
-	hash    common.Hash
-	tx      *types.Transaction
-	block   *Block
-	index   uint64
+	backend  ethapi.Backend
+	hash     common.Hash
+	tx       *types.Transaction
+	block    *Block
+	index    uint64
+	finalize bool
+}
+
+// finalizeTransaction returns the unsigned transaction standing for the
+// finalize() protocol call ending a block. It follows the transactions of the
+// block and has no sender, gas price nor value.
+func finalizeTransaction(backend ethapi.Backend, block *Block, number *big.Int, index uint64) *Transaction {
+	return &Transaction{
+		backend: backend,
+		hash:    common.ACHash(number),
+		tx: types.NewTx(&types.LegacyTx{
+			To:       &params.AutonityContractAddress,
+			Value:    new(big.Int),
+			GasPrice: new(big.Int),
+			Data:     protocol.FinalizeCallData(),
+			V:        new(big.Int),
+			R:        new(big.Int),
+			S:        new(big.Int),
+		}),
+		block:    block,
+		index:    index,
+		finalize: true,
+	}
 }
 
 // resolve returns the internal transaction object, fetching it if needed.
 func (t *Transaction) resolve(ctx context.Context) (*types.Transaction, error) {
 	if t.tx == nil {
+		// The finalize() call of a block is looked up by its pseudo hash
+		if ok, number := common.IsACHash(t.hash); ok {
+			receipt, block, err := ethapi.GetFinalizeReceipt(ctx, t.backend, number)
+			if receipt == nil || err != nil {
+				return nil, err
+			}
+			blockNrOrHash := rpc.BlockNumberOrHashWithHash(block.Hash(), false)
+			*t = *finalizeTransaction(t.backend, &Block{
+				backend:      t.backend,
+				numberOrHash: &blockNrOrHash,
+			}, number, uint64(len(block.Transactions())))
+			return t.tx, nil
+		}
 		// Try to return an already finalized transaction
 		tx, blockHash, _, index, err := t.backend.GetTransaction(ctx, t.hash)
 		if err == nil && tx != nil {
@@ -203,6 +241,13 @@ func (t *Transaction) Hash(ctx context.Context) common.Hash {
 	return t.hash
 }
 
+func (t *Transaction) Finalize(ctx context.Context) (bool, error) {
+	if _, err := t.resolve(ctx); err != nil {
+		return false, err
+	}
+	return t.finalize, nil
+}
+
 func (t *Transaction) InputData(ctx context.Context) (hexutil.Bytes, error) {
 	tx, err := t.resolve(ctx)
 	if err != nil || tx == nil {
@@ -325,8 +370,11 @@ func (t *Transaction) From(ctx context.Context, args BlockNumberArgs) (*Account,
 	if err != nil || tx == nil {
 		return nil, err
 	}
-	signer := types.LatestSigner(t.backend.ChainConfig())
-	from, _ := types.Sender(signer, tx)
+	from := params.DeployerAddress
+	if !t.finalize {
+		signer := types.LatestSigner(t.backend.ChainConfig())
+		from, _ = types.Sender(signer, tx)
+	}
 	return &Account{
 		backend:       t.backend,
 		address:       from,
@@ -798,6 +846,23 @@ func (b *Block) Transactions(ctx context.Context) (*[]*Transaction, error) {
 	return &ret, nil
 }
 
+// FinalizeTransaction returns the finalize() protocol call ending the block, if
+// any, which follows its transactions.
+func (b *Block) FinalizeTransaction(ctx context.Context) (*Transaction, error) {
+	block, err := b.resolve(ctx)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	receipts, err := b.resolveReceipts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(receipts) != len(block.Transactions())+1 {
+		return nil, nil
+	}
+	return finalizeTransaction(b.backend, b, block.Number(), uint64(len(block.Transactions()))), nil
+}
+
 func (b *Block) TransactionAt(ctx context.Context, args struct{ Index int32 }) (*Transaction, error) {
 	block, err := b.resolve(ctx)
 	if err != nil || block == nil {
@@ -1055,9 +1120,20 @@ type Resolver struct {
 func (r *Resolver) Block(ctx context.Context, args struct {
 	Number *Long
 	Hash   *common.Hash
+	Tag    *string
 }) (*Block, error) {
 	var block *Block
-	if args.Number != nil {
+	if args.Tag != nil {
+		var number rpc.BlockNumber
+		if err := number.UnmarshalJSON([]byte(strconv.Quote(*args.Tag))); err != nil {
+			return nil, err
+		}
+		numberOrHash := rpc.BlockNumberOrHashWithNumber(number)
+		block = &Block{
+			backend:      r.backend,
+			numberOrHash: &numberOrHash,
+		}
+	} else if args.Number != nil {
 		if *args.Number < 0 {
 			return nil, nil
 		}
@@ -127,6 +127,26 @@ func TestGraphQLBlockSerialization(t *testing.T) {
 			want: `{"errors":[{"message":"strconv.ParseInt: parsing \"a\": invalid syntax"}],"data":{}}`,
 			code: 400,
 		},
+		{
+			body: `{"query": "{block(tag:\"finalized\"){number}}","variables": null}`,
+			want: `{"data":{"block":{"number":10}}}`,
+			code: 200,
+		},
+		{
+			body: `{"query": "{block(tag:\"safe\"){number}}","variables": null}`,
+			want: `{"data":{"block":{"number":10}}}`,
+			code: 200,
+		},
+		{
+			body: `{"query": "{block(tag:\"earliest\"){number}}","variables": null}`,
+			want: `{"data":{"block":{"number":0}}}`,
+			code: 200,
+		},
+		{
+			body: `{"query": "{block(tag:\"bad\"){number}}","variables": null}`,
+			want: `{"errors":[{"message":"hex string without 0x prefix","path":["block"]}],"data":{"block":null}}`,
+			code: 400,
+		},
 		{
 			body: `{"query": "{bleh{number}}","variables": null}"`,
 			want: `{"errors":[{"message":"Cannot query field \"bleh\" on type \"Query\".","locations":[{"line":1,"column":2}]}]}`,
@@ -138,6 +138,11 @@ const schema string = `
         #Envelope transaction support
         type: Int
         accessList: [AccessTuple!]
+        # Finalize is true for the finalize() call of the protocol contract
+        # ending each block. It follows the transactions of the block, is sent
+        # by the zero address and its hash is the pseudo hash of the block
+        # number, starting with 0xac.
+        finalize: Boolean!
     }
 
     # BlockFilterCriteria encapsulates log filter criteria for a filter applied
@@ -218,6 +223,9 @@ const schema string = `
         # Transactions is a list of transactions associated with this block. If
         # transactions are unavailable for this block, this field will be null.
         transactions: [Transaction!]
+        # FinalizeTransaction is the finalize() call of the protocol contract
+        # ending this block. This field will be null for the genesis block.
+        finalizeTransaction: Transaction
         # TransactionAt returns the transaction at the specified index. If
         # transactions are unavailable for this block, or if the index is out of
         # bounds, this field will be null.
@@ -315,9 +323,10 @@ const schema string = `
     }
 
     type Query {
-        # Block fetches an Ethereum block by number or by hash. If neither is
-        # supplied, the most recent known block is returned.
-        block(number: Long, hash: Bytes32): Block
+        # Block fetches an Ethereum block by number, by hash or by block tag
+        # ("latest", "earliest", "pending", "finalized" or "safe"). If none
+        # is supplied, the most recent known block is returned.
+        block(number: Long, hash: Bytes32, tag: String): Block
         # Blocks returns all the blocks between two numbers, inclusive. If
         # to is not supplied, it defaults to the most recent known block.
         blocks(from: Long, to: Long): [Block!]!
@@ -0,0 +1,117 @@
+package era
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/internal/era/e2store"
+	"github.com/autonity/autonity/rlp"
+	"github.com/golang/snappy"
+)
+
+// Builder writes consecutive blocks into an era file.
+type Builder struct {
+	w       *e2store.Writer
+	written uint64
+
+	start   *uint64
+	offsets []uint64
+	hashes  []common.Hash
+	tds     []*big.Int
+}
+
+// NewBuilder creates a builder writing an era file into w.
+func NewBuilder(w io.Writer) *Builder {
+	return &Builder{w: e2store.NewWriter(w)}
+}
+
+// Add appends a block along with its receipts and total difficulty to the era.
+func (b *Builder) Add(block *types.Block, receipts types.Receipts, td *big.Int) error {
+	header, err := rlp.EncodeToBytes(block.Header())
+	if err != nil {
+		return err
+	}
+	body, err := rlp.EncodeToBytes(block.Body())
+	if err != nil {
+		return err
+	}
+	storageReceipts := make([]*types.ReceiptForStorage, len(receipts))
+	for i, receipt := range receipts {
+		storageReceipts[i] = (*types.ReceiptForStorage)(receipt)
+	}
+	encReceipts, err := rlp.EncodeToBytes(storageReceipts)
+	if err != nil {
+		return err
+	}
+	return b.AddRLP(header, body, encReceipts, block.NumberU64(), block.Hash(), td)
+}
+
+// AddRLP appends an RLP encoded block along with its receipts, in their storage
+// encoding, and total difficulty to the era.
+func (b *Builder) AddRLP(header, body, receipts []byte, number uint64, hash common.Hash, td *big.Int) error {
+	if len(b.offsets) >= MaxEraBatchSize {
+		return fmt.Errorf("era full: %d blocks", MaxEraBatchSize)
+	}
+	if b.start == nil {
+		if err := b.write(TypeVersion, nil); err != nil {
+			return err
+		}
+		b.start = &number
+	} else if want := *b.start + uint64(len(b.offsets)); number != want {
+		return fmt.Errorf("non contiguous block: have #%d, want #%d", number, want)
+	}
+	b.offsets = append(b.offsets, b.written)
+	b.hashes = append(b.hashes, hash)
+	b.tds = append(b.tds, new(big.Int).Set(td))
+
+	for _, item := range []struct {
+		typ  uint16
+		blob []byte
+	}{
+		{TypeCompressedHeader, header},
+		{TypeCompressedBody, body},
+		{TypeCompressedReceipts, receipts},
+	} {
+		if err := b.write(item.typ, snappy.Encode(nil, item.blob)); err != nil {
+			return err
+		}
+	}
+	return b.write(TypeTotalDifficulty, common.BigToHash(td).Bytes())
+}
+
+// Finalize writes the accumulator and the block index closing the era file,
+// returning the accumulator root.
+func (b *Builder) Finalize() (common.Hash, error) {
+	if b.start == nil {
+		return common.Hash{}, errors.New("empty era")
+	}
+	root, err := ComputeAccumulator(b.hashes, b.tds)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := b.write(TypeAccumulator, root.Bytes()); err != nil {
+		return common.Hash{}, err
+	}
+	index := make([]byte, 16+8*len(b.offsets))
+	binary.LittleEndian.PutUint64(index, *b.start)
+	for i, offset := range b.offsets {
+		binary.LittleEndian.PutUint64(index[8+8*i:], offset)
+	}
+	binary.LittleEndian.PutUint64(index[8+8*len(b.offsets):], uint64(len(b.offsets)))
+	if err := b.write(TypeBlockIndex, index); err != nil {
+		return common.Hash{}, err
+	}
+	return root, nil
+}
+
+// write appends an entry to the era, tracking the file offset.
+func (b *Builder) write(typ uint16, value []byte) error {
+	n, err := b.w.Write(typ, value)
+	b.written += uint64(n)
+	return err
+}
@@ -0,0 +1,115 @@
+// Package e2store implements the type-length-value record encoding of era
+// archive files.
+//
+// Every entry starts with an 8 byte header holding the type (2 bytes), the
+// length of the value (4 bytes) and two reserved zero bytes, all little endian,
+// followed by the value itself.
+package e2store
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	headerSize     = 8
+	valueSizeLimit = 50 * 1024 * 1024
+)
+
+var errReservedBytes = errors.New("reserved header bytes are non-zero")
+
+// Entry is a single type-length-value record of an e2store.
+type Entry struct {
+	Type  uint16
+	Value []byte
+}
+
+// Writer writes entries into an underlying stream.
+type Writer struct {
+	w io.Writer
+}
+
+// NewWriter creates a writer appending entries to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// Write writes a single entry, returning the number of bytes written including
+// the entry header.
+func (w *Writer) Write(typ uint16, value []byte) (int, error) {
+	if len(value) > valueSizeLimit {
+		return 0, fmt.Errorf("entry too large: %d > %d", len(value), valueSizeLimit)
+	}
+	header := make([]byte, headerSize)
+	binary.LittleEndian.PutUint16(header, typ)
+	binary.LittleEndian.PutUint32(header[2:], uint32(len(value)))
+
+	if n, err := w.w.Write(header); err != nil {
+		return n, err
+	}
+	n, err := w.w.Write(value)
+	return headerSize + n, err
+}
+
+// Reader reads entries at arbitrary offsets of an underlying file.
+type Reader struct {
+	r io.ReaderAt
+}
+
+// NewReader creates a reader over r.
+func NewReader(r io.ReaderAt) *Reader {
+	return &Reader{r: r}
+}
+
+// ReadAt reads the entry at the given offset, returning it along with its total
+// length including the entry header.
+func (r *Reader) ReadAt(off int64) (*Entry, int64, error) {
+	typ, length, err := r.ReadMetadataAt(off)
+	if err != nil {
+		return nil, 0, err
+	}
+	entry := &Entry{Type: typ, Value: make([]byte, length)}
+	if length > 0 {
+		if _, err := r.r.ReadAt(entry.Value, off+headerSize); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, 0, err
+		}
+	}
+	return entry, headerSize + int64(length), nil
+}
+
+// ReadTypeAt reads the value of the entry at the given offset, which must be of
+// the expected type, returning it along with the total length of the entry.
+func (r *Reader) ReadTypeAt(typ uint16, off int64) ([]byte, int64, error) {
+	entry, n, err := r.ReadAt(off)
+	if err != nil {
+		return nil, 0, err
+	}
+	if entry.Type != typ {
+		return nil, 0, fmt.Errorf("entry type mismatch at offset %d: have %#x, want %#x", off, entry.Type, typ)
+	}
+	return entry.Value, n, nil
+}
+
+// ReadMetadataAt reads the header of the entry at the given offset.
+func (r *Reader) ReadMetadataAt(off int64) (uint16, uint32, error) {
+	header := make([]byte, headerSize)
+	if n, err := r.r.ReadAt(header, off); err != nil {
+		if err == io.EOF && n > 0 {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, 0, err
+	}
+	if header[6] != 0 || header[7] != 0 {
+		return 0, 0, errReservedBytes
+	}
+	length := binary.LittleEndian.Uint32(header[2:])
+	if length > valueSizeLimit {
+		return 0, 0, fmt.Errorf("entry too large: %d > %d", length, valueSizeLimit)
+	}
+	return binary.LittleEndian.Uint16(header), length, nil
+}
@@ -0,0 +1,69 @@
+package e2store
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+// Tests that entries written into an e2store are read back from their offsets.
+func TestEncodeDecode(t *testing.T) {
+	entries := []Entry{
+		{Type: 0x3265, Value: []byte{}},
+		{Type: 0x03, Value: []byte("header")},
+		{Type: 0x04, Value: bytes.Repeat([]byte{0xaa}, 1000)},
+		{Type: 0xffff, Value: []byte{0x01}},
+	}
+	var (
+		buf     = new(bytes.Buffer)
+		w       = NewWriter(buf)
+		offsets []int64
+	)
+	for _, entry := range entries {
+		offsets = append(offsets, int64(buf.Len()))
+		n, err := w.Write(entry.Type, entry.Value)
+		if err != nil {
+			t.Fatalf("failed to write entry: %v", err)
+		}
+		if n != headerSize+len(entry.Value) {
+			t.Fatalf("written size mismatch: have %d, want %d", n, headerSize+len(entry.Value))
+		}
+	}
+	r := NewReader(bytes.NewReader(buf.Bytes()))
+	for i, entry := range entries {
+		have, n, err := r.ReadAt(offsets[i])
+		if err != nil {
+			t.Fatalf("entry %d: failed to read: %v", i, err)
+		}
+		if have.Type != entry.Type || !bytes.Equal(have.Value, entry.Value) {
+			t.Fatalf("entry %d: mismatch: have %#x %x, want %#x %x", i, have.Type, have.Value, entry.Type, entry.Value)
+		}
+		if n != int64(headerSize+len(entry.Value)) {
+			t.Fatalf("entry %d: length mismatch: have %d, want %d", i, n, headerSize+len(entry.Value))
+		}
+	}
+	if _, _, err := r.ReadTypeAt(0x04, offsets[1]); err == nil {
+		t.Fatalf("read entry of unexpected type")
+	}
+	if _, _, err := r.ReadAt(int64(buf.Len())); err != io.EOF {
+		t.Fatalf("read past the end: have %v, want %v", err, io.EOF)
+	}
+}
+
+// Tests that malformed entries are rejected.
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		blob []byte
+		err  error
+	}{
+		{blob: []byte{0x01, 0x00, 0x00, 0x00}, err: io.ErrUnexpectedEOF},
+		{blob: []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00}, err: errReservedBytes},
+		{blob: []byte{0x01, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0xaa}, err: io.ErrUnexpectedEOF},
+	}
+	for i, tt := range tests {
+		if _, _, err := NewReader(bytes.NewReader(tt.blob)).ReadAt(0); !errors.Is(err, tt.err) {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,278 @@
+// Package era implements an archive format for a fixed number of consecutive
+// canonical blocks along with their receipts, which can be verified and seeked
+// into without importing it.
+//
+// An era file is an e2store made of the following entries:
+//
+//	Version | block-tuple* | Accumulator | BlockIndex
+//	block-tuple := CompressedHeader | CompressedBody | CompressedReceipts | TotalDifficulty
+//
+// Headers are stored in their RLP encoding, which for BFT blocks includes the
+// committee and the committed seals, so that the files can be checked against
+// the committee of the previous block. Bodies and receipts (in their storage
+// encoding) are snappy compressed. The total difficulty is a 32 byte big endian
+// integer. The accumulator is the root of a binary keccak tree over the block
+// hashes and total difficulties. The index holds the number of the first block,
+// the file offset of every block tuple and the number of blocks, all 8 byte
+// little endian integers.
+package era
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/internal/era/e2store"
+	"github.com/autonity/autonity/rlp"
+	"github.com/golang/snappy"
+)
+
+const (
+	TypeVersion            uint16 = 0x3265
+	TypeCompressedHeader   uint16 = 0x03
+	TypeCompressedBody     uint16 = 0x04
+	TypeCompressedReceipts uint16 = 0x05
+	TypeTotalDifficulty    uint16 = 0x06
+	TypeAccumulator        uint16 = 0x07
+	TypeBlockIndex         uint16 = 0x3266
+
+	// MaxEraBatchSize is the number of blocks stored in a single era file.
+	MaxEraBatchSize = 8192
+
+	entryHeaderSize = 8
+)
+
+var errInvalidEra = errors.New("invalid era file")
+
+// ReadAtSeekCloser is the file an era is read from.
+type ReadAtSeekCloser interface {
+	io.ReaderAt
+	io.Seeker
+	io.Closer
+}
+
+// Era is a reader of an era file.
+type Era struct {
+	f       ReadAtSeekCloser
+	s       *e2store.Reader
+	start   uint64   // Number of the first block
+	offsets []uint64 // File offsets of the block tuples
+	root    int64    // File offset of the accumulator
+}
+
+// Open opens the era file at the given path.
+func Open(path string) (*Era, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	e, err := From(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return e, nil
+}
+
+// From creates an era reader over the given file, loading its block index.
+func From(f ReadAtSeekCloser) (*Era, error) {
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+	s := e2store.NewReader(f)
+	if _, _, err := s.ReadTypeAt(TypeVersion, 0); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidEra, err)
+	}
+	// The block count closes the file, locate the index with it
+	if size < entryHeaderSize+16 {
+		return nil, fmt.Errorf("%w: file too short", errInvalidEra)
+	}
+	buf := make([]byte, 8)
+	if _, err := f.ReadAt(buf, size-8); err != nil {
+		return nil, err
+	}
+	count := binary.LittleEndian.Uint64(buf)
+	if count == 0 || count > MaxEraBatchSize {
+		return nil, fmt.Errorf("%w: block count %d", errInvalidEra, count)
+	}
+	index := size - int64(entryHeaderSize+16+8*count)
+	value, _, err := s.ReadTypeAt(TypeBlockIndex, index)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidEra, err)
+	}
+	e := &Era{
+		f:       f,
+		s:       s,
+		start:   binary.LittleEndian.Uint64(value),
+		offsets: make([]uint64, count),
+		root:    index - entryHeaderSize - common.HashLength,
+	}
+	for i := range e.offsets {
+		e.offsets[i] = binary.LittleEndian.Uint64(value[8+8*i:])
+	}
+	return e, nil
+}
+
+// Close closes the underlying file.
+func (e *Era) Close() error {
+	return e.f.Close()
+}
+
+// Start returns the number of the first block in the era.
+func (e *Era) Start() uint64 {
+	return e.start
+}
+
+// Count returns the number of blocks in the era.
+func (e *Era) Count() uint64 {
+	return uint64(len(e.offsets))
+}
+
+// Accumulator retrieves the accumulator root stored in the era.
+func (e *Era) Accumulator() (common.Hash, error) {
+	value, _, err := e.s.ReadTypeAt(TypeAccumulator, e.root)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
+
+// GetBlockByNumber retrieves the block with the given number from the era.
+func (e *Era) GetBlockByNumber(number uint64) (*types.Block, error) {
+	header, body, _, _, err := e.readTuple(number)
+	if err != nil {
+		return nil, err
+	}
+	var h types.Header
+	if err := rlp.DecodeBytes(header, &h); err != nil {
+		return nil, fmt.Errorf("invalid header #%d: %v", number, err)
+	}
+	var b types.Body
+	if err := rlp.DecodeBytes(body, &b); err != nil {
+		return nil, fmt.Errorf("invalid body #%d: %v", number, err)
+	}
+	return types.NewBlockWithHeader(&h).WithBody(b.Transactions, b.Uncles), nil
+}
+
+// GetRawReceiptsByNumber retrieves the receipts of the block with the given
+// number from the era, in their storage encoding.
+func (e *Era) GetRawReceiptsByNumber(number uint64) ([]byte, error) {
+	_, _, receipts, _, err := e.readTuple(number)
+	return receipts, err
+}
+
+// GetTotalDifficultyByNumber retrieves the total difficulty of the block with
+// the given number from the era.
+func (e *Era) GetTotalDifficultyByNumber(number uint64) (*big.Int, error) {
+	_, _, _, td, err := e.readTuple(number)
+	return td, err
+}
+
+// readTuple reads and decompresses the entries of the given block.
+func (e *Era) readTuple(number uint64) (header, body, receipts []byte, td *big.Int, err error) {
+	if number < e.start || number >= e.start+e.Count() {
+		return nil, nil, nil, nil, fmt.Errorf("block #%d out of era range [%d, %d)", number, e.start, e.start+e.Count())
+	}
+	off := int64(e.offsets[number-e.start])
+	for _, item := range []struct {
+		typ uint16
+		out *[]byte
+	}{
+		{TypeCompressedHeader, &header},
+		{TypeCompressedBody, &body},
+		{TypeCompressedReceipts, &receipts},
+	} {
+		value, n, err := e.s.ReadTypeAt(item.typ, off)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		if *item.out, err = snappy.Decode(nil, value); err != nil {
+			return nil, nil, nil, nil, fmt.Errorf("block #%d: %v", number, err)
+		}
+		off += n
+	}
+	value, _, err := e.s.ReadTypeAt(TypeTotalDifficulty, off)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return header, body, receipts, new(big.Int).SetBytes(value), nil
+}
+
+// ComputeAccumulator calculates the root of the binary keccak tree whose leaves
+// are the hashes of the blocks concatenated with their total difficulties.
+func ComputeAccumulator(hashes []common.Hash, tds []*big.Int) (common.Hash, error) {
+	if len(hashes) != len(tds) {
+		return common.Hash{}, fmt.Errorf("hash and total difficulty count mismatch: %d != %d", len(hashes), len(tds))
+	}
+	if len(hashes) == 0 {
+		return common.Hash{}, nil
+	}
+	nodes := make([]common.Hash, len(hashes))
+	for i := range hashes {
+		nodes[i] = crypto.Keccak256Hash(hashes[i][:], common.BigToHash(tds[i]).Bytes())
+	}
+	for len(nodes) > 1 {
+		next := make([]common.Hash, (len(nodes)+1)/2)
+		for i := range next {
+			if 2*i+1 < len(nodes) {
+				next[i] = crypto.Keccak256Hash(nodes[2*i][:], nodes[2*i+1][:])
+			} else {
+				next[i] = nodes[2*i]
+			}
+		}
+		nodes = next
+	}
+	return nodes[0], nil
+}
+
+// Filename returns the name of the era file of the given network and epoch,
+// suffixed with the prefix of its accumulator root.
+func Filename(network string, epoch int, root common.Hash) string {
+	return fmt.Sprintf("%s-%05d-%x.era", network, epoch, root[:4])
+}
+
+// ReadDir lists the era files of the given network in the directory, sorted by
+// epoch, ensuring that the epochs are consecutive.
+func ReadDir(dir, network string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		files  []string
+		epochs = make(map[string]int)
+	)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".era" || !strings.HasPrefix(name, network+"-") {
+			continue
+		}
+		parts := strings.Split(strings.TrimSuffix(name, ".era"), "-")
+		if len(parts) < 3 {
+			return nil, fmt.Errorf("malformed era filename: %s", name)
+		}
+		epoch, err := strconv.Atoi(parts[len(parts)-2])
+		if err != nil {
+			return nil, fmt.Errorf("malformed era filename %s: %v", name, err)
+		}
+		files, epochs[name] = append(files, name), epoch
+	}
+	sort.Slice(files, func(i, j int) bool { return epochs[files[i]] < epochs[files[j]] })
+	for i := 1; i < len(files); i++ {
+		if epochs[files[i]] != epochs[files[i-1]]+1 {
+			return nil, fmt.Errorf("missing era epoch %d", epochs[files[i-1]]+1)
+		}
+	}
+	return files, nil
+}
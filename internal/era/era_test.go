@@ -0,0 +1,117 @@
+package era
+
+import (
+	"bytes"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/types"
+)
+
+// Tests that blocks written into an era are read back along with their
+// receipts, total difficulties and accumulator.
+func TestEra(t *testing.T) {
+	var (
+		dir    = t.TempDir()
+		path   = filepath.Join(dir, "test.era")
+		start  = uint64(128)
+		blocks []*types.Block
+		hashes []common.Hash
+		tds    []*big.Int
+	)
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("failed to create era file: %v", err)
+	}
+	b := NewBuilder(f)
+	for i := uint64(0); i < 16; i++ {
+		header := &types.Header{
+			Number:     new(big.Int).SetUint64(start + i),
+			Difficulty: big.NewInt(1),
+			Extra:      bytes.Repeat([]byte{byte(i)}, 32),
+		}
+		block := types.NewBlockWithHeader(header)
+		td := big.NewInt(int64(start + i + 1))
+		receipts := types.Receipts{{Status: types.ReceiptStatusSuccessful, CumulativeGasUsed: i, Logs: []*types.Log{}}}
+		if err := b.Add(block, receipts, td); err != nil {
+			t.Fatalf("failed to add block #%d: %v", start+i, err)
+		}
+		blocks, hashes, tds = append(blocks, block), append(hashes, block.Hash()), append(tds, td)
+	}
+	if err := b.Add(blocks[0], nil, tds[0]); err == nil {
+		t.Fatalf("added non contiguous block")
+	}
+	root, err := b.Finalize()
+	if err != nil {
+		t.Fatalf("failed to finalize era: %v", err)
+	}
+	f.Close()
+
+	if want, _ := ComputeAccumulator(hashes, tds); root != want {
+		t.Fatalf("accumulator mismatch: have %x, want %x", root, want)
+	}
+	e, err := Open(path)
+	if err != nil {
+		t.Fatalf("failed to open era: %v", err)
+	}
+	defer e.Close()
+
+	if e.Start() != start || e.Count() != uint64(len(blocks)) {
+		t.Fatalf("era range mismatch: have [%d, +%d], want [%d, +%d]", e.Start(), e.Count(), start, len(blocks))
+	}
+	if have, err := e.Accumulator(); err != nil || have != root {
+		t.Fatalf("stored accumulator mismatch: have %x (%v), want %x", have, err, root)
+	}
+	for i, want := range blocks {
+		n := want.NumberU64()
+		have, err := e.GetBlockByNumber(n)
+		if err != nil {
+			t.Fatalf("failed to read block #%d: %v", n, err)
+		}
+		if have.Hash() != want.Hash() {
+			t.Fatalf("block #%d hash mismatch: have %x, want %x", n, have.Hash(), want.Hash())
+		}
+		td, err := e.GetTotalDifficultyByNumber(n)
+		if err != nil || td.Cmp(tds[i]) != 0 {
+			t.Fatalf("block #%d total difficulty mismatch: have %v (%v), want %v", n, td, err, tds[i])
+		}
+		if _, err := e.GetRawReceiptsByNumber(n); err != nil {
+			t.Fatalf("failed to read receipts #%d: %v", n, err)
+		}
+	}
+	if _, err := e.GetBlockByNumber(start + e.Count()); err == nil {
+		t.Fatalf("read block beyond the era")
+	}
+}
+
+// Tests that era files are listed in epoch order and gaps are detected.
+func TestReadDir(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		Filename("65111111", 1, common.Hash{0x01}),
+		Filename("65111111", 0, common.Hash{0x02}),
+		Filename("1", 0, common.Hash{0x03}),
+		"checksums.txt",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	files, err := ReadDir(dir, "65111111")
+	if err != nil {
+		t.Fatalf("failed to read era dir: %v", err)
+	}
+	want := []string{"65111111-00000-02000000.era", "65111111-00001-01000000.era"}
+	if len(files) != len(want) || files[0] != want[0] || files[1] != want[1] {
+		t.Fatalf("era files mismatch: have %v, want %v", files, want)
+	}
+	if err := os.WriteFile(filepath.Join(dir, Filename("65111111", 3, common.Hash{})), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadDir(dir, "65111111"); err == nil {
+		t.Fatalf("missing epoch not detected")
+	}
+}
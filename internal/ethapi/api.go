@@ -1592,9 +1592,17 @@ func (s *PublicTransactionPoolAPI) GetRawTransactionByHash(ctx context.Context,
 }
 
 // GetTransactionReceipt returns the transaction receipt for the given transaction hash.
+// The hash common.ACHash(number) returns the receipt of the finalize() protocol
+// call ending the block of the given number, see marshalFinalizeReceipt.
 func (s *PublicTransactionPoolAPI) GetTransactionReceipt(ctx context.Context, hash common.Hash) (map[string]interface{}, error) {
-	// TODO: special case for an autonity contract receipt
-	tx, blockHash, blockNumber, index, err := s.b.GetTransaction(ctx, hash)
+	if ok, number := common.IsACHash(hash); ok {
+		receipt, block, err := GetFinalizeReceipt(ctx, s.b, number)
+		if err != nil || receipt == nil {
+			return nil, err
+		}
+		return marshalFinalizeReceipt(receipt, block), nil
+	}
+	tx, blockHash, _, index, err := s.b.GetTransaction(ctx, hash)
 	if err != nil {
 		return nil, nil
 	}
@@ -1605,17 +1613,71 @@ func (s *PublicTransactionPoolAPI) GetTransactionReceipt(ctx context.Context, ha
 	if len(receipts) <= int(index) {
 		return nil, nil
 	}
-	receipt := receipts[index]
+	header, err := s.b.HeaderByHash(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	return marshalReceipt(receipts[index], header, tx, index, s.b.ChainConfig()), nil
+}
 
+// GetBlockReceipts returns the receipts of all the transactions of the given
+// block, followed by the receipt of its finalize() protocol call, flagged with
+// "finalize" set to true, if the block has one.
+func (s *PublicBlockChainAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	block, err := s.b.BlockByNumberOrHash(ctx, blockNrOrHash)
+	if block == nil || err != nil {
+		return nil, err
+	}
+	receipts, err := s.b.GetReceipts(ctx, block.Hash())
+	if err != nil {
+		return nil, err
+	}
+	txs := block.Transactions()
+	if len(receipts) != len(txs) && len(receipts) != len(txs)+1 {
+		return nil, fmt.Errorf("receipts length mismatch: %d vs %d", len(receipts), len(txs))
+	}
+	result := make([]map[string]interface{}, len(receipts))
+	for i, receipt := range receipts {
+		if i == len(txs) {
+			result[i] = marshalFinalizeReceipt(receipt, block)
+		} else {
+			result[i] = marshalReceipt(receipt, block.Header(), txs[i], uint64(i), s.b.ChainConfig())
+		}
+	}
+	return result, nil
+}
+
+// GetFinalizeReceipt returns the receipt of the finalize() protocol call ending the
+// canonical block of the given number, along with the block. The receipt is nil if
+// the block is unknown or has no finalize call, as the genesis block.
+func GetFinalizeReceipt(ctx context.Context, b Backend, number *big.Int) (*types.Receipt, *types.Block, error) {
+	if !number.IsInt64() {
+		return nil, nil, nil
+	}
+	block, err := b.BlockByNumber(ctx, rpc.BlockNumber(number.Int64()))
+	if block == nil || err != nil {
+		return nil, nil, err
+	}
+	receipts, err := b.GetReceipts(ctx, block.Hash())
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(receipts) != len(block.Transactions())+1 {
+		return nil, nil, nil
+	}
+	return receipts[len(receipts)-1], block, nil
+}
+
+// marshalReceipt converts the receipt of a transaction into its RPC representation.
+func marshalReceipt(receipt *types.Receipt, header *types.Header, tx *types.Transaction, index uint64, config *params.ChainConfig) map[string]interface{} {
 	// Derive the sender.
-	bigblock := new(big.Int).SetUint64(blockNumber)
-	signer := types.MakeSigner(s.b.ChainConfig(), bigblock)
+	signer := types.MakeSigner(config, header.Number)
 	from, _ := types.Sender(signer, tx)
 
 	fields := map[string]interface{}{
-		"blockHash":         blockHash,
-		"blockNumber":       hexutil.Uint64(blockNumber),
-		"transactionHash":   hash,
+		"blockHash":         header.Hash(),
+		"blockNumber":       hexutil.Uint64(header.Number.Uint64()),
+		"transactionHash":   tx.Hash(),
 		"transactionIndex":  hexutil.Uint64(index),
 		"from":              from,
 		"to":                tx.To(),
@@ -1627,13 +1689,9 @@ func (s *PublicTransactionPoolAPI) GetTransactionReceipt(ctx context.Context, ha
 		"type":              hexutil.Uint(tx.Type()),
 	}
 	// Assign the effective gas price paid
-	if !s.b.ChainConfig().IsLondon(bigblock) {
+	if !config.IsLondon(header.Number) {
 		fields["effectiveGasPrice"] = hexutil.Uint64(tx.GasPrice().Uint64())
 	} else {
-		header, err := s.b.HeaderByHash(ctx, blockHash)
-		if err != nil {
-			return nil, err
-		}
 		gasPrice := new(big.Int).Add(header.BaseFee, tx.EffectiveGasTipValue(header.BaseFee))
 		fields["effectiveGasPrice"] = hexutil.Uint64(gasPrice.Uint64())
 	}
@@ -1650,7 +1708,38 @@ func (s *PublicTransactionPoolAPI) GetTransactionReceipt(ctx context.Context, ha
 	if receipt.ContractAddress != (common.Address{}) {
 		fields["contractAddress"] = receipt.ContractAddress
 	}
-	return fields, nil
+	return fields
+}
+
+// marshalFinalizeReceipt converts the receipt of the finalize() protocol call of
+// a block into the RPC representation of a transaction receipt. The call has no
+// transaction: its hash is common.ACHash of the block number, its index follows
+// the transactions of the block, it is sent by the deployer account to the
+// Autonity contract, and uses no gas. Its logs are the events emitted by the
+// protocol contracts during the finalization, such as the rewards and the epoch
+// changes. The "finalize" field is set to true.
+func marshalFinalizeReceipt(receipt *types.Receipt, block *types.Block) map[string]interface{} {
+	fields := map[string]interface{}{
+		"blockHash":         block.Hash(),
+		"blockNumber":       hexutil.Uint64(block.NumberU64()),
+		"transactionHash":   common.ACHash(block.Number()),
+		"transactionIndex":  hexutil.Uint64(len(block.Transactions())),
+		"from":              params.DeployerAddress,
+		"to":                params.AutonityContractAddress,
+		"gasUsed":           hexutil.Uint64(0),
+		"cumulativeGasUsed": hexutil.Uint64(receipt.CumulativeGasUsed),
+		"effectiveGasPrice": hexutil.Uint64(0),
+		"contractAddress":   nil,
+		"logs":              receipt.Logs,
+		"logsBloom":         receipt.Bloom,
+		"type":              hexutil.Uint(types.LegacyTxType),
+		"status":            hexutil.Uint(receipt.Status),
+		"finalize":          true,
+	}
+	if receipt.Logs == nil {
+		fields["logs"] = []*types.Log{}
+	}
+	return fields
 }
 
 // sign is a helper function that signs a transaction with the private key of the given address.
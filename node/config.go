@@ -71,6 +71,11 @@ type Config struct {
 	// in memory.
 	DataDir string
 
+	// DBEngine is the key-value database engine backing the node databases, either
+	// "leveldb" or "pebble". If empty, the engine of an existing database is used,
+	// leveldb otherwise.
+	DBEngine string `toml:",omitempty"`
+
 	// Configuration of peer-to-peer networking.
 	ExecutionP2P p2p.Config
 
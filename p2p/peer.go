@@ -120,6 +120,10 @@ type Peer struct {
 	// events receives message send / receive events if set
 	events   *event.Feed
 	testPipe *MsgPipeRW // for testing
+
+	// round trip time measurement, updated by the ping loop (atomic)
+	pingSent int64 // unix nano timestamp of the last unanswered ping
+	rtt      int64 // last measured round trip time in nanoseconds
 }
 
 // NewPeer returns a peer for testing purposes.
@@ -231,6 +235,12 @@ func (p *Peer) Inbound() bool {
 	return p.rw.is(inboundConn)
 }
 
+// RTT returns the last measured round trip time of the base protocol ping,
+// or zero if no pong has been received yet.
+func (p *Peer) RTT() time.Duration {
+	return time.Duration(atomic.LoadInt64(&p.rtt))
+}
+
 func newPeer(log log.Logger, conn *conn, protocols []Protocol) *Peer {
 	protomap := matchProtocols(protocols, conn.caps, conn)
 	p := &Peer{
@@ -306,6 +316,7 @@ func (p *Peer) pingLoop() {
 	for {
 		select {
 		case <-ping.C:
+			atomic.StoreInt64(&p.pingSent, time.Now().UnixNano())
 			if err := SendItems(p.rw, pingMsg); err != nil {
 				p.protoErr <- err
 				return
@@ -338,6 +349,11 @@ func (p *Peer) handle(msg Msg) error {
 	case msg.Code == pingMsg:
 		msg.Discard()
 		go SendItems(p.rw, pongMsg)
+	case msg.Code == pongMsg:
+		msg.Discard()
+		if sent := atomic.SwapInt64(&p.pingSent, 0); sent != 0 {
+			atomic.StoreInt64(&p.rtt, msg.ReceivedAt.UnixNano()-sent)
+		}
 	case msg.Code == discMsg:
 		var reason [1]DiscReason
 		// This is the last message. We don't need to discard or
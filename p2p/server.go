@@ -340,6 +340,19 @@ func (srv *Server) Peers() []*Peer {
 	return ps
 }
 
+// PeerRTT returns the last measured round trip time to the given connected
+// peer. The boolean result is false if the peer is not connected or if no
+// measurement is available yet.
+func (srv *Server) PeerRTT(id enode.ID) (time.Duration, bool) {
+	var rtt time.Duration
+	srv.doPeerOp(func(peers map[enode.ID]*Peer) {
+		if p, ok := peers[id]; ok {
+			rtt = p.RTT()
+		}
+	})
+	return rtt, rtt > 0
+}
+
 // PeerCount returns the number of connected peers.
 func (srv *Server) PeerCount() int {
 	var count int
@@ -454,7 +454,7 @@ var (
 	//
 	// This configuration is intentionally not using keyed fields to force anyone
 	// adding flags to the config to also have to set these fields.
-	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, nil, new(EthashConfig), nil, nil, nil, AsmConfig{}, false}
+	AllEthashProtocolChanges = &ChainConfig{big.NewInt(1337), big.NewInt(0), nil, false, big.NewInt(0), common.Hash{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, nil, nil, nil, nil, new(EthashConfig), nil, nil, nil, nil, AsmConfig{}, nil, nil, nil, nil, false}
 
 	TestNodeKeys = []string{
 		"b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291",
@@ -508,11 +508,16 @@ var (
 		TestAutonityContractConfig,
 		DefaultAccountabilityConfig,
 		DefaultGenesisOracleConfig,
+		nil,
 		AsmConfig{
 			ACUContractConfig:           DefaultAcuContractGenesis,
 			StabilizationContractConfig: DefaultStabilizationGenesis,
 			SupplyControlConfig:         DefaultSupplyControlGenesis,
 		},
+		nil,
+		nil,
+		big.NewInt(0),
+		big.NewInt(0),
 		false,
 	}
 )
@@ -623,9 +628,27 @@ type ChainConfig struct {
 	AutonityContractConfig *AutonityContractGenesis `json:"autonity,omitempty"`
 	AccountabilityConfig   *AccountabilityGenesis   `json:"accountability,omitempty"`
 	OracleContractConfig   *OracleContractGenesis   `json:"oracle,omitempty"`
+	GovernanceConfig       *GovernanceGenesis       `json:"governance,omitempty"`
 
 	ASM AsmConfig `json:"asm,omitempty"`
 
+	// CommitteeSelection schedules the algorithms used by the CommitteeSelector
+	// precompile to choose the committee among the validators, by ascending
+	// block. The top stake algorithm is used before the first fork.
+	CommitteeSelection []*CommitteeSelectionFork `json:"committeeSelection,omitempty"`
+
+	// RandomBeaconBlock switches the proposer election to the random beacon
+	// signed by the proposers in the headers (nil = no fork, 0 = already activated)
+	RandomBeaconBlock *big.Int `json:"randomBeaconBlock,omitempty"`
+
+	// ConsensusEndpointsBlock enables the registry of the consensus endpoints of
+	// the validators running behind sentry nodes (nil = no fork, 0 = already activated)
+	ConsensusEndpointsBlock *big.Int `json:"consensusEndpointsBlock,omitempty"`
+
+	// BLSSignaturesBlock enables the precompiles verifying and aggregating BLS
+	// signatures and public keys (nil = no fork, 0 = already activated)
+	BLSSignaturesBlock *big.Int `json:"blsSignaturesBlock,omitempty"`
+
 	// true if run in testmode, false by default
 	TestMode bool `json:"testMode,omitempty"`
 }
@@ -644,6 +667,47 @@ func (c *EthashConfig) String() string {
 	return "ethash"
 }
 
+// Committee selection algorithms of the CommitteeSelector precompile.
+const (
+	// TopStakeCommitteeSelection picks the validators with the highest bonded
+	// stake, their voting power being their bonded stake.
+	TopStakeCommitteeSelection = "topStake"
+	// WeightedRandomCommitteeSelection samples the validators without
+	// replacement with a probability proportional to their bonded stake,
+	// seeded by the hash of the previous block.
+	WeightedRandomCommitteeSelection = "weightedRandom"
+	// CappedVotingPowerCommitteeSelection picks the validators with the highest
+	// bonded stake and caps their voting power to MaxVotingPowerShare of the
+	// committee voting power.
+	CappedVotingPowerCommitteeSelection = "cappedVotingPower"
+)
+
+// VotingPowerSharePrecision is the precision of MaxVotingPowerShare, in basis
+// points.
+const VotingPowerSharePrecision = 10000
+
+// CommitteeSelectionFork switches the committee selection algorithm from the
+// given block on.
+type CommitteeSelectionFork struct {
+	Block     *big.Int `json:"block"`
+	Algorithm string   `json:"algorithm"`
+	// MaxVotingPowerShare is the maximum share of the committee voting power of
+	// a member in basis points, used by the capped voting power algorithm.
+	MaxVotingPowerShare uint64 `json:"maxVotingPowerShare,omitempty"`
+}
+
+// topStakeCommitteeSelection is the committee selection in effect before any
+// fork is scheduled.
+var topStakeCommitteeSelection = &CommitteeSelectionFork{
+	Block:     common.Big0,
+	Algorithm: TopStakeCommitteeSelection,
+}
+
+func (f *CommitteeSelectionFork) equal(other *CommitteeSelectionFork) bool {
+	return other != nil && configNumEqual(f.Block, other.Block) &&
+		f.Algorithm == other.Algorithm && f.MaxVotingPowerShare == other.MaxVotingPowerShare
+}
+
 // String implements the fmt.Stringer interface.
 func (c *ChainConfig) String() string {
 	var engine interface{}
@@ -741,6 +805,34 @@ func (c *ChainConfig) IsArrowGlacier(num *big.Int) bool {
 	return isForked(c.ArrowGlacierBlock, num)
 }
 
+// IsRandomBeacon returns whether num is either equal to the random beacon fork block or greater.
+func (c *ChainConfig) IsRandomBeacon(num *big.Int) bool {
+	return isForked(c.RandomBeaconBlock, num)
+}
+
+// IsConsensusEndpoints returns whether num is either equal to the consensus endpoints fork block or greater.
+func (c *ChainConfig) IsConsensusEndpoints(num *big.Int) bool {
+	return isForked(c.ConsensusEndpointsBlock, num)
+}
+
+// IsBLSSignatures returns whether num is either equal to the BLS signatures fork block or greater.
+func (c *ChainConfig) IsBLSSignatures(num *big.Int) bool {
+	return isForked(c.BLSSignaturesBlock, num)
+}
+
+// CommitteeSelectionAt returns the committee selection in effect at the given
+// block.
+func (c *ChainConfig) CommitteeSelectionAt(num *big.Int) *CommitteeSelectionFork {
+	selection := topStakeCommitteeSelection
+	for _, fork := range c.CommitteeSelection {
+		if !isForked(fork.Block, num) {
+			break
+		}
+		selection = fork
+	}
+	return selection
+}
+
 // CheckCompatible checks whether scheduled fork transitions have been imported
 // with a mismatching chain configuration.
 func (c *ChainConfig) CheckCompatible(newcfg *ChainConfig, height uint64) *ConfigCompatError {
@@ -802,6 +894,31 @@ func (c *ChainConfig) CheckConfigForkOrder() error {
 			lastFork = cur
 		}
 	}
+	return c.checkCommitteeSelection()
+}
+
+// checkCommitteeSelection checks that the committee selection forks are
+// ordered and use a known algorithm.
+func (c *ChainConfig) checkCommitteeSelection() error {
+	var last *big.Int
+	for i, fork := range c.CommitteeSelection {
+		if fork == nil || fork.Block == nil {
+			return fmt.Errorf("committee selection fork %d has no block", i)
+		}
+		if last != nil && last.Cmp(fork.Block) >= 0 {
+			return fmt.Errorf("unsupported committee selection fork ordering: fork %d at %v, previous one at %v", i, fork.Block, last)
+		}
+		last = fork.Block
+		switch fork.Algorithm {
+		case TopStakeCommitteeSelection, WeightedRandomCommitteeSelection:
+		case CappedVotingPowerCommitteeSelection:
+			if fork.MaxVotingPowerShare == 0 || fork.MaxVotingPowerShare > VotingPowerSharePrecision {
+				return fmt.Errorf("committee selection fork %d: invalid max voting power share %d", i, fork.MaxVotingPowerShare)
+			}
+		default:
+			return fmt.Errorf("committee selection fork %d: unknown algorithm %q", i, fork.Algorithm)
+		}
+	}
 	return nil
 }
 
@@ -858,6 +975,28 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *Confi
 	if isForkIncompatible(c.MergeForkBlock, newcfg.MergeForkBlock, head) {
 		return newCompatError("Merge Start fork block", c.MergeForkBlock, newcfg.MergeForkBlock)
 	}
+	if isForkIncompatible(c.RandomBeaconBlock, newcfg.RandomBeaconBlock, head) {
+		return newCompatError("Random beacon fork block", c.RandomBeaconBlock, newcfg.RandomBeaconBlock)
+	}
+	if isForkIncompatible(c.ConsensusEndpointsBlock, newcfg.ConsensusEndpointsBlock, head) {
+		return newCompatError("Consensus endpoints fork block", c.ConsensusEndpointsBlock, newcfg.ConsensusEndpointsBlock)
+	}
+	if isForkIncompatible(c.BLSSignaturesBlock, newcfg.BLSSignaturesBlock, head) {
+		return newCompatError("BLS signatures fork block", c.BLSSignaturesBlock, newcfg.BLSSignaturesBlock)
+	}
+	for i := 0; i < len(c.CommitteeSelection) || i < len(newcfg.CommitteeSelection); i++ {
+		var stored, updated *CommitteeSelectionFork
+		var storedBlock, updatedBlock *big.Int
+		if i < len(c.CommitteeSelection) {
+			stored, storedBlock = c.CommitteeSelection[i], c.CommitteeSelection[i].Block
+		}
+		if i < len(newcfg.CommitteeSelection) {
+			updated, updatedBlock = newcfg.CommitteeSelection[i], newcfg.CommitteeSelection[i].Block
+		}
+		if isForkIncompatible(storedBlock, updatedBlock, head) || isForked(storedBlock, head) && !stored.equal(updated) {
+			return newCompatError("committee selection fork block", storedBlock, updatedBlock)
+		}
+	}
 	return nil
 }
 
@@ -875,6 +1014,9 @@ func (c *ChainConfig) Copy() *ChainConfig {
 	if c.OracleContractConfig != nil {
 		cfg.OracleContractConfig = c.OracleContractConfig
 	}
+	if c.GovernanceConfig != nil {
+		cfg.GovernanceConfig = c.GovernanceConfig
+	}
 	if c.ChainID != nil {
 		cfg.ChainID = big.NewInt(0).Set(c.ChainID)
 	}
@@ -902,6 +1044,22 @@ func (c *ChainConfig) Copy() *ChainConfig {
 	if c.PetersburgBlock != nil {
 		cfg.PetersburgBlock = big.NewInt(0).Set(c.PetersburgBlock)
 	}
+	if c.RandomBeaconBlock != nil {
+		cfg.RandomBeaconBlock = big.NewInt(0).Set(c.RandomBeaconBlock)
+	}
+	if c.ConsensusEndpointsBlock != nil {
+		cfg.ConsensusEndpointsBlock = big.NewInt(0).Set(c.ConsensusEndpointsBlock)
+	}
+	if c.BLSSignaturesBlock != nil {
+		cfg.BLSSignaturesBlock = big.NewInt(0).Set(c.BLSSignaturesBlock)
+	}
+	for _, fork := range c.CommitteeSelection {
+		forkCopy := *fork
+		if fork.Block != nil {
+			forkCopy.Block = new(big.Int).Set(fork.Block)
+		}
+		cfg.CommitteeSelection = append(cfg.CommitteeSelection, &forkCopy)
+	}
 	return cfg
 }
 
@@ -971,6 +1129,7 @@ type Rules struct {
 	IsByzantium, IsConstantinople, IsPetersburg, IsIstanbul bool
 	IsBerlin, IsLondon                                      bool
 	IsMerge                                                 bool
+	IsRandomBeacon, IsConsensusEndpoints, IsBLSSignatures   bool
 }
 
 // Rules ensures c's ChainID is not nil.
@@ -992,5 +1151,9 @@ func (c *ChainConfig) Rules(num *big.Int, isMerge bool) Rules {
 		IsBerlin:         c.IsBerlin(num),
 		IsLondon:         c.IsLondon(num),
 		IsMerge:          isMerge,
+
+		IsRandomBeacon:       c.IsRandomBeacon(num),
+		IsConsensusEndpoints: c.IsConsensusEndpoints(num),
+		IsBLSSignatures:      c.IsBLSSignatures(num),
 	}
 }
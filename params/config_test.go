@@ -96,3 +96,58 @@ func TestCheckCompatible(t *testing.T) {
 		}
 	}
 }
+
+func TestCommitteeSelection(t *testing.T) {
+	config := &ChainConfig{CommitteeSelection: []*CommitteeSelectionFork{
+		{Block: big.NewInt(10), Algorithm: WeightedRandomCommitteeSelection},
+		{Block: big.NewInt(20), Algorithm: CappedVotingPowerCommitteeSelection, MaxVotingPowerShare: 1000},
+	}}
+	if err := config.checkCommitteeSelection(); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		block     int64
+		algorithm string
+	}{
+		{0, TopStakeCommitteeSelection},
+		{9, TopStakeCommitteeSelection},
+		{10, WeightedRandomCommitteeSelection},
+		{19, WeightedRandomCommitteeSelection},
+		{20, CappedVotingPowerCommitteeSelection},
+		{1000, CappedVotingPowerCommitteeSelection},
+	} {
+		if got := config.CommitteeSelectionAt(big.NewInt(test.block)).Algorithm; got != test.algorithm {
+			t.Errorf("block %d: algorithm mismatch: have %s, want %s", test.block, got, test.algorithm)
+		}
+	}
+
+	for _, invalid := range [][]*CommitteeSelectionFork{
+		{{Algorithm: TopStakeCommitteeSelection}},
+		{{Block: big.NewInt(10), Algorithm: "random"}},
+		{{Block: big.NewInt(10), Algorithm: CappedVotingPowerCommitteeSelection}},
+		{{Block: big.NewInt(10), Algorithm: CappedVotingPowerCommitteeSelection, MaxVotingPowerShare: 10001}},
+		{
+			{Block: big.NewInt(10), Algorithm: WeightedRandomCommitteeSelection},
+			{Block: big.NewInt(10), Algorithm: TopStakeCommitteeSelection},
+		},
+	} {
+		if err := (&ChainConfig{CommitteeSelection: invalid}).checkCommitteeSelection(); err == nil {
+			t.Errorf("no error for invalid committee selection %v", invalid)
+		}
+	}
+
+	// rescheduling a fork is only allowed before it is reached
+	rescheduled := config.Copy()
+	rescheduled.CommitteeSelection[1].Block = big.NewInt(30)
+	if err := config.CheckCompatible(rescheduled, 15); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := config.CheckCompatible(rescheduled, 25); err == nil || err.RewindTo != 19 {
+		t.Errorf("wrong error: %v", err)
+	}
+	rescheduled.CommitteeSelection[0].Algorithm = CappedVotingPowerCommitteeSelection
+	rescheduled.CommitteeSelection[0].MaxVotingPowerShare = 1000
+	if err := config.CheckCompatible(rescheduled, 15); err == nil || err.RewindTo != 9 {
+		t.Errorf("wrong error: %v", err)
+	}
+}
@@ -4,7 +4,7 @@ import "strings"
 import "github.com/autonity/autonity/accounts/abi"
 import "github.com/autonity/autonity/common"
 
-var AccountabilityBytecode = common.Hex2Bytes("608060405260006011553480156200001657600080fd5b5060405162004436380380620044368339810160408190526200003991620000f7565b600180546001600160a01b0319166001600160a01b03841690811790915560408051636fd8d26960e11b8152905163dfb1a4d2916004808201926020929091908290030181865afa15801562000093573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620000b99190620001bf565b6000558051600355602081015160045560408101516005556060810151600655608081015160075560a081015160085560c0015160095550620001d9565b6000808284036101008112156200010d57600080fd5b83516001600160a01b03811681146200012557600080fd5b925060e0601f19820112156200013a57600080fd5b5060405160e081016001600160401b03811182821017156200016c57634e487b7160e01b600052604160045260246000fd5b80604052506020840151815260408401516020820152606084015160408201526080840151606082015260a0840151608082015260c084015160a082015260e084015160c0820152809150509250929050565b600060208284031215620001d257600080fd5b5051919050565b61424d80620001e96000396000f3fe6080604052600436106100d25760003560e01c806379502c551161007f578063b5b7a18411610059578063b5b7a184146102af578063bebaa8fc146102d3578063c50d21f014610300578063e7bb0b521461032057600080fd5b806379502c55146101e75780637ccecadd1461024b5780639cb22b061461028257600080fd5b80634108a95a116100b05780634108a95a146101775780636b5f444c146101a75780636c9789b0146101c757600080fd5b806301567739146100d75780630b7914301461012a5780631de9d9b614610162575b600080fd5b3480156100e357600080fd5b5061010d6100f23660046135f3565b600a602052600090815260409020546001600160a01b031681565b6040516001600160a01b0390911681526020015b60405180910390f35b34801561013657600080fd5b5061014a610145366004613617565b610358565b6040516101219c9b9a999897969594939291906136d3565b6101756101703660046135f3565b61046c565b005b34801561018357600080fd5b5061019761019236600461376e565b6106fd565b6040519015158152602001610121565b3480156101b357600080fd5b506101756101c2366004613617565b6107c6565b3480156101d357600080fd5b506101756101e23660046137ac565b61084a565b3480156101f357600080fd5b506003546004546005546006546007546008546009546102169695949392919087565b604080519788526020880196909652948601939093526060850191909152608084015260a083015260c082015260e001610121565b34801561025757600080fd5b5061026b61026636600461376e565b6108e2565b604080519215158352602083019190915201610121565b34801561028e57600080fd5b506102a261029d3660046135f3565b610a50565b60405161012191906138a4565b3480156102bb57600080fd5b506102c560005481565b604051908152602001610121565b3480156102df57600080fd5b506102f36102ee3660046135f3565b610cf6565b60405161012191906138b7565b34801561030c57600080fd5b5061017561031b366004613a83565b611004565b34801561032c57600080fd5b506102c561033b366004613b8f565b600e60209081526000928352604080842090915290825290205481565b6002818154811061036857600080fd5b600091825260209091206008909102018054600182015460028301805460ff8085169650610100850481169562010000860482169563010000008104909216946001600160a01b036401000000009093048316949216929091906103cb90613bbb565b80601f01602080910402602001604051908101604052809291908181526020018280546103f790613bbb565b80156104445780601f1061041957610100808354040283529160200191610444565b820191906000526020600020905b81548152906001019060200180831161042757829003601f168201915b505050505090806003015490806004015490806005015490806006015490806007015490508c565b6001546001600160a01b031633146104f05760405162461bcd60e51b8152602060048201526024808201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460448201527f61746f720000000000000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b6001546001600160a01b038281166000908152600a60205260408082205490517f1904bb2e000000000000000000000000000000000000000000000000000000008152908316600482015290929190911690631904bb2e90602401600060405180830381865afa158015610568573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526105909190810190613c75565b516040519091506000906001600160a01b038316906108fc90349084818181858888f193505050503d80600081146105e4576040519150601f19603f3d011682016040523d82523d6000602084013e6105e9565b606091505b50509050806106bc57600160009054906101000a90046001600160a01b03166001600160a01b031663f7866ee36040518163ffffffff1660e01b8152600401602060405180830381865afa158015610645573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906106699190613df3565b6001600160a01b03163460405160006040518083038185875af1925050503d80600081146106b3576040519150601f19603f3d011682016040523d82523d6000602084013e6106b8565b606091505b5050505b50506001600160a01b03166000908152600a6020526040902080547fffffffffffffffffffffffff0000000000000000000000000000000000000000169055565b6000806107098461139b565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018690529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa158015610771573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906107959190613e10565b6001600160a01b0387166000908152600e602090815260408083209383529290522054919091109150509392505050565b6001546001600160a01b031633146108455760405162461bcd60e51b8152602060048201526024808201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460448201527f61746f720000000000000000000000000000000000000000000000000000000060648201526084016104e7565b600055565b6001546001600160a01b031633146108c95760405162461bcd60e51b8152602060048201526024808201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460448201527f61746f720000000000000000000000000000000000000000000000000000000060648201526084016104e7565b6108d1611406565b80156108df576108df61175f565b50565b60008060006108f08561139b565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018790529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa158015610958573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061097c9190613e10565b6001600160a01b0388166000908152600e6020908152604080832084845290915290205490915082116109b6576000935060009250610a46565b6001600160a01b0387166000908152600c602052604090205415610a3d576001600160a01b0387166000908152600c60205260408120546002906109fc90600190613e58565b81548110610a0c57610a0c613e6b565b90600052602060002090600802019050600094506003600001548160040154610a359190613e9a565b935050610a46565b60019350600092505b5050935093915050565b610ac66040805161018081018252600080825260208201819052909182019081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b6001600160a01b0382166000908152600c6020526040902054610b2b5760405162461bcd60e51b815260206004820152600d60248201527f6e6f2061636375736174696f6e0000000000000000000000000000000000000060448201526064016104e7565b6001600160a01b0382166000908152600c6020526040902054600290610b5390600190613e58565b81548110610b6357610b63613e6b565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115610bb857610bb8613630565b6002811115610bc957610bc9613630565b815281546020909101906301000000900460ff166009811115610bee57610bee613630565b6009811115610bff57610bff613630565b815281546001600160a01b03640100000000909104811660208301526001830154166040820152600282018054606090920191610c3b90613bbb565b80601f0160208091040260200160405190810160405280929190818152602001828054610c6790613bbb565b8015610cb45780601f10610c8957610100808354040283529160200191610cb4565b820191906000526020600020905b815481529060010190602001808311610c9757829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509050919050565b6001600160a01b0381166000908152600b60205260408120546060919067ffffffffffffffff811115610d2b57610d2b613937565b604051908082528060200260200182016040528015610dd257816020015b610dbf6040805161018081018252600080825260208201819052909182019081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b815260200190600190039081610d495790505b50905060005b6001600160a01b0384166000908152600b6020526040902054811015610ffd576001600160a01b0384166000908152600b6020526040902080546002919083908110610e2657610e26613e6b565b906000526020600020015481548110610e4157610e41613e6b565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115610e9657610e96613630565b6002811115610ea757610ea7613630565b815281546020909101906301000000900460ff166009811115610ecc57610ecc613630565b6009811115610edd57610edd613630565b815281546001600160a01b03640100000000909104811660208301526001830154166040820152600282018054606090920191610f1990613bbb565b80601f0160208091040260200160405190810160405280929190818152602001828054610f4590613bbb565b8015610f925780601f10610f6757610100808354040283529160200191610f92565b820191906000526020600020905b815481529060010190602001808311610f7557829003601f168201915b5050505050815260200160038201548152602001600482015481526020016005820154815260200160068201548152602001600782015481525050828281518110610fdf57610fdf613e6b565b60200260200101819052508080610ff590613ead565b915050610dd8565b5092915050565b6001546040517f1904bb2e0000000000000000000000000000000000000000000000000000000081523360048201526000916001600160a01b031690631904bb2e90602401600060405180830381865afa158015611066573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405261108e9190810190613c75565b60208101519091506001600160a01b031633146111135760405162461bcd60e51b815260206004820152602d60248201527f66756e6374696f6e207265737472696374656420746f2061207265676973746560448201527f7265642076616c696461746f720000000000000000000000000000000000000060648201526084016104e7565b60808201516001600160a01b0316331461116f5760405162461bcd60e51b815260206004820152601d60248201527f6576656e74207265706f72746572206d7573742062652063616c6c657200000060448201526064016104e7565b6001826000015160ff16111561132557600061118a83611a42565b90508061119657505050565b336000908152600d6020908152604091829020825161018081018452815460ff808216835261010082048116948301949094529093919291840191620100009091041660028111156111ea576111ea613630565b60028111156111fb576111fb613630565b815281546020909101906301000000900460ff16600981111561122057611220613630565b600981111561123157611231613630565b815281546001600160a01b0364010000000090910481166020830152600183015416604082015260028201805460609092019161126d90613bbb565b80601f016020809104026020016040519081016040528092919081815260200182805461129990613bbb565b80156112e65780601f106112bb576101008083540402835291602001916112e6565b820191906000526020600020905b8154815290600101906020018083116112c957829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509250505b60008260400151600281111561133d5761133d613630565b0361134f5761134b82611cfc565b5050565b60018260400151600281111561136757611367613630565b036113755761134b82611f8c565b60028260400151600281111561138d5761138d613630565b0361134b5761134b82612174565b600060098260098111156113b1576113b1613630565b036113bf5760025b92915050565b60008260098111156113d3576113d3613630565b036113df5760026113b9565b60018260098111156113f3576113f3613630565b036113ff5760026113b9565b60026113b9565b6011545b60105481101561175a5760006010828154811061142957611429613e6b565b90600052602060002001549050806000036114445750611748565b61144f600182613e58565b905060006002828154811061146657611466613e6b565b600091825260209182902060408051610180810182526008909302909101805460ff8082168552610100820481169585019590955292939092918401916201000090041660028111156114bb576114bb613630565b60028111156114cc576114cc613630565b815281546020909101906301000000900460ff1660098111156114f1576114f1613630565b600981111561150257611502613630565b815281546001600160a01b0364010000000090910481166020830152600183015416604082015260028201805460609092019161153e90613bbb565b80601f016020809104026020016040519081016040528092919081815260200182805461156a90613bbb565b80156115b75780601f1061158c576101008083540402835291602001916115b7565b820191906000526020600020905b81548152906001019060200180831161159a57829003601f168201915b505050505081526020016003820154815260200160048201548152602001600582015481526020016006820154815260200160078201548152505090504360036000015482610140015161160b9190613e9a565b1115611618575050601155565b60a08101516001600160a01b03166000908152600c6020526040812081905560608201516116459061139b565b60a08301516001600160a01b03166000908152600e602090815260408083206101208701518452909152902054909150811161168357505050611748565b60a0820180516001600160a01b039081166000908152600e6020908152604080832061012088015184528252808320869055845184168352600b825280832080546001808201835591855283852001899055600f805491820181559093527f8d1108e10bcb7c27dddfc02ed9d693a074039d026cf4ea4240b40f7d581ac80290920187905592518151858152938401879052909116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f910160405180910390a25050505b8061175281613ead565b91505061140a565b601155565b600080600160009054906101000a90046001600160a01b03166001600160a01b031663c9d97af46040518163ffffffff1660e01b8152600401602060405180830381865afa1580156117b5573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906117d99190613e10565b905060005b600f5481101561185057816002600f83815481106117fe576117fe613e6b565b90600052602060002001548154811061181957611819613e6b565b9060005260206000209060080201600501540361183e5761183b600184613e9a565b92505b8061184881613ead565b9150506117de565b5060005b600f54811015611a3557611a236002600f838154811061187657611876613e6b565b90600052602060002001548154811061189157611891613e6b565b600091825260209182902060408051610180810182526008909302909101805460ff8082168552610100820481169585019590955292939092918401916201000090041660028111156118e6576118e6613630565b60028111156118f7576118f7613630565b815281546020909101906301000000900460ff16600981111561191c5761191c613630565b600981111561192d5761192d613630565b815281546001600160a01b0364010000000090910481166020830152600183015416604082015260028201805460609092019161196990613bbb565b80601f016020809104026020016040519081016040528092919081815260200182805461199590613bbb565b80156119e25780601f106119b7576101008083540402835291602001916119e2565b820191906000526020600020905b8154815290600101906020018083116119c557829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250508461231b565b80611a2d81613ead565b915050611854565b5061134b600f6000613579565b6000816020015160ff16600003611bf857336000908152600d6020908152604091829020845181549286015160ff908116610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009094169116179190911780825591840151849282907fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff1662010000836002811115611ae557611ae5613630565b02179055506060820151815482907fffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffffff166301000000836009811115611b2c57611b2c613630565b0217905550608082015181547fffffffffffffffff0000000000000000000000000000000000000000ffffffff166401000000006001600160a01b039283160217825560a08301516001830180547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905560c08201516002820190611bb79082613f30565b5060e0820151600382015561010082015160048201556101208201516005820155610140820151600682015561016090910151600790910155506000919050565b602080830151336000908152600d90925260409091205460ff91821691611c2691610100900416600161402c565b60ff1614611c765760405162461bcd60e51b815260206004820152601960248201527f6368756e6b73206d75737420626520636f6e746967756f75730000000000000060448201526064016104e7565b336000908152600d6020526040902060c0830151611c979160020190612918565b336000908152600d602052604090208054600191908290611cc1908290610100900460ff1661402c565b92506101000a81548160ff021916908360ff160217905550816000015160ff1682602001516001611cf2919061402c565b60ff161492915050565b6000806000806000611d1360fe8760c00151612a80565b9450945094509450945084611d6a5760405162461bcd60e51b815260206004820152601960248201527f6661696c65642070726f6f6620766572696669636174696f6e0000000000000060448201526064016104e7565b8560a001516001600160a01b0316846001600160a01b031614611dcf5760405162461bcd60e51b815260206004820152601160248201527f6f6666656e646572206d69736d6174636800000000000000000000000000000060448201526064016104e7565b85606001516009811115611de557611de5613630565b8314611e335760405162461bcd60e51b815260206004820152601060248201527f72756c65206964206d69736d617463680000000000000000000000000000000060448201526064016104e7565b438210611e825760405162461bcd60e51b815260206004820152601660248201527f63616e277420626520696e20746865206675747572650000000000000000000060448201526064016104e7565b60008211611ed25760405162461bcd60e51b815260206004820152601360248201527f63616e27742062652061742067656e657369730000000000000000000000000060448201526064016104e7565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa158015611f35573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611f599190613e10565b610100880184905261012088018190524361014089015261016088018390529050611f8387612ae7565b50505050505050565b6000806000806000611fa360fc8760c00151612a80565b9450945094509450945084611ffa5760405162461bcd60e51b815260206004820152601e60248201527f6661696c65642061636375736174696f6e20766572696669636174696f6e000060448201526064016104e7565b8560a001516001600160a01b0316846001600160a01b03161461205f5760405162461bcd60e51b815260206004820152601160248201527f6f6666656e646572206d69736d6174636800000000000000000000000000000060448201526064016104e7565b8560600151600981111561207557612075613630565b83146120c35760405162461bcd60e51b815260206004820152601060248201527f72756c65206964206d69736d617463680000000000000000000000000000000060448201526064016104e7565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa158015612126573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061214a9190613e10565b610100880184905261012088018190524361014089015261016088018390529050611f8387612e41565b600080600080600061218b60fd8760c00151612a80565b94509450945094509450846121e25760405162461bcd60e51b815260206004820152601d60248201527f6661696c656420696e6e6f63656e636520766572696669636174696f6e00000060448201526064016104e7565b8560a001516001600160a01b0316846001600160a01b0316146122475760405162461bcd60e51b815260206004820152601160248201527f6f6666656e646572206d69736d6174636800000000000000000000000000000060448201526064016104e7565b8560600151600981111561225d5761225d613630565b83146122ab5760405162461bcd60e51b815260206004820152601060248201527f72756c65206964206d69736d617463680000000000000000000000000000000060448201526064016104e7565b4382106122fa5760405162461bcd60e51b815260206004820152601660248201527f63616e277420626520696e20746865206675747572650000000000000000000060448201526064016104e7565b61010086018290526101608601819052612313866131ce565b505050505050565b60015460a08301516040517f1904bb2e0000000000000000000000000000000000000000000000000000000081526001600160a01b0391821660048201526000929190911690631904bb2e90602401600060405180830381865afa158015612387573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526123af9190810190613c75565b608084015160a08501516001600160a01b039081166000908152600a6020526040902080547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905590506003816102600151600381111561241857612418613630565b0361242257505050565b6000612439612434856060015161139b565b613520565b610220830151600754919250906000906124539083614045565b6006546124609087614045565b61246a9085613e9a565b6124749190613e9a565b60095490915081111561248657506009545b60008461012001518560c001518660a001516124a29190613e9a565b6124ac9190613e9a565b6009549091506000906124bf8385614045565b6124c9919061405c565b90506000811180156124da57508181145b1561262357600060a087018190526101008701819052610120870181905260c08701526101e086018051829190612512908390613e9a565b905250610220860180516001919061252b908390613e9a565b905250600361026087015260006102008701526001546040517f35be16e00000000000000000000000000000000000000000000000000000000081526001600160a01b03909116906335be16e0906125879089906004016140a7565b600060405180830381600087803b1580156125a157600080fd5b505af11580156125b5573d6000803e3d6000fd5b5050505060208681015160e08a0151604080516001600160a01b03909316835292820184905260008284015260016060830152608082015290517f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f99181900360a00190a15050505050505050565b61012086015181908111612650578087610120018181516126449190613e58565b9052506000905061266b565b6101208701516126609082613e58565b600061012089015290505b80156126e85780876101000151106126b3578087610100018181516126909190613e58565b90525060a0870180518291906126a7908390613e58565b905250600090506126e8565b6101008701516126c39082613e58565b90508661010001518760a0018181516126dc9190613e58565b90525060006101008801525b60008111801561270b575060008760a001518860c001516127099190613e9a565b115b156127b75760008760a001518860c001516127269190613e9a565b60c08901516127359084614045565b61273f919061405c565b905060008860a001518960c001516127579190613e9a565b60a08a01516127669085614045565b612770919061405c565b9050818960c0018181516127849190613e58565b90525060a08901805182919061279b908390613e58565b9052506127a88183613e9a565b6127b29084613e58565b925050505b6127c18183613e58565b915081876101e0018181516127d69190613e9a565b90525061022087018051600191906127ef908390613e9a565b9052506000546102208801516008546128089190614045565b6128129190614045565b61281c9043613e9a565b61020088015260026102608801526001546040517f35be16e00000000000000000000000000000000000000000000000000000000081526001600160a01b03909116906335be16e090612873908a906004016140a7565b600060405180830381600087803b15801561288d57600080fd5b505af11580156128a1573d6000803e3d6000fd5b5050506020808901516102008a015160e08d0151604080516001600160a01b039094168452938301879052928201526000606082015260808101919091527f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f9915060a00160405180910390a1505050505050505050565b8154600260018083161561010002038216048251808201602081106020841001600281146129c257600181146129e7578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b808210156129ac578151845560018401935060208201915061298f565b815191036101000a908190040290915550611f83565b60028302826020036101000a846020036101000a602089015104020185018755611f83565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a039250828251167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff008a160184556020820191506001840193505b80821015612a665781518455600184019350602082019150612a49565b815191036101000a90819004029091555050505050505050565b60008060008060008086516020612a979190613e9a565b9050612aa1613597565b60a081838a8c5afa612ab257600080fd5b8051600103612ac057600196505b602081015160408201516060830151608090930151989b919a509850909695509350505050565b6000612af6826060015161139b565b60a08301516001600160a01b03166000908152600e6020908152604080832061012087015184529091529020549091508111612b995760405162461bcd60e51b8152602060048201526024808201527f616c726561647920736c6173686564206174207468652070726f6f662773206560448201527f706f63680000000000000000000000000000000000000000000000000000000060648201526084016104e7565b6002805460e084018190526001810182556000829052835160089091027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace018054602086015160ff908116610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009092169316929092179190911780825560408501518593909183917fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff9091169062010000908490811115612c5f57612c5f613630565b02179055506060820151815482907fffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffffff166301000000836009811115612ca657612ca6613630565b0217905550608082015181547fffffffffffffffff0000000000000000000000000000000000000000ffffffff166401000000006001600160a01b039283160217825560a08301516001830180547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905560c08201516002820190612d319082613f30565b5060e0828101516003830155610100830151600483015561012080840151600584015561014084015160068401556101609093015160079092019190915560a0840180516001600160a01b039081166000908152600b602090815260408083209589018051875460018181018a5598865284862001558051600f8054988901815585527f8d1108e10bcb7c27dddfc02ed9d693a074039d026cf4ea4240b40f7d581ac80290970196909655845184168352600e82528083209689015183529590528490208590559051915192519116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f91612e3591858252602082015260400190565b60405180910390a25050565b60a08101516001600160a01b03166000908152600c602052604090205415612eab5760405162461bcd60e51b815260206004820181905260248201527f616c72656164792070726f63657373696e6720616e2061636375736174696f6e60448201526064016104e7565b6000612eba826060015161139b565b60a08301516001600160a01b03166000908152600e6020908152604080832061012087015184529091529020549091508111612f5d5760405162461bcd60e51b8152602060048201526024808201527f616c726561647920736c6173686564206174207468652070726f6f662773206560448201527f706f63680000000000000000000000000000000000000000000000000000000060648201526084016104e7565b6002805460e084018190526001810182556000829052835160089091027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace018054602086015160ff908116610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009092169316929092179190911780825560408501518593909183917fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff909116906201000090849081111561302357613023613630565b02179055506060820151815482907fffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffffff16630100000083600981111561306a5761306a613630565b0217905550608082015181547fffffffffffffffff0000000000000000000000000000000000000000ffffffff166401000000006001600160a01b039283160217825560a08301516001830180547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905560c082015160028201906130f59082613f30565b5060e08281015160038301556101008301516004830155610120830151600583015561014083015160068301556101609092015160079091015582015161313d906001613e9a565b60a08301516001600160a01b03166000908152600c602052604090205560e082015160109061316d906001613e9a565b81546001810183556000928352602092839020015560a083015160e084015160408051858152938401919091526001600160a01b03909116917f2e8e354b41470731dafa7c3df150e9498a8d5b9c51ff0259fbf77f721ba403519101612e35565b60a08101516001600160a01b03166000908152600c60205260408120549081900361323b5760405162461bcd60e51b815260206004820152601860248201527f6e6f206173736f6369617465642061636375736174696f6e000000000000000060448201526064016104e7565b8160600151600981111561325157613251613630565b600261325e600184613e58565b8154811061326e5761326e613e6b565b60009182526020909120600890910201546301000000900460ff16600981111561329a5761329a613630565b1461330d5760405162461bcd60e51b815260206004820152602760248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e2060448201527f72756c652069640000000000000000000000000000000000000000000000000060648201526084016104e7565b6101008201516002613320600184613e58565b8154811061333057613330613e6b565b906000526020600020906008020160040154146133b55760405162461bcd60e51b815260206004820152602560248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e2060448201527f626c6f636b00000000000000000000000000000000000000000000000000000060648201526084016104e7565b61016082015160026133c8600184613e58565b815481106133d8576133d8613e6b565b9060005260206000209060080201600701541461345c5760405162461bcd60e51b8152602060048201526024808201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e2060448201527f686173680000000000000000000000000000000000000000000000000000000060648201526084016104e7565b6011545b6010548110156134c657816010828154811061347e5761347e613e6b565b9060005260206000200154036134b4576000601082815481106134a3576134a3613e6b565b6000918252602090912001556134c6565b806134be81613ead565b915050613460565b5060a0820180516001600160a01b039081166000908152600c602090815260408083208390559351935191825292909116917f1fa96beb8dddcb7d4484dd00c4059e872439f7a474a2ecf49c430fc6e86c9e1f9101612e35565b60008161352f57505060055490565b6001820361353f57505060055490565b6002820361354f57505060055490565b6003820361355f57505060055490565b600482036135705750612710919050565b50612710919050565b50805460008255906000526020600020908101906108df91906135b5565b6040518060a001604052806005906020820280368337509192915050565b5b808211156135ca57600081556001016135b6565b5090565b6001600160a01b03811681146108df57600080fd5b80356135ee816135ce565b919050565b60006020828403121561360557600080fd5b8135613610816135ce565b9392505050565b60006020828403121561362957600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6003811061366f5761366f613630565b9052565b600a811061366f5761366f613630565b60005b8381101561369e578181015183820152602001613686565b50506000910152565b600081518084526136bf816020860160208601613683565b601f01601f19169290920160200192915050565b600061018060ff8f16835260ff8e1660208401526136f4604084018e61365f565b613701606084018d613673565b6001600160a01b038b811660808501528a1660a084015260c0830181905261372b8184018a6136a7565b60e0840198909852505061010081019490945261012084019290925261014083015261016090910152979650505050505050565b8035600a81106135ee57600080fd5b60008060006060848603121561378357600080fd5b833561378e816135ce565b925061379c6020850161375f565b9150604084013590509250925092565b6000602082840312156137be57600080fd5b8135801515811461361057600080fd5b805160ff168252600061018060208301516137ee602086018260ff169052565b506040830151613801604086018261365f565b5060608301516138146060860182613673565b50608083015161382f60808601826001600160a01b03169052565b5060a083015161384a60a08601826001600160a01b03169052565b5060c08301518160c0860152613862828601826136a7565b60e08581015190870152610100808601519087015261012080860151908701526101408086015190870152610160948501519490950193909352509192915050565b60208152600061361060208301846137ce565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101561392a577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc08886030184526139188583516137ce565b945092850192908501906001016138de565b5092979650505050505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b604051610180810167ffffffffffffffff8111828210171561398a5761398a613937565b60405290565b604051610280810167ffffffffffffffff8111828210171561398a5761398a613937565b604051601f8201601f1916810167ffffffffffffffff811182821017156139dd576139dd613937565b604052919050565b803560ff811681146135ee57600080fd5b8035600381106135ee57600080fd5b600067ffffffffffffffff821115613a1f57613a1f613937565b50601f01601f191660200190565b600082601f830112613a3e57600080fd5b8135613a51613a4c82613a05565b6139b4565b818152846020838601011115613a6657600080fd5b816020850160208301376000918101602001919091529392505050565b600060208284031215613a9557600080fd5b813567ffffffffffffffff80821115613aad57600080fd5b908301906101808286031215613ac257600080fd5b613aca613966565b613ad3836139e5565b8152613ae1602084016139e5565b6020820152613af2604084016139f6565b6040820152613b036060840161375f565b6060820152613b14608084016135e3565b6080820152613b2560a084016135e3565b60a082015260c083013582811115613b3c57600080fd5b613b4887828601613a2d565b60c08301525060e083810135908201526101008084013590820152610120808401359082015261014080840135908201526101609283013592810192909252509392505050565b60008060408385031215613ba257600080fd5b8235613bad816135ce565b946020939093013593505050565b600181811c90821680613bcf57607f821691505b602082108103613c08577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b80516135ee816135ce565b600082601f830112613c2a57600080fd5b8151613c38613a4c82613a05565b818152846020838601011115613c4d57600080fd5b613c5e826020830160208701613683565b949350505050565b8051600481106135ee57600080fd5b600060208284031215613c8757600080fd5b815167ffffffffffffffff80821115613c9f57600080fd5b908301906102808286031215613cb457600080fd5b613cbc613990565b613cc583613c0e565b8152613cd360208401613c0e565b6020820152613ce460408401613c0e565b6040820152606083015182811115613cfb57600080fd5b613d0787828601613c19565b6060830152506080830151608082015260a083015160a082015260c083015160c082015260e083015160e0820152610100808401518183015250610120808401518183015250610140808401518183015250610160808401518183015250610180613d73818501613c0e565b908201526101a083810151908201526101c080840151908201526101e08084015190820152610200808401519082015261022080840151908201526102408084015183811115613dc257600080fd5b613dce88828701613c19565b8284015250506102609150613de4828401613c66565b91810191909152949350505050565b600060208284031215613e0557600080fd5b8151613610816135ce565b600060208284031215613e2257600080fd5b5051919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156113b9576113b9613e29565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b808201808211156113b9576113b9613e29565b60007fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8203613ede57613ede613e29565b5060010190565b601f821115613f2b57600081815260208120601f850160051c81016020861015613f0c5750805b601f850160051c820191505b8181101561231357828155600101613f18565b505050565b815167ffffffffffffffff811115613f4a57613f4a613937565b613f5e81613f588454613bbb565b84613ee5565b602080601f831160018114613fb15760008415613f7b5750858301515b7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff600386901b1c1916600185901b178555612313565b600085815260208120601f198616915b82811015613fe057888601518255948401946001909101908401613fc1565b508582101561401c57878501517fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff600388901b60f8161c191681555b5050505050600190811b01905550565b60ff81811683821601908111156113b9576113b9613e29565b80820281158282048414176113b9576113b9613e29565b600082614092577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b500490565b6004811061366f5761366f613630565b602081526140c16020820183516001600160a01b03169052565b600060208301516140dd60408401826001600160a01b03169052565b5060408301516001600160a01b038116606084015250606083015161028080608085015261410f6102a08501836136a7565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a0614196818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f1901610260808801919091529091506141f884836136a7565b93508087015191505061420d82860182614097565b509094935050505056fea2646970667358221220803e0d7e5a205c02f15a876228a4853b5c774cea53d8650e75a55e2509773b4264736f6c63430008150033")
+var AccountabilityBytecode = common.Hex2Bytes("608060405260006011553480156200001657600080fd5b5060405162004436380380620044368339810160408190526200003991620000f7565b600180546001600160a01b0319166001600160a01b03841690811790915560408051636fd8d26960e11b8152905163dfb1a4d2916004808201926020929091908290030181865afa15801562000093573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620000b99190620001bf565b6000558051600355602081015160045560408101516005556060810151600655608081015160075560a081015160085560c0015160095550620001d9565b6000808284036101008112156200010d57600080fd5b83516001600160a01b03811681146200012557600080fd5b925060e0601f19820112156200013a57600080fd5b5060405160e081016001600160401b03811182821017156200016c57634e487b7160e01b600052604160045260246000fd5b80604052506020840151815260408401516020820152606084015160408201526080840151606082015260a0840151608082015260c084015160a082015260e084015160c0820152809150509250929050565b600060208284031215620001d257600080fd5b5051919050565b61424d80620001e96000396000f3fe6080604052600436106100d25760003560e01c806379502c551161007f578063b5b7a18411610059578063b5b7a184146102af578063bebaa8fc146102d3578063c50d21f014610300578063e7bb0b521461032057600080fd5b806379502c55146101e75780637ccecadd1461024b5780639cb22b061461028257600080fd5b80634108a95a116100b05780634108a95a146101775780636b5f444c146101a75780636c9789b0146101c757600080fd5b806301567739146100d75780630b7914301461012a5780631de9d9b614610162575b600080fd5b3480156100e357600080fd5b5061010d6100f23660046135f3565b600a602052600090815260409020546001600160a01b031681565b6040516001600160a01b0390911681526020015b60405180910390f35b34801561013657600080fd5b5061014a610145366004613617565b610358565b6040516101219c9b9a999897969594939291906136d3565b6101756101703660046135f3565b61046c565b005b34801561018357600080fd5b5061019761019236600461376e565b6106fd565b6040519015158152602001610121565b3480156101b357600080fd5b506101756101c2366004613617565b6107c6565b3480156101d357600080fd5b506101756101e23660046137ac565b61084a565b3480156101f357600080fd5b506003546004546005546006546007546008546009546102169695949392919087565b604080519788526020880196909652948601939093526060850191909152608084015260a083015260c082015260e001610121565b34801561025757600080fd5b5061026b61026636600461376e565b6108e2565b604080519215158352602083019190915201610121565b34801561028e57600080fd5b506102a261029d3660046135f3565b610a50565b60405161012191906138a4565b3480156102bb57600080fd5b506102c560005481565b604051908152602001610121565b3480156102df57600080fd5b506102f36102ee3660046135f3565b610cf6565b60405161012191906138b7565b34801561030c57600080fd5b5061017561031b366004613a83565b611004565b34801561032c57600080fd5b506102c561033b366004613b8f565b600e60209081526000928352604080842090915290825290205481565b6002818154811061036857600080fd5b600091825260209091206008909102018054600182015460028301805460ff8085169650610100850481169562010000860482169563010000008104909216946001600160a01b036401000000009093048316949216929091906103cb90613bbb565b80601f01602080910402602001604051908101604052809291908181526020018280546103f790613bbb565b80156104445780601f1061041957610100808354040283529160200191610444565b820191906000526020600020905b81548152906001019060200180831161042757829003601f168201915b505050505090806003015490806004015490806005015490806006015490806007015490508c565b6001546001600160a01b031633146104f05760405162461bcd60e51b8152602060048201526024808201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460448201527f61746f720000000000000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b6001546001600160a01b038281166000908152600a60205260408082205490517f1904bb2e000000000000000000000000000000000000000000000000000000008152908316600482015290929190911690631904bb2e90602401600060405180830381865afa158015610568573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526105909190810190613c75565b516040519091506000906001600160a01b038316906108fc90349084818181858888f193505050503d80600081146105e4576040519150601f19603f3d011682016040523d82523d6000602084013e6105e9565b606091505b50509050806106bc57600160009054906101000a90046001600160a01b03166001600160a01b031663f7866ee36040518163ffffffff1660e01b8152600401602060405180830381865afa158015610645573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906106699190613df3565b6001600160a01b03163460405160006040518083038185875af1925050503d80600081146106b3576040519150601f19603f3d011682016040523d82523d6000602084013e6106b8565b606091505b5050505b50506001600160a01b03166000908152600a6020526040902080547fffffffffffffffffffffffff0000000000000000000000000000000000000000169055565b6000806107098461139b565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018690529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa158015610771573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906107959190613e10565b6001600160a01b0387166000908152600e602090815260408083209383529290522054919091109150509392505050565b6001546001600160a01b031633146108455760405162461bcd60e51b8152602060048201526024808201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460448201527f61746f720000000000000000000000000000000000000000000000000000000060648201526084016104e7565b600055565b6001546001600160a01b031633146108c95760405162461bcd60e51b8152602060048201526024808201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460448201527f61746f720000000000000000000000000000000000000000000000000000000060648201526084016104e7565b6108d1611406565b80156108df576108df61175f565b50565b60008060006108f08561139b565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018790529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa158015610958573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061097c9190613e10565b6001600160a01b0388166000908152600e6020908152604080832084845290915290205490915082116109b6576000935060009250610a46565b6001600160a01b0387166000908152600c602052604090205415610a3d576001600160a01b0387166000908152600c60205260408120546002906109fc90600190613e58565b81548110610a0c57610a0c613e6b565b90600052602060002090600802019050600094506003600001548160040154610a359190613e9a565b935050610a46565b60019350600092505b5050935093915050565b610ac66040805161018081018252600080825260208201819052909182019081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b6001600160a01b0382166000908152600c6020526040902054610b2b5760405162461bcd60e51b815260206004820152600d60248201527f6e6f2061636375736174696f6e0000000000000000000000000000000000000060448201526064016104e7565b6001600160a01b0382166000908152600c6020526040902054600290610b5390600190613e58565b81548110610b6357610b63613e6b565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115610bb857610bb8613630565b6002811115610bc957610bc9613630565b815281546020909101906301000000900460ff166009811115610bee57610bee613630565b6009811115610bff57610bff613630565b815281546001600160a01b03640100000000909104811660208301526001830154166040820152600282018054606090920191610c3b90613bbb565b80601f0160208091040260200160405190810160405280929190818152602001828054610c6790613bbb565b8015610cb45780601f10610c8957610100808354040283529160200191610cb4565b820191906000526020600020905b815481529060010190602001808311610c9757829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509050919050565b6001600160a01b0381166000908152600b60205260408120546060919067ffffffffffffffff811115610d2b57610d2b613937565b604051908082528060200260200182016040528015610dd257816020015b610dbf6040805161018081018252600080825260208201819052909182019081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b815260200190600190039081610d495790505b50905060005b6001600160a01b0384166000908152600b6020526040902054811015610ffd576001600160a01b0384166000908152600b6020526040902080546002919083908110610e2657610e26613e6b565b906000526020600020015481548110610e4157610e41613e6b565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115610e9657610e96613630565b6002811115610ea757610ea7613630565b815281546020909101906301000000900460ff166009811115610ecc57610ecc613630565b6009811115610edd57610edd613630565b815281546001600160a01b03640100000000909104811660208301526001830154166040820152600282018054606090920191610f1990613bbb565b80601f0160208091040260200160405190810160405280929190818152602001828054610f4590613bbb565b8015610f925780601f10610f6757610100808354040283529160200191610f92565b820191906000526020600020905b815481529060010190602001808311610f7557829003601f168201915b5050505050815260200160038201548152602001600482015481526020016005820154815260200160068201548152602001600782015481525050828281518110610fdf57610fdf613e6b565b60200260200101819052508080610ff590613ead565b915050610dd8565b5092915050565b6001546040517f1904bb2e0000000000000000000000000000000000000000000000000000000081523360048201526000916001600160a01b031690631904bb2e90602401600060405180830381865afa158015611066573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405261108e9190810190613c75565b60208101519091506001600160a01b031633146111135760405162461bcd60e51b815260206004820152602d60248201527f66756e6374696f6e207265737472696374656420746f2061207265676973746560448201527f7265642076616c696461746f720000000000000000000000000000000000000060648201526084016104e7565b60808201516001600160a01b0316331461116f5760405162461bcd60e51b815260206004820152601d60248201527f6576656e74207265706f72746572206d7573742062652063616c6c657200000060448201526064016104e7565b6001826000015160ff16111561132557600061118a83611a42565b90508061119657505050565b336000908152600d6020908152604091829020825161018081018452815460ff808216835261010082048116948301949094529093919291840191620100009091041660028111156111ea576111ea613630565b60028111156111fb576111fb613630565b815281546020909101906301000000900460ff16600981111561122057611220613630565b600981111561123157611231613630565b815281546001600160a01b0364010000000090910481166020830152600183015416604082015260028201805460609092019161126d90613bbb565b80601f016020809104026020016040519081016040528092919081815260200182805461129990613bbb565b80156112e65780601f106112bb576101008083540402835291602001916112e6565b820191906000526020600020905b8154815290600101906020018083116112c957829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509250505b60008260400151600281111561133d5761133d613630565b0361134f5761134b82611cfc565b5050565b60018260400151600281111561136757611367613630565b036113755761134b82611f8c565b60028260400151600281111561138d5761138d613630565b0361134b5761134b82612174565b600060098260098111156113b1576113b1613630565b036113bf5760025b92915050565b60008260098111156113d3576113d3613630565b036113df5760026113b9565b60018260098111156113f3576113f3613630565b036113ff5760026113b9565b60026113b9565b6011545b60105481101561175a5760006010828154811061142957611429613e6b565b90600052602060002001549050806000036114445750611748565b61144f600182613e58565b905060006002828154811061146657611466613e6b565b600091825260209182902060408051610180810182526008909302909101805460ff8082168552610100820481169585019590955292939092918401916201000090041660028111156114bb576114bb613630565b60028111156114cc576114cc613630565b815281546020909101906301000000900460ff1660098111156114f1576114f1613630565b600981111561150257611502613630565b815281546001600160a01b0364010000000090910481166020830152600183015416604082015260028201805460609092019161153e90613bbb565b80601f016020809104026020016040519081016040528092919081815260200182805461156a90613bbb565b80156115b75780601f1061158c576101008083540402835291602001916115b7565b820191906000526020600020905b81548152906001019060200180831161159a57829003601f168201915b505050505081526020016003820154815260200160048201548152602001600582015481526020016006820154815260200160078201548152505090504360036000015482610140015161160b9190613e9a565b1115611618575050601155565b60a08101516001600160a01b03166000908152600c6020526040812081905560608201516116459061139b565b60a08301516001600160a01b03166000908152600e602090815260408083206101208701518452909152902054909150811161168357505050611748565b60a0820180516001600160a01b039081166000908152600e6020908152604080832061012088015184528252808320869055845184168352600b825280832080546001808201835591855283852001899055600f805491820181559093527f8d1108e10bcb7c27dddfc02ed9d693a074039d026cf4ea4240b40f7d581ac80290920187905592518151858152938401879052909116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f910160405180910390a25050505b8061175281613ead565b91505061140a565b601155565b600080600160009054906101000a90046001600160a01b03166001600160a01b031663c9d97af46040518163ffffffff1660e01b8152600401602060405180830381865afa1580156117b5573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906117d99190613e10565b905060005b600f5481101561185057816002600f83815481106117fe576117fe613e6b565b90600052602060002001548154811061181957611819613e6b565b9060005260206000209060080201600501540361183e5761183b600184613e9a565b92505b8061184881613ead565b9150506117de565b5060005b600f54811015611a3557611a236002600f838154811061187657611876613e6b565b90600052602060002001548154811061189157611891613e6b565b600091825260209182902060408051610180810182526008909302909101805460ff8082168552610100820481169585019590955292939092918401916201000090041660028111156118e6576118e6613630565b60028111156118f7576118f7613630565b815281546020909101906301000000900460ff16600981111561191c5761191c613630565b600981111561192d5761192d613630565b815281546001600160a01b0364010000000090910481166020830152600183015416604082015260028201805460609092019161196990613bbb565b80601f016020809104026020016040519081016040528092919081815260200182805461199590613bbb565b80156119e25780601f106119b7576101008083540402835291602001916119e2565b820191906000526020600020905b8154815290600101906020018083116119c557829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250508461231b565b80611a2d81613ead565b915050611854565b5061134b600f6000613579565b6000816020015160ff16600003611bf857336000908152600d6020908152604091829020845181549286015160ff908116610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009094169116179190911780825591840151849282907fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff1662010000836002811115611ae557611ae5613630565b02179055506060820151815482907fffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffffff166301000000836009811115611b2c57611b2c613630565b0217905550608082015181547fffffffffffffffff0000000000000000000000000000000000000000ffffffff166401000000006001600160a01b039283160217825560a08301516001830180547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905560c08201516002820190611bb79082613f30565b5060e0820151600382015561010082015160048201556101208201516005820155610140820151600682015561016090910151600790910155506000919050565b602080830151336000908152600d90925260409091205460ff91821691611c2691610100900416600161402c565b60ff1614611c765760405162461bcd60e51b815260206004820152601960248201527f6368756e6b73206d75737420626520636f6e746967756f75730000000000000060448201526064016104e7565b336000908152600d6020526040902060c0830151611c979160020190612918565b336000908152600d602052604090208054600191908290611cc1908290610100900460ff1661402c565b92506101000a81548160ff021916908360ff160217905550816000015160ff1682602001516001611cf2919061402c565b60ff161492915050565b6000806000806000611d1360fe8760c00151612a80565b9450945094509450945084611d6a5760405162461bcd60e51b815260206004820152601960248201527f6661696c65642070726f6f6620766572696669636174696f6e0000000000000060448201526064016104e7565b8560a001516001600160a01b0316846001600160a01b031614611dcf5760405162461bcd60e51b815260206004820152601160248201527f6f6666656e646572206d69736d6174636800000000000000000000000000000060448201526064016104e7565b85606001516009811115611de557611de5613630565b8314611e335760405162461bcd60e51b815260206004820152601060248201527f72756c65206964206d69736d617463680000000000000000000000000000000060448201526064016104e7565b438210611e825760405162461bcd60e51b815260206004820152601660248201527f63616e277420626520696e20746865206675747572650000000000000000000060448201526064016104e7565b60008211611ed25760405162461bcd60e51b815260206004820152601360248201527f63616e27742062652061742067656e657369730000000000000000000000000060448201526064016104e7565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa158015611f35573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611f599190613e10565b610100880184905261012088018190524361014089015261016088018390529050611f8387612ae7565b50505050505050565b6000806000806000611fa360fc8760c00151612a80565b9450945094509450945084611ffa5760405162461bcd60e51b815260206004820152601e60248201527f6661696c65642061636375736174696f6e20766572696669636174696f6e000060448201526064016104e7565b8560a001516001600160a01b0316846001600160a01b03161461205f5760405162461bcd60e51b815260206004820152601160248201527f6f6666656e646572206d69736d6174636800000000000000000000000000000060448201526064016104e7565b8560600151600981111561207557612075613630565b83146120c35760405162461bcd60e51b815260206004820152601060248201527f72756c65206964206d69736d617463680000000000000000000000000000000060448201526064016104e7565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa158015612126573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061214a9190613e10565b610100880184905261012088018190524361014089015261016088018390529050611f8387612e41565b600080600080600061218b60fd8760c00151612a80565b94509450945094509450846121e25760405162461bcd60e51b815260206004820152601d60248201527f6661696c656420696e6e6f63656e636520766572696669636174696f6e00000060448201526064016104e7565b8560a001516001600160a01b0316846001600160a01b0316146122475760405162461bcd60e51b815260206004820152601160248201527f6f6666656e646572206d69736d6174636800000000000000000000000000000060448201526064016104e7565b8560600151600981111561225d5761225d613630565b83146122ab5760405162461bcd60e51b815260206004820152601060248201527f72756c65206964206d69736d617463680000000000000000000000000000000060448201526064016104e7565b4382106122fa5760405162461bcd60e51b815260206004820152601660248201527f63616e277420626520696e20746865206675747572650000000000000000000060448201526064016104e7565b61010086018290526101608601819052612313866131ce565b505050505050565b60015460a08301516040517f1904bb2e0000000000000000000000000000000000000000000000000000000081526001600160a01b0391821660048201526000929190911690631904bb2e90602401600060405180830381865afa158015612387573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526123af9190810190613c75565b608084015160a08501516001600160a01b039081166000908152600a6020526040902080547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905590506003816102600151600381111561241857612418613630565b0361242257505050565b6000612439612434856060015161139b565b613520565b610220830151600754919250906000906124539083614045565b6006546124609087614045565b61246a9085613e9a565b6124749190613e9a565b60095490915081111561248657506009545b60008461012001518560c001518660a001516124a29190613e9a565b6124ac9190613e9a565b6009549091506000906124bf8385614045565b6124c9919061405c565b90506000811180156124da57508181145b1561262357600060a087018190526101008701819052610120870181905260c08701526101e086018051829190612512908390613e9a565b905250610220860180516001919061252b908390613e9a565b905250600361026087015260006102008701526001546040517f35be16e00000000000000000000000000000000000000000000000000000000081526001600160a01b03909116906335be16e0906125879089906004016140a7565b600060405180830381600087803b1580156125a157600080fd5b505af11580156125b5573d6000803e3d6000fd5b5050505060208681015160e08a0151604080516001600160a01b03909316835292820184905260008284015260016060830152608082015290517f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f99181900360a00190a15050505050505050565b61012086015181908111612650578087610120018181516126449190613e58565b9052506000905061266b565b6101208701516126609082613e58565b600061012089015290505b80156126e85780876101000151106126b3578087610100018181516126909190613e58565b90525060a0870180518291906126a7908390613e58565b905250600090506126e8565b6101008701516126c39082613e58565b90508661010001518760a0018181516126dc9190613e58565b90525060006101008801525b60008111801561270b575060008760a001518860c001516127099190613e9a565b115b156127b75760008760a001518860c001516127269190613e9a565b60c08901516127359084614045565b61273f919061405c565b905060008860a001518960c001516127579190613e9a565b60a08a01516127669085614045565b612770919061405c565b9050818960c0018181516127849190613e58565b90525060a08901805182919061279b908390613e58565b9052506127a88183613e9a565b6127b29084613e58565b925050505b6127c18183613e58565b915081876101e0018181516127d69190613e9a565b90525061022087018051600191906127ef908390613e9a565b9052506000546102208801516008546128089190614045565b6128129190614045565b61281c9043613e9a565b61020088015260026102608801526001546040517f35be16e00000000000000000000000000000000000000000000000000000000081526001600160a01b03909116906335be16e090612873908a906004016140a7565b600060405180830381600087803b15801561288d57600080fd5b505af11580156128a1573d6000803e3d6000fd5b5050506020808901516102008a015160e08d0151604080516001600160a01b039094168452938301879052928201526000606082015260808101919091527f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f9915060a00160405180910390a1505050505050505050565b8154600260018083161561010002038216048251808201602081106020841001600281146129c257600181146129e7578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b808210156129ac578151845560018401935060208201915061298f565b815191036101000a908190040290915550611f83565b60028302826020036101000a846020036101000a602089015104020185018755611f83565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a039250828251167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff008a160184556020820191506001840193505b80821015612a665781518455600184019350602082019150612a49565b815191036101000a90819004029091555050505050505050565b60008060008060008086516020612a979190613e9a565b9050612aa1613597565b60a081838a8c5afa612ab257600080fd5b8051600103612ac057600196505b602081015160408201516060830151608090930151989b919a509850909695509350505050565b6000612af6826060015161139b565b60a08301516001600160a01b03166000908152600e6020908152604080832061012087015184529091529020549091508111612b995760405162461bcd60e51b8152602060048201526024808201527f616c726561647920736c6173686564206174207468652070726f6f662773206560448201527f706f63680000000000000000000000000000000000000000000000000000000060648201526084016104e7565b6002805460e084018190526001810182556000829052835160089091027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace018054602086015160ff908116610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009092169316929092179190911780825560408501518593909183917fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff9091169062010000908490811115612c5f57612c5f613630565b02179055506060820151815482907fffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffffff166301000000836009811115612ca657612ca6613630565b0217905550608082015181547fffffffffffffffff0000000000000000000000000000000000000000ffffffff166401000000006001600160a01b039283160217825560a08301516001830180547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905560c08201516002820190612d319082613f30565b5060e0828101516003830155610100830151600483015561012080840151600584015561014084015160068401556101609093015160079092019190915560a0840180516001600160a01b039081166000908152600b602090815260408083209589018051875460018181018a5598865284862001558051600f8054988901815585527f8d1108e10bcb7c27dddfc02ed9d693a074039d026cf4ea4240b40f7d581ac80290970196909655845184168352600e82528083209689015183529590528490208590559051915192519116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f91612e3591858252602082015260400190565b60405180910390a25050565b60a08101516001600160a01b03166000908152600c602052604090205415612eab5760405162461bcd60e51b815260206004820181905260248201527f616c72656164792070726f63657373696e6720616e2061636375736174696f6e60448201526064016104e7565b6000612eba826060015161139b565b60a08301516001600160a01b03166000908152600e6020908152604080832061012087015184529091529020549091508111612f5d5760405162461bcd60e51b8152602060048201526024808201527f616c726561647920736c6173686564206174207468652070726f6f662773206560448201527f706f63680000000000000000000000000000000000000000000000000000000060648201526084016104e7565b6002805460e084018190526001810182556000829052835160089091027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace018054602086015160ff908116610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009092169316929092179190911780825560408501518593909183917fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff909116906201000090849081111561302357613023613630565b02179055506060820151815482907fffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffffff16630100000083600981111561306a5761306a613630565b0217905550608082015181547fffffffffffffffff0000000000000000000000000000000000000000ffffffff166401000000006001600160a01b039283160217825560a08301516001830180547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905560c082015160028201906130f59082613f30565b5060e08281015160038301556101008301516004830155610120830151600583015561014083015160068301556101609092015160079091015582015161313d906001613e9a565b60a08301516001600160a01b03166000908152600c602052604090205560e082015160109061316d906001613e9a565b81546001810183556000928352602092839020015560a083015160e084015160408051858152938401919091526001600160a01b03909116917f2e8e354b41470731dafa7c3df150e9498a8d5b9c51ff0259fbf77f721ba403519101612e35565b60a08101516001600160a01b03166000908152600c60205260408120549081900361323b5760405162461bcd60e51b815260206004820152601860248201527f6e6f206173736f6369617465642061636375736174696f6e000000000000000060448201526064016104e7565b8160600151600981111561325157613251613630565b600261325e600184613e58565b8154811061326e5761326e613e6b565b60009182526020909120600890910201546301000000900460ff16600981111561329a5761329a613630565b1461330d5760405162461bcd60e51b815260206004820152602760248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e2060448201527f72756c652069640000000000000000000000000000000000000000000000000060648201526084016104e7565b6101008201516002613320600184613e58565b8154811061333057613330613e6b565b906000526020600020906008020160040154146133b55760405162461bcd60e51b815260206004820152602560248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e2060448201527f626c6f636b00000000000000000000000000000000000000000000000000000060648201526084016104e7565b61016082015160026133c8600184613e58565b815481106133d8576133d8613e6b565b9060005260206000209060080201600701541461345c5760405162461bcd60e51b8152602060048201526024808201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e2060448201527f686173680000000000000000000000000000000000000000000000000000000060648201526084016104e7565b6011545b6010548110156134c657816010828154811061347e5761347e613e6b565b9060005260206000200154036134b4576000601082815481106134a3576134a3613e6b565b6000918252602090912001556134c6565b806134be81613ead565b915050613460565b5060a0820180516001600160a01b039081166000908152600c602090815260408083208390559351935191825292909116917f1fa96beb8dddcb7d4484dd00c4059e872439f7a474a2ecf49c430fc6e86c9e1f9101612e35565b60008161352f57505060055490565b6001820361353f57505060055490565b6002820361354f57505060055490565b6003820361355f57505060055490565b600482036135705750612710919050565b50612710919050565b50805460008255906000526020600020908101906108df91906135b5565b6040518060a001604052806005906020820280368337509192915050565b5b808211156135ca57600081556001016135b6565b5090565b6001600160a01b03811681146108df57600080fd5b80356135ee816135ce565b919050565b60006020828403121561360557600080fd5b8135613610816135ce565b9392505050565b60006020828403121561362957600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6003811061366f5761366f613630565b9052565b600a811061366f5761366f613630565b60005b8381101561369e578181015183820152602001613686565b50506000910152565b600081518084526136bf816020860160208601613683565b601f01601f19169290920160200192915050565b600061018060ff8f16835260ff8e1660208401526136f4604084018e61365f565b613701606084018d613673565b6001600160a01b038b811660808501528a1660a084015260c0830181905261372b8184018a6136a7565b60e0840198909852505061010081019490945261012084019290925261014083015261016090910152979650505050505050565b8035600a81106135ee57600080fd5b60008060006060848603121561378357600080fd5b833561378e816135ce565b925061379c6020850161375f565b9150604084013590509250925092565b6000602082840312156137be57600080fd5b8135801515811461361057600080fd5b805160ff168252600061018060208301516137ee602086018260ff169052565b506040830151613801604086018261365f565b5060608301516138146060860182613673565b50608083015161382f60808601826001600160a01b03169052565b5060a083015161384a60a08601826001600160a01b03169052565b5060c08301518160c0860152613862828601826136a7565b60e08581015190870152610100808601519087015261012080860151908701526101408086015190870152610160948501519490950193909352509192915050565b60208152600061361060208301846137ce565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101561392a577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc08886030184526139188583516137ce565b945092850192908501906001016138de565b5092979650505050505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b604051610180810167ffffffffffffffff8111828210171561398a5761398a613937565b60405290565b604051610280810167ffffffffffffffff8111828210171561398a5761398a613937565b604051601f8201601f1916810167ffffffffffffffff811182821017156139dd576139dd613937565b604052919050565b803560ff811681146135ee57600080fd5b8035600381106135ee57600080fd5b600067ffffffffffffffff821115613a1f57613a1f613937565b50601f01601f191660200190565b600082601f830112613a3e57600080fd5b8135613a51613a4c82613a05565b6139b4565b818152846020838601011115613a6657600080fd5b816020850160208301376000918101602001919091529392505050565b600060208284031215613a9557600080fd5b813567ffffffffffffffff80821115613aad57600080fd5b908301906101808286031215613ac257600080fd5b613aca613966565b613ad3836139e5565b8152613ae1602084016139e5565b6020820152613af2604084016139f6565b6040820152613b036060840161375f565b6060820152613b14608084016135e3565b6080820152613b2560a084016135e3565b60a082015260c083013582811115613b3c57600080fd5b613b4887828601613a2d565b60c08301525060e083810135908201526101008084013590820152610120808401359082015261014080840135908201526101609283013592810192909252509392505050565b60008060408385031215613ba257600080fd5b8235613bad816135ce565b946020939093013593505050565b600181811c90821680613bcf57607f821691505b602082108103613c08577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b80516135ee816135ce565b600082601f830112613c2a57600080fd5b8151613c38613a4c82613a05565b818152846020838601011115613c4d57600080fd5b613c5e826020830160208701613683565b949350505050565b8051600481106135ee57600080fd5b600060208284031215613c8757600080fd5b815167ffffffffffffffff80821115613c9f57600080fd5b908301906102808286031215613cb457600080fd5b613cbc613990565b613cc583613c0e565b8152613cd360208401613c0e565b6020820152613ce460408401613c0e565b6040820152606083015182811115613cfb57600080fd5b613d0787828601613c19565b6060830152506080830151608082015260a083015160a082015260c083015160c082015260e083015160e0820152610100808401518183015250610120808401518183015250610140808401518183015250610160808401518183015250610180613d73818501613c0e565b908201526101a083810151908201526101c080840151908201526101e08084015190820152610200808401519082015261022080840151908201526102408084015183811115613dc257600080fd5b613dce88828701613c19565b8284015250506102609150613de4828401613c66565b91810191909152949350505050565b600060208284031215613e0557600080fd5b8151613610816135ce565b600060208284031215613e2257600080fd5b5051919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156113b9576113b9613e29565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b808201808211156113b9576113b9613e29565b60007fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8203613ede57613ede613e29565b5060010190565b601f821115613f2b57600081815260208120601f850160051c81016020861015613f0c5750805b601f850160051c820191505b8181101561231357828155600101613f18565b505050565b815167ffffffffffffffff811115613f4a57613f4a613937565b613f5e81613f588454613bbb565b84613ee5565b602080601f831160018114613fb15760008415613f7b5750858301515b7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff600386901b1c1916600185901b178555612313565b600085815260208120601f198616915b82811015613fe057888601518255948401946001909101908401613fc1565b508582101561401c57878501517fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff600388901b60f8161c191681555b5050505050600190811b01905550565b60ff81811683821601908111156113b9576113b9613e29565b80820281158282048414176113b9576113b9613e29565b600082614092577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b500490565b6004811061366f5761366f613630565b602081526140c16020820183516001600160a01b03169052565b600060208301516140dd60408401826001600160a01b03169052565b5060408301516001600160a01b038116606084015250606083015161028080608085015261410f6102a08501836136a7565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a0614196818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f1901610260808801919091529091506141f884836136a7565b93508087015191505061420d82860182614097565b509094935050505056fea264697066735822122055a466f1f439ff6a1dd1301e2ae6ff470600b4e6b132ec67b5c039cda216221664736f6c63430008150033")
 
 var AccountabilityAbi, _ = abi.JSON(strings.NewReader(`[
    {
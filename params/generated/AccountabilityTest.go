@@ -4,7 +4,7 @@ import "strings"
 import "github.com/autonity/autonity/accounts/abi"
 import "github.com/autonity/autonity/common"
 
-var AccountabilityTestBytecode = common.Hex2Bytes("608060405260006011553480156200001657600080fd5b506040516200481d3803806200481d833981016040819052620000399162000100565b600180546001600160a01b0319166001600160a01b03841690811790915560408051636fd8d26960e11b8152905184928492909163dfb1a4d2916004808201926020929091908290030181865afa15801562000099573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620000bf9190620001c8565b6000558051600355602081015160045560408101516005556060810151600655608081015160075560a081015160085560c0015160095550620001e2915050565b6000808284036101008112156200011657600080fd5b83516001600160a01b03811681146200012e57600080fd5b925060e0601f19820112156200014357600080fd5b5060405160e081016001600160401b03811182821017156200017557634e487b7160e01b600052604160045260246000fd5b80604052506020840151815260408401516020820152606084015160408201526080840151606082015260a0840151608082015260c084015160a082015260e084015160c0820152809150509250929050565b600060208284031215620001db57600080fd5b5051919050565b61462b80620001f26000396000f3fe60806040526004361061015f5760003560e01c80637ec8be1d116100c0578063b5b7a18411610074578063bebaa8fc11610059578063bebaa8fc1461041f578063c50d21f01461044c578063e7bb0b521461046c57600080fd5b8063b5b7a184146103db578063bb841eda146103ff57600080fd5b8063a044ba41116100a5578063a044ba4114610386578063a04cd117146103a6578063a9dd7e23146103c657600080fd5b80637ec8be1d146103445780639cb22b061461035957600080fd5b8063633b5e5e116101175780636c9789b0116100fc5780636c9789b01461028957806379502c55146102a95780637ccecadd1461030d57600080fd5b8063633b5e5e146102545780636b5f444c1461026957600080fd5b80631de9d9b6116101485780631de9d9b6146101ef5780634108a95a1461020457806342d507641461023457600080fd5b806301567739146101645780630b791430146101b7575b600080fd5b34801561017057600080fd5b5061019a61017f36600461397c565b600a602052600090815260409020546001600160a01b031681565b6040516001600160a01b0390911681526020015b60405180910390f35b3480156101c357600080fd5b506101d76101d23660046139a0565b6104a4565b6040516101ae9c9b9a99989796959493929190613a5c565b6102026101fd36600461397c565b6105b8565b005b34801561021057600080fd5b5061022461021f366004613af7565b610849565b60405190151581526020016101ae565b34801561024057600080fd5b5061020261024f366004613d68565b610912565b34801561026057600080fd5b5061020261091e565b34801561027557600080fd5b506102026102843660046139a0565b610928565b34801561029557600080fd5b506102026102a4366004613da5565b6109ac565b3480156102b557600080fd5b506003546004546005546006546007546008546009546102d89695949392919087565b604080519788526020880196909652948601939093526060850191909152608084015260a083015260c082015260e0016101ae565b34801561031957600080fd5b5061032d610328366004613af7565b610a41565b6040805192151583526020830191909152016101ae565b34801561035057600080fd5b50610202610baf565b34801561036557600080fd5b5061037961037436600461397c565b610bb7565b6040516101ae9190613e9d565b34801561039257600080fd5b506102026103a1366004613eb0565b610e5d565b3480156103b257600080fd5b506102026103c1366004613d68565b610e6b565b3480156103d257600080fd5b50610379610e74565b3480156103e757600080fd5b506103f160005481565b6040519081526020016101ae565b34801561040b57600080fd5b5061020261041a366004613d68565b61107a565b34801561042b57600080fd5b5061043f61043a36600461397c565b611083565b6040516101ae9190613ef5565b34801561045857600080fd5b50610202610467366004613d68565b611391565b34801561047857600080fd5b506103f1610487366004613f75565b600e60209081526000928352604080842090915290825290205481565b600281815481106104b457600080fd5b600091825260209091206008909102018054600182015460028301805460ff8085169650610100850481169562010000860482169563010000008104909216946001600160a01b0364010000000090930483169492169290919061051790613fa1565b80601f016020809104026020016040519081016040528092919081815260200182805461054390613fa1565b80156105905780601f1061056557610100808354040283529160200191610590565b820191906000526020600020905b81548152906001019060200180831161057357829003601f168201915b505050505090806003015490806004015490806005015490806006015490806007015490508c565b6001546001600160a01b0316331461063c5760405162461bcd60e51b8152602060048201526024808201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460448201527f61746f720000000000000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b6001546001600160a01b038281166000908152600a60205260408082205490517f1904bb2e000000000000000000000000000000000000000000000000000000008152908316600482015290929190911690631904bb2e90602401600060405180830381865afa1580156106b4573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526106dc9190810190614053565b516040519091506000906001600160a01b038316906108fc90349084818181858888f193505050503d8060008114610730576040519150601f19603f3d011682016040523d82523d6000602084013e610735565b606091505b505090508061080857600160009054906101000a90046001600160a01b03166001600160a01b031663f7866ee36040518163ffffffff1660e01b8152600401602060405180830381865afa158015610791573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906107b591906141d1565b6001600160a01b03163460405160006040518083038185875af1925050503d80600081146107ff576040519150601f19603f3d011682016040523d82523d6000602084013e610804565b606091505b5050505b50506001600160a01b03166000908152600a6020526040902080547fffffffffffffffffffffffff0000000000000000000000000000000000000000169055565b60008061085584611724565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018690529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa1580156108bd573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906108e191906141ee565b6001600160a01b0387166000908152600e602090815260408083209383529290522054919091109150509392505050565b61091b8161178f565b50565b610926611ae9565b565b6001546001600160a01b031633146109a75760405162461bcd60e51b8152602060048201526024808201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460448201527f61746f72000000000000000000000000000000000000000000000000000000006064820152608401610633565b600055565b6001546001600160a01b03163314610a2b5760405162461bcd60e51b8152602060048201526024808201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460448201527f61746f72000000000000000000000000000000000000000000000000000000006064820152608401610633565b610a33611dcc565b801561091b5761091b611ae9565b6000806000610a4f85611724565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018790529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa158015610ab7573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610adb91906141ee565b6001600160a01b0388166000908152600e602090815260408083208484529091529020549091508211610b15576000935060009250610ba5565b6001600160a01b0387166000908152600c602052604090205415610b9c576001600160a01b0387166000908152600c6020526040812054600290610b5b90600190614236565b81548110610b6b57610b6b614249565b90600052602060002090600802019050600094506003600001548160040154610b949190614278565b935050610ba5565b60019350600092505b5050935093915050565b610926611dcc565b610c2d6040805161018081018252600080825260208201819052909182019081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b6001600160a01b0382166000908152600c6020526040902054610c925760405162461bcd60e51b815260206004820152600d60248201527f6e6f2061636375736174696f6e000000000000000000000000000000000000006044820152606401610633565b6001600160a01b0382166000908152600c6020526040902054600290610cba90600190614236565b81548110610cca57610cca614249565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115610d1f57610d1f6139b9565b6002811115610d3057610d306139b9565b815281546020909101906301000000900460ff166009811115610d5557610d556139b9565b6009811115610d6657610d666139b9565b815281546001600160a01b03640100000000909104811660208301526001830154166040820152600282018054606090920191610da290613fa1565b80601f0160208091040260200160405190810160405280929190818152602001828054610dce90613fa1565b8015610e1b5780601f10610df057610100808354040283529160200191610e1b565b820191906000526020600020905b815481529060010190602001808311610dfe57829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509050919050565b610e678282612125565b5050565b61091b81612722565b610eea6040805161018081018252600080825260208201819052909182019081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b336000908152600d6020908152604091829020825161018081018452815460ff80821683526101008204811694830194909452909391929184019162010000909104166002811115610f3e57610f3e6139b9565b6002811115610f4f57610f4f6139b9565b815281546020909101906301000000900460ff166009811115610f7457610f746139b9565b6009811115610f8557610f856139b9565b815281546001600160a01b03640100000000909104811660208301526001830154166040820152600282018054606090920191610fc190613fa1565b80601f0160208091040260200160405190810160405280929190818152602001828054610fed90613fa1565b801561103a5780601f1061100f5761010080835404028352916020019161103a565b820191906000526020600020905b81548152906001019060200180831161101d57829003601f168201915b5050505050815260200160038201548152602001600482015481526020016005820154815260200160068201548152602001600782015481525050905090565b61091b81612a74565b6001600160a01b0381166000908152600b60205260408120546060919067ffffffffffffffff8111156110b8576110b8613b35565b60405190808252806020026020018201604052801561115f57816020015b61114c6040805161018081018252600080825260208201819052909182019081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b8152602001906001900390816110d65790505b50905060005b6001600160a01b0384166000908152600b602052604090205481101561138a576001600160a01b0384166000908152600b60205260409020805460029190839081106111b3576111b3614249565b9060005260206000200154815481106111ce576111ce614249565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115611223576112236139b9565b6002811115611234576112346139b9565b815281546020909101906301000000900460ff166009811115611259576112596139b9565b600981111561126a5761126a6139b9565b815281546001600160a01b036401000000009091048116602083015260018301541660408201526002820180546060909201916112a690613fa1565b80601f01602080910402602001604051908101604052809291908181526020018280546112d290613fa1565b801561131f5780601f106112f45761010080835404028352916020019161131f565b820191906000526020600020905b81548152906001019060200180831161130257829003601f168201915b505050505081526020016003820154815260200160048201548152602001600582015481526020016006820154815260200160078201548152505082828151811061136c5761136c614249565b602002602001018190525080806113829061428b565b915050611165565b5092915050565b6001546040517f1904bb2e0000000000000000000000000000000000000000000000000000000081523360048201526000916001600160a01b031690631904bb2e90602401600060405180830381865afa1580156113f3573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405261141b9190810190614053565b60208101519091506001600160a01b031633146114a05760405162461bcd60e51b815260206004820152602d60248201527f66756e6374696f6e207265737472696374656420746f2061207265676973746560448201527f7265642076616c696461746f72000000000000000000000000000000000000006064820152608401610633565b60808201516001600160a01b031633146114fc5760405162461bcd60e51b815260206004820152601d60248201527f6576656e74207265706f72746572206d7573742062652063616c6c65720000006044820152606401610633565b6001826000015160ff1611156116b257600061151783612e01565b90508061152357505050565b336000908152600d6020908152604091829020825161018081018452815460ff80821683526101008204811694830194909452909391929184019162010000909104166002811115611577576115776139b9565b6002811115611588576115886139b9565b815281546020909101906301000000900460ff1660098111156115ad576115ad6139b9565b60098111156115be576115be6139b9565b815281546001600160a01b036401000000009091048116602083015260018301541660408201526002820180546060909201916115fa90613fa1565b80601f016020809104026020016040519081016040528092919081815260200182805461162690613fa1565b80156116735780601f1061164857610100808354040283529160200191611673565b820191906000526020600020905b81548152906001019060200180831161165657829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509250505b6000826040015160028111156116ca576116ca6139b9565b036116d857610e67826130bb565b6001826040015160028111156116f0576116f06139b9565b036116fe57610e678261334b565b600282604001516002811115611716576117166139b9565b03610e6757610e6782613533565b6000600982600981111561173a5761173a6139b9565b036117485760025b92915050565b600082600981111561175c5761175c6139b9565b03611768576002611742565b600182600981111561177c5761177c6139b9565b03611788576002611742565b6002611742565b600061179e8260600151611724565b60a08301516001600160a01b03166000908152600e60209081526040808320610120870151845290915290205490915081116118415760405162461bcd60e51b8152602060048201526024808201527f616c726561647920736c6173686564206174207468652070726f6f662773206560448201527f706f6368000000000000000000000000000000000000000000000000000000006064820152608401610633565b6002805460e084018190526001810182556000829052835160089091027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace018054602086015160ff908116610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009092169316929092179190911780825560408501518593909183917fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff9091169062010000908490811115611907576119076139b9565b02179055506060820151815482907fffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffffff16630100000083600981111561194e5761194e6139b9565b0217905550608082015181547fffffffffffffffff0000000000000000000000000000000000000000ffffffff166401000000006001600160a01b039283160217825560a08301516001830180547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905560c082015160028201906119d9908261430e565b5060e0828101516003830155610100830151600483015561012080840151600584015561014084015160068401556101609093015160079092019190915560a0840180516001600160a01b039081166000908152600b602090815260408083209589018051875460018181018a5598865284862001558051600f8054988901815585527f8d1108e10bcb7c27dddfc02ed9d693a074039d026cf4ea4240b40f7d581ac80290970196909655845184168352600e82528083209689015183529590528490208590559051915192519116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f91611add91858252602082015260400190565b60405180910390a25050565b600080600160009054906101000a90046001600160a01b03166001600160a01b031663c9d97af46040518163ffffffff1660e01b8152600401602060405180830381865afa158015611b3f573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611b6391906141ee565b905060005b600f54811015611bda57816002600f8381548110611b8857611b88614249565b906000526020600020015481548110611ba357611ba3614249565b90600052602060002090600802016005015403611bc857611bc5600184614278565b92505b80611bd28161428b565b915050611b68565b5060005b600f54811015611dbf57611dad6002600f8381548110611c0057611c00614249565b906000526020600020015481548110611c1b57611c1b614249565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115611c7057611c706139b9565b6002811115611c8157611c816139b9565b815281546020909101906301000000900460ff166009811115611ca657611ca66139b9565b6009811115611cb757611cb76139b9565b815281546001600160a01b03640100000000909104811660208301526001830154166040820152600282018054606090920191611cf390613fa1565b80601f0160208091040260200160405190810160405280929190818152602001828054611d1f90613fa1565b8015611d6c5780601f10611d4157610100808354040283529160200191611d6c565b820191906000526020600020905b815481529060010190602001808311611d4f57829003601f168201915b505050505081526020016003820154815260200160048201548152602001600582015481526020016006820154815260200160078201548152505084612125565b80611db78161428b565b915050611bde565b50610e67600f6000613902565b6011545b60105481101561212057600060108281548110611def57611def614249565b9060005260206000200154905080600003611e0a575061210e565b611e15600182614236565b9050600060028281548110611e2c57611e2c614249565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115611e8157611e816139b9565b6002811115611e9257611e926139b9565b815281546020909101906301000000900460ff166009811115611eb757611eb76139b9565b6009811115611ec857611ec86139b9565b815281546001600160a01b03640100000000909104811660208301526001830154166040820152600282018054606090920191611f0490613fa1565b80601f0160208091040260200160405190810160405280929190818152602001828054611f3090613fa1565b8015611f7d5780601f10611f5257610100808354040283529160200191611f7d565b820191906000526020600020905b815481529060010190602001808311611f6057829003601f168201915b5050505050815260200160038201548152602001600482015481526020016005820154815260200160068201548152602001600782015481525050905043600360000154826101400151611fd19190614278565b1115611fde575050601155565b60a08101516001600160a01b03166000908152600c60205260408120819055606082015161200b90611724565b60a08301516001600160a01b03166000908152600e60209081526040808320610120870151845290915290205490915081116120495750505061210e565b60a0820180516001600160a01b039081166000908152600e6020908152604080832061012088015184528252808320869055845184168352600b825280832080546001808201835591855283852001899055600f805491820181559093527f8d1108e10bcb7c27dddfc02ed9d693a074039d026cf4ea4240b40f7d581ac80290920187905592518151858152938401879052909116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f910160405180910390a25050505b806121188161428b565b915050611dd0565b601155565b60015460a08301516040517f1904bb2e0000000000000000000000000000000000000000000000000000000081526001600160a01b0391821660048201526000929190911690631904bb2e90602401600060405180830381865afa158015612191573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526121b99190810190614053565b608084015160a08501516001600160a01b039081166000908152600a6020526040902080547fffffffffffffffffffffffff00000000000000000000000000000000000000001691909216179055905060038161026001516003811115612222576122226139b9565b0361222c57505050565b600061224361223e8560600151611724565b6136da565b6102208301516007549192509060009061225d908361440a565b60065461226a908761440a565b6122749085614278565b61227e9190614278565b60095490915081111561229057506009545b60008461012001518560c001518660a001516122ac9190614278565b6122b69190614278565b6009549091506000906122c9838561440a565b6122d39190614421565b90506000811180156122e457508181145b1561242d57600060a087018190526101008701819052610120870181905260c08701526101e08601805182919061231c908390614278565b9052506102208601805160019190612335908390614278565b905250600361026087015260006102008701526001546040517f35be16e00000000000000000000000000000000000000000000000000000000081526001600160a01b03909116906335be16e09061239190899060040161446c565b600060405180830381600087803b1580156123ab57600080fd5b505af11580156123bf573d6000803e3d6000fd5b5050505060208681015160e08a0151604080516001600160a01b03909316835292820184905260008284015260016060830152608082015290517f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f99181900360a00190a15050505050505050565b6101208601518190811161245a5780876101200181815161244e9190614236565b90525060009050612475565b61012087015161246a9082614236565b600061012089015290505b80156124f25780876101000151106124bd5780876101000181815161249a9190614236565b90525060a0870180518291906124b1908390614236565b905250600090506124f2565b6101008701516124cd9082614236565b90508661010001518760a0018181516124e69190614236565b90525060006101008801525b600081118015612515575060008760a001518860c001516125139190614278565b115b156125c15760008760a001518860c001516125309190614278565b60c089015161253f908461440a565b6125499190614421565b905060008860a001518960c001516125619190614278565b60a08a0151612570908561440a565b61257a9190614421565b9050818960c00181815161258e9190614236565b90525060a0890180518291906125a5908390614236565b9052506125b28183614278565b6125bc9084614236565b925050505b6125cb8183614236565b915081876101e0018181516125e09190614278565b90525061022087018051600191906125f9908390614278565b905250600054610220880151600854612612919061440a565b61261c919061440a565b6126269043614278565b61020088015260026102608801526001546040517f35be16e00000000000000000000000000000000000000000000000000000000081526001600160a01b03909116906335be16e09061267d908a9060040161446c565b600060405180830381600087803b15801561269757600080fd5b505af11580156126ab573d6000803e3d6000fd5b5050506020808901516102008a015160e08d0151604080516001600160a01b039094168452938301879052928201526000606082015260808101919091527f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f9915060a00160405180910390a1505050505050505050565b60a08101516001600160a01b03166000908152600c60205260408120549081900361278f5760405162461bcd60e51b815260206004820152601860248201527f6e6f206173736f6369617465642061636375736174696f6e00000000000000006044820152606401610633565b816060015160098111156127a5576127a56139b9565b60026127b2600184614236565b815481106127c2576127c2614249565b60009182526020909120600890910201546301000000900460ff1660098111156127ee576127ee6139b9565b146128615760405162461bcd60e51b815260206004820152602760248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e2060448201527f72756c65206964000000000000000000000000000000000000000000000000006064820152608401610633565b6101008201516002612874600184614236565b8154811061288457612884614249565b906000526020600020906008020160040154146129095760405162461bcd60e51b815260206004820152602560248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e2060448201527f626c6f636b0000000000000000000000000000000000000000000000000000006064820152608401610633565b610160820151600261291c600184614236565b8154811061292c5761292c614249565b906000526020600020906008020160070154146129b05760405162461bcd60e51b8152602060048201526024808201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e2060448201527f68617368000000000000000000000000000000000000000000000000000000006064820152608401610633565b6011545b601054811015612a1a5781601082815481106129d2576129d2614249565b906000526020600020015403612a08576000601082815481106129f7576129f7614249565b600091825260209091200155612a1a565b80612a128161428b565b9150506129b4565b5060a0820180516001600160a01b039081166000908152600c602090815260408083208390559351935191825292909116917f1fa96beb8dddcb7d4484dd00c4059e872439f7a474a2ecf49c430fc6e86c9e1f9101611add565b60a08101516001600160a01b03166000908152600c602052604090205415612ade5760405162461bcd60e51b815260206004820181905260248201527f616c72656164792070726f63657373696e6720616e2061636375736174696f6e6044820152606401610633565b6000612aed8260600151611724565b60a08301516001600160a01b03166000908152600e6020908152604080832061012087015184529091529020549091508111612b905760405162461bcd60e51b8152602060048201526024808201527f616c726561647920736c6173686564206174207468652070726f6f662773206560448201527f706f6368000000000000000000000000000000000000000000000000000000006064820152608401610633565b6002805460e084018190526001810182556000829052835160089091027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace018054602086015160ff908116610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009092169316929092179190911780825560408501518593909183917fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff9091169062010000908490811115612c5657612c566139b9565b02179055506060820151815482907fffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffffff166301000000836009811115612c9d57612c9d6139b9565b0217905550608082015181547fffffffffffffffff0000000000000000000000000000000000000000ffffffff166401000000006001600160a01b039283160217825560a08301516001830180547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905560c08201516002820190612d28908261430e565b5060e082810151600383015561010083015160048301556101208301516005830155610140830151600683015561016090920151600790910155820151612d70906001614278565b60a08301516001600160a01b03166000908152600c602052604090205560e0820151601090612da0906001614278565b81546001810183556000928352602092839020015560a083015160e084015160408051858152938401919091526001600160a01b03909116917f2e8e354b41470731dafa7c3df150e9498a8d5b9c51ff0259fbf77f721ba403519101611add565b6000816020015160ff16600003612fb757336000908152600d6020908152604091829020845181549286015160ff908116610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009094169116179190911780825591840151849282907fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff1662010000836002811115612ea457612ea46139b9565b02179055506060820151815482907fffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffffff166301000000836009811115612eeb57612eeb6139b9565b0217905550608082015181547fffffffffffffffff0000000000000000000000000000000000000000ffffffff166401000000006001600160a01b039283160217825560a08301516001830180547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905560c08201516002820190612f76908261430e565b5060e0820151600382015561010082015160048201556101208201516005820155610140820151600682015561016090910151600790910155506000919050565b602080830151336000908152600d90925260409091205460ff91821691612fe59161010090041660016145dc565b60ff16146130355760405162461bcd60e51b815260206004820152601960248201527f6368756e6b73206d75737420626520636f6e746967756f7573000000000000006044820152606401610633565b336000908152600d6020526040902060c08301516130569160020190613733565b336000908152600d602052604090208054600191908290613080908290610100900460ff166145dc565b92506101000a81548160ff021916908360ff160217905550816000015160ff16826020015160016130b191906145dc565b60ff161492915050565b60008060008060006130d260fe8760c0015161389b565b94509450945094509450846131295760405162461bcd60e51b815260206004820152601960248201527f6661696c65642070726f6f6620766572696669636174696f6e000000000000006044820152606401610633565b8560a001516001600160a01b0316846001600160a01b03161461318e5760405162461bcd60e51b815260206004820152601160248201527f6f6666656e646572206d69736d617463680000000000000000000000000000006044820152606401610633565b856060015160098111156131a4576131a46139b9565b83146131f25760405162461bcd60e51b815260206004820152601060248201527f72756c65206964206d69736d61746368000000000000000000000000000000006044820152606401610633565b4382106132415760405162461bcd60e51b815260206004820152601660248201527f63616e277420626520696e2074686520667574757265000000000000000000006044820152606401610633565b600082116132915760405162461bcd60e51b815260206004820152601360248201527f63616e27742062652061742067656e65736973000000000000000000000000006044820152606401610633565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa1580156132f4573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061331891906141ee565b6101008801849052610120880181905243610140890152610160880183905290506133428761178f565b50505050505050565b600080600080600061336260fc8760c0015161389b565b94509450945094509450846133b95760405162461bcd60e51b815260206004820152601e60248201527f6661696c65642061636375736174696f6e20766572696669636174696f6e00006044820152606401610633565b8560a001516001600160a01b0316846001600160a01b03161461341e5760405162461bcd60e51b815260206004820152601160248201527f6f6666656e646572206d69736d617463680000000000000000000000000000006044820152606401610633565b85606001516009811115613434576134346139b9565b83146134825760405162461bcd60e51b815260206004820152601060248201527f72756c65206964206d69736d61746368000000000000000000000000000000006044820152606401610633565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa1580156134e5573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061350991906141ee565b61010088018490526101208801819052436101408901526101608801839052905061334287612a74565b600080600080600061354a60fd8760c0015161389b565b94509450945094509450846135a15760405162461bcd60e51b815260206004820152601d60248201527f6661696c656420696e6e6f63656e636520766572696669636174696f6e0000006044820152606401610633565b8560a001516001600160a01b0316846001600160a01b0316146136065760405162461bcd60e51b815260206004820152601160248201527f6f6666656e646572206d69736d617463680000000000000000000000000000006044820152606401610633565b8560600151600981111561361c5761361c6139b9565b831461366a5760405162461bcd60e51b815260206004820152601060248201527f72756c65206964206d69736d61746368000000000000000000000000000000006044820152606401610633565b4382106136b95760405162461bcd60e51b815260206004820152601660248201527f63616e277420626520696e2074686520667574757265000000000000000000006044820152606401610633565b610100860182905261016086018190526136d286612722565b505050505050565b6000816136e957505060055490565b600182036136f957505060055490565b6002820361370957505060055490565b6003820361371957505060055490565b6004820361372a5750612710919050565b50612710919050565b8154600260018083161561010002038216048251808201602081106020841001600281146137dd5760018114613802578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b808210156137c757815184556001840193506020820191506137aa565b815191036101000a908190040290915550613342565b60028302826020036101000a846020036101000a602089015104020185018755613342565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a039250828251167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff008a160184556020820191506001840193505b808210156138815781518455600184019350602082019150613864565b815191036101000a90819004029091555050505050505050565b600080600080600080865160206138b29190614278565b90506138bc613920565b60a081838a8c5afa6138cd57600080fd5b80516001036138db57600196505b602081015160408201516060830151608090930151989b919a509850909695509350505050565b508054600082559060005260206000209081019061091b919061393e565b6040518060a001604052806005906020820280368337509192915050565b5b80821115613953576000815560010161393f565b5090565b6001600160a01b038116811461091b57600080fd5b803561397781613957565b919050565b60006020828403121561398e57600080fd5b813561399981613957565b9392505050565b6000602082840312156139b257600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b600381106139f8576139f86139b9565b9052565b600a81106139f8576139f86139b9565b60005b83811015613a27578181015183820152602001613a0f565b50506000910152565b60008151808452613a48816020860160208601613a0c565b601f01601f19169290920160200192915050565b600061018060ff8f16835260ff8e166020840152613a7d604084018e6139e8565b613a8a606084018d6139fc565b6001600160a01b038b811660808501528a1660a084015260c08301819052613ab48184018a613a30565b60e0840198909852505061010081019490945261012084019290925261014083015261016090910152979650505050505050565b8035600a811061397757600080fd5b600080600060608486031215613b0c57600080fd5b8335613b1781613957565b9250613b2560208501613ae8565b9150604084013590509250925092565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b604051610180810167ffffffffffffffff81118282101715613b8857613b88613b35565b60405290565b604051610280810167ffffffffffffffff81118282101715613b8857613b88613b35565b604051601f8201601f1916810167ffffffffffffffff81118282101715613bdb57613bdb613b35565b604052919050565b803560ff8116811461397757600080fd5b80356003811061397757600080fd5b600067ffffffffffffffff821115613c1d57613c1d613b35565b50601f01601f191660200190565b600082601f830112613c3c57600080fd5b8135613c4f613c4a82613c03565b613bb2565b818152846020838601011115613c6457600080fd5b816020850160208301376000918101602001919091529392505050565b60006101808284031215613c9457600080fd5b613c9c613b64565b9050613ca782613be3565b8152613cb560208301613be3565b6020820152613cc660408301613bf4565b6040820152613cd760608301613ae8565b6060820152613ce86080830161396c565b6080820152613cf960a0830161396c565b60a082015260c082013567ffffffffffffffff811115613d1857600080fd5b613d2484828501613c2b565b60c08301525060e082810135908201526101008083013590820152610120808301359082015261014080830135908201526101609182013591810191909152919050565b600060208284031215613d7a57600080fd5b813567ffffffffffffffff811115613d9157600080fd5b613d9d84828501613c81565b949350505050565b600060208284031215613db757600080fd5b8135801515811461399957600080fd5b805160ff16825260006101806020830151613de7602086018260ff169052565b506040830151613dfa60408601826139e8565b506060830151613e0d60608601826139fc565b506080830151613e2860808601826001600160a01b03169052565b5060a0830151613e4360a08601826001600160a01b03169052565b5060c08301518160c0860152613e5b82860182613a30565b60e08581015190870152610100808601519087015261012080860151908701526101408086015190870152610160948501519490950193909352509192915050565b6020815260006139996020830184613dc7565b60008060408385031215613ec357600080fd5b823567ffffffffffffffff811115613eda57600080fd5b613ee685828601613c81565b95602094909401359450505050565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b82811015613f68577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc0888603018452613f56858351613dc7565b94509285019290850190600101613f1c565b5092979650505050505050565b60008060408385031215613f8857600080fd5b8235613f9381613957565b946020939093013593505050565b600181811c90821680613fb557607f821691505b602082108103613fee577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b805161397781613957565b600082601f83011261401057600080fd5b815161401e613c4a82613c03565b81815284602083860101111561403357600080fd5b613d9d826020830160208701613a0c565b80516004811061397757600080fd5b60006020828403121561406557600080fd5b815167ffffffffffffffff8082111561407d57600080fd5b90830190610280828603121561409257600080fd5b61409a613b8e565b6140a383613ff4565b81526140b160208401613ff4565b60208201526140c260408401613ff4565b60408201526060830151828111156140d957600080fd5b6140e587828601613fff565b6060830152506080830151608082015260a083015160a082015260c083015160c082015260e083015160e0820152610100808401518183015250610120808401518183015250610140808401518183015250610160808401518183015250610180614151818501613ff4565b908201526101a083810151908201526101c080840151908201526101e080840151908201526102008084015190820152610220808401519082015261024080840151838111156141a057600080fd5b6141ac88828701613fff565b82840152505061026091506141c2828401614044565b91810191909152949350505050565b6000602082840312156141e357600080fd5b815161399981613957565b60006020828403121561420057600080fd5b5051919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111561174257611742614207565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b8082018082111561174257611742614207565b60007fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff82036142bc576142bc614207565b5060010190565b601f82111561430957600081815260208120601f850160051c810160208610156142ea5750805b601f850160051c820191505b818110156136d2578281556001016142f6565b505050565b815167ffffffffffffffff81111561432857614328613b35565b61433c816143368454613fa1565b846142c3565b602080601f83116001811461438f57600084156143595750858301515b7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff600386901b1c1916600185901b1785556136d2565b600085815260208120601f198616915b828110156143be5788860151825594840194600190910190840161439f565b50858210156143fa57878501517fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff600388901b60f8161c191681555b5050505050600190811b01905550565b808202811582820484141761174257611742614207565b600082614457577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b500490565b600481106139f8576139f86139b9565b602081526144866020820183516001600160a01b03169052565b600060208301516144a260408401826001600160a01b03169052565b5060408301516001600160a01b03811660608401525060608301516102808060808501526144d46102a0850183613a30565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a061455b818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f1901610260808801919091529091506145bd8483613a30565b9350808701519150506145d28286018261445c565b5090949350505050565b60ff81811683821601908111156117425761174261420756fea26469706673582212206d0bfa77df64e701e847d1310f1bc93026832f9e2326bbbd9a34091139f0d02d64736f6c63430008150033")
+var AccountabilityTestBytecode = common.Hex2Bytes("608060405260006011553480156200001657600080fd5b506040516200481d3803806200481d833981016040819052620000399162000100565b600180546001600160a01b0319166001600160a01b03841690811790915560408051636fd8d26960e11b8152905184928492909163dfb1a4d2916004808201926020929091908290030181865afa15801562000099573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620000bf9190620001c8565b6000558051600355602081015160045560408101516005556060810151600655608081015160075560a081015160085560c0015160095550620001e2915050565b6000808284036101008112156200011657600080fd5b83516001600160a01b03811681146200012e57600080fd5b925060e0601f19820112156200014357600080fd5b5060405160e081016001600160401b03811182821017156200017557634e487b7160e01b600052604160045260246000fd5b80604052506020840151815260408401516020820152606084015160408201526080840151606082015260a0840151608082015260c084015160a082015260e084015160c0820152809150509250929050565b600060208284031215620001db57600080fd5b5051919050565b61462b80620001f26000396000f3fe60806040526004361061015f5760003560e01c80637ec8be1d116100c0578063b5b7a18411610074578063bebaa8fc11610059578063bebaa8fc1461041f578063c50d21f01461044c578063e7bb0b521461046c57600080fd5b8063b5b7a184146103db578063bb841eda146103ff57600080fd5b8063a044ba41116100a5578063a044ba4114610386578063a04cd117146103a6578063a9dd7e23146103c657600080fd5b80637ec8be1d146103445780639cb22b061461035957600080fd5b8063633b5e5e116101175780636c9789b0116100fc5780636c9789b01461028957806379502c55146102a95780637ccecadd1461030d57600080fd5b8063633b5e5e146102545780636b5f444c1461026957600080fd5b80631de9d9b6116101485780631de9d9b6146101ef5780634108a95a1461020457806342d507641461023457600080fd5b806301567739146101645780630b791430146101b7575b600080fd5b34801561017057600080fd5b5061019a61017f36600461397c565b600a602052600090815260409020546001600160a01b031681565b6040516001600160a01b0390911681526020015b60405180910390f35b3480156101c357600080fd5b506101d76101d23660046139a0565b6104a4565b6040516101ae9c9b9a99989796959493929190613a5c565b6102026101fd36600461397c565b6105b8565b005b34801561021057600080fd5b5061022461021f366004613af7565b610849565b60405190151581526020016101ae565b34801561024057600080fd5b5061020261024f366004613d68565b610912565b34801561026057600080fd5b5061020261091e565b34801561027557600080fd5b506102026102843660046139a0565b610928565b34801561029557600080fd5b506102026102a4366004613da5565b6109ac565b3480156102b557600080fd5b506003546004546005546006546007546008546009546102d89695949392919087565b604080519788526020880196909652948601939093526060850191909152608084015260a083015260c082015260e0016101ae565b34801561031957600080fd5b5061032d610328366004613af7565b610a41565b6040805192151583526020830191909152016101ae565b34801561035057600080fd5b50610202610baf565b34801561036557600080fd5b5061037961037436600461397c565b610bb7565b6040516101ae9190613e9d565b34801561039257600080fd5b506102026103a1366004613eb0565b610e5d565b3480156103b257600080fd5b506102026103c1366004613d68565b610e6b565b3480156103d257600080fd5b50610379610e74565b3480156103e757600080fd5b506103f160005481565b6040519081526020016101ae565b34801561040b57600080fd5b5061020261041a366004613d68565b61107a565b34801561042b57600080fd5b5061043f61043a36600461397c565b611083565b6040516101ae9190613ef5565b34801561045857600080fd5b50610202610467366004613d68565b611391565b34801561047857600080fd5b506103f1610487366004613f75565b600e60209081526000928352604080842090915290825290205481565b600281815481106104b457600080fd5b600091825260209091206008909102018054600182015460028301805460ff8085169650610100850481169562010000860482169563010000008104909216946001600160a01b0364010000000090930483169492169290919061051790613fa1565b80601f016020809104026020016040519081016040528092919081815260200182805461054390613fa1565b80156105905780601f1061056557610100808354040283529160200191610590565b820191906000526020600020905b81548152906001019060200180831161057357829003601f168201915b505050505090806003015490806004015490806005015490806006015490806007015490508c565b6001546001600160a01b0316331461063c5760405162461bcd60e51b8152602060048201526024808201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460448201527f61746f720000000000000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b6001546001600160a01b038281166000908152600a60205260408082205490517f1904bb2e000000000000000000000000000000000000000000000000000000008152908316600482015290929190911690631904bb2e90602401600060405180830381865afa1580156106b4573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526106dc9190810190614053565b516040519091506000906001600160a01b038316906108fc90349084818181858888f193505050503d8060008114610730576040519150601f19603f3d011682016040523d82523d6000602084013e610735565b606091505b505090508061080857600160009054906101000a90046001600160a01b03166001600160a01b031663f7866ee36040518163ffffffff1660e01b8152600401602060405180830381865afa158015610791573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906107b591906141d1565b6001600160a01b03163460405160006040518083038185875af1925050503d80600081146107ff576040519150601f19603f3d011682016040523d82523d6000602084013e610804565b606091505b5050505b50506001600160a01b03166000908152600a6020526040902080547fffffffffffffffffffffffff0000000000000000000000000000000000000000169055565b60008061085584611724565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018690529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa1580156108bd573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906108e191906141ee565b6001600160a01b0387166000908152600e602090815260408083209383529290522054919091109150509392505050565b61091b8161178f565b50565b610926611ae9565b565b6001546001600160a01b031633146109a75760405162461bcd60e51b8152602060048201526024808201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460448201527f61746f72000000000000000000000000000000000000000000000000000000006064820152608401610633565b600055565b6001546001600160a01b03163314610a2b5760405162461bcd60e51b8152602060048201526024808201527f66756e6374696f6e207265737472696374656420746f207468652076616c696460448201527f61746f72000000000000000000000000000000000000000000000000000000006064820152608401610633565b610a33611dcc565b801561091b5761091b611ae9565b6000806000610a4f85611724565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018790529192506000916001600160a01b03909116906396b477cb90602401602060405180830381865afa158015610ab7573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610adb91906141ee565b6001600160a01b0388166000908152600e602090815260408083208484529091529020549091508211610b15576000935060009250610ba5565b6001600160a01b0387166000908152600c602052604090205415610b9c576001600160a01b0387166000908152600c6020526040812054600290610b5b90600190614236565b81548110610b6b57610b6b614249565b90600052602060002090600802019050600094506003600001548160040154610b949190614278565b935050610ba5565b60019350600092505b5050935093915050565b610926611dcc565b610c2d6040805161018081018252600080825260208201819052909182019081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b6001600160a01b0382166000908152600c6020526040902054610c925760405162461bcd60e51b815260206004820152600d60248201527f6e6f2061636375736174696f6e000000000000000000000000000000000000006044820152606401610633565b6001600160a01b0382166000908152600c6020526040902054600290610cba90600190614236565b81548110610cca57610cca614249565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115610d1f57610d1f6139b9565b6002811115610d3057610d306139b9565b815281546020909101906301000000900460ff166009811115610d5557610d556139b9565b6009811115610d6657610d666139b9565b815281546001600160a01b03640100000000909104811660208301526001830154166040820152600282018054606090920191610da290613fa1565b80601f0160208091040260200160405190810160405280929190818152602001828054610dce90613fa1565b8015610e1b5780601f10610df057610100808354040283529160200191610e1b565b820191906000526020600020905b815481529060010190602001808311610dfe57829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509050919050565b610e678282612125565b5050565b61091b81612722565b610eea6040805161018081018252600080825260208201819052909182019081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b336000908152600d6020908152604091829020825161018081018452815460ff80821683526101008204811694830194909452909391929184019162010000909104166002811115610f3e57610f3e6139b9565b6002811115610f4f57610f4f6139b9565b815281546020909101906301000000900460ff166009811115610f7457610f746139b9565b6009811115610f8557610f856139b9565b815281546001600160a01b03640100000000909104811660208301526001830154166040820152600282018054606090920191610fc190613fa1565b80601f0160208091040260200160405190810160405280929190818152602001828054610fed90613fa1565b801561103a5780601f1061100f5761010080835404028352916020019161103a565b820191906000526020600020905b81548152906001019060200180831161101d57829003601f168201915b5050505050815260200160038201548152602001600482015481526020016005820154815260200160068201548152602001600782015481525050905090565b61091b81612a74565b6001600160a01b0381166000908152600b60205260408120546060919067ffffffffffffffff8111156110b8576110b8613b35565b60405190808252806020026020018201604052801561115f57816020015b61114c6040805161018081018252600080825260208201819052909182019081526020016000815260200160006001600160a01b0316815260200160006001600160a01b031681526020016060815260200160008152602001600081526020016000815260200160008152602001600081525090565b8152602001906001900390816110d65790505b50905060005b6001600160a01b0384166000908152600b602052604090205481101561138a576001600160a01b0384166000908152600b60205260409020805460029190839081106111b3576111b3614249565b9060005260206000200154815481106111ce576111ce614249565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115611223576112236139b9565b6002811115611234576112346139b9565b815281546020909101906301000000900460ff166009811115611259576112596139b9565b600981111561126a5761126a6139b9565b815281546001600160a01b036401000000009091048116602083015260018301541660408201526002820180546060909201916112a690613fa1565b80601f01602080910402602001604051908101604052809291908181526020018280546112d290613fa1565b801561131f5780601f106112f45761010080835404028352916020019161131f565b820191906000526020600020905b81548152906001019060200180831161130257829003601f168201915b505050505081526020016003820154815260200160048201548152602001600582015481526020016006820154815260200160078201548152505082828151811061136c5761136c614249565b602002602001018190525080806113829061428b565b915050611165565b5092915050565b6001546040517f1904bb2e0000000000000000000000000000000000000000000000000000000081523360048201526000916001600160a01b031690631904bb2e90602401600060405180830381865afa1580156113f3573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405261141b9190810190614053565b60208101519091506001600160a01b031633146114a05760405162461bcd60e51b815260206004820152602d60248201527f66756e6374696f6e207265737472696374656420746f2061207265676973746560448201527f7265642076616c696461746f72000000000000000000000000000000000000006064820152608401610633565b60808201516001600160a01b031633146114fc5760405162461bcd60e51b815260206004820152601d60248201527f6576656e74207265706f72746572206d7573742062652063616c6c65720000006044820152606401610633565b6001826000015160ff1611156116b257600061151783612e01565b90508061152357505050565b336000908152600d6020908152604091829020825161018081018452815460ff80821683526101008204811694830194909452909391929184019162010000909104166002811115611577576115776139b9565b6002811115611588576115886139b9565b815281546020909101906301000000900460ff1660098111156115ad576115ad6139b9565b60098111156115be576115be6139b9565b815281546001600160a01b036401000000009091048116602083015260018301541660408201526002820180546060909201916115fa90613fa1565b80601f016020809104026020016040519081016040528092919081815260200182805461162690613fa1565b80156116735780601f1061164857610100808354040283529160200191611673565b820191906000526020600020905b81548152906001019060200180831161165657829003601f168201915b50505050508152602001600382015481526020016004820154815260200160058201548152602001600682015481526020016007820154815250509250505b6000826040015160028111156116ca576116ca6139b9565b036116d857610e67826130bb565b6001826040015160028111156116f0576116f06139b9565b036116fe57610e678261334b565b600282604001516002811115611716576117166139b9565b03610e6757610e6782613533565b6000600982600981111561173a5761173a6139b9565b036117485760025b92915050565b600082600981111561175c5761175c6139b9565b03611768576002611742565b600182600981111561177c5761177c6139b9565b03611788576002611742565b6002611742565b600061179e8260600151611724565b60a08301516001600160a01b03166000908152600e60209081526040808320610120870151845290915290205490915081116118415760405162461bcd60e51b8152602060048201526024808201527f616c726561647920736c6173686564206174207468652070726f6f662773206560448201527f706f6368000000000000000000000000000000000000000000000000000000006064820152608401610633565b6002805460e084018190526001810182556000829052835160089091027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace018054602086015160ff908116610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009092169316929092179190911780825560408501518593909183917fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff9091169062010000908490811115611907576119076139b9565b02179055506060820151815482907fffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffffff16630100000083600981111561194e5761194e6139b9565b0217905550608082015181547fffffffffffffffff0000000000000000000000000000000000000000ffffffff166401000000006001600160a01b039283160217825560a08301516001830180547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905560c082015160028201906119d9908261430e565b5060e0828101516003830155610100830151600483015561012080840151600584015561014084015160068401556101609093015160079092019190915560a0840180516001600160a01b039081166000908152600b602090815260408083209589018051875460018181018a5598865284862001558051600f8054988901815585527f8d1108e10bcb7c27dddfc02ed9d693a074039d026cf4ea4240b40f7d581ac80290970196909655845184168352600e82528083209689015183529590528490208590559051915192519116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f91611add91858252602082015260400190565b60405180910390a25050565b600080600160009054906101000a90046001600160a01b03166001600160a01b031663c9d97af46040518163ffffffff1660e01b8152600401602060405180830381865afa158015611b3f573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611b6391906141ee565b905060005b600f54811015611bda57816002600f8381548110611b8857611b88614249565b906000526020600020015481548110611ba357611ba3614249565b90600052602060002090600802016005015403611bc857611bc5600184614278565b92505b80611bd28161428b565b915050611b68565b5060005b600f54811015611dbf57611dad6002600f8381548110611c0057611c00614249565b906000526020600020015481548110611c1b57611c1b614249565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115611c7057611c706139b9565b6002811115611c8157611c816139b9565b815281546020909101906301000000900460ff166009811115611ca657611ca66139b9565b6009811115611cb757611cb76139b9565b815281546001600160a01b03640100000000909104811660208301526001830154166040820152600282018054606090920191611cf390613fa1565b80601f0160208091040260200160405190810160405280929190818152602001828054611d1f90613fa1565b8015611d6c5780601f10611d4157610100808354040283529160200191611d6c565b820191906000526020600020905b815481529060010190602001808311611d4f57829003601f168201915b505050505081526020016003820154815260200160048201548152602001600582015481526020016006820154815260200160078201548152505084612125565b80611db78161428b565b915050611bde565b50610e67600f6000613902565b6011545b60105481101561212057600060108281548110611def57611def614249565b9060005260206000200154905080600003611e0a575061210e565b611e15600182614236565b9050600060028281548110611e2c57611e2c614249565b600091825260209182902060408051610180810182526008909302909101805460ff808216855261010082048116958501959095529293909291840191620100009004166002811115611e8157611e816139b9565b6002811115611e9257611e926139b9565b815281546020909101906301000000900460ff166009811115611eb757611eb76139b9565b6009811115611ec857611ec86139b9565b815281546001600160a01b03640100000000909104811660208301526001830154166040820152600282018054606090920191611f0490613fa1565b80601f0160208091040260200160405190810160405280929190818152602001828054611f3090613fa1565b8015611f7d5780601f10611f5257610100808354040283529160200191611f7d565b820191906000526020600020905b815481529060010190602001808311611f6057829003601f168201915b5050505050815260200160038201548152602001600482015481526020016005820154815260200160068201548152602001600782015481525050905043600360000154826101400151611fd19190614278565b1115611fde575050601155565b60a08101516001600160a01b03166000908152600c60205260408120819055606082015161200b90611724565b60a08301516001600160a01b03166000908152600e60209081526040808320610120870151845290915290205490915081116120495750505061210e565b60a0820180516001600160a01b039081166000908152600e6020908152604080832061012088015184528252808320869055845184168352600b825280832080546001808201835591855283852001899055600f805491820181559093527f8d1108e10bcb7c27dddfc02ed9d693a074039d026cf4ea4240b40f7d581ac80290920187905592518151858152938401879052909116917f6b7783718ab8e152c193eb08bf76eed1191fcd1677a23a7fe9d338265aad132f910160405180910390a25050505b806121188161428b565b915050611dd0565b601155565b60015460a08301516040517f1904bb2e0000000000000000000000000000000000000000000000000000000081526001600160a01b0391821660048201526000929190911690631904bb2e90602401600060405180830381865afa158015612191573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526121b99190810190614053565b608084015160a08501516001600160a01b039081166000908152600a6020526040902080547fffffffffffffffffffffffff00000000000000000000000000000000000000001691909216179055905060038161026001516003811115612222576122226139b9565b0361222c57505050565b600061224361223e8560600151611724565b6136da565b6102208301516007549192509060009061225d908361440a565b60065461226a908761440a565b6122749085614278565b61227e9190614278565b60095490915081111561229057506009545b60008461012001518560c001518660a001516122ac9190614278565b6122b69190614278565b6009549091506000906122c9838561440a565b6122d39190614421565b90506000811180156122e457508181145b1561242d57600060a087018190526101008701819052610120870181905260c08701526101e08601805182919061231c908390614278565b9052506102208601805160019190612335908390614278565b905250600361026087015260006102008701526001546040517f35be16e00000000000000000000000000000000000000000000000000000000081526001600160a01b03909116906335be16e09061239190899060040161446c565b600060405180830381600087803b1580156123ab57600080fd5b505af11580156123bf573d6000803e3d6000fd5b5050505060208681015160e08a0151604080516001600160a01b03909316835292820184905260008284015260016060830152608082015290517f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f99181900360a00190a15050505050505050565b6101208601518190811161245a5780876101200181815161244e9190614236565b90525060009050612475565b61012087015161246a9082614236565b600061012089015290505b80156124f25780876101000151106124bd5780876101000181815161249a9190614236565b90525060a0870180518291906124b1908390614236565b905250600090506124f2565b6101008701516124cd9082614236565b90508661010001518760a0018181516124e69190614236565b90525060006101008801525b600081118015612515575060008760a001518860c001516125139190614278565b115b156125c15760008760a001518860c001516125309190614278565b60c089015161253f908461440a565b6125499190614421565b905060008860a001518960c001516125619190614278565b60a08a0151612570908561440a565b61257a9190614421565b9050818960c00181815161258e9190614236565b90525060a0890180518291906125a5908390614236565b9052506125b28183614278565b6125bc9084614236565b925050505b6125cb8183614236565b915081876101e0018181516125e09190614278565b90525061022087018051600191906125f9908390614278565b905250600054610220880151600854612612919061440a565b61261c919061440a565b6126269043614278565b61020088015260026102608801526001546040517f35be16e00000000000000000000000000000000000000000000000000000000081526001600160a01b03909116906335be16e09061267d908a9060040161446c565b600060405180830381600087803b15801561269757600080fd5b505af11580156126ab573d6000803e3d6000fd5b5050506020808901516102008a015160e08d0151604080516001600160a01b039094168452938301879052928201526000606082015260808101919091527f6617e612ea2d01b5a235997fa4963b56b1097df6f968a82972433e9ff852e0f9915060a00160405180910390a1505050505050505050565b60a08101516001600160a01b03166000908152600c60205260408120549081900361278f5760405162461bcd60e51b815260206004820152601860248201527f6e6f206173736f6369617465642061636375736174696f6e00000000000000006044820152606401610633565b816060015160098111156127a5576127a56139b9565b60026127b2600184614236565b815481106127c2576127c2614249565b60009182526020909120600890910201546301000000900460ff1660098111156127ee576127ee6139b9565b146128615760405162461bcd60e51b815260206004820152602760248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e2060448201527f72756c65206964000000000000000000000000000000000000000000000000006064820152608401610633565b6101008201516002612874600184614236565b8154811061288457612884614249565b906000526020600020906008020160040154146129095760405162461bcd60e51b815260206004820152602560248201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e2060448201527f626c6f636b0000000000000000000000000000000000000000000000000000006064820152608401610633565b610160820151600261291c600184614236565b8154811061292c5761292c614249565b906000526020600020906008020160070154146129b05760405162461bcd60e51b8152602060048201526024808201527f756e6d61746368696e672070726f6f6620616e642061636375736174696f6e2060448201527f68617368000000000000000000000000000000000000000000000000000000006064820152608401610633565b6011545b601054811015612a1a5781601082815481106129d2576129d2614249565b906000526020600020015403612a08576000601082815481106129f7576129f7614249565b600091825260209091200155612a1a565b80612a128161428b565b9150506129b4565b5060a0820180516001600160a01b039081166000908152600c602090815260408083208390559351935191825292909116917f1fa96beb8dddcb7d4484dd00c4059e872439f7a474a2ecf49c430fc6e86c9e1f9101611add565b60a08101516001600160a01b03166000908152600c602052604090205415612ade5760405162461bcd60e51b815260206004820181905260248201527f616c72656164792070726f63657373696e6720616e2061636375736174696f6e6044820152606401610633565b6000612aed8260600151611724565b60a08301516001600160a01b03166000908152600e6020908152604080832061012087015184529091529020549091508111612b905760405162461bcd60e51b8152602060048201526024808201527f616c726561647920736c6173686564206174207468652070726f6f662773206560448201527f706f6368000000000000000000000000000000000000000000000000000000006064820152608401610633565b6002805460e084018190526001810182556000829052835160089091027f405787fa12a823e0f2b7631cc41b3ba8828b3321ca811111fa75cd3aa3bb5ace018054602086015160ff908116610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009092169316929092179190911780825560408501518593909183917fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff9091169062010000908490811115612c5657612c566139b9565b02179055506060820151815482907fffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffffff166301000000836009811115612c9d57612c9d6139b9565b0217905550608082015181547fffffffffffffffff0000000000000000000000000000000000000000ffffffff166401000000006001600160a01b039283160217825560a08301516001830180547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905560c08201516002820190612d28908261430e565b5060e082810151600383015561010083015160048301556101208301516005830155610140830151600683015561016090920151600790910155820151612d70906001614278565b60a08301516001600160a01b03166000908152600c602052604090205560e0820151601090612da0906001614278565b81546001810183556000928352602092839020015560a083015160e084015160408051858152938401919091526001600160a01b03909116917f2e8e354b41470731dafa7c3df150e9498a8d5b9c51ff0259fbf77f721ba403519101611add565b6000816020015160ff16600003612fb757336000908152600d6020908152604091829020845181549286015160ff908116610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009094169116179190911780825591840151849282907fffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffff1662010000836002811115612ea457612ea46139b9565b02179055506060820151815482907fffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ffffff166301000000836009811115612eeb57612eeb6139b9565b0217905550608082015181547fffffffffffffffff0000000000000000000000000000000000000000ffffffff166401000000006001600160a01b039283160217825560a08301516001830180547fffffffffffffffffffffffff0000000000000000000000000000000000000000169190921617905560c08201516002820190612f76908261430e565b5060e0820151600382015561010082015160048201556101208201516005820155610140820151600682015561016090910151600790910155506000919050565b602080830151336000908152600d90925260409091205460ff91821691612fe59161010090041660016145dc565b60ff16146130355760405162461bcd60e51b815260206004820152601960248201527f6368756e6b73206d75737420626520636f6e746967756f7573000000000000006044820152606401610633565b336000908152600d6020526040902060c08301516130569160020190613733565b336000908152600d602052604090208054600191908290613080908290610100900460ff166145dc565b92506101000a81548160ff021916908360ff160217905550816000015160ff16826020015160016130b191906145dc565b60ff161492915050565b60008060008060006130d260fe8760c0015161389b565b94509450945094509450846131295760405162461bcd60e51b815260206004820152601960248201527f6661696c65642070726f6f6620766572696669636174696f6e000000000000006044820152606401610633565b8560a001516001600160a01b0316846001600160a01b03161461318e5760405162461bcd60e51b815260206004820152601160248201527f6f6666656e646572206d69736d617463680000000000000000000000000000006044820152606401610633565b856060015160098111156131a4576131a46139b9565b83146131f25760405162461bcd60e51b815260206004820152601060248201527f72756c65206964206d69736d61746368000000000000000000000000000000006044820152606401610633565b4382106132415760405162461bcd60e51b815260206004820152601660248201527f63616e277420626520696e2074686520667574757265000000000000000000006044820152606401610633565b600082116132915760405162461bcd60e51b815260206004820152601360248201527f63616e27742062652061742067656e65736973000000000000000000000000006044820152606401610633565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa1580156132f4573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061331891906141ee565b6101008801849052610120880181905243610140890152610160880183905290506133428761178f565b50505050505050565b600080600080600061336260fc8760c0015161389b565b94509450945094509450846133b95760405162461bcd60e51b815260206004820152601e60248201527f6661696c65642061636375736174696f6e20766572696669636174696f6e00006044820152606401610633565b8560a001516001600160a01b0316846001600160a01b03161461341e5760405162461bcd60e51b815260206004820152601160248201527f6f6666656e646572206d69736d617463680000000000000000000000000000006044820152606401610633565b85606001516009811115613434576134346139b9565b83146134825760405162461bcd60e51b815260206004820152601060248201527f72756c65206964206d69736d61746368000000000000000000000000000000006044820152606401610633565b6001546040517f96b477cb000000000000000000000000000000000000000000000000000000008152600481018490526000916001600160a01b0316906396b477cb90602401602060405180830381865afa1580156134e5573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061350991906141ee565b61010088018490526101208801819052436101408901526101608801839052905061334287612a74565b600080600080600061354a60fd8760c0015161389b565b94509450945094509450846135a15760405162461bcd60e51b815260206004820152601d60248201527f6661696c656420696e6e6f63656e636520766572696669636174696f6e0000006044820152606401610633565b8560a001516001600160a01b0316846001600160a01b0316146136065760405162461bcd60e51b815260206004820152601160248201527f6f6666656e646572206d69736d617463680000000000000000000000000000006044820152606401610633565b8560600151600981111561361c5761361c6139b9565b831461366a5760405162461bcd60e51b815260206004820152601060248201527f72756c65206964206d69736d61746368000000000000000000000000000000006044820152606401610633565b4382106136b95760405162461bcd60e51b815260206004820152601660248201527f63616e277420626520696e2074686520667574757265000000000000000000006044820152606401610633565b610100860182905261016086018190526136d286612722565b505050505050565b6000816136e957505060055490565b600182036136f957505060055490565b6002820361370957505060055490565b6003820361371957505060055490565b6004820361372a5750612710919050565b50612710919050565b8154600260018083161561010002038216048251808201602081106020841001600281146137dd5760018114613802578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b808210156137c757815184556001840193506020820191506137aa565b815191036101000a908190040290915550613342565b60028302826020036101000a846020036101000a602089015104020185018755613342565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a039250828251167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff008a160184556020820191506001840193505b808210156138815781518455600184019350602082019150613864565b815191036101000a90819004029091555050505050505050565b600080600080600080865160206138b29190614278565b90506138bc613920565b60a081838a8c5afa6138cd57600080fd5b80516001036138db57600196505b602081015160408201516060830151608090930151989b919a509850909695509350505050565b508054600082559060005260206000209081019061091b919061393e565b6040518060a001604052806005906020820280368337509192915050565b5b80821115613953576000815560010161393f565b5090565b6001600160a01b038116811461091b57600080fd5b803561397781613957565b919050565b60006020828403121561398e57600080fd5b813561399981613957565b9392505050565b6000602082840312156139b257600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b600381106139f8576139f86139b9565b9052565b600a81106139f8576139f86139b9565b60005b83811015613a27578181015183820152602001613a0f565b50506000910152565b60008151808452613a48816020860160208601613a0c565b601f01601f19169290920160200192915050565b600061018060ff8f16835260ff8e166020840152613a7d604084018e6139e8565b613a8a606084018d6139fc565b6001600160a01b038b811660808501528a1660a084015260c08301819052613ab48184018a613a30565b60e0840198909852505061010081019490945261012084019290925261014083015261016090910152979650505050505050565b8035600a811061397757600080fd5b600080600060608486031215613b0c57600080fd5b8335613b1781613957565b9250613b2560208501613ae8565b9150604084013590509250925092565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b604051610180810167ffffffffffffffff81118282101715613b8857613b88613b35565b60405290565b604051610280810167ffffffffffffffff81118282101715613b8857613b88613b35565b604051601f8201601f1916810167ffffffffffffffff81118282101715613bdb57613bdb613b35565b604052919050565b803560ff8116811461397757600080fd5b80356003811061397757600080fd5b600067ffffffffffffffff821115613c1d57613c1d613b35565b50601f01601f191660200190565b600082601f830112613c3c57600080fd5b8135613c4f613c4a82613c03565b613bb2565b818152846020838601011115613c6457600080fd5b816020850160208301376000918101602001919091529392505050565b60006101808284031215613c9457600080fd5b613c9c613b64565b9050613ca782613be3565b8152613cb560208301613be3565b6020820152613cc660408301613bf4565b6040820152613cd760608301613ae8565b6060820152613ce86080830161396c565b6080820152613cf960a0830161396c565b60a082015260c082013567ffffffffffffffff811115613d1857600080fd5b613d2484828501613c2b565b60c08301525060e082810135908201526101008083013590820152610120808301359082015261014080830135908201526101609182013591810191909152919050565b600060208284031215613d7a57600080fd5b813567ffffffffffffffff811115613d9157600080fd5b613d9d84828501613c81565b949350505050565b600060208284031215613db757600080fd5b8135801515811461399957600080fd5b805160ff16825260006101806020830151613de7602086018260ff169052565b506040830151613dfa60408601826139e8565b506060830151613e0d60608601826139fc565b506080830151613e2860808601826001600160a01b03169052565b5060a0830151613e4360a08601826001600160a01b03169052565b5060c08301518160c0860152613e5b82860182613a30565b60e08581015190870152610100808601519087015261012080860151908701526101408086015190870152610160948501519490950193909352509192915050565b6020815260006139996020830184613dc7565b60008060408385031215613ec357600080fd5b823567ffffffffffffffff811115613eda57600080fd5b613ee685828601613c81565b95602094909401359450505050565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b82811015613f68577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc0888603018452613f56858351613dc7565b94509285019290850190600101613f1c565b5092979650505050505050565b60008060408385031215613f8857600080fd5b8235613f9381613957565b946020939093013593505050565b600181811c90821680613fb557607f821691505b602082108103613fee577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b805161397781613957565b600082601f83011261401057600080fd5b815161401e613c4a82613c03565b81815284602083860101111561403357600080fd5b613d9d826020830160208701613a0c565b80516004811061397757600080fd5b60006020828403121561406557600080fd5b815167ffffffffffffffff8082111561407d57600080fd5b90830190610280828603121561409257600080fd5b61409a613b8e565b6140a383613ff4565b81526140b160208401613ff4565b60208201526140c260408401613ff4565b60408201526060830151828111156140d957600080fd5b6140e587828601613fff565b6060830152506080830151608082015260a083015160a082015260c083015160c082015260e083015160e0820152610100808401518183015250610120808401518183015250610140808401518183015250610160808401518183015250610180614151818501613ff4565b908201526101a083810151908201526101c080840151908201526101e080840151908201526102008084015190820152610220808401519082015261024080840151838111156141a057600080fd5b6141ac88828701613fff565b82840152505061026091506141c2828401614044565b91810191909152949350505050565b6000602082840312156141e357600080fd5b815161399981613957565b60006020828403121561420057600080fd5b5051919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111561174257611742614207565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b8082018082111561174257611742614207565b60007fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff82036142bc576142bc614207565b5060010190565b601f82111561430957600081815260208120601f850160051c810160208610156142ea5750805b601f850160051c820191505b818110156136d2578281556001016142f6565b505050565b815167ffffffffffffffff81111561432857614328613b35565b61433c816143368454613fa1565b846142c3565b602080601f83116001811461438f57600084156143595750858301515b7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff600386901b1c1916600185901b1785556136d2565b600085815260208120601f198616915b828110156143be5788860151825594840194600190910190840161439f565b50858210156143fa57878501517fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff600388901b60f8161c191681555b5050505050600190811b01905550565b808202811582820484141761174257611742614207565b600082614457577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b500490565b600481106139f8576139f86139b9565b602081526144866020820183516001600160a01b03169052565b600060208301516144a260408401826001600160a01b03169052565b5060408301516001600160a01b03811660608401525060608301516102808060808501526144d46102a0850183613a30565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a061455b818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f1901610260808801919091529091506145bd8483613a30565b9350808701519150506145d28286018261445c565b5090949350505050565b60ff81811683821601908111156117425761174261420756fea2646970667358221220dcd918eab59f09a129fd39de97f74769c23c9061b6c5d16a92996886bb44a9c964736f6c63430008150033")
 
 var AccountabilityTestAbi, _ = abi.JSON(strings.NewReader(`[
    {
@@ -4,7 +4,7 @@ import "strings"
 import "github.com/autonity/autonity/accounts/abi"
 import "github.com/autonity/autonity/common"
 
-var AutonityBytecode = common.Hex2Bytes("60806040526000600b556000600c553480156200001b57600080fd5b506040516200b5b83803806200b5b88339810160408190526200003e9162000e64565b601c546000036200006757602a80546001600160a01b031916331790556200006782826200006f565b505062001325565b80518051600d55602080820151600e55604080830151600f55606080840151601055608093840151601180546001600160a01b03199081166001600160a01b03938416179091558487015180516012805484169185169190911790558086015160138054841691851691909117905580850151601480548416918516919091179055808401516015805484169185169190911790559586015160168054831691841691909117905560a0909501516017805487169183169190911790558286015180516018805490971692169190911790945591830151601955820151601a5590810151601b55810151601c5560005b825181101562000420576000838281518110620001805762000180620010a1565b602002602001015160a0015190506000848381518110620001a557620001a5620010a1565b60200260200101516101a00181815250506000848381518110620001cd57620001cd620010a1565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620002095762000209620010a1565b602002602001015160a00181815250506000848381518110620002305762000230620010a1565b60209081029190910101516101c00152600f5484518590849081106200025a576200025a620010a1565b602002602001015160800181815250506000848381518110620002815762000281620010a1565b602002602001015161026001906003811115620002a257620002a2620010b7565b90816003811115620002b857620002b8620010b7565b815250506000848381518110620002d357620002d3620010a1565b602002602001015161016001818152505062000311848381518110620002fd57620002fd620010a1565b60200260200101516200042560201b60201c565b6200033e8483815181106200032a576200032a620010a1565b60200260200101516200056060201b60201c565b8060276000868581518110620003585762000358620010a1565b6020026020010151600001516001600160a01b03166001600160a01b031681526020019081526020016000206000828254620003959190620010e3565b925050819055508060296000828254620003b09190620010e3565b925050819055506200040a848381518110620003d057620003d0620010a1565b60200260200101516020015182868581518110620003f257620003f2620010a1565b6020026020010151600001516200079160201b60201c565b50806200041781620010ff565b9150506200015f565b505050565b60006200043c82606001516200097c60201b60201c565b6001600160a01b03909116602084015290508015620004905760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064015b60405180910390fd5b6020808301516001600160a01b03908116600090815260289092526040909120600101541615620005045760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000487565b612710826080015111156200055c5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000487565b5050565b6101808101516001600160a01b0316620005e357601d546000906200058590620009ca565b905081602001518260000151836080015183604051620005a59062000aea565b620005b494939291906200111b565b604051809103906000f080158015620005d1573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352602890955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620006959082620011ff565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f8201556102008201516010820155610220820151601182015561024082015160128201906200075f9082620011ff565b5061026082015160138201805460ff19166001836003811115620007875762000787620010b7565b0217905550505050565b60008211620007ef5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000487565b6001600160a01b038116600090815260276020526040902054821115620008595760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000487565b6001600160a01b0381166000908152602760205260408120805484929062000883908490620012cb565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b03199182161782559551600182018054919098169616959095179095559051600284015551910155805491926200090f83620010ff565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a35050505050565b6000806200098962000af8565b60008060ff9050604083875160208901845afa620009a657600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b606081600003620009f25750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000a22578062000a0981620010ff565b915062000a1a9050600a83620012f7565b9150620009f6565b6000816001600160401b0381111562000a3f5762000a3f62000b16565b6040519080825280601f01601f19166020018201604052801562000a6a576020820181803683370190505b5090505b841562000ae25762000a82600183620012cb565b915062000a91600a866200130e565b62000a9e906030620010e3565b60f81b81838151811062000ab65762000ab6620010a1565b60200101906001600160f81b031916908160001a90535062000ada600a86620012f7565b945062000a6e565b949350505050565b611a208062009b9883390190565b60405180604001604052806002906020820280368337509192915050565b634e487b7160e01b600052604160045260246000fd5b604051608081016001600160401b038111828210171562000b515762000b5162000b16565b60405290565b60405160a081016001600160401b038111828210171562000b515762000b5162000b16565b60405161028081016001600160401b038111828210171562000b515762000b5162000b16565b604051601f8201601f191681016001600160401b038111828210171562000bcd5762000bcd62000b16565b604052919050565b6001600160a01b038116811462000beb57600080fd5b50565b805162000bfb8162000bd5565b919050565b60005b8381101562000c1d57818101518382015260200162000c03565b50506000910152565b600082601f83011262000c3857600080fd5b81516001600160401b0381111562000c545762000c5462000b16565b62000c69601f8201601f191660200162000ba2565b81815284602083860101111562000c7f57600080fd5b62000ae282602083016020870162000c00565b80516004811062000bfb57600080fd5b600060c0828403121562000cb557600080fd5b60405160c081016001600160401b038111828210171562000cda5762000cda62000b16565b8060405250809150825162000cef8162000bd5565b8152602083015162000d018162000bd5565b6020820152604083015162000d168162000bd5565b6040820152606083015162000d2b8162000bd5565b6060820152608083015162000d408162000bd5565b608082015260a083015162000d558162000bd5565b60a0919091015292915050565b60006080828403121562000d7557600080fd5b62000d7f62000b2c565b9050815162000d8e8162000bd5565b8082525060208201516020820152604082015160408201526060820151606082015292915050565b600081830361020081121562000dcb57600080fd5b62000dd562000b2c565b915060a081121562000de657600080fd5b5062000df162000b57565b82518152602083015160208201526040830151604082015260608301516060820152608083015162000e238162000bd5565b6080820152815262000e398360a0840162000ca2565b602082015262000e4e83610160840162000d62565b60408201526101e0820151606082015292915050565b60008061022080848603121562000e7a57600080fd5b83516001600160401b038082111562000e9257600080fd5b818601915086601f83011262000ea757600080fd5b815160208282111562000ebe5762000ebe62000b16565b8160051b62000ecf82820162000ba2565b928352848101820192828101908b85111562000eea57600080fd5b83870192505b848310156200107f5782518681111562000f0957600080fd5b8701610280818e03601f1901121562000f2157600080fd5b62000f2b62000b7c565b62000f3886830162000bee565b815262000f486040830162000bee565b8682015262000f5a6060830162000bee565b604082015260808201518881111562000f7257600080fd5b62000f828f888386010162000c26565b60608301525060a0820151608082015260c082015160a082015260e082015160c082015261010082015160e082015261012082015161010082015261014082015161012082015261016082015161014082015261018082015161016082015262000ff06101a0830162000bee565b6101808201526101c08201516101a08201526101e08201516101c08201526102008201516101e0820152898201516102008201526102408201518a820152610260820151888111156200104257600080fd5b620010528f888386010162000c26565b6102408301525062001068610280830162000c92565b610260820152835250918301919083019062000ef0565b8099505050506200109389828a0162000db6565b955050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b80820180821115620010f957620010f9620010cd565b92915050565b600060018201620011145762001114620010cd565b5060010190565b600060018060a01b0380871683528086166020840152508360408301526080606083015282518060808401526200115a8160a085016020870162000c00565b601f01601f19169190910160a00195945050505050565b600181811c908216806200118657607f821691505b602082108103620011a757634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200042057600081815260208120601f850160051c81016020861015620011d65750805b601f850160051c820191505b81811015620011f757828155600101620011e2565b505050505050565b81516001600160401b038111156200121b576200121b62000b16565b62001233816200122c845462001171565b84620011ad565b602080601f8311600181146200126b5760008415620012525750858301515b600019600386901b1c1916600185901b178555620011f7565b600085815260208120601f198616915b828110156200129c578886015182559484019460019091019084016200127b565b5085821015620012bb5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b81810381811115620010f957620010f9620010cd565b634e487b7160e01b600052601260045260246000fd5b600082620013095762001309620012e1565b500490565b600082620013205762001320620012e1565b500690565b61886380620013356000396000f3fe608060405260043610620003db5760003560e01c8063872cf0591162000203578063b66b3e791162000117578063d372c07e11620000a7578063dd62ed3e1162000075578063dd62ed3e1462000ced578063dfb1a4d21462000d37578063e7f43c681462000d4e578063f7866ee31462000d6e57005b8063d372c07e1462000c69578063d5f394881462000c8e578063d861b0e81462000cb0578063d886f8a21462000cc857005b8063cb696f5411620000e5578063cb696f541462000be2578063ceaad4551462000c07578063cf9c57191462000c2c578063cfd19fb91462000c4457005b8063b66b3e791462000b72578063b7ab4db51462000b9a578063c2362dd51462000bb2578063c9d97af41462000bca57005b8063a5d059ca1162000193578063ae1f5fa01162000161578063ae1f5fa01462000adc578063b2ea9adb1462000b03578063b3ecbadd1462000b28578063b46e55201462000b4d57005b8063a5d059ca1462000a44578063a8b2216e1462000a69578063a9059cbb1462000a90578063ab8f6ffe1462000ab557005b80639bb851c011620001d15780639bb851c014620009ca5780639c98e47114620009e25780639dc29fac14620009fa578063a515366a1462000a1f57005b8063872cf05914620009145780638bac7dad146200092c57806395d89b41146200095157806396b477cb146200099957005b806340c10f1911620002fb57806370a08231116200028b578063819b64631162000259578063819b64631462000893578063833b1fce14620008aa57806384467fdb14620008ca578063852c484914620008ef57005b806370a08231146200073f578063731b3a03146200077957806377e741c7146200079057806379502c5514620007b557005b8063520fdbbc11620002c9578063520fdbbc14620006a05780635f7d394914620006c55780636b5f444c14620007035780636fd2c80b146200072857005b806340c10f19146200061757806343645969146200063c578063496ccd9b14620006535780634bb278f3146200067857005b80631604e416116200037757806329070c6d116200034557806329070c6d14620005a55780632f2c3f2e14620005bc578063313ce56714620005d457806335be16e014620005f257005b80631604e416146200051d57806318160ddd14620005355780631904bb2e146200054c57806323b872dd146200058057005b80630d8e6e2c11620003b55780630d8e6e2c146200049b5780631122063314620004bc578063114eaf5514620004d35780631250a28d14620004f857005b806306fdde0314620003e5578063095ea7b314620004405780630ae65e7a146200047657005b36620003e357005b005b348015620003f257600080fd5b5060408051808201909152600681527f4e6577746f6e000000000000000000000000000000000000000000000000000060208201525b60405162000437919062005d81565b60405180910390f35b3480156200044d57600080fd5b50620004656200045f36600462005db3565b62000d8e565b604051901515815260200162000437565b3480156200048357600080fd5b50620003e36200049536600462005de2565b62000da7565b348015620004a857600080fd5b50601c545b60405190815260200162000437565b348015620004c957600080fd5b50600e54620004ad565b348015620004e057600080fd5b50620003e3620004f236600462005e02565b62000eb9565b3480156200050557600080fd5b50620003e36200051736600462005de2565b62000f1a565b3480156200052a57600080fd5b50620004ad60245481565b3480156200054257600080fd5b50602954620004ad565b3480156200055957600080fd5b50620005716200056b36600462005de2565b62000fa5565b60405162000437919062005e87565b3480156200058d57600080fd5b50620004656200059f36600462006000565b62001282565b348015620005b257600080fd5b50600d54620004ad565b348015620005c957600080fd5b50620004ad61271081565b348015620005e157600080fd5b506040516012815260200162000437565b348015620005ff57600080fd5b50620003e36200061136600462006046565b620012dc565b3480156200062457600080fd5b50620003e36200063636600462005db3565b620014d2565b3480156200064957600080fd5b50601a54620004ad565b3480156200066057600080fd5b50620003e36200067236600462005de2565b620015bb565b3480156200068557600080fd5b506200069062001733565b6040516200043792919062006107565b348015620006ad57600080fd5b50620003e3620006bf36600462005de2565b62001bb3565b348015620006d257600080fd5b50620006ea620006e436600462006124565b62001e67565b6040516001600160a01b03909116815260200162000437565b3480156200071057600080fd5b50620003e36200072236600462005e02565b62002092565b3480156200073557600080fd5b50601054620004ad565b3480156200074c57600080fd5b50620004ad6200075e36600462005de2565b6001600160a01b031660009081526027602052604090205490565b3480156200078657600080fd5b50602054620004ad565b3480156200079d57600080fd5b50620003e3620007af36600462005e02565b62002260565b348015620007c257600080fd5b506040805160a08082018352600d548252600e54602080840191909152600f54838501526010546060808501919091526011546001600160a01b03908116608080870191909152865160c0810188526012548316815260135483168186015260145483168189015260155483168185015260165483168183015260175483169581019590955286519081018752601854909116815260195492810192909252601a5494820194909452601b5493810193909352601c5462000881939084565b60405162000437949392919062006147565b348015620008a057600080fd5b50601b54620004ad565b348015620008b757600080fd5b506013546001600160a01b0316620006ea565b348015620008d757600080fd5b50620003e3620008e9366004620062e3565b620022c1565b348015620008fc57600080fd5b50620003e36200090e36600462005db3565b620023f0565b3480156200092157600080fd5b50620003e362002612565b3480156200093957600080fd5b50620003e36200094b36600462005e02565b6200267d565b3480156200095e57600080fd5b5060408051808201909152600381527f4e544e0000000000000000000000000000000000000000000000000000000000602082015262000428565b348015620009a657600080fd5b50620004ad620009b836600462005e02565b6000908152601f602052604090205490565b348015620009d757600080fd5b50620004ad60235481565b348015620009ef57600080fd5b50620004ad60215481565b34801562000a0757600080fd5b50620003e362000a1936600462005db3565b62002730565b34801562000a2c57600080fd5b50620003e362000a3e36600462005db3565b6200287c565b34801562000a5157600080fd5b50620003e362000a6336600462005db3565b6200297e565b34801562000a7657600080fd5b5062000a8162002a4c565b6040516200043791906200638c565b34801562000a9d57600080fd5b506200046562000aaf36600462005db3565b62002b2f565b34801562000ac257600080fd5b5062000acd62002b3e565b60405162000437919062006410565b34801562000ae957600080fd5b5062000af462002c50565b60405162000437919062006425565b34801562000b1057600080fd5b50620003e362000b2236600462006474565b62002ef6565b34801562000b3557600080fd5b50620003e362000b4736600462005de2565b62002f6c565b34801562000b5a57600080fd5b50620003e362000b6c36600462005de2565b62002ff7565b34801562000b7f57600080fd5b5062000b8a620032b7565b60405162000437929190620064df565b34801562000ba757600080fd5b5062000af4620033ee565b34801562000bbf57600080fd5b50620004ad60205481565b34801562000bd757600080fd5b50620004ad601e5481565b34801562000bef57600080fd5b50620003e362000c0136600462005e02565b62003452565b34801562000c1457600080fd5b50620003e362000c2636600462005de2565b620034e4565b34801562000c3957600080fd5b50620003e36200356f565b34801562000c5157600080fd5b50620003e362000c6336600462005de2565b620035f2565b34801562000c7657600080fd5b50620003e362000c8836600462005de2565b6200367d565b34801562000c9b57600080fd5b50602a54620006ea906001600160a01b031681565b34801562000cbd57600080fd5b50620003e362003708565b34801562000cd557600080fd5b50620003e362000ce736600462005de2565b6200379e565b34801562000cfa57600080fd5b50620004ad62000d0c36600462006511565b6001600160a01b03918216600090815260266020908152604080832093909416825291909152205490565b34801562000d4457600080fd5b50601954620004ad565b34801562000d5b57600080fd5b506018546001600160a01b0316620006ea565b34801562000d7b57600080fd5b506011546001600160a01b0316620006ea565b600062000d9d33848462003829565b5060015b92915050565b6001600160a01b038082166000818152602860205260409020600101549091161462000e1a5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d75737420626520726567697374657265640000000060448201526064015b60405180910390fd5b6001600160a01b0381811660009081526028602052604090205416331462000eab5760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162000e11565b62000eb68162003986565b50565b6018546001600160a01b0316331462000f155760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b601055565b6018546001600160a01b0316331462000f765760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6012805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b62000faf62005b5a565b6001600160a01b03808316600081815260286020526040902060010154909116146200101e5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162000e11565b6001600160a01b03808316600090815260286020908152604091829020825161028081018452815485168152600182015485169281019290925260028101549093169181019190915260038201805491929160608401919062001081906200654f565b80601f0160208091040260200160405190810160405280929190818152602001828054620010af906200654f565b8015620011005780601f10620010d45761010080835404028352916020019162001100565b820191906000526020600020905b815481529060010190602001808311620010e257829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e090920191620011bc906200654f565b80601f0160208091040260200160405190810160405280929190818152602001828054620011ea906200654f565b80156200123b5780601f106200120f576101008083540402835291602001916200123b565b820191906000526020600020905b8154815290600101906020018083116200121d57829003601f168201915b5050509183525050601382015460209091019060ff16600381111562001265576200126562005e1c565b600381111562001279576200127962005e1c565b90525092915050565b60006200129184848462003a5d565b6001600160a01b0384166000908152602660209081526040808320338452909152812054620012c2908490620065d3565b9050620012d185338362003829565b506001949350505050565b6012546001600160a01b031633146200135e5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e747260448201527f6163740000000000000000000000000000000000000000000000000000000000606482015260840162000e11565b60006101208201356028826200137b604086016020870162005de2565b6001600160a01b03166001600160a01b0316815260200190815260200160002060090154620013ab9190620065d3565b60c083013560286000620013c6604087016020880162005de2565b6001600160a01b03166001600160a01b0316815260200190815260200160002060060154620013f69190620065d3565b60a08401356028600062001411604088016020890162005de2565b6001600160a01b03166001600160a01b0316815260200190815260200160002060050154620014419190620065d3565b6200144d9190620065e9565b620014599190620065e9565b6011546001600160a01b03166000908152602760205260408120805492935083929091906200148a908490620065e9565b9091555082905060286000620014a7604084016020850162005de2565b6001600160a01b031681526020810191909152604001600020620014cc8282620067f9565b50505050565b6018546001600160a01b031633146200152e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6001600160a01b0382166000908152602760205260408120805483929062001558908490620065e9565b925050819055508060296000828254620015739190620065e9565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf906020015b60405180910390a25050565b6018546001600160a01b03163314620016175760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6013805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556014546040517f7adbf973000000000000000000000000000000000000000000000000000000008152600481019290925290911690637adbf97390602401600060405180830381600087803b1580156200169c57600080fd5b505af1158015620016b1573d6000803e3d6000fd5b50506016546040517f7adbf9730000000000000000000000000000000000000000000000000000000081526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b1580156200171757600080fd5b505af11580156200172c573d6000803e3d6000fd5b5050505050565b602a546000906060906001600160a01b03163314620017bb5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162000e11565b601e54436000818152601f6020908152604082209390935560195492549092620017e591620065e9565b6012546040517f6c9789b0000000000000000000000000000000000000000000000000000000008152929091146004830181905292506001600160a01b031690636c9789b090602401600060405180830381600087803b1580156200184957600080fd5b505af11580156200185e573d6000803e3d6000fd5b5050505080156200196c576200187362003b6d565b6200187d62003f6f565b620018876200405e565b60006200189362002c50565b6013546040517f845023f20000000000000000000000000000000000000000000000000000000081529192506001600160a01b03169063845023f290620018df90849060040162006425565b600060405180830381600087803b158015620018fa57600080fd5b505af11580156200190f573d6000803e3d6000fd5b50505050436020819055506001601e60008282546200192f9190620065e9565b9091555050601e546040519081527febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3359060200160405180910390a1505b601354604080517f4bb278f300000000000000000000000000000000000000000000000000000000815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af1158015620019d1573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620019f79190620069de565b9050801562001a8c57601454604080517fa2e6204500000000000000000000000000000000000000000000000000000000815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af192505050801562001a84575060408051601f3d908101601f1916820190925262001a8191810190620069de565b60015b1562001a8c57505b600254602280546040805160208084028201810190925282815260ff9094169391839160009084015b8282101562001ba3576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162001b0b906200654f565b80601f016020809104026020016040519081016040528092919081815260200182805462001b39906200654f565b801562001b8a5780601f1062001b5e5761010080835404028352916020019162001b8a565b820191906000526020600020905b81548152906001019060200180831162001b6c57829003601f168201915b5050505050815250508152602001906001019062001ab5565b5050505090509350935050509091565b6018546001600160a01b0316331462001c0f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6018805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556013546040517fb3ab15fb00000000000000000000000000000000000000000000000000000000815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b15801562001c9457600080fd5b505af115801562001ca9573d6000803e3d6000fd5b50506014546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001d0e57600080fd5b505af115801562001d23573d6000803e3d6000fd5b50506015546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001d8857600080fd5b505af115801562001d9d573d6000803e3d6000fd5b50506016546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001e0257600080fd5b505af115801562001e17573d6000803e3d6000fd5b50506017546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401620016fc565b600080805b60225481101562001ec3576022818154811062001e8d5762001e8d62006a02565b9060005260206000209060030201600101548262001eac9190620065e9565b91508062001eba8162006a31565b91505062001e6c565b508060000362001f165760405162461bcd60e51b815260206004820152601c60248201527f54686520636f6d6d6974746565206973206e6f74207374616b696e6700000000604482015260640162000e11565b60008362001f2660638762006a4e565b62001f329190620065e9565b905060008160405160200162001f4a91815260200190565b60408051601f1981840301815291905280516020909101209050600062001f72848362006a97565b90506000805b60225481101562002022576022818154811062001f995762001f9962006a02565b9060005260206000209060030201600101548262001fb89190620065e9565b915062001fc7600183620065d3565b83116200200d576022818154811062001fe45762001fe462006a02565b60009182526020909120600390910201546001600160a01b0316965062000da195505050505050565b80620020198162006a31565b91505062001f78565b5060405162461bcd60e51b815260206004820152602960248201527f5468657265206973206e6f2076616c696461746f72206c65667420696e20746860448201527f65206e6574776f726b0000000000000000000000000000000000000000000000606482015260840162000e11565b6018546001600160a01b03163314620020ee5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b601954811015620021a55780602054620021099190620065e9565b4310620021a55760405162461bcd60e51b815260206004820152605760248201527f63757272656e7420636861696e2068656164206578636565642074686520776960448201527f6e646f773a206c617374426c6f636b45706f6368202b205f6e6577506572696f60648201527f642c2074727920616761696e206c6174746572206f6e2e000000000000000000608482015260a40162000e11565b60198190556012546040517f6b5f444c000000000000000000000000000000000000000000000000000000008152600481018390526001600160a01b0390911690636b5f444c90602401600060405180830381600087803b1580156200220a57600080fd5b505af11580156200221f573d6000803e3d6000fd5b505050507fd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f81816040516200225591815260200190565b60405180910390a150565b6018546001600160a01b03163314620022bc5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b600d55565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b03168152602001868152602001600d6000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020014381526020016000815260200160008152602001600081526020018481526020016000600381111562002390576200239062005e1c565b90529050620023a08183620041a3565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c92620023e192339289918b9162006aae565b60405180910390a15050505050565b6001600160a01b03808316600081815260286020526040902060010154909116146200245f5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000e11565b6001600160a01b03828116600090815260286020526040902054163314620024f05760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162000e11565b612710811115620025445760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e207261746500604482015260640162000e11565b604080516060810182526001600160a01b038481168252436020808401918252838501868152600c80546000908152600a9093529582208551815473ffffffffffffffffffffffffffffffffffffffff1916951694909417845591516001808501919091559151600290930192909255835492939092909190620025ca908490620065e9565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6018546001600160a01b031633146200266e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6002805460ff19166001179055565b6018546001600160a01b03163314620026d95760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b600081116200272b5760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e2774206265203000000000000000604482015260640162000e11565b601b55565b6018546001600160a01b031633146200278c5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6001600160a01b038216600090815260276020526040902054811115620027f65760405162461bcd60e51b815260206004820152601660248201527f416d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162000e11565b6001600160a01b0382166000908152602760205260408120805483929062002820908490620065d3565b9250508190555080602960008282546200283b9190620065d3565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a390602001620015af565b6001600160a01b0380831660008181526028602052604090206001015490911614620028eb5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162000e11565b6001600160a01b03821660009081526028602052604081206013015460ff1660038111156200291e576200291e62005e1c565b146200296d5760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f206265206163746976650000000000604482015260640162000e11565b6200297a82823362004646565b5050565b6001600160a01b0380831660008181526028602052604090206001015490911614620029ed5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162000e11565b6000811162002a3f5760405162461bcd60e51b815260206004820152601560248201527f756e626f6e64696e6720616d6f756e7420697320300000000000000000000000604482015260640162000e11565b6200297a82823362004859565b60606025805480602002602001604051908101604052809291908181526020016000905b8282101562002b2657838290600052602060002001805462002a92906200654f565b80601f016020809104026020016040519081016040528092919081815260200182805462002ac0906200654f565b801562002b115780601f1062002ae55761010080835404028352916020019162002b11565b820191906000526020600020905b81548152906001019060200180831162002af357829003601f168201915b50505050508152602001906001019062002a70565b50505050905090565b600062000d9d33848462003a5d565b60606022805480602002602001604051908101604052809291908181526020016000905b8282101562002b26576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162002bb8906200654f565b80601f016020809104026020016040519081016040528092919081815260200182805462002be6906200654f565b801562002c375780601f1062002c0b5761010080835404028352916020019162002c37565b820191906000526020600020905b81548152906001019060200180831162002c1957829003601f168201915b5050505050815250508152602001906001019062002b62565b602a546060906001600160a01b0316331462002cd55760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162000e11565b601d5462002d265760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f72730000000000000000604482015260640162000e11565b62002d3062005c2a565b601b546080820152601d815260286020820152602260408201526021606082015262002d5c8162004c2d565b62002d6a6025600062005c48565b6022548062002dbc5760405162461bcd60e51b815260206004820152601260248201527f636f6d6d697474656520697320656d7074790000000000000000000000000000604482015260640162000e11565b60008167ffffffffffffffff81111562002dda5762002dda6200621f565b60405190808252806020026020018201604052801562002e04578160200160208202803683370190505b50905060005b8281101562002eee576000602860006022848154811062002e2f5762002e2f62006a02565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060258054600181018255925292507f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c019062002e979083018262006af8565b50600281015483516001600160a01b039091169084908490811062002ec05762002ec062006a02565b6001600160a01b0390921660209283029190910190910152508062002ee58162006a31565b91505062002e0a565b509250505090565b6018546001600160a01b0316331462002f525760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b62002f5f60008362004c48565b6200297a60018262004c48565b6018546001600160a01b0316331462002fc85760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6015805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b0380821660008181526028602052604090206001015490911614620030665760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000e11565b6001600160a01b03808216600090815260286020526040902080549091163314620030fa5760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162000e11565b6000601382015460ff16600381111562003118576200311862005e1c565b03620031675760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c7265616479206163746976650000000000000000604482015260640162000e11565b6002601382015460ff16600381111562003185576200318562005e1c565b148015620031965750438160100154115b15620031e55760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c000000000000000000604482015260640162000e11565b6003601382015460ff16600381111562003203576200320362005e1c565b03620032525760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c7900000000604482015260640162000e11565b60138101805460ff1916905580546019546020546001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b591620032a29190620065e9565b60405190815260200160405180910390a35050565b60608060006001818054620032cc906200654f565b80601f0160208091040260200160405190810160405280929190818152602001828054620032fa906200654f565b80156200334b5780601f106200331f576101008083540402835291602001916200334b565b820191906000526020600020905b8154815290600101906020018083116200332d57829003601f168201915b5050505050915080805462003360906200654f565b80601f01602080910402602001604051908101604052809291908181526020018280546200338e906200654f565b8015620033df5780601f10620033b357610100808354040283529160200191620033df565b820191906000526020600020905b815481529060010190602001808311620033c157829003601f168201915b50505050509050915091509091565b6060601d8054806020026020016040519081016040528092919081815260200182805480156200344857602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162003429575b5050505050905090565b6018546001600160a01b03163314620034ae5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b600e8190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200162002255565b6018546001600160a01b03163314620035405760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6017805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6018546001600160a01b03163314620035cb5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b620035d860008062005c68565b620035e66001600062005c68565b6002805460ff19169055565b6018546001600160a01b031633146200364e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6016805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6018546001600160a01b03163314620036d95760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6014805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b602a546001600160a01b031633146200378a5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162000e11565b6200379462003f6f565b62000eb662002c50565b6018546001600160a01b03163314620037fa5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6011805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b038316620038a65760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f7265737300000000000000000000000000000000000000000000000000000000606482015260840162000e11565b6001600160a01b038216620039245760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f7373000000000000000000000000000000000000000000000000000000000000606482015260840162000e11565b6001600160a01b0383811660008181526026602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6001600160a01b038116600090815260286020526040812090601382015460ff166003811115620039bb57620039bb62005e1c565b1462003a0a5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d757374206265206163746976650000000000000000604482015260640162000e11565b60138101805460ff1916600117905580546019546020546001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c91620032a29190620065e9565b6001600160a01b03831660009081526027602052604090205481111562003ac75760405162461bcd60e51b815260206004820152601660248201527f616d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162000e11565b6001600160a01b0383166000908152602760205260408120805483929062003af1908490620065d3565b90915550506001600160a01b0382166000908152602760205260408120805483929062003b20908490620065e9565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef836040516200397991815260200190565b4760000362003b7857565b600d544790600090670de0b6b3a76400009062003b9790849062006a4e565b62003ba3919062006be5565b9050801562003c21576011546040516000916001600160a01b03169083908381818185875af1925050503d806000811462003bfb576040519150601f19603f3d011682016040523d82523d6000602084013e62003c00565b606091505b509091505080151560010362003c1f5762003c1c8284620065d3565b92505b505b816023600082825462003c359190620065e9565b90915550600090505b60225481101562003f6a576000602860006022848154811062003c655762003c6562006a02565b600091825260208083206003909202909101546001600160a01b0316835282019290925260400181206021546022805492945090918791908690811062003cb05762003cb062006a02565b90600052602060002090600302016001015462003cce919062006a4e565b62003cda919062006be5565b9050801562003f52576002601383015460ff16600381111562003d015762003d0162005e1c565b148062003d2957506003601383015460ff16600381111562003d275762003d2762005e1c565b145b1562003de957601254602280546001600160a01b0390921691631de9d9b69184918790811062003d5d5762003d5d62006a02565b600091825260209091206003909102015460405160e084901b7fffffffff000000000000000000000000000000000000000000000000000000001681526001600160a01b0390911660048201526024016000604051808303818588803b15801562003dc757600080fd5b505af115801562003ddc573d6000803e3d6000fd5b5050505050505062003f55565b6000826005015482846008015462003e02919062006a4e565b62003e0e919062006be5565b9050600062003e1e8284620065d3565b9050811562003e835783546040516001600160a01b03909116906108fc9084906000818181858888f193505050503d806000811462003e7a576040519150601f19603f3d011682016040523d82523d6000602084013e62003e7f565b606091505b5050505b801562003f0b5783600c0160009054906101000a90046001600160a01b03166001600160a01b031663fb489a7b826040518263ffffffff1660e01b815260040160206040518083038185885af115801562003ee2573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019062003f09919062006bfc565b505b60018401546040518481526001600160a01b03909116907fb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe5639060200160405180910390a250505b50505b8062003f618162006a31565b91505062003c3e565b505050565b6004545b60055481101562003f9c5762003f968162003f8e8162006a31565b925062004d9b565b62003f73565b506005546004556008546007540362003fb157565b6009545b60085481101562003fde5762003fd88162003fd08162006a31565b925062004fdb565b62003fb5565b50600854600955600754805b600854811015620040585760105460008281526006602052604090206004015443916200401791620065e9565b116200403d57620040288162005308565b62004035600183620065e9565b915062004043565b62004058565b806200404f8162006a31565b91505062003fea565b50600755565b600c54600b541015620041a157600b546000908152600a60205260409020601054600182015443916200409191620065e9565b11156200409b5750565b600281015481546001600160a01b03908116600090815260286020526040808220600490810185905585548416835291819020600c015490517f19fac8fd0000000000000000000000000000000000000000000000000000000081529216926319fac8fd926200410f920190815260200190565b600060405180830381600087803b1580156200412a57600080fd5b505af11580156200413f573d6000803e3d6000fd5b5050600b80546000908152600a60205260408120805473ffffffffffffffffffffffffffffffffffffffff191681556001808201839055600290910182905582549094509192509062004194908490620065e9565b909155506200405e915050565b565b60e2815114620041f65760405162461bcd60e51b815260206004820152601460248201527f496e76616c69642070726f6f66206c656e677468000000000000000000000000604482015260640162000e11565b603082610240015151146200424e5760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e67746800000000604482015260640162000e11565b620042598262005453565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a000000000000602080830191909152845192519192600092620042cf920160609190911b7fffffffffffffffffffffffffffffffffffffffff00000000000000000000000016815260140190565b6040516020818303038152906040529050600082620042ef835162005592565b83604051602001620043049392919062006c16565b60408051601f19818403018152828252805160209182012060028085526060850184529094506000939290918301908036833701905050905060008080806200435c8982620043566041600262006a4e565b620056e7565b905060006200437b8a620043736041600262006a4e565b6060620056e7565b905060205b82518110156200444c576200439683826200581b565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa158015620043f2573d6000803e3d6000fd5b5050604051601f1901519050876200440c60418462006be5565b815181106200441f576200441f62006a02565b6001600160a01b039092166020928302919091019091015262004444604182620065e9565b905062004380565b508a602001516001600160a01b03168660008151811062004471576200447162006a02565b60200260200101516001600160a01b031614620044f75760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f6660448201527f2070726f76696465640000000000000000000000000000000000000000000000606482015260840162000e11565b8a604001516001600160a01b0316866001815181106200451b576200451b62006a02565b60200260200101516001600160a01b031614620045a15760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201527f6f662070726f7669646564000000000000000000000000000000000000000000606482015260840162000e11565b6001620045b98c6102400151838e6000015162005852565b146200462e5760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e6572736869702060448201527f70726f6f6620666f7220726567697374726174696f6e00000000000000000000606482015260840162000e11565b620046398b620058c1565b5050505050505050505050565b60008211620046be5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201527f6976650000000000000000000000000000000000000000000000000000000000606482015260840162000e11565b6001600160a01b038116600090815260276020526040902054821115620047285760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000e11565b6001600160a01b0381166000908152602760205260408120805484929062004752908490620065d3565b9091555050604080516080810182526001600160a01b038084168252858116602080840191825283850187815243606086019081526005805460009081526003948590529788208751815490881673ffffffffffffffffffffffffffffffffffffffff19918216178255955160018201805491909816961695909517909555905160028401555191015580549192620047eb8362006a31565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d91015b60405180910390a35050505050565b6001600160a01b038084166000908152602860205260409020805490918381169116148062004a1357600c8201546040517f84955c880000000000000000000000000000000000000000000000000000000081526001600160a01b03858116600483015260009216906384955c8890602401602060405180830381865afa158015620048e9573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200490f919062006bfc565b905084811015620049895760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201527f746f6e2062616c616e6365000000000000000000000000000000000000000000606482015260840162000e11565b600c8301546040517f282d3fdf0000000000000000000000000000000000000000000000000000000081526001600160a01b038681166004830152602482018890529091169063282d3fdf90604401600060405180830381600087803b158015620049f357600080fd5b505af115801562004a08573d6000803e3d6000fd5b505050505062004abc565b8382600b0154836008015462004a2a9190620065d3565b101562004aa05760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201527f62616c616e636500000000000000000000000000000000000000000000000000606482015260840162000e11565b8382600b01600082825462004ab69190620065e9565b90915550505b6040805160e0810182526001600160a01b0380861682528781166020808401918252838501898152600060608601818152436080880190815260a088018381528a151560c08a0190815260088054865260069097529984209851895490891673ffffffffffffffffffffffffffffffffffffffff19918216178a55965160018a0180549190991697169690961790965591516002870155905160038601559251600485015590516005909301805494511515610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff941515949094167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000909516949094179290921790925580549162004bd78362006a31565b9190505550826001600160a01b0316856001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc83876040516200484a9291909115158252602082015260400190565b60fa60a06000808285855af462003f6a573d6000803e3d6000fd5b81546002600180831615610100020382160482518082016020811060208410016002811462004cf7576001811462004d1d578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b8082101562004ce0578151845560018401935060208201915062004cc1565b815191036101000a90819004029091555062004d92565b60028302826020036101000a846020036101000a60208901510402018501875562004d92565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b8082101562004d80578151845560018401935060208201915062004d61565b815191036101000a9081900402909155505b50505050505050565b600081815260036020908152604080832060018101546001600160a01b03168452602890925282209091601382015460ff16600381111562004de15762004de162005e1c565b1462004e8157600282015482546001600160a01b03166000908152602760205260408120805490919062004e17908490620065e9565b909155505081546001830154600284015460138401546040517f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f8783429462004e74946001600160a01b0391821694911692909160ff9091169062006c5f565b60405180910390a1505050565b805482546001600160a01b0390811691161462004f97576000808260080154836005015462004eb19190620065d3565b90508060000362004ec9578360020154915062004eef565b80846002015484600d015462004ee0919062006a4e565b62004eec919062006be5565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b15801562004f5a57600080fd5b505af115801562004f6f573d6000803e3d6000fd5b505050508183600d01600082825462004f899190620065e9565b9091555062004fb792505050565b816002015481600801600082825462004fb19190620065e9565b90915550505b816002015481600501600082825462004fd19190620065e9565b9091555050505050565b600081815260066020908152604080832060018101546001600160a01b0316845260289092528220600582015491929091610100900460ff1662005201576002830154600c83015484546040517f7eee288d0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b1580156200508957600080fd5b505af11580156200509e573d6000803e3d6000fd5b50505050600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b1580156200510d57600080fd5b505af115801562005122573d6000803e3d6000fd5b505050506000836008015484600501546200513e9190620065d3565b600d85015490915062005152828462006a4e565b6200515e919062006be5565b92508184600d016000828254620051769190620065d3565b90915550506006840154600003620051955760038501839055620051bd565b60068401546007850154620051ab908562006a4e565b620051b7919062006be5565b60038601555b82846006016000828254620051d39190620065e9565b90915550506003850154600785018054600090620051f3908490620065e9565b90915550620052d692505050565b50600282015460088201548111156200521b575060088101545b81600901546000036200523557600383018190556200525d565b6009820154600a8301546200524b908362006a4e565b62005257919062006be5565b60038401555b80826009016000828254620052739190620065e9565b90915550506003830154600a8301805460009062005293908490620065e9565b9250508190555080826008016000828254620052b09190620065d3565b90915550506002830154600b83018054600090620052d0908490620065d3565b90915550505b6005808401805460ff1916600117905582018054829190600090620052fd908490620065d3565b909155505050505050565b6000818152600660205260408120600381015490910362005327575050565b60018101546001600160a01b031660009081526028602052604081206005830154909190610100900460ff16620053c35781600701548260060154846003015462005373919062006a4e565b6200537f919062006be5565b905080826006016000828254620053979190620065d3565b90915550506003830154600783018054600090620053b7908490620065d3565b90915550620054289050565b81600a015482600901548460030154620053de919062006a4e565b620053ea919062006be5565b905080826009016000828254620054029190620065d3565b90915550506003830154600a8301805460009062005422908490620065d3565b90915550505b82546001600160a01b031660009081526027602052604081208054839290620052fd908490620065e9565b600062005464826060015162005b0c565b6001600160a01b03909116602084015290508015620054c65760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f72000000000000000000000000000000000000000000604482015260640162000e11565b6020808301516001600160a01b039081166000908152602890925260409091206001015416156200553a5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000e11565b612710826080015111156200297a5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000e11565b606081600003620055d657505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b8115620056065780620055ed8162006a31565b9150620055fe9050600a8362006be5565b9150620055da565b60008167ffffffffffffffff8111156200562457620056246200621f565b6040519080825280601f01601f1916602001820160405280156200564f576020820181803683370190505b5090505b8415620056df5762005667600183620065d3565b915062005676600a8662006a97565b62005683906030620065e9565b60f81b8183815181106200569b576200569b62006a02565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a905350620056d7600a8662006be5565b945062005653565b949350505050565b606081620056f781601f620065e9565b1015620057475760405162461bcd60e51b815260206004820152600e60248201527f736c6963655f6f766572666c6f77000000000000000000000000000000000000604482015260640162000e11565b620057538284620065e9565b84511015620057a55760405162461bcd60e51b815260206004820152601160248201527f736c6963655f6f75744f66426f756e6473000000000000000000000000000000604482015260640162000e11565b606082158015620057c6576040519150600082526020820160405262005812565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101562005801578051835260209283019201620057e7565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b8110156200584b5762005848601b8262006c8d565b90505b9250925092565b60006200585e62005ca7565b6000858585604051602001620058779392919062006ca9565b6040516020818303038152906040529050600060fb9050600082516020620058a09190620065e9565b90506020848285855afa620058b457600080fd5b5050905195945050505050565b6101808101516001600160a01b03166200594457601d54600090620058e69062005592565b905081602001518260000151836080015183604051620059069062005cc5565b62005915949392919062006d0b565b604051809103906000f08015801562005932573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f909101805473ffffffffffffffffffffffffffffffffffffffff199081166001600160a01b039586161790915584518416835260289095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062005a03908262006d49565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c8201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062005ada908262006d49565b5061026082015160138201805460ff1916600183600381111562005b025762005b0262005e1c565b0217905550505050565b60008062005b1962005cd3565b60008060ff9050604083875160208901845afa62005b3657600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562005c255762005c2562005e1c565b905290565b6040518060a001604052806005906020820280368337509192915050565b508054600082559060005260206000209081019062000eb6919062005cf1565b50805462005c76906200654f565b6000825580601f1062005c87575050565b601f01602090049060005260206000209081019062000eb6919062005d16565b60405180602001604052806001906020820280368337509192915050565b611a208062006e0e83390190565b60405180604001604052806002906020820280368337509192915050565b8082111562005d1257600062005d08828262005c68565b5060010162005cf1565b5090565b5b8082111562005d12576000815560010162005d17565b60005b8381101562005d4a57818101518382015260200162005d30565b50506000910152565b6000815180845262005d6d81602086016020860162005d2d565b601f01601f19169290920160200192915050565b60208152600062005d96602083018462005d53565b9392505050565b6001600160a01b038116811462000eb657600080fd5b6000806040838503121562005dc757600080fd5b823562005dd48162005d9d565b946020939093013593505050565b60006020828403121562005df557600080fd5b813562005d968162005d9d565b60006020828403121562005e1557600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6004811062005e83577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b9052565b6020815262005ea26020820183516001600160a01b03169052565b6000602083015162005ebf60408401826001600160a01b03169052565b5060408301516001600160a01b038116606084015250606083015161028080608085015262005ef36102a085018362005d53565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a062005f7b818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f19016102608088019190915290915062005fdf848362005d53565b93508087015191505062005ff68286018262005e4b565b5090949350505050565b6000806000606084860312156200601657600080fd5b8335620060238162005d9d565b92506020840135620060358162005d9d565b929592945050506040919091013590565b6000602082840312156200605957600080fd5b813567ffffffffffffffff8111156200607157600080fd5b8201610280818503121562005d9657600080fd5b600081518084526020808501808196508360051b8101915082860160005b85811015620060fa578284038952815180516001600160a01b031685528581015186860152604090810151606091860182905290620060e58187018362005d53565b9a87019a9550505090840190600101620060a3565b5091979650505050505050565b8215158152604060208201526000620056df604083018462006085565b600080604083850312156200613857600080fd5b50508035926020909101359150565b6000610200820190508551825260208601516020830152604086015160408301526060860151606083015260808601516001600160a01b0380821660808501528087511660a08501528060208801511660c08501528060408801511660e08501528060608801511661010085015280608088015116610120850152505060a0850151620061e06101408401826001600160a01b03169052565b5083516001600160a01b0316610160830152602084015161018083015260408401516101a08301526060909301516101c08201526101e0015292915050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b600082601f8301126200626057600080fd5b813567ffffffffffffffff808211156200627e576200627e6200621f565b604051601f8301601f19908116603f01168101908282118183101715620062a957620062a96200621f565b81604052838152866020858801011115620062c357600080fd5b836020870160208301376000602085830101528094505050505092915050565b60008060008060808587031215620062fa57600080fd5b843567ffffffffffffffff808211156200631357600080fd5b62006321888389016200624e565b955060208701359150620063358262005d9d565b909350604086013590808211156200634c57600080fd5b6200635a888389016200624e565b935060608701359150808211156200637157600080fd5b5062006380878288016200624e565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101562006403577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc0888603018452620063f085835162005d53565b94509285019290850190600101620063b3565b5092979650505050505050565b60208152600062005d96602083018462006085565b6020808252825182820181905260009190848201906040850190845b81811015620064685783516001600160a01b03168352928401929184019160010162006441565b50909695505050505050565b600080604083850312156200648857600080fd5b823567ffffffffffffffff80821115620064a157600080fd5b620064af868387016200624e565b93506020850135915080821115620064c657600080fd5b50620064d5858286016200624e565b9150509250929050565b604081526000620064f4604083018562005d53565b828103602084015262006508818562005d53565b95945050505050565b600080604083850312156200652557600080fd5b8235620065328162005d9d565b91506020830135620065448162005d9d565b809150509250929050565b600181811c908216806200656457607f821691505b6020821081036200659e577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111562000da15762000da1620065a4565b8082018082111562000da15762000da1620065a4565b6000813562000da18162005d9d565b60008083357fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe18436030181126200664457600080fd5b83018035915067ffffffffffffffff8211156200666057600080fd5b6020019150368190038213156200667657600080fd5b9250929050565b601f82111562003f6a57600081815260208120601f850160051c81016020861015620066a65750805b601f850160051c820191505b81811015620066c757828155600101620066b2565b505050505050565b67ffffffffffffffff831115620066ea57620066ea6200621f565b6200670283620066fb83546200654f565b836200667d565b6000601f841160018114620067395760008515620067205750838201355b600019600387901b1c1916600186901b1783556200172c565b600083815260209020601f19861690835b828110156200676c57868501358255602094850194600190920191016200674a565b50868210156200678a5760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b600081356004811062000da157600080fd5b60048210620067e6577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b60ff1981541660ff831681178255505050565b620068326200680883620065ff565b825473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0391909116178255565b620068726200684460208401620065ff565b600183016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b620068b26200688460408401620065ff565b600283016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b620068c160608301836200660e565b620068d1818360038601620066cf565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b820155620069686200693a6101808401620065ff565b600c83016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f82015561020082013560108201556102208201356011820155620069af6102408301836200660e565b620069bf818360128601620066cf565b50506200297a620069d461026084016200679c565b60138301620067ae565b600060208284031215620069f157600080fd5b8151801515811462005d9657600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b6000600019820362006a475762006a47620065a4565b5060010190565b808202811582820484141762000da15762000da1620065a4565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b60008262006aa95762006aa962006a68565b500690565b60006001600160a01b0380881683528087166020840152808616604084015260a0606084015262006ae360a084018662005d53565b91508084166080840152509695505050505050565b81810362006b04575050565b62006b1082546200654f565b67ffffffffffffffff81111562006b2b5762006b2b6200621f565b62006b438162006b3c84546200654f565b846200667d565b6000601f82116001811462006b7a576000831562006b615750848201545b600019600385901b1c1916600184901b1784556200172c565b600085815260209020601f19841690600086815260209020845b8381101562006bb6578286015482556001958601959091019060200162006b94565b508583101562006bd55781850154600019600388901b60f8161c191681555b5050505050600190811b01905550565b60008262006bf75762006bf762006a68565b500490565b60006020828403121562006c0f57600080fd5b5051919050565b6000845162006c2a81846020890162005d2d565b84519083019062006c4081836020890162005d2d565b845191019062006c5581836020880162005d2d565b0195945050505050565b6001600160a01b03858116825284166020820152604081018390526080810162006508606083018462005e4b565b60ff818116838216019081111562000da15762000da1620065a4565b6000845162006cbd81846020890162005d2d565b84519083019062006cd381836020890162005d2d565b60609490941b7fffffffffffffffffffffffffffffffffffffffff000000000000000000000000169301928352505060140192915050565b60006001600160a01b0380871683528086166020840152508360408301526080606083015262006d3f608083018462005d53565b9695505050505050565b815167ffffffffffffffff81111562006d665762006d666200621f565b62006d778162006b3c84546200654f565b602080601f83116001811462006daf576000841562006d965750858301515b600019600386901b1c1916600185901b178555620066c7565b600085815260208120601f198616915b8281101562006de05788860151825594840194600190910190840162006dbf565b508582101562006bd557939096015160001960f8600387901b161c19169092555050600190811b0190555056fe60806040523480156200001157600080fd5b5060405162001a2038038062001a20833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61164880620003d86000396000f3fe60806040526004361061018b5760003560e01c806359355736116100d6578063949813b81161007f578063a9059cbb11610059578063a9059cbb1461049b578063dd62ed3e146104bb578063fb489a7b1461050e57600080fd5b8063949813b81461044657806395d89b41146104665780639dc29fac1461047b57600080fd5b806370a08231116100b057806370a08231146103c35780637eee288d1461040657806384955c881461042657600080fd5b8063593557361461033d5780635ea1d6f81461038057806361d027b31461039657600080fd5b8063282d3fdf11610138578063372500ab11610112578063372500ab146102b65780633a5381b5146102cb57806340c10f191461031d57600080fd5b8063282d3fdf146102645780632f2c3f2e14610284578063313ce5671461029a57600080fd5b8063187cf4d711610169578063187cf4d71461020a57806319fac8fd1461022257806323b872dd1461024457600080fd5b806306fdde0314610190578063095ea7b3146101bb57806318160ddd146101eb575b600080fd5b34801561019c57600080fd5b506101a5610516565b6040516101b291906113af565b60405180910390f35b3480156101c757600080fd5b506101db6101d6366004611444565b6105a8565b60405190151581526020016101b2565b3480156101f757600080fd5b506004545b6040519081526020016101b2565b34801561021657600080fd5b506101fc633b9aca0081565b34801561022e57600080fd5b5061024261023d36600461146e565b6105bf565b005b34801561025057600080fd5b506101db61025f366004611487565b610656565b34801561027057600080fd5b5061024261027f366004611444565b610797565b34801561029057600080fd5b506101fc61271081565b3480156102a657600080fd5b50604051601281526020016101b2565b3480156102c257600080fd5b50610242610912565b3480156102d757600080fd5b50600a546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b60405173ffffffffffffffffffffffffffffffffffffffff90911681526020016101b2565b34801561032957600080fd5b50610242610338366004611444565b6109c9565b34801561034957600080fd5b506101fc6103583660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526002602052604090205490565b34801561038c57600080fd5b506101fc600c5481565b3480156103a257600080fd5b50600b546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b3480156103cf57600080fd5b506101fc6103de3660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561041257600080fd5b50610242610421366004611444565b610ab3565b34801561043257600080fd5b506101fc6104413660046114c3565b610c10565b34801561045257600080fd5b506101fc6104613660046114c3565b610c4b565b34801561047257600080fd5b506101a5610c86565b34801561048757600080fd5b50610242610496366004611444565b610c95565b3480156104a757600080fd5b506101db6104b6366004611444565b610d77565b3480156104c757600080fd5b506101fc6104d63660046114e5565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260036020908152604080832093909416825291909152205490565b6101fc610de3565b60606008805461052590611518565b80601f016020809104026020016040519081016040528092919081815260200182805461055190611518565b801561059e5780601f106105735761010080835404028352916020019161059e565b820191906000526020600020905b81548152906001019060200180831161058157829003601f168201915b5050505050905090565b60006105b5338484610fbb565b5060015b92915050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146106515760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e747261637400000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b600c55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600360209081526040808320338452909152812054828110156106fd5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610648565b610711853361070c868561159a565b610fbb565b61071b858461113a565b6107258484611249565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161078491815260200190565b60405180910390a3506001949350505050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146108245760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff821660009081526002602090815260408083205460019092529091205482916108619161159a565b10156108d45760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040812080548392906109099084906115ad565b90915550505050565b600061091d336112a1565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461096f576040519150601f19603f3d011682016040523d82523d6000602084013e610974565b606091505b50509050806109c55760405162461bcd60e51b815260206004820152601460248201527f4661696c656420746f2073656e642045746865720000000000000000000000006044820152606401610648565b5050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610a565760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610a608282611249565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610b405760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040902054811115610bdb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600260205260408120805483929061090990849061159a565b73ffffffffffffffffffffffffffffffffffffffff811660009081526002602090815260408083205460019092528220546105b9919061159a565b6000610c5682611320565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600560205260409020546105b991906115ad565b60606009805461052590611518565b60005473ffffffffffffffffffffffffffffffffffffffff163314610d225760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610d2c828261113a565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610aa7565b6000610d83338361113a565b610d8d8383611249565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6000805473ffffffffffffffffffffffffffffffffffffffff163314610e715760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b600c54349060009061271090610e8790846115c0565b610e9191906115d7565b905081811115610ee35760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f722072657761726400000000000000006044820152606401610648565b610eed818361159a565b600b5460405191935073ffffffffffffffffffffffffffffffffffffffff16906108fc9083906000818181858888f193505050503d8060008114610f4d576040519150601f19603f3d011682016040523d82523d6000602084013e610f52565b606091505b505060045460009150610f69633b9aca00856115c0565b610f7391906115d7565b905080600754610f8391906115ad565b600755600454600090633b9aca0090610f9c90846115c0565b610fa691906115d7565b9050610fb281846115ad565b94505050505090565b73ffffffffffffffffffffffffffffffffffffffff83166110435760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166110cc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b611143826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020908152604080832054600290925290912054611180908261159a565b8211156111cf5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610648565b6111d9828261159a565b73ffffffffffffffffffffffffffffffffffffffff841660009081526001602052604090205580820361122d5773ffffffffffffffffffffffffffffffffffffffff83166000908152600660205260408120555b816004600082825461123f919061159a565b9091555050505050565b611252826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906112889084906115ad565b92505081905550806004600082825461090991906115ad565b6000806112ad83611320565b73ffffffffffffffffffffffffffffffffffffffff84166000908152600560205260409020549091506112e19082906115ad565b73ffffffffffffffffffffffffffffffffffffffff90931660009081526005602090815260408083208690556007546006909252909120555090919050565b73ffffffffffffffffffffffffffffffffffffffff81166000908152600160205260408120548082036113565750600092915050565b73ffffffffffffffffffffffffffffffffffffffff8316600090815260066020526040812054600754611389919061159a565b90506000633b9aca0061139c84846115c0565b6113a691906115d7565b95945050505050565b600060208083528351808285015260005b818110156113dc578581018301518582016040015282016113c0565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff8116811461143f57600080fd5b919050565b6000806040838503121561145757600080fd5b6114608361141b565b946020939093013593505050565b60006020828403121561148057600080fd5b5035919050565b60008060006060848603121561149c57600080fd5b6114a58461141b565b92506114b36020850161141b565b9150604084013590509250925092565b6000602082840312156114d557600080fd5b6114de8261141b565b9392505050565b600080604083850312156114f857600080fd5b6115018361141b565b915061150f6020840161141b565b90509250929050565b600181811c9082168061152c57607f821691505b602082108103611565577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156105b9576105b961156b565b808201808211156105b9576105b961156b565b80820281158282048414176105b9576105b961156b565b60008261160d577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220257272b65cbec4bea28792495543336863e79a2fca1536e6ea5e70c11574e1ec64736f6c63430008150033a26469706673582212209d94dab84245505dba806b8063b7fa310d2800adcf9f9580b4ffd86d68b2570b64736f6c6343000815003360806040523480156200001157600080fd5b5060405162001a2038038062001a20833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61164880620003d86000396000f3fe60806040526004361061018b5760003560e01c806359355736116100d6578063949813b81161007f578063a9059cbb11610059578063a9059cbb1461049b578063dd62ed3e146104bb578063fb489a7b1461050e57600080fd5b8063949813b81461044657806395d89b41146104665780639dc29fac1461047b57600080fd5b806370a08231116100b057806370a08231146103c35780637eee288d1461040657806384955c881461042657600080fd5b8063593557361461033d5780635ea1d6f81461038057806361d027b31461039657600080fd5b8063282d3fdf11610138578063372500ab11610112578063372500ab146102b65780633a5381b5146102cb57806340c10f191461031d57600080fd5b8063282d3fdf146102645780632f2c3f2e14610284578063313ce5671461029a57600080fd5b8063187cf4d711610169578063187cf4d71461020a57806319fac8fd1461022257806323b872dd1461024457600080fd5b806306fdde0314610190578063095ea7b3146101bb57806318160ddd146101eb575b600080fd5b34801561019c57600080fd5b506101a5610516565b6040516101b291906113af565b60405180910390f35b3480156101c757600080fd5b506101db6101d6366004611444565b6105a8565b60405190151581526020016101b2565b3480156101f757600080fd5b506004545b6040519081526020016101b2565b34801561021657600080fd5b506101fc633b9aca0081565b34801561022e57600080fd5b5061024261023d36600461146e565b6105bf565b005b34801561025057600080fd5b506101db61025f366004611487565b610656565b34801561027057600080fd5b5061024261027f366004611444565b610797565b34801561029057600080fd5b506101fc61271081565b3480156102a657600080fd5b50604051601281526020016101b2565b3480156102c257600080fd5b50610242610912565b3480156102d757600080fd5b50600a546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b60405173ffffffffffffffffffffffffffffffffffffffff90911681526020016101b2565b34801561032957600080fd5b50610242610338366004611444565b6109c9565b34801561034957600080fd5b506101fc6103583660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526002602052604090205490565b34801561038c57600080fd5b506101fc600c5481565b3480156103a257600080fd5b50600b546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b3480156103cf57600080fd5b506101fc6103de3660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561041257600080fd5b50610242610421366004611444565b610ab3565b34801561043257600080fd5b506101fc6104413660046114c3565b610c10565b34801561045257600080fd5b506101fc6104613660046114c3565b610c4b565b34801561047257600080fd5b506101a5610c86565b34801561048757600080fd5b50610242610496366004611444565b610c95565b3480156104a757600080fd5b506101db6104b6366004611444565b610d77565b3480156104c757600080fd5b506101fc6104d63660046114e5565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260036020908152604080832093909416825291909152205490565b6101fc610de3565b60606008805461052590611518565b80601f016020809104026020016040519081016040528092919081815260200182805461055190611518565b801561059e5780601f106105735761010080835404028352916020019161059e565b820191906000526020600020905b81548152906001019060200180831161058157829003601f168201915b5050505050905090565b60006105b5338484610fbb565b5060015b92915050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146106515760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e747261637400000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b600c55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600360209081526040808320338452909152812054828110156106fd5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610648565b610711853361070c868561159a565b610fbb565b61071b858461113a565b6107258484611249565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161078491815260200190565b60405180910390a3506001949350505050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146108245760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff821660009081526002602090815260408083205460019092529091205482916108619161159a565b10156108d45760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040812080548392906109099084906115ad565b90915550505050565b600061091d336112a1565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461096f576040519150601f19603f3d011682016040523d82523d6000602084013e610974565b606091505b50509050806109c55760405162461bcd60e51b815260206004820152601460248201527f4661696c656420746f2073656e642045746865720000000000000000000000006044820152606401610648565b5050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610a565760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610a608282611249565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610b405760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040902054811115610bdb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600260205260408120805483929061090990849061159a565b73ffffffffffffffffffffffffffffffffffffffff811660009081526002602090815260408083205460019092528220546105b9919061159a565b6000610c5682611320565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600560205260409020546105b991906115ad565b60606009805461052590611518565b60005473ffffffffffffffffffffffffffffffffffffffff163314610d225760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610d2c828261113a565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610aa7565b6000610d83338361113a565b610d8d8383611249565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6000805473ffffffffffffffffffffffffffffffffffffffff163314610e715760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b600c54349060009061271090610e8790846115c0565b610e9191906115d7565b905081811115610ee35760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f722072657761726400000000000000006044820152606401610648565b610eed818361159a565b600b5460405191935073ffffffffffffffffffffffffffffffffffffffff16906108fc9083906000818181858888f193505050503d8060008114610f4d576040519150601f19603f3d011682016040523d82523d6000602084013e610f52565b606091505b505060045460009150610f69633b9aca00856115c0565b610f7391906115d7565b905080600754610f8391906115ad565b600755600454600090633b9aca0090610f9c90846115c0565b610fa691906115d7565b9050610fb281846115ad565b94505050505090565b73ffffffffffffffffffffffffffffffffffffffff83166110435760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166110cc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b611143826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020908152604080832054600290925290912054611180908261159a565b8211156111cf5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610648565b6111d9828261159a565b73ffffffffffffffffffffffffffffffffffffffff841660009081526001602052604090205580820361122d5773ffffffffffffffffffffffffffffffffffffffff83166000908152600660205260408120555b816004600082825461123f919061159a565b9091555050505050565b611252826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906112889084906115ad565b92505081905550806004600082825461090991906115ad565b6000806112ad83611320565b73ffffffffffffffffffffffffffffffffffffffff84166000908152600560205260409020549091506112e19082906115ad565b73ffffffffffffffffffffffffffffffffffffffff90931660009081526005602090815260408083208690556007546006909252909120555090919050565b73ffffffffffffffffffffffffffffffffffffffff81166000908152600160205260408120548082036113565750600092915050565b73ffffffffffffffffffffffffffffffffffffffff8316600090815260066020526040812054600754611389919061159a565b90506000633b9aca0061139c84846115c0565b6113a691906115d7565b95945050505050565b600060208083528351808285015260005b818110156113dc578581018301518582016040015282016113c0565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff8116811461143f57600080fd5b919050565b6000806040838503121561145757600080fd5b6114608361141b565b946020939093013593505050565b60006020828403121561148057600080fd5b5035919050565b60008060006060848603121561149c57600080fd5b6114a58461141b565b92506114b36020850161141b565b9150604084013590509250925092565b6000602082840312156114d557600080fd5b6114de8261141b565b9392505050565b600080604083850312156114f857600080fd5b6115018361141b565b915061150f6020840161141b565b90509250929050565b600181811c9082168061152c57607f821691505b602082108103611565577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156105b9576105b961156b565b808201808211156105b9576105b961156b565b80820281158282048414176105b9576105b961156b565b60008261160d577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220257272b65cbec4bea28792495543336863e79a2fca1536e6ea5e70c11574e1ec64736f6c63430008150033")
+var AutonityBytecode = common.Hex2Bytes("60806040526000600b556000600c553480156200001b57600080fd5b506040516200baac3803806200baac8339810160408190526200003e9162000e64565b601c546000036200006757602a80546001600160a01b031916331790556200006782826200006f565b505062001325565b80518051600d55602080820151600e55604080830151600f55606080840151601055608093840151601180546001600160a01b03199081166001600160a01b03938416179091558487015180516012805484169185169190911790558086015160138054841691851691909117905580850151601480548416918516919091179055808401516015805484169185169190911790559586015160168054831691841691909117905560a0909501516017805487169183169190911790558286015180516018805490971692169190911790945591830151601955820151601a5590810151601b55810151601c5560005b825181101562000420576000838281518110620001805762000180620010a1565b602002602001015160a0015190506000848381518110620001a557620001a5620010a1565b60200260200101516101a00181815250506000848381518110620001cd57620001cd620010a1565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620002095762000209620010a1565b602002602001015160a00181815250506000848381518110620002305762000230620010a1565b60209081029190910101516101c00152600f5484518590849081106200025a576200025a620010a1565b602002602001015160800181815250506000848381518110620002815762000281620010a1565b602002602001015161026001906003811115620002a257620002a2620010b7565b90816003811115620002b857620002b8620010b7565b815250506000848381518110620002d357620002d3620010a1565b602002602001015161016001818152505062000311848381518110620002fd57620002fd620010a1565b60200260200101516200042560201b60201c565b6200033e8483815181106200032a576200032a620010a1565b60200260200101516200056060201b60201c565b8060276000868581518110620003585762000358620010a1565b6020026020010151600001516001600160a01b03166001600160a01b031681526020019081526020016000206000828254620003959190620010e3565b925050819055508060296000828254620003b09190620010e3565b925050819055506200040a848381518110620003d057620003d0620010a1565b60200260200101516020015182868581518110620003f257620003f2620010a1565b6020026020010151600001516200079160201b60201c565b50806200041781620010ff565b9150506200015f565b505050565b60006200043c82606001516200097c60201b60201c565b6001600160a01b03909116602084015290508015620004905760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064015b60405180910390fd5b6020808301516001600160a01b03908116600090815260289092526040909120600101541615620005045760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000487565b612710826080015111156200055c5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000487565b5050565b6101808101516001600160a01b0316620005e357601d546000906200058590620009ca565b905081602001518260000151836080015183604051620005a59062000aea565b620005b494939291906200111b565b604051809103906000f080158015620005d1573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352602890955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620006959082620011ff565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f8201556102008201516010820155610220820151601182015561024082015160128201906200075f9082620011ff565b5061026082015160138201805460ff19166001836003811115620007875762000787620010b7565b0217905550505050565b60008211620007ef5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000487565b6001600160a01b038116600090815260276020526040902054821115620008595760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000487565b6001600160a01b0381166000908152602760205260408120805484929062000883908490620012cb565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b03199182161782559551600182018054919098169616959095179095559051600284015551910155805491926200090f83620010ff565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a35050505050565b6000806200098962000af8565b60008060ff9050604083875160208901845afa620009a657600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b606081600003620009f25750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000a22578062000a0981620010ff565b915062000a1a9050600a83620012f7565b9150620009f6565b6000816001600160401b0381111562000a3f5762000a3f62000b16565b6040519080825280601f01601f19166020018201604052801562000a6a576020820181803683370190505b5090505b841562000ae25762000a82600183620012cb565b915062000a91600a866200130e565b62000a9e906030620010e3565b60f81b81838151811062000ab65762000ab6620010a1565b60200101906001600160f81b031916908160001a90535062000ada600a86620012f7565b945062000a6e565b949350505050565b611a20806200a08c83390190565b60405180604001604052806002906020820280368337509192915050565b634e487b7160e01b600052604160045260246000fd5b604051608081016001600160401b038111828210171562000b515762000b5162000b16565b60405290565b60405160a081016001600160401b038111828210171562000b515762000b5162000b16565b60405161028081016001600160401b038111828210171562000b515762000b5162000b16565b604051601f8201601f191681016001600160401b038111828210171562000bcd5762000bcd62000b16565b604052919050565b6001600160a01b038116811462000beb57600080fd5b50565b805162000bfb8162000bd5565b919050565b60005b8381101562000c1d57818101518382015260200162000c03565b50506000910152565b600082601f83011262000c3857600080fd5b81516001600160401b0381111562000c545762000c5462000b16565b62000c69601f8201601f191660200162000ba2565b81815284602083860101111562000c7f57600080fd5b62000ae282602083016020870162000c00565b80516004811062000bfb57600080fd5b600060c0828403121562000cb557600080fd5b60405160c081016001600160401b038111828210171562000cda5762000cda62000b16565b8060405250809150825162000cef8162000bd5565b8152602083015162000d018162000bd5565b6020820152604083015162000d168162000bd5565b6040820152606083015162000d2b8162000bd5565b6060820152608083015162000d408162000bd5565b608082015260a083015162000d558162000bd5565b60a0919091015292915050565b60006080828403121562000d7557600080fd5b62000d7f62000b2c565b9050815162000d8e8162000bd5565b8082525060208201516020820152604082015160408201526060820151606082015292915050565b600081830361020081121562000dcb57600080fd5b62000dd562000b2c565b915060a081121562000de657600080fd5b5062000df162000b57565b82518152602083015160208201526040830151604082015260608301516060820152608083015162000e238162000bd5565b6080820152815262000e398360a0840162000ca2565b602082015262000e4e83610160840162000d62565b60408201526101e0820151606082015292915050565b60008061022080848603121562000e7a57600080fd5b83516001600160401b038082111562000e9257600080fd5b818601915086601f83011262000ea757600080fd5b815160208282111562000ebe5762000ebe62000b16565b8160051b62000ecf82820162000ba2565b928352848101820192828101908b85111562000eea57600080fd5b83870192505b848310156200107f5782518681111562000f0957600080fd5b8701610280818e03601f1901121562000f2157600080fd5b62000f2b62000b7c565b62000f3886830162000bee565b815262000f486040830162000bee565b8682015262000f5a6060830162000bee565b604082015260808201518881111562000f7257600080fd5b62000f828f888386010162000c26565b60608301525060a0820151608082015260c082015160a082015260e082015160c082015261010082015160e082015261012082015161010082015261014082015161012082015261016082015161014082015261018082015161016082015262000ff06101a0830162000bee565b6101808201526101c08201516101a08201526101e08201516101c08201526102008201516101e0820152898201516102008201526102408201518a820152610260820151888111156200104257600080fd5b620010528f888386010162000c26565b6102408301525062001068610280830162000c92565b610260820152835250918301919083019062000ef0565b8099505050506200109389828a0162000db6565b955050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b80820180821115620010f957620010f9620010cd565b92915050565b600060018201620011145762001114620010cd565b5060010190565b600060018060a01b0380871683528086166020840152508360408301526080606083015282518060808401526200115a8160a085016020870162000c00565b601f01601f19169190910160a00195945050505050565b600181811c908216806200118657607f821691505b602082108103620011a757634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200042057600081815260208120601f850160051c81016020861015620011d65750805b601f850160051c820191505b81811015620011f757828155600101620011e2565b505050505050565b81516001600160401b038111156200121b576200121b62000b16565b62001233816200122c845462001171565b84620011ad565b602080601f8311600181146200126b5760008415620012525750858301515b600019600386901b1c1916600185901b178555620011f7565b600085815260208120601f198616915b828110156200129c578886015182559484019460019091019084016200127b565b5085821015620012bb5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b81810381811115620010f957620010f9620010cd565b634e487b7160e01b600052601260045260246000fd5b600082620013095762001309620012e1565b500490565b600082620013205762001320620012e1565b500690565b618d5780620013356000396000f3fe6080604052600436106200041b5760003560e01c8063872cf059116200021d578063b66b3e791162000131578063d372c07e11620000c1578063da45591b116200008f578063dfb1a4d21162000075578063dfb1a4d21462000de6578063e7f43c681462000dfd578063f7866ee31462000e1d57005b8063da45591b1462000d77578063dd62ed3e1462000d9c57005b8063d372c07e1462000cf3578063d5f394881462000d18578063d861b0e81462000d3a578063d886f8a21462000d5257005b8063cb696f5411620000ff578063cb696f541462000c6c578063ceaad4551462000c91578063cf9c57191462000cb6578063cfd19fb91462000cce57005b8063b66b3e791462000bfc578063b7ab4db51462000c24578063c2362dd51462000c3c578063c9d97af41462000c5457005b8063a5d059ca11620001ad578063ae1f5fa0116200017b578063ae1f5fa01462000b66578063b2ea9adb1462000b8d578063b3ecbadd1462000bb2578063b46e55201462000bd757005b8063a5d059ca1462000ace578063a8b2216e1462000af3578063a9059cbb1462000b1a578063ab8f6ffe1462000b3f57005b80639bb851c011620001eb5780639bb851c01462000a545780639c98e4711462000a6c5780639dc29fac1462000a84578063a515366a1462000aa957005b8063872cf059146200099e5780638bac7dad14620009b657806395d89b4114620009db57806396b477cb1462000a2357005b806339b17fec116200032f5780636fd2c80b11620002bf57806379502c55116200028d578063833b1fce1162000273578063833b1fce146200093457806384467fdb1462000954578063852c4849146200097957005b806379502c55146200083f578063819b6463146200091d57005b80636fd2c80b14620007b257806370a0823114620007c9578063731b3a03146200080357806377e741c7146200081a57005b80634bb278f311620002fd5780634bb278f31462000702578063520fdbbc146200072a5780635f7d3949146200074f5780636b5f444c146200078d57005b806339b17fec146200067c57806340c10f1914620006a15780634364596914620006c6578063496ccd9b14620006dd57005b806318160ddd11620003ab5780632a400bc311620003795780632a400bc314620005fc5780632f2c3f2e1462000621578063313ce567146200063957806335be16e0146200065757005b806318160ddd14620005755780631904bb2e146200058c57806323b872dd14620005c057806329070c6d14620005e557005b80631122063311620003e95780631122063314620004fc578063114eaf5514620005135780631250a28d14620005385780631604e416146200055d57005b806306fdde031462000425578063095ea7b314620004805780630ae65e7a14620004b65780630d8e6e2c14620004db57005b366200042357005b005b3480156200043257600080fd5b5060408051808201909152600681527f4e6577746f6e000000000000000000000000000000000000000000000000000060208201525b6040516200047791906200623c565b60405180910390f35b3480156200048d57600080fd5b50620004a56200049f3660046200626e565b62000e3d565b604051901515815260200162000477565b348015620004c357600080fd5b5062000423620004d53660046200629d565b62000e56565b348015620004e857600080fd5b50601c545b60405190815260200162000477565b3480156200050957600080fd5b50600e54620004ed565b3480156200052057600080fd5b506200042362000532366004620062bd565b62000f68565b3480156200054557600080fd5b5062000423620005573660046200629d565b62000fc9565b3480156200056a57600080fd5b50620004ed60245481565b3480156200058257600080fd5b50602954620004ed565b3480156200059957600080fd5b50620005b1620005ab3660046200629d565b62001054565b60405162000477919062006342565b348015620005cd57600080fd5b50620004a5620005df366004620064bb565b62001331565b348015620005f257600080fd5b50600d54620004ed565b3480156200060957600080fd5b50620004236200061b366004620064bb565b6200138b565b3480156200062e57600080fd5b50620004ed61271081565b3480156200064657600080fd5b506040516012815260200162000477565b3480156200066457600080fd5b50620004236200067636600462006501565b620014ef565b3480156200068957600080fd5b50620004236200069b366004620064bb565b620016e5565b348015620006ae57600080fd5b5062000423620006c03660046200626e565b62001815565b348015620006d357600080fd5b50601a54620004ed565b348015620006ea57600080fd5b5062000423620006fc3660046200629d565b620018fe565b3480156200070f57600080fd5b506200071a62001a76565b60405162000477929190620065c2565b3480156200073757600080fd5b5062000423620007493660046200629d565b62001ef6565b3480156200075c57600080fd5b50620007746200076e366004620065df565b620021aa565b6040516001600160a01b03909116815260200162000477565b3480156200079a57600080fd5b5062000423620007ac366004620062bd565b620023d5565b348015620007bf57600080fd5b50601054620004ed565b348015620007d657600080fd5b50620004ed620007e83660046200629d565b6001600160a01b031660009081526027602052604090205490565b3480156200081057600080fd5b50602054620004ed565b3480156200082757600080fd5b506200042362000839366004620062bd565b620025a3565b3480156200084c57600080fd5b506040805160a08082018352600d548252600e54602080840191909152600f54838501526010546060808501919091526011546001600160a01b03908116608080870191909152865160c0810188526012548316815260135483168186015260145483168189015260155483168185015260165483168183015260175483169581019590955286519081018752601854909116815260195492810192909252601a5494820194909452601b5493810193909352601c546200090b939084565b60405162000477949392919062006602565b3480156200092a57600080fd5b50601b54620004ed565b3480156200094157600080fd5b506013546001600160a01b031662000774565b3480156200096157600080fd5b5062000423620009733660046200679e565b62002604565b3480156200098657600080fd5b5062000423620009983660046200626e565b62002733565b348015620009ab57600080fd5b506200042362002955565b348015620009c357600080fd5b5062000423620009d5366004620062bd565b620029c0565b348015620009e857600080fd5b5060408051808201909152600381527f4e544e0000000000000000000000000000000000000000000000000000000000602082015262000468565b34801562000a3057600080fd5b50620004ed62000a42366004620062bd565b6000908152601f602052604090205490565b34801562000a6157600080fd5b50620004ed60235481565b34801562000a7957600080fd5b50620004ed60215481565b34801562000a9157600080fd5b506200042362000aa33660046200626e565b62002a73565b34801562000ab657600080fd5b506200042362000ac83660046200626e565b62002bbf565b34801562000adb57600080fd5b506200042362000aed3660046200626e565b62002cc1565b34801562000b0057600080fd5b5062000b0b62002d8f565b60405162000477919062006847565b34801562000b2757600080fd5b50620004a562000b393660046200626e565b62002e72565b34801562000b4c57600080fd5b5062000b5762002e81565b604051620004779190620068cb565b34801562000b7357600080fd5b5062000b7e62002f93565b604051620004779190620068e0565b34801562000b9a57600080fd5b506200042362000bac3660046200692f565b62003239565b34801562000bbf57600080fd5b506200042362000bd13660046200629d565b620032af565b34801562000be457600080fd5b506200042362000bf63660046200629d565b6200333a565b34801562000c0957600080fd5b5062000c14620035fa565b604051620004779291906200699a565b34801562000c3157600080fd5b5062000b7e62003731565b34801562000c4957600080fd5b50620004ed60205481565b34801562000c6157600080fd5b50620004ed601e5481565b34801562000c7957600080fd5b506200042362000c8b366004620062bd565b62003795565b34801562000c9e57600080fd5b506200042362000cb03660046200629d565b62003827565b34801562000cc357600080fd5b5062000423620038b2565b34801562000cdb57600080fd5b506200042362000ced3660046200629d565b62003935565b34801562000d0057600080fd5b506200042362000d123660046200629d565b620039c0565b34801562000d2557600080fd5b50602a5462000774906001600160a01b031681565b34801562000d4757600080fd5b506200042362003a4b565b34801562000d5f57600080fd5b506200042362000d713660046200629d565b62003ae1565b34801562000d8457600080fd5b506200042362000d96366004620069cc565b62003b6c565b34801562000da957600080fd5b50620004ed62000dbb36600462006a05565b6001600160a01b03918216600090815260266020908152604080832093909416825291909152205490565b34801562000df357600080fd5b50601954620004ed565b34801562000e0a57600080fd5b506018546001600160a01b031662000774565b34801562000e2a57600080fd5b506011546001600160a01b031662000774565b600062000e4c33848462003cb3565b5060015b92915050565b6001600160a01b038082166000818152602860205260409020600101549091161462000ec95760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d75737420626520726567697374657265640000000060448201526064015b60405180910390fd5b6001600160a01b0381811660009081526028602052604090205416331462000f5a5760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162000ec0565b62000f658162003e10565b50565b6018546001600160a01b0316331462000fc45760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b601055565b6018546001600160a01b03163314620010255760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6012805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6200105e62006015565b6001600160a01b0380831660008181526028602052604090206001015490911614620010cd5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162000ec0565b6001600160a01b038083166000908152602860209081526040918290208251610280810184528154851681526001820154851692810192909252600281015490931691810191909152600382018054919291606084019190620011309062006a43565b80601f01602080910402602001604051908101604052809291908181526020018280546200115e9062006a43565b8015620011af5780601f106200118357610100808354040283529160200191620011af565b820191906000526020600020905b8154815290600101906020018083116200119157829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e0909201916200126b9062006a43565b80601f0160208091040260200160405190810160405280929190818152602001828054620012999062006a43565b8015620012ea5780601f10620012be57610100808354040283529160200191620012ea565b820191906000526020600020905b815481529060010190602001808311620012cc57829003601f168201915b5050509183525050601382015460209091019060ff166003811115620013145762001314620062d7565b6003811115620013285762001328620062d7565b90525092915050565b60006200134084848462003ee7565b6001600160a01b03841660009081526026602090815260408083203384529091528120546200137190849062006ac7565b90506200138085338362003cb3565b506001949350505050565b6018546001600160a01b03163314620013e75760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6001600160a01b0380841660008181526028602052604090206001015490911614620014565760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000ec0565b6001600160a01b0383811660009081526028602052604090819020600c015490517f282d3fdf00000000000000000000000000000000000000000000000000000000815284831660048201526024810184905291169063282d3fdf906044015b600060405180830381600087803b158015620014d157600080fd5b505af1158015620014e6573d6000803e3d6000fd5b50505050505050565b6012546001600160a01b03163314620015715760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e747260448201527f6163740000000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b60006101208201356028826200158e60408601602087016200629d565b6001600160a01b03166001600160a01b0316815260200190815260200160002060090154620015be919062006ac7565b60c083013560286000620015d960408701602088016200629d565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001609919062006ac7565b60a0840135602860006200162460408801602089016200629d565b6001600160a01b03166001600160a01b031681526020019081526020016000206005015462001654919062006ac7565b62001660919062006add565b6200166c919062006add565b6011546001600160a01b03166000908152602760205260408120805492935083929091906200169d90849062006add565b9091555082905060286000620016ba60408401602085016200629d565b6001600160a01b031681526020810191909152604001600020620016df828262006ced565b50505050565b6018546001600160a01b03163314620017415760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6001600160a01b0380841660008181526028602052604090206001015490911614620017b05760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000ec0565b6001600160a01b0383811660009081526028602052604090819020600c015490517f7eee288d000000000000000000000000000000000000000000000000000000008152848316600482015260248101849052911690637eee288d90604401620014b6565b6018546001600160a01b03163314620018715760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6001600160a01b038216600090815260276020526040812080548392906200189b90849062006add565b925050819055508060296000828254620018b6919062006add565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf906020015b60405180910390a25050565b6018546001600160a01b031633146200195a5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6013805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556014546040517f7adbf973000000000000000000000000000000000000000000000000000000008152600481019290925290911690637adbf97390602401600060405180830381600087803b158015620019df57600080fd5b505af1158015620019f4573d6000803e3d6000fd5b50506016546040517f7adbf9730000000000000000000000000000000000000000000000000000000081526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b15801562001a5a57600080fd5b505af115801562001a6f573d6000803e3d6000fd5b5050505050565b602a546000906060906001600160a01b0316331462001afe5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b601e54436000818152601f602090815260408220939093556019549254909262001b289162006add565b6012546040517f6c9789b0000000000000000000000000000000000000000000000000000000008152929091146004830181905292506001600160a01b031690636c9789b090602401600060405180830381600087803b15801562001b8c57600080fd5b505af115801562001ba1573d6000803e3d6000fd5b50505050801562001caf5762001bb662003ff7565b62001bc0620043f9565b62001bca620044e8565b600062001bd662002f93565b6013546040517f845023f20000000000000000000000000000000000000000000000000000000081529192506001600160a01b03169063845023f29062001c22908490600401620068e0565b600060405180830381600087803b15801562001c3d57600080fd5b505af115801562001c52573d6000803e3d6000fd5b50505050436020819055506001601e600082825462001c72919062006add565b9091555050601e546040519081527febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3359060200160405180910390a1505b601354604080517f4bb278f300000000000000000000000000000000000000000000000000000000815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af115801562001d14573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062001d3a919062006ed2565b9050801562001dcf57601454604080517fa2e6204500000000000000000000000000000000000000000000000000000000815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af192505050801562001dc7575060408051601f3d908101601f1916820190925262001dc49181019062006ed2565b60015b1562001dcf57505b600254602280546040805160208084028201810190925282815260ff9094169391839160009084015b8282101562001ee6576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162001e4e9062006a43565b80601f016020809104026020016040519081016040528092919081815260200182805462001e7c9062006a43565b801562001ecd5780601f1062001ea15761010080835404028352916020019162001ecd565b820191906000526020600020905b81548152906001019060200180831162001eaf57829003601f168201915b5050505050815250508152602001906001019062001df8565b5050505090509350935050509091565b6018546001600160a01b0316331462001f525760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6018805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556013546040517fb3ab15fb00000000000000000000000000000000000000000000000000000000815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b15801562001fd757600080fd5b505af115801562001fec573d6000803e3d6000fd5b50506014546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200205157600080fd5b505af115801562002066573d6000803e3d6000fd5b50506015546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620020cb57600080fd5b505af1158015620020e0573d6000803e3d6000fd5b50506016546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200214557600080fd5b505af11580156200215a573d6000803e3d6000fd5b50506017546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb915060240162001a3f565b600080805b602254811015620022065760228181548110620021d057620021d062006ef6565b90600052602060002090600302016001015482620021ef919062006add565b915080620021fd8162006f25565b915050620021af565b5080600003620022595760405162461bcd60e51b815260206004820152601c60248201527f54686520636f6d6d6974746565206973206e6f74207374616b696e6700000000604482015260640162000ec0565b6000836200226960638762006f42565b62002275919062006add565b90506000816040516020016200228d91815260200190565b60408051601f19818403018152919052805160209091012090506000620022b5848362006f8b565b90506000805b602254811015620023655760228181548110620022dc57620022dc62006ef6565b90600052602060002090600302016001015482620022fb919062006add565b91506200230a60018362006ac7565b831162002350576022818154811062002327576200232762006ef6565b60009182526020909120600390910201546001600160a01b0316965062000e5095505050505050565b806200235c8162006f25565b915050620022bb565b5060405162461bcd60e51b815260206004820152602960248201527f5468657265206973206e6f2076616c696461746f72206c65667420696e20746860448201527f65206e6574776f726b0000000000000000000000000000000000000000000000606482015260840162000ec0565b6018546001600160a01b03163314620024315760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b601954811015620024e857806020546200244c919062006add565b4310620024e85760405162461bcd60e51b815260206004820152605760248201527f63757272656e7420636861696e2068656164206578636565642074686520776960448201527f6e646f773a206c617374426c6f636b45706f6368202b205f6e6577506572696f60648201527f642c2074727920616761696e206c6174746572206f6e2e000000000000000000608482015260a40162000ec0565b60198190556012546040517f6b5f444c000000000000000000000000000000000000000000000000000000008152600481018390526001600160a01b0390911690636b5f444c90602401600060405180830381600087803b1580156200254d57600080fd5b505af115801562002562573d6000803e3d6000fd5b505050507fd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f81816040516200259891815260200190565b60405180910390a150565b6018546001600160a01b03163314620025ff5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b600d55565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b03168152602001868152602001600d6000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200143815260200160008152602001600081526020016000815260200184815260200160006003811115620026d357620026d3620062d7565b90529050620026e381836200462d565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c926200272492339289918b9162006fa2565b60405180910390a15050505050565b6001600160a01b0380831660008181526028602052604090206001015490911614620027a25760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000ec0565b6001600160a01b03828116600090815260286020526040902054163314620028335760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162000ec0565b612710811115620028875760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e207261746500604482015260640162000ec0565b604080516060810182526001600160a01b038481168252436020808401918252838501868152600c80546000908152600a9093529582208551815473ffffffffffffffffffffffffffffffffffffffff19169516949094178455915160018085019190915591516002909301929092558354929390929091906200290d90849062006add565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6018546001600160a01b03163314620029b15760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6002805460ff19166001179055565b6018546001600160a01b0316331462002a1c5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6000811162002a6e5760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e2774206265203000000000000000604482015260640162000ec0565b601b55565b6018546001600160a01b0316331462002acf5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6001600160a01b03821660009081526027602052604090205481111562002b395760405162461bcd60e51b815260206004820152601660248201527f416d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162000ec0565b6001600160a01b0382166000908152602760205260408120805483929062002b6390849062006ac7565b92505081905550806029600082825462002b7e919062006ac7565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a390602001620018f2565b6001600160a01b038083166000818152602860205260409020600101549091161462002c2e5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162000ec0565b6001600160a01b03821660009081526028602052604081206013015460ff16600381111562002c615762002c61620062d7565b1462002cb05760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f206265206163746976650000000000604482015260640162000ec0565b62002cbd82823362004ad0565b5050565b6001600160a01b038083166000818152602860205260409020600101549091161462002d305760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162000ec0565b6000811162002d825760405162461bcd60e51b815260206004820152601560248201527f756e626f6e64696e6720616d6f756e7420697320300000000000000000000000604482015260640162000ec0565b62002cbd82823362004ce3565b60606025805480602002602001604051908101604052809291908181526020016000905b8282101562002e6957838290600052602060002001805462002dd59062006a43565b80601f016020809104026020016040519081016040528092919081815260200182805462002e039062006a43565b801562002e545780601f1062002e285761010080835404028352916020019162002e54565b820191906000526020600020905b81548152906001019060200180831162002e3657829003601f168201915b50505050508152602001906001019062002db3565b50505050905090565b600062000e4c33848462003ee7565b60606022805480602002602001604051908101604052809291908181526020016000905b8282101562002e69576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162002efb9062006a43565b80601f016020809104026020016040519081016040528092919081815260200182805462002f299062006a43565b801562002f7a5780601f1062002f4e5761010080835404028352916020019162002f7a565b820191906000526020600020905b81548152906001019060200180831162002f5c57829003601f168201915b5050505050815250508152602001906001019062002ea5565b602a546060906001600160a01b03163314620030185760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b601d54620030695760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f72730000000000000000604482015260640162000ec0565b62003073620060e5565b601b546080820152601d81526028602082015260226040820152602160608201526200309f81620050b7565b620030ad6025600062006103565b60225480620030ff5760405162461bcd60e51b815260206004820152601260248201527f636f6d6d697474656520697320656d7074790000000000000000000000000000604482015260640162000ec0565b60008167ffffffffffffffff8111156200311d576200311d620066da565b60405190808252806020026020018201604052801562003147578160200160208202803683370190505b50905060005b8281101562003231576000602860006022848154811062003172576200317262006ef6565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060258054600181018255925292507f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c0190620031da9083018262006fec565b50600281015483516001600160a01b039091169084908490811062003203576200320362006ef6565b6001600160a01b03909216602092830291909101909101525080620032288162006f25565b9150506200314d565b509250505090565b6018546001600160a01b03163314620032955760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b620032a2600083620050d2565b62002cbd600182620050d2565b6018546001600160a01b031633146200330b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6015805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b0380821660008181526028602052604090206001015490911614620033a95760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000ec0565b6001600160a01b038082166000908152602860205260409020805490911633146200343d5760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162000ec0565b6000601382015460ff1660038111156200345b576200345b620062d7565b03620034aa5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c7265616479206163746976650000000000000000604482015260640162000ec0565b6002601382015460ff166003811115620034c857620034c8620062d7565b148015620034d95750438160100154115b15620035285760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c000000000000000000604482015260640162000ec0565b6003601382015460ff166003811115620035465762003546620062d7565b03620035955760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c7900000000604482015260640162000ec0565b60138101805460ff1916905580546019546020546001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b591620035e5919062006add565b60405190815260200160405180910390a35050565b606080600060018180546200360f9062006a43565b80601f01602080910402602001604051908101604052809291908181526020018280546200363d9062006a43565b80156200368e5780601f1062003662576101008083540402835291602001916200368e565b820191906000526020600020905b8154815290600101906020018083116200367057829003601f168201915b50505050509150808054620036a39062006a43565b80601f0160208091040260200160405190810160405280929190818152602001828054620036d19062006a43565b8015620037225780601f10620036f65761010080835404028352916020019162003722565b820191906000526020600020905b8154815290600101906020018083116200370457829003601f168201915b50505050509050915091509091565b6060601d8054806020026020016040519081016040528092919081815260200182805480156200378b57602002820191906000526020600020905b81546001600160a01b031681526001909101906020018083116200376c575b5050505050905090565b6018546001600160a01b03163314620037f15760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b600e8190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200162002598565b6018546001600160a01b03163314620038835760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6017805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6018546001600160a01b031633146200390e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6200391b60008062006123565b620039296001600062006123565b6002805460ff19169055565b6018546001600160a01b03163314620039915760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6016805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6018546001600160a01b0316331462003a1c5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6014805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b602a546001600160a01b0316331462003acd5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b62003ad7620043f9565b62000f6562002f93565b6018546001600160a01b0316331462003b3d5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6011805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b600062003b798262005224565b6001600160a01b0380821660008181526028602052604090206001015492935091161462003bea5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000ec0565b6001600160a01b0381811660009081526028602052604090205416331462003c7b5760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162000ec0565b6040516001600160a01b038216907f68d46f7ed6d57eea4fc550f4783b3f528ef5f858f9e78b9a7728b1bef0a1b92f90600090a25050565b6001600160a01b03831662003d305760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f7265737300000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b6001600160a01b03821662003dae5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f7373000000000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b6001600160a01b0383811660008181526026602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6001600160a01b038116600090815260286020526040812090601382015460ff16600381111562003e455762003e45620062d7565b1462003e945760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d757374206265206163746976650000000000000000604482015260640162000ec0565b60138101805460ff1916600117905580546019546020546001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c91620035e5919062006add565b6001600160a01b03831660009081526027602052604090205481111562003f515760405162461bcd60e51b815260206004820152601660248201527f616d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162000ec0565b6001600160a01b0383166000908152602760205260408120805483929062003f7b90849062006ac7565b90915550506001600160a01b0382166000908152602760205260408120805483929062003faa90849062006add565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8360405162003e0391815260200190565b476000036200400257565b600d544790600090670de0b6b3a7640000906200402190849062006f42565b6200402d9190620070d9565b90508015620040ab576011546040516000916001600160a01b03169083908381818185875af1925050503d806000811462004085576040519150601f19603f3d011682016040523d82523d6000602084013e6200408a565b606091505b5090915050801515600103620040a957620040a6828462006ac7565b92505b505b8160236000828254620040bf919062006add565b90915550600090505b602254811015620043f45760006028600060228481548110620040ef57620040ef62006ef6565b600091825260208083206003909202909101546001600160a01b031683528201929092526040018120602154602280549294509091879190869081106200413a576200413a62006ef6565b90600052602060002090600302016001015462004158919062006f42565b620041649190620070d9565b90508015620043dc576002601383015460ff1660038111156200418b576200418b620062d7565b1480620041b357506003601383015460ff166003811115620041b157620041b1620062d7565b145b156200427357601254602280546001600160a01b0390921691631de9d9b691849187908110620041e757620041e762006ef6565b600091825260209091206003909102015460405160e084901b7fffffffff000000000000000000000000000000000000000000000000000000001681526001600160a01b0390911660048201526024016000604051808303818588803b1580156200425157600080fd5b505af115801562004266573d6000803e3d6000fd5b50505050505050620043df565b600082600501548284600801546200428c919062006f42565b620042989190620070d9565b90506000620042a8828462006ac7565b905081156200430d5783546040516001600160a01b03909116906108fc9084906000818181858888f193505050503d806000811462004304576040519150601f19603f3d011682016040523d82523d6000602084013e62004309565b606091505b5050505b8015620043955783600c0160009054906101000a90046001600160a01b03166001600160a01b031663fb489a7b826040518263ffffffff1660e01b815260040160206040518083038185885af11580156200436c573d6000803e3d6000fd5b50505050506040513d601f19601f82011682018060405250810190620043939190620070f0565b505b60018401546040518481526001600160a01b03909116907fb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe5639060200160405180910390a250505b50505b80620043eb8162006f25565b915050620040c8565b505050565b6004545b60055481101562004426576200442081620044188162006f25565b925062005256565b620043fd565b50600554600455600854600754036200443b57565b6009545b600854811015620044685762004462816200445a8162006f25565b925062005496565b6200443f565b50600854600955600754805b600854811015620044e2576010546000828152600660205260409020600401544391620044a19162006add565b11620044c757620044b281620057c3565b620044bf60018362006add565b9150620044cd565b620044e2565b80620044d98162006f25565b91505062004474565b50600755565b600c54600b5410156200462b57600b546000908152600a60205260409020601054600182015443916200451b9162006add565b1115620045255750565b600281015481546001600160a01b03908116600090815260286020526040808220600490810185905585548416835291819020600c015490517f19fac8fd0000000000000000000000000000000000000000000000000000000081529216926319fac8fd9262004599920190815260200190565b600060405180830381600087803b158015620045b457600080fd5b505af1158015620045c9573d6000803e3d6000fd5b5050600b80546000908152600a60205260408120805473ffffffffffffffffffffffffffffffffffffffff19168155600180820183905560029091018290558254909450919250906200461e90849062006add565b90915550620044e8915050565b565b60e2815114620046805760405162461bcd60e51b815260206004820152601460248201527f496e76616c69642070726f6f66206c656e677468000000000000000000000000604482015260640162000ec0565b60308261024001515114620046d85760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e67746800000000604482015260640162000ec0565b620046e3826200590e565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a00000000000060208083019190915284519251919260009262004759920160609190911b7fffffffffffffffffffffffffffffffffffffffff00000000000000000000000016815260140190565b604051602081830303815290604052905060008262004779835162005a4d565b836040516020016200478e939291906200710a565b60408051601f1981840301815282825280516020918201206002808552606085018452909450600093929091830190803683370190505090506000808080620047e68982620047e06041600262006f42565b62005ba2565b90506000620048058a620047fd6041600262006f42565b606062005ba2565b905060205b8251811015620048d65762004820838262005cd6565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa1580156200487c573d6000803e3d6000fd5b5050604051601f19015190508762004896604184620070d9565b81518110620048a957620048a962006ef6565b6001600160a01b0390921660209283029190910190910152620048ce60418262006add565b90506200480a565b508a602001516001600160a01b031686600081518110620048fb57620048fb62006ef6565b60200260200101516001600160a01b031614620049815760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f6660448201527f2070726f76696465640000000000000000000000000000000000000000000000606482015260840162000ec0565b8a604001516001600160a01b031686600181518110620049a557620049a562006ef6565b60200260200101516001600160a01b03161462004a2b5760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201527f6f662070726f7669646564000000000000000000000000000000000000000000606482015260840162000ec0565b600162004a438c6102400151838e6000015162005d0d565b1462004ab85760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e6572736869702060448201527f70726f6f6620666f7220726567697374726174696f6e00000000000000000000606482015260840162000ec0565b62004ac38b62005d7c565b5050505050505050505050565b6000821162004b485760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201527f6976650000000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b6001600160a01b03811660009081526027602052604090205482111562004bb25760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000ec0565b6001600160a01b0381166000908152602760205260408120805484929062004bdc90849062006ac7565b9091555050604080516080810182526001600160a01b038084168252858116602080840191825283850187815243606086019081526005805460009081526003948590529788208751815490881673ffffffffffffffffffffffffffffffffffffffff1991821617825595516001820180549190981696169590951790955590516002840155519101558054919262004c758362006f25565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d91015b60405180910390a35050505050565b6001600160a01b038084166000908152602860205260409020805490918381169116148062004e9d57600c8201546040517f84955c880000000000000000000000000000000000000000000000000000000081526001600160a01b03858116600483015260009216906384955c8890602401602060405180830381865afa15801562004d73573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062004d999190620070f0565b90508481101562004e135760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201527f746f6e2062616c616e6365000000000000000000000000000000000000000000606482015260840162000ec0565b600c8301546040517f282d3fdf0000000000000000000000000000000000000000000000000000000081526001600160a01b038681166004830152602482018890529091169063282d3fdf90604401600060405180830381600087803b15801562004e7d57600080fd5b505af115801562004e92573d6000803e3d6000fd5b505050505062004f46565b8382600b0154836008015462004eb4919062006ac7565b101562004f2a5760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201527f62616c616e636500000000000000000000000000000000000000000000000000606482015260840162000ec0565b8382600b01600082825462004f40919062006add565b90915550505b6040805160e0810182526001600160a01b0380861682528781166020808401918252838501898152600060608601818152436080880190815260a088018381528a151560c08a0190815260088054865260069097529984209851895490891673ffffffffffffffffffffffffffffffffffffffff19918216178a55965160018a0180549190991697169690961790965591516002870155905160038601559251600485015590516005909301805494511515610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff941515949094167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009095169490941792909217909255805491620050618362006f25565b9190505550826001600160a01b0316856001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc838760405162004cd49291909115158252602082015260400190565b60fa60a06000808285855af4620043f4573d6000803e3d6000fd5b815460026001808316156101000203821604825180820160208110602084100160028114620051815760018114620051a7578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b808210156200516a57815184556001840193506020820191506200514b565b815191036101000a908190040290915550620014e6565b60028302826020036101000a846020036101000a602089015104020185018755620014e6565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b808210156200520a5781518455600184019350602082019150620051eb565b815191036101000a90819004029091555050505050505050565b60006200523062006162565b600060f890506020828551602087016000855af16200524e57600080fd5b505192915050565b600081815260036020908152604080832060018101546001600160a01b03168452602890925282209091601382015460ff1660038111156200529c576200529c620062d7565b146200533c57600282015482546001600160a01b031660009081526027602052604081208054909190620052d290849062006add565b909155505081546001830154600284015460138401546040517f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f878342946200532f946001600160a01b0391821694911692909160ff9091169062007153565b60405180910390a1505050565b805482546001600160a01b039081169116146200545257600080826008015483600501546200536c919062006ac7565b905080600003620053845783600201549150620053aa565b80846002015484600d01546200539b919062006f42565b620053a79190620070d9565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b1580156200541557600080fd5b505af11580156200542a573d6000803e3d6000fd5b505050508183600d01600082825462005444919062006add565b909155506200547292505050565b81600201548160080160008282546200546c919062006add565b90915550505b81600201548160050160008282546200548c919062006add565b9091555050505050565b600081815260066020908152604080832060018101546001600160a01b0316845260289092528220600582015491929091610100900460ff16620056bc576002830154600c83015484546040517f7eee288d0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b1580156200554457600080fd5b505af115801562005559573d6000803e3d6000fd5b50505050600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b158015620055c857600080fd5b505af1158015620055dd573d6000803e3d6000fd5b50505050600083600801548460050154620055f9919062006ac7565b600d8501549091506200560d828462006f42565b620056199190620070d9565b92508184600d01600082825462005631919062006ac7565b9091555050600684015460000362005650576003850183905562005678565b6006840154600785015462005666908562006f42565b620056729190620070d9565b60038601555b828460060160008282546200568e919062006add565b90915550506003850154600785018054600090620056ae90849062006add565b909155506200579192505050565b5060028201546008820154811115620056d6575060088101545b8160090154600003620056f0576003830181905562005718565b6009820154600a83015462005706908362006f42565b620057129190620070d9565b60038401555b808260090160008282546200572e919062006add565b90915550506003830154600a830180546000906200574e90849062006add565b92505081905550808260080160008282546200576b919062006ac7565b90915550506002830154600b830180546000906200578b90849062006ac7565b90915550505b6005808401805460ff1916600117905582018054829190600090620057b890849062006ac7565b909155505050505050565b60008181526006602052604081206003810154909103620057e2575050565b60018101546001600160a01b031660009081526028602052604081206005830154909190610100900460ff166200587e578160070154826006015484600301546200582e919062006f42565b6200583a9190620070d9565b90508082600601600082825462005852919062006ac7565b909155505060038301546007830180546000906200587290849062006ac7565b90915550620058e39050565b81600a01548260090154846003015462005899919062006f42565b620058a59190620070d9565b905080826009016000828254620058bd919062006ac7565b90915550506003830154600a83018054600090620058dd90849062006ac7565b90915550505b82546001600160a01b031660009081526027602052604081208054839290620057b890849062006add565b60006200591f826060015162005fc7565b6001600160a01b03909116602084015290508015620059815760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f72000000000000000000000000000000000000000000604482015260640162000ec0565b6020808301516001600160a01b03908116600090815260289092526040909120600101541615620059f55760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000ec0565b6127108260800151111562002cbd5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000ec0565b60608160000362005a9157505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b811562005ac1578062005aa88162006f25565b915062005ab99050600a83620070d9565b915062005a95565b60008167ffffffffffffffff81111562005adf5762005adf620066da565b6040519080825280601f01601f19166020018201604052801562005b0a576020820181803683370190505b5090505b841562005b9a5762005b2260018362006ac7565b915062005b31600a8662006f8b565b62005b3e90603062006add565b60f81b81838151811062005b565762005b5662006ef6565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a90535062005b92600a86620070d9565b945062005b0e565b949350505050565b60608162005bb281601f62006add565b101562005c025760405162461bcd60e51b815260206004820152600e60248201527f736c6963655f6f766572666c6f77000000000000000000000000000000000000604482015260640162000ec0565b62005c0e828462006add565b8451101562005c605760405162461bcd60e51b815260206004820152601160248201527f736c6963655f6f75744f66426f756e6473000000000000000000000000000000604482015260640162000ec0565b60608215801562005c81576040519150600082526020820160405262005ccd565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101562005cbc57805183526020928301920162005ca2565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b81101562005d065762005d03601b8262007181565b90505b9250925092565b600062005d1962006162565b600085858560405160200162005d32939291906200719d565b6040516020818303038152906040529050600060fb905060008251602062005d5b919062006add565b90506020848285855afa62005d6f57600080fd5b5050905195945050505050565b6101808101516001600160a01b031662005dff57601d5460009062005da19062005a4d565b90508160200151826000015183608001518360405162005dc19062006180565b62005dd09493929190620071ff565b604051809103906000f08015801562005ded573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f909101805473ffffffffffffffffffffffffffffffffffffffff199081166001600160a01b039586161790915584518416835260289095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062005ebe90826200723d565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c8201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062005f9590826200723d565b5061026082015160138201805460ff1916600183600381111562005fbd5762005fbd620062d7565b0217905550505050565b60008062005fd46200618e565b60008060ff9050604083875160208901845afa62005ff157600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b0316815260200160008152602001600081526020016000815260200160008152602001600081526020016060815260200160006003811115620060e057620060e0620062d7565b905290565b6040518060a001604052806005906020820280368337509192915050565b508054600082559060005260206000209081019062000f659190620061ac565b508054620061319062006a43565b6000825580601f1062006142575050565b601f01602090049060005260206000209081019062000f659190620061d1565b60405180602001604052806001906020820280368337509192915050565b611a20806200730283390190565b60405180604001604052806002906020820280368337509192915050565b80821115620061cd576000620061c3828262006123565b50600101620061ac565b5090565b5b80821115620061cd5760008155600101620061d2565b60005b8381101562006205578181015183820152602001620061eb565b50506000910152565b6000815180845262006228816020860160208601620061e8565b601f01601f19169290920160200192915050565b6020815260006200625160208301846200620e565b9392505050565b6001600160a01b038116811462000f6557600080fd5b600080604083850312156200628257600080fd5b82356200628f8162006258565b946020939093013593505050565b600060208284031215620062b057600080fd5b8135620062518162006258565b600060208284031215620062d057600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b600481106200633e577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b9052565b602081526200635d6020820183516001600160a01b03169052565b600060208301516200637a60408401826001600160a01b03169052565b5060408301516001600160a01b0381166060840152506060830151610280806080850152620063ae6102a08501836200620e565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a062006436818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f1901610260808801919091529091506200649a84836200620e565b935080870151915050620064b18286018262006306565b5090949350505050565b600080600060608486031215620064d157600080fd5b8335620064de8162006258565b92506020840135620064f08162006258565b929592945050506040919091013590565b6000602082840312156200651457600080fd5b813567ffffffffffffffff8111156200652c57600080fd5b820161028081850312156200625157600080fd5b600081518084526020808501808196508360051b8101915082860160005b85811015620065b5578284038952815180516001600160a01b031685528581015186860152604090810151606091860182905290620065a0818701836200620e565b9a87019a95505050908401906001016200655e565b5091979650505050505050565b821515815260406020820152600062005b9a604083018462006540565b60008060408385031215620065f357600080fd5b50508035926020909101359150565b6000610200820190508551825260208601516020830152604086015160408301526060860151606083015260808601516001600160a01b0380821660808501528087511660a08501528060208801511660c08501528060408801511660e08501528060608801511661010085015280608088015116610120850152505060a08501516200669b6101408401826001600160a01b03169052565b5083516001600160a01b0316610160830152602084015161018083015260408401516101a08301526060909301516101c08201526101e0015292915050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b600082601f8301126200671b57600080fd5b813567ffffffffffffffff80821115620067395762006739620066da565b604051601f8301601f19908116603f01168101908282118183101715620067645762006764620066da565b816040528381528660208588010111156200677e57600080fd5b836020870160208301376000602085830101528094505050505092915050565b60008060008060808587031215620067b557600080fd5b843567ffffffffffffffff80821115620067ce57600080fd5b620067dc8883890162006709565b955060208701359150620067f08262006258565b909350604086013590808211156200680757600080fd5b620068158883890162006709565b935060608701359150808211156200682c57600080fd5b506200683b8782880162006709565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b82811015620068be577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc0888603018452620068ab8583516200620e565b945092850192908501906001016200686e565b5092979650505050505050565b60208152600062006251602083018462006540565b6020808252825182820181905260009190848201906040850190845b81811015620069235783516001600160a01b031683529284019291840191600101620068fc565b50909695505050505050565b600080604083850312156200694357600080fd5b823567ffffffffffffffff808211156200695c57600080fd5b6200696a8683870162006709565b935060208501359150808211156200698157600080fd5b50620069908582860162006709565b9150509250929050565b604081526000620069af60408301856200620e565b8281036020840152620069c381856200620e565b95945050505050565b600060208284031215620069df57600080fd5b813567ffffffffffffffff811115620069f757600080fd5b62005b9a8482850162006709565b6000806040838503121562006a1957600080fd5b823562006a268162006258565b9150602083013562006a388162006258565b809150509250929050565b600181811c9082168062006a5857607f821691505b60208210810362006a92577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111562000e505762000e5062006a98565b8082018082111562000e505762000e5062006a98565b6000813562000e508162006258565b60008083357fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe184360301811262006b3857600080fd5b83018035915067ffffffffffffffff82111562006b5457600080fd5b60200191503681900382131562006b6a57600080fd5b9250929050565b601f821115620043f457600081815260208120601f850160051c8101602086101562006b9a5750805b601f850160051c820191505b8181101562006bbb5782815560010162006ba6565b505050505050565b67ffffffffffffffff83111562006bde5762006bde620066da565b62006bf68362006bef835462006a43565b8362006b71565b6000601f84116001811462006c2d576000851562006c145750838201355b600019600387901b1c1916600186901b17835562001a6f565b600083815260209020601f19861690835b8281101562006c60578685013582556020948501946001909201910162006c3e565b508682101562006c7e5760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b600081356004811062000e5057600080fd5b6004821062006cda577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b60ff1981541660ff831681178255505050565b62006d2662006cfc8362006af3565b825473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0391909116178255565b62006d6662006d386020840162006af3565b600183016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b62006da662006d786040840162006af3565b600283016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b62006db5606083018362006b02565b62006dc581836003860162006bc3565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b82015562006e5c62006e2e610180840162006af3565b600c83016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f8201556102008201356010820155610220820135601182015562006ea361024083018362006b02565b62006eb381836012860162006bc3565b505062002cbd62006ec8610260840162006c90565b6013830162006ca2565b60006020828403121562006ee557600080fd5b815180151581146200625157600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b6000600019820362006f3b5762006f3b62006a98565b5060010190565b808202811582820484141762000e505762000e5062006a98565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b60008262006f9d5762006f9d62006f5c565b500690565b60006001600160a01b0380881683528087166020840152808616604084015260a0606084015262006fd760a08401866200620e565b91508084166080840152509695505050505050565b81810362006ff8575050565b62007004825462006a43565b67ffffffffffffffff8111156200701f576200701f620066da565b620070378162007030845462006a43565b8462006b71565b6000601f8211600181146200706e5760008315620070555750848201545b600019600385901b1c1916600184901b17845562001a6f565b600085815260209020601f19841690600086815260209020845b83811015620070aa578286015482556001958601959091019060200162007088565b5085831015620070c95781850154600019600388901b60f8161c191681555b5050505050600190811b01905550565b600082620070eb57620070eb62006f5c565b500490565b6000602082840312156200710357600080fd5b5051919050565b600084516200711e818460208901620061e8565b84519083019062007134818360208901620061e8565b845191019062007149818360208801620061e8565b0195945050505050565b6001600160a01b038581168252841660208201526040810183905260808101620069c3606083018462006306565b60ff818116838216019081111562000e505762000e5062006a98565b60008451620071b1818460208901620061e8565b845190830190620071c7818360208901620061e8565b60609490941b7fffffffffffffffffffffffffffffffffffffffff000000000000000000000000169301928352505060140192915050565b60006001600160a01b038087168352808616602084015250836040830152608060608301526200723360808301846200620e565b9695505050505050565b815167ffffffffffffffff8111156200725a576200725a620066da565b6200726b8162007030845462006a43565b602080601f831160018114620072a357600084156200728a5750858301515b600019600386901b1c1916600185901b17855562006bbb565b600085815260208120601f198616915b82811015620072d457888601518255948401946001909101908401620072b3565b5085821015620070c957939096015160001960f8600387901b161c19169092555050600190811b0190555056fe60806040523480156200001157600080fd5b5060405162001a2038038062001a20833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61164880620003d86000396000f3fe60806040526004361061018b5760003560e01c806359355736116100d6578063949813b81161007f578063a9059cbb11610059578063a9059cbb1461049b578063dd62ed3e146104bb578063fb489a7b1461050e57600080fd5b8063949813b81461044657806395d89b41146104665780639dc29fac1461047b57600080fd5b806370a08231116100b057806370a08231146103c35780637eee288d1461040657806384955c881461042657600080fd5b8063593557361461033d5780635ea1d6f81461038057806361d027b31461039657600080fd5b8063282d3fdf11610138578063372500ab11610112578063372500ab146102b65780633a5381b5146102cb57806340c10f191461031d57600080fd5b8063282d3fdf146102645780632f2c3f2e14610284578063313ce5671461029a57600080fd5b8063187cf4d711610169578063187cf4d71461020a57806319fac8fd1461022257806323b872dd1461024457600080fd5b806306fdde0314610190578063095ea7b3146101bb57806318160ddd146101eb575b600080fd5b34801561019c57600080fd5b506101a5610516565b6040516101b291906113af565b60405180910390f35b3480156101c757600080fd5b506101db6101d6366004611444565b6105a8565b60405190151581526020016101b2565b3480156101f757600080fd5b506004545b6040519081526020016101b2565b34801561021657600080fd5b506101fc633b9aca0081565b34801561022e57600080fd5b5061024261023d36600461146e565b6105bf565b005b34801561025057600080fd5b506101db61025f366004611487565b610656565b34801561027057600080fd5b5061024261027f366004611444565b610797565b34801561029057600080fd5b506101fc61271081565b3480156102a657600080fd5b50604051601281526020016101b2565b3480156102c257600080fd5b50610242610912565b3480156102d757600080fd5b50600a546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b60405173ffffffffffffffffffffffffffffffffffffffff90911681526020016101b2565b34801561032957600080fd5b50610242610338366004611444565b6109c9565b34801561034957600080fd5b506101fc6103583660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526002602052604090205490565b34801561038c57600080fd5b506101fc600c5481565b3480156103a257600080fd5b50600b546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b3480156103cf57600080fd5b506101fc6103de3660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561041257600080fd5b50610242610421366004611444565b610ab3565b34801561043257600080fd5b506101fc6104413660046114c3565b610c10565b34801561045257600080fd5b506101fc6104613660046114c3565b610c4b565b34801561047257600080fd5b506101a5610c86565b34801561048757600080fd5b50610242610496366004611444565b610c95565b3480156104a757600080fd5b506101db6104b6366004611444565b610d77565b3480156104c757600080fd5b506101fc6104d63660046114e5565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260036020908152604080832093909416825291909152205490565b6101fc610de3565b60606008805461052590611518565b80601f016020809104026020016040519081016040528092919081815260200182805461055190611518565b801561059e5780601f106105735761010080835404028352916020019161059e565b820191906000526020600020905b81548152906001019060200180831161058157829003601f168201915b5050505050905090565b60006105b5338484610fbb565b5060015b92915050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146106515760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e747261637400000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b600c55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600360209081526040808320338452909152812054828110156106fd5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610648565b610711853361070c868561159a565b610fbb565b61071b858461113a565b6107258484611249565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161078491815260200190565b60405180910390a3506001949350505050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146108245760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff821660009081526002602090815260408083205460019092529091205482916108619161159a565b10156108d45760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040812080548392906109099084906115ad565b90915550505050565b600061091d336112a1565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461096f576040519150601f19603f3d011682016040523d82523d6000602084013e610974565b606091505b50509050806109c55760405162461bcd60e51b815260206004820152601460248201527f4661696c656420746f2073656e642045746865720000000000000000000000006044820152606401610648565b5050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610a565760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610a608282611249565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610b405760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040902054811115610bdb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600260205260408120805483929061090990849061159a565b73ffffffffffffffffffffffffffffffffffffffff811660009081526002602090815260408083205460019092528220546105b9919061159a565b6000610c5682611320565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600560205260409020546105b991906115ad565b60606009805461052590611518565b60005473ffffffffffffffffffffffffffffffffffffffff163314610d225760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610d2c828261113a565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610aa7565b6000610d83338361113a565b610d8d8383611249565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6000805473ffffffffffffffffffffffffffffffffffffffff163314610e715760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b600c54349060009061271090610e8790846115c0565b610e9191906115d7565b905081811115610ee35760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f722072657761726400000000000000006044820152606401610648565b610eed818361159a565b600b5460405191935073ffffffffffffffffffffffffffffffffffffffff16906108fc9083906000818181858888f193505050503d8060008114610f4d576040519150601f19603f3d011682016040523d82523d6000602084013e610f52565b606091505b505060045460009150610f69633b9aca00856115c0565b610f7391906115d7565b905080600754610f8391906115ad565b600755600454600090633b9aca0090610f9c90846115c0565b610fa691906115d7565b9050610fb281846115ad565b94505050505090565b73ffffffffffffffffffffffffffffffffffffffff83166110435760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166110cc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b611143826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020908152604080832054600290925290912054611180908261159a565b8211156111cf5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610648565b6111d9828261159a565b73ffffffffffffffffffffffffffffffffffffffff841660009081526001602052604090205580820361122d5773ffffffffffffffffffffffffffffffffffffffff83166000908152600660205260408120555b816004600082825461123f919061159a565b9091555050505050565b611252826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906112889084906115ad565b92505081905550806004600082825461090991906115ad565b6000806112ad83611320565b73ffffffffffffffffffffffffffffffffffffffff84166000908152600560205260409020549091506112e19082906115ad565b73ffffffffffffffffffffffffffffffffffffffff90931660009081526005602090815260408083208690556007546006909252909120555090919050565b73ffffffffffffffffffffffffffffffffffffffff81166000908152600160205260408120548082036113565750600092915050565b73ffffffffffffffffffffffffffffffffffffffff8316600090815260066020526040812054600754611389919061159a565b90506000633b9aca0061139c84846115c0565b6113a691906115d7565b95945050505050565b600060208083528351808285015260005b818110156113dc578581018301518582016040015282016113c0565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff8116811461143f57600080fd5b919050565b6000806040838503121561145757600080fd5b6114608361141b565b946020939093013593505050565b60006020828403121561148057600080fd5b5035919050565b60008060006060848603121561149c57600080fd5b6114a58461141b565b92506114b36020850161141b565b9150604084013590509250925092565b6000602082840312156114d557600080fd5b6114de8261141b565b9392505050565b600080604083850312156114f857600080fd5b6115018361141b565b915061150f6020840161141b565b90509250929050565b600181811c9082168061152c57607f821691505b602082108103611565577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156105b9576105b961156b565b808201808211156105b9576105b961156b565b80820281158282048414176105b9576105b961156b565b60008261160d577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220ba014708b1b5df37f7d92aba1040b129bc35bb10417560ed1d7e4efd39583c0164736f6c63430008150033a2646970667358221220c8c97511ee643f2048174b36394965cc1e773711399344baa6f9729da40d781b64736f6c6343000815003360806040523480156200001157600080fd5b5060405162001a2038038062001a20833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61164880620003d86000396000f3fe60806040526004361061018b5760003560e01c806359355736116100d6578063949813b81161007f578063a9059cbb11610059578063a9059cbb1461049b578063dd62ed3e146104bb578063fb489a7b1461050e57600080fd5b8063949813b81461044657806395d89b41146104665780639dc29fac1461047b57600080fd5b806370a08231116100b057806370a08231146103c35780637eee288d1461040657806384955c881461042657600080fd5b8063593557361461033d5780635ea1d6f81461038057806361d027b31461039657600080fd5b8063282d3fdf11610138578063372500ab11610112578063372500ab146102b65780633a5381b5146102cb57806340c10f191461031d57600080fd5b8063282d3fdf146102645780632f2c3f2e14610284578063313ce5671461029a57600080fd5b8063187cf4d711610169578063187cf4d71461020a57806319fac8fd1461022257806323b872dd1461024457600080fd5b806306fdde0314610190578063095ea7b3146101bb57806318160ddd146101eb575b600080fd5b34801561019c57600080fd5b506101a5610516565b6040516101b291906113af565b60405180910390f35b3480156101c757600080fd5b506101db6101d6366004611444565b6105a8565b60405190151581526020016101b2565b3480156101f757600080fd5b506004545b6040519081526020016101b2565b34801561021657600080fd5b506101fc633b9aca0081565b34801561022e57600080fd5b5061024261023d36600461146e565b6105bf565b005b34801561025057600080fd5b506101db61025f366004611487565b610656565b34801561027057600080fd5b5061024261027f366004611444565b610797565b34801561029057600080fd5b506101fc61271081565b3480156102a657600080fd5b50604051601281526020016101b2565b3480156102c257600080fd5b50610242610912565b3480156102d757600080fd5b50600a546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b60405173ffffffffffffffffffffffffffffffffffffffff90911681526020016101b2565b34801561032957600080fd5b50610242610338366004611444565b6109c9565b34801561034957600080fd5b506101fc6103583660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526002602052604090205490565b34801561038c57600080fd5b506101fc600c5481565b3480156103a257600080fd5b50600b546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b3480156103cf57600080fd5b506101fc6103de3660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561041257600080fd5b50610242610421366004611444565b610ab3565b34801561043257600080fd5b506101fc6104413660046114c3565b610c10565b34801561045257600080fd5b506101fc6104613660046114c3565b610c4b565b34801561047257600080fd5b506101a5610c86565b34801561048757600080fd5b50610242610496366004611444565b610c95565b3480156104a757600080fd5b506101db6104b6366004611444565b610d77565b3480156104c757600080fd5b506101fc6104d63660046114e5565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260036020908152604080832093909416825291909152205490565b6101fc610de3565b60606008805461052590611518565b80601f016020809104026020016040519081016040528092919081815260200182805461055190611518565b801561059e5780601f106105735761010080835404028352916020019161059e565b820191906000526020600020905b81548152906001019060200180831161058157829003601f168201915b5050505050905090565b60006105b5338484610fbb565b5060015b92915050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146106515760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e747261637400000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b600c55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600360209081526040808320338452909152812054828110156106fd5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610648565b610711853361070c868561159a565b610fbb565b61071b858461113a565b6107258484611249565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161078491815260200190565b60405180910390a3506001949350505050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146108245760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff821660009081526002602090815260408083205460019092529091205482916108619161159a565b10156108d45760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040812080548392906109099084906115ad565b90915550505050565b600061091d336112a1565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461096f576040519150601f19603f3d011682016040523d82523d6000602084013e610974565b606091505b50509050806109c55760405162461bcd60e51b815260206004820152601460248201527f4661696c656420746f2073656e642045746865720000000000000000000000006044820152606401610648565b5050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610a565760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610a608282611249565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610b405760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040902054811115610bdb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600260205260408120805483929061090990849061159a565b73ffffffffffffffffffffffffffffffffffffffff811660009081526002602090815260408083205460019092528220546105b9919061159a565b6000610c5682611320565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600560205260409020546105b991906115ad565b60606009805461052590611518565b60005473ffffffffffffffffffffffffffffffffffffffff163314610d225760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610d2c828261113a565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610aa7565b6000610d83338361113a565b610d8d8383611249565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6000805473ffffffffffffffffffffffffffffffffffffffff163314610e715760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b600c54349060009061271090610e8790846115c0565b610e9191906115d7565b905081811115610ee35760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f722072657761726400000000000000006044820152606401610648565b610eed818361159a565b600b5460405191935073ffffffffffffffffffffffffffffffffffffffff16906108fc9083906000818181858888f193505050503d8060008114610f4d576040519150601f19603f3d011682016040523d82523d6000602084013e610f52565b606091505b505060045460009150610f69633b9aca00856115c0565b610f7391906115d7565b905080600754610f8391906115ad565b600755600454600090633b9aca0090610f9c90846115c0565b610fa691906115d7565b9050610fb281846115ad565b94505050505090565b73ffffffffffffffffffffffffffffffffffffffff83166110435760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166110cc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b611143826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020908152604080832054600290925290912054611180908261159a565b8211156111cf5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610648565b6111d9828261159a565b73ffffffffffffffffffffffffffffffffffffffff841660009081526001602052604090205580820361122d5773ffffffffffffffffffffffffffffffffffffffff83166000908152600660205260408120555b816004600082825461123f919061159a565b9091555050505050565b611252826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906112889084906115ad565b92505081905550806004600082825461090991906115ad565b6000806112ad83611320565b73ffffffffffffffffffffffffffffffffffffffff84166000908152600560205260409020549091506112e19082906115ad565b73ffffffffffffffffffffffffffffffffffffffff90931660009081526005602090815260408083208690556007546006909252909120555090919050565b73ffffffffffffffffffffffffffffffffffffffff81166000908152600160205260408120548082036113565750600092915050565b73ffffffffffffffffffffffffffffffffffffffff8316600090815260066020526040812054600754611389919061159a565b90506000633b9aca0061139c84846115c0565b6113a691906115d7565b95945050505050565b600060208083528351808285015260005b818110156113dc578581018301518582016040015282016113c0565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff8116811461143f57600080fd5b919050565b6000806040838503121561145757600080fd5b6114608361141b565b946020939093013593505050565b60006020828403121561148057600080fd5b5035919050565b60008060006060848603121561149c57600080fd5b6114a58461141b565b92506114b36020850161141b565b9150604084013590509250925092565b6000602082840312156114d557600080fd5b6114de8261141b565b9392505050565b600080604083850312156114f857600080fd5b6115018361141b565b915061150f6020840161141b565b90509250929050565b600181811c9082168061152c57607f821691505b602082108103611565577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156105b9576105b961156b565b808201808211156105b9576105b961156b565b80820281158282048414176105b9576105b961156b565b60008261160d577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220ba014708b1b5df37f7d92aba1040b129bc35bb10417560ed1d7e4efd39583c0164736f6c63430008150033")
 
 var AutonityAbi, _ = abi.JSON(strings.NewReader(`[
    {
@@ -347,6 +347,19 @@ var AutonityAbi, _ = abi.JSON(strings.NewReader(`[
       "name" : "CommissionRateChange",
       "type" : "event"
    },
+   {
+      "anonymous" : false,
+      "inputs" : [
+         {
+            "indexed" : true,
+            "internalType" : "address",
+            "name" : "addr",
+            "type" : "address"
+         }
+      ],
+      "name" : "ConsensusEndpointsUpdated",
+      "type" : "event"
+   },
    {
       "anonymous" : false,
       "inputs" : [
@@ -1354,6 +1367,29 @@ var AutonityAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "view",
       "type" : "function"
    },
+   {
+      "inputs" : [
+         {
+            "internalType" : "address",
+            "name" : "_validator",
+            "type" : "address"
+         },
+         {
+            "internalType" : "address",
+            "name" : "_delegator",
+            "type" : "address"
+         },
+         {
+            "internalType" : "uint256",
+            "name" : "_amount",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "lockLiquid",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
@@ -1712,6 +1748,42 @@ var AutonityAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "nonpayable",
       "type" : "function"
    },
+   {
+      "inputs" : [
+         {
+            "internalType" : "address",
+            "name" : "_validator",
+            "type" : "address"
+         },
+         {
+            "internalType" : "address",
+            "name" : "_delegator",
+            "type" : "address"
+         },
+         {
+            "internalType" : "uint256",
+            "name" : "_amount",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "unlockLiquid",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "internalType" : "bytes",
+            "name" : "_record",
+            "type" : "bytes"
+         }
+      ],
+      "name" : "updateConsensusEndpoints",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
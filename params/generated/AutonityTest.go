@@ -4,7 +4,7 @@ import "strings"
 import "github.com/autonity/autonity/accounts/abi"
 import "github.com/autonity/autonity/common"
 
-var AutonityTestBytecode = common.Hex2Bytes("60806040526000600b556000600c553480156200001b57600080fd5b506040516200c2573803806200c2578339810160408190526200003e9162000e6b565b8181600d600f01546000036200006c57602a80546001600160a01b031916331790556200006c828262000076565b505050506200132c565b80518051600d55602080820151600e55604080830151600f55606080840151601055608093840151601180546001600160a01b03199081166001600160a01b03938416179091558487015180516012805484169185169190911790558086015160138054841691851691909117905580850151601480548416918516919091179055808401516015805484169185169190911790559586015160168054831691841691909117905560a0909501516017805487169183169190911790558286015180516018805490971692169190911790945591830151601955820151601a5590810151601b55810151601c5560005b825181101562000427576000838281518110620001875762000187620010a8565b602002602001015160a0015190506000848381518110620001ac57620001ac620010a8565b60200260200101516101a00181815250506000848381518110620001d457620001d4620010a8565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620002105762000210620010a8565b602002602001015160a00181815250506000848381518110620002375762000237620010a8565b60209081029190910101516101c00152600f548451859084908110620002615762000261620010a8565b602002602001015160800181815250506000848381518110620002885762000288620010a8565b602002602001015161026001906003811115620002a957620002a9620010be565b90816003811115620002bf57620002bf620010be565b815250506000848381518110620002da57620002da620010a8565b602002602001015161016001818152505062000318848381518110620003045762000304620010a8565b60200260200101516200042c60201b60201c565b62000345848381518110620003315762000331620010a8565b60200260200101516200056760201b60201c565b80602760008685815181106200035f576200035f620010a8565b6020026020010151600001516001600160a01b03166001600160a01b0316815260200190815260200160002060008282546200039c9190620010ea565b925050819055508060296000828254620003b79190620010ea565b9250508190555062000411848381518110620003d757620003d7620010a8565b60200260200101516020015182868581518110620003f957620003f9620010a8565b6020026020010151600001516200079860201b60201c565b50806200041e8162001106565b91505062000166565b505050565b60006200044382606001516200098360201b60201c565b6001600160a01b03909116602084015290508015620004975760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064015b60405180910390fd5b6020808301516001600160a01b039081166000908152602890925260409091206001015416156200050b5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c726561647920726567697374657265640000000060448201526064016200048e565b61271082608001511115620005635760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e207261746500000000000000000060448201526064016200048e565b5050565b6101808101516001600160a01b0316620005ea57601d546000906200058c90620009d1565b905081602001518260000151836080015183604051620005ac9062000af1565b620005bb949392919062001122565b604051809103906000f080158015620005d8573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b03958616179091558451841683526028909552604091829020865181548716908516178155935190840180548616918416919091179055840151600283018054909416911617909155606082015182919060038201906200069c908262001206565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062000766908262001206565b5061026082015160138201805460ff191660018360038111156200078e576200078e620010be565b0217905550505050565b60008211620007f65760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b60648201526084016200048e565b6001600160a01b038116600090815260276020526040902054821115620008605760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e6365000000000060448201526064016200048e565b6001600160a01b038116600090815260276020526040812080548492906200088a908490620012d2565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b0319918216178255955160018201805491909816961695909517909555905160028401555191015580549192620009168362001106565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a35050505050565b6000806200099062000aff565b60008060ff9050604083875160208901845afa620009ad57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b606081600003620009f95750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000a29578062000a108162001106565b915062000a219050600a83620012fe565b9150620009fd565b6000816001600160401b0381111562000a465762000a4662000b1d565b6040519080825280601f01601f19166020018201604052801562000a71576020820181803683370190505b5090505b841562000ae95762000a89600183620012d2565b915062000a98600a8662001315565b62000aa5906030620010ea565b60f81b81838151811062000abd5762000abd620010a8565b60200101906001600160f81b031916908160001a90535062000ae1600a86620012fe565b945062000a75565b949350505050565b611a20806200a83783390190565b60405180604001604052806002906020820280368337509192915050565b634e487b7160e01b600052604160045260246000fd5b604051608081016001600160401b038111828210171562000b585762000b5862000b1d565b60405290565b60405160a081016001600160401b038111828210171562000b585762000b5862000b1d565b60405161028081016001600160401b038111828210171562000b585762000b5862000b1d565b604051601f8201601f191681016001600160401b038111828210171562000bd45762000bd462000b1d565b604052919050565b6001600160a01b038116811462000bf257600080fd5b50565b805162000c028162000bdc565b919050565b60005b8381101562000c2457818101518382015260200162000c0a565b50506000910152565b600082601f83011262000c3f57600080fd5b81516001600160401b0381111562000c5b5762000c5b62000b1d565b62000c70601f8201601f191660200162000ba9565b81815284602083860101111562000c8657600080fd5b62000ae982602083016020870162000c07565b80516004811062000c0257600080fd5b600060c0828403121562000cbc57600080fd5b60405160c081016001600160401b038111828210171562000ce15762000ce162000b1d565b8060405250809150825162000cf68162000bdc565b8152602083015162000d088162000bdc565b6020820152604083015162000d1d8162000bdc565b6040820152606083015162000d328162000bdc565b6060820152608083015162000d478162000bdc565b608082015260a083015162000d5c8162000bdc565b60a0919091015292915050565b60006080828403121562000d7c57600080fd5b62000d8662000b33565b9050815162000d958162000bdc565b8082525060208201516020820152604082015160408201526060820151606082015292915050565b600081830361020081121562000dd257600080fd5b62000ddc62000b33565b915060a081121562000ded57600080fd5b5062000df862000b5e565b82518152602083015160208201526040830151604082015260608301516060820152608083015162000e2a8162000bdc565b6080820152815262000e408360a0840162000ca9565b602082015262000e5583610160840162000d69565b60408201526101e0820151606082015292915050565b60008061022080848603121562000e8157600080fd5b83516001600160401b038082111562000e9957600080fd5b818601915086601f83011262000eae57600080fd5b815160208282111562000ec55762000ec562000b1d565b8160051b62000ed682820162000ba9565b928352848101820192828101908b85111562000ef157600080fd5b83870192505b84831015620010865782518681111562000f1057600080fd5b8701610280818e03601f1901121562000f2857600080fd5b62000f3262000b83565b62000f3f86830162000bf5565b815262000f4f6040830162000bf5565b8682015262000f616060830162000bf5565b604082015260808201518881111562000f7957600080fd5b62000f898f888386010162000c2d565b60608301525060a0820151608082015260c082015160a082015260e082015160c082015261010082015160e082015261012082015161010082015261014082015161012082015261016082015161014082015261018082015161016082015262000ff76101a0830162000bf5565b6101808201526101c08201516101a08201526101e08201516101c08201526102008201516101e0820152898201516102008201526102408201518a820152610260820151888111156200104957600080fd5b620010598f888386010162000c2d565b610240830152506200106f610280830162000c99565b610260820152835250918301919083019062000ef7565b8099505050506200109a89828a0162000dbd565b955050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b80820180821115620011005762001100620010d4565b92915050565b6000600182016200111b576200111b620010d4565b5060010190565b600060018060a01b038087168352808616602084015250836040830152608060608301528251806080840152620011618160a085016020870162000c07565b601f01601f19169190910160a00195945050505050565b600181811c908216806200118d57607f821691505b602082108103620011ae57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200042757600081815260208120601f850160051c81016020861015620011dd5750805b601f850160051c820191505b81811015620011fe57828155600101620011e9565b505050505050565b81516001600160401b0381111562001222576200122262000b1d565b6200123a8162001233845462001178565b84620011b4565b602080601f831160018114620012725760008415620012595750858301515b600019600386901b1c1916600185901b178555620011fe565b600085815260208120601f198616915b82811015620012a35788860151825594840194600190910190840162001282565b5085821015620012c25787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b81810381811115620011005762001100620010d4565b634e487b7160e01b600052601260045260246000fd5b600082620013105762001310620012e8565b500490565b600082620013275762001327620012e8565b500690565b6194fb806200133c6000396000f3fe608060405260043610620004d15760003560e01c8063852c48491162000285578063b66b3e791162000165578063d372c07e11620000db578063dfb1a4d2116200008f578063e7f43c681162000075578063e7f43c681462001039578063f5502f221462001059578063f7866ee3146200113057005b8063dfb1a4d2146200100a578063dfd4b688146200102157005b8063d861b0e811620000c1578063d861b0e81462000f83578063d886f8a21462000f9b578063dd62ed3e1462000fc057005b8063d372c07e1462000f3c578063d5f394881462000f6157005b8063c9d97af41162000133578063ceaad4551162000119578063ceaad4551462000eda578063cf9c57191462000eff578063cfd19fb91462000f1757005b8063c9d97af41462000e9d578063cb696f541462000eb557005b8063b66b3e791462000e2e578063b7ab4db51462000e56578063bb0db4281462000e6e578063c2362dd51462000e8557005b8063a515366a11620001fb578063ab8f6ffe11620001c9578063b2ea9adb11620001af578063b2ea9adb1462000dbf578063b3ecbadd1462000de4578063b46e55201462000e0957005b8063ab8f6ffe1462000d71578063ae1f5fa01462000d9857005b8063a515366a1462000cdb578063a5d059ca1462000d00578063a8b2216e1462000d25578063a9059cbb1462000d4c57005b806396b477cb11620002535780639c98e47111620002395780639c98e4711462000c875780639dc29fac1462000c9f5780639efa9e7a1462000cc457005b806396b477cb1462000c3e5780639bb851c01462000c6f57005b8063852c48491462000b94578063872cf0591462000bb95780638bac7dad1462000bd157806395d89b411462000bf657005b806340c10f1911620003b15780636b5f444c116200032757806377e741c711620002f5578063819b646311620002db578063819b64631462000b38578063833b1fce1462000b4f57806384467fdb1462000b6f57005b806377e741c71462000a3557806379502c551462000a5a57005b80636b5f444c14620009a85780636fd2c80b14620009cd57806370a0823114620009e4578063731b3a031462000a1e57005b80634bb278f3116200037f578063520fdbbc1162000365578063520fdbbc146200092d5780635f7d39491462000952578063676c24ab146200099057005b80634bb278f314620008ee5780634efcd15f146200091657005b806340c10f191462000876578063427bc5de146200089b5780634364596914620008b2578063496ccd9b14620008c957005b80631904bb2e11620004475780632f2c3f2e1162000415578063313ce56711620003fb578063313ce567146200081c57806335be16e0146200083a5780633d0ae216146200085f57005b80632f2c3f2e14620006ca57806330bcb81c14620006e257005b80631904bb2e146200064257806323b872dd14620006765780632701849b146200069b57806329070c6d14620006b357005b806311220633116200049f5780631250a28d11620004855780631250a28d14620005ee5780631604e416146200061357806318160ddd146200062b57005b80631122063314620005b2578063114eaf5514620005c957005b806306fdde0314620004db578063095ea7b314620005365780630ae65e7a146200056c5780630d8e6e2c146200059157005b36620004d957005b005b348015620004e857600080fd5b5060408051808201909152600681527f4e6577746f6e000000000000000000000000000000000000000000000000000060208201525b6040516200052d919062006990565b60405180910390f35b3480156200054357600080fd5b506200055b62000555366004620069c2565b62001150565b60405190151581526020016200052d565b3480156200057957600080fd5b50620004d96200058b366004620069f1565b62001169565b3480156200059e57600080fd5b50601c545b6040519081526020016200052d565b348015620005bf57600080fd5b50600e54620005a3565b348015620005d657600080fd5b50620004d9620005e836600462006a11565b6200127b565b348015620005fb57600080fd5b50620004d96200060d366004620069f1565b620012dc565b3480156200062057600080fd5b50620005a360245481565b3480156200063857600080fd5b50602954620005a3565b3480156200064f57600080fd5b506200066762000661366004620069f1565b62001367565b6040516200052d919062006a96565b3480156200068357600080fd5b506200055b6200069536600462006c0f565b62001644565b348015620006a857600080fd5b50620004d96200169e565b348015620006c057600080fd5b50600d54620005a3565b348015620006d757600080fd5b50620005a361271081565b348015620006ef57600080fd5b50620007b46200070136600462006a11565b6040805160e081018252600080825260208201819052918101829052606081018290526080810182905260a0810182905260c081019190915250600090815260066020908152604091829020825160e08101845281546001600160a01b03908116825260018301541692810192909252600281015492820192909252600382015460608201526004820154608082015260059091015460ff808216151560a084015261010090910416151560c082015290565b6040516200052d9190600060e0820190506001600160a01b038084511683528060208501511660208401525060408301516040830152606083015160608301526080830151608083015260a0830151151560a083015260c0830151151560c083015292915050565b3480156200082957600080fd5b50604051601281526020016200052d565b3480156200084757600080fd5b50620004d96200085936600462006c55565b6200172c565b3480156200086c57600080fd5b50600954620005a3565b3480156200088357600080fd5b50620004d962000895366004620069c2565b62001922565b348015620008a857600080fd5b50600554620005a3565b348015620008bf57600080fd5b50601a54620005a3565b348015620008d657600080fd5b50620004d9620008e8366004620069f1565b62001a0b565b348015620008fb57600080fd5b506200090662001b83565b6040516200052d92919062006d16565b3480156200092357600080fd5b50602154620005a3565b3480156200093a57600080fd5b50620004d96200094c366004620069f1565b62002003565b3480156200095f57600080fd5b50620009776200097136600462006d33565b620022b7565b6040516001600160a01b0390911681526020016200052d565b3480156200099d57600080fd5b50620004d9620024e2565b348015620009b557600080fd5b50620004d9620009c736600462006a11565b62002c99565b348015620009da57600080fd5b50601054620005a3565b348015620009f157600080fd5b50620005a362000a03366004620069f1565b6001600160a01b031660009081526027602052604090205490565b34801562000a2b57600080fd5b50602054620005a3565b34801562000a4257600080fd5b50620004d962000a5436600462006a11565b62002e67565b34801562000a6757600080fd5b506040805160a08082018352600d548252600e54602080840191909152600f54838501526010546060808501919091526011546001600160a01b03908116608080870191909152865160c0810188526012548316815260135483168186015260145483168189015260155483168185015260165483168183015260175483169581019590955286519081018752601854909116815260195492810192909252601a5494820194909452601b5493810193909352601c5462000b26939084565b6040516200052d949392919062006d56565b34801562000b4557600080fd5b50601b54620005a3565b34801562000b5c57600080fd5b506013546001600160a01b031662000977565b34801562000b7c57600080fd5b50620004d962000b8e36600462006ef2565b62002ec8565b34801562000ba157600080fd5b50620004d962000bb3366004620069c2565b62002ff7565b34801562000bc657600080fd5b50620004d962003219565b34801562000bde57600080fd5b50620004d962000bf036600462006a11565b62003284565b34801562000c0357600080fd5b5060408051808201909152600381527f4e544e000000000000000000000000000000000000000000000000000000000060208201526200051e565b34801562000c4b57600080fd5b50620005a362000c5d36600462006a11565b6000908152601f602052604090205490565b34801562000c7c57600080fd5b50620005a360235481565b34801562000c9457600080fd5b50620005a360215481565b34801562000cac57600080fd5b50620004d962000cbe366004620069c2565b62003337565b34801562000cd157600080fd5b50600854620005a3565b34801562000ce857600080fd5b50620004d962000cfa366004620069c2565b62003483565b34801562000d0d57600080fd5b50620004d962000d1f366004620069c2565b62003585565b34801562000d3257600080fd5b5062000d3d62003653565b6040516200052d919062006f9b565b34801562000d5957600080fd5b506200055b62000d6b366004620069c2565b62003736565b34801562000d7e57600080fd5b5062000d8962003745565b6040516200052d91906200701f565b34801562000da557600080fd5b5062000db062003857565b6040516200052d919062007034565b34801562000dcc57600080fd5b50620004d962000dde36600462007083565b62003afd565b34801562000df157600080fd5b50620004d962000e03366004620069f1565b62003b73565b34801562000e1657600080fd5b50620004d962000e28366004620069f1565b62003bfe565b34801562000e3b57600080fd5b5062000e4662003ebe565b6040516200052d929190620070ee565b34801562000e6357600080fd5b5062000db062003ff5565b34801562000e7b57600080fd5b50600454620005a3565b34801562000e9257600080fd5b50620005a360205481565b34801562000eaa57600080fd5b50620005a3601e5481565b34801562000ec257600080fd5b50620004d962000ed436600462006a11565b62004059565b34801562000ee757600080fd5b50620004d962000ef9366004620069f1565b620040eb565b34801562000f0c57600080fd5b50620004d962004176565b34801562000f2457600080fd5b50620004d962000f36366004620069f1565b620041f9565b34801562000f4957600080fd5b50620004d962000f5b366004620069f1565b62004284565b34801562000f6e57600080fd5b50602a5462000977906001600160a01b031681565b34801562000f9057600080fd5b50620004d96200430f565b34801562000fa857600080fd5b50620004d962000fba366004620069f1565b620043a5565b34801562000fcd57600080fd5b50620005a362000fdf36600462007120565b6001600160a01b03918216600090815260266020908152604080832093909416825291909152205490565b3480156200101757600080fd5b50601954620005a3565b3480156200102e57600080fd5b50620004d962004430565b3480156200104657600080fd5b506018546001600160a01b031662000977565b3480156200106657600080fd5b50620010ea6200107836600462006a11565b60408051608081018252600080825260208201819052918101829052606081019190915250600090815260036020818152604092839020835160808101855281546001600160a01b03908116825260018301541692810192909252600281015493820193909352910154606082015290565b6040516200052d919060006080820190506001600160a01b0380845116835280602085015116602084015250604083015160408301526060830151606083015292915050565b3480156200113d57600080fd5b506011546001600160a01b031662000977565b60006200115f3384846200443a565b5060015b92915050565b6001600160a01b0380821660008181526028602052604090206001015490911614620011dc5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d75737420626520726567697374657265640000000060448201526064015b60405180910390fd5b6001600160a01b038181166000908152602860205260409020541633146200126d5760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e7400000000000000000000000000000000000000006064820152608401620011d3565b620012788162004597565b50565b6018546001600160a01b03163314620012d75760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b601055565b6018546001600160a01b03163314620013385760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b6012805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6200137162006769565b6001600160a01b0380831660008181526028602052604090206001015490911614620013e05760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620011d3565b6001600160a01b03808316600090815260286020908152604091829020825161028081018452815485168152600182015485169281019290925260028101549093169181019190915260038201805491929160608401919062001443906200715e565b80601f016020809104026020016040519081016040528092919081815260200182805462001471906200715e565b8015620014c25780601f106200149657610100808354040283529160200191620014c2565b820191906000526020600020905b815481529060010190602001808311620014a457829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e0909201916200157e906200715e565b80601f0160208091040260200160405190810160405280929190818152602001828054620015ac906200715e565b8015620015fd5780601f10620015d157610100808354040283529160200191620015fd565b820191906000526020600020905b815481529060010190602001808311620015df57829003601f168201915b5050509183525050601382015460209091019060ff16600381111562001627576200162762006a2b565b60038111156200163b576200163b62006a2b565b90525092915050565b6000620016538484846200466e565b6001600160a01b038416600090815260266020908152604080832033845290915281205462001684908490620071e2565b9050620016938533836200443a565b506001949350505050565b602a546001600160a01b03163314620017205760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620011d3565b6200172a6200477e565b565b6012546001600160a01b03163314620017ae5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e747260448201527f61637400000000000000000000000000000000000000000000000000000000006064820152608401620011d3565b6000610120820135602882620017cb6040860160208701620069f1565b6001600160a01b03166001600160a01b0316815260200190815260200160002060090154620017fb9190620071e2565b60c083013560286000620018166040870160208801620069f1565b6001600160a01b03166001600160a01b0316815260200190815260200160002060060154620018469190620071e2565b60a084013560286000620018616040880160208901620069f1565b6001600160a01b03166001600160a01b0316815260200190815260200160002060050154620018919190620071e2565b6200189d9190620071f8565b620018a99190620071f8565b6011546001600160a01b0316600090815260276020526040812080549293508392909190620018da908490620071f8565b9091555082905060286000620018f76040840160208501620069f1565b6001600160a01b0316815260208101919091526040016000206200191c828262007408565b50505050565b6018546001600160a01b031633146200197e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b6001600160a01b03821660009081526027602052604081208054839290620019a8908490620071f8565b925050819055508060296000828254620019c39190620071f8565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf906020015b60405180910390a25050565b6018546001600160a01b0316331462001a675760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b6013805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556014546040517f7adbf973000000000000000000000000000000000000000000000000000000008152600481019290925290911690637adbf97390602401600060405180830381600087803b15801562001aec57600080fd5b505af115801562001b01573d6000803e3d6000fd5b50506016546040517f7adbf9730000000000000000000000000000000000000000000000000000000081526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b15801562001b6757600080fd5b505af115801562001b7c573d6000803e3d6000fd5b5050505050565b602a546000906060906001600160a01b0316331462001c0b5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620011d3565b601e54436000818152601f602090815260408220939093556019549254909262001c3591620071f8565b6012546040517f6c9789b0000000000000000000000000000000000000000000000000000000008152929091146004830181905292506001600160a01b031690636c9789b090602401600060405180830381600087803b15801562001c9957600080fd5b505af115801562001cae573d6000803e3d6000fd5b50505050801562001dbc5762001cc3620048c1565b62001ccd62004cc3565b62001cd76200477e565b600062001ce362003857565b6013546040517f845023f20000000000000000000000000000000000000000000000000000000081529192506001600160a01b03169063845023f29062001d2f90849060040162007034565b600060405180830381600087803b15801562001d4a57600080fd5b505af115801562001d5f573d6000803e3d6000fd5b50505050436020819055506001601e600082825462001d7f9190620071f8565b9091555050601e546040519081527febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3359060200160405180910390a1505b601354604080517f4bb278f300000000000000000000000000000000000000000000000000000000815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af115801562001e21573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062001e479190620075ed565b9050801562001edc57601454604080517fa2e6204500000000000000000000000000000000000000000000000000000000815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af192505050801562001ed4575060408051601f3d908101601f1916820190925262001ed191810190620075ed565b60015b1562001edc57505b600254602280546040805160208084028201810190925282815260ff9094169391839160009084015b8282101562001ff3576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162001f5b906200715e565b80601f016020809104026020016040519081016040528092919081815260200182805462001f89906200715e565b801562001fda5780601f1062001fae5761010080835404028352916020019162001fda565b820191906000526020600020905b81548152906001019060200180831162001fbc57829003601f168201915b5050505050815250508152602001906001019062001f05565b5050505090509350935050509091565b6018546001600160a01b031633146200205f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b6018805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556013546040517fb3ab15fb00000000000000000000000000000000000000000000000000000000815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b158015620020e457600080fd5b505af1158015620020f9573d6000803e3d6000fd5b50506014546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200215e57600080fd5b505af115801562002173573d6000803e3d6000fd5b50506015546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620021d857600080fd5b505af1158015620021ed573d6000803e3d6000fd5b50506016546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200225257600080fd5b505af115801562002267573d6000803e3d6000fd5b50506017546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb915060240162001b4c565b600080805b602254811015620023135760228181548110620022dd57620022dd62007611565b90600052602060002090600302016001015482620022fc9190620071f8565b9150806200230a8162007640565b915050620022bc565b5080600003620023665760405162461bcd60e51b815260206004820152601c60248201527f54686520636f6d6d6974746565206973206e6f74207374616b696e67000000006044820152606401620011d3565b600083620023766063876200765d565b620023829190620071f8565b90506000816040516020016200239a91815260200190565b60408051601f19818403018152919052805160209091012090506000620023c28483620076a6565b90506000805b602254811015620024725760228181548110620023e957620023e962007611565b90600052602060002090600302016001015482620024089190620071f8565b915062002417600183620071e2565b83116200245d576022818154811062002434576200243462007611565b60009182526020909120600390910201546001600160a01b031696506200116395505050505050565b80620024698162007640565b915050620023c8565b5060405162461bcd60e51b815260206004820152602960248201527f5468657265206973206e6f2076616c696461746f72206c65667420696e20746860448201527f65206e6574776f726b00000000000000000000000000000000000000000000006064820152608401620011d3565b620024ec62004cc3565b6000620024f862003857565b90506000815167ffffffffffffffff81111562002519576200251962006e2e565b60405190808252806020026020018201604052801562002543578160200160208202803683370190505b50601b5460225491925060009182911015620025c85760405162461bcd60e51b815260206004820152602760248201527f636f6d6d69747465652073697a652065786365656473204d6178436f6d6d697460448201527f74656553697a65000000000000000000000000000000000000000000000000006064820152608401620011d3565b60005b60225481101562002ad257600060228281548110620025ee57620025ee62007611565b60009182526020909120600390910201546001600160a01b0316905080620026595760405162461bcd60e51b815260206004820152600f60248201527f696e76616c6964206164647265737300000000000000000000000000000000006044820152606401620011d3565b808583815181106200266f576200266f62007611565b60200260200101906001600160a01b031690816001600160a01b031681525050600060228381548110620026a757620026a762007611565b9060005260206000209060030201600101549050600081116200270d5760405162461bcd60e51b815260206004820152601460248201527f30207374616b6520696e20636f6d6d69747465650000000000000000000000006044820152606401620011d3565b620027198186620071f8565b94508215620027745780841015620027745760405162461bcd60e51b815260206004820152601c60248201527f636f6d6d6974746565206d656d62657273206e6f7420736f72746564000000006044820152606401620011d3565b6001600160a01b0380831660008181526028602052604090206001810154939650869390921614620027e95760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220646f6573206e6f7420657869737400000000000000006044820152606401620011d3565b818160050154146200283e5760405162461bcd60e51b815260206004820152600e60248201527f7374616b65206d69736d617463680000000000000000000000000000000000006044820152606401620011d3565b87848151811062002853576200285362007611565b602090810291909101015160028201546001600160a01b03908116911614620028bf5760405162461bcd60e51b815260206004820152601760248201527f6f7261636c652061646472657373206d69736d617463680000000000000000006044820152606401620011d3565b6000601382015460ff166003811115620028dd57620028dd62006a2b565b146200292c5760405162461bcd60e51b815260206004820152601460248201527f76616c696461746f72206e6f74206163746976650000000000000000000000006044820152606401620011d3565b6025848154811062002942576200294262007611565b906000526020600020016040516020016200295e919062007738565b60405160208183030381529060405280519060200120816003016040516020016200298a919062007738565b6040516020818303038152906040528051906020012014620029ef5760405162461bcd60e51b815260206004820152600e60248201527f656e6f6465206d69736d617463680000000000000000000000000000000000006044820152606401620011d3565b6022848154811062002a055762002a0562007611565b906000526020600020906003020160020160405160200162002a28919062007738565b604051602081830303815290604052805190602001208160120160405160200162002a54919062007738565b604051602081830303815290604052805190602001201462002ab95760405162461bcd60e51b815260206004820152601660248201527f636f6e73656e737573206b6579206d69736d61746368000000000000000000006044820152606401620011d3565b505050808062002ac99062007640565b915050620025cb565b50602154821462002b265760405162461bcd60e51b815260206004820152601460248201527f746f74616c207374616b65206d69736d617463680000000000000000000000006044820152606401620011d3565b60005b601d5481101562001b7c576000805b855181101562002bb85785818151811062002b575762002b5762007611565b60200260200101516001600160a01b0316601d848154811062002b7e5762002b7e62007611565b6000918252602090912001546001600160a01b03160362002ba3576001915062002bb8565b8062002baf8162007640565b91505062002b38565b50600060286000601d858154811062002bd55762002bd562007611565b60009182526020808320909101546001600160a01b0316835282019290925260400181209150821515900362002c8157838160050154111562002c815760405162461bcd60e51b815260206004820152602360248201527f68696768207374616b6520666f72206e6f6e2d636f6d6d6974746565206d656d60448201527f62657200000000000000000000000000000000000000000000000000000000006064820152608401620011d3565b5050808062002c909062007640565b91505062002b29565b6018546001600160a01b0316331462002cf55760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b60195481101562002dac578060205462002d109190620071f8565b431062002dac5760405162461bcd60e51b815260206004820152605760248201527f63757272656e7420636861696e2068656164206578636565642074686520776960448201527f6e646f773a206c617374426c6f636b45706f6368202b205f6e6577506572696f60648201527f642c2074727920616761696e206c6174746572206f6e2e000000000000000000608482015260a401620011d3565b60198190556012546040517f6b5f444c000000000000000000000000000000000000000000000000000000008152600481018390526001600160a01b0390911690636b5f444c90602401600060405180830381600087803b15801562002e1157600080fd5b505af115801562002e26573d6000803e3d6000fd5b505050507fd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f818160405162002e5c91815260200190565b60405180910390a150565b6018546001600160a01b0316331462002ec35760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b600d55565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b03168152602001868152602001600d6000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020014381526020016000815260200160008152602001600081526020018481526020016000600381111562002f975762002f9762006a2b565b9052905062002fa7818362004db2565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c9262002fe892339289918b9162007746565b60405180910390a15050505050565b6001600160a01b0380831660008181526028602052604090206001015490911614620030665760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d7573742062652072656769737465726564000000006044820152606401620011d3565b6001600160a01b03828116600090815260286020526040902054163314620030f75760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e7400000000000000000000000000000000000000006064820152608401620011d3565b6127108111156200314b5760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e2072617465006044820152606401620011d3565b604080516060810182526001600160a01b038481168252436020808401918252838501868152600c80546000908152600a9093529582208551815473ffffffffffffffffffffffffffffffffffffffff1916951694909417845591516001808501919091559151600290930192909255835492939092909190620031d1908490620071f8565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6018546001600160a01b03163314620032755760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b6002805460ff19166001179055565b6018546001600160a01b03163314620032e05760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b60008111620033325760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e27742062652030000000000000006044820152606401620011d3565b601b55565b6018546001600160a01b03163314620033935760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b6001600160a01b038216600090815260276020526040902054811115620033fd5760405162461bcd60e51b815260206004820152601660248201527f416d6f756e7420657863656564732062616c616e6365000000000000000000006044820152606401620011d3565b6001600160a01b0382166000908152602760205260408120805483929062003427908490620071e2565b925050819055508060296000828254620034429190620071e2565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a390602001620019ff565b6001600160a01b0380831660008181526028602052604090206001015490911614620034f25760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620011d3565b6001600160a01b03821660009081526028602052604081206013015460ff16600381111562003525576200352562006a2b565b14620035745760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f2062652061637469766500000000006044820152606401620011d3565b6200358182823362005255565b5050565b6001600160a01b0380831660008181526028602052604090206001015490911614620035f45760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f74207265676973746572656400000000000000006044820152606401620011d3565b60008111620036465760405162461bcd60e51b815260206004820152601560248201527f756e626f6e64696e6720616d6f756e74206973203000000000000000000000006044820152606401620011d3565b6200358182823362005468565b60606025805480602002602001604051908101604052809291908181526020016000905b828210156200372d57838290600052602060002001805462003699906200715e565b80601f0160208091040260200160405190810160405280929190818152602001828054620036c7906200715e565b8015620037185780601f10620036ec5761010080835404028352916020019162003718565b820191906000526020600020905b815481529060010190602001808311620036fa57829003601f168201915b50505050508152602001906001019062003677565b50505050905090565b60006200115f3384846200466e565b60606022805480602002602001604051908101604052809291908181526020016000905b828210156200372d576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620037bf906200715e565b80601f0160208091040260200160405190810160405280929190818152602001828054620037ed906200715e565b80156200383e5780601f1062003812576101008083540402835291602001916200383e565b820191906000526020600020905b8154815290600101906020018083116200382057829003601f168201915b5050505050815250508152602001906001019062003769565b602a546060906001600160a01b03163314620038dc5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620011d3565b601d546200392d5760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f727300000000000000006044820152606401620011d3565b6200393762006839565b601b546080820152601d815260286020820152602260408201526021606082015262003963816200583c565b620039716025600062006857565b60225480620039c35760405162461bcd60e51b815260206004820152601260248201527f636f6d6d697474656520697320656d70747900000000000000000000000000006044820152606401620011d3565b60008167ffffffffffffffff811115620039e157620039e162006e2e565b60405190808252806020026020018201604052801562003a0b578160200160208202803683370190505b50905060005b8281101562003af5576000602860006022848154811062003a365762003a3662007611565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060258054600181018255925292507f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c019062003a9e9083018262007790565b50600281015483516001600160a01b039091169084908490811062003ac75762003ac762007611565b6001600160a01b0390921660209283029190910190910152508062003aec8162007640565b91505062003a11565b509250505090565b6018546001600160a01b0316331462003b595760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b62003b6660008362005857565b6200358160018262005857565b6018546001600160a01b0316331462003bcf5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b6015805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b038082166000818152602860205260409020600101549091161462003c6d5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d7573742062652072656769737465726564000000006044820152606401620011d3565b6001600160a01b0380821660009081526028602052604090208054909116331462003d015760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e7400000000000000000000000000000000006064820152608401620011d3565b6000601382015460ff16600381111562003d1f5762003d1f62006a2b565b0362003d6e5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c72656164792061637469766500000000000000006044820152606401620011d3565b6002601382015460ff16600381111562003d8c5762003d8c62006a2b565b14801562003d9d5750438160100154115b1562003dec5760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c0000000000000000006044820152606401620011d3565b6003601382015460ff16600381111562003e0a5762003e0a62006a2b565b0362003e595760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c79000000006044820152606401620011d3565b60138101805460ff1916905580546019546020546001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b59162003ea99190620071f8565b60405190815260200160405180910390a35050565b6060806000600181805462003ed3906200715e565b80601f016020809104026020016040519081016040528092919081815260200182805462003f01906200715e565b801562003f525780601f1062003f265761010080835404028352916020019162003f52565b820191906000526020600020905b81548152906001019060200180831162003f3457829003601f168201915b5050505050915080805462003f67906200715e565b80601f016020809104026020016040519081016040528092919081815260200182805462003f95906200715e565b801562003fe65780601f1062003fba5761010080835404028352916020019162003fe6565b820191906000526020600020905b81548152906001019060200180831162003fc857829003601f168201915b50505050509050915091509091565b6060601d8054806020026020016040519081016040528092919081815260200182805480156200404f57602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162004030575b5050505050905090565b6018546001600160a01b03163314620040b55760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b600e8190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200162002e5c565b6018546001600160a01b03163314620041475760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b6017805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6018546001600160a01b03163314620041d25760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b620041df60008062006877565b620041ed6001600062006877565b6002805460ff19169055565b6018546001600160a01b03163314620042555760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b6016805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6018546001600160a01b03163314620042e05760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b6014805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b602a546001600160a01b03163314620043915760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c00000000000000000000000000000000000000000000000000000000006064820152608401620011d3565b6200439b62004cc3565b6200127862003857565b6018546001600160a01b03163314620044015760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f720000000000006044820152606401620011d3565b6011805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6200172a62004cc3565b6001600160a01b038316620044b75760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401620011d3565b6001600160a01b038216620045355760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401620011d3565b6001600160a01b0383811660008181526026602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6001600160a01b038116600090815260286020526040812090601382015460ff166003811115620045cc57620045cc62006a2b565b146200461b5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d7573742062652061637469766500000000000000006044820152606401620011d3565b60138101805460ff1916600117905580546019546020546001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c9162003ea99190620071f8565b6001600160a01b038316600090815260276020526040902054811115620046d85760405162461bcd60e51b815260206004820152601660248201527f616d6f756e7420657863656564732062616c616e6365000000000000000000006044820152606401620011d3565b6001600160a01b0383166000908152602760205260408120805483929062004702908490620071e2565b90915550506001600160a01b0382166000908152602760205260408120805483929062004731908490620071f8565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef836040516200458a91815260200190565b600c54600b5410156200172a57600b546000908152600a6020526040902060105460018201544391620047b191620071f8565b1115620047bb5750565b600281015481546001600160a01b03908116600090815260286020526040808220600490810185905585548416835291819020600c015490517f19fac8fd0000000000000000000000000000000000000000000000000000000081529216926319fac8fd926200482f920190815260200190565b600060405180830381600087803b1580156200484a57600080fd5b505af11580156200485f573d6000803e3d6000fd5b5050600b80546000908152600a60205260408120805473ffffffffffffffffffffffffffffffffffffffff1916815560018082018390556002909101829055825490945091925090620048b4908490620071f8565b909155506200477e915050565b47600003620048cc57565b600d544790600090670de0b6b3a764000090620048eb9084906200765d565b620048f791906200787d565b9050801562004975576011546040516000916001600160a01b03169083908381818185875af1925050503d80600081146200494f576040519150601f19603f3d011682016040523d82523d6000602084013e62004954565b606091505b50909150508015156001036200497357620049708284620071e2565b92505b505b8160236000828254620049899190620071f8565b90915550600090505b60225481101562004cbe5760006028600060228481548110620049b957620049b962007611565b600091825260208083206003909202909101546001600160a01b0316835282019290925260400181206021546022805492945090918791908690811062004a045762004a0462007611565b90600052602060002090600302016001015462004a2291906200765d565b62004a2e91906200787d565b9050801562004ca6576002601383015460ff16600381111562004a555762004a5562006a2b565b148062004a7d57506003601383015460ff16600381111562004a7b5762004a7b62006a2b565b145b1562004b3d57601254602280546001600160a01b0390921691631de9d9b69184918790811062004ab15762004ab162007611565b600091825260209091206003909102015460405160e084901b7fffffffff000000000000000000000000000000000000000000000000000000001681526001600160a01b0390911660048201526024016000604051808303818588803b15801562004b1b57600080fd5b505af115801562004b30573d6000803e3d6000fd5b5050505050505062004ca9565b6000826005015482846008015462004b5691906200765d565b62004b6291906200787d565b9050600062004b728284620071e2565b9050811562004bd75783546040516001600160a01b03909116906108fc9084906000818181858888f193505050503d806000811462004bce576040519150601f19603f3d011682016040523d82523d6000602084013e62004bd3565b606091505b5050505b801562004c5f5783600c0160009054906101000a90046001600160a01b03166001600160a01b031663fb489a7b826040518263ffffffff1660e01b815260040160206040518083038185885af115801562004c36573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019062004c5d919062007894565b505b60018401546040518481526001600160a01b03909116907fb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe5639060200160405180910390a250505b50505b8062004cb58162007640565b91505062004992565b505050565b6004545b60055481101562004cf05762004cea8162004ce28162007640565b9250620059aa565b62004cc7565b506005546004556008546007540362004d0557565b6009545b60085481101562004d325762004d2c8162004d248162007640565b925062005bea565b62004d09565b50600854600955600754805b60085481101562004dac57601054600082815260066020526040902060040154439162004d6b91620071f8565b1162004d915762004d7c8162005f17565b62004d89600183620071f8565b915062004d97565b62004dac565b8062004da38162007640565b91505062004d3e565b50600755565b60e281511462004e055760405162461bcd60e51b815260206004820152601460248201527f496e76616c69642070726f6f66206c656e6774680000000000000000000000006044820152606401620011d3565b6030826102400151511462004e5d5760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e677468000000006044820152606401620011d3565b62004e688262006062565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a00000000000060208083019190915284519251919260009262004ede920160609190911b7fffffffffffffffffffffffffffffffffffffffff00000000000000000000000016815260140190565b604051602081830303815290604052905060008262004efe8351620061a1565b8360405160200162004f1393929190620078ae565b60408051601f198184030181528282528051602091820120600280855260608501845290945060009392909183019080368337019050509050600080808062004f6b898262004f65604160026200765d565b620062f6565b9050600062004f8a8a62004f82604160026200765d565b6060620062f6565b905060205b82518110156200505b5762004fa583826200642a565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa15801562005001573d6000803e3d6000fd5b5050604051601f1901519050876200501b6041846200787d565b815181106200502e576200502e62007611565b6001600160a01b039092166020928302919091019091015262005053604182620071f8565b905062004f8f565b508a602001516001600160a01b03168660008151811062005080576200508062007611565b60200260200101516001600160a01b031614620051065760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f6660448201527f2070726f766964656400000000000000000000000000000000000000000000006064820152608401620011d3565b8a604001516001600160a01b0316866001815181106200512a576200512a62007611565b60200260200101516001600160a01b031614620051b05760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201527f6f662070726f76696465640000000000000000000000000000000000000000006064820152608401620011d3565b6001620051c88c6102400151838e6000015162006461565b146200523d5760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e6572736869702060448201527f70726f6f6620666f7220726567697374726174696f6e000000000000000000006064820152608401620011d3565b620052488b620064d0565b5050505050505050505050565b60008211620052cd5760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201527f69766500000000000000000000000000000000000000000000000000000000006064820152608401620011d3565b6001600160a01b038116600090815260276020526040902054821115620053375760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e636500000000006044820152606401620011d3565b6001600160a01b0381166000908152602760205260408120805484929062005361908490620071e2565b9091555050604080516080810182526001600160a01b038084168252858116602080840191825283850187815243606086019081526005805460009081526003948590529788208751815490881673ffffffffffffffffffffffffffffffffffffffff19918216178255955160018201805491909816961695909517909555905160028401555191015580549192620053fa8362007640565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d91015b60405180910390a35050505050565b6001600160a01b03808416600090815260286020526040902080549091838116911614806200562257600c8201546040517f84955c880000000000000000000000000000000000000000000000000000000081526001600160a01b03858116600483015260009216906384955c8890602401602060405180830381865afa158015620054f8573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200551e919062007894565b905084811015620055985760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201527f746f6e2062616c616e63650000000000000000000000000000000000000000006064820152608401620011d3565b600c8301546040517f282d3fdf0000000000000000000000000000000000000000000000000000000081526001600160a01b038681166004830152602482018890529091169063282d3fdf90604401600060405180830381600087803b1580156200560257600080fd5b505af115801562005617573d6000803e3d6000fd5b5050505050620056cb565b8382600b01548360080154620056399190620071e2565b1015620056af5760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201527f62616c616e6365000000000000000000000000000000000000000000000000006064820152608401620011d3565b8382600b016000828254620056c59190620071f8565b90915550505b6040805160e0810182526001600160a01b0380861682528781166020808401918252838501898152600060608601818152436080880190815260a088018381528a151560c08a0190815260088054865260069097529984209851895490891673ffffffffffffffffffffffffffffffffffffffff19918216178a55965160018a0180549190991697169690961790965591516002870155905160038601559251600485015590516005909301805494511515610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff941515949094167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00009095169490941792909217909255805491620057e68362007640565b9190505550826001600160a01b0316856001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc8387604051620054599291909115158252602082015260400190565b60fa60a06000808285855af462004cbe573d6000803e3d6000fd5b8154600260018083161561010002038216048251808201602081106020841001600281146200590657600181146200592c578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b80821015620058ef5781518455600184019350602082019150620058d0565b815191036101000a908190040290915550620059a1565b60028302826020036101000a846020036101000a602089015104020185018755620059a1565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b808210156200598f578151845560018401935060208201915062005970565b815191036101000a9081900402909155505b50505050505050565b600081815260036020908152604080832060018101546001600160a01b03168452602890925282209091601382015460ff166003811115620059f057620059f062006a2b565b1462005a9057600282015482546001600160a01b03166000908152602760205260408120805490919062005a26908490620071f8565b909155505081546001830154600284015460138401546040517f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f8783429462005a83946001600160a01b0391821694911692909160ff90911690620078f7565b60405180910390a1505050565b805482546001600160a01b0390811691161462005ba6576000808260080154836005015462005ac09190620071e2565b90508060000362005ad8578360020154915062005afe565b80846002015484600d015462005aef91906200765d565b62005afb91906200787d565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b15801562005b6957600080fd5b505af115801562005b7e573d6000803e3d6000fd5b505050508183600d01600082825462005b989190620071f8565b9091555062005bc692505050565b816002015481600801600082825462005bc09190620071f8565b90915550505b816002015481600501600082825462005be09190620071f8565b9091555050505050565b600081815260066020908152604080832060018101546001600160a01b0316845260289092528220600582015491929091610100900460ff1662005e10576002830154600c83015484546040517f7eee288d0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b15801562005c9857600080fd5b505af115801562005cad573d6000803e3d6000fd5b50505050600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b15801562005d1c57600080fd5b505af115801562005d31573d6000803e3d6000fd5b5050505060008360080154846005015462005d4d9190620071e2565b600d85015490915062005d6182846200765d565b62005d6d91906200787d565b92508184600d01600082825462005d859190620071e2565b9091555050600684015460000362005da4576003850183905562005dcc565b6006840154600785015462005dba90856200765d565b62005dc691906200787d565b60038601555b8284600601600082825462005de29190620071f8565b9091555050600385015460078501805460009062005e02908490620071f8565b9091555062005ee592505050565b506002820154600882015481111562005e2a575060088101545b816009015460000362005e44576003830181905562005e6c565b6009820154600a83015462005e5a90836200765d565b62005e6691906200787d565b60038401555b8082600901600082825462005e829190620071f8565b90915550506003830154600a8301805460009062005ea2908490620071f8565b925050819055508082600801600082825462005ebf9190620071e2565b90915550506002830154600b8301805460009062005edf908490620071e2565b90915550505b6005808401805460ff191660011790558201805482919060009062005f0c908490620071e2565b909155505050505050565b6000818152600660205260408120600381015490910362005f36575050565b60018101546001600160a01b031660009081526028602052604081206005830154909190610100900460ff1662005fd25781600701548260060154846003015462005f8291906200765d565b62005f8e91906200787d565b90508082600601600082825462005fa69190620071e2565b9091555050600383015460078301805460009062005fc6908490620071e2565b90915550620060379050565b81600a01548260090154846003015462005fed91906200765d565b62005ff991906200787d565b905080826009016000828254620060119190620071e2565b90915550506003830154600a8301805460009062006031908490620071e2565b90915550505b82546001600160a01b03166000908152602760205260408120805483929062005f0c908490620071f8565b60006200607382606001516200671b565b6001600160a01b03909116602084015290508015620060d55760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f720000000000000000000000000000000000000000006044820152606401620011d3565b6020808301516001600160a01b03908116600090815260289092526040909120600101541615620061495760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c72656164792072656769737465726564000000006044820152606401620011d3565b61271082608001511115620035815760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e20726174650000000000000000006044820152606401620011d3565b606081600003620061e557505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b8115620062155780620061fc8162007640565b91506200620d9050600a836200787d565b9150620061e9565b60008167ffffffffffffffff81111562006233576200623362006e2e565b6040519080825280601f01601f1916602001820160405280156200625e576020820181803683370190505b5090505b8415620062ee5762006276600183620071e2565b915062006285600a86620076a6565b62006292906030620071f8565b60f81b818381518110620062aa57620062aa62007611565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a905350620062e6600a866200787d565b945062006262565b949350505050565b6060816200630681601f620071f8565b1015620063565760405162461bcd60e51b815260206004820152600e60248201527f736c6963655f6f766572666c6f770000000000000000000000000000000000006044820152606401620011d3565b620063628284620071f8565b84511015620063b45760405162461bcd60e51b815260206004820152601160248201527f736c6963655f6f75744f66426f756e64730000000000000000000000000000006044820152606401620011d3565b606082158015620063d5576040519150600082526020820160405262006421565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101562006410578051835260209283019201620063f6565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b8110156200645a5762006457601b8262007925565b90505b9250925092565b60006200646d620068b6565b6000858585604051602001620064869392919062007941565b6040516020818303038152906040529050600060fb9050600082516020620064af9190620071f8565b90506020848285855afa620064c357600080fd5b5050905195945050505050565b6101808101516001600160a01b03166200655357601d54600090620064f590620061a1565b9050816020015182600001518360800151836040516200651590620068d4565b620065249493929190620079a3565b604051809103906000f08015801562006541573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f909101805473ffffffffffffffffffffffffffffffffffffffff199081166001600160a01b0395861617909155845184168352602890955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620066129082620079e1565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c8201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f820155610200820151601082015561022082015160118201556102408201516012820190620066e99082620079e1565b5061026082015160138201805460ff1916600183600381111562006711576200671162006a2b565b0217905550505050565b60008062006728620068e2565b60008060ff9050604083875160208901845afa6200674557600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562006834576200683462006a2b565b905290565b6040518060a001604052806005906020820280368337509192915050565b508054600082559060005260206000209081019062001278919062006900565b50805462006885906200715e565b6000825580601f1062006896575050565b601f01602090049060005260206000209081019062001278919062006925565b60405180602001604052806001906020820280368337509192915050565b611a208062007aa683390190565b60405180604001604052806002906020820280368337509192915050565b808211156200692157600062006917828262006877565b5060010162006900565b5090565b5b8082111562006921576000815560010162006926565b60005b83811015620069595781810151838201526020016200693f565b50506000910152565b600081518084526200697c8160208601602086016200693c565b601f01601f19169290920160200192915050565b602081526000620069a5602083018462006962565b9392505050565b6001600160a01b03811681146200127857600080fd5b60008060408385031215620069d657600080fd5b8235620069e381620069ac565b946020939093013593505050565b60006020828403121562006a0457600080fd5b8135620069a581620069ac565b60006020828403121562006a2457600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6004811062006a92577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b9052565b6020815262006ab16020820183516001600160a01b03169052565b6000602083015162006ace60408401826001600160a01b03169052565b5060408301516001600160a01b038116606084015250606083015161028080608085015262006b026102a085018362006962565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a062006b8a818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f19016102608088019190915290915062006bee848362006962565b93508087015191505062006c058286018262006a5a565b5090949350505050565b60008060006060848603121562006c2557600080fd5b833562006c3281620069ac565b9250602084013562006c4481620069ac565b929592945050506040919091013590565b60006020828403121562006c6857600080fd5b813567ffffffffffffffff81111562006c8057600080fd5b82016102808185031215620069a557600080fd5b600081518084526020808501808196508360051b8101915082860160005b8581101562006d09578284038952815180516001600160a01b03168552858101518686015260409081015160609186018290529062006cf48187018362006962565b9a87019a955050509084019060010162006cb2565b5091979650505050505050565b8215158152604060208201526000620062ee604083018462006c94565b6000806040838503121562006d4757600080fd5b50508035926020909101359150565b6000610200820190508551825260208601516020830152604086015160408301526060860151606083015260808601516001600160a01b0380821660808501528087511660a08501528060208801511660c08501528060408801511660e08501528060608801511661010085015280608088015116610120850152505060a085015162006def6101408401826001600160a01b03169052565b5083516001600160a01b0316610160830152602084015161018083015260408401516101a08301526060909301516101c08201526101e0015292915050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b600082601f83011262006e6f57600080fd5b813567ffffffffffffffff8082111562006e8d5762006e8d62006e2e565b604051601f8301601f19908116603f0116810190828211818310171562006eb85762006eb862006e2e565b8160405283815286602085880101111562006ed257600080fd5b836020870160208301376000602085830101528094505050505092915050565b6000806000806080858703121562006f0957600080fd5b843567ffffffffffffffff8082111562006f2257600080fd5b62006f308883890162006e5d565b95506020870135915062006f4482620069ac565b9093506040860135908082111562006f5b57600080fd5b62006f698883890162006e5d565b9350606087013591508082111562006f8057600080fd5b5062006f8f8782880162006e5d565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b8281101562007012577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc088860301845262006fff85835162006962565b9450928501929085019060010162006fc2565b5092979650505050505050565b602081526000620069a5602083018462006c94565b6020808252825182820181905260009190848201906040850190845b81811015620070775783516001600160a01b03168352928401929184019160010162007050565b50909695505050505050565b600080604083850312156200709757600080fd5b823567ffffffffffffffff80821115620070b057600080fd5b620070be8683870162006e5d565b93506020850135915080821115620070d557600080fd5b50620070e48582860162006e5d565b9150509250929050565b60408152600062007103604083018562006962565b828103602084015262007117818562006962565b95945050505050565b600080604083850312156200713457600080fd5b82356200714181620069ac565b915060208301356200715381620069ac565b809150509250929050565b600181811c908216806200717357607f821691505b602082108103620071ad577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b81810381811115620011635762001163620071b3565b80820180821115620011635762001163620071b3565b600081356200116381620069ac565b60008083357fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe18436030181126200725357600080fd5b83018035915067ffffffffffffffff8211156200726f57600080fd5b6020019150368190038213156200728557600080fd5b9250929050565b601f82111562004cbe57600081815260208120601f850160051c81016020861015620072b55750805b601f850160051c820191505b81811015620072d657828155600101620072c1565b505050505050565b67ffffffffffffffff831115620072f957620072f962006e2e565b62007311836200730a83546200715e565b836200728c565b6000601f8411600181146200734857600085156200732f5750838201355b600019600387901b1c1916600186901b17835562001b7c565b600083815260209020601f19861690835b828110156200737b578685013582556020948501946001909201910162007359565b5086821015620073995760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b60008135600481106200116357600080fd5b60048210620073f5577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b60ff1981541660ff831681178255505050565b6200744162007417836200720e565b825473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0391909116178255565b6200748162007453602084016200720e565b600183016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b620074c162007493604084016200720e565b600283016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b620074d060608301836200721d565b620074e0818360038601620072de565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b820155620075776200754961018084016200720e565b600c83016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f82015561020082013560108201556102208201356011820155620075be6102408301836200721d565b620075ce818360128601620072de565b505062003581620075e36102608401620073ab565b60138301620073bd565b6000602082840312156200760057600080fd5b81518015158114620069a557600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b60006000198203620076565762007656620071b3565b5060010190565b8082028115828204841417620011635762001163620071b3565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b600082620076b857620076b862007677565b500690565b60008154620076cc816200715e565b60018281168015620076e75760018114620076fd576200772e565b60ff19841687528215158302870194506200772e565b8560005260208060002060005b85811015620077255781548a8201529084019082016200770a565b50505082870194505b5050505092915050565b6000620069a58284620076bd565b60006001600160a01b0380881683528087166020840152808616604084015260a060608401526200777b60a084018662006962565b91508084166080840152509695505050505050565b8181036200779c575050565b620077a882546200715e565b67ffffffffffffffff811115620077c357620077c362006e2e565b620077db81620077d484546200715e565b846200728c565b6000601f821160018114620078125760008315620077f95750848201545b600019600385901b1c1916600184901b17845562001b7c565b600085815260209020601f19841690600086815260209020845b838110156200784e57828601548255600195860195909101906020016200782c565b50858310156200786d5781850154600019600388901b60f8161c191681555b5050505050600190811b01905550565b6000826200788f576200788f62007677565b500490565b600060208284031215620078a757600080fd5b5051919050565b60008451620078c28184602089016200693c565b845190830190620078d88183602089016200693c565b8451910190620078ed8183602088016200693c565b0195945050505050565b6001600160a01b03858116825284166020820152604081018390526080810162007117606083018462006a5a565b60ff8181168382160190811115620011635762001163620071b3565b60008451620079558184602089016200693c565b8451908301906200796b8183602089016200693c565b60609490941b7fffffffffffffffffffffffffffffffffffffffff000000000000000000000000169301928352505060140192915050565b60006001600160a01b03808716835280861660208401525083604083015260806060830152620079d7608083018462006962565b9695505050505050565b815167ffffffffffffffff811115620079fe57620079fe62006e2e565b62007a0f81620077d484546200715e565b602080601f83116001811462007a47576000841562007a2e5750858301515b600019600386901b1c1916600185901b178555620072d6565b600085815260208120601f198616915b8281101562007a785788860151825594840194600190910190840162007a57565b50858210156200786d57939096015160001960f8600387901b161c19169092555050600190811b0190555056fe60806040523480156200001157600080fd5b5060405162001a2038038062001a20833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61164880620003d86000396000f3fe60806040526004361061018b5760003560e01c806359355736116100d6578063949813b81161007f578063a9059cbb11610059578063a9059cbb1461049b578063dd62ed3e146104bb578063fb489a7b1461050e57600080fd5b8063949813b81461044657806395d89b41146104665780639dc29fac1461047b57600080fd5b806370a08231116100b057806370a08231146103c35780637eee288d1461040657806384955c881461042657600080fd5b8063593557361461033d5780635ea1d6f81461038057806361d027b31461039657600080fd5b8063282d3fdf11610138578063372500ab11610112578063372500ab146102b65780633a5381b5146102cb57806340c10f191461031d57600080fd5b8063282d3fdf146102645780632f2c3f2e14610284578063313ce5671461029a57600080fd5b8063187cf4d711610169578063187cf4d71461020a57806319fac8fd1461022257806323b872dd1461024457600080fd5b806306fdde0314610190578063095ea7b3146101bb57806318160ddd146101eb575b600080fd5b34801561019c57600080fd5b506101a5610516565b6040516101b291906113af565b60405180910390f35b3480156101c757600080fd5b506101db6101d6366004611444565b6105a8565b60405190151581526020016101b2565b3480156101f757600080fd5b506004545b6040519081526020016101b2565b34801561021657600080fd5b506101fc633b9aca0081565b34801561022e57600080fd5b5061024261023d36600461146e565b6105bf565b005b34801561025057600080fd5b506101db61025f366004611487565b610656565b34801561027057600080fd5b5061024261027f366004611444565b610797565b34801561029057600080fd5b506101fc61271081565b3480156102a657600080fd5b50604051601281526020016101b2565b3480156102c257600080fd5b50610242610912565b3480156102d757600080fd5b50600a546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b60405173ffffffffffffffffffffffffffffffffffffffff90911681526020016101b2565b34801561032957600080fd5b50610242610338366004611444565b6109c9565b34801561034957600080fd5b506101fc6103583660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526002602052604090205490565b34801561038c57600080fd5b506101fc600c5481565b3480156103a257600080fd5b50600b546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b3480156103cf57600080fd5b506101fc6103de3660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561041257600080fd5b50610242610421366004611444565b610ab3565b34801561043257600080fd5b506101fc6104413660046114c3565b610c10565b34801561045257600080fd5b506101fc6104613660046114c3565b610c4b565b34801561047257600080fd5b506101a5610c86565b34801561048757600080fd5b50610242610496366004611444565b610c95565b3480156104a757600080fd5b506101db6104b6366004611444565b610d77565b3480156104c757600080fd5b506101fc6104d63660046114e5565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260036020908152604080832093909416825291909152205490565b6101fc610de3565b60606008805461052590611518565b80601f016020809104026020016040519081016040528092919081815260200182805461055190611518565b801561059e5780601f106105735761010080835404028352916020019161059e565b820191906000526020600020905b81548152906001019060200180831161058157829003601f168201915b5050505050905090565b60006105b5338484610fbb565b5060015b92915050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146106515760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e747261637400000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b600c55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600360209081526040808320338452909152812054828110156106fd5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610648565b610711853361070c868561159a565b610fbb565b61071b858461113a565b6107258484611249565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161078491815260200190565b60405180910390a3506001949350505050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146108245760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff821660009081526002602090815260408083205460019092529091205482916108619161159a565b10156108d45760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040812080548392906109099084906115ad565b90915550505050565b600061091d336112a1565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461096f576040519150601f19603f3d011682016040523d82523d6000602084013e610974565b606091505b50509050806109c55760405162461bcd60e51b815260206004820152601460248201527f4661696c656420746f2073656e642045746865720000000000000000000000006044820152606401610648565b5050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610a565760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610a608282611249565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610b405760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040902054811115610bdb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600260205260408120805483929061090990849061159a565b73ffffffffffffffffffffffffffffffffffffffff811660009081526002602090815260408083205460019092528220546105b9919061159a565b6000610c5682611320565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600560205260409020546105b991906115ad565b60606009805461052590611518565b60005473ffffffffffffffffffffffffffffffffffffffff163314610d225760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610d2c828261113a565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610aa7565b6000610d83338361113a565b610d8d8383611249565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6000805473ffffffffffffffffffffffffffffffffffffffff163314610e715760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b600c54349060009061271090610e8790846115c0565b610e9191906115d7565b905081811115610ee35760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f722072657761726400000000000000006044820152606401610648565b610eed818361159a565b600b5460405191935073ffffffffffffffffffffffffffffffffffffffff16906108fc9083906000818181858888f193505050503d8060008114610f4d576040519150601f19603f3d011682016040523d82523d6000602084013e610f52565b606091505b505060045460009150610f69633b9aca00856115c0565b610f7391906115d7565b905080600754610f8391906115ad565b600755600454600090633b9aca0090610f9c90846115c0565b610fa691906115d7565b9050610fb281846115ad565b94505050505090565b73ffffffffffffffffffffffffffffffffffffffff83166110435760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166110cc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b611143826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020908152604080832054600290925290912054611180908261159a565b8211156111cf5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610648565b6111d9828261159a565b73ffffffffffffffffffffffffffffffffffffffff841660009081526001602052604090205580820361122d5773ffffffffffffffffffffffffffffffffffffffff83166000908152600660205260408120555b816004600082825461123f919061159a565b9091555050505050565b611252826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906112889084906115ad565b92505081905550806004600082825461090991906115ad565b6000806112ad83611320565b73ffffffffffffffffffffffffffffffffffffffff84166000908152600560205260409020549091506112e19082906115ad565b73ffffffffffffffffffffffffffffffffffffffff90931660009081526005602090815260408083208690556007546006909252909120555090919050565b73ffffffffffffffffffffffffffffffffffffffff81166000908152600160205260408120548082036113565750600092915050565b73ffffffffffffffffffffffffffffffffffffffff8316600090815260066020526040812054600754611389919061159a565b90506000633b9aca0061139c84846115c0565b6113a691906115d7565b95945050505050565b600060208083528351808285015260005b818110156113dc578581018301518582016040015282016113c0565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff8116811461143f57600080fd5b919050565b6000806040838503121561145757600080fd5b6114608361141b565b946020939093013593505050565b60006020828403121561148057600080fd5b5035919050565b60008060006060848603121561149c57600080fd5b6114a58461141b565b92506114b36020850161141b565b9150604084013590509250925092565b6000602082840312156114d557600080fd5b6114de8261141b565b9392505050565b600080604083850312156114f857600080fd5b6115018361141b565b915061150f6020840161141b565b90509250929050565b600181811c9082168061152c57607f821691505b602082108103611565577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156105b9576105b961156b565b808201808211156105b9576105b961156b565b80820281158282048414176105b9576105b961156b565b60008261160d577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220257272b65cbec4bea28792495543336863e79a2fca1536e6ea5e70c11574e1ec64736f6c63430008150033a26469706673582212203714ef58639c4bbfbba0aa8a28d34d33a4e1098042a93edb9cde18657db69d4664736f6c6343000815003360806040523480156200001157600080fd5b5060405162001a2038038062001a20833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61164880620003d86000396000f3fe60806040526004361061018b5760003560e01c806359355736116100d6578063949813b81161007f578063a9059cbb11610059578063a9059cbb1461049b578063dd62ed3e146104bb578063fb489a7b1461050e57600080fd5b8063949813b81461044657806395d89b41146104665780639dc29fac1461047b57600080fd5b806370a08231116100b057806370a08231146103c35780637eee288d1461040657806384955c881461042657600080fd5b8063593557361461033d5780635ea1d6f81461038057806361d027b31461039657600080fd5b8063282d3fdf11610138578063372500ab11610112578063372500ab146102b65780633a5381b5146102cb57806340c10f191461031d57600080fd5b8063282d3fdf146102645780632f2c3f2e14610284578063313ce5671461029a57600080fd5b8063187cf4d711610169578063187cf4d71461020a57806319fac8fd1461022257806323b872dd1461024457600080fd5b806306fdde0314610190578063095ea7b3146101bb57806318160ddd146101eb575b600080fd5b34801561019c57600080fd5b506101a5610516565b6040516101b291906113af565b60405180910390f35b3480156101c757600080fd5b506101db6101d6366004611444565b6105a8565b60405190151581526020016101b2565b3480156101f757600080fd5b506004545b6040519081526020016101b2565b34801561021657600080fd5b506101fc633b9aca0081565b34801561022e57600080fd5b5061024261023d36600461146e565b6105bf565b005b34801561025057600080fd5b506101db61025f366004611487565b610656565b34801561027057600080fd5b5061024261027f366004611444565b610797565b34801561029057600080fd5b506101fc61271081565b3480156102a657600080fd5b50604051601281526020016101b2565b3480156102c257600080fd5b50610242610912565b3480156102d757600080fd5b50600a546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b60405173ffffffffffffffffffffffffffffffffffffffff90911681526020016101b2565b34801561032957600080fd5b50610242610338366004611444565b6109c9565b34801561034957600080fd5b506101fc6103583660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526002602052604090205490565b34801561038c57600080fd5b506101fc600c5481565b3480156103a257600080fd5b50600b546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b3480156103cf57600080fd5b506101fc6103de3660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561041257600080fd5b50610242610421366004611444565b610ab3565b34801561043257600080fd5b506101fc6104413660046114c3565b610c10565b34801561045257600080fd5b506101fc6104613660046114c3565b610c4b565b34801561047257600080fd5b506101a5610c86565b34801561048757600080fd5b50610242610496366004611444565b610c95565b3480156104a757600080fd5b506101db6104b6366004611444565b610d77565b3480156104c757600080fd5b506101fc6104d63660046114e5565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260036020908152604080832093909416825291909152205490565b6101fc610de3565b60606008805461052590611518565b80601f016020809104026020016040519081016040528092919081815260200182805461055190611518565b801561059e5780601f106105735761010080835404028352916020019161059e565b820191906000526020600020905b81548152906001019060200180831161058157829003601f168201915b5050505050905090565b60006105b5338484610fbb565b5060015b92915050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146106515760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e747261637400000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b600c55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600360209081526040808320338452909152812054828110156106fd5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610648565b610711853361070c868561159a565b610fbb565b61071b858461113a565b6107258484611249565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161078491815260200190565b60405180910390a3506001949350505050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146108245760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff821660009081526002602090815260408083205460019092529091205482916108619161159a565b10156108d45760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040812080548392906109099084906115ad565b90915550505050565b600061091d336112a1565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461096f576040519150601f19603f3d011682016040523d82523d6000602084013e610974565b606091505b50509050806109c55760405162461bcd60e51b815260206004820152601460248201527f4661696c656420746f2073656e642045746865720000000000000000000000006044820152606401610648565b5050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610a565760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610a608282611249565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610b405760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040902054811115610bdb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600260205260408120805483929061090990849061159a565b73ffffffffffffffffffffffffffffffffffffffff811660009081526002602090815260408083205460019092528220546105b9919061159a565b6000610c5682611320565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600560205260409020546105b991906115ad565b60606009805461052590611518565b60005473ffffffffffffffffffffffffffffffffffffffff163314610d225760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610d2c828261113a565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610aa7565b6000610d83338361113a565b610d8d8383611249565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6000805473ffffffffffffffffffffffffffffffffffffffff163314610e715760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b600c54349060009061271090610e8790846115c0565b610e9191906115d7565b905081811115610ee35760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f722072657761726400000000000000006044820152606401610648565b610eed818361159a565b600b5460405191935073ffffffffffffffffffffffffffffffffffffffff16906108fc9083906000818181858888f193505050503d8060008114610f4d576040519150601f19603f3d011682016040523d82523d6000602084013e610f52565b606091505b505060045460009150610f69633b9aca00856115c0565b610f7391906115d7565b905080600754610f8391906115ad565b600755600454600090633b9aca0090610f9c90846115c0565b610fa691906115d7565b9050610fb281846115ad565b94505050505090565b73ffffffffffffffffffffffffffffffffffffffff83166110435760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166110cc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b611143826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020908152604080832054600290925290912054611180908261159a565b8211156111cf5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610648565b6111d9828261159a565b73ffffffffffffffffffffffffffffffffffffffff841660009081526001602052604090205580820361122d5773ffffffffffffffffffffffffffffffffffffffff83166000908152600660205260408120555b816004600082825461123f919061159a565b9091555050505050565b611252826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906112889084906115ad565b92505081905550806004600082825461090991906115ad565b6000806112ad83611320565b73ffffffffffffffffffffffffffffffffffffffff84166000908152600560205260409020549091506112e19082906115ad565b73ffffffffffffffffffffffffffffffffffffffff90931660009081526005602090815260408083208690556007546006909252909120555090919050565b73ffffffffffffffffffffffffffffffffffffffff81166000908152600160205260408120548082036113565750600092915050565b73ffffffffffffffffffffffffffffffffffffffff8316600090815260066020526040812054600754611389919061159a565b90506000633b9aca0061139c84846115c0565b6113a691906115d7565b95945050505050565b600060208083528351808285015260005b818110156113dc578581018301518582016040015282016113c0565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff8116811461143f57600080fd5b919050565b6000806040838503121561145757600080fd5b6114608361141b565b946020939093013593505050565b60006020828403121561148057600080fd5b5035919050565b60008060006060848603121561149c57600080fd5b6114a58461141b565b92506114b36020850161141b565b9150604084013590509250925092565b6000602082840312156114d557600080fd5b6114de8261141b565b9392505050565b600080604083850312156114f857600080fd5b6115018361141b565b915061150f6020840161141b565b90509250929050565b600181811c9082168061152c57607f821691505b602082108103611565577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156105b9576105b961156b565b808201808211156105b9576105b961156b565b80820281158282048414176105b9576105b961156b565b60008261160d577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220257272b65cbec4bea28792495543336863e79a2fca1536e6ea5e70c11574e1ec64736f6c63430008150033")
+var AutonityTestBytecode = common.Hex2Bytes("60806040526000600b556000600c553480156200001b57600080fd5b506040516200c7593803806200c7598339810160408190526200003e9162000e6b565b8181600d600f01546000036200006c57602a80546001600160a01b031916331790556200006c828262000076565b505050506200132c565b80518051600d55602080820151600e55604080830151600f55606080840151601055608093840151601180546001600160a01b03199081166001600160a01b03938416179091558487015180516012805484169185169190911790558086015160138054841691851691909117905580850151601480548416918516919091179055808401516015805484169185169190911790559586015160168054831691841691909117905560a0909501516017805487169183169190911790558286015180516018805490971692169190911790945591830151601955820151601a5590810151601b55810151601c5560005b825181101562000427576000838281518110620001875762000187620010a8565b602002602001015160a0015190506000848381518110620001ac57620001ac620010a8565b60200260200101516101a00181815250506000848381518110620001d457620001d4620010a8565b602002602001015161018001906001600160a01b031690816001600160a01b0316815250506000848381518110620002105762000210620010a8565b602002602001015160a00181815250506000848381518110620002375762000237620010a8565b60209081029190910101516101c00152600f548451859084908110620002615762000261620010a8565b602002602001015160800181815250506000848381518110620002885762000288620010a8565b602002602001015161026001906003811115620002a957620002a9620010be565b90816003811115620002bf57620002bf620010be565b815250506000848381518110620002da57620002da620010a8565b602002602001015161016001818152505062000318848381518110620003045762000304620010a8565b60200260200101516200042c60201b60201c565b62000345848381518110620003315762000331620010a8565b60200260200101516200056760201b60201c565b80602760008685815181106200035f576200035f620010a8565b6020026020010151600001516001600160a01b03166001600160a01b0316815260200190815260200160002060008282546200039c9190620010ea565b925050819055508060296000828254620003b79190620010ea565b9250508190555062000411848381518110620003d757620003d7620010a8565b60200260200101516020015182868581518110620003f957620003f9620010a8565b6020026020010151600001516200079860201b60201c565b50806200041e8162001106565b91505062000166565b505050565b60006200044382606001516200098360201b60201c565b6001600160a01b03909116602084015290508015620004975760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b60448201526064015b60405180910390fd5b6020808301516001600160a01b039081166000908152602890925260409091206001015416156200050b5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c726561647920726567697374657265640000000060448201526064016200048e565b61271082608001511115620005635760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e207261746500000000000000000060448201526064016200048e565b5050565b6101808101516001600160a01b0316620005ea57601d546000906200058c90620009d1565b905081602001518260000151836080015183604051620005ac9062000af1565b620005bb949392919062001122565b604051809103906000f080158015620005d8573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b03958616179091558451841683526028909552604091829020865181548716908516178155935190840180548616918416919091179055840151600283018054909416911617909155606082015182919060038201906200069c908262001206565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062000766908262001206565b5061026082015160138201805460ff191660018360038111156200078e576200078e620010be565b0217905550505050565b60008211620007f65760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b60648201526084016200048e565b6001600160a01b038116600090815260276020526040902054821115620008605760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e6365000000000060448201526064016200048e565b6001600160a01b038116600090815260276020526040812080548492906200088a908490620012d2565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b0319918216178255955160018201805491909816961695909517909555905160028401555191015580549192620009168362001106565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a35050505050565b6000806200099062000aff565b60008060ff9050604083875160208901845afa620009ad57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b606081600003620009f95750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000a29578062000a108162001106565b915062000a219050600a83620012fe565b9150620009fd565b6000816001600160401b0381111562000a465762000a4662000b1d565b6040519080825280601f01601f19166020018201604052801562000a71576020820181803683370190505b5090505b841562000ae95762000a89600183620012d2565b915062000a98600a8662001315565b62000aa5906030620010ea565b60f81b81838151811062000abd5762000abd620010a8565b60200101906001600160f81b031916908160001a90535062000ae1600a86620012fe565b945062000a75565b949350505050565b611a20806200ad3983390190565b60405180604001604052806002906020820280368337509192915050565b634e487b7160e01b600052604160045260246000fd5b604051608081016001600160401b038111828210171562000b585762000b5862000b1d565b60405290565b60405160a081016001600160401b038111828210171562000b585762000b5862000b1d565b60405161028081016001600160401b038111828210171562000b585762000b5862000b1d565b604051601f8201601f191681016001600160401b038111828210171562000bd45762000bd462000b1d565b604052919050565b6001600160a01b038116811462000bf257600080fd5b50565b805162000c028162000bdc565b919050565b60005b8381101562000c2457818101518382015260200162000c0a565b50506000910152565b600082601f83011262000c3f57600080fd5b81516001600160401b0381111562000c5b5762000c5b62000b1d565b62000c70601f8201601f191660200162000ba9565b81815284602083860101111562000c8657600080fd5b62000ae982602083016020870162000c07565b80516004811062000c0257600080fd5b600060c0828403121562000cbc57600080fd5b60405160c081016001600160401b038111828210171562000ce15762000ce162000b1d565b8060405250809150825162000cf68162000bdc565b8152602083015162000d088162000bdc565b6020820152604083015162000d1d8162000bdc565b6040820152606083015162000d328162000bdc565b6060820152608083015162000d478162000bdc565b608082015260a083015162000d5c8162000bdc565b60a0919091015292915050565b60006080828403121562000d7c57600080fd5b62000d8662000b33565b9050815162000d958162000bdc565b8082525060208201516020820152604082015160408201526060820151606082015292915050565b600081830361020081121562000dd257600080fd5b62000ddc62000b33565b915060a081121562000ded57600080fd5b5062000df862000b5e565b82518152602083015160208201526040830151604082015260608301516060820152608083015162000e2a8162000bdc565b6080820152815262000e408360a0840162000ca9565b602082015262000e5583610160840162000d69565b60408201526101e0820151606082015292915050565b60008061022080848603121562000e8157600080fd5b83516001600160401b038082111562000e9957600080fd5b818601915086601f83011262000eae57600080fd5b815160208282111562000ec55762000ec562000b1d565b8160051b62000ed682820162000ba9565b928352848101820192828101908b85111562000ef157600080fd5b83870192505b84831015620010865782518681111562000f1057600080fd5b8701610280818e03601f1901121562000f2857600080fd5b62000f3262000b83565b62000f3f86830162000bf5565b815262000f4f6040830162000bf5565b8682015262000f616060830162000bf5565b604082015260808201518881111562000f7957600080fd5b62000f898f888386010162000c2d565b60608301525060a0820151608082015260c082015160a082015260e082015160c082015261010082015160e082015261012082015161010082015261014082015161012082015261016082015161014082015261018082015161016082015262000ff76101a0830162000bf5565b6101808201526101c08201516101a08201526101e08201516101c08201526102008201516101e0820152898201516102008201526102408201518a820152610260820151888111156200104957600080fd5b620010598f888386010162000c2d565b610240830152506200106f610280830162000c99565b610260820152835250918301919083019062000ef7565b8099505050506200109a89828a0162000dbd565b955050505050509250929050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b80820180821115620011005762001100620010d4565b92915050565b6000600182016200111b576200111b620010d4565b5060010190565b600060018060a01b038087168352808616602084015250836040830152608060608301528251806080840152620011618160a085016020870162000c07565b601f01601f19169190910160a00195945050505050565b600181811c908216806200118d57607f821691505b602082108103620011ae57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200042757600081815260208120601f850160051c81016020861015620011dd5750805b601f850160051c820191505b81811015620011fe57828155600101620011e9565b505050505050565b81516001600160401b0381111562001222576200122262000b1d565b6200123a8162001233845462001178565b84620011b4565b602080601f831160018114620012725760008415620012595750858301515b600019600386901b1c1916600185901b178555620011fe565b600085815260208120601f198616915b82811015620012a35788860151825594840194600190910190840162001282565b5085821015620012c25787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b81810381811115620011005762001100620010d4565b634e487b7160e01b600052601260045260246000fd5b600082620013105762001310620012e8565b500490565b600082620013275762001327620012e8565b500690565b6199fd806200133c6000396000f3fe6080604052600436106200051f5760003560e01c8063852c4849116200029f578063b7ab4db51162000165578063d5f3948811620000db578063dfb1a4d2116200008f578063e7f43c681162000075578063e7f43c6814620010f6578063f5502f221462001116578063f7866ee314620011ed57005b8063dfb1a4d214620010c7578063dfd4b68814620010de57005b8063d886f8a211620000c1578063d886f8a21462001033578063da45591b1462001058578063dd62ed3e146200107d57005b8063d5f394881462000ff9578063d861b0e8146200101b57005b8063cb696f541162000133578063cf9c57191162000119578063cf9c57191462000f97578063cfd19fb91462000faf578063d372c07e1462000fd457005b8063cb696f541462000f4d578063ceaad4551462000f7257005b8063b7ab4db51462000eee578063bb0db4281462000f06578063c2362dd51462000f1d578063c9d97af41462000f3557005b8063a515366a1162000215578063ae1f5fa011620001c9578063b3ecbadd11620001af578063b3ecbadd1462000e7c578063b46e55201462000ea1578063b66b3e791462000ec657005b8063ae1f5fa01462000e30578063b2ea9adb1462000e5757005b8063a8b2216e11620001fb578063a8b2216e1462000dbd578063a9059cbb1462000de4578063ab8f6ffe1462000e0957005b8063a515366a1462000d73578063a5d059ca1462000d9857005b806396b477cb116200026d5780639c98e47111620002535780639c98e4711462000d1f5780639dc29fac1462000d375780639efa9e7a1462000d5c57005b806396b477cb1462000cd65780639bb851c01462000d0757005b8063852c48491462000c2c578063872cf0591462000c515780638bac7dad1462000c6957806395d89b411462000c8e57005b80633d0ae21611620003e5578063676c24ab116200035b57806377e741c7116200030f578063819b646311620002f5578063819b64631462000bd0578063833b1fce1462000be757806384467fdb1462000c0757005b806377e741c71462000acd57806379502c551462000af257005b80636fd2c80b11620003415780636fd2c80b1462000a6557806370a082311462000a7c578063731b3a031462000ab657005b8063676c24ab1462000a285780636b5f444c1462000a4057005b8063496ccd9b11620003b35780634efcd15f11620003995780634efcd15f14620009ae578063520fdbbc14620009c55780635f7d394914620009ea57005b8063496ccd9b14620009615780634bb278f3146200098657005b80633d0ae21614620008f757806340c10f19146200090e578063427bc5de146200093357806343645969146200094a57005b80631904bb2e11620004955780632f2c3f2e1162000449578063313ce567116200042f578063313ce567146200088f57806335be16e014620008ad57806339b17fec14620008d257005b80632f2c3f2e146200073d57806330bcb81c146200075557005b80632701849b116200047b5780632701849b14620006e957806329070c6d14620007015780632a400bc3146200071857005b80631904bb2e146200069057806323b872dd14620006c457005b80631122063311620004ed5780631250a28d11620004d35780631250a28d146200063c5780631604e416146200066157806318160ddd146200067957005b8063112206331462000600578063114eaf55146200061757005b806306fdde031462000529578063095ea7b314620005845780630ae65e7a14620005ba5780630d8e6e2c14620005df57005b366200052757005b005b3480156200053657600080fd5b5060408051808201909152600681527f4e6577746f6e000000000000000000000000000000000000000000000000000060208201525b6040516200057b919062006e59565b60405180910390f35b3480156200059157600080fd5b50620005a9620005a336600462006e8b565b6200120d565b60405190151581526020016200057b565b348015620005c757600080fd5b5062000527620005d936600462006eba565b62001226565b348015620005ec57600080fd5b50601c545b6040519081526020016200057b565b3480156200060d57600080fd5b50600e54620005f1565b3480156200062457600080fd5b50620005276200063636600462006eda565b62001338565b3480156200064957600080fd5b50620005276200065b36600462006eba565b62001399565b3480156200066e57600080fd5b50620005f160245481565b3480156200068657600080fd5b50602954620005f1565b3480156200069d57600080fd5b50620006b5620006af36600462006eba565b62001424565b6040516200057b919062006f5f565b348015620006d157600080fd5b50620005a9620006e3366004620070d8565b62001701565b348015620006f657600080fd5b50620005276200175b565b3480156200070e57600080fd5b50600d54620005f1565b3480156200072557600080fd5b506200052762000737366004620070d8565b620017e9565b3480156200074a57600080fd5b50620005f161271081565b3480156200076257600080fd5b50620008276200077436600462006eda565b6040805160e081018252600080825260208201819052918101829052606081018290526080810182905260a0810182905260c081019190915250600090815260066020908152604091829020825160e08101845281546001600160a01b03908116825260018301541692810192909252600281015492820192909252600382015460608201526004820154608082015260059091015460ff808216151560a084015261010090910416151560c082015290565b6040516200057b9190600060e0820190506001600160a01b038084511683528060208501511660208401525060408301516040830152606083015160608301526080830151608083015260a0830151151560a083015260c0830151151560c083015292915050565b3480156200089c57600080fd5b50604051601281526020016200057b565b348015620008ba57600080fd5b5062000527620008cc3660046200711e565b6200194d565b348015620008df57600080fd5b5062000527620008f1366004620070d8565b62001b43565b3480156200090457600080fd5b50600954620005f1565b3480156200091b57600080fd5b50620005276200092d36600462006e8b565b62001c73565b3480156200094057600080fd5b50600554620005f1565b3480156200095757600080fd5b50601a54620005f1565b3480156200096e57600080fd5b50620005276200098036600462006eba565b62001d5c565b3480156200099357600080fd5b506200099e62001ed4565b6040516200057b929190620071df565b348015620009bb57600080fd5b50602154620005f1565b348015620009d257600080fd5b5062000527620009e436600462006eba565b62002354565b348015620009f757600080fd5b5062000a0f62000a09366004620071fc565b62002608565b6040516001600160a01b0390911681526020016200057b565b34801562000a3557600080fd5b506200052762002833565b34801562000a4d57600080fd5b506200052762000a5f36600462006eda565b62002fea565b34801562000a7257600080fd5b50601054620005f1565b34801562000a8957600080fd5b50620005f162000a9b36600462006eba565b6001600160a01b031660009081526027602052604090205490565b34801562000ac357600080fd5b50602054620005f1565b34801562000ada57600080fd5b506200052762000aec36600462006eda565b620031b8565b34801562000aff57600080fd5b506040805160a08082018352600d548252600e54602080840191909152600f54838501526010546060808501919091526011546001600160a01b03908116608080870191909152865160c0810188526012548316815260135483168186015260145483168189015260155483168185015260165483168183015260175483169581019590955286519081018752601854909116815260195492810192909252601a5494820194909452601b5493810193909352601c5462000bbe939084565b6040516200057b94939291906200721f565b34801562000bdd57600080fd5b50601b54620005f1565b34801562000bf457600080fd5b506013546001600160a01b031662000a0f565b34801562000c1457600080fd5b506200052762000c26366004620073bb565b62003219565b34801562000c3957600080fd5b506200052762000c4b36600462006e8b565b62003348565b34801562000c5e57600080fd5b50620005276200356a565b34801562000c7657600080fd5b506200052762000c8836600462006eda565b620035d5565b34801562000c9b57600080fd5b5060408051808201909152600381527f4e544e000000000000000000000000000000000000000000000000000000000060208201526200056c565b34801562000ce357600080fd5b50620005f162000cf536600462006eda565b6000908152601f602052604090205490565b34801562000d1457600080fd5b50620005f160235481565b34801562000d2c57600080fd5b50620005f160215481565b34801562000d4457600080fd5b506200052762000d5636600462006e8b565b62003688565b34801562000d6957600080fd5b50600854620005f1565b34801562000d8057600080fd5b506200052762000d9236600462006e8b565b620037d4565b34801562000da557600080fd5b506200052762000db736600462006e8b565b620038d6565b34801562000dca57600080fd5b5062000dd5620039a4565b6040516200057b919062007464565b34801562000df157600080fd5b50620005a962000e0336600462006e8b565b62003a87565b34801562000e1657600080fd5b5062000e2162003a96565b6040516200057b9190620074e8565b34801562000e3d57600080fd5b5062000e4862003ba8565b6040516200057b9190620074fd565b34801562000e6457600080fd5b506200052762000e763660046200754c565b62003e4e565b34801562000e8957600080fd5b506200052762000e9b36600462006eba565b62003ec4565b34801562000eae57600080fd5b506200052762000ec036600462006eba565b62003f4f565b34801562000ed357600080fd5b5062000ede6200420f565b6040516200057b929190620075b7565b34801562000efb57600080fd5b5062000e4862004346565b34801562000f1357600080fd5b50600454620005f1565b34801562000f2a57600080fd5b50620005f160205481565b34801562000f4257600080fd5b50620005f1601e5481565b34801562000f5a57600080fd5b506200052762000f6c36600462006eda565b620043aa565b34801562000f7f57600080fd5b506200052762000f9136600462006eba565b6200443c565b34801562000fa457600080fd5b5062000527620044c7565b34801562000fbc57600080fd5b506200052762000fce36600462006eba565b6200454a565b34801562000fe157600080fd5b506200052762000ff336600462006eba565b620045d5565b3480156200100657600080fd5b50602a5462000a0f906001600160a01b031681565b3480156200102857600080fd5b506200052762004660565b3480156200104057600080fd5b50620005276200105236600462006eba565b620046f6565b3480156200106557600080fd5b506200052762001077366004620075e9565b62004781565b3480156200108a57600080fd5b50620005f16200109c36600462007622565b6001600160a01b03918216600090815260266020908152604080832093909416825291909152205490565b348015620010d457600080fd5b50601954620005f1565b348015620010eb57600080fd5b5062000527620048c8565b3480156200110357600080fd5b506018546001600160a01b031662000a0f565b3480156200112357600080fd5b50620011a76200113536600462006eda565b60408051608081018252600080825260208201819052918101829052606081019190915250600090815260036020818152604092839020835160808101855281546001600160a01b03908116825260018301541692810192909252600281015493820193909352910154606082015290565b6040516200057b919060006080820190506001600160a01b0380845116835280602085015116602084015250604083015160408301526060830151606083015292915050565b348015620011fa57600080fd5b506011546001600160a01b031662000a0f565b60006200121c338484620048d2565b5060015b92915050565b6001600160a01b0380821660008181526028602052604090206001015490911614620012995760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d75737420626520726567697374657265640000000060448201526064015b60405180910390fd5b6001600160a01b038181166000908152602860205260409020541633146200132a5760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162001290565b620013358162004a2f565b50565b6018546001600160a01b03163314620013945760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b601055565b6018546001600160a01b03163314620013f55760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6012805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6200142e62006c32565b6001600160a01b03808316600081815260286020526040902060010154909116146200149d5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001290565b6001600160a01b038083166000908152602860209081526040918290208251610280810184528154851681526001820154851692810192909252600281015490931691810191909152600382018054919291606084019190620015009062007660565b80601f01602080910402602001604051908101604052809291908181526020018280546200152e9062007660565b80156200157f5780601f1062001553576101008083540402835291602001916200157f565b820191906000526020600020905b8154815290600101906020018083116200156157829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e0909201916200163b9062007660565b80601f0160208091040260200160405190810160405280929190818152602001828054620016699062007660565b8015620016ba5780601f106200168e57610100808354040283529160200191620016ba565b820191906000526020600020905b8154815290600101906020018083116200169c57829003601f168201915b5050509183525050601382015460209091019060ff166003811115620016e457620016e462006ef4565b6003811115620016f857620016f862006ef4565b90525092915050565b60006200171084848462004b06565b6001600160a01b038416600090815260266020908152604080832033845290915281205462001741908490620076e4565b905062001750853383620048d2565b506001949350505050565b602a546001600160a01b03163314620017dd5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001290565b620017e762004c16565b565b6018546001600160a01b03163314620018455760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6001600160a01b0380841660008181526028602052604090206001015490911614620018b45760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162001290565b6001600160a01b0383811660009081526028602052604090819020600c015490517f282d3fdf00000000000000000000000000000000000000000000000000000000815284831660048201526024810184905291169063282d3fdf906044015b600060405180830381600087803b1580156200192f57600080fd5b505af115801562001944573d6000803e3d6000fd5b50505050505050565b6012546001600160a01b03163314620019cf5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e747260448201527f6163740000000000000000000000000000000000000000000000000000000000606482015260840162001290565b6000610120820135602882620019ec604086016020870162006eba565b6001600160a01b03166001600160a01b031681526020019081526020016000206009015462001a1c9190620076e4565b60c08301356028600062001a37604087016020880162006eba565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001a679190620076e4565b60a08401356028600062001a82604088016020890162006eba565b6001600160a01b03166001600160a01b031681526020019081526020016000206005015462001ab29190620076e4565b62001abe9190620076fa565b62001aca9190620076fa565b6011546001600160a01b031660009081526027602052604081208054929350839290919062001afb908490620076fa565b909155508290506028600062001b18604084016020850162006eba565b6001600160a01b03168152602081019190915260400160002062001b3d82826200790a565b50505050565b6018546001600160a01b0316331462001b9f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6001600160a01b038084166000818152602860205260409020600101549091161462001c0e5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162001290565b6001600160a01b0383811660009081526028602052604090819020600c015490517f7eee288d000000000000000000000000000000000000000000000000000000008152848316600482015260248101849052911690637eee288d9060440162001914565b6018546001600160a01b0316331462001ccf5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6001600160a01b0382166000908152602760205260408120805483929062001cf9908490620076fa565b92505081905550806029600082825462001d149190620076fa565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf906020015b60405180910390a25050565b6018546001600160a01b0316331462001db85760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6013805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556014546040517f7adbf973000000000000000000000000000000000000000000000000000000008152600481019290925290911690637adbf97390602401600060405180830381600087803b15801562001e3d57600080fd5b505af115801562001e52573d6000803e3d6000fd5b50506016546040517f7adbf9730000000000000000000000000000000000000000000000000000000081526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b15801562001eb857600080fd5b505af115801562001ecd573d6000803e3d6000fd5b5050505050565b602a546000906060906001600160a01b0316331462001f5c5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001290565b601e54436000818152601f602090815260408220939093556019549254909262001f8691620076fa565b6012546040517f6c9789b0000000000000000000000000000000000000000000000000000000008152929091146004830181905292506001600160a01b031690636c9789b090602401600060405180830381600087803b15801562001fea57600080fd5b505af115801562001fff573d6000803e3d6000fd5b5050505080156200210d576200201462004d59565b6200201e6200515b565b6200202862004c16565b60006200203462003ba8565b6013546040517f845023f20000000000000000000000000000000000000000000000000000000081529192506001600160a01b03169063845023f29062002080908490600401620074fd565b600060405180830381600087803b1580156200209b57600080fd5b505af1158015620020b0573d6000803e3d6000fd5b50505050436020819055506001601e6000828254620020d09190620076fa565b9091555050601e546040519081527febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3359060200160405180910390a1505b601354604080517f4bb278f300000000000000000000000000000000000000000000000000000000815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af115801562002172573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062002198919062007aef565b905080156200222d57601454604080517fa2e6204500000000000000000000000000000000000000000000000000000000815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af192505050801562002225575060408051601f3d908101601f19168201909252620022229181019062007aef565b60015b156200222d57505b600254602280546040805160208084028201810190925282815260ff9094169391839160009084015b8282101562002344576000848152602090819020604080516060810182526003860290920180546001600160a01b0316835260018101549383019390935260028301805492939291840191620022ac9062007660565b80601f0160208091040260200160405190810160405280929190818152602001828054620022da9062007660565b80156200232b5780601f10620022ff576101008083540402835291602001916200232b565b820191906000526020600020905b8154815290600101906020018083116200230d57829003601f168201915b5050505050815250508152602001906001019062002256565b5050505090509350935050509091565b6018546001600160a01b03163314620023b05760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6018805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556013546040517fb3ab15fb00000000000000000000000000000000000000000000000000000000815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b1580156200243557600080fd5b505af11580156200244a573d6000803e3d6000fd5b50506014546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620024af57600080fd5b505af1158015620024c4573d6000803e3d6000fd5b50506015546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200252957600080fd5b505af11580156200253e573d6000803e3d6000fd5b50506016546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620025a357600080fd5b505af1158015620025b8573d6000803e3d6000fd5b50506017546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb915060240162001e9d565b600080805b6022548110156200266457602281815481106200262e576200262e62007b13565b906000526020600020906003020160010154826200264d9190620076fa565b9150806200265b8162007b42565b9150506200260d565b5080600003620026b75760405162461bcd60e51b815260206004820152601c60248201527f54686520636f6d6d6974746565206973206e6f74207374616b696e6700000000604482015260640162001290565b600083620026c760638762007b5f565b620026d39190620076fa565b9050600081604051602001620026eb91815260200190565b60408051601f1981840301815291905280516020909101209050600062002713848362007ba8565b90506000805b602254811015620027c357602281815481106200273a576200273a62007b13565b90600052602060002090600302016001015482620027599190620076fa565b915062002768600183620076e4565b8311620027ae576022818154811062002785576200278562007b13565b60009182526020909120600390910201546001600160a01b031696506200122095505050505050565b80620027ba8162007b42565b91505062002719565b5060405162461bcd60e51b815260206004820152602960248201527f5468657265206973206e6f2076616c696461746f72206c65667420696e20746860448201527f65206e6574776f726b0000000000000000000000000000000000000000000000606482015260840162001290565b6200283d6200515b565b60006200284962003ba8565b90506000815167ffffffffffffffff8111156200286a576200286a620072f7565b60405190808252806020026020018201604052801562002894578160200160208202803683370190505b50601b5460225491925060009182911015620029195760405162461bcd60e51b815260206004820152602760248201527f636f6d6d69747465652073697a652065786365656473204d6178436f6d6d697460448201527f74656553697a6500000000000000000000000000000000000000000000000000606482015260840162001290565b60005b60225481101562002e23576000602282815481106200293f576200293f62007b13565b60009182526020909120600390910201546001600160a01b0316905080620029aa5760405162461bcd60e51b815260206004820152600f60248201527f696e76616c696420616464726573730000000000000000000000000000000000604482015260640162001290565b80858381518110620029c057620029c062007b13565b60200260200101906001600160a01b031690816001600160a01b031681525050600060228381548110620029f857620029f862007b13565b90600052602060002090600302016001015490506000811162002a5e5760405162461bcd60e51b815260206004820152601460248201527f30207374616b6520696e20636f6d6d6974746565000000000000000000000000604482015260640162001290565b62002a6a8186620076fa565b9450821562002ac5578084101562002ac55760405162461bcd60e51b815260206004820152601c60248201527f636f6d6d6974746565206d656d62657273206e6f7420736f7274656400000000604482015260640162001290565b6001600160a01b038083166000818152602860205260409020600181015493965086939092161462002b3a5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220646f6573206e6f742065786973740000000000000000604482015260640162001290565b8181600501541462002b8f5760405162461bcd60e51b815260206004820152600e60248201527f7374616b65206d69736d61746368000000000000000000000000000000000000604482015260640162001290565b87848151811062002ba45762002ba462007b13565b602090810291909101015160028201546001600160a01b0390811691161462002c105760405162461bcd60e51b815260206004820152601760248201527f6f7261636c652061646472657373206d69736d61746368000000000000000000604482015260640162001290565b6000601382015460ff16600381111562002c2e5762002c2e62006ef4565b1462002c7d5760405162461bcd60e51b815260206004820152601460248201527f76616c696461746f72206e6f7420616374697665000000000000000000000000604482015260640162001290565b6025848154811062002c935762002c9362007b13565b9060005260206000200160405160200162002caf919062007c3a565b604051602081830303815290604052805190602001208160030160405160200162002cdb919062007c3a565b604051602081830303815290604052805190602001201462002d405760405162461bcd60e51b815260206004820152600e60248201527f656e6f6465206d69736d61746368000000000000000000000000000000000000604482015260640162001290565b6022848154811062002d565762002d5662007b13565b906000526020600020906003020160020160405160200162002d79919062007c3a565b604051602081830303815290604052805190602001208160120160405160200162002da5919062007c3a565b604051602081830303815290604052805190602001201462002e0a5760405162461bcd60e51b815260206004820152601660248201527f636f6e73656e737573206b6579206d69736d6174636800000000000000000000604482015260640162001290565b505050808062002e1a9062007b42565b9150506200291c565b50602154821462002e775760405162461bcd60e51b815260206004820152601460248201527f746f74616c207374616b65206d69736d61746368000000000000000000000000604482015260640162001290565b60005b601d5481101562001ecd576000805b855181101562002f095785818151811062002ea85762002ea862007b13565b60200260200101516001600160a01b0316601d848154811062002ecf5762002ecf62007b13565b6000918252602090912001546001600160a01b03160362002ef4576001915062002f09565b8062002f008162007b42565b91505062002e89565b50600060286000601d858154811062002f265762002f2662007b13565b60009182526020808320909101546001600160a01b0316835282019290925260400181209150821515900362002fd257838160050154111562002fd25760405162461bcd60e51b815260206004820152602360248201527f68696768207374616b6520666f72206e6f6e2d636f6d6d6974746565206d656d60448201527f6265720000000000000000000000000000000000000000000000000000000000606482015260840162001290565b5050808062002fe19062007b42565b91505062002e7a565b6018546001600160a01b03163314620030465760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b601954811015620030fd5780602054620030619190620076fa565b4310620030fd5760405162461bcd60e51b815260206004820152605760248201527f63757272656e7420636861696e2068656164206578636565642074686520776960448201527f6e646f773a206c617374426c6f636b45706f6368202b205f6e6577506572696f60648201527f642c2074727920616761696e206c6174746572206f6e2e000000000000000000608482015260a40162001290565b60198190556012546040517f6b5f444c000000000000000000000000000000000000000000000000000000008152600481018390526001600160a01b0390911690636b5f444c90602401600060405180830381600087803b1580156200316257600080fd5b505af115801562003177573d6000803e3d6000fd5b505050507fd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f8181604051620031ad91815260200190565b60405180910390a150565b6018546001600160a01b03163314620032145760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b600d55565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b03168152602001868152602001600d6000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200143815260200160008152602001600081526020016000815260200184815260200160006003811115620032e857620032e862006ef4565b90529050620032f881836200524a565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c926200333992339289918b9162007c48565b60405180910390a15050505050565b6001600160a01b0380831660008181526028602052604090206001015490911614620033b75760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162001290565b6001600160a01b03828116600090815260286020526040902054163314620034485760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162001290565b6127108111156200349c5760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e207261746500604482015260640162001290565b604080516060810182526001600160a01b038481168252436020808401918252838501868152600c80546000908152600a9093529582208551815473ffffffffffffffffffffffffffffffffffffffff191695169490941784559151600180850191909155915160029093019290925583549293909290919062003522908490620076fa565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6018546001600160a01b03163314620035c65760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6002805460ff19166001179055565b6018546001600160a01b03163314620036315760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b60008111620036835760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e2774206265203000000000000000604482015260640162001290565b601b55565b6018546001600160a01b03163314620036e45760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6001600160a01b0382166000908152602760205260409020548111156200374e5760405162461bcd60e51b815260206004820152601660248201527f416d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162001290565b6001600160a01b0382166000908152602760205260408120805483929062003778908490620076e4565b925050819055508060296000828254620037939190620076e4565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a39060200162001d50565b6001600160a01b0380831660008181526028602052604090206001015490911614620038435760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001290565b6001600160a01b03821660009081526028602052604081206013015460ff16600381111562003876576200387662006ef4565b14620038c55760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f206265206163746976650000000000604482015260640162001290565b620038d2828233620056ed565b5050565b6001600160a01b0380831660008181526028602052604090206001015490911614620039455760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162001290565b60008111620039975760405162461bcd60e51b815260206004820152601560248201527f756e626f6e64696e6720616d6f756e7420697320300000000000000000000000604482015260640162001290565b620038d282823362005900565b60606025805480602002602001604051908101604052809291908181526020016000905b8282101562003a7e578382906000526020600020018054620039ea9062007660565b80601f016020809104026020016040519081016040528092919081815260200182805462003a189062007660565b801562003a695780601f1062003a3d5761010080835404028352916020019162003a69565b820191906000526020600020905b81548152906001019060200180831162003a4b57829003601f168201915b505050505081526020019060010190620039c8565b50505050905090565b60006200121c33848462004b06565b60606022805480602002602001604051908101604052809291908181526020016000905b8282101562003a7e576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162003b109062007660565b80601f016020809104026020016040519081016040528092919081815260200182805462003b3e9062007660565b801562003b8f5780601f1062003b635761010080835404028352916020019162003b8f565b820191906000526020600020905b81548152906001019060200180831162003b7157829003601f168201915b5050505050815250508152602001906001019062003aba565b602a546060906001600160a01b0316331462003c2d5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001290565b601d5462003c7e5760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f72730000000000000000604482015260640162001290565b62003c8862006d02565b601b546080820152601d815260286020820152602260408201526021606082015262003cb48162005cd4565b62003cc26025600062006d20565b6022548062003d145760405162461bcd60e51b815260206004820152601260248201527f636f6d6d697474656520697320656d7074790000000000000000000000000000604482015260640162001290565b60008167ffffffffffffffff81111562003d325762003d32620072f7565b60405190808252806020026020018201604052801562003d5c578160200160208202803683370190505b50905060005b8281101562003e46576000602860006022848154811062003d875762003d8762007b13565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060258054600181018255925292507f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c019062003def9083018262007c92565b50600281015483516001600160a01b039091169084908490811062003e185762003e1862007b13565b6001600160a01b0390921660209283029190910190910152508062003e3d8162007b42565b91505062003d62565b509250505090565b6018546001600160a01b0316331462003eaa5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b62003eb760008362005cef565b620038d260018262005cef565b6018546001600160a01b0316331462003f205760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6015805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b038082166000818152602860205260409020600101549091161462003fbe5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162001290565b6001600160a01b03808216600090815260286020526040902080549091163314620040525760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162001290565b6000601382015460ff16600381111562004070576200407062006ef4565b03620040bf5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c7265616479206163746976650000000000000000604482015260640162001290565b6002601382015460ff166003811115620040dd57620040dd62006ef4565b148015620040ee5750438160100154115b156200413d5760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c000000000000000000604482015260640162001290565b6003601382015460ff1660038111156200415b576200415b62006ef4565b03620041aa5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c7900000000604482015260640162001290565b60138101805460ff1916905580546019546020546001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b591620041fa9190620076fa565b60405190815260200160405180910390a35050565b60608060006001818054620042249062007660565b80601f0160208091040260200160405190810160405280929190818152602001828054620042529062007660565b8015620042a35780601f106200427757610100808354040283529160200191620042a3565b820191906000526020600020905b8154815290600101906020018083116200428557829003601f168201915b50505050509150808054620042b89062007660565b80601f0160208091040260200160405190810160405280929190818152602001828054620042e69062007660565b8015620043375780601f106200430b5761010080835404028352916020019162004337565b820191906000526020600020905b8154815290600101906020018083116200431957829003601f168201915b50505050509050915091509091565b6060601d805480602002602001604051908101604052809291908181526020018280548015620043a057602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162004381575b5050505050905090565b6018546001600160a01b03163314620044065760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b600e8190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd38912890602001620031ad565b6018546001600160a01b03163314620044985760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6017805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6018546001600160a01b03163314620045235760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6200453060008062006d40565b6200453e6001600062006d40565b6002805460ff19169055565b6018546001600160a01b03163314620045a65760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6016805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6018546001600160a01b03163314620046315760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6014805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b602a546001600160a01b03163314620046e25760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162001290565b620046ec6200515b565b6200133562003ba8565b6018546001600160a01b03163314620047525760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162001290565b6011805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b60006200478e8262005e41565b6001600160a01b03808216600081815260286020526040902060010154929350911614620047ff5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162001290565b6001600160a01b03818116600090815260286020526040902054163314620048905760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162001290565b6040516001600160a01b038216907f68d46f7ed6d57eea4fc550f4783b3f528ef5f858f9e78b9a7728b1bef0a1b92f90600090a25050565b620017e76200515b565b6001600160a01b0383166200494f5760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f7265737300000000000000000000000000000000000000000000000000000000606482015260840162001290565b6001600160a01b038216620049cd5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f7373000000000000000000000000000000000000000000000000000000000000606482015260840162001290565b6001600160a01b0383811660008181526026602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6001600160a01b038116600090815260286020526040812090601382015460ff16600381111562004a645762004a6462006ef4565b1462004ab35760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d757374206265206163746976650000000000000000604482015260640162001290565b60138101805460ff1916600117905580546019546020546001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c91620041fa9190620076fa565b6001600160a01b03831660009081526027602052604090205481111562004b705760405162461bcd60e51b815260206004820152601660248201527f616d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162001290565b6001600160a01b0383166000908152602760205260408120805483929062004b9a908490620076e4565b90915550506001600160a01b0382166000908152602760205260408120805483929062004bc9908490620076fa565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8360405162004a2291815260200190565b600c54600b541015620017e757600b546000908152600a602052604090206010546001820154439162004c4991620076fa565b111562004c535750565b600281015481546001600160a01b03908116600090815260286020526040808220600490810185905585548416835291819020600c015490517f19fac8fd0000000000000000000000000000000000000000000000000000000081529216926319fac8fd9262004cc7920190815260200190565b600060405180830381600087803b15801562004ce257600080fd5b505af115801562004cf7573d6000803e3d6000fd5b5050600b80546000908152600a60205260408120805473ffffffffffffffffffffffffffffffffffffffff191681556001808201839055600290910182905582549094509192509062004d4c908490620076fa565b9091555062004c16915050565b4760000362004d6457565b600d544790600090670de0b6b3a76400009062004d8390849062007b5f565b62004d8f919062007d7f565b9050801562004e0d576011546040516000916001600160a01b03169083908381818185875af1925050503d806000811462004de7576040519150601f19603f3d011682016040523d82523d6000602084013e62004dec565b606091505b509091505080151560010362004e0b5762004e088284620076e4565b92505b505b816023600082825462004e219190620076fa565b90915550600090505b60225481101562005156576000602860006022848154811062004e515762004e5162007b13565b600091825260208083206003909202909101546001600160a01b0316835282019290925260400181206021546022805492945090918791908690811062004e9c5762004e9c62007b13565b90600052602060002090600302016001015462004eba919062007b5f565b62004ec6919062007d7f565b905080156200513e576002601383015460ff16600381111562004eed5762004eed62006ef4565b148062004f1557506003601383015460ff16600381111562004f135762004f1362006ef4565b145b1562004fd557601254602280546001600160a01b0390921691631de9d9b69184918790811062004f495762004f4962007b13565b600091825260209091206003909102015460405160e084901b7fffffffff000000000000000000000000000000000000000000000000000000001681526001600160a01b0390911660048201526024016000604051808303818588803b15801562004fb357600080fd5b505af115801562004fc8573d6000803e3d6000fd5b5050505050505062005141565b6000826005015482846008015462004fee919062007b5f565b62004ffa919062007d7f565b905060006200500a8284620076e4565b905081156200506f5783546040516001600160a01b03909116906108fc9084906000818181858888f193505050503d806000811462005066576040519150601f19603f3d011682016040523d82523d6000602084013e6200506b565b606091505b5050505b8015620050f75783600c0160009054906101000a90046001600160a01b03166001600160a01b031663fb489a7b826040518263ffffffff1660e01b815260040160206040518083038185885af1158015620050ce573d6000803e3d6000fd5b50505050506040513d601f19601f82011682018060405250810190620050f5919062007d96565b505b60018401546040518481526001600160a01b03909116907fb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe5639060200160405180910390a250505b50505b806200514d8162007b42565b91505062004e2a565b505050565b6004545b600554811015620051885762005182816200517a8162007b42565b925062005e73565b6200515f565b50600554600455600854600754036200519d57565b6009545b600854811015620051ca57620051c481620051bc8162007b42565b9250620060b3565b620051a1565b50600854600955600754805b600854811015620052445760105460008281526006602052604090206004015443916200520391620076fa565b1162005229576200521481620063e0565b62005221600183620076fa565b91506200522f565b62005244565b806200523b8162007b42565b915050620051d6565b50600755565b60e28151146200529d5760405162461bcd60e51b815260206004820152601460248201527f496e76616c69642070726f6f66206c656e677468000000000000000000000000604482015260640162001290565b60308261024001515114620052f55760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e67746800000000604482015260640162001290565b62005300826200652b565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a00000000000060208083019190915284519251919260009262005376920160609190911b7fffffffffffffffffffffffffffffffffffffffff00000000000000000000000016815260140190565b60405160208183030381529060405290506000826200539683516200666a565b83604051602001620053ab9392919062007db0565b60408051601f1981840301815282825280516020918201206002808552606085018452909450600093929091830190803683370190505090506000808080620054038982620053fd6041600262007b5f565b620067bf565b90506000620054228a6200541a6041600262007b5f565b6060620067bf565b905060205b8251811015620054f3576200543d8382620068f3565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa15801562005499573d6000803e3d6000fd5b5050604051601f190151905087620054b360418462007d7f565b81518110620054c657620054c662007b13565b6001600160a01b0390921660209283029190910190910152620054eb604182620076fa565b905062005427565b508a602001516001600160a01b03168660008151811062005518576200551862007b13565b60200260200101516001600160a01b0316146200559e5760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f6660448201527f2070726f76696465640000000000000000000000000000000000000000000000606482015260840162001290565b8a604001516001600160a01b031686600181518110620055c257620055c262007b13565b60200260200101516001600160a01b031614620056485760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201527f6f662070726f7669646564000000000000000000000000000000000000000000606482015260840162001290565b6001620056608c6102400151838e600001516200692a565b14620056d55760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e6572736869702060448201527f70726f6f6620666f7220726567697374726174696f6e00000000000000000000606482015260840162001290565b620056e08b62006999565b5050505050505050505050565b60008211620057655760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201527f6976650000000000000000000000000000000000000000000000000000000000606482015260840162001290565b6001600160a01b038116600090815260276020526040902054821115620057cf5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162001290565b6001600160a01b03811660009081526027602052604081208054849290620057f9908490620076e4565b9091555050604080516080810182526001600160a01b038084168252858116602080840191825283850187815243606086019081526005805460009081526003948590529788208751815490881673ffffffffffffffffffffffffffffffffffffffff19918216178255955160018201805491909816961695909517909555905160028401555191015580549192620058928362007b42565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d91015b60405180910390a35050505050565b6001600160a01b038084166000908152602860205260409020805490918381169116148062005aba57600c8201546040517f84955c880000000000000000000000000000000000000000000000000000000081526001600160a01b03858116600483015260009216906384955c8890602401602060405180830381865afa15801562005990573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620059b6919062007d96565b90508481101562005a305760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201527f746f6e2062616c616e6365000000000000000000000000000000000000000000606482015260840162001290565b600c8301546040517f282d3fdf0000000000000000000000000000000000000000000000000000000081526001600160a01b038681166004830152602482018890529091169063282d3fdf90604401600060405180830381600087803b15801562005a9a57600080fd5b505af115801562005aaf573d6000803e3d6000fd5b505050505062005b63565b8382600b0154836008015462005ad19190620076e4565b101562005b475760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201527f62616c616e636500000000000000000000000000000000000000000000000000606482015260840162001290565b8382600b01600082825462005b5d9190620076fa565b90915550505b6040805160e0810182526001600160a01b0380861682528781166020808401918252838501898152600060608601818152436080880190815260a088018381528a151560c08a0190815260088054865260069097529984209851895490891673ffffffffffffffffffffffffffffffffffffffff19918216178a55965160018a0180549190991697169690961790965591516002870155905160038601559251600485015590516005909301805494511515610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff941515949094167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000909516949094179290921790925580549162005c7e8362007b42565b9190505550826001600160a01b0316856001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc8387604051620058f19291909115158252602082015260400190565b60fa60a06000808285855af462005156573d6000803e3d6000fd5b81546002600180831615610100020382160482518082016020811060208410016002811462005d9e576001811462005dc4578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b8082101562005d87578151845560018401935060208201915062005d68565b815191036101000a90819004029091555062001944565b60028302826020036101000a846020036101000a60208901510402018501875562001944565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b8082101562005e27578151845560018401935060208201915062005e08565b815191036101000a90819004029091555050505050505050565b600062005e4d62006d7f565b600060f890506020828551602087016000855af162005e6b57600080fd5b505192915050565b600081815260036020908152604080832060018101546001600160a01b03168452602890925282209091601382015460ff16600381111562005eb95762005eb962006ef4565b1462005f5957600282015482546001600160a01b03166000908152602760205260408120805490919062005eef908490620076fa565b909155505081546001830154600284015460138401546040517f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f8783429462005f4c946001600160a01b0391821694911692909160ff9091169062007df9565b60405180910390a1505050565b805482546001600160a01b039081169116146200606f576000808260080154836005015462005f899190620076e4565b90508060000362005fa1578360020154915062005fc7565b80846002015484600d015462005fb8919062007b5f565b62005fc4919062007d7f565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b1580156200603257600080fd5b505af115801562006047573d6000803e3d6000fd5b505050508183600d016000828254620060619190620076fa565b909155506200608f92505050565b8160020154816008016000828254620060899190620076fa565b90915550505b8160020154816005016000828254620060a99190620076fa565b9091555050505050565b600081815260066020908152604080832060018101546001600160a01b0316845260289092528220600582015491929091610100900460ff16620062d9576002830154600c83015484546040517f7eee288d0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b1580156200616157600080fd5b505af115801562006176573d6000803e3d6000fd5b50505050600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b158015620061e557600080fd5b505af1158015620061fa573d6000803e3d6000fd5b50505050600083600801548460050154620062169190620076e4565b600d8501549091506200622a828462007b5f565b62006236919062007d7f565b92508184600d0160008282546200624e9190620076e4565b909155505060068401546000036200626d576003850183905562006295565b6006840154600785015462006283908562007b5f565b6200628f919062007d7f565b60038601555b82846006016000828254620062ab9190620076fa565b90915550506003850154600785018054600090620062cb908490620076fa565b90915550620063ae92505050565b5060028201546008820154811115620062f3575060088101545b81600901546000036200630d576003830181905562006335565b6009820154600a83015462006323908362007b5f565b6200632f919062007d7f565b60038401555b808260090160008282546200634b9190620076fa565b90915550506003830154600a830180546000906200636b908490620076fa565b9250508190555080826008016000828254620063889190620076e4565b90915550506002830154600b83018054600090620063a8908490620076e4565b90915550505b6005808401805460ff1916600117905582018054829190600090620063d5908490620076e4565b909155505050505050565b60008181526006602052604081206003810154909103620063ff575050565b60018101546001600160a01b031660009081526028602052604081206005830154909190610100900460ff166200649b578160070154826006015484600301546200644b919062007b5f565b62006457919062007d7f565b9050808260060160008282546200646f9190620076e4565b909155505060038301546007830180546000906200648f908490620076e4565b90915550620065009050565b81600a015482600901548460030154620064b6919062007b5f565b620064c2919062007d7f565b905080826009016000828254620064da9190620076e4565b90915550506003830154600a83018054600090620064fa908490620076e4565b90915550505b82546001600160a01b031660009081526027602052604081208054839290620063d5908490620076fa565b60006200653c826060015162006be4565b6001600160a01b039091166020840152905080156200659e5760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f72000000000000000000000000000000000000000000604482015260640162001290565b6020808301516001600160a01b03908116600090815260289092526040909120600101541615620066125760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162001290565b61271082608001511115620038d25760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162001290565b606081600003620066ae57505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b8115620066de5780620066c58162007b42565b9150620066d69050600a8362007d7f565b9150620066b2565b60008167ffffffffffffffff811115620066fc57620066fc620072f7565b6040519080825280601f01601f19166020018201604052801562006727576020820181803683370190505b5090505b8415620067b7576200673f600183620076e4565b91506200674e600a8662007ba8565b6200675b906030620076fa565b60f81b81838151811062006773576200677362007b13565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a905350620067af600a8662007d7f565b94506200672b565b949350505050565b606081620067cf81601f620076fa565b10156200681f5760405162461bcd60e51b815260206004820152600e60248201527f736c6963655f6f766572666c6f77000000000000000000000000000000000000604482015260640162001290565b6200682b8284620076fa565b845110156200687d5760405162461bcd60e51b815260206004820152601160248201527f736c6963655f6f75744f66426f756e6473000000000000000000000000000000604482015260640162001290565b6060821580156200689e5760405191506000825260208201604052620068ea565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015620068d9578051835260209283019201620068bf565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b811015620069235762006920601b8262007e27565b90505b9250925092565b60006200693662006d7f565b60008585856040516020016200694f9392919062007e43565b6040516020818303038152906040529050600060fb9050600082516020620069789190620076fa565b90506020848285855afa6200698c57600080fd5b5050905195945050505050565b6101808101516001600160a01b031662006a1c57601d54600090620069be906200666a565b905081602001518260000151836080015183604051620069de9062006d9d565b620069ed949392919062007ea5565b604051809103906000f08015801562006a0a573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f909101805473ffffffffffffffffffffffffffffffffffffffff199081166001600160a01b039586161790915584518416835260289095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062006adb908262007ee3565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c8201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062006bb2908262007ee3565b5061026082015160138201805460ff1916600183600381111562006bda5762006bda62006ef4565b0217905550505050565b60008062006bf162006dab565b60008060ff9050604083875160208901845afa62006c0e57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562006cfd5762006cfd62006ef4565b905290565b6040518060a001604052806005906020820280368337509192915050565b508054600082559060005260206000209081019062001335919062006dc9565b50805462006d4e9062007660565b6000825580601f1062006d5f575050565b601f01602090049060005260206000209081019062001335919062006dee565b60405180602001604052806001906020820280368337509192915050565b611a208062007fa883390190565b60405180604001604052806002906020820280368337509192915050565b8082111562006dea57600062006de0828262006d40565b5060010162006dc9565b5090565b5b8082111562006dea576000815560010162006def565b60005b8381101562006e2257818101518382015260200162006e08565b50506000910152565b6000815180845262006e4581602086016020860162006e05565b601f01601f19169290920160200192915050565b60208152600062006e6e602083018462006e2b565b9392505050565b6001600160a01b03811681146200133557600080fd5b6000806040838503121562006e9f57600080fd5b823562006eac8162006e75565b946020939093013593505050565b60006020828403121562006ecd57600080fd5b813562006e6e8162006e75565b60006020828403121562006eed57600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6004811062006f5b577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b9052565b6020815262006f7a6020820183516001600160a01b03169052565b6000602083015162006f9760408401826001600160a01b03169052565b5060408301516001600160a01b038116606084015250606083015161028080608085015262006fcb6102a085018362006e2b565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a062007053818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f190161026080880191909152909150620070b7848362006e2b565b935080870151915050620070ce8286018262006f23565b5090949350505050565b600080600060608486031215620070ee57600080fd5b8335620070fb8162006e75565b925060208401356200710d8162006e75565b929592945050506040919091013590565b6000602082840312156200713157600080fd5b813567ffffffffffffffff8111156200714957600080fd5b8201610280818503121562006e6e57600080fd5b600081518084526020808501808196508360051b8101915082860160005b85811015620071d2578284038952815180516001600160a01b031685528581015186860152604090810151606091860182905290620071bd8187018362006e2b565b9a87019a95505050908401906001016200717b565b5091979650505050505050565b8215158152604060208201526000620067b760408301846200715d565b600080604083850312156200721057600080fd5b50508035926020909101359150565b6000610200820190508551825260208601516020830152604086015160408301526060860151606083015260808601516001600160a01b0380821660808501528087511660a08501528060208801511660c08501528060408801511660e08501528060608801511661010085015280608088015116610120850152505060a0850151620072b86101408401826001600160a01b03169052565b5083516001600160a01b0316610160830152602084015161018083015260408401516101a08301526060909301516101c08201526101e0015292915050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b600082601f8301126200733857600080fd5b813567ffffffffffffffff80821115620073565762007356620072f7565b604051601f8301601f19908116603f01168101908282118183101715620073815762007381620072f7565b816040528381528660208588010111156200739b57600080fd5b836020870160208301376000602085830101528094505050505092915050565b60008060008060808587031215620073d257600080fd5b843567ffffffffffffffff80821115620073eb57600080fd5b620073f98883890162007326565b9550602087013591506200740d8262006e75565b909350604086013590808211156200742457600080fd5b620074328883890162007326565b935060608701359150808211156200744957600080fd5b50620074588782880162007326565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b82811015620074db577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc0888603018452620074c885835162006e2b565b945092850192908501906001016200748b565b5092979650505050505050565b60208152600062006e6e60208301846200715d565b6020808252825182820181905260009190848201906040850190845b81811015620075405783516001600160a01b03168352928401929184019160010162007519565b50909695505050505050565b600080604083850312156200756057600080fd5b823567ffffffffffffffff808211156200757957600080fd5b620075878683870162007326565b935060208501359150808211156200759e57600080fd5b50620075ad8582860162007326565b9150509250929050565b604081526000620075cc604083018562006e2b565b8281036020840152620075e0818562006e2b565b95945050505050565b600060208284031215620075fc57600080fd5b813567ffffffffffffffff8111156200761457600080fd5b620067b78482850162007326565b600080604083850312156200763657600080fd5b8235620076438162006e75565b91506020830135620076558162006e75565b809150509250929050565b600181811c908216806200767557607f821691505b602082108103620076af577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b81810381811115620012205762001220620076b5565b80820180821115620012205762001220620076b5565b60008135620012208162006e75565b60008083357fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe18436030181126200775557600080fd5b83018035915067ffffffffffffffff8211156200777157600080fd5b6020019150368190038213156200778757600080fd5b9250929050565b601f8211156200515657600081815260208120601f850160051c81016020861015620077b75750805b601f850160051c820191505b81811015620077d857828155600101620077c3565b505050505050565b67ffffffffffffffff831115620077fb57620077fb620072f7565b62007813836200780c835462007660565b836200778e565b6000601f8411600181146200784a5760008515620078315750838201355b600019600387901b1c1916600186901b17835562001ecd565b600083815260209020601f19861690835b828110156200787d57868501358255602094850194600190920191016200785b565b50868210156200789b5760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b60008135600481106200122057600080fd5b60048210620078f7577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b60ff1981541660ff831681178255505050565b62007943620079198362007710565b825473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0391909116178255565b62007983620079556020840162007710565b600183016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b620079c3620079956040840162007710565b600283016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b620079d260608301836200771f565b620079e2818360038601620077e0565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b82015562007a7962007a4b610180840162007710565b600c83016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f8201556102008201356010820155610220820135601182015562007ac06102408301836200771f565b62007ad0818360128601620077e0565b5050620038d262007ae56102608401620078ad565b60138301620078bf565b60006020828403121562007b0257600080fd5b8151801515811462006e6e57600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b6000600019820362007b585762007b58620076b5565b5060010190565b8082028115828204841417620012205762001220620076b5565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b60008262007bba5762007bba62007b79565b500690565b6000815462007bce8162007660565b6001828116801562007be9576001811462007bff5762007c30565b60ff198416875282151583028701945062007c30565b8560005260208060002060005b8581101562007c275781548a82015290840190820162007c0c565b50505082870194505b5050505092915050565b600062006e6e828462007bbf565b60006001600160a01b0380881683528087166020840152808616604084015260a0606084015262007c7d60a084018662006e2b565b91508084166080840152509695505050505050565b81810362007c9e575050565b62007caa825462007660565b67ffffffffffffffff81111562007cc55762007cc5620072f7565b62007cdd8162007cd6845462007660565b846200778e565b6000601f82116001811462007d14576000831562007cfb5750848201545b600019600385901b1c1916600184901b17845562001ecd565b600085815260209020601f19841690600086815260209020845b8381101562007d50578286015482556001958601959091019060200162007d2e565b508583101562007d6f5781850154600019600388901b60f8161c191681555b5050505050600190811b01905550565b60008262007d915762007d9162007b79565b500490565b60006020828403121562007da957600080fd5b5051919050565b6000845162007dc481846020890162006e05565b84519083019062007dda81836020890162006e05565b845191019062007def81836020880162006e05565b0195945050505050565b6001600160a01b038581168252841660208201526040810183905260808101620075e0606083018462006f23565b60ff8181168382160190811115620012205762001220620076b5565b6000845162007e5781846020890162006e05565b84519083019062007e6d81836020890162006e05565b60609490941b7fffffffffffffffffffffffffffffffffffffffff000000000000000000000000169301928352505060140192915050565b60006001600160a01b0380871683528086166020840152508360408301526080606083015262007ed9608083018462006e2b565b9695505050505050565b815167ffffffffffffffff81111562007f005762007f00620072f7565b62007f118162007cd6845462007660565b602080601f83116001811462007f49576000841562007f305750858301515b600019600386901b1c1916600185901b178555620077d8565b600085815260208120601f198616915b8281101562007f7a5788860151825594840194600190910190840162007f59565b508582101562007d6f57939096015160001960f8600387901b161c19169092555050600190811b0190555056fe60806040523480156200001157600080fd5b5060405162001a2038038062001a20833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61164880620003d86000396000f3fe60806040526004361061018b5760003560e01c806359355736116100d6578063949813b81161007f578063a9059cbb11610059578063a9059cbb1461049b578063dd62ed3e146104bb578063fb489a7b1461050e57600080fd5b8063949813b81461044657806395d89b41146104665780639dc29fac1461047b57600080fd5b806370a08231116100b057806370a08231146103c35780637eee288d1461040657806384955c881461042657600080fd5b8063593557361461033d5780635ea1d6f81461038057806361d027b31461039657600080fd5b8063282d3fdf11610138578063372500ab11610112578063372500ab146102b65780633a5381b5146102cb57806340c10f191461031d57600080fd5b8063282d3fdf146102645780632f2c3f2e14610284578063313ce5671461029a57600080fd5b8063187cf4d711610169578063187cf4d71461020a57806319fac8fd1461022257806323b872dd1461024457600080fd5b806306fdde0314610190578063095ea7b3146101bb57806318160ddd146101eb575b600080fd5b34801561019c57600080fd5b506101a5610516565b6040516101b291906113af565b60405180910390f35b3480156101c757600080fd5b506101db6101d6366004611444565b6105a8565b60405190151581526020016101b2565b3480156101f757600080fd5b506004545b6040519081526020016101b2565b34801561021657600080fd5b506101fc633b9aca0081565b34801561022e57600080fd5b5061024261023d36600461146e565b6105bf565b005b34801561025057600080fd5b506101db61025f366004611487565b610656565b34801561027057600080fd5b5061024261027f366004611444565b610797565b34801561029057600080fd5b506101fc61271081565b3480156102a657600080fd5b50604051601281526020016101b2565b3480156102c257600080fd5b50610242610912565b3480156102d757600080fd5b50600a546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b60405173ffffffffffffffffffffffffffffffffffffffff90911681526020016101b2565b34801561032957600080fd5b50610242610338366004611444565b6109c9565b34801561034957600080fd5b506101fc6103583660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526002602052604090205490565b34801561038c57600080fd5b506101fc600c5481565b3480156103a257600080fd5b50600b546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b3480156103cf57600080fd5b506101fc6103de3660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561041257600080fd5b50610242610421366004611444565b610ab3565b34801561043257600080fd5b506101fc6104413660046114c3565b610c10565b34801561045257600080fd5b506101fc6104613660046114c3565b610c4b565b34801561047257600080fd5b506101a5610c86565b34801561048757600080fd5b50610242610496366004611444565b610c95565b3480156104a757600080fd5b506101db6104b6366004611444565b610d77565b3480156104c757600080fd5b506101fc6104d63660046114e5565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260036020908152604080832093909416825291909152205490565b6101fc610de3565b60606008805461052590611518565b80601f016020809104026020016040519081016040528092919081815260200182805461055190611518565b801561059e5780601f106105735761010080835404028352916020019161059e565b820191906000526020600020905b81548152906001019060200180831161058157829003601f168201915b5050505050905090565b60006105b5338484610fbb565b5060015b92915050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146106515760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e747261637400000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b600c55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600360209081526040808320338452909152812054828110156106fd5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610648565b610711853361070c868561159a565b610fbb565b61071b858461113a565b6107258484611249565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161078491815260200190565b60405180910390a3506001949350505050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146108245760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff821660009081526002602090815260408083205460019092529091205482916108619161159a565b10156108d45760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040812080548392906109099084906115ad565b90915550505050565b600061091d336112a1565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461096f576040519150601f19603f3d011682016040523d82523d6000602084013e610974565b606091505b50509050806109c55760405162461bcd60e51b815260206004820152601460248201527f4661696c656420746f2073656e642045746865720000000000000000000000006044820152606401610648565b5050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610a565760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610a608282611249565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610b405760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040902054811115610bdb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600260205260408120805483929061090990849061159a565b73ffffffffffffffffffffffffffffffffffffffff811660009081526002602090815260408083205460019092528220546105b9919061159a565b6000610c5682611320565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600560205260409020546105b991906115ad565b60606009805461052590611518565b60005473ffffffffffffffffffffffffffffffffffffffff163314610d225760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610d2c828261113a565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610aa7565b6000610d83338361113a565b610d8d8383611249565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6000805473ffffffffffffffffffffffffffffffffffffffff163314610e715760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b600c54349060009061271090610e8790846115c0565b610e9191906115d7565b905081811115610ee35760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f722072657761726400000000000000006044820152606401610648565b610eed818361159a565b600b5460405191935073ffffffffffffffffffffffffffffffffffffffff16906108fc9083906000818181858888f193505050503d8060008114610f4d576040519150601f19603f3d011682016040523d82523d6000602084013e610f52565b606091505b505060045460009150610f69633b9aca00856115c0565b610f7391906115d7565b905080600754610f8391906115ad565b600755600454600090633b9aca0090610f9c90846115c0565b610fa691906115d7565b9050610fb281846115ad565b94505050505090565b73ffffffffffffffffffffffffffffffffffffffff83166110435760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166110cc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b611143826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020908152604080832054600290925290912054611180908261159a565b8211156111cf5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610648565b6111d9828261159a565b73ffffffffffffffffffffffffffffffffffffffff841660009081526001602052604090205580820361122d5773ffffffffffffffffffffffffffffffffffffffff83166000908152600660205260408120555b816004600082825461123f919061159a565b9091555050505050565b611252826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906112889084906115ad565b92505081905550806004600082825461090991906115ad565b6000806112ad83611320565b73ffffffffffffffffffffffffffffffffffffffff84166000908152600560205260409020549091506112e19082906115ad565b73ffffffffffffffffffffffffffffffffffffffff90931660009081526005602090815260408083208690556007546006909252909120555090919050565b73ffffffffffffffffffffffffffffffffffffffff81166000908152600160205260408120548082036113565750600092915050565b73ffffffffffffffffffffffffffffffffffffffff8316600090815260066020526040812054600754611389919061159a565b90506000633b9aca0061139c84846115c0565b6113a691906115d7565b95945050505050565b600060208083528351808285015260005b818110156113dc578581018301518582016040015282016113c0565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff8116811461143f57600080fd5b919050565b6000806040838503121561145757600080fd5b6114608361141b565b946020939093013593505050565b60006020828403121561148057600080fd5b5035919050565b60008060006060848603121561149c57600080fd5b6114a58461141b565b92506114b36020850161141b565b9150604084013590509250925092565b6000602082840312156114d557600080fd5b6114de8261141b565b9392505050565b600080604083850312156114f857600080fd5b6115018361141b565b915061150f6020840161141b565b90509250929050565b600181811c9082168061152c57607f821691505b602082108103611565577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156105b9576105b961156b565b808201808211156105b9576105b961156b565b80820281158282048414176105b9576105b961156b565b60008261160d577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220ba014708b1b5df37f7d92aba1040b129bc35bb10417560ed1d7e4efd39583c0164736f6c63430008150033a264697066735822122088c043e592135068b78cb3742948e803077d92010ef92114df39fdc443587e5a64736f6c6343000815003360806040523480156200001157600080fd5b5060405162001a2038038062001a20833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61164880620003d86000396000f3fe60806040526004361061018b5760003560e01c806359355736116100d6578063949813b81161007f578063a9059cbb11610059578063a9059cbb1461049b578063dd62ed3e146104bb578063fb489a7b1461050e57600080fd5b8063949813b81461044657806395d89b41146104665780639dc29fac1461047b57600080fd5b806370a08231116100b057806370a08231146103c35780637eee288d1461040657806384955c881461042657600080fd5b8063593557361461033d5780635ea1d6f81461038057806361d027b31461039657600080fd5b8063282d3fdf11610138578063372500ab11610112578063372500ab146102b65780633a5381b5146102cb57806340c10f191461031d57600080fd5b8063282d3fdf146102645780632f2c3f2e14610284578063313ce5671461029a57600080fd5b8063187cf4d711610169578063187cf4d71461020a57806319fac8fd1461022257806323b872dd1461024457600080fd5b806306fdde0314610190578063095ea7b3146101bb57806318160ddd146101eb575b600080fd5b34801561019c57600080fd5b506101a5610516565b6040516101b291906113af565b60405180910390f35b3480156101c757600080fd5b506101db6101d6366004611444565b6105a8565b60405190151581526020016101b2565b3480156101f757600080fd5b506004545b6040519081526020016101b2565b34801561021657600080fd5b506101fc633b9aca0081565b34801561022e57600080fd5b5061024261023d36600461146e565b6105bf565b005b34801561025057600080fd5b506101db61025f366004611487565b610656565b34801561027057600080fd5b5061024261027f366004611444565b610797565b34801561029057600080fd5b506101fc61271081565b3480156102a657600080fd5b50604051601281526020016101b2565b3480156102c257600080fd5b50610242610912565b3480156102d757600080fd5b50600a546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b60405173ffffffffffffffffffffffffffffffffffffffff90911681526020016101b2565b34801561032957600080fd5b50610242610338366004611444565b6109c9565b34801561034957600080fd5b506101fc6103583660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526002602052604090205490565b34801561038c57600080fd5b506101fc600c5481565b3480156103a257600080fd5b50600b546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b3480156103cf57600080fd5b506101fc6103de3660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561041257600080fd5b50610242610421366004611444565b610ab3565b34801561043257600080fd5b506101fc6104413660046114c3565b610c10565b34801561045257600080fd5b506101fc6104613660046114c3565b610c4b565b34801561047257600080fd5b506101a5610c86565b34801561048757600080fd5b50610242610496366004611444565b610c95565b3480156104a757600080fd5b506101db6104b6366004611444565b610d77565b3480156104c757600080fd5b506101fc6104d63660046114e5565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260036020908152604080832093909416825291909152205490565b6101fc610de3565b60606008805461052590611518565b80601f016020809104026020016040519081016040528092919081815260200182805461055190611518565b801561059e5780601f106105735761010080835404028352916020019161059e565b820191906000526020600020905b81548152906001019060200180831161058157829003601f168201915b5050505050905090565b60006105b5338484610fbb565b5060015b92915050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146106515760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e747261637400000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b600c55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600360209081526040808320338452909152812054828110156106fd5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610648565b610711853361070c868561159a565b610fbb565b61071b858461113a565b6107258484611249565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161078491815260200190565b60405180910390a3506001949350505050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146108245760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff821660009081526002602090815260408083205460019092529091205482916108619161159a565b10156108d45760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040812080548392906109099084906115ad565b90915550505050565b600061091d336112a1565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461096f576040519150601f19603f3d011682016040523d82523d6000602084013e610974565b606091505b50509050806109c55760405162461bcd60e51b815260206004820152601460248201527f4661696c656420746f2073656e642045746865720000000000000000000000006044820152606401610648565b5050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610a565760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610a608282611249565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610b405760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040902054811115610bdb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600260205260408120805483929061090990849061159a565b73ffffffffffffffffffffffffffffffffffffffff811660009081526002602090815260408083205460019092528220546105b9919061159a565b6000610c5682611320565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600560205260409020546105b991906115ad565b60606009805461052590611518565b60005473ffffffffffffffffffffffffffffffffffffffff163314610d225760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610d2c828261113a565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610aa7565b6000610d83338361113a565b610d8d8383611249565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6000805473ffffffffffffffffffffffffffffffffffffffff163314610e715760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b600c54349060009061271090610e8790846115c0565b610e9191906115d7565b905081811115610ee35760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f722072657761726400000000000000006044820152606401610648565b610eed818361159a565b600b5460405191935073ffffffffffffffffffffffffffffffffffffffff16906108fc9083906000818181858888f193505050503d8060008114610f4d576040519150601f19603f3d011682016040523d82523d6000602084013e610f52565b606091505b505060045460009150610f69633b9aca00856115c0565b610f7391906115d7565b905080600754610f8391906115ad565b600755600454600090633b9aca0090610f9c90846115c0565b610fa691906115d7565b9050610fb281846115ad565b94505050505090565b73ffffffffffffffffffffffffffffffffffffffff83166110435760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166110cc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b611143826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020908152604080832054600290925290912054611180908261159a565b8211156111cf5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610648565b6111d9828261159a565b73ffffffffffffffffffffffffffffffffffffffff841660009081526001602052604090205580820361122d5773ffffffffffffffffffffffffffffffffffffffff83166000908152600660205260408120555b816004600082825461123f919061159a565b9091555050505050565b611252826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906112889084906115ad565b92505081905550806004600082825461090991906115ad565b6000806112ad83611320565b73ffffffffffffffffffffffffffffffffffffffff84166000908152600560205260409020549091506112e19082906115ad565b73ffffffffffffffffffffffffffffffffffffffff90931660009081526005602090815260408083208690556007546006909252909120555090919050565b73ffffffffffffffffffffffffffffffffffffffff81166000908152600160205260408120548082036113565750600092915050565b73ffffffffffffffffffffffffffffffffffffffff8316600090815260066020526040812054600754611389919061159a565b90506000633b9aca0061139c84846115c0565b6113a691906115d7565b95945050505050565b600060208083528351808285015260005b818110156113dc578581018301518582016040015282016113c0565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff8116811461143f57600080fd5b919050565b6000806040838503121561145757600080fd5b6114608361141b565b946020939093013593505050565b60006020828403121561148057600080fd5b5035919050565b60008060006060848603121561149c57600080fd5b6114a58461141b565b92506114b36020850161141b565b9150604084013590509250925092565b6000602082840312156114d557600080fd5b6114de8261141b565b9392505050565b600080604083850312156114f857600080fd5b6115018361141b565b915061150f6020840161141b565b90509250929050565b600181811c9082168061152c57607f821691505b602082108103611565577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156105b9576105b961156b565b808201808211156105b9576105b961156b565b80820281158282048414176105b9576105b961156b565b60008261160d577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220ba014708b1b5df37f7d92aba1040b129bc35bb10417560ed1d7e4efd39583c0164736f6c63430008150033")
 
 var AutonityTestAbi, _ = abi.JSON(strings.NewReader(`[
    {
@@ -347,6 +347,19 @@ var AutonityTestAbi, _ = abi.JSON(strings.NewReader(`[
       "name" : "CommissionRateChange",
       "type" : "event"
    },
+   {
+      "anonymous" : false,
+      "inputs" : [
+         {
+            "indexed" : true,
+            "internalType" : "address",
+            "name" : "addr",
+            "type" : "address"
+         }
+      ],
+      "name" : "ConsensusEndpointsUpdated",
+      "type" : "event"
+   },
    {
       "anonymous" : false,
       "inputs" : [
@@ -1530,6 +1543,29 @@ var AutonityTestAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "view",
       "type" : "function"
    },
+   {
+      "inputs" : [
+         {
+            "internalType" : "address",
+            "name" : "_validator",
+            "type" : "address"
+         },
+         {
+            "internalType" : "address",
+            "name" : "_delegator",
+            "type" : "address"
+         },
+         {
+            "internalType" : "uint256",
+            "name" : "_amount",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "lockLiquid",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
@@ -1895,6 +1931,42 @@ var AutonityTestAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "nonpayable",
       "type" : "function"
    },
+   {
+      "inputs" : [
+         {
+            "internalType" : "address",
+            "name" : "_validator",
+            "type" : "address"
+         },
+         {
+            "internalType" : "address",
+            "name" : "_delegator",
+            "type" : "address"
+         },
+         {
+            "internalType" : "uint256",
+            "name" : "_amount",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "unlockLiquid",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "internalType" : "bytes",
+            "name" : "_record",
+            "type" : "bytes"
+         }
+      ],
+      "name" : "updateConsensusEndpoints",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
@@ -4,7 +4,7 @@ import "strings"
 import "github.com/autonity/autonity/accounts/abi"
 import "github.com/autonity/autonity/common"
 
-var AutonityUpgradeTestBytecode = common.Hex2Bytes("60806040526000600b556000600c553480156200001b57600080fd5b50604080516000808252602082019092529062000051565b6200003d62000d4e565b815260200190600190039081620000335790505b506040805161012081018252600d546080808301918252600e5460a080850191909152600f5460c08086019190915260105460e08601526011546001600160a01b039081166101008701529385528551908101865260125484168152601354841660208281019190915260145485168288015260155485166060808401919091526016548616838601526017548616938301939093528086019190915285519283018652601854909316825260195492820192909252601a5481850152601b548183015292820192909252601c54918101829052906000036200014c57602a80546001600160a01b031916331790556200014c828262000169565b5050601c546001036200016357620001636200051f565b6200115e565b80518051600d55602080820151600e55604080830151600f55606080840151601055608093840151601180546001600160a01b03199081166001600160a01b03938416179091558487015180516012805484169185169190911790558086015160138054841691851691909117905580850151601480548416918516919091179055808401516015805484169185169190911790559586015160168054831691841691909117905560a0909501516017805487169183169190911790558286015180516018805490971692169190911790945591830151601955820151601a5590810151601b55810151601c5560005b82518110156200051a5760008382815181106200027a576200027a62000ebd565b602002602001015160a00151905060008483815181106200029f576200029f62000ebd565b60200260200101516101a00181815250506000848381518110620002c757620002c762000ebd565b602002602001015161018001906001600160a01b031690816001600160a01b031681525050600084838151811062000303576200030362000ebd565b602002602001015160a001818152505060008483815181106200032a576200032a62000ebd565b60209081029190910101516101c00152600f54845185908490811062000354576200035462000ebd565b6020026020010151608001818152505060008483815181106200037b576200037b62000ebd565b6020026020010151610260019060038111156200039c576200039c62000ed3565b90816003811115620003b257620003b262000ed3565b815250506000848381518110620003cd57620003cd62000ebd565b60200260200101516101600181815250506200040b848381518110620003f757620003f762000ebd565b60200260200101516200068d60201b60201c565b6200043884838151811062000424576200042462000ebd565b6020026020010151620007c460201b60201c565b806027600086858151811062000452576200045262000ebd565b6020026020010151600001516001600160a01b03166001600160a01b0316815260200190815260200160002060008282546200048f919062000eff565b925050819055508060296000828254620004aa919062000eff565b9250508190555062000504848381518110620004ca57620004ca62000ebd565b60200260200101516020015182868581518110620004ec57620004ec62000ebd565b602002602001015160000151620009f560201b60201c565b5080620005118162000f1b565b91505062000259565b505050565b602a546001600160a01b031633146200058b5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201526218dbdb60ea1b60648201526084015b60405180910390fd5b600260286000601d600181548110620005a857620005a862000ebd565b60009182526020808320909101546001600160a01b0316835282019290925260400181206005018054909190620005e190849062000f4d565b92505081905550600260286000601d60018154811062000605576200060562000ebd565b60009182526020808320909101546001600160a01b03168352820192909252604001812060080180549091906200063e90849062000f4d565b90915550506002601c556018546001600160a01b031660009081526027602052604081206103e8905562000673908062000e1e565b620006816001600062000e1e565b6002805460ff19169055565b6000620006a4826060015162000be060201b60201c565b6001600160a01b03909116602084015290508015620006f45760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b604482015260640162000582565b6020808301516001600160a01b03908116600090815260289092526040909120600101541615620007685760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000582565b61271082608001511115620007c05760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000582565b5050565b6101808101516001600160a01b03166200084757601d54600090620007e99062000c2e565b905081602001518260000151836080015183604051620008099062000e60565b62000818949392919062000f64565b604051809103906000f08015801562000835573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352602890955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620008f9908262001065565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f820155610200820151601082015561022082015160118201556102408201516012820190620009c3908262001065565b5061026082015160138201805460ff19166001836003811115620009eb57620009eb62000ed3565b0217905550505050565b6000821162000a535760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000582565b6001600160a01b03811660009081526027602052604090205482111562000abd5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000582565b6001600160a01b0381166000908152602760205260408120805484929062000ae790849062001131565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b031991821617825595516001820180549190981696169590951790955590516002840155519101558054919262000b738362000f1b565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a35050505050565b60008062000bed62000e6e565b60008060ff9050604083875160208901845afa62000c0a57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000c565750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000c86578062000c6d8162000f1b565b915062000c7e9050600a8362000f4d565b915062000c5a565b6000816001600160401b0381111562000ca35762000ca362000ea7565b6040519080825280601f01601f19166020018201604052801562000cce576020820181803683370190505b5090505b841562000d465762000ce660018362001131565b915062000cf5600a8662001147565b62000d0290603062000eff565b60f81b81838151811062000d1a5762000d1a62000ebd565b60200101906001600160f81b031916908160001a90535062000d3e600a8662000f4d565b945062000cd2565b949350505050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562000e195762000e1962000ed3565b905290565b50805462000e2c9062000fd7565b6000825580601f1062000e3d575050565b601f01602090049060005260206000209081019062000e5d919062000e8c565b50565b611a2080620099fa83390190565b60405180604001604052806002906020820280368337509192915050565b5b8082111562000ea3576000815560010162000e8d565b5090565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b8082018082111562000f155762000f1562000ee9565b92915050565b60006001820162000f305762000f3062000ee9565b5060010190565b634e487b7160e01b600052601260045260246000fd5b60008262000f5f5762000f5f62000f37565b500490565b600060018060a01b038087168352602081871681850152856040850152608060608501528451915081608085015260005b8281101562000fb35785810182015185820160a00152810162000f95565b5050600060a0828501015260a0601f19601f83011684010191505095945050505050565b600181811c9082168062000fec57607f821691505b6020821081036200100d57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200051a57600081815260208120601f850160051c810160208610156200103c5750805b601f850160051c820191505b818110156200105d5782815560010162001048565b505050505050565b81516001600160401b0381111562001081576200108162000ea7565b620010998162001092845462000fd7565b8462001013565b602080601f831160018114620010d15760008415620010b85750858301515b600019600386901b1c1916600185901b1785556200105d565b600085815260208120601f198616915b828110156200110257888601518255948401946001909101908401620010e1565b5085821015620011215787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b8181038181111562000f155762000f1562000ee9565b60008262001159576200115962000f37565b500690565b61888c806200116e6000396000f3fe608060405260043610620003db5760003560e01c8063872cf0591162000203578063b66b3e791162000117578063d372c07e11620000a7578063dd62ed3e1162000075578063dd62ed3e1462000ced578063dfb1a4d21462000d37578063e7f43c681462000d4e578063f7866ee31462000d6e57005b8063d372c07e1462000c69578063d5f394881462000c8e578063d861b0e81462000cb0578063d886f8a21462000cc857005b8063cb696f5411620000e5578063cb696f541462000be2578063ceaad4551462000c07578063cf9c57191462000c2c578063cfd19fb91462000c4457005b8063b66b3e791462000b72578063b7ab4db51462000b9a578063c2362dd51462000bb2578063c9d97af41462000bca57005b8063a5d059ca1162000193578063ae1f5fa01162000161578063ae1f5fa01462000adc578063b2ea9adb1462000b03578063b3ecbadd1462000b28578063b46e55201462000b4d57005b8063a5d059ca1462000a44578063a8b2216e1462000a69578063a9059cbb1462000a90578063ab8f6ffe1462000ab557005b80639bb851c011620001d15780639bb851c014620009ca5780639c98e47114620009e25780639dc29fac14620009fa578063a515366a1462000a1f57005b8063872cf05914620009145780638bac7dad146200092c57806395d89b41146200095157806396b477cb146200099957005b806340c10f1911620002fb57806370a08231116200028b578063819b64631162000259578063819b64631462000893578063833b1fce14620008aa57806384467fdb14620008ca578063852c484914620008ef57005b806370a08231146200073f578063731b3a03146200077957806377e741c7146200079057806379502c5514620007b557005b8063520fdbbc11620002c9578063520fdbbc14620006a05780635f7d394914620006c55780636b5f444c14620007035780636fd2c80b146200072857005b806340c10f19146200061757806343645969146200063c578063496ccd9b14620006535780634bb278f3146200067857005b80631604e416116200037757806329070c6d116200034557806329070c6d14620005a55780632f2c3f2e14620005bc578063313ce56714620005d457806335be16e014620005f257005b80631604e416146200051d57806318160ddd14620005355780631904bb2e146200054c57806323b872dd146200058057005b80630d8e6e2c11620003b55780630d8e6e2c146200049b5780631122063314620004bc578063114eaf5514620004d35780631250a28d14620004f857005b806306fdde0314620003e5578063095ea7b314620004405780630ae65e7a146200047657005b36620003e357005b005b348015620003f257600080fd5b5060408051808201909152600681527f4e6577746f6e000000000000000000000000000000000000000000000000000060208201525b60405162000437919062005daa565b60405180910390f35b3480156200044d57600080fd5b50620004656200045f36600462005ddc565b62000d8e565b604051901515815260200162000437565b3480156200048357600080fd5b50620003e36200049536600462005e0b565b62000da7565b348015620004a857600080fd5b50601c545b60405190815260200162000437565b348015620004c957600080fd5b50600e54620004ad565b348015620004e057600080fd5b50620003e3620004f236600462005e2b565b62000eb9565b3480156200050557600080fd5b50620003e36200051736600462005e0b565b62000f1a565b3480156200052a57600080fd5b50620004ad60245481565b3480156200054257600080fd5b50602954620004ad565b3480156200055957600080fd5b50620005716200056b36600462005e0b565b62000fa5565b60405162000437919062005eb0565b3480156200058d57600080fd5b50620004656200059f36600462006029565b62001282565b348015620005b257600080fd5b50600d54620004ad565b348015620005c957600080fd5b50620004ad61271081565b348015620005e157600080fd5b506040516012815260200162000437565b348015620005ff57600080fd5b50620003e3620006113660046200606f565b620012dc565b3480156200062457600080fd5b50620003e36200063636600462005ddc565b620014d2565b3480156200064957600080fd5b50601a54620004ad565b3480156200066057600080fd5b50620003e36200067236600462005e0b565b620015bb565b3480156200068557600080fd5b506200069062001733565b6040516200043792919062006130565b348015620006ad57600080fd5b50620003e3620006bf36600462005e0b565b62001bb3565b348015620006d257600080fd5b50620006ea620006e43660046200614d565b62001e67565b6040516001600160a01b03909116815260200162000437565b3480156200071057600080fd5b50620003e36200072236600462005e2b565b62002092565b3480156200073557600080fd5b50601054620004ad565b3480156200074c57600080fd5b50620004ad6200075e36600462005e0b565b6001600160a01b031660009081526027602052604090205490565b3480156200078657600080fd5b50602054620004ad565b3480156200079d57600080fd5b50620003e3620007af36600462005e2b565b62002260565b348015620007c257600080fd5b506040805160a08082018352600d548252600e54602080840191909152600f54838501526010546060808501919091526011546001600160a01b03908116608080870191909152865160c0810188526012548316815260135483168186015260145483168189015260155483168185015260165483168183015260175483169581019590955286519081018752601854909116815260195492810192909252601a5494820194909452601b5493810193909352601c5462000881939084565b60405162000437949392919062006170565b348015620008a057600080fd5b50601b54620004ad565b348015620008b757600080fd5b506013546001600160a01b0316620006ea565b348015620008d757600080fd5b50620003e3620008e93660046200630c565b620022c1565b348015620008fc57600080fd5b50620003e36200090e36600462005ddc565b620023f0565b3480156200092157600080fd5b50620003e362002612565b3480156200093957600080fd5b50620003e36200094b36600462005e2b565b6200267d565b3480156200095e57600080fd5b5060408051808201909152600381527f4e544e0000000000000000000000000000000000000000000000000000000000602082015262000428565b348015620009a657600080fd5b50620004ad620009b836600462005e2b565b6000908152601f602052604090205490565b348015620009d757600080fd5b50620004ad60235481565b348015620009ef57600080fd5b50620004ad60215481565b34801562000a0757600080fd5b50620003e362000a1936600462005ddc565b62002730565b34801562000a2c57600080fd5b50620003e362000a3e36600462005ddc565b6200287c565b34801562000a5157600080fd5b50620003e362000a6336600462005ddc565b6200297e565b34801562000a7657600080fd5b5062000a8162002a4c565b604051620004379190620063b5565b34801562000a9d57600080fd5b506200046562000aaf36600462005ddc565b62002b2f565b34801562000ac257600080fd5b5062000acd62002b3e565b60405162000437919062006439565b34801562000ae957600080fd5b5062000af462002c50565b6040516200043791906200644e565b34801562000b1057600080fd5b50620003e362000b223660046200649d565b62002ef6565b34801562000b3557600080fd5b50620003e362000b4736600462005e0b565b62002f6c565b34801562000b5a57600080fd5b50620003e362000b6c36600462005e0b565b62002ff7565b34801562000b7f57600080fd5b5062000b8a620032b7565b6040516200043792919062006508565b34801562000ba757600080fd5b5062000af4620033ee565b34801562000bbf57600080fd5b50620004ad60205481565b34801562000bd757600080fd5b50620004ad601e5481565b34801562000bef57600080fd5b50620003e362000c0136600462005e2b565b62003452565b34801562000c1457600080fd5b50620003e362000c2636600462005e0b565b620034e4565b34801562000c3957600080fd5b50620003e36200356f565b34801562000c5157600080fd5b50620003e362000c6336600462005e0b565b620035f2565b34801562000c7657600080fd5b50620003e362000c8836600462005e0b565b6200367d565b34801562000c9b57600080fd5b50602a54620006ea906001600160a01b031681565b34801562000cbd57600080fd5b50620003e362003708565b34801562000cd557600080fd5b50620003e362000ce736600462005e0b565b6200379e565b34801562000cfa57600080fd5b50620004ad62000d0c3660046200653a565b6001600160a01b03918216600090815260266020908152604080832093909416825291909152205490565b34801562000d4457600080fd5b50601954620004ad565b34801562000d5b57600080fd5b506018546001600160a01b0316620006ea565b34801562000d7b57600080fd5b506011546001600160a01b0316620006ea565b600062000d9d33848462003829565b5060015b92915050565b6001600160a01b038082166000818152602860205260409020600101549091161462000e1a5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d75737420626520726567697374657265640000000060448201526064015b60405180910390fd5b6001600160a01b0381811660009081526028602052604090205416331462000eab5760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162000e11565b62000eb68162003986565b50565b6018546001600160a01b0316331462000f155760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b601055565b6018546001600160a01b0316331462000f765760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6012805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b62000faf62005b83565b6001600160a01b03808316600081815260286020526040902060010154909116146200101e5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162000e11565b6001600160a01b038083166000908152602860209081526040918290208251610280810184528154851681526001820154851692810192909252600281015490931691810191909152600382018054919291606084019190620010819062006578565b80601f0160208091040260200160405190810160405280929190818152602001828054620010af9062006578565b8015620011005780601f10620010d45761010080835404028352916020019162001100565b820191906000526020600020905b815481529060010190602001808311620010e257829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e090920191620011bc9062006578565b80601f0160208091040260200160405190810160405280929190818152602001828054620011ea9062006578565b80156200123b5780601f106200120f576101008083540402835291602001916200123b565b820191906000526020600020905b8154815290600101906020018083116200121d57829003601f168201915b5050509183525050601382015460209091019060ff16600381111562001265576200126562005e45565b600381111562001279576200127962005e45565b90525092915050565b60006200129184848462003a5d565b6001600160a01b0384166000908152602660209081526040808320338452909152812054620012c2908490620065fc565b9050620012d185338362003829565b506001949350505050565b6012546001600160a01b031633146200135e5760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e747260448201527f6163740000000000000000000000000000000000000000000000000000000000606482015260840162000e11565b60006101208201356028826200137b604086016020870162005e0b565b6001600160a01b03166001600160a01b0316815260200190815260200160002060090154620013ab9190620065fc565b60c083013560286000620013c6604087016020880162005e0b565b6001600160a01b03166001600160a01b0316815260200190815260200160002060060154620013f69190620065fc565b60a08401356028600062001411604088016020890162005e0b565b6001600160a01b03166001600160a01b0316815260200190815260200160002060050154620014419190620065fc565b6200144d919062006612565b62001459919062006612565b6011546001600160a01b03166000908152602760205260408120805492935083929091906200148a90849062006612565b9091555082905060286000620014a7604084016020850162005e0b565b6001600160a01b031681526020810191909152604001600020620014cc828262006822565b50505050565b6018546001600160a01b031633146200152e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6001600160a01b038216600090815260276020526040812080548392906200155890849062006612565b92505081905550806029600082825462001573919062006612565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf906020015b60405180910390a25050565b6018546001600160a01b03163314620016175760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6013805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556014546040517f7adbf973000000000000000000000000000000000000000000000000000000008152600481019290925290911690637adbf97390602401600060405180830381600087803b1580156200169c57600080fd5b505af1158015620016b1573d6000803e3d6000fd5b50506016546040517f7adbf9730000000000000000000000000000000000000000000000000000000081526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b1580156200171757600080fd5b505af11580156200172c573d6000803e3d6000fd5b5050505050565b602a546000906060906001600160a01b03163314620017bb5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162000e11565b601e54436000818152601f6020908152604082209390935560195492549092620017e59162006612565b6012546040517f6c9789b0000000000000000000000000000000000000000000000000000000008152929091146004830181905292506001600160a01b031690636c9789b090602401600060405180830381600087803b1580156200184957600080fd5b505af11580156200185e573d6000803e3d6000fd5b5050505080156200196c576200187362003b96565b6200187d62003f98565b6200188762004087565b60006200189362002c50565b6013546040517f845023f20000000000000000000000000000000000000000000000000000000081529192506001600160a01b03169063845023f290620018df9084906004016200644e565b600060405180830381600087803b158015620018fa57600080fd5b505af11580156200190f573d6000803e3d6000fd5b50505050436020819055506001601e60008282546200192f919062006612565b9091555050601e546040519081527febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3359060200160405180910390a1505b601354604080517f4bb278f300000000000000000000000000000000000000000000000000000000815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af1158015620019d1573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620019f7919062006a07565b9050801562001a8c57601454604080517fa2e6204500000000000000000000000000000000000000000000000000000000815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af192505050801562001a84575060408051601f3d908101601f1916820190925262001a819181019062006a07565b60015b1562001a8c57505b600254602280546040805160208084028201810190925282815260ff9094169391839160009084015b8282101562001ba3576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162001b0b9062006578565b80601f016020809104026020016040519081016040528092919081815260200182805462001b399062006578565b801562001b8a5780601f1062001b5e5761010080835404028352916020019162001b8a565b820191906000526020600020905b81548152906001019060200180831162001b6c57829003601f168201915b5050505050815250508152602001906001019062001ab5565b5050505090509350935050509091565b6018546001600160a01b0316331462001c0f5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6018805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556013546040517fb3ab15fb00000000000000000000000000000000000000000000000000000000815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b15801562001c9457600080fd5b505af115801562001ca9573d6000803e3d6000fd5b50506014546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001d0e57600080fd5b505af115801562001d23573d6000803e3d6000fd5b50506015546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001d8857600080fd5b505af115801562001d9d573d6000803e3d6000fd5b50506016546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b15801562001e0257600080fd5b505af115801562001e17573d6000803e3d6000fd5b50506017546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401620016fc565b600080805b60225481101562001ec3576022818154811062001e8d5762001e8d62006a2b565b9060005260206000209060030201600101548262001eac919062006612565b91508062001eba8162006a5a565b91505062001e6c565b508060000362001f165760405162461bcd60e51b815260206004820152601c60248201527f54686520636f6d6d6974746565206973206e6f74207374616b696e6700000000604482015260640162000e11565b60008362001f2660638762006a77565b62001f32919062006612565b905060008160405160200162001f4a91815260200190565b60408051601f1981840301815291905280516020909101209050600062001f72848362006ac0565b90506000805b60225481101562002022576022818154811062001f995762001f9962006a2b565b9060005260206000209060030201600101548262001fb8919062006612565b915062001fc7600183620065fc565b83116200200d576022818154811062001fe45762001fe462006a2b565b60009182526020909120600390910201546001600160a01b0316965062000da195505050505050565b80620020198162006a5a565b91505062001f78565b5060405162461bcd60e51b815260206004820152602960248201527f5468657265206973206e6f2076616c696461746f72206c65667420696e20746860448201527f65206e6574776f726b0000000000000000000000000000000000000000000000606482015260840162000e11565b6018546001600160a01b03163314620020ee5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b601954811015620021a5578060205462002109919062006612565b4310620021a55760405162461bcd60e51b815260206004820152605760248201527f63757272656e7420636861696e2068656164206578636565642074686520776960448201527f6e646f773a206c617374426c6f636b45706f6368202b205f6e6577506572696f60648201527f642c2074727920616761696e206c6174746572206f6e2e000000000000000000608482015260a40162000e11565b60198190556012546040517f6b5f444c000000000000000000000000000000000000000000000000000000008152600481018390526001600160a01b0390911690636b5f444c90602401600060405180830381600087803b1580156200220a57600080fd5b505af11580156200221f573d6000803e3d6000fd5b505050507fd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f81816040516200225591815260200190565b60405180910390a150565b6018546001600160a01b03163314620022bc5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b600d55565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b03168152602001868152602001600d6000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b03168152602001600081526020014381526020016000815260200160008152602001600081526020018481526020016000600381111562002390576200239062005e45565b90529050620023a08183620041cc565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c92620023e192339289918b9162006ad7565b60405180910390a15050505050565b6001600160a01b03808316600081815260286020526040902060010154909116146200245f5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000e11565b6001600160a01b03828116600090815260286020526040902054163314620024f05760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162000e11565b612710811115620025445760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e207261746500604482015260640162000e11565b604080516060810182526001600160a01b038481168252436020808401918252838501868152600c80546000908152600a9093529582208551815473ffffffffffffffffffffffffffffffffffffffff1916951694909417845591516001808501919091559151600290930192909255835492939092909190620025ca90849062006612565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6018546001600160a01b031633146200266e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6002805460ff19166001179055565b6018546001600160a01b03163314620026d95760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b600081116200272b5760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e2774206265203000000000000000604482015260640162000e11565b601b55565b6018546001600160a01b031633146200278c5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6001600160a01b038216600090815260276020526040902054811115620027f65760405162461bcd60e51b815260206004820152601660248201527f416d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162000e11565b6001600160a01b0382166000908152602760205260408120805483929062002820908490620065fc565b9250508190555080602960008282546200283b9190620065fc565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a390602001620015af565b6001600160a01b0380831660008181526028602052604090206001015490911614620028eb5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162000e11565b6001600160a01b03821660009081526028602052604081206013015460ff1660038111156200291e576200291e62005e45565b146200296d5760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f206265206163746976650000000000604482015260640162000e11565b6200297a8282336200466f565b5050565b6001600160a01b0380831660008181526028602052604090206001015490911614620029ed5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162000e11565b6000811162002a3f5760405162461bcd60e51b815260206004820152601560248201527f756e626f6e64696e6720616d6f756e7420697320300000000000000000000000604482015260640162000e11565b6200297a82823362004882565b60606025805480602002602001604051908101604052809291908181526020016000905b8282101562002b2657838290600052602060002001805462002a929062006578565b80601f016020809104026020016040519081016040528092919081815260200182805462002ac09062006578565b801562002b115780601f1062002ae55761010080835404028352916020019162002b11565b820191906000526020600020905b81548152906001019060200180831162002af357829003601f168201915b50505050508152602001906001019062002a70565b50505050905090565b600062000d9d33848462003a5d565b60606022805480602002602001604051908101604052809291908181526020016000905b8282101562002b26576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162002bb89062006578565b80601f016020809104026020016040519081016040528092919081815260200182805462002be69062006578565b801562002c375780601f1062002c0b5761010080835404028352916020019162002c37565b820191906000526020600020905b81548152906001019060200180831162002c1957829003601f168201915b5050505050815250508152602001906001019062002b62565b602a546060906001600160a01b0316331462002cd55760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162000e11565b601d5462002d265760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f72730000000000000000604482015260640162000e11565b62002d3062005c53565b601b546080820152601d815260286020820152602260408201526021606082015262002d5c8162004c56565b62002d6a6025600062005c71565b6022548062002dbc5760405162461bcd60e51b815260206004820152601260248201527f636f6d6d697474656520697320656d7074790000000000000000000000000000604482015260640162000e11565b60008167ffffffffffffffff81111562002dda5762002dda62006248565b60405190808252806020026020018201604052801562002e04578160200160208202803683370190505b50905060005b8281101562002eee576000602860006022848154811062002e2f5762002e2f62006a2b565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060258054600181018255925292507f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c019062002e979083018262006b21565b50600281015483516001600160a01b039091169084908490811062002ec05762002ec062006a2b565b6001600160a01b0390921660209283029190910190910152508062002ee58162006a5a565b91505062002e0a565b509250505090565b6018546001600160a01b0316331462002f525760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b62002f5f60008362004c71565b6200297a60018262004c71565b6018546001600160a01b0316331462002fc85760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6015805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b0380821660008181526028602052604090206001015490911614620030665760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000e11565b6001600160a01b03808216600090815260286020526040902080549091163314620030fa5760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162000e11565b6000601382015460ff16600381111562003118576200311862005e45565b03620031675760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c7265616479206163746976650000000000000000604482015260640162000e11565b6002601382015460ff16600381111562003185576200318562005e45565b148015620031965750438160100154115b15620031e55760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c000000000000000000604482015260640162000e11565b6003601382015460ff16600381111562003203576200320362005e45565b03620032525760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c7900000000604482015260640162000e11565b60138101805460ff1916905580546019546020546001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b591620032a2919062006612565b60405190815260200160405180910390a35050565b60608060006001818054620032cc9062006578565b80601f0160208091040260200160405190810160405280929190818152602001828054620032fa9062006578565b80156200334b5780601f106200331f576101008083540402835291602001916200334b565b820191906000526020600020905b8154815290600101906020018083116200332d57829003601f168201915b50505050509150808054620033609062006578565b80601f01602080910402602001604051908101604052809291908181526020018280546200338e9062006578565b8015620033df5780601f10620033b357610100808354040283529160200191620033df565b820191906000526020600020905b815481529060010190602001808311620033c157829003601f168201915b50505050509050915091509091565b6060601d8054806020026020016040519081016040528092919081815260200182805480156200344857602002820191906000526020600020905b81546001600160a01b0316815260019091019060200180831162003429575b5050505050905090565b6018546001600160a01b03163314620034ae5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b600e8190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200162002255565b6018546001600160a01b03163314620035405760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6017805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6018546001600160a01b03163314620035cb5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b620035d860008062005c91565b620035e66001600062005c91565b6002805460ff19169055565b6018546001600160a01b031633146200364e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6016805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6018546001600160a01b03163314620036d95760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6014805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b602a546001600160a01b031633146200378a5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162000e11565b6200379462003f98565b62000eb662002c50565b6018546001600160a01b03163314620037fa5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000e11565b6011805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b038316620038a65760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f7265737300000000000000000000000000000000000000000000000000000000606482015260840162000e11565b6001600160a01b038216620039245760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f7373000000000000000000000000000000000000000000000000000000000000606482015260840162000e11565b6001600160a01b0383811660008181526026602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6001600160a01b038116600090815260286020526040812090601382015460ff166003811115620039bb57620039bb62005e45565b1462003a0a5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d757374206265206163746976650000000000000000604482015260640162000e11565b60138101805460ff1916600117905580546019546020546001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c91620032a2919062006612565b6001600160a01b03831660009081526027602052604090205481111562003ac75760405162461bcd60e51b815260206004820152601660248201527f616d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162000e11565b6001600160a01b0383166000908152602760205260408120805483929062003af1908490620065fc565b92505081905550806029600082825462003b0c919062006612565b9091555062003b1f905081600262006a77565b6001600160a01b0383166000908152602760205260408120805490919062003b4990849062006612565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef836040516200397991815260200190565b4760000362003ba157565b600d544790600090670de0b6b3a76400009062003bc090849062006a77565b62003bcc919062006c0e565b9050801562003c4a576011546040516000916001600160a01b03169083908381818185875af1925050503d806000811462003c24576040519150601f19603f3d011682016040523d82523d6000602084013e62003c29565b606091505b509091505080151560010362003c485762003c458284620065fc565b92505b505b816023600082825462003c5e919062006612565b90915550600090505b60225481101562003f93576000602860006022848154811062003c8e5762003c8e62006a2b565b600091825260208083206003909202909101546001600160a01b0316835282019290925260400181206021546022805492945090918791908690811062003cd95762003cd962006a2b565b90600052602060002090600302016001015462003cf7919062006a77565b62003d03919062006c0e565b9050801562003f7b576002601383015460ff16600381111562003d2a5762003d2a62005e45565b148062003d5257506003601383015460ff16600381111562003d505762003d5062005e45565b145b1562003e1257601254602280546001600160a01b0390921691631de9d9b69184918790811062003d865762003d8662006a2b565b600091825260209091206003909102015460405160e084901b7fffffffff000000000000000000000000000000000000000000000000000000001681526001600160a01b0390911660048201526024016000604051808303818588803b15801562003df057600080fd5b505af115801562003e05573d6000803e3d6000fd5b5050505050505062003f7e565b6000826005015482846008015462003e2b919062006a77565b62003e37919062006c0e565b9050600062003e478284620065fc565b9050811562003eac5783546040516001600160a01b03909116906108fc9084906000818181858888f193505050503d806000811462003ea3576040519150601f19603f3d011682016040523d82523d6000602084013e62003ea8565b606091505b5050505b801562003f345783600c0160009054906101000a90046001600160a01b03166001600160a01b031663fb489a7b826040518263ffffffff1660e01b815260040160206040518083038185885af115801562003f0b573d6000803e3d6000fd5b50505050506040513d601f19601f8201168201806040525081019062003f32919062006c25565b505b60018401546040518481526001600160a01b03909116907fb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe5639060200160405180910390a250505b50505b8062003f8a8162006a5a565b91505062003c67565b505050565b6004545b60055481101562003fc55762003fbf8162003fb78162006a5a565b925062004dc4565b62003f9c565b506005546004556008546007540362003fda57565b6009545b6008548110156200400757620040018162003ff98162006a5a565b925062005004565b62003fde565b50600854600955600754805b60085481101562004081576010546000828152600660205260409020600401544391620040409162006612565b116200406657620040518162005331565b6200405e60018362006612565b91506200406c565b62004081565b80620040788162006a5a565b91505062004013565b50600755565b600c54600b541015620041ca57600b546000908152600a6020526040902060105460018201544391620040ba9162006612565b1115620040c45750565b600281015481546001600160a01b03908116600090815260286020526040808220600490810185905585548416835291819020600c015490517f19fac8fd0000000000000000000000000000000000000000000000000000000081529216926319fac8fd9262004138920190815260200190565b600060405180830381600087803b1580156200415357600080fd5b505af115801562004168573d6000803e3d6000fd5b5050600b80546000908152600a60205260408120805473ffffffffffffffffffffffffffffffffffffffff1916815560018082018390556002909101829055825490945091925090620041bd90849062006612565b9091555062004087915050565b565b60e28151146200421f5760405162461bcd60e51b815260206004820152601460248201527f496e76616c69642070726f6f66206c656e677468000000000000000000000000604482015260640162000e11565b60308261024001515114620042775760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e67746800000000604482015260640162000e11565b62004282826200547c565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a000000000000602080830191909152845192519192600092620042f8920160609190911b7fffffffffffffffffffffffffffffffffffffffff00000000000000000000000016815260140190565b6040516020818303038152906040529050600082620043188351620055bb565b836040516020016200432d9392919062006c3f565b60408051601f19818403018152828252805160209182012060028085526060850184529094506000939290918301908036833701905050905060008080806200438589826200437f6041600262006a77565b62005710565b90506000620043a48a6200439c6041600262006a77565b606062005710565b905060205b82518110156200447557620043bf838262005844565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa1580156200441b573d6000803e3d6000fd5b5050604051601f1901519050876200443560418462006c0e565b8151811062004448576200444862006a2b565b6001600160a01b03909216602092830291909101909101526200446d60418262006612565b9050620043a9565b508a602001516001600160a01b0316866000815181106200449a576200449a62006a2b565b60200260200101516001600160a01b031614620045205760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f6660448201527f2070726f76696465640000000000000000000000000000000000000000000000606482015260840162000e11565b8a604001516001600160a01b03168660018151811062004544576200454462006a2b565b60200260200101516001600160a01b031614620045ca5760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201527f6f662070726f7669646564000000000000000000000000000000000000000000606482015260840162000e11565b6001620045e28c6102400151838e600001516200587b565b14620046575760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e6572736869702060448201527f70726f6f6620666f7220726567697374726174696f6e00000000000000000000606482015260840162000e11565b620046628b620058ea565b5050505050505050505050565b60008211620046e75760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201527f6976650000000000000000000000000000000000000000000000000000000000606482015260840162000e11565b6001600160a01b038116600090815260276020526040902054821115620047515760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000e11565b6001600160a01b038116600090815260276020526040812080548492906200477b908490620065fc565b9091555050604080516080810182526001600160a01b038084168252858116602080840191825283850187815243606086019081526005805460009081526003948590529788208751815490881673ffffffffffffffffffffffffffffffffffffffff19918216178255955160018201805491909816961695909517909555905160028401555191015580549192620048148362006a5a565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d91015b60405180910390a35050505050565b6001600160a01b038084166000908152602860205260409020805490918381169116148062004a3c57600c8201546040517f84955c880000000000000000000000000000000000000000000000000000000081526001600160a01b03858116600483015260009216906384955c8890602401602060405180830381865afa15801562004912573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062004938919062006c25565b905084811015620049b25760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201527f746f6e2062616c616e6365000000000000000000000000000000000000000000606482015260840162000e11565b600c8301546040517f282d3fdf0000000000000000000000000000000000000000000000000000000081526001600160a01b038681166004830152602482018890529091169063282d3fdf90604401600060405180830381600087803b15801562004a1c57600080fd5b505af115801562004a31573d6000803e3d6000fd5b505050505062004ae5565b8382600b0154836008015462004a539190620065fc565b101562004ac95760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201527f62616c616e636500000000000000000000000000000000000000000000000000606482015260840162000e11565b8382600b01600082825462004adf919062006612565b90915550505b6040805160e0810182526001600160a01b0380861682528781166020808401918252838501898152600060608601818152436080880190815260a088018381528a151560c08a0190815260088054865260069097529984209851895490891673ffffffffffffffffffffffffffffffffffffffff19918216178a55965160018a0180549190991697169690961790965591516002870155905160038601559251600485015590516005909301805494511515610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff941515949094167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff0000909516949094179290921790925580549162004c008362006a5a565b9190505550826001600160a01b0316856001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc8387604051620048739291909115158252602082015260400190565b60fa60a06000808285855af462003f93573d6000803e3d6000fd5b81546002600180831615610100020382160482518082016020811060208410016002811462004d20576001811462004d46578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b8082101562004d09578151845560018401935060208201915062004cea565b815191036101000a90819004029091555062004dbb565b60028302826020036101000a846020036101000a60208901510402018501875562004dbb565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b8082101562004da9578151845560018401935060208201915062004d8a565b815191036101000a9081900402909155505b50505050505050565b600081815260036020908152604080832060018101546001600160a01b03168452602890925282209091601382015460ff16600381111562004e0a5762004e0a62005e45565b1462004eaa57600282015482546001600160a01b03166000908152602760205260408120805490919062004e4090849062006612565b909155505081546001830154600284015460138401546040517f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f8783429462004e9d946001600160a01b0391821694911692909160ff9091169062006c88565b60405180910390a1505050565b805482546001600160a01b0390811691161462004fc0576000808260080154836005015462004eda9190620065fc565b90508060000362004ef2578360020154915062004f18565b80846002015484600d015462004f09919062006a77565b62004f15919062006c0e565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b15801562004f8357600080fd5b505af115801562004f98573d6000803e3d6000fd5b505050508183600d01600082825462004fb2919062006612565b9091555062004fe092505050565b816002015481600801600082825462004fda919062006612565b90915550505b816002015481600501600082825462004ffa919062006612565b9091555050505050565b600081815260066020908152604080832060018101546001600160a01b0316845260289092528220600582015491929091610100900460ff166200522a576002830154600c83015484546040517f7eee288d0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b158015620050b257600080fd5b505af1158015620050c7573d6000803e3d6000fd5b50505050600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b1580156200513657600080fd5b505af11580156200514b573d6000803e3d6000fd5b50505050600083600801548460050154620051679190620065fc565b600d8501549091506200517b828462006a77565b62005187919062006c0e565b92508184600d0160008282546200519f9190620065fc565b90915550506006840154600003620051be5760038501839055620051e6565b60068401546007850154620051d4908562006a77565b620051e0919062006c0e565b60038601555b82846006016000828254620051fc919062006612565b909155505060038501546007850180546000906200521c90849062006612565b90915550620052ff92505050565b506002820154600882015481111562005244575060088101545b81600901546000036200525e576003830181905562005286565b6009820154600a83015462005274908362006a77565b62005280919062006c0e565b60038401555b808260090160008282546200529c919062006612565b90915550506003830154600a83018054600090620052bc90849062006612565b9250508190555080826008016000828254620052d99190620065fc565b90915550506002830154600b83018054600090620052f9908490620065fc565b90915550505b6005808401805460ff191660011790558201805482919060009062005326908490620065fc565b909155505050505050565b6000818152600660205260408120600381015490910362005350575050565b60018101546001600160a01b031660009081526028602052604081206005830154909190610100900460ff16620053ec578160070154826006015484600301546200539c919062006a77565b620053a8919062006c0e565b905080826006016000828254620053c09190620065fc565b90915550506003830154600783018054600090620053e0908490620065fc565b90915550620054519050565b81600a01548260090154846003015462005407919062006a77565b62005413919062006c0e565b9050808260090160008282546200542b9190620065fc565b90915550506003830154600a830180546000906200544b908490620065fc565b90915550505b82546001600160a01b0316600090815260276020526040812080548392906200532690849062006612565b60006200548d826060015162005b35565b6001600160a01b03909116602084015290508015620054ef5760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f72000000000000000000000000000000000000000000604482015260640162000e11565b6020808301516001600160a01b03908116600090815260289092526040909120600101541615620055635760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000e11565b612710826080015111156200297a5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000e11565b606081600003620055ff57505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b81156200562f5780620056168162006a5a565b9150620056279050600a8362006c0e565b915062005603565b60008167ffffffffffffffff8111156200564d576200564d62006248565b6040519080825280601f01601f19166020018201604052801562005678576020820181803683370190505b5090505b8415620057085762005690600183620065fc565b91506200569f600a8662006ac0565b620056ac90603062006612565b60f81b818381518110620056c457620056c462006a2b565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a90535062005700600a8662006c0e565b94506200567c565b949350505050565b6060816200572081601f62006612565b1015620057705760405162461bcd60e51b815260206004820152600e60248201527f736c6963655f6f766572666c6f77000000000000000000000000000000000000604482015260640162000e11565b6200577c828462006612565b84511015620057ce5760405162461bcd60e51b815260206004820152601160248201527f736c6963655f6f75744f66426f756e6473000000000000000000000000000000604482015260640162000e11565b606082158015620057ef57604051915060008252602082016040526200583b565b6040519150601f8416801560200281840101858101878315602002848b0101015b818310156200582a57805183526020928301920162005810565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b811015620058745762005871601b8262006cb6565b90505b9250925092565b60006200588762005cd0565b6000858585604051602001620058a09392919062006cd2565b6040516020818303038152906040529050600060fb9050600082516020620058c9919062006612565b90506020848285855afa620058dd57600080fd5b5050905195945050505050565b6101808101516001600160a01b03166200596d57601d546000906200590f90620055bb565b9050816020015182600001518360800151836040516200592f9062005cee565b6200593e949392919062006d34565b604051809103906000f0801580156200595b573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f909101805473ffffffffffffffffffffffffffffffffffffffff199081166001600160a01b039586161790915584518416835260289095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062005a2c908262006d72565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c8201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062005b03908262006d72565b5061026082015160138201805460ff1916600183600381111562005b2b5762005b2b62005e45565b0217905550505050565b60008062005b4262005cfc565b60008060ff9050604083875160208901845afa62005b5f57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562005c4e5762005c4e62005e45565b905290565b6040518060a001604052806005906020820280368337509192915050565b508054600082559060005260206000209081019062000eb6919062005d1a565b50805462005c9f9062006578565b6000825580601f1062005cb0575050565b601f01602090049060005260206000209081019062000eb6919062005d3f565b60405180602001604052806001906020820280368337509192915050565b611a208062006e3783390190565b60405180604001604052806002906020820280368337509192915050565b8082111562005d3b57600062005d31828262005c91565b5060010162005d1a565b5090565b5b8082111562005d3b576000815560010162005d40565b60005b8381101562005d7357818101518382015260200162005d59565b50506000910152565b6000815180845262005d9681602086016020860162005d56565b601f01601f19169290920160200192915050565b60208152600062005dbf602083018462005d7c565b9392505050565b6001600160a01b038116811462000eb657600080fd5b6000806040838503121562005df057600080fd5b823562005dfd8162005dc6565b946020939093013593505050565b60006020828403121562005e1e57600080fd5b813562005dbf8162005dc6565b60006020828403121562005e3e57600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6004811062005eac577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b9052565b6020815262005ecb6020820183516001600160a01b03169052565b6000602083015162005ee860408401826001600160a01b03169052565b5060408301516001600160a01b038116606084015250606083015161028080608085015262005f1c6102a085018362005d7c565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a062005fa4818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f19016102608088019190915290915062006008848362005d7c565b9350808701519150506200601f8286018262005e74565b5090949350505050565b6000806000606084860312156200603f57600080fd5b83356200604c8162005dc6565b925060208401356200605e8162005dc6565b929592945050506040919091013590565b6000602082840312156200608257600080fd5b813567ffffffffffffffff8111156200609a57600080fd5b8201610280818503121562005dbf57600080fd5b600081518084526020808501808196508360051b8101915082860160005b8581101562006123578284038952815180516001600160a01b0316855285810151868601526040908101516060918601829052906200610e8187018362005d7c565b9a87019a9550505090840190600101620060cc565b5091979650505050505050565b8215158152604060208201526000620057086040830184620060ae565b600080604083850312156200616157600080fd5b50508035926020909101359150565b6000610200820190508551825260208601516020830152604086015160408301526060860151606083015260808601516001600160a01b0380821660808501528087511660a08501528060208801511660c08501528060408801511660e08501528060608801511661010085015280608088015116610120850152505060a0850151620062096101408401826001600160a01b03169052565b5083516001600160a01b0316610160830152602084015161018083015260408401516101a08301526060909301516101c08201526101e0015292915050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b600082601f8301126200628957600080fd5b813567ffffffffffffffff80821115620062a757620062a762006248565b604051601f8301601f19908116603f01168101908282118183101715620062d257620062d262006248565b81604052838152866020858801011115620062ec57600080fd5b836020870160208301376000602085830101528094505050505092915050565b600080600080608085870312156200632357600080fd5b843567ffffffffffffffff808211156200633c57600080fd5b6200634a8883890162006277565b9550602087013591506200635e8262005dc6565b909350604086013590808211156200637557600080fd5b620063838883890162006277565b935060608701359150808211156200639a57600080fd5b50620063a98782880162006277565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b828110156200642c577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc08886030184526200641985835162005d7c565b94509285019290850190600101620063dc565b5092979650505050505050565b60208152600062005dbf6020830184620060ae565b6020808252825182820181905260009190848201906040850190845b81811015620064915783516001600160a01b0316835292840192918401916001016200646a565b50909695505050505050565b60008060408385031215620064b157600080fd5b823567ffffffffffffffff80821115620064ca57600080fd5b620064d88683870162006277565b93506020850135915080821115620064ef57600080fd5b50620064fe8582860162006277565b9150509250929050565b6040815260006200651d604083018562005d7c565b828103602084015262006531818562005d7c565b95945050505050565b600080604083850312156200654e57600080fd5b82356200655b8162005dc6565b915060208301356200656d8162005dc6565b809150509250929050565b600181811c908216806200658d57607f821691505b602082108103620065c7577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111562000da15762000da1620065cd565b8082018082111562000da15762000da1620065cd565b6000813562000da18162005dc6565b60008083357fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe18436030181126200666d57600080fd5b83018035915067ffffffffffffffff8211156200668957600080fd5b6020019150368190038213156200669f57600080fd5b9250929050565b601f82111562003f9357600081815260208120601f850160051c81016020861015620066cf5750805b601f850160051c820191505b81811015620066f057828155600101620066db565b505050505050565b67ffffffffffffffff83111562006713576200671362006248565b6200672b8362006724835462006578565b83620066a6565b6000601f841160018114620067625760008515620067495750838201355b600019600387901b1c1916600186901b1783556200172c565b600083815260209020601f19861690835b8281101562006795578685013582556020948501946001909201910162006773565b5086821015620067b35760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b600081356004811062000da157600080fd5b600482106200680f577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b60ff1981541660ff831681178255505050565b6200685b620068318362006628565b825473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0391909116178255565b6200689b6200686d6020840162006628565b600183016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b620068db620068ad6040840162006628565b600283016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b620068ea606083018362006637565b620068fa818360038601620066f8565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b8201556200699162006963610180840162006628565b600c83016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f82015561020082013560108201556102208201356011820155620069d861024083018362006637565b620069e8818360128601620066f8565b50506200297a620069fd6102608401620067c5565b60138301620067d7565b60006020828403121562006a1a57600080fd5b8151801515811462005dbf57600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b6000600019820362006a705762006a70620065cd565b5060010190565b808202811582820484141762000da15762000da1620065cd565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b60008262006ad25762006ad262006a91565b500690565b60006001600160a01b0380881683528087166020840152808616604084015260a0606084015262006b0c60a084018662005d7c565b91508084166080840152509695505050505050565b81810362006b2d575050565b62006b39825462006578565b67ffffffffffffffff81111562006b545762006b5462006248565b62006b6c8162006b65845462006578565b84620066a6565b6000601f82116001811462006ba3576000831562006b8a5750848201545b600019600385901b1c1916600184901b1784556200172c565b600085815260209020601f19841690600086815260209020845b8381101562006bdf578286015482556001958601959091019060200162006bbd565b508583101562006bfe5781850154600019600388901b60f8161c191681555b5050505050600190811b01905550565b60008262006c205762006c2062006a91565b500490565b60006020828403121562006c3857600080fd5b5051919050565b6000845162006c5381846020890162005d56565b84519083019062006c6981836020890162005d56565b845191019062006c7e81836020880162005d56565b0195945050505050565b6001600160a01b03858116825284166020820152604081018390526080810162006531606083018462005e74565b60ff818116838216019081111562000da15762000da1620065cd565b6000845162006ce681846020890162005d56565b84519083019062006cfc81836020890162005d56565b60609490941b7fffffffffffffffffffffffffffffffffffffffff000000000000000000000000169301928352505060140192915050565b60006001600160a01b0380871683528086166020840152508360408301526080606083015262006d68608083018462005d7c565b9695505050505050565b815167ffffffffffffffff81111562006d8f5762006d8f62006248565b62006da08162006b65845462006578565b602080601f83116001811462006dd8576000841562006dbf5750858301515b600019600386901b1c1916600185901b178555620066f0565b600085815260208120601f198616915b8281101562006e095788860151825594840194600190910190840162006de8565b508582101562006bfe57939096015160001960f8600387901b161c19169092555050600190811b0190555056fe60806040523480156200001157600080fd5b5060405162001a2038038062001a20833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61164880620003d86000396000f3fe60806040526004361061018b5760003560e01c806359355736116100d6578063949813b81161007f578063a9059cbb11610059578063a9059cbb1461049b578063dd62ed3e146104bb578063fb489a7b1461050e57600080fd5b8063949813b81461044657806395d89b41146104665780639dc29fac1461047b57600080fd5b806370a08231116100b057806370a08231146103c35780637eee288d1461040657806384955c881461042657600080fd5b8063593557361461033d5780635ea1d6f81461038057806361d027b31461039657600080fd5b8063282d3fdf11610138578063372500ab11610112578063372500ab146102b65780633a5381b5146102cb57806340c10f191461031d57600080fd5b8063282d3fdf146102645780632f2c3f2e14610284578063313ce5671461029a57600080fd5b8063187cf4d711610169578063187cf4d71461020a57806319fac8fd1461022257806323b872dd1461024457600080fd5b806306fdde0314610190578063095ea7b3146101bb57806318160ddd146101eb575b600080fd5b34801561019c57600080fd5b506101a5610516565b6040516101b291906113af565b60405180910390f35b3480156101c757600080fd5b506101db6101d6366004611444565b6105a8565b60405190151581526020016101b2565b3480156101f757600080fd5b506004545b6040519081526020016101b2565b34801561021657600080fd5b506101fc633b9aca0081565b34801561022e57600080fd5b5061024261023d36600461146e565b6105bf565b005b34801561025057600080fd5b506101db61025f366004611487565b610656565b34801561027057600080fd5b5061024261027f366004611444565b610797565b34801561029057600080fd5b506101fc61271081565b3480156102a657600080fd5b50604051601281526020016101b2565b3480156102c257600080fd5b50610242610912565b3480156102d757600080fd5b50600a546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b60405173ffffffffffffffffffffffffffffffffffffffff90911681526020016101b2565b34801561032957600080fd5b50610242610338366004611444565b6109c9565b34801561034957600080fd5b506101fc6103583660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526002602052604090205490565b34801561038c57600080fd5b506101fc600c5481565b3480156103a257600080fd5b50600b546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b3480156103cf57600080fd5b506101fc6103de3660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561041257600080fd5b50610242610421366004611444565b610ab3565b34801561043257600080fd5b506101fc6104413660046114c3565b610c10565b34801561045257600080fd5b506101fc6104613660046114c3565b610c4b565b34801561047257600080fd5b506101a5610c86565b34801561048757600080fd5b50610242610496366004611444565b610c95565b3480156104a757600080fd5b506101db6104b6366004611444565b610d77565b3480156104c757600080fd5b506101fc6104d63660046114e5565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260036020908152604080832093909416825291909152205490565b6101fc610de3565b60606008805461052590611518565b80601f016020809104026020016040519081016040528092919081815260200182805461055190611518565b801561059e5780601f106105735761010080835404028352916020019161059e565b820191906000526020600020905b81548152906001019060200180831161058157829003601f168201915b5050505050905090565b60006105b5338484610fbb565b5060015b92915050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146106515760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e747261637400000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b600c55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600360209081526040808320338452909152812054828110156106fd5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610648565b610711853361070c868561159a565b610fbb565b61071b858461113a565b6107258484611249565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161078491815260200190565b60405180910390a3506001949350505050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146108245760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff821660009081526002602090815260408083205460019092529091205482916108619161159a565b10156108d45760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040812080548392906109099084906115ad565b90915550505050565b600061091d336112a1565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461096f576040519150601f19603f3d011682016040523d82523d6000602084013e610974565b606091505b50509050806109c55760405162461bcd60e51b815260206004820152601460248201527f4661696c656420746f2073656e642045746865720000000000000000000000006044820152606401610648565b5050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610a565760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610a608282611249565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610b405760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040902054811115610bdb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600260205260408120805483929061090990849061159a565b73ffffffffffffffffffffffffffffffffffffffff811660009081526002602090815260408083205460019092528220546105b9919061159a565b6000610c5682611320565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600560205260409020546105b991906115ad565b60606009805461052590611518565b60005473ffffffffffffffffffffffffffffffffffffffff163314610d225760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610d2c828261113a565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610aa7565b6000610d83338361113a565b610d8d8383611249565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6000805473ffffffffffffffffffffffffffffffffffffffff163314610e715760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b600c54349060009061271090610e8790846115c0565b610e9191906115d7565b905081811115610ee35760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f722072657761726400000000000000006044820152606401610648565b610eed818361159a565b600b5460405191935073ffffffffffffffffffffffffffffffffffffffff16906108fc9083906000818181858888f193505050503d8060008114610f4d576040519150601f19603f3d011682016040523d82523d6000602084013e610f52565b606091505b505060045460009150610f69633b9aca00856115c0565b610f7391906115d7565b905080600754610f8391906115ad565b600755600454600090633b9aca0090610f9c90846115c0565b610fa691906115d7565b9050610fb281846115ad565b94505050505090565b73ffffffffffffffffffffffffffffffffffffffff83166110435760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166110cc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b611143826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020908152604080832054600290925290912054611180908261159a565b8211156111cf5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610648565b6111d9828261159a565b73ffffffffffffffffffffffffffffffffffffffff841660009081526001602052604090205580820361122d5773ffffffffffffffffffffffffffffffffffffffff83166000908152600660205260408120555b816004600082825461123f919061159a565b9091555050505050565b611252826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906112889084906115ad565b92505081905550806004600082825461090991906115ad565b6000806112ad83611320565b73ffffffffffffffffffffffffffffffffffffffff84166000908152600560205260409020549091506112e19082906115ad565b73ffffffffffffffffffffffffffffffffffffffff90931660009081526005602090815260408083208690556007546006909252909120555090919050565b73ffffffffffffffffffffffffffffffffffffffff81166000908152600160205260408120548082036113565750600092915050565b73ffffffffffffffffffffffffffffffffffffffff8316600090815260066020526040812054600754611389919061159a565b90506000633b9aca0061139c84846115c0565b6113a691906115d7565b95945050505050565b600060208083528351808285015260005b818110156113dc578581018301518582016040015282016113c0565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff8116811461143f57600080fd5b919050565b6000806040838503121561145757600080fd5b6114608361141b565b946020939093013593505050565b60006020828403121561148057600080fd5b5035919050565b60008060006060848603121561149c57600080fd5b6114a58461141b565b92506114b36020850161141b565b9150604084013590509250925092565b6000602082840312156114d557600080fd5b6114de8261141b565b9392505050565b600080604083850312156114f857600080fd5b6115018361141b565b915061150f6020840161141b565b90509250929050565b600181811c9082168061152c57607f821691505b602082108103611565577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156105b9576105b961156b565b808201808211156105b9576105b961156b565b80820281158282048414176105b9576105b961156b565b60008261160d577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220257272b65cbec4bea28792495543336863e79a2fca1536e6ea5e70c11574e1ec64736f6c63430008150033a2646970667358221220b58c552a7da92ae7fe067b0679350a3d179d39cd54fbe2f54e9081b5057fb82864736f6c6343000815003360806040523480156200001157600080fd5b5060405162001a2038038062001a20833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61164880620003d86000396000f3fe60806040526004361061018b5760003560e01c806359355736116100d6578063949813b81161007f578063a9059cbb11610059578063a9059cbb1461049b578063dd62ed3e146104bb578063fb489a7b1461050e57600080fd5b8063949813b81461044657806395d89b41146104665780639dc29fac1461047b57600080fd5b806370a08231116100b057806370a08231146103c35780637eee288d1461040657806384955c881461042657600080fd5b8063593557361461033d5780635ea1d6f81461038057806361d027b31461039657600080fd5b8063282d3fdf11610138578063372500ab11610112578063372500ab146102b65780633a5381b5146102cb57806340c10f191461031d57600080fd5b8063282d3fdf146102645780632f2c3f2e14610284578063313ce5671461029a57600080fd5b8063187cf4d711610169578063187cf4d71461020a57806319fac8fd1461022257806323b872dd1461024457600080fd5b806306fdde0314610190578063095ea7b3146101bb57806318160ddd146101eb575b600080fd5b34801561019c57600080fd5b506101a5610516565b6040516101b291906113af565b60405180910390f35b3480156101c757600080fd5b506101db6101d6366004611444565b6105a8565b60405190151581526020016101b2565b3480156101f757600080fd5b506004545b6040519081526020016101b2565b34801561021657600080fd5b506101fc633b9aca0081565b34801561022e57600080fd5b5061024261023d36600461146e565b6105bf565b005b34801561025057600080fd5b506101db61025f366004611487565b610656565b34801561027057600080fd5b5061024261027f366004611444565b610797565b34801561029057600080fd5b506101fc61271081565b3480156102a657600080fd5b50604051601281526020016101b2565b3480156102c257600080fd5b50610242610912565b3480156102d757600080fd5b50600a546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b60405173ffffffffffffffffffffffffffffffffffffffff90911681526020016101b2565b34801561032957600080fd5b50610242610338366004611444565b6109c9565b34801561034957600080fd5b506101fc6103583660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526002602052604090205490565b34801561038c57600080fd5b506101fc600c5481565b3480156103a257600080fd5b50600b546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b3480156103cf57600080fd5b506101fc6103de3660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561041257600080fd5b50610242610421366004611444565b610ab3565b34801561043257600080fd5b506101fc6104413660046114c3565b610c10565b34801561045257600080fd5b506101fc6104613660046114c3565b610c4b565b34801561047257600080fd5b506101a5610c86565b34801561048757600080fd5b50610242610496366004611444565b610c95565b3480156104a757600080fd5b506101db6104b6366004611444565b610d77565b3480156104c757600080fd5b506101fc6104d63660046114e5565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260036020908152604080832093909416825291909152205490565b6101fc610de3565b60606008805461052590611518565b80601f016020809104026020016040519081016040528092919081815260200182805461055190611518565b801561059e5780601f106105735761010080835404028352916020019161059e565b820191906000526020600020905b81548152906001019060200180831161058157829003601f168201915b5050505050905090565b60006105b5338484610fbb565b5060015b92915050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146106515760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e747261637400000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b600c55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600360209081526040808320338452909152812054828110156106fd5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610648565b610711853361070c868561159a565b610fbb565b61071b858461113a565b6107258484611249565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161078491815260200190565b60405180910390a3506001949350505050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146108245760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff821660009081526002602090815260408083205460019092529091205482916108619161159a565b10156108d45760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040812080548392906109099084906115ad565b90915550505050565b600061091d336112a1565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461096f576040519150601f19603f3d011682016040523d82523d6000602084013e610974565b606091505b50509050806109c55760405162461bcd60e51b815260206004820152601460248201527f4661696c656420746f2073656e642045746865720000000000000000000000006044820152606401610648565b5050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610a565760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610a608282611249565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610b405760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040902054811115610bdb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600260205260408120805483929061090990849061159a565b73ffffffffffffffffffffffffffffffffffffffff811660009081526002602090815260408083205460019092528220546105b9919061159a565b6000610c5682611320565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600560205260409020546105b991906115ad565b60606009805461052590611518565b60005473ffffffffffffffffffffffffffffffffffffffff163314610d225760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610d2c828261113a565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610aa7565b6000610d83338361113a565b610d8d8383611249565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6000805473ffffffffffffffffffffffffffffffffffffffff163314610e715760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b600c54349060009061271090610e8790846115c0565b610e9191906115d7565b905081811115610ee35760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f722072657761726400000000000000006044820152606401610648565b610eed818361159a565b600b5460405191935073ffffffffffffffffffffffffffffffffffffffff16906108fc9083906000818181858888f193505050503d8060008114610f4d576040519150601f19603f3d011682016040523d82523d6000602084013e610f52565b606091505b505060045460009150610f69633b9aca00856115c0565b610f7391906115d7565b905080600754610f8391906115ad565b600755600454600090633b9aca0090610f9c90846115c0565b610fa691906115d7565b9050610fb281846115ad565b94505050505090565b73ffffffffffffffffffffffffffffffffffffffff83166110435760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166110cc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b611143826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020908152604080832054600290925290912054611180908261159a565b8211156111cf5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610648565b6111d9828261159a565b73ffffffffffffffffffffffffffffffffffffffff841660009081526001602052604090205580820361122d5773ffffffffffffffffffffffffffffffffffffffff83166000908152600660205260408120555b816004600082825461123f919061159a565b9091555050505050565b611252826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906112889084906115ad565b92505081905550806004600082825461090991906115ad565b6000806112ad83611320565b73ffffffffffffffffffffffffffffffffffffffff84166000908152600560205260409020549091506112e19082906115ad565b73ffffffffffffffffffffffffffffffffffffffff90931660009081526005602090815260408083208690556007546006909252909120555090919050565b73ffffffffffffffffffffffffffffffffffffffff81166000908152600160205260408120548082036113565750600092915050565b73ffffffffffffffffffffffffffffffffffffffff8316600090815260066020526040812054600754611389919061159a565b90506000633b9aca0061139c84846115c0565b6113a691906115d7565b95945050505050565b600060208083528351808285015260005b818110156113dc578581018301518582016040015282016113c0565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff8116811461143f57600080fd5b919050565b6000806040838503121561145757600080fd5b6114608361141b565b946020939093013593505050565b60006020828403121561148057600080fd5b5035919050565b60008060006060848603121561149c57600080fd5b6114a58461141b565b92506114b36020850161141b565b9150604084013590509250925092565b6000602082840312156114d557600080fd5b6114de8261141b565b9392505050565b600080604083850312156114f857600080fd5b6115018361141b565b915061150f6020840161141b565b90509250929050565b600181811c9082168061152c57607f821691505b602082108103611565577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156105b9576105b961156b565b808201808211156105b9576105b961156b565b80820281158282048414176105b9576105b961156b565b60008261160d577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220257272b65cbec4bea28792495543336863e79a2fca1536e6ea5e70c11574e1ec64736f6c63430008150033")
+var AutonityUpgradeTestBytecode = common.Hex2Bytes("60806040526000600b556000600c553480156200001b57600080fd5b50604080516000808252602082019092529062000051565b6200003d62000d4e565b815260200190600190039081620000335790505b506040805161012081018252600d546080808301918252600e5460a080850191909152600f5460c08086019190915260105460e08601526011546001600160a01b039081166101008701529385528551908101865260125484168152601354841660208281019190915260145485168288015260155485166060808401919091526016548616838601526017548616938301939093528086019190915285519283018652601854909316825260195492820192909252601a5481850152601b548183015292820192909252601c54918101829052906000036200014c57602a80546001600160a01b031916331790556200014c828262000169565b5050601c546001036200016357620001636200051f565b6200115e565b80518051600d55602080820151600e55604080830151600f55606080840151601055608093840151601180546001600160a01b03199081166001600160a01b03938416179091558487015180516012805484169185169190911790558086015160138054841691851691909117905580850151601480548416918516919091179055808401516015805484169185169190911790559586015160168054831691841691909117905560a0909501516017805487169183169190911790558286015180516018805490971692169190911790945591830151601955820151601a5590810151601b55810151601c5560005b82518110156200051a5760008382815181106200027a576200027a62000ebd565b602002602001015160a00151905060008483815181106200029f576200029f62000ebd565b60200260200101516101a00181815250506000848381518110620002c757620002c762000ebd565b602002602001015161018001906001600160a01b031690816001600160a01b031681525050600084838151811062000303576200030362000ebd565b602002602001015160a001818152505060008483815181106200032a576200032a62000ebd565b60209081029190910101516101c00152600f54845185908490811062000354576200035462000ebd565b6020026020010151608001818152505060008483815181106200037b576200037b62000ebd565b6020026020010151610260019060038111156200039c576200039c62000ed3565b90816003811115620003b257620003b262000ed3565b815250506000848381518110620003cd57620003cd62000ebd565b60200260200101516101600181815250506200040b848381518110620003f757620003f762000ebd565b60200260200101516200068d60201b60201c565b6200043884838151811062000424576200042462000ebd565b6020026020010151620007c460201b60201c565b806027600086858151811062000452576200045262000ebd565b6020026020010151600001516001600160a01b03166001600160a01b0316815260200190815260200160002060008282546200048f919062000eff565b925050819055508060296000828254620004aa919062000eff565b9250508190555062000504848381518110620004ca57620004ca62000ebd565b60200260200101516020015182868581518110620004ec57620004ec62000ebd565b602002602001015160000151620009f560201b60201c565b5080620005118162000f1b565b91505062000259565b505050565b602a546001600160a01b031633146200058b5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201526218dbdb60ea1b60648201526084015b60405180910390fd5b600260286000601d600181548110620005a857620005a862000ebd565b60009182526020808320909101546001600160a01b0316835282019290925260400181206005018054909190620005e190849062000f4d565b92505081905550600260286000601d60018154811062000605576200060562000ebd565b60009182526020808320909101546001600160a01b03168352820192909252604001812060080180549091906200063e90849062000f4d565b90915550506002601c556018546001600160a01b031660009081526027602052604081206103e8905562000673908062000e1e565b620006816001600062000e1e565b6002805460ff19169055565b6000620006a4826060015162000be060201b60201c565b6001600160a01b03909116602084015290508015620006f45760405162461bcd60e51b815260206004820152600b60248201526a32b737b2329032b93937b960a91b604482015260640162000582565b6020808301516001600160a01b03908116600090815260289092526040909120600101541615620007685760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000582565b61271082608001511115620007c05760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000582565b5050565b6101808101516001600160a01b03166200084757601d54600090620007e99062000c2e565b905081602001518260000151836080015183604051620008099062000e60565b62000818949392919062000f64565b604051809103906000f08015801562000835573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f90910180546001600160a01b03199081166001600160a01b0395861617909155845184168352602890955260409182902086518154871690851617815593519084018054861691841691909117905584015160028301805490941691161790915560608201518291906003820190620008f9908262001065565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c820180546001600160a01b0319166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f820155610200820151601082015561022082015160118201556102408201516012820190620009c3908262001065565b5061026082015160138201805460ff19166001836003811115620009eb57620009eb62000ed3565b0217905550505050565b6000821162000a535760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201526269766560e81b606482015260840162000582565b6001600160a01b03811660009081526027602052604090205482111562000abd5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000582565b6001600160a01b0381166000908152602760205260408120805484929062000ae790849062001131565b9091555050604080516080810182526001600160a01b03808416825285811660208084019182528385018781524360608601908152600580546000908152600394859052978820875181549088166001600160a01b031991821617825595516001820180549190981696169590951790955590516002840155519101558054919262000b738362000f1b565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d910160405180910390a35050505050565b60008062000bed62000e6e565b60008060ff9050604083875160208901845afa62000c0a57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60608160000362000c565750506040805180820190915260018152600360fc1b602082015290565b8160005b811562000c86578062000c6d8162000f1b565b915062000c7e9050600a8362000f4d565b915062000c5a565b6000816001600160401b0381111562000ca35762000ca362000ea7565b6040519080825280601f01601f19166020018201604052801562000cce576020820181803683370190505b5090505b841562000d465762000ce660018362001131565b915062000cf5600a8662001147565b62000d0290603062000eff565b60f81b81838151811062000d1a5762000d1a62000ebd565b60200101906001600160f81b031916908160001a90535062000d3e600a8662000f4d565b945062000cd2565b949350505050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562000e195762000e1962000ed3565b905290565b50805462000e2c9062000fd7565b6000825580601f1062000e3d575050565b601f01602090049060005260206000209081019062000e5d919062000e8c565b50565b611a208062009eee83390190565b60405180604001604052806002906020820280368337509192915050565b5b8082111562000ea3576000815560010162000e8d565b5090565b634e487b7160e01b600052604160045260246000fd5b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b8082018082111562000f155762000f1562000ee9565b92915050565b60006001820162000f305762000f3062000ee9565b5060010190565b634e487b7160e01b600052601260045260246000fd5b60008262000f5f5762000f5f62000f37565b500490565b600060018060a01b038087168352602081871681850152856040850152608060608501528451915081608085015260005b8281101562000fb35785810182015185820160a00152810162000f95565b5050600060a0828501015260a0601f19601f83011684010191505095945050505050565b600181811c9082168062000fec57607f821691505b6020821081036200100d57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200051a57600081815260208120601f850160051c810160208610156200103c5750805b601f850160051c820191505b818110156200105d5782815560010162001048565b505050505050565b81516001600160401b0381111562001081576200108162000ea7565b620010998162001092845462000fd7565b8462001013565b602080601f831160018114620010d15760008415620010b85750858301515b600019600386901b1c1916600185901b1785556200105d565b600085815260208120601f198616915b828110156200110257888601518255948401946001909101908401620010e1565b5085821015620011215787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b8181038181111562000f155762000f1562000ee9565b60008262001159576200115962000f37565b500690565b618d80806200116e6000396000f3fe6080604052600436106200041b5760003560e01c8063872cf059116200021d578063b66b3e791162000131578063d372c07e11620000c1578063da45591b116200008f578063dfb1a4d21162000075578063dfb1a4d21462000de6578063e7f43c681462000dfd578063f7866ee31462000e1d57005b8063da45591b1462000d77578063dd62ed3e1462000d9c57005b8063d372c07e1462000cf3578063d5f394881462000d18578063d861b0e81462000d3a578063d886f8a21462000d5257005b8063cb696f5411620000ff578063cb696f541462000c6c578063ceaad4551462000c91578063cf9c57191462000cb6578063cfd19fb91462000cce57005b8063b66b3e791462000bfc578063b7ab4db51462000c24578063c2362dd51462000c3c578063c9d97af41462000c5457005b8063a5d059ca11620001ad578063ae1f5fa0116200017b578063ae1f5fa01462000b66578063b2ea9adb1462000b8d578063b3ecbadd1462000bb2578063b46e55201462000bd757005b8063a5d059ca1462000ace578063a8b2216e1462000af3578063a9059cbb1462000b1a578063ab8f6ffe1462000b3f57005b80639bb851c011620001eb5780639bb851c01462000a545780639c98e4711462000a6c5780639dc29fac1462000a84578063a515366a1462000aa957005b8063872cf059146200099e5780638bac7dad14620009b657806395d89b4114620009db57806396b477cb1462000a2357005b806339b17fec116200032f5780636fd2c80b11620002bf57806379502c55116200028d578063833b1fce1162000273578063833b1fce146200093457806384467fdb1462000954578063852c4849146200097957005b806379502c55146200083f578063819b6463146200091d57005b80636fd2c80b14620007b257806370a0823114620007c9578063731b3a03146200080357806377e741c7146200081a57005b80634bb278f311620002fd5780634bb278f31462000702578063520fdbbc146200072a5780635f7d3949146200074f5780636b5f444c146200078d57005b806339b17fec146200067c57806340c10f1914620006a15780634364596914620006c6578063496ccd9b14620006dd57005b806318160ddd11620003ab5780632a400bc311620003795780632a400bc314620005fc5780632f2c3f2e1462000621578063313ce567146200063957806335be16e0146200065757005b806318160ddd14620005755780631904bb2e146200058c57806323b872dd14620005c057806329070c6d14620005e557005b80631122063311620003e95780631122063314620004fc578063114eaf5514620005135780631250a28d14620005385780631604e416146200055d57005b806306fdde031462000425578063095ea7b314620004805780630ae65e7a14620004b65780630d8e6e2c14620004db57005b366200042357005b005b3480156200043257600080fd5b5060408051808201909152600681527f4e6577746f6e000000000000000000000000000000000000000000000000000060208201525b60405162000477919062006265565b60405180910390f35b3480156200048d57600080fd5b50620004a56200049f36600462006297565b62000e3d565b604051901515815260200162000477565b348015620004c357600080fd5b5062000423620004d5366004620062c6565b62000e56565b348015620004e857600080fd5b50601c545b60405190815260200162000477565b3480156200050957600080fd5b50600e54620004ed565b3480156200052057600080fd5b506200042362000532366004620062e6565b62000f68565b3480156200054557600080fd5b506200042362000557366004620062c6565b62000fc9565b3480156200056a57600080fd5b50620004ed60245481565b3480156200058257600080fd5b50602954620004ed565b3480156200059957600080fd5b50620005b1620005ab366004620062c6565b62001054565b6040516200047791906200636b565b348015620005cd57600080fd5b50620004a5620005df366004620064e4565b62001331565b348015620005f257600080fd5b50600d54620004ed565b3480156200060957600080fd5b50620004236200061b366004620064e4565b6200138b565b3480156200062e57600080fd5b50620004ed61271081565b3480156200064657600080fd5b506040516012815260200162000477565b3480156200066457600080fd5b5062000423620006763660046200652a565b620014ef565b3480156200068957600080fd5b50620004236200069b366004620064e4565b620016e5565b348015620006ae57600080fd5b5062000423620006c036600462006297565b62001815565b348015620006d357600080fd5b50601a54620004ed565b348015620006ea57600080fd5b5062000423620006fc366004620062c6565b620018fe565b3480156200070f57600080fd5b506200071a62001a76565b60405162000477929190620065eb565b3480156200073757600080fd5b506200042362000749366004620062c6565b62001ef6565b3480156200075c57600080fd5b50620007746200076e36600462006608565b620021aa565b6040516001600160a01b03909116815260200162000477565b3480156200079a57600080fd5b5062000423620007ac366004620062e6565b620023d5565b348015620007bf57600080fd5b50601054620004ed565b348015620007d657600080fd5b50620004ed620007e8366004620062c6565b6001600160a01b031660009081526027602052604090205490565b3480156200081057600080fd5b50602054620004ed565b3480156200082757600080fd5b506200042362000839366004620062e6565b620025a3565b3480156200084c57600080fd5b506040805160a08082018352600d548252600e54602080840191909152600f54838501526010546060808501919091526011546001600160a01b03908116608080870191909152865160c0810188526012548316815260135483168186015260145483168189015260155483168185015260165483168183015260175483169581019590955286519081018752601854909116815260195492810192909252601a5494820194909452601b5493810193909352601c546200090b939084565b6040516200047794939291906200662b565b3480156200092a57600080fd5b50601b54620004ed565b3480156200094157600080fd5b506013546001600160a01b031662000774565b3480156200096157600080fd5b506200042362000973366004620067c7565b62002604565b3480156200098657600080fd5b50620004236200099836600462006297565b62002733565b348015620009ab57600080fd5b506200042362002955565b348015620009c357600080fd5b5062000423620009d5366004620062e6565b620029c0565b348015620009e857600080fd5b5060408051808201909152600381527f4e544e0000000000000000000000000000000000000000000000000000000000602082015262000468565b34801562000a3057600080fd5b50620004ed62000a42366004620062e6565b6000908152601f602052604090205490565b34801562000a6157600080fd5b50620004ed60235481565b34801562000a7957600080fd5b50620004ed60215481565b34801562000a9157600080fd5b506200042362000aa336600462006297565b62002a73565b34801562000ab657600080fd5b506200042362000ac836600462006297565b62002bbf565b34801562000adb57600080fd5b506200042362000aed36600462006297565b62002cc1565b34801562000b0057600080fd5b5062000b0b62002d8f565b60405162000477919062006870565b34801562000b2757600080fd5b50620004a562000b3936600462006297565b62002e72565b34801562000b4c57600080fd5b5062000b5762002e81565b604051620004779190620068f4565b34801562000b7357600080fd5b5062000b7e62002f93565b60405162000477919062006909565b34801562000b9a57600080fd5b506200042362000bac36600462006958565b62003239565b34801562000bbf57600080fd5b506200042362000bd1366004620062c6565b620032af565b34801562000be457600080fd5b506200042362000bf6366004620062c6565b6200333a565b34801562000c0957600080fd5b5062000c14620035fa565b60405162000477929190620069c3565b34801562000c3157600080fd5b5062000b7e62003731565b34801562000c4957600080fd5b50620004ed60205481565b34801562000c6157600080fd5b50620004ed601e5481565b34801562000c7957600080fd5b506200042362000c8b366004620062e6565b62003795565b34801562000c9e57600080fd5b506200042362000cb0366004620062c6565b62003827565b34801562000cc357600080fd5b5062000423620038b2565b34801562000cdb57600080fd5b506200042362000ced366004620062c6565b62003935565b34801562000d0057600080fd5b506200042362000d12366004620062c6565b620039c0565b34801562000d2557600080fd5b50602a5462000774906001600160a01b031681565b34801562000d4757600080fd5b506200042362003a4b565b34801562000d5f57600080fd5b506200042362000d71366004620062c6565b62003ae1565b34801562000d8457600080fd5b506200042362000d96366004620069f5565b62003b6c565b34801562000da957600080fd5b50620004ed62000dbb36600462006a2e565b6001600160a01b03918216600090815260266020908152604080832093909416825291909152205490565b34801562000df357600080fd5b50601954620004ed565b34801562000e0a57600080fd5b506018546001600160a01b031662000774565b34801562000e2a57600080fd5b506011546001600160a01b031662000774565b600062000e4c33848462003cb3565b5060015b92915050565b6001600160a01b038082166000818152602860205260409020600101549091161462000ec95760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d75737420626520726567697374657265640000000060448201526064015b60405180910390fd5b6001600160a01b0381811660009081526028602052604090205416331462000f5a5760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162000ec0565b62000f658162003e10565b50565b6018546001600160a01b0316331462000fc45760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b601055565b6018546001600160a01b03163314620010255760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6012805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6200105e6200603e565b6001600160a01b0380831660008181526028602052604090206001015490911614620010cd5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162000ec0565b6001600160a01b038083166000908152602860209081526040918290208251610280810184528154851681526001820154851692810192909252600281015490931691810191909152600382018054919291606084019190620011309062006a6c565b80601f01602080910402602001604051908101604052809291908181526020018280546200115e9062006a6c565b8015620011af5780601f106200118357610100808354040283529160200191620011af565b820191906000526020600020905b8154815290600101906020018083116200119157829003601f168201915b505050918352505060048201546020820152600582015460408201526006820154606082015260078201546080820152600882015460a0820152600982015460c0820152600a82015460e0820152600b820154610100820152600c8201546001600160a01b0316610120820152600d820154610140820152600e820154610160820152600f82015461018082015260108201546101a082015260118201546101c08201526012820180546101e0909201916200126b9062006a6c565b80601f0160208091040260200160405190810160405280929190818152602001828054620012999062006a6c565b8015620012ea5780601f10620012be57610100808354040283529160200191620012ea565b820191906000526020600020905b815481529060010190602001808311620012cc57829003601f168201915b5050509183525050601382015460209091019060ff16600381111562001314576200131462006300565b600381111562001328576200132862006300565b90525092915050565b60006200134084848462003ee7565b6001600160a01b03841660009081526026602090815260408083203384529091528120546200137190849062006af0565b90506200138085338362003cb3565b506001949350505050565b6018546001600160a01b03163314620013e75760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6001600160a01b0380841660008181526028602052604090206001015490911614620014565760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000ec0565b6001600160a01b0383811660009081526028602052604090819020600c015490517f282d3fdf00000000000000000000000000000000000000000000000000000000815284831660048201526024810184905291169063282d3fdf906044015b600060405180830381600087803b158015620014d157600080fd5b505af1158015620014e6573d6000803e3d6000fd5b50505050505050565b6012546001600160a01b03163314620015715760405162461bcd60e51b815260206004820152602360248201527f63616c6c6572206973206e6f742074686520736c617368696e6720636f6e747260448201527f6163740000000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b60006101208201356028826200158e6040860160208701620062c6565b6001600160a01b03166001600160a01b0316815260200190815260200160002060090154620015be919062006af0565b60c083013560286000620015d96040870160208801620062c6565b6001600160a01b03166001600160a01b031681526020019081526020016000206006015462001609919062006af0565b60a084013560286000620016246040880160208901620062c6565b6001600160a01b03166001600160a01b031681526020019081526020016000206005015462001654919062006af0565b62001660919062006b06565b6200166c919062006b06565b6011546001600160a01b03166000908152602760205260408120805492935083929091906200169d90849062006b06565b9091555082905060286000620016ba6040840160208501620062c6565b6001600160a01b031681526020810191909152604001600020620016df828262006d16565b50505050565b6018546001600160a01b03163314620017415760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6001600160a01b0380841660008181526028602052604090206001015490911614620017b05760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000ec0565b6001600160a01b0383811660009081526028602052604090819020600c015490517f7eee288d000000000000000000000000000000000000000000000000000000008152848316600482015260248101849052911690637eee288d90604401620014b6565b6018546001600160a01b03163314620018715760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6001600160a01b038216600090815260276020526040812080548392906200189b90849062006b06565b925050819055508060296000828254620018b6919062006b06565b90915550506040518181526001600160a01b038316907f48490b4407bb949b708ec5f514b4167f08f4969baaf78d53b05028adf369bfcf906020015b60405180910390a25050565b6018546001600160a01b031633146200195a5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6013805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556014546040517f7adbf973000000000000000000000000000000000000000000000000000000008152600481019290925290911690637adbf97390602401600060405180830381600087803b158015620019df57600080fd5b505af1158015620019f4573d6000803e3d6000fd5b50506016546040517f7adbf9730000000000000000000000000000000000000000000000000000000081526001600160a01b0385811660048301529091169250637adbf97391506024015b600060405180830381600087803b15801562001a5a57600080fd5b505af115801562001a6f573d6000803e3d6000fd5b5050505050565b602a546000906060906001600160a01b0316331462001afe5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b601e54436000818152601f602090815260408220939093556019549254909262001b289162006b06565b6012546040517f6c9789b0000000000000000000000000000000000000000000000000000000008152929091146004830181905292506001600160a01b031690636c9789b090602401600060405180830381600087803b15801562001b8c57600080fd5b505af115801562001ba1573d6000803e3d6000fd5b50505050801562001caf5762001bb662004020565b62001bc062004422565b62001bca62004511565b600062001bd662002f93565b6013546040517f845023f20000000000000000000000000000000000000000000000000000000081529192506001600160a01b03169063845023f29062001c2290849060040162006909565b600060405180830381600087803b15801562001c3d57600080fd5b505af115801562001c52573d6000803e3d6000fd5b50505050436020819055506001601e600082825462001c72919062006b06565b9091555050601e546040519081527febad8099c467528a56c98b63c8d476d251cf1ffb4c75db94b4d23fa2b6a1e3359060200160405180910390a1505b601354604080517f4bb278f300000000000000000000000000000000000000000000000000000000815290516000926001600160a01b031691634bb278f3916004808301926020929190829003018187875af115801562001d14573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062001d3a919062006efb565b9050801562001dcf57601454604080517fa2e6204500000000000000000000000000000000000000000000000000000000815290516001600160a01b039092169163a2e620459160048082019260209290919082900301816000875af192505050801562001dc7575060408051601f3d908101601f1916820190925262001dc49181019062006efb565b60015b1562001dcf57505b600254602280546040805160208084028201810190925282815260ff9094169391839160009084015b8282101562001ee6576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162001e4e9062006a6c565b80601f016020809104026020016040519081016040528092919081815260200182805462001e7c9062006a6c565b801562001ecd5780601f1062001ea15761010080835404028352916020019162001ecd565b820191906000526020600020905b81548152906001019060200180831162001eaf57829003601f168201915b5050505050815250508152602001906001019062001df8565b5050505090509350935050509091565b6018546001600160a01b0316331462001f525760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6018805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b038381169182179092556013546040517fb3ab15fb00000000000000000000000000000000000000000000000000000000815260048101929092529091169063b3ab15fb90602401600060405180830381600087803b15801562001fd757600080fd5b505af115801562001fec573d6000803e3d6000fd5b50506014546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200205157600080fd5b505af115801562002066573d6000803e3d6000fd5b50506015546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b158015620020cb57600080fd5b505af1158015620020e0573d6000803e3d6000fd5b50506016546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb9150602401600060405180830381600087803b1580156200214557600080fd5b505af11580156200215a573d6000803e3d6000fd5b50506017546040517fb3ab15fb0000000000000000000000000000000000000000000000000000000081526001600160a01b038581166004830152909116925063b3ab15fb915060240162001a3f565b600080805b602254811015620022065760228181548110620021d057620021d062006f1f565b90600052602060002090600302016001015482620021ef919062006b06565b915080620021fd8162006f4e565b915050620021af565b5080600003620022595760405162461bcd60e51b815260206004820152601c60248201527f54686520636f6d6d6974746565206973206e6f74207374616b696e6700000000604482015260640162000ec0565b6000836200226960638762006f6b565b62002275919062006b06565b90506000816040516020016200228d91815260200190565b60408051601f19818403018152919052805160209091012090506000620022b5848362006fb4565b90506000805b602254811015620023655760228181548110620022dc57620022dc62006f1f565b90600052602060002090600302016001015482620022fb919062006b06565b91506200230a60018362006af0565b831162002350576022818154811062002327576200232762006f1f565b60009182526020909120600390910201546001600160a01b0316965062000e5095505050505050565b806200235c8162006f4e565b915050620022bb565b5060405162461bcd60e51b815260206004820152602960248201527f5468657265206973206e6f2076616c696461746f72206c65667420696e20746860448201527f65206e6574776f726b0000000000000000000000000000000000000000000000606482015260840162000ec0565b6018546001600160a01b03163314620024315760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b601954811015620024e857806020546200244c919062006b06565b4310620024e85760405162461bcd60e51b815260206004820152605760248201527f63757272656e7420636861696e2068656164206578636565642074686520776960448201527f6e646f773a206c617374426c6f636b45706f6368202b205f6e6577506572696f60648201527f642c2074727920616761696e206c6174746572206f6e2e000000000000000000608482015260a40162000ec0565b60198190556012546040517f6b5f444c000000000000000000000000000000000000000000000000000000008152600481018390526001600160a01b0390911690636b5f444c90602401600060405180830381600087803b1580156200254d57600080fd5b505af115801562002562573d6000803e3d6000fd5b505050507fd7f1279ded354dbf22a69fcc2fd661763a6e2956a5d2891af9410af880fa5f81816040516200259891815260200190565b60405180910390a150565b6018546001600160a01b03163314620025ff5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b600d55565b6000604051806102800160405280336001600160a01b0316815260200160006001600160a01b03168152602001856001600160a01b03168152602001868152602001600d6000016002015481526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200143815260200160008152602001600081526020016000815260200184815260200160006003811115620026d357620026d362006300565b90529050620026e3818362004656565b60208101516101808201516040517f8ad8bd2eb6950e5f332fd3a6dca48cb358ecfe3057848902b98cbdfe455c915c926200272492339289918b9162006fcb565b60405180910390a15050505050565b6001600160a01b0380831660008181526028602052604090206001015490911614620027a25760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000ec0565b6001600160a01b03828116600090815260286020526040902054163314620028335760405162461bcd60e51b815260206004820152602c60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72206160448201527f646d696e206163636f756e740000000000000000000000000000000000000000606482015260840162000ec0565b612710811115620028875760405162461bcd60e51b815260206004820152601f60248201527f7265717569726520636f727265637420636f6d6d697373696f6e207261746500604482015260640162000ec0565b604080516060810182526001600160a01b038481168252436020808401918252838501868152600c80546000908152600a9093529582208551815473ffffffffffffffffffffffffffffffffffffffff19169516949094178455915160018085019190915591516002909301929092558354929390929091906200290d90849062006b06565b90915550506040518281526001600160a01b038416907f4fba51c92fa3d6ad8374d394f6cd5766857552e153d7384a8f23aa4ce9a8a7cf9060200160405180910390a2505050565b6018546001600160a01b03163314620029b15760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6002805460ff19166001179055565b6018546001600160a01b0316331462002a1c5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6000811162002a6e5760405162461bcd60e51b815260206004820152601960248201527f636f6d6d69747465652073697a652063616e2774206265203000000000000000604482015260640162000ec0565b601b55565b6018546001600160a01b0316331462002acf5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6001600160a01b03821660009081526027602052604090205481111562002b395760405162461bcd60e51b815260206004820152601660248201527f416d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162000ec0565b6001600160a01b0382166000908152602760205260408120805483929062002b6390849062006af0565b92505081905550806029600082825462002b7e919062006af0565b90915550506040518181526001600160a01b038316907f5024dbeedf0c06664c9bd7be836915730c955e936972c020683dadf11d5488a390602001620018f2565b6001600160a01b038083166000818152602860205260409020600101549091161462002c2e5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162000ec0565b6001600160a01b03821660009081526028602052604081206013015460ff16600381111562002c615762002c6162006300565b1462002cb05760405162461bcd60e51b815260206004820152601b60248201527f76616c696461746f72206e65656420746f206265206163746976650000000000604482015260640162000ec0565b62002cbd82823362004af9565b5050565b6001600160a01b038083166000818152602860205260409020600101549091161462002d305760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206e6f7420726567697374657265640000000000000000604482015260640162000ec0565b6000811162002d825760405162461bcd60e51b815260206004820152601560248201527f756e626f6e64696e6720616d6f756e7420697320300000000000000000000000604482015260640162000ec0565b62002cbd82823362004d0c565b60606025805480602002602001604051908101604052809291908181526020016000905b8282101562002e6957838290600052602060002001805462002dd59062006a6c565b80601f016020809104026020016040519081016040528092919081815260200182805462002e039062006a6c565b801562002e545780601f1062002e285761010080835404028352916020019162002e54565b820191906000526020600020905b81548152906001019060200180831162002e3657829003601f168201915b50505050508152602001906001019062002db3565b50505050905090565b600062000e4c33848462003ee7565b60606022805480602002602001604051908101604052809291908181526020016000905b8282101562002e69576000848152602090819020604080516060810182526003860290920180546001600160a01b031683526001810154938301939093526002830180549293929184019162002efb9062006a6c565b80601f016020809104026020016040519081016040528092919081815260200182805462002f299062006a6c565b801562002f7a5780601f1062002f4e5761010080835404028352916020019162002f7a565b820191906000526020600020905b81548152906001019060200180831162002f5c57829003601f168201915b5050505050815250508152602001906001019062002ea5565b602a546060906001600160a01b03163314620030185760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b601d54620030695760405162461bcd60e51b815260206004820152601860248201527f5468657265206d7573742062652076616c696461746f72730000000000000000604482015260640162000ec0565b620030736200610e565b601b546080820152601d81526028602082015260226040820152602160608201526200309f81620050e0565b620030ad602560006200612c565b60225480620030ff5760405162461bcd60e51b815260206004820152601260248201527f636f6d6d697474656520697320656d7074790000000000000000000000000000604482015260640162000ec0565b60008167ffffffffffffffff8111156200311d576200311d62006703565b60405190808252806020026020018201604052801562003147578160200160208202803683370190505b50905060005b8281101562003231576000602860006022848154811062003172576200317262006f1f565b60009182526020808320600392830201546001600160a01b031684528301939093526040909101812060258054600181018255925292507f401968ff42a154441da5f6c4c935ac46b8671f0e062baaa62a7545ba53bb6e4c0190620031da9083018262007015565b50600281015483516001600160a01b039091169084908490811062003203576200320362006f1f565b6001600160a01b03909216602092830291909101909101525080620032288162006f4e565b9150506200314d565b509250505090565b6018546001600160a01b03163314620032955760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b620032a2600083620050fb565b62002cbd600182620050fb565b6018546001600160a01b031633146200330b5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6015805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6001600160a01b0380821660008181526028602052604090206001015490911614620033a95760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000ec0565b6001600160a01b038082166000908152602860205260409020805490911633146200343d5760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162000ec0565b6000601382015460ff1660038111156200345b576200345b62006300565b03620034aa5760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f7220616c7265616479206163746976650000000000000000604482015260640162000ec0565b6002601382015460ff166003811115620034c857620034c862006300565b148015620034d95750438160100154115b15620035285760405162461bcd60e51b815260206004820152601760248201527f76616c696461746f72207374696c6c20696e206a61696c000000000000000000604482015260640162000ec0565b6003601382015460ff16600381111562003546576200354662006300565b03620035955760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206a61696c6564207065726d616e656e746c7900000000604482015260640162000ec0565b60138101805460ff1916905580546019546020546001600160a01b038581169316917f60fcbf2d07dc712a93e59fb28f1edb626d7c2497c57ba71a8c0b3999ecb9a3b591620035e5919062006b06565b60405190815260200160405180910390a35050565b606080600060018180546200360f9062006a6c565b80601f01602080910402602001604051908101604052809291908181526020018280546200363d9062006a6c565b80156200368e5780601f1062003662576101008083540402835291602001916200368e565b820191906000526020600020905b8154815290600101906020018083116200367057829003601f168201915b50505050509150808054620036a39062006a6c565b80601f0160208091040260200160405190810160405280929190818152602001828054620036d19062006a6c565b8015620037225780601f10620036f65761010080835404028352916020019162003722565b820191906000526020600020905b8154815290600101906020018083116200370457829003601f168201915b50505050509050915091509091565b6060601d8054806020026020016040519081016040528092919081815260200182805480156200378b57602002820191906000526020600020905b81546001600160a01b031681526001909101906020018083116200376c575b5050505050905090565b6018546001600160a01b03163314620037f15760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b600e8190556040518181527f1f4d2fc7529047a5bd96d3229bfea127fd18b7748f13586e097c69fccd3891289060200162002598565b6018546001600160a01b03163314620038835760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6017805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6018546001600160a01b031633146200390e5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6200391b6000806200614c565b62003929600160006200614c565b6002805460ff19169055565b6018546001600160a01b03163314620039915760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6016805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b6018546001600160a01b0316331462003a1c5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6014805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b602a546001600160a01b0316331462003acd5760405162461bcd60e51b815260206004820152602360248201527f66756e6374696f6e207265737472696374656420746f207468652070726f746f60448201527f636f6c0000000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b62003ad762004422565b62000f6562002f93565b6018546001600160a01b0316331462003b3d5760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f7420746865206f70657261746f72000000000000604482015260640162000ec0565b6011805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0392909216919091179055565b600062003b79826200524d565b6001600160a01b0380821660008181526028602052604090206001015492935091161462003bea5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f72206d757374206265207265676973746572656400000000604482015260640162000ec0565b6001600160a01b0381811660009081526028602052604090205416331462003c7b5760405162461bcd60e51b815260206004820152602f60248201527f726571756972652063616c6c657220746f2062652076616c696461746f72207460448201527f72656173757279206163636f756e740000000000000000000000000000000000606482015260840162000ec0565b6040516001600160a01b038216907f68d46f7ed6d57eea4fc550f4783b3f528ef5f858f9e78b9a7728b1bef0a1b92f90600090a25050565b6001600160a01b03831662003d305760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f7265737300000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b6001600160a01b03821662003dae5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f7373000000000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b6001600160a01b0383811660008181526026602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92591015b60405180910390a3505050565b6001600160a01b038116600090815260286020526040812090601382015460ff16600381111562003e455762003e4562006300565b1462003e945760405162461bcd60e51b815260206004820152601860248201527f76616c696461746f72206d757374206265206163746976650000000000000000604482015260640162000ec0565b60138101805460ff1916600117905580546019546020546001600160a01b038581169316917f75bdcdbe540758778e669d108fbcb7ede734f27f46e4e5525eeb8ecf91849a9c91620035e5919062006b06565b6001600160a01b03831660009081526027602052604090205481111562003f515760405162461bcd60e51b815260206004820152601660248201527f616d6f756e7420657863656564732062616c616e636500000000000000000000604482015260640162000ec0565b6001600160a01b0383166000908152602760205260408120805483929062003f7b90849062006af0565b92505081905550806029600082825462003f96919062006b06565b9091555062003fa9905081600262006f6b565b6001600160a01b0383166000908152602760205260408120805490919062003fd390849062006b06565b92505081905550816001600160a01b0316836001600160a01b03167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8360405162003e0391815260200190565b476000036200402b57565b600d544790600090670de0b6b3a7640000906200404a90849062006f6b565b62004056919062007102565b90508015620040d4576011546040516000916001600160a01b03169083908381818185875af1925050503d8060008114620040ae576040519150601f19603f3d011682016040523d82523d6000602084013e620040b3565b606091505b5090915050801515600103620040d257620040cf828462006af0565b92505b505b8160236000828254620040e8919062006b06565b90915550600090505b6022548110156200441d576000602860006022848154811062004118576200411862006f1f565b600091825260208083206003909202909101546001600160a01b0316835282019290925260400181206021546022805492945090918791908690811062004163576200416362006f1f565b90600052602060002090600302016001015462004181919062006f6b565b6200418d919062007102565b9050801562004405576002601383015460ff166003811115620041b457620041b462006300565b1480620041dc57506003601383015460ff166003811115620041da57620041da62006300565b145b156200429c57601254602280546001600160a01b0390921691631de9d9b69184918790811062004210576200421062006f1f565b600091825260209091206003909102015460405160e084901b7fffffffff000000000000000000000000000000000000000000000000000000001681526001600160a01b0390911660048201526024016000604051808303818588803b1580156200427a57600080fd5b505af11580156200428f573d6000803e3d6000fd5b5050505050505062004408565b60008260050154828460080154620042b5919062006f6b565b620042c1919062007102565b90506000620042d1828462006af0565b90508115620043365783546040516001600160a01b03909116906108fc9084906000818181858888f193505050503d80600081146200432d576040519150601f19603f3d011682016040523d82523d6000602084013e62004332565b606091505b5050505b8015620043be5783600c0160009054906101000a90046001600160a01b03166001600160a01b031663fb489a7b826040518263ffffffff1660e01b815260040160206040518083038185885af115801562004395573d6000803e3d6000fd5b50505050506040513d601f19601f82011682018060405250810190620043bc919062007119565b505b60018401546040518481526001600160a01b03909116907fb3b7a071186534c03b40695710096f289fd4ed6c1a374aff0bb648955e4fe5639060200160405180910390a250505b50505b80620044148162006f4e565b915050620040f1565b505050565b6004545b6005548110156200444f576200444981620044418162006f4e565b92506200527f565b62004426565b50600554600455600854600754036200446457565b6009545b60085481101562004491576200448b81620044838162006f4e565b9250620054bf565b62004468565b50600854600955600754805b6008548110156200450b576010546000828152600660205260409020600401544391620044ca9162006b06565b11620044f057620044db81620057ec565b620044e860018362006b06565b9150620044f6565b6200450b565b80620045028162006f4e565b9150506200449d565b50600755565b600c54600b5410156200465457600b546000908152600a6020526040902060105460018201544391620045449162006b06565b11156200454e5750565b600281015481546001600160a01b03908116600090815260286020526040808220600490810185905585548416835291819020600c015490517f19fac8fd0000000000000000000000000000000000000000000000000000000081529216926319fac8fd92620045c2920190815260200190565b600060405180830381600087803b158015620045dd57600080fd5b505af1158015620045f2573d6000803e3d6000fd5b5050600b80546000908152600a60205260408120805473ffffffffffffffffffffffffffffffffffffffff19168155600180820183905560029091018290558254909450919250906200464790849062006b06565b9091555062004511915050565b565b60e2815114620046a95760405162461bcd60e51b815260206004820152601460248201527f496e76616c69642070726f6f66206c656e677468000000000000000000000000604482015260640162000ec0565b60308261024001515114620047015760405162461bcd60e51b815260206004820152601c60248201527f496e76616c696420636f6e73656e737573206b6579206c656e67746800000000604482015260640162000ec0565b6200470c8262005937565b604080518082018252601a81527f19457468657265756d205369676e6564204d6573736167653a0a00000000000060208083019190915284519251919260009262004782920160609190911b7fffffffffffffffffffffffffffffffffffffffff00000000000000000000000016815260140190565b6040516020818303038152906040529050600082620047a2835162005a76565b83604051602001620047b79392919062007133565b60408051601f19818403018152828252805160209182012060028085526060850184529094506000939290918301908036833701905050905060008080806200480f8982620048096041600262006f6b565b62005bcb565b905060006200482e8a620048266041600262006f6b565b606062005bcb565b905060205b8251811015620048ff5762004849838262005cff565b6040805160008152602081018083528d905260ff8316918101919091526060810184905260808101839052929850909650945060019060a0016020604051602081039080840390855afa158015620048a5573d6000803e3d6000fd5b5050604051601f190151905087620048bf60418462007102565b81518110620048d257620048d262006f1f565b6001600160a01b0390921660209283029190910190910152620048f760418262006b06565b905062004833565b508a602001516001600160a01b03168660008151811062004924576200492462006f1f565b60200260200101516001600160a01b031614620049aa5760405162461bcd60e51b815260206004820152602960248201527f496e76616c6964206e6f6465206b6579206f776e6572736869702070726f6f6660448201527f2070726f76696465640000000000000000000000000000000000000000000000606482015260840162000ec0565b8a604001516001600160a01b031686600181518110620049ce57620049ce62006f1f565b60200260200101516001600160a01b03161462004a545760405162461bcd60e51b815260206004820152602b60248201527f496e76616c6964206f7261636c65206b6579206f776e6572736869702070726f60448201527f6f662070726f7669646564000000000000000000000000000000000000000000606482015260840162000ec0565b600162004a6c8c6102400151838e6000015162005d36565b1462004ae15760405162461bcd60e51b815260206004820152603660248201527f496e76616c696420636f6e73656e737573206b6579206f776e6572736869702060448201527f70726f6f6620666f7220726567697374726174696f6e00000000000000000000606482015260840162000ec0565b62004aec8b62005da5565b5050505050505050505050565b6000821162004b715760405162461bcd60e51b815260206004820152602360248201527f616d6f756e74206e65656420746f206265207374726963746c7920706f73697460448201527f6976650000000000000000000000000000000000000000000000000000000000606482015260840162000ec0565b6001600160a01b03811660009081526027602052604090205482111562004bdb5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e74204e6577746f6e2062616c616e63650000000000604482015260640162000ec0565b6001600160a01b0381166000908152602760205260408120805484929062004c0590849062006af0565b9091555050604080516080810182526001600160a01b038084168252858116602080840191825283850187815243606086019081526005805460009081526003948590529788208751815490881673ffffffffffffffffffffffffffffffffffffffff1991821617825595516001820180549190981696169590951790955590516002840155519101558054919262004c9e8362006f4e565b90915550506001600160a01b03848116600081815260286020908152604091829020548251908516948716948514808252918101889052909392917fc46aaee12f38035617ad448c04a7956119f7c7ed395ecc347b898817451ddb8d91015b60405180910390a35050505050565b6001600160a01b038084166000908152602860205260409020805490918381169116148062004ec657600c8201546040517f84955c880000000000000000000000000000000000000000000000000000000081526001600160a01b03858116600483015260009216906384955c8890602401602060405180830381865afa15801562004d9c573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019062004dc2919062007119565b90508481101562004e3c5760405162461bcd60e51b815260206004820152602b60248201527f696e73756666696369656e7420756e6c6f636b6564204c6971756964204e657760448201527f746f6e2062616c616e6365000000000000000000000000000000000000000000606482015260840162000ec0565b600c8301546040517f282d3fdf0000000000000000000000000000000000000000000000000000000081526001600160a01b038681166004830152602482018890529091169063282d3fdf90604401600060405180830381600087803b15801562004ea657600080fd5b505af115801562004ebb573d6000803e3d6000fd5b505050505062004f6f565b8382600b0154836008015462004edd919062006af0565b101562004f535760405162461bcd60e51b815260206004820152602760248201527f696e73756666696369656e742073656c6620626f6e646564206e6577746f6e2060448201527f62616c616e636500000000000000000000000000000000000000000000000000606482015260840162000ec0565b8382600b01600082825462004f69919062006b06565b90915550505b6040805160e0810182526001600160a01b0380861682528781166020808401918252838501898152600060608601818152436080880190815260a088018381528a151560c08a0190815260088054865260069097529984209851895490891673ffffffffffffffffffffffffffffffffffffffff19918216178a55965160018a0180549190991697169690961790965591516002870155905160038601559251600485015590516005909301805494511515610100027fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff00ff941515949094167fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff000090951694909417929092179092558054916200508a8362006f4e565b9190505550826001600160a01b0316856001600160a01b03167f63f8870909f7c59c9c4932bf98dbd491647c8d2e89ca0a032aacdd943a13e2fc838760405162004cfd9291909115158252602082015260400190565b60fa60a06000808285855af46200441d573d6000803e3d6000fd5b815460026001808316156101000203821604825180820160208110602084100160028114620051aa5760018114620051d0578660005260208404602060002001600160028402018855602085068060200390508088018589016001836101000a0392508282511684540184556001840193506020820191505b8082101562005193578151845560018401935060208201915062005174565b815191036101000a908190040290915550620014e6565b60028302826020036101000a846020036101000a602089015104020185018755620014e6565b8660005260208404602060002001600160028402018855846020038088018589016001836101000a0392508282511660ff198a160184556020820191506001840193505b8082101562005233578151845560018401935060208201915062005214565b815191036101000a90819004029091555050505050505050565b6000620052596200618b565b600060f890506020828551602087016000855af16200527757600080fd5b505192915050565b600081815260036020908152604080832060018101546001600160a01b03168452602890925282209091601382015460ff166003811115620052c557620052c562006300565b146200536557600282015482546001600160a01b031660009081526027602052604081208054909190620052fb90849062006b06565b909155505081546001830154600284015460138401546040517f1ff2b052afa4bb37ce30d9aaccde416a700b97e632d089111749af937f8783429462005358946001600160a01b0391821694911692909160ff909116906200717c565b60405180910390a1505050565b805482546001600160a01b039081169116146200547b576000808260080154836005015462005395919062006af0565b905080600003620053ad5783600201549150620053d3565b80846002015484600d0154620053c4919062006f6b565b620053d0919062007102565b91505b600c83015484546040517f40c10f190000000000000000000000000000000000000000000000000000000081526001600160a01b039182166004820152602481018590529116906340c10f1990604401600060405180830381600087803b1580156200543e57600080fd5b505af115801562005453573d6000803e3d6000fd5b505050508183600d0160008282546200546d919062006b06565b909155506200549b92505050565b816002015481600801600082825462005495919062006b06565b90915550505b8160020154816005016000828254620054b5919062006b06565b9091555050505050565b600081815260066020908152604080832060018101546001600160a01b0316845260289092528220600582015491929091610100900460ff16620056e5576002830154600c83015484546040517f7eee288d0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690637eee288d90604401600060405180830381600087803b1580156200556d57600080fd5b505af115801562005582573d6000803e3d6000fd5b50505050600c83015484546040517f9dc29fac0000000000000000000000000000000000000000000000000000000081526001600160a01b03918216600482015260248101849052911690639dc29fac90604401600060405180830381600087803b158015620055f157600080fd5b505af115801562005606573d6000803e3d6000fd5b5050505060008360080154846005015462005622919062006af0565b600d85015490915062005636828462006f6b565b62005642919062007102565b92508184600d0160008282546200565a919062006af0565b90915550506006840154600003620056795760038501839055620056a1565b600684015460078501546200568f908562006f6b565b6200569b919062007102565b60038601555b82846006016000828254620056b7919062006b06565b90915550506003850154600785018054600090620056d790849062006b06565b90915550620057ba92505050565b5060028201546008820154811115620056ff575060088101545b816009015460000362005719576003830181905562005741565b6009820154600a8301546200572f908362006f6b565b6200573b919062007102565b60038401555b8082600901600082825462005757919062006b06565b90915550506003830154600a830180546000906200577790849062006b06565b925050819055508082600801600082825462005794919062006af0565b90915550506002830154600b83018054600090620057b490849062006af0565b90915550505b6005808401805460ff1916600117905582018054829190600090620057e190849062006af0565b909155505050505050565b600081815260066020526040812060038101549091036200580b575050565b60018101546001600160a01b031660009081526028602052604081206005830154909190610100900460ff16620058a75781600701548260060154846003015462005857919062006f6b565b62005863919062007102565b9050808260060160008282546200587b919062006af0565b909155505060038301546007830180546000906200589b90849062006af0565b909155506200590c9050565b81600a015482600901548460030154620058c2919062006f6b565b620058ce919062007102565b905080826009016000828254620058e6919062006af0565b90915550506003830154600a830180546000906200590690849062006af0565b90915550505b82546001600160a01b031660009081526027602052604081208054839290620057e190849062006b06565b600062005948826060015162005ff0565b6001600160a01b03909116602084015290508015620059aa5760405162461bcd60e51b815260206004820152600b60248201527f656e6f6465206572726f72000000000000000000000000000000000000000000604482015260640162000ec0565b6020808301516001600160a01b0390811660009081526028909252604090912060010154161562005a1e5760405162461bcd60e51b815260206004820152601c60248201527f76616c696461746f7220616c7265616479207265676973746572656400000000604482015260640162000ec0565b6127108260800151111562002cbd5760405162461bcd60e51b815260206004820152601760248201527f696e76616c696420636f6d6d697373696f6e2072617465000000000000000000604482015260640162000ec0565b60608160000362005aba57505060408051808201909152600181527f3000000000000000000000000000000000000000000000000000000000000000602082015290565b8160005b811562005aea578062005ad18162006f4e565b915062005ae29050600a8362007102565b915062005abe565b60008167ffffffffffffffff81111562005b085762005b0862006703565b6040519080825280601f01601f19166020018201604052801562005b33576020820181803683370190505b5090505b841562005bc35762005b4b60018362006af0565b915062005b5a600a8662006fb4565b62005b6790603062006b06565b60f81b81838151811062005b7f5762005b7f62006f1f565b60200101907effffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff1916908160001a90535062005bbb600a8662007102565b945062005b37565b949350505050565b60608162005bdb81601f62006b06565b101562005c2b5760405162461bcd60e51b815260206004820152600e60248201527f736c6963655f6f766572666c6f77000000000000000000000000000000000000604482015260640162000ec0565b62005c37828462006b06565b8451101562005c895760405162461bcd60e51b815260206004820152601160248201527f736c6963655f6f75744f66426f756e6473000000000000000000000000000000604482015260640162000ec0565b60608215801562005caa576040519150600082526020820160405262005cf6565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101562005ce557805183526020928301920162005ccb565b5050858452601f01601f1916604052505b50949350505050565b8181018051602082015160409092015190919060001a601b81101562005d2f5762005d2c601b82620071aa565b90505b9250925092565b600062005d426200618b565b600085858560405160200162005d5b93929190620071c6565b6040516020818303038152906040529050600060fb905060008251602062005d84919062006b06565b90506020848285855afa62005d9857600080fd5b5050905195945050505050565b6101808101516001600160a01b031662005e2857601d5460009062005dca9062005a76565b90508160200151826000015183608001518360405162005dea90620061a9565b62005df9949392919062007228565b604051809103906000f08015801562005e16573d6000803e3d6000fd5b506001600160a01b0316610180830152505b60208181018051601d80546001808201835560009283527f6d4407e7be21f808e6509aa9fa9143369579dd7d760fe20a2c09680fc146134f909101805473ffffffffffffffffffffffffffffffffffffffff199081166001600160a01b039586161790915584518416835260289095526040918290208651815487169085161781559351908401805486169184169190911790558401516002830180549094169116179091556060820151829190600382019062005ee7908262007266565b506080820151600482015560a0820151600582015560c0820151600682015560e0820151600782015561010082015160088201556101208201516009820155610140820151600a820155610160820151600b820155610180820151600c8201805473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b039092169190911790556101a0820151600d8201556101c0820151600e8201556101e0820151600f82015561020082015160108201556102208201516011820155610240820151601282019062005fbe908262007266565b5061026082015160138201805460ff1916600183600381111562005fe65762005fe662006300565b0217905550505050565b60008062005ffd620061b7565b60008060ff9050604083875160208901845afa6200601a57600080fd5b505080516020909101516c0100000000000000000000000090910494909350915050565b60405180610280016040528060006001600160a01b0316815260200160006001600160a01b0316815260200160006001600160a01b0316815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160008152602001600081526020016000815260200160006001600160a01b031681526020016000815260200160008152602001600081526020016000815260200160008152602001606081526020016000600381111562006109576200610962006300565b905290565b6040518060a001604052806005906020820280368337509192915050565b508054600082559060005260206000209081019062000f659190620061d5565b5080546200615a9062006a6c565b6000825580601f106200616b575050565b601f01602090049060005260206000209081019062000f659190620061fa565b60405180602001604052806001906020820280368337509192915050565b611a20806200732b83390190565b60405180604001604052806002906020820280368337509192915050565b80821115620061f6576000620061ec82826200614c565b50600101620061d5565b5090565b5b80821115620061f65760008155600101620061fb565b60005b838110156200622e57818101518382015260200162006214565b50506000910152565b600081518084526200625181602086016020860162006211565b601f01601f19169290920160200192915050565b6020815260006200627a602083018462006237565b9392505050565b6001600160a01b038116811462000f6557600080fd5b60008060408385031215620062ab57600080fd5b8235620062b88162006281565b946020939093013593505050565b600060208284031215620062d957600080fd5b81356200627a8162006281565b600060208284031215620062f957600080fd5b5035919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b6004811062006367577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b9052565b60208152620063866020820183516001600160a01b03169052565b60006020830151620063a360408401826001600160a01b03169052565b5060408301516001600160a01b0381166060840152506060830151610280806080850152620063d76102a085018362006237565b9150608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506101408181870152808701519150506101608181870152808701519150506101808181870152808701519150506101a06200645f818701836001600160a01b03169052565b8601516101c0868101919091528601516101e080870191909152860151610200808701919091528601516102208087019190915286015161024080870191909152860151858403601f190161026080880191909152909150620064c3848362006237565b935080870151915050620064da828601826200632f565b5090949350505050565b600080600060608486031215620064fa57600080fd5b8335620065078162006281565b92506020840135620065198162006281565b929592945050506040919091013590565b6000602082840312156200653d57600080fd5b813567ffffffffffffffff8111156200655557600080fd5b820161028081850312156200627a57600080fd5b600081518084526020808501808196508360051b8101915082860160005b85811015620065de578284038952815180516001600160a01b031685528581015186860152604090810151606091860182905290620065c98187018362006237565b9a87019a955050509084019060010162006587565b5091979650505050505050565b821515815260406020820152600062005bc3604083018462006569565b600080604083850312156200661c57600080fd5b50508035926020909101359150565b6000610200820190508551825260208601516020830152604086015160408301526060860151606083015260808601516001600160a01b0380821660808501528087511660a08501528060208801511660c08501528060408801511660e08501528060608801511661010085015280608088015116610120850152505060a0850151620066c46101408401826001600160a01b03169052565b5083516001600160a01b0316610160830152602084015161018083015260408401516101a08301526060909301516101c08201526101e0015292915050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b600082601f8301126200674457600080fd5b813567ffffffffffffffff8082111562006762576200676262006703565b604051601f8301601f19908116603f011681019082821181831017156200678d576200678d62006703565b81604052838152866020858801011115620067a757600080fd5b836020870160208301376000602085830101528094505050505092915050565b60008060008060808587031215620067de57600080fd5b843567ffffffffffffffff80821115620067f757600080fd5b620068058883890162006732565b955060208701359150620068198262006281565b909350604086013590808211156200683057600080fd5b6200683e8883890162006732565b935060608701359150808211156200685557600080fd5b50620068648782880162006732565b91505092959194509250565b6000602080830181845280855180835260408601915060408160051b870101925083870160005b82811015620068e7577fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffc0888603018452620068d485835162006237565b9450928501929085019060010162006897565b5092979650505050505050565b6020815260006200627a602083018462006569565b6020808252825182820181905260009190848201906040850190845b818110156200694c5783516001600160a01b03168352928401929184019160010162006925565b50909695505050505050565b600080604083850312156200696c57600080fd5b823567ffffffffffffffff808211156200698557600080fd5b620069938683870162006732565b93506020850135915080821115620069aa57600080fd5b50620069b98582860162006732565b9150509250929050565b604081526000620069d8604083018562006237565b8281036020840152620069ec818562006237565b95945050505050565b60006020828403121562006a0857600080fd5b813567ffffffffffffffff81111562006a2057600080fd5b62005bc38482850162006732565b6000806040838503121562006a4257600080fd5b823562006a4f8162006281565b9150602083013562006a618162006281565b809150509250929050565b600181811c9082168062006a8157607f821691505b60208210810362006abb577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111562000e505762000e5062006ac1565b8082018082111562000e505762000e5062006ac1565b6000813562000e508162006281565b60008083357fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe184360301811262006b6157600080fd5b83018035915067ffffffffffffffff82111562006b7d57600080fd5b60200191503681900382131562006b9357600080fd5b9250929050565b601f8211156200441d57600081815260208120601f850160051c8101602086101562006bc35750805b601f850160051c820191505b8181101562006be45782815560010162006bcf565b505050505050565b67ffffffffffffffff83111562006c075762006c0762006703565b62006c1f8362006c18835462006a6c565b8362006b9a565b6000601f84116001811462006c56576000851562006c3d5750838201355b600019600387901b1c1916600186901b17835562001a6f565b600083815260209020601f19861690835b8281101562006c89578685013582556020948501946001909201910162006c67565b508682101562006ca75760001960f88860031b161c19848701351681555b505060018560011b0183555050505050565b600081356004811062000e5057600080fd5b6004821062006d03577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b60ff1981541660ff831681178255505050565b62006d4f62006d258362006b1c565b825473ffffffffffffffffffffffffffffffffffffffff19166001600160a01b0391909116178255565b62006d8f62006d616020840162006b1c565b600183016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b62006dcf62006da16040840162006b1c565b600283016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b62006dde606083018362006b2b565b62006dee81836003860162006bec565b50506080820135600482015560a0820135600582015560c0820135600682015560e0820135600782015561010082013560088201556101208201356009820155610140820135600a820155610160820135600b82015562006e8562006e57610180840162006b1c565b600c83016001600160a01b03821673ffffffffffffffffffffffffffffffffffffffff198254161781555050565b6101a0820135600d8201556101c0820135600e8201556101e0820135600f8201556102008201356010820155610220820135601182015562006ecc61024083018362006b2b565b62006edc81836012860162006bec565b505062002cbd62006ef1610260840162006cb9565b6013830162006ccb565b60006020828403121562006f0e57600080fd5b815180151581146200627a57600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b6000600019820362006f645762006f6462006ac1565b5060010190565b808202811582820484141762000e505762000e5062006ac1565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b60008262006fc65762006fc662006f85565b500690565b60006001600160a01b0380881683528087166020840152808616604084015260a060608401526200700060a084018662006237565b91508084166080840152509695505050505050565b81810362007021575050565b6200702d825462006a6c565b67ffffffffffffffff81111562007048576200704862006703565b620070608162007059845462006a6c565b8462006b9a565b6000601f8211600181146200709757600083156200707e5750848201545b600019600385901b1c1916600184901b17845562001a6f565b600085815260209020601f19841690600086815260209020845b83811015620070d35782860154825560019586019590910190602001620070b1565b5085831015620070f25781850154600019600388901b60f8161c191681555b5050505050600190811b01905550565b60008262007114576200711462006f85565b500490565b6000602082840312156200712c57600080fd5b5051919050565b600084516200714781846020890162006211565b8451908301906200715d81836020890162006211565b84519101906200717281836020880162006211565b0195945050505050565b6001600160a01b038581168252841660208201526040810183905260808101620069ec60608301846200632f565b60ff818116838216019081111562000e505762000e5062006ac1565b60008451620071da81846020890162006211565b845190830190620071f081836020890162006211565b60609490941b7fffffffffffffffffffffffffffffffffffffffff000000000000000000000000169301928352505060140192915050565b60006001600160a01b038087168352808616602084015250836040830152608060608301526200725c608083018462006237565b9695505050505050565b815167ffffffffffffffff81111562007283576200728362006703565b620072948162007059845462006a6c565b602080601f831160018114620072cc5760008415620072b35750858301515b600019600386901b1c1916600185901b17855562006be4565b600085815260208120601f198616915b82811015620072fd57888601518255948401946001909101908401620072dc565b5085821015620070f257939096015160001960f8600387901b161c19169092555050600190811b0190555056fe60806040523480156200001157600080fd5b5060405162001a2038038062001a20833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61164880620003d86000396000f3fe60806040526004361061018b5760003560e01c806359355736116100d6578063949813b81161007f578063a9059cbb11610059578063a9059cbb1461049b578063dd62ed3e146104bb578063fb489a7b1461050e57600080fd5b8063949813b81461044657806395d89b41146104665780639dc29fac1461047b57600080fd5b806370a08231116100b057806370a08231146103c35780637eee288d1461040657806384955c881461042657600080fd5b8063593557361461033d5780635ea1d6f81461038057806361d027b31461039657600080fd5b8063282d3fdf11610138578063372500ab11610112578063372500ab146102b65780633a5381b5146102cb57806340c10f191461031d57600080fd5b8063282d3fdf146102645780632f2c3f2e14610284578063313ce5671461029a57600080fd5b8063187cf4d711610169578063187cf4d71461020a57806319fac8fd1461022257806323b872dd1461024457600080fd5b806306fdde0314610190578063095ea7b3146101bb57806318160ddd146101eb575b600080fd5b34801561019c57600080fd5b506101a5610516565b6040516101b291906113af565b60405180910390f35b3480156101c757600080fd5b506101db6101d6366004611444565b6105a8565b60405190151581526020016101b2565b3480156101f757600080fd5b506004545b6040519081526020016101b2565b34801561021657600080fd5b506101fc633b9aca0081565b34801561022e57600080fd5b5061024261023d36600461146e565b6105bf565b005b34801561025057600080fd5b506101db61025f366004611487565b610656565b34801561027057600080fd5b5061024261027f366004611444565b610797565b34801561029057600080fd5b506101fc61271081565b3480156102a657600080fd5b50604051601281526020016101b2565b3480156102c257600080fd5b50610242610912565b3480156102d757600080fd5b50600a546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b60405173ffffffffffffffffffffffffffffffffffffffff90911681526020016101b2565b34801561032957600080fd5b50610242610338366004611444565b6109c9565b34801561034957600080fd5b506101fc6103583660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526002602052604090205490565b34801561038c57600080fd5b506101fc600c5481565b3480156103a257600080fd5b50600b546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b3480156103cf57600080fd5b506101fc6103de3660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561041257600080fd5b50610242610421366004611444565b610ab3565b34801561043257600080fd5b506101fc6104413660046114c3565b610c10565b34801561045257600080fd5b506101fc6104613660046114c3565b610c4b565b34801561047257600080fd5b506101a5610c86565b34801561048757600080fd5b50610242610496366004611444565b610c95565b3480156104a757600080fd5b506101db6104b6366004611444565b610d77565b3480156104c757600080fd5b506101fc6104d63660046114e5565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260036020908152604080832093909416825291909152205490565b6101fc610de3565b60606008805461052590611518565b80601f016020809104026020016040519081016040528092919081815260200182805461055190611518565b801561059e5780601f106105735761010080835404028352916020019161059e565b820191906000526020600020905b81548152906001019060200180831161058157829003601f168201915b5050505050905090565b60006105b5338484610fbb565b5060015b92915050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146106515760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e747261637400000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b600c55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600360209081526040808320338452909152812054828110156106fd5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610648565b610711853361070c868561159a565b610fbb565b61071b858461113a565b6107258484611249565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161078491815260200190565b60405180910390a3506001949350505050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146108245760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff821660009081526002602090815260408083205460019092529091205482916108619161159a565b10156108d45760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040812080548392906109099084906115ad565b90915550505050565b600061091d336112a1565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461096f576040519150601f19603f3d011682016040523d82523d6000602084013e610974565b606091505b50509050806109c55760405162461bcd60e51b815260206004820152601460248201527f4661696c656420746f2073656e642045746865720000000000000000000000006044820152606401610648565b5050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610a565760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610a608282611249565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610b405760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040902054811115610bdb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600260205260408120805483929061090990849061159a565b73ffffffffffffffffffffffffffffffffffffffff811660009081526002602090815260408083205460019092528220546105b9919061159a565b6000610c5682611320565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600560205260409020546105b991906115ad565b60606009805461052590611518565b60005473ffffffffffffffffffffffffffffffffffffffff163314610d225760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610d2c828261113a565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610aa7565b6000610d83338361113a565b610d8d8383611249565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6000805473ffffffffffffffffffffffffffffffffffffffff163314610e715760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b600c54349060009061271090610e8790846115c0565b610e9191906115d7565b905081811115610ee35760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f722072657761726400000000000000006044820152606401610648565b610eed818361159a565b600b5460405191935073ffffffffffffffffffffffffffffffffffffffff16906108fc9083906000818181858888f193505050503d8060008114610f4d576040519150601f19603f3d011682016040523d82523d6000602084013e610f52565b606091505b505060045460009150610f69633b9aca00856115c0565b610f7391906115d7565b905080600754610f8391906115ad565b600755600454600090633b9aca0090610f9c90846115c0565b610fa691906115d7565b9050610fb281846115ad565b94505050505090565b73ffffffffffffffffffffffffffffffffffffffff83166110435760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166110cc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b611143826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020908152604080832054600290925290912054611180908261159a565b8211156111cf5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610648565b6111d9828261159a565b73ffffffffffffffffffffffffffffffffffffffff841660009081526001602052604090205580820361122d5773ffffffffffffffffffffffffffffffffffffffff83166000908152600660205260408120555b816004600082825461123f919061159a565b9091555050505050565b611252826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906112889084906115ad565b92505081905550806004600082825461090991906115ad565b6000806112ad83611320565b73ffffffffffffffffffffffffffffffffffffffff84166000908152600560205260409020549091506112e19082906115ad565b73ffffffffffffffffffffffffffffffffffffffff90931660009081526005602090815260408083208690556007546006909252909120555090919050565b73ffffffffffffffffffffffffffffffffffffffff81166000908152600160205260408120548082036113565750600092915050565b73ffffffffffffffffffffffffffffffffffffffff8316600090815260066020526040812054600754611389919061159a565b90506000633b9aca0061139c84846115c0565b6113a691906115d7565b95945050505050565b600060208083528351808285015260005b818110156113dc578581018301518582016040015282016113c0565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff8116811461143f57600080fd5b919050565b6000806040838503121561145757600080fd5b6114608361141b565b946020939093013593505050565b60006020828403121561148057600080fd5b5035919050565b60008060006060848603121561149c57600080fd5b6114a58461141b565b92506114b36020850161141b565b9150604084013590509250925092565b6000602082840312156114d557600080fd5b6114de8261141b565b9392505050565b600080604083850312156114f857600080fd5b6115018361141b565b915061150f6020840161141b565b90509250929050565b600181811c9082168061152c57607f821691505b602082108103611565577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156105b9576105b961156b565b808201808211156105b9576105b961156b565b80820281158282048414176105b9576105b961156b565b60008261160d577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220ba014708b1b5df37f7d92aba1040b129bc35bb10417560ed1d7e4efd39583c0164736f6c63430008150033a2646970667358221220646579b30ad97986ceec5baeaa8110c99549699aabc0180fca0c17c245507aa064736f6c6343000815003360806040523480156200001157600080fd5b5060405162001a2038038062001a20833981016040819052620000349162000151565b6127108211156200004457600080fd5b600a80546001600160a01b038087166001600160a01b031992831617909255600b805492861692909116919091179055600c8290556040516200008c9082906020016200023e565b60405160208183030381529060405260089081620000ab9190620002fc565b5080604051602001620000bf91906200023e565b60405160208183030381529060405260099081620000de9190620002fc565b5050600080546001600160a01b0319163317905550620003c8915050565b6001600160a01b03811681146200011257600080fd5b50565b634e487b7160e01b600052604160045260246000fd5b60005b83811015620001485781810151838201526020016200012e565b50506000910152565b600080600080608085870312156200016857600080fd5b84516200017581620000fc565b60208601519094506200018881620000fc565b6040860151606087015191945092506001600160401b0380821115620001ad57600080fd5b818701915087601f830112620001c257600080fd5b815181811115620001d757620001d762000115565b604051601f8201601f19908116603f0116810190838211818310171562000202576200020262000115565b816040528281528a60208487010111156200021c57600080fd5b6200022f8360208301602088016200012b565b979a9699509497505050505050565b644c4e544e2d60d81b815260008251620002608160058501602087016200012b565b9190910160050192915050565b600181811c908216806200028257607f821691505b602082108103620002a357634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620002f757600081815260208120601f850160051c81016020861015620002d25750805b601f850160051c820191505b81811015620002f357828155600101620002de565b5050505b505050565b81516001600160401b0381111562000318576200031862000115565b62000330816200032984546200026d565b84620002a9565b602080601f8311600181146200036857600084156200034f5750858301515b600019600386901b1c1916600185901b178555620002f3565b600085815260208120601f198616915b82811015620003995788860151825594840194600190910190840162000378565b5085821015620003b85787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61164880620003d86000396000f3fe60806040526004361061018b5760003560e01c806359355736116100d6578063949813b81161007f578063a9059cbb11610059578063a9059cbb1461049b578063dd62ed3e146104bb578063fb489a7b1461050e57600080fd5b8063949813b81461044657806395d89b41146104665780639dc29fac1461047b57600080fd5b806370a08231116100b057806370a08231146103c35780637eee288d1461040657806384955c881461042657600080fd5b8063593557361461033d5780635ea1d6f81461038057806361d027b31461039657600080fd5b8063282d3fdf11610138578063372500ab11610112578063372500ab146102b65780633a5381b5146102cb57806340c10f191461031d57600080fd5b8063282d3fdf146102645780632f2c3f2e14610284578063313ce5671461029a57600080fd5b8063187cf4d711610169578063187cf4d71461020a57806319fac8fd1461022257806323b872dd1461024457600080fd5b806306fdde0314610190578063095ea7b3146101bb57806318160ddd146101eb575b600080fd5b34801561019c57600080fd5b506101a5610516565b6040516101b291906113af565b60405180910390f35b3480156101c757600080fd5b506101db6101d6366004611444565b6105a8565b60405190151581526020016101b2565b3480156101f757600080fd5b506004545b6040519081526020016101b2565b34801561021657600080fd5b506101fc633b9aca0081565b34801561022e57600080fd5b5061024261023d36600461146e565b6105bf565b005b34801561025057600080fd5b506101db61025f366004611487565b610656565b34801561027057600080fd5b5061024261027f366004611444565b610797565b34801561029057600080fd5b506101fc61271081565b3480156102a657600080fd5b50604051601281526020016101b2565b3480156102c257600080fd5b50610242610912565b3480156102d757600080fd5b50600a546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b60405173ffffffffffffffffffffffffffffffffffffffff90911681526020016101b2565b34801561032957600080fd5b50610242610338366004611444565b6109c9565b34801561034957600080fd5b506101fc6103583660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526002602052604090205490565b34801561038c57600080fd5b506101fc600c5481565b3480156103a257600080fd5b50600b546102f89073ffffffffffffffffffffffffffffffffffffffff1681565b3480156103cf57600080fd5b506101fc6103de3660046114c3565b73ffffffffffffffffffffffffffffffffffffffff1660009081526001602052604090205490565b34801561041257600080fd5b50610242610421366004611444565b610ab3565b34801561043257600080fd5b506101fc6104413660046114c3565b610c10565b34801561045257600080fd5b506101fc6104613660046114c3565b610c4b565b34801561047257600080fd5b506101a5610c86565b34801561048757600080fd5b50610242610496366004611444565b610c95565b3480156104a757600080fd5b506101db6104b6366004611444565b610d77565b3480156104c757600080fd5b506101fc6104d63660046114e5565b73ffffffffffffffffffffffffffffffffffffffff918216600090815260036020908152604080832093909416825291909152205490565b6101fc610de3565b60606008805461052590611518565b80601f016020809104026020016040519081016040528092919081815260200182805461055190611518565b801561059e5780601f106105735761010080835404028352916020019161059e565b820191906000526020600020905b81548152906001019060200180831161058157829003601f168201915b5050505050905090565b60006105b5338484610fbb565b5060015b92915050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146106515760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e747261637400000000000000000000000000000000000000000000000060648201526084015b60405180910390fd5b600c55565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600360209081526040808320338452909152812054828110156106fd5760405162461bcd60e51b815260206004820152602860248201527f45524332303a207472616e7366657220616d6f756e742065786365656473206160448201527f6c6c6f77616e63650000000000000000000000000000000000000000000000006064820152608401610648565b610711853361070c868561159a565b610fbb565b61071b858461113a565b6107258484611249565b8373ffffffffffffffffffffffffffffffffffffffff168573ffffffffffffffffffffffffffffffffffffffff167fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef8560405161078491815260200190565b60405180910390a3506001949350505050565b60005473ffffffffffffffffffffffffffffffffffffffff1633146108245760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff821660009081526002602090815260408083205460019092529091205482916108619161159a565b10156108d45760405162461bcd60e51b8152602060048201526024808201527f63616e2774206c6f636b206d6f72652066756e6473207468616e20617661696c60448201527f61626c65000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040812080548392906109099084906115ad565b90915550505050565b600061091d336112a1565b33600081815260056020526040808220829055519293509183908381818185875af1925050503d806000811461096f576040519150601f19603f3d011682016040523d82523d6000602084013e610974565b606091505b50509050806109c55760405162461bcd60e51b815260206004820152601460248201527f4661696c656420746f2073656e642045746865720000000000000000000000006044820152606401610648565b5050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610a565760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610a608282611249565b60405181815273ffffffffffffffffffffffffffffffffffffffff8316906000907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906020015b60405180910390a35050565b60005473ffffffffffffffffffffffffffffffffffffffff163314610b405760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff8216600090815260026020526040902054811115610bdb5760405162461bcd60e51b815260206004820152602360248201527f63616e277420756e6c6f636b206d6f72652066756e6473207468616e206c6f6360448201527f6b656400000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166000908152600260205260408120805483929061090990849061159a565b73ffffffffffffffffffffffffffffffffffffffff811660009081526002602090815260408083205460019092528220546105b9919061159a565b6000610c5682611320565b73ffffffffffffffffffffffffffffffffffffffff83166000908152600560205260409020546105b991906115ad565b60606009805461052590611518565b60005473ffffffffffffffffffffffffffffffffffffffff163314610d225760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b610d2c828261113a565b60405181815260009073ffffffffffffffffffffffffffffffffffffffff8416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef90602001610aa7565b6000610d83338361113a565b610d8d8383611249565b60405182815273ffffffffffffffffffffffffffffffffffffffff84169033907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef9060200160405180910390a350600192915050565b6000805473ffffffffffffffffffffffffffffffffffffffff163314610e715760405162461bcd60e51b815260206004820152602860248201527f43616c6c207265737472696374656420746f20746865204175746f6e6974792060448201527f436f6e74726163740000000000000000000000000000000000000000000000006064820152608401610648565b600c54349060009061271090610e8790846115c0565b610e9191906115d7565b905081811115610ee35760405162461bcd60e51b815260206004820152601860248201527f696e76616c69642076616c696461746f722072657761726400000000000000006044820152606401610648565b610eed818361159a565b600b5460405191935073ffffffffffffffffffffffffffffffffffffffff16906108fc9083906000818181858888f193505050503d8060008114610f4d576040519150601f19603f3d011682016040523d82523d6000602084013e610f52565b606091505b505060045460009150610f69633b9aca00856115c0565b610f7391906115d7565b905080600754610f8391906115ad565b600755600454600090633b9aca0090610f9c90846115c0565b610fa691906115d7565b9050610fb281846115ad565b94505050505090565b73ffffffffffffffffffffffffffffffffffffffff83166110435760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f2061646460448201527f72657373000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff82166110cc5760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f20616464726560448201527f73730000000000000000000000000000000000000000000000000000000000006064820152608401610648565b73ffffffffffffffffffffffffffffffffffffffff83811660008181526003602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b611143826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020908152604080832054600290925290912054611180908261159a565b8211156111cf5760405162461bcd60e51b815260206004820152601b60248201527f696e73756666696369656e7420756e6c6f636b65642066756e647300000000006044820152606401610648565b6111d9828261159a565b73ffffffffffffffffffffffffffffffffffffffff841660009081526001602052604090205580820361122d5773ffffffffffffffffffffffffffffffffffffffff83166000908152600660205260408120555b816004600082825461123f919061159a565b9091555050505050565b611252826112a1565b5073ffffffffffffffffffffffffffffffffffffffff8216600090815260016020526040812080548392906112889084906115ad565b92505081905550806004600082825461090991906115ad565b6000806112ad83611320565b73ffffffffffffffffffffffffffffffffffffffff84166000908152600560205260409020549091506112e19082906115ad565b73ffffffffffffffffffffffffffffffffffffffff90931660009081526005602090815260408083208690556007546006909252909120555090919050565b73ffffffffffffffffffffffffffffffffffffffff81166000908152600160205260408120548082036113565750600092915050565b73ffffffffffffffffffffffffffffffffffffffff8316600090815260066020526040812054600754611389919061159a565b90506000633b9aca0061139c84846115c0565b6113a691906115d7565b95945050505050565b600060208083528351808285015260005b818110156113dc578581018301518582016040015282016113c0565b5060006040828601015260407fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe0601f8301168501019250505092915050565b803573ffffffffffffffffffffffffffffffffffffffff8116811461143f57600080fd5b919050565b6000806040838503121561145757600080fd5b6114608361141b565b946020939093013593505050565b60006020828403121561148057600080fd5b5035919050565b60008060006060848603121561149c57600080fd5b6114a58461141b565b92506114b36020850161141b565b9150604084013590509250925092565b6000602082840312156114d557600080fd5b6114de8261141b565b9392505050565b600080604083850312156114f857600080fd5b6115018361141b565b915061150f6020840161141b565b90509250929050565b600181811c9082168061152c57607f821691505b602082108103611565577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b818103818111156105b9576105b961156b565b808201808211156105b9576105b961156b565b80820281158282048414176105b9576105b961156b565b60008261160d577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea2646970667358221220ba014708b1b5df37f7d92aba1040b129bc35bb10417560ed1d7e4efd39583c0164736f6c63430008150033")
 
 var AutonityUpgradeTestAbi, _ = abi.JSON(strings.NewReader(`[
    {
@@ -131,6 +131,19 @@ var AutonityUpgradeTestAbi, _ = abi.JSON(strings.NewReader(`[
       "name" : "CommissionRateChange",
       "type" : "event"
    },
+   {
+      "anonymous" : false,
+      "inputs" : [
+         {
+            "indexed" : true,
+            "internalType" : "address",
+            "name" : "addr",
+            "type" : "address"
+         }
+      ],
+      "name" : "ConsensusEndpointsUpdated",
+      "type" : "event"
+   },
    {
       "anonymous" : false,
       "inputs" : [
@@ -1138,6 +1151,29 @@ var AutonityUpgradeTestAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "view",
       "type" : "function"
    },
+   {
+      "inputs" : [
+         {
+            "internalType" : "address",
+            "name" : "_validator",
+            "type" : "address"
+         },
+         {
+            "internalType" : "address",
+            "name" : "_delegator",
+            "type" : "address"
+         },
+         {
+            "internalType" : "uint256",
+            "name" : "_amount",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "lockLiquid",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
@@ -1496,6 +1532,42 @@ var AutonityUpgradeTestAbi, _ = abi.JSON(strings.NewReader(`[
       "stateMutability" : "nonpayable",
       "type" : "function"
    },
+   {
+      "inputs" : [
+         {
+            "internalType" : "address",
+            "name" : "_validator",
+            "type" : "address"
+         },
+         {
+            "internalType" : "address",
+            "name" : "_delegator",
+            "type" : "address"
+         },
+         {
+            "internalType" : "uint256",
+            "name" : "_amount",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "unlockLiquid",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "internalType" : "bytes",
+            "name" : "_record",
+            "type" : "bytes"
+         }
+      ],
+      "name" : "updateConsensusEndpoints",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
    {
       "inputs" : [
          {
@@ -0,0 +1,622 @@
+package generated
+
+import "strings"
+import "github.com/autonity/autonity/accounts/abi"
+import "github.com/autonity/autonity/common"
+
+var GovernanceBytecode = common.Hex2Bytes("60806040523480156200001157600080fd5b5060405162003133380380620031338339810160408190526200003491620001d8565b600080546001600160a01b0319166001600160a01b0384161790556200005a8162000062565b50506200028b565b8051620000b65760405162461bcd60e51b815260206004820152601560248201527f696e76616c696420766f74696e6720706572696f64000000000000000000000060448201526064015b60405180910390fd5b61271081602001511115620000ff5760405162461bcd60e51b815260206004820152600e60248201526d696e76616c69642071756f72756d60901b6044820152606401620000ad565b600081604001511180156200011a5750612710816040015111155b6200015c5760405162461bcd60e51b81526020600482015260116024820152701a5b9d985b1a59081d1a1c995cda1bdb19607a1b6044820152606401620000ad565b805160018190556020808301516002819055604080850151600381905560608087015160048190556080808901516005819055855198895296880195909552928601919091528401528201527f2ba978126f856e763cfc2e7694871d83c30f5c931c5e16443db1e38c63dafd219060a00160405180910390a150565b60008082840360c0811215620001ed57600080fd5b83516001600160a01b03811681146200020557600080fd5b925060a0601f19820112156200021a57600080fd5b5060405160a081016001600160401b03811182821017156200024c57634e487b7160e01b600052604160045260246000fd5b80604052506020840151815260408401516020820152606084015160408201526080840151606082015260a08401516080820152809150509250929050565b612e98806200029b6000396000f3fe608060405234801561001057600080fd5b50600436106100ea5760003560e01c8063bc3f931f1161008c578063c7f758a811610066578063c7f758a8146101fe578063e75569121461021e578063ed8b6b3114610231578063fe0d94c11461024457600080fd5b8063bc3f931f1461018c578063c08cc02d146101ac578063c3f909d4146101b457600080fd5b806386b646f2116100c857806386b646f21461013d57806386f056781461015d5780639f30ff3014610170578063aaf5eb681461018357600080fd5b8063159bf8cf146100ef57806337bdc99b1461011557806340e58ee51461012a575b600080fd5b6101026100fd366004612356565b610257565b6040519081526020015b60405180910390f35b610128610123366004612462565b6107ca565b005b610128610138366004612462565b610aa5565b61015061014b366004612462565b610b57565b60405161010c91906124cc565b61012861016b3660046124df565b610bd0565b61010261017e366004612533565b6111c7565b61010261271081565b61019f61019a36600461255a565b611437565b60405161010c919061258a565b600654610102565b6101bc611579565b60405161010c9190600060a082019050825182526020830151602083015260408301516040830152606083015160608301526080830151608083015292915050565b61021161020c366004612462565b6115e0565b60405161010c919061270d565b61012861022c3660046127e8565b6118ce565b61012861023f366004612462565b611929565b610128610252366004612462565b611a9d565b6000808451116102ae5760405162461bcd60e51b815260206004820152600e60248201527f656d7074792070726f706f73616c00000000000000000000000000000000000060448201526064015b60405180910390fd5b82518451146102ff5760405162461bcd60e51b815260206004820152601560248201527f63616c6c73206c656e677468206d69736d61746368000000000000000000000060448201526064016102a5565b6006805460018101825560009182528154604080517fb7ab4db500000000000000000000000000000000000000000000000000000000815290519293600a85027ff652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d3f01939092839273ffffffffffffffffffffffffffffffffffffffff9091169163b7ab4db59160048082019286929091908290030181865afa1580156103a9573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040526103d19190810190612868565b905060005b815181101561069b5760008054835173ffffffffffffffffffffffffffffffffffffffff90911690631904bb2e9085908590811061041657610416612902565b60200260200101516040518263ffffffff1660e01b8152600401610456919073ffffffffffffffffffffffffffffffffffffffff91909116815260200190565b600060405180830381865afa158015610473573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405261049b919081019061298d565b905060006009600088815260200190815260200160002060008585815181106104c6576104c6612902565b60209081029190910181015173ffffffffffffffffffffffffffffffffffffffff90811683529082019290925260400160002061018084015181549083167fffffffffffffffffffffffff00000000000000000000000000000000000000009182161782558451600183018054919094169116179091556101008301516002820181905560a084015191925061055b91612b3a565b60038201556101a0820151600482015560a0820151600587018054600090610584908490612b4d565b90915550506101808201516040517f84955c8800000000000000000000000000000000000000000000000000000000815233600482015260009161062b91849173ffffffffffffffffffffffffffffffffffffffff16906384955c88906024015b602060405180830381865afa158015610602573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906106269190612b60565b611cf2565b915061063990508187612b4d565b95503373ffffffffffffffffffffffffffffffffffffffff16836000015173ffffffffffffffffffffffffffffffffffffffff1603610685576101008301516106829087612b4d565b95505b505050808061069390612b79565b9150506103d6565b506000821180156106ae57506005548210155b6106fa5760405162461bcd60e51b815260206004820152601960248201527f696e73756666696369656e7420766f74696e6720706f7765720000000000000060448201526064016102a5565b82547fffffffffffffffffffffffff00000000000000000000000000000000000000001633178355600183016107308782612c53565b50875161074690600285019060208b0190611fab565b50865161075c90600385019060208a0190612035565b5060015461076a9043612b4d565b6004840181905560098401805460ff19169055604051339186917f3cb586fc92d2dd2c453581211155b0ef86a580179e1bffd96b3590ceb5945b65916107b6918d918d918d9190612d4f565b60405180910390a350919695505050505050565b600654811061081b5760405162461bcd60e51b815260206004820152601060248201527f756e6b6e6f776e2070726f706f73616c0000000000000000000000000000000060448201526064016102a5565b60006006828154811061083057610830612902565b60009182526020909120600a9091020190506004600982015460ff16600481111561085d5761085d6126a3565b148061086c5750806004015443115b6108b85760405162461bcd60e51b815260206004820152601960248201527f766f74696e6720706572696f64206973206e6f74206f7665720000000000000060448201526064016102a5565b6000828152600860209081526040808320338452909152902060028101546109225760405162461bcd60e51b815260206004820152601760248201527f6e6f206c6f636b6564206c6971756964206e6577746f6e00000000000000000060448201526064016102a5565b60005b6002820154811015610a565760005460028301805473ffffffffffffffffffffffffffffffffffffffff909216916339b17fec91908490811061096a5761096a612902565b9060005260206000200160009054906101000a900473ffffffffffffffffffffffffffffffffffffffff16338560030185815481106109ab576109ab612902565b6000918252602090912001546040517fffffffff0000000000000000000000000000000000000000000000000000000060e086901b16815273ffffffffffffffffffffffffffffffffffffffff93841660048201529290911660248301526044820152606401600060405180830381600087803b158015610a2b57600080fd5b505af1158015610a3f573d6000803e3d6000fd5b505050508080610a4e90612b79565b915050610925565b50610a65600282016000612087565b610a73600382016000612087565b604051339084907ff2d1b6805ed805e90676fe890865e6e22992435036a1637c2e19ca60ef1716b290600090a3505050565b6000610ab082611d45565b805490915073ffffffffffffffffffffffffffffffffffffffff163314610b195760405162461bcd60e51b815260206004820152601a60248201527f63616c6c6572206973206e6f74207468652070726f706f73657200000000000060448201526064016102a5565b60098101805460ff1916600417905560405182907f416e669c63d9a3a5e36ee7cc7e2104b8db28ccd286aa18966e98fa230c73b08c90600090a25050565b600081815260076020908152604091829020805483518184028101840190945280845260609392830182828015610bc457602002820191906000526020600020905b815473ffffffffffffffffffffffffffffffffffffffff168152600190910190602001808311610b99575b50505050509050919050565b6000610bdb84611d45565b90508060040154431115610c315760405162461bcd60e51b815260206004820152601560248201527f766f74696e6720706572696f64206973206f766572000000000000000000000060448201526064016102a5565b60008481526008602090815260408083203384529091529020805460ff1615610c9c5760405162461bcd60e51b815260206004820152600d60248201527f616c726561647920766f7465640000000000000000000000000000000000000060448201526064016102a5565b6000805b845181101561107657801580610d1d575084610cbd600183612b3a565b81518110610ccd57610ccd612902565b602002602001015173ffffffffffffffffffffffffffffffffffffffff16858281518110610cfd57610cfd612902565b602002602001015173ffffffffffffffffffffffffffffffffffffffff16115b610d8f5760405162461bcd60e51b815260206004820152602160248201527f76616c696461746f7273206e6f7420696e20617363656e64696e67206f72646560448201527f720000000000000000000000000000000000000000000000000000000000000060648201526084016102a5565b600087815260096020526040812086518290889085908110610db357610db3612902565b60209081029190910181015173ffffffffffffffffffffffffffffffffffffffff908116835290820192909252604001600020805490925016610e385760405162461bcd60e51b815260206004820152601d60248201527f76616c696461746f72206e6f7420696e2074686520736e617073686f7400000060448201526064016102a5565b60018101543373ffffffffffffffffffffffffffffffffffffffff90911603610e6d576002810154610e6a9084612b4d565b92505b80546040517f84955c880000000000000000000000000000000000000000000000000000000081523360048201526000918291610ecd91859173ffffffffffffffffffffffffffffffffffffffff909116906384955c88906024016105e5565b9150915081600003610ee157505050611064565b600054885173ffffffffffffffffffffffffffffffffffffffff90911690632a400bc3908a9087908110610f1757610f17612902565b60209081029190910101516040517fffffffff0000000000000000000000000000000000000000000000000000000060e084901b16815273ffffffffffffffffffffffffffffffffffffffff909116600482015233602482015260448101859052606401600060405180830381600087803b158015610f9557600080fd5b505af1158015610fa9573d6000803e3d6000fd5b5050505081836005016000828254610fc19190612b4d565b9250508190555085600201888581518110610fde57610fde612902565b602090810291909101810151825460018082018555600094855283852090910180547fffffffffffffffffffffffff00000000000000000000000000000000000000001673ffffffffffffffffffffffffffffffffffffffff909316929092179091556003890180549182018155835291200182905561105e8186612b4d565b94505050505b8061106e81612b79565b915050610ca0565b5060008111801561108957506005548110155b6110d55760405162461bcd60e51b815260206004820152601960248201527f696e73756666696369656e7420766f74696e6720706f7765720000000000000060448201526064016102a5565b846110e15760026110e4565b60015b825460ff9190911660ff1990911617825560018083018290556000878152600760209081526040822080549384018155825290200180547fffffffffffffffffffffffff000000000000000000000000000000000000000016331790558415611166578083600701600082825461115b9190612b4d565b909155506111809050565b8083600801600082825461117a9190612b4d565b90915550505b60408051861515815260208101839052339188917fcbdf6214089cba887ecbf35a0b6a734589959c9763342c756bb2a80ca2bc9f6e910160405180910390a3505050505050565b600654600090841061121b5760405162461bcd60e51b815260206004820152601060248201527f756e6b6e6f776e2070726f706f73616c0000000000000000000000000000000060448201526064016102a5565b60005b825181101561142f5780158061129b57508261123b600183612b3a565b8151811061124b5761124b612902565b602002602001015173ffffffffffffffffffffffffffffffffffffffff1683828151811061127b5761127b612902565b602002602001015173ffffffffffffffffffffffffffffffffffffffff16115b61130d5760405162461bcd60e51b815260206004820152602160248201527f76616c696461746f7273206e6f7420696e20617363656e64696e67206f72646560448201527f720000000000000000000000000000000000000000000000000000000000000060648201526084016102a5565b60008581526009602052604081208451829086908590811061133157611331612902565b60209081029190910181015173ffffffffffffffffffffffffffffffffffffffff908116835290820192909252604001600020805490925016611374575061141d565b600181015473ffffffffffffffffffffffffffffffffffffffff8087169116036113aa5760028101546113a79084612b4d565b92505b80546040517f84955c8800000000000000000000000000000000000000000000000000000000815273ffffffffffffffffffffffffffffffffffffffff878116600483015260009261140a928592909116906384955c88906024016105e5565b915061141890508185612b4d565b935050505b8061142781612b79565b91505061121e565b509392505050565b6114656040518060800160405280600060ff1681526020016000815260200160608152602001606081525090565b600083815260086020908152604080832073ffffffffffffffffffffffffffffffffffffffff861684528252918290208251608081018452815460ff1681526001820154818401526002820180548551818602810186018752818152929593949386019383018282801561150f57602002820191906000526020600020905b815473ffffffffffffffffffffffffffffffffffffffff1681526001909101906020018083116114e4575b505050505081526020016003820180548060200260200160405190810160405280929190818152602001828054801561156757602002820191906000526020600020905b815481526020019060010190808311611553575b50505050508152505090505b92915050565b6115ab6040518060a0016040528060008152602001600081526020016000815260200160008152602001600081525090565b506040805160a08101825260015481526002546020820152600354918101919091526004546060820152600554608082015290565b6115e86120a5565b60065482106116395760405162461bcd60e51b815260206004820152601060248201527f756e6b6e6f776e2070726f706f73616c0000000000000000000000000000000060448201526064016102a5565b6006828154811061164c5761164c612902565b6000918252602091829020604080516101408101909152600a90920201805473ffffffffffffffffffffffffffffffffffffffff168252600181018054929391929184019161169a90612bb1565b80601f01602080910402602001604051908101604052809291908181526020018280546116c690612bb1565b80156117135780601f106116e857610100808354040283529160200191611713565b820191906000526020600020905b8154815290600101906020018083116116f657829003601f168201915b505050505081526020016002820180548060200260200160405190810160405280929190818152602001828054801561178257602002820191906000526020600020905b815473ffffffffffffffffffffffffffffffffffffffff168152600190910190602001808311611757575b5050505050815260200160038201805480602002602001604051908101604052809291908181526020016000905b8282101561185c5783829060005260206000200180546117cf90612bb1565b80601f01602080910402602001604051908101604052809291908181526020018280546117fb90612bb1565b80156118485780601f1061181d57610100808354040283529160200191611848565b820191906000526020600020905b81548152906001019060200180831161182b57829003601f168201915b5050505050815260200190600101906117b0565b505050908252506004828101546020830152600583015460408301526006830154606083015260078301546080830152600883015460a0830152600983015460c09092019160ff16908111156118b4576118b46126a3565b60048111156118c5576118c56126a3565b90525092915050565b33301461191d5760405162461bcd60e51b815260206004820152601c60248201527f63616c6c6572206973206e6f742074686520676f7665726e616e63650000000060448201526064016102a5565b61192681611e25565b50565b600061193482611d45565b9050806004015443116119895760405162461bcd60e51b815260206004820152601960248201527f766f74696e6720706572696f64206973206e6f74206f7665720000000000000060448201526064016102a5565b60008160080154826007015461199f9190612b4d565b9050600080821180156119ce575060025460058401546119bf9190612d9a565b6119cb61271084612d9a565b10155b80156119f757506003546119e29083612d9a565b61271084600701546119f49190612d9a565b10155b90508015611a255760098301805460ff19166002179055600454611a1b9043612b4d565b6006840155611a35565b60098301805460ff191660011790555b837f462a743cfc5478a80328a625e4961bcae9b1cf71b711c00bfc4d1ec7dbb79d4384600701548560080154848760060154604051611a8f9493929190938452602084019290925215156040830152606082015260800190565b60405180910390a250505050565b6006548110611aee5760405162461bcd60e51b815260206004820152601060248201527f756e6b6e6f776e2070726f706f73616c0000000000000000000000000000000060448201526064016102a5565b600060068281548110611b0357611b03612902565b60009182526020909120600a9091020190506002600982015460ff166004811115611b3057611b306126a3565b14611b7d5760405162461bcd60e51b815260206004820152601660248201527f70726f706f73616c206973206e6f74207175657565640000000000000000000060448201526064016102a5565b8060060154431015611bd15760405162461bcd60e51b815260206004820152601660248201527f70726f706f73616c2069732074696d656c6f636b65640000000000000000000060448201526064016102a5565b60098101805460ff1916600317905560005b6002820154811015611cc257600080836002018381548110611c0757611c07612902565b60009182526020909120015460038501805473ffffffffffffffffffffffffffffffffffffffff9092169185908110611c4257611c42612902565b90600052602060002001604051611c599190612db1565b6000604051808303816000865af19150503d8060008114611c96576040519150601f19603f3d011682016040523d82523d6000602084013e611c9b565b606091505b509150915081611cad57805160208201fd5b50508080611cba90612b79565b915050611be3565b5060405182907f712ae1383f79ac853f8d882153778e0260ef8f03b504e2866e0593e04d2b291f90600090a25050565b60008083600501548460040154611d099190612b3a565b915081831015611d17578291505b8115611d3e5760048401546003850154611d319084612d9a565b611d3b9190612e27565b90505b9250929050565b6006546000908210611d995760405162461bcd60e51b815260206004820152601060248201527f756e6b6e6f776e2070726f706f73616c0000000000000000000000000000000060448201526064016102a5565b600060068381548110611dae57611dae612902565b600091825260208220600a909102019150600982015460ff166004811115611dd857611dd86126a3565b146115735760405162461bcd60e51b815260206004820152601660248201527f70726f706f73616c206973206e6f74206163746976650000000000000000000060448201526064016102a5565b8051611e735760405162461bcd60e51b815260206004820152601560248201527f696e76616c696420766f74696e6720706572696f64000000000000000000000060448201526064016102a5565b61271081602001511115611ec95760405162461bcd60e51b815260206004820152600e60248201527f696e76616c69642071756f72756d00000000000000000000000000000000000060448201526064016102a5565b60008160400151118015611ee35750612710816040015111155b611f2f5760405162461bcd60e51b815260206004820152601160248201527f696e76616c6964207468726573686f6c6400000000000000000000000000000060448201526064016102a5565b805160018190556020808301516002819055604080850151600381905560608087015160048190556080808901516005819055855198895296880195909552928601919091528401528201527f2ba978126f856e763cfc2e7694871d83c30f5c931c5e16443db1e38c63dafd219060a00160405180910390a150565b828054828255906000526020600020908101928215612025579160200282015b8281111561202557825182547fffffffffffffffffffffffff00000000000000000000000000000000000000001673ffffffffffffffffffffffffffffffffffffffff909116178255602090920191600190910190611fcb565b5061203192915061211e565b5090565b82805482825590600052602060002090810192821561207b579160200282015b8281111561207b578251829061206b9082612c53565b5091602001919060010190612055565b50612031929150612133565b5080546000825590600052602060002090810190611926919061211e565b604051806101400160405280600073ffffffffffffffffffffffffffffffffffffffff168152602001606081526020016060815260200160608152602001600081526020016000815260200160008152602001600081526020016000815260200160006004811115612119576121196126a3565b905290565b5b80821115612031576000815560010161211f565b808211156120315760006121478282612150565b50600101612133565b50805461215c90612bb1565b6000825580601f1061216c575050565b601f016020900490600052602060002090810190611926919061211e565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b604051610280810167ffffffffffffffff811182821017156121dd576121dd61218a565b60405290565b604051601f8201601f1916810167ffffffffffffffff8111828210171561220c5761220c61218a565b604052919050565b600067ffffffffffffffff82111561222e5761222e61218a565b5060051b60200190565b73ffffffffffffffffffffffffffffffffffffffff8116811461192657600080fd5b600082601f83011261226b57600080fd5b8135602061228061227b83612214565b6121e3565b82815260059290921b8401810191818101908684111561229f57600080fd5b8286015b848110156122c35780356122b681612238565b83529183019183016122a3565b509695505050505050565b600067ffffffffffffffff8211156122e8576122e861218a565b50601f01601f191660200190565b600061230461227b846122ce565b905082815283838301111561231857600080fd5b828260208301376000602084830101529392505050565b600082601f83011261234057600080fd5b61234f838335602085016122f6565b9392505050565b60008060006060848603121561236b57600080fd5b833567ffffffffffffffff8082111561238357600080fd5b61238f8783880161225a565b94506020915081860135818111156123a657600080fd5b8601601f810188136123b757600080fd5b80356123c561227b82612214565b81815260059190911b8201840190848101908a8311156123e457600080fd5b8584015b83811015612431578035868111156124005760008081fd5b8501603f81018d136124125760008081fd5b6124238d89830135604084016122f6565b8452509186019186016123e8565b509650505050604086013591508082111561244b57600080fd5b506124588682870161232f565b9150509250925092565b60006020828403121561247457600080fd5b5035919050565b600081518084526020808501945080840160005b838110156124c157815173ffffffffffffffffffffffffffffffffffffffff168752958201959082019060010161248f565b509495945050505050565b60208152600061234f602083018461247b565b6000806000606084860312156124f457600080fd5b833592506020840135801515811461250b57600080fd5b9150604084013567ffffffffffffffff81111561252757600080fd5b6124588682870161225a565b60008060006060848603121561254857600080fd5b83359250602084013561250b81612238565b6000806040838503121561256d57600080fd5b82359150602083013561257f81612238565b809150509250929050565b6000602080835260ff845116818401528084015160408401526040840151608060608501526125bc60a085018261247b565b6060860151858203601f19016080870152805180835290840192506000918401905b808310156122c357835182529284019260019290920191908401906125de565b60005b83811015612619578181015183820152602001612601565b50506000910152565b6000815180845261263a8160208601602086016125fe565b601f01601f19169290920160200192915050565b600081518084526020808501808196508360051b8101915082860160005b85811015612696578284038952612684848351612622565b9885019893509084019060010161266c565b5091979650505050505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b60058110612709577f4e487b7100000000000000000000000000000000000000000000000000000000600052602160045260246000fd5b9052565b6020815261273460208201835173ffffffffffffffffffffffffffffffffffffffff169052565b60006020830151610140806040850152612752610160850183612622565b91506040850151601f1980868503016060870152612770848361247b565b935060608701519150808685030160808701525061278e838261264e565b925050608085015160a085015260a085015160c085015260c085015160e085015260e08501516101008181870152808701519150506101208181870152808701519150506127de828601826126d2565b5090949350505050565b600060a082840312156127fa57600080fd5b60405160a0810181811067ffffffffffffffff8211171561281d5761281d61218a565b806040525082358152602083013560208201526040830135604082015260608301356060820152608083013560808201528091505092915050565b805161286381612238565b919050565b6000602080838503121561287b57600080fd5b825167ffffffffffffffff81111561289257600080fd5b8301601f810185136128a357600080fd5b80516128b161227b82612214565b81815260059190911b820183019083810190878311156128d057600080fd5b928401925b828410156128f75783516128e881612238565b825292840192908401906128d5565b979650505050505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052603260045260246000fd5b600082601f83011261294257600080fd5b815161295061227b826122ce565b81815284602083860101111561296557600080fd5b6129768260208301602087016125fe565b949350505050565b80516004811061286357600080fd5b60006020828403121561299f57600080fd5b815167ffffffffffffffff808211156129b757600080fd5b9083019061028082860312156129cc57600080fd5b6129d46121b9565b6129dd83612858565b81526129eb60208401612858565b60208201526129fc60408401612858565b6040820152606083015182811115612a1357600080fd5b612a1f87828601612931565b6060830152506080830151608082015260a083015160a082015260c083015160c082015260e083015160e0820152610100808401518183015250610120808401518183015250610140808401518183015250610160808401518183015250610180612a8b818501612858565b908201526101a083810151908201526101c080840151908201526101e08084015190820152610200808401519082015261022080840151908201526102408084015183811115612ada57600080fd5b612ae688828701612931565b8284015250506102609150612afc82840161297e565b91810191909152949350505050565b7f4e487b7100000000000000000000000000000000000000000000000000000000600052601160045260246000fd5b8181038181111561157357611573612b0b565b8082018082111561157357611573612b0b565b600060208284031215612b7257600080fd5b5051919050565b60007fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff8203612baa57612baa612b0b565b5060010190565b600181811c90821680612bc557607f821691505b602082108103612bfe577f4e487b7100000000000000000000000000000000000000000000000000000000600052602260045260246000fd5b50919050565b601f821115612c4e57600081815260208120601f850160051c81016020861015612c2b5750805b601f850160051c820191505b81811015612c4a57828155600101612c37565b5050505b505050565b815167ffffffffffffffff811115612c6d57612c6d61218a565b612c8181612c7b8454612bb1565b84612c04565b602080601f831160018114612cd45760008415612c9e5750858301515b7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff600386901b1c1916600185901b178555612c4a565b600085815260208120601f198616915b82811015612d0357888601518255948401946001909101908401612ce4565b5085821015612d3f57878501517fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff600388901b60f8161c191681555b5050505050600190811b01905550565b608081526000612d62608083018761247b565b8281036020840152612d74818761264e565b90508281036040840152612d888186612622565b91505082606083015295945050505050565b808202811582820484141761157357611573612b0b565b6000808354612dbf81612bb1565b60018281168015612dd75760018114612dec57612e1b565b60ff1984168752821515830287019450612e1b565b8760005260208060002060005b85811015612e125781548a820152908401908201612df9565b50505082870194505b50929695505050505050565b600082612e5d577f4e487b7100000000000000000000000000000000000000000000000000000000600052601260045260246000fd5b50049056fea26469706673582212202fe5b6cfe12ff45a7408467810a121653a2673bb9ccb15bfd8238dacf2ee8c3a64736f6c63430008150033")
+
+var GovernanceAbi, _ = abi.JSON(strings.NewReader(`[
+   {
+      "inputs" : [
+         {
+            "internalType" : "address payable",
+            "name" : "_autonity",
+            "type" : "address"
+         },
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "votingPeriod",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "quorum",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "threshold",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "timelock",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "minVotingPower",
+                  "type" : "uint256"
+               }
+            ],
+            "internalType" : "struct Governance.Config",
+            "name" : "_config",
+            "type" : "tuple"
+         }
+      ],
+      "stateMutability" : "nonpayable",
+      "type" : "constructor"
+   },
+   {
+      "anonymous" : false,
+      "inputs" : [
+         {
+            "indexed" : false,
+            "internalType" : "uint256",
+            "name" : "votingPeriod",
+            "type" : "uint256"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "uint256",
+            "name" : "quorum",
+            "type" : "uint256"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "uint256",
+            "name" : "threshold",
+            "type" : "uint256"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "uint256",
+            "name" : "timelock",
+            "type" : "uint256"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "uint256",
+            "name" : "minVotingPower",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "ConfigUpdated",
+      "type" : "event"
+   },
+   {
+      "anonymous" : false,
+      "inputs" : [
+         {
+            "indexed" : true,
+            "internalType" : "uint256",
+            "name" : "id",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "ProposalCancelled",
+      "type" : "event"
+   },
+   {
+      "anonymous" : false,
+      "inputs" : [
+         {
+            "indexed" : true,
+            "internalType" : "uint256",
+            "name" : "id",
+            "type" : "uint256"
+         },
+         {
+            "indexed" : true,
+            "internalType" : "address",
+            "name" : "proposer",
+            "type" : "address"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "address[]",
+            "name" : "targets",
+            "type" : "address[]"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "bytes[]",
+            "name" : "calldatas",
+            "type" : "bytes[]"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "string",
+            "name" : "description",
+            "type" : "string"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "uint256",
+            "name" : "endBlock",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "ProposalCreated",
+      "type" : "event"
+   },
+   {
+      "anonymous" : false,
+      "inputs" : [
+         {
+            "indexed" : true,
+            "internalType" : "uint256",
+            "name" : "id",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "ProposalExecuted",
+      "type" : "event"
+   },
+   {
+      "anonymous" : false,
+      "inputs" : [
+         {
+            "indexed" : true,
+            "internalType" : "uint256",
+            "name" : "id",
+            "type" : "uint256"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "uint256",
+            "name" : "yesVotes",
+            "type" : "uint256"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "uint256",
+            "name" : "noVotes",
+            "type" : "uint256"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "bool",
+            "name" : "accepted",
+            "type" : "bool"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "uint256",
+            "name" : "eta",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "ProposalTallied",
+      "type" : "event"
+   },
+   {
+      "anonymous" : false,
+      "inputs" : [
+         {
+            "indexed" : true,
+            "internalType" : "uint256",
+            "name" : "id",
+            "type" : "uint256"
+         },
+         {
+            "indexed" : true,
+            "internalType" : "address",
+            "name" : "voter",
+            "type" : "address"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "bool",
+            "name" : "support",
+            "type" : "bool"
+         },
+         {
+            "indexed" : false,
+            "internalType" : "uint256",
+            "name" : "power",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "VoteCast",
+      "type" : "event"
+   },
+   {
+      "anonymous" : false,
+      "inputs" : [
+         {
+            "indexed" : true,
+            "internalType" : "uint256",
+            "name" : "id",
+            "type" : "uint256"
+         },
+         {
+            "indexed" : true,
+            "internalType" : "address",
+            "name" : "voter",
+            "type" : "address"
+         }
+      ],
+      "name" : "VoteReleased",
+      "type" : "event"
+   },
+   {
+      "inputs" : [],
+      "name" : "PRECISION",
+      "outputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "",
+            "type" : "uint256"
+         }
+      ],
+      "stateMutability" : "view",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "_id",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "cancel",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "_id",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "execute",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
+   {
+      "inputs" : [],
+      "name" : "getConfig",
+      "outputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "votingPeriod",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "quorum",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "threshold",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "timelock",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "minVotingPower",
+                  "type" : "uint256"
+               }
+            ],
+            "internalType" : "struct Governance.Config",
+            "name" : "",
+            "type" : "tuple"
+         }
+      ],
+      "stateMutability" : "view",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "_id",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "getProposal",
+      "outputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "address",
+                  "name" : "proposer",
+                  "type" : "address"
+               },
+               {
+                  "internalType" : "string",
+                  "name" : "description",
+                  "type" : "string"
+               },
+               {
+                  "internalType" : "address[]",
+                  "name" : "targets",
+                  "type" : "address[]"
+               },
+               {
+                  "internalType" : "bytes[]",
+                  "name" : "calldatas",
+                  "type" : "bytes[]"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "endBlock",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "totalStake",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "eta",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "yesVotes",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "noVotes",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "enum Governance.ProposalState",
+                  "name" : "state",
+                  "type" : "uint8"
+               }
+            ],
+            "internalType" : "struct Governance.Proposal",
+            "name" : "",
+            "type" : "tuple"
+         }
+      ],
+      "stateMutability" : "view",
+      "type" : "function"
+   },
+   {
+      "inputs" : [],
+      "name" : "getProposalCount",
+      "outputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "",
+            "type" : "uint256"
+         }
+      ],
+      "stateMutability" : "view",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "_id",
+            "type" : "uint256"
+         },
+         {
+            "internalType" : "address",
+            "name" : "_account",
+            "type" : "address"
+         }
+      ],
+      "name" : "getVote",
+      "outputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "uint8",
+                  "name" : "choice",
+                  "type" : "uint8"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "power",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "address[]",
+                  "name" : "validators",
+                  "type" : "address[]"
+               },
+               {
+                  "internalType" : "uint256[]",
+                  "name" : "locked",
+                  "type" : "uint256[]"
+               }
+            ],
+            "internalType" : "struct Governance.Vote",
+            "name" : "",
+            "type" : "tuple"
+         }
+      ],
+      "stateMutability" : "view",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "_id",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "getVoters",
+      "outputs" : [
+         {
+            "internalType" : "address[]",
+            "name" : "",
+            "type" : "address[]"
+         }
+      ],
+      "stateMutability" : "view",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "internalType" : "address[]",
+            "name" : "_targets",
+            "type" : "address[]"
+         },
+         {
+            "internalType" : "bytes[]",
+            "name" : "_calldatas",
+            "type" : "bytes[]"
+         },
+         {
+            "internalType" : "string",
+            "name" : "_description",
+            "type" : "string"
+         }
+      ],
+      "name" : "propose",
+      "outputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "",
+            "type" : "uint256"
+         }
+      ],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "_id",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "release",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "components" : [
+               {
+                  "internalType" : "uint256",
+                  "name" : "votingPeriod",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "quorum",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "threshold",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "timelock",
+                  "type" : "uint256"
+               },
+               {
+                  "internalType" : "uint256",
+                  "name" : "minVotingPower",
+                  "type" : "uint256"
+               }
+            ],
+            "internalType" : "struct Governance.Config",
+            "name" : "_config",
+            "type" : "tuple"
+         }
+      ],
+      "name" : "setConfig",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "_id",
+            "type" : "uint256"
+         }
+      ],
+      "name" : "tally",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "_id",
+            "type" : "uint256"
+         },
+         {
+            "internalType" : "bool",
+            "name" : "_support",
+            "type" : "bool"
+         },
+         {
+            "internalType" : "address[]",
+            "name" : "_validators",
+            "type" : "address[]"
+         }
+      ],
+      "name" : "vote",
+      "outputs" : [],
+      "stateMutability" : "nonpayable",
+      "type" : "function"
+   },
+   {
+      "inputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "_id",
+            "type" : "uint256"
+         },
+         {
+            "internalType" : "address",
+            "name" : "_account",
+            "type" : "address"
+         },
+         {
+            "internalType" : "address[]",
+            "name" : "_validators",
+            "type" : "address[]"
+         }
+      ],
+      "name" : "votingPower",
+      "outputs" : [
+         {
+            "internalType" : "uint256",
+            "name" : "_power",
+            "type" : "uint256"
+         }
+      ],
+      "stateMutability" : "view",
+      "type" : "function"
+   }
+]
+`))
@@ -65,8 +65,13 @@ var (
 	SupplyControlContractAddress  = crypto.CreateAddress(DeployerAddress, 4)
 	StabilizationContractAddress  = crypto.CreateAddress(DeployerAddress, 5)
 	UpgradeManagerContractAddress = crypto.CreateAddress(DeployerAddress, 6)
+	GovernanceContractAddress     = crypto.CreateAddress(DeployerAddress, 7)
 )
 
+// GovernancePrecision is the precision of the quorum and threshold of the
+// Governance contract.
+const GovernancePrecision = 10_000
+
 type AutonityContractGenesis struct {
 	Bytecode         hexutil.Bytes  `json:"bytecode,omitempty" toml:",omitempty"`
 	ABI              *abi.ABI       `json:"abi,omitempty" toml:",omitempty"`
@@ -324,6 +329,53 @@ func (v *Validator) Validate() error {
 	return nil
 }
 
+// GovernanceGenesis is the configuration of the Governance contract. The
+// contract is deployed at genesis only if configured, and it is then the
+// operator account of the protocol contracts.
+type GovernanceGenesis struct {
+	Bytecode       hexutil.Bytes         `json:"bytecode,omitempty" toml:",omitempty"`
+	ABI            *abi.ABI              `json:"abi,omitempty" toml:",omitempty"`
+	VotingPeriod   uint64                `json:"votingPeriod"`
+	Quorum         uint64                `json:"quorum"`
+	Threshold      uint64                `json:"threshold"`
+	Timelock       uint64                `json:"timelock"`
+	MinVotingPower *math.HexOrDecimal256 `json:"minVotingPower"`
+}
+
+// SetDefaults uses the generated bytecode and ABI of the Governance contract
+// unless both are given.
+func (g *GovernanceGenesis) SetDefaults() error {
+	if g.Bytecode == nil && g.ABI != nil || g.Bytecode != nil && g.ABI == nil {
+		return errors.New("it is an error to set only one of governance contract abi or bytecode")
+	}
+	if g.Bytecode == nil && g.ABI == nil {
+		g.ABI = &generated.GovernanceAbi
+		g.Bytecode = generated.GovernanceBytecode
+	}
+	return nil
+}
+
+// Validate checks the Governance contract configuration against the rules
+// enforced by the contract.
+func (g *GovernanceGenesis) Validate() error {
+	if g.Bytecode == nil || g.ABI == nil {
+		return errors.New("governance contract abi or bytecode missing")
+	}
+	if g.VotingPeriod == 0 {
+		return errors.New("invalid governance voting period")
+	}
+	if g.Quorum > GovernancePrecision {
+		return errors.New("invalid governance quorum")
+	}
+	if g.Threshold == 0 || g.Threshold > GovernancePrecision {
+		return errors.New("invalid governance threshold")
+	}
+	if g.MinVotingPower == nil {
+		return errors.New("governance minimum voting power missing")
+	}
+	return nil
+}
+
 // OracleContractGenesis Autonity contract config. It'is used for deployment.
 type OracleContractGenesis struct {
 	Bytecode   hexutil.Bytes `json:"bytecode,omitempty" toml:",omitempty"`
@@ -6,9 +6,12 @@ import (
 	"net"
 	"testing"
 
+	"github.com/autonity/autonity/accounts/abi"
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/common/math"
 	"github.com/autonity/autonity/crypto"
 	"github.com/autonity/autonity/p2p/enode"
+	"github.com/autonity/autonity/params/generated"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -112,3 +115,40 @@ func TestPrepareAutonityContract_CommitteSizeNotProvided_Fail(t *testing.T) {
 	}
 	assert.Error(t, contractConfig.Prepare(), "Expecting Prepare to return error")
 }
+
+func TestGovernanceGenesisValidate(t *testing.T) {
+	valid := func() *GovernanceGenesis {
+		g := &GovernanceGenesis{
+			VotingPeriod:   100,
+			Quorum:         2_000,
+			Threshold:      5_000,
+			Timelock:       10,
+			MinVotingPower: (*math.HexOrDecimal256)(big.NewInt(1)),
+		}
+		require.NoError(t, g.SetDefaults())
+		return g
+	}
+	require.NoError(t, valid().Validate())
+	require.Equal(t, generated.GovernanceBytecode, []byte(valid().Bytecode))
+
+	// the bytecode and abi are given together
+	require.Error(t, (&GovernanceGenesis{Bytecode: []byte{0x00}}).SetDefaults())
+	require.Error(t, (&GovernanceGenesis{ABI: &abi.ABI{}}).SetDefaults())
+
+	tests := map[string]func(g *GovernanceGenesis){
+		"missing bytecode":          func(g *GovernanceGenesis) { g.Bytecode = nil },
+		"missing abi":               func(g *GovernanceGenesis) { g.ABI = nil },
+		"zero voting period":        func(g *GovernanceGenesis) { g.VotingPeriod = 0 },
+		"quorum above precision":    func(g *GovernanceGenesis) { g.Quorum = GovernancePrecision + 1 },
+		"zero threshold":            func(g *GovernanceGenesis) { g.Threshold = 0 },
+		"threshold above precision": func(g *GovernanceGenesis) { g.Threshold = GovernancePrecision + 1 },
+		"missing min voting power":  func(g *GovernanceGenesis) { g.MinVotingPower = nil },
+	}
+	for name, invalidate := range tests {
+		t.Run(name, func(t *testing.T) {
+			g := valid()
+			invalidate(g)
+			require.Error(t, g.Validate())
+		})
+	}
+}
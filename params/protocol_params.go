@@ -145,10 +145,21 @@ const (
 	Bn256PairingPerPointGasByzantium uint64 = 80000  // Byzantium per-point price for an elliptic curve pairing check
 	Bn256PairingPerPointGasIstanbul  uint64 = 34000  // Per-point price for an elliptic curve pairing check
 
-	AutonityEnodeCheckGas       uint64 = 5000 // Price for enode checker precompiled contracts
-	POPVerifierGas              uint64 = 5000 // Price for the POP verifier precompiled contract
-	AutonityAFDContractGasPerKB uint64 = 5000 // Price Unit for per KB data input for autonity AFD contracts
-	ProtocolOnlyBaseGas         uint64 = 1000 // Base price for protocol restricted precompile functions to avoid spamming.
+	AutonityEnodeCheckGas       uint64 = 5000  // Price for enode checker precompiled contracts
+	POPVerifierGas              uint64 = 5000  // Price for the POP verifier precompiled contract
+	RandomnessBeaconGas         uint64 = 75000 // Price for the randomness beacon precompiled contract, per beacon verified
+	AutonityAFDContractGasPerKB uint64 = 5000  // Price Unit for per KB data input for autonity AFD contracts
+	ProtocolOnlyBaseGas         uint64 = 1000  // Base price for protocol restricted precompile functions to avoid spamming.
+
+	BLSVerifyBaseGas                uint64 = 70000 // Base price for the BLS signature verification precompiled contract
+	BLSVerifyPerWordGas             uint64 = 12    // Per-word price of the message of a BLS signature verification
+	BLSAggregateVerifyBaseGas       uint64 = 45000 // Base price for the BLS aggregated signature verification precompiled contract
+	BLSAggregateVerifyPerPairGas    uint64 = 25000 // Per key and message price of a BLS aggregated signature verification
+	BLSAggregatePublicKeysBaseGas   uint64 = 600   // Base price for the BLS public key aggregation precompiled contract
+	BLSAggregatePublicKeysPerKeyGas uint64 = 3000  // Per key price of a BLS public key aggregation
+
+	RandomnessBeaconHistory uint64 = 256 // Number of recent blocks whose randomness is served by the randomness beacon precompiled contract
+	RandomnessBeaconWindow  uint64 = 4   // Number of consecutive beacons aggregated into the randomness of a block
 
 	Bls12381G1AddGas          uint64 = 600    // Price for BLS12-381 elliptic curve G1 point addition
 	Bls12381G1MulGas          uint64 = 12000  // Price for BLS12-381 elliptic curve G1 point scalar multiplication
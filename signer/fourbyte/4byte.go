@@ -1,6 +1,6 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// 4byte.json (6.047kB)
+// 4byte.json (6.252kB)
 
 package fourbyte
 
@@ -180,7 +180,11 @@ var __4byteJson = []byte(`{
   "55463ceb": "autonity()",
   "570ca735": "operator()",
   "6e3d9ff0": "upgrade(address,string)",
-  "ceaad455": "setUpgradeManagerContract(address)"
+  "ceaad455": "setUpgradeManagerContract(address)",
+  "da45591b": "updateConsensusEndpoints(bytes)",
+  "6c9cfd2d": "CONSENSUS_ENDPOINTS_CONTRACT()",
+  "2a400bc3": "lockLiquid(address,address,uint256)",
+  "39b17fec": "unlockLiquid(address,address,uint256)"
 }
 `)
 
@@ -195,7 +199,7 @@ func _4byteJson() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "4byte.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8a, 0x8e, 0x15, 0x6e, 0x21, 0x31, 0x3e, 0x32, 0x4, 0xb6, 0x9c, 0xd6, 0x74, 0xe3, 0x95, 0xad, 0x61, 0x91, 0xb5, 0x2c, 0x6e, 0x1a, 0x3d, 0x7f, 0xff, 0xac, 0xa8, 0x51, 0x3e, 0xc, 0xf5, 0x2b}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2b, 0x29, 0xef, 0x65, 0xb, 0xcc, 0xb, 0xb, 0xc, 0x85, 0x30, 0x25, 0x7a, 0xfe, 0x7f, 0x5f, 0xad, 0x54, 0x4b, 0xf1, 0x25, 0x64, 0x40, 0x7a, 0x29, 0x9a, 0x32, 0x88, 0x54, 0x43, 0xff, 0x44}}
 	return a, nil
 }
 
@@ -34,7 +34,8 @@ const leafChanSize = 200
 type leaf struct {
 	size int         // size of the rlp data (estimate)
 	hash common.Hash // hash of rlp data
-	node node        // the node to commit
+	node node        // the node to commit, nil for a deleted one (path-based scheme only)
+	path []byte      // the path of the node in the trie
 }
 
 // committer is a type used for the trie Commit operation. A committer has some
@@ -49,6 +50,12 @@ type committer struct {
 
 	onleaf LeafCallback
 	leafCh chan *leaf
+
+	// Fields used by the path-based scheme only, collecting the nodes written
+	// and deleted by the commit along with their paths.
+	owner  common.Hash
+	tracer *tracer
+	nodes  *nodeSet
 }
 
 // committers live in a global sync.Pool
@@ -69,6 +76,9 @@ func newCommitter() *committer {
 func returnCommitterToPool(h *committer) {
 	h.onleaf = nil
 	h.leafCh = nil
+	h.owner = common.Hash{}
+	h.tracer = nil
+	h.nodes = nil
 	committerPool.Put(h)
 }
 
@@ -77,7 +87,7 @@ func (c *committer) Commit(n node, db *Database) (hashNode, int, error) {
 	if db == nil {
 		return nil, 0, errors.New("no db provided")
 	}
-	h, committed, err := c.commit(n, db)
+	h, committed, err := c.commit(nil, n, db)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -85,7 +95,7 @@ func (c *committer) Commit(n node, db *Database) (hashNode, int, error) {
 }
 
 // commit collapses a node down into a hash node and inserts it into the database
-func (c *committer) commit(n node, db *Database) (node, int, error) {
+func (c *committer) commit(path []byte, n node, db *Database) (node, int, error) {
 	// if this path is clean, use available cached data
 	hash, dirty := n.cache()
 	if hash != nil && !dirty {
@@ -101,7 +111,7 @@ func (c *committer) commit(n node, db *Database) (node, int, error) {
 		// otherwise it can only be hashNode or valueNode.
 		var childCommitted int
 		if _, ok := cn.Val.(*fullNode); ok {
-			childV, committed, err := c.commit(cn.Val, db)
+			childV, committed, err := c.commit(append(path, cn.Key...), cn.Val, db)
 			if err != nil {
 				return nil, 0, err
 			}
@@ -109,20 +119,20 @@ func (c *committer) commit(n node, db *Database) (node, int, error) {
 		}
 		// The key needs to be copied, since we're delivering it to database
 		collapsed.Key = hexToCompact(cn.Key)
-		hashedNode := c.store(collapsed, db)
+		hashedNode := c.store(path, collapsed, db)
 		if hn, ok := hashedNode.(hashNode); ok {
 			return hn, childCommitted + 1, nil
 		}
 		return collapsed, childCommitted, nil
 	case *fullNode:
-		hashedKids, childCommitted, err := c.commitChildren(cn, db)
+		hashedKids, childCommitted, err := c.commitChildren(path, cn, db)
 		if err != nil {
 			return nil, 0, err
 		}
 		collapsed := cn.copy()
 		collapsed.Children = hashedKids
 
-		hashedNode := c.store(collapsed, db)
+		hashedNode := c.store(path, collapsed, db)
 		if hn, ok := hashedNode.(hashNode); ok {
 			return hn, childCommitted + 1, nil
 		}
@@ -136,7 +146,7 @@ func (c *committer) commit(n node, db *Database) (node, int, error) {
 }
 
 // commitChildren commits the children of the given fullnode
-func (c *committer) commitChildren(n *fullNode, db *Database) ([17]node, int, error) {
+func (c *committer) commitChildren(path []byte, n *fullNode, db *Database) ([17]node, int, error) {
 	var (
 		committed int
 		children  [17]node
@@ -156,7 +166,7 @@ func (c *committer) commitChildren(n *fullNode, db *Database) ([17]node, int, er
 		// Commit the child recursively and store the "hashed" value.
 		// Note the returned node can be some embedded nodes, so it's
 		// possible the type is not hashNode.
-		hashed, childCommitted, err := c.commit(child, db)
+		hashed, childCommitted, err := c.commit(append(path, byte(i)), child, db)
 		if err != nil {
 			return children, 0, err
 		}
@@ -173,7 +183,7 @@ func (c *committer) commitChildren(n *fullNode, db *Database) ([17]node, int, er
 // store hashes the node n and if we have a storage layer specified, it writes
 // the key/value pair to it and tracks any node->child references as well as any
 // node->external trie references.
-func (c *committer) store(n node, db *Database) node {
+func (c *committer) store(path []byte, n node, db *Database) node {
 	// Larger nodes are replaced by their hash and stored in the database.
 	var (
 		hash, _ = n.cache()
@@ -184,6 +194,19 @@ func (c *committer) store(n node, db *Database) node {
 		// In theory, we should apply the leafCall here if it's not nil(embedded
 		// node usually contains value). But small value(less than 32bytes) is
 		// not our target.
+		//
+		// The node is embedded in its parent, in other words, this node
+		// will not be stored in the database independently, mark it as
+		// deleted only if the node was existent in database before.
+		if c.nodes != nil {
+			if _, ok := c.tracer.accessList[string(path)]; ok {
+				if c.leafCh != nil {
+					c.leafCh <- &leaf{path: common.CopyBytes(path)}
+				} else {
+					c.nodes.markDeleted(path)
+				}
+			}
+		}
 		return n
 	} else {
 		// We have the hash already, estimate the RLP encoding-size of the node.
@@ -197,7 +220,12 @@ func (c *committer) store(n node, db *Database) node {
 			size: size,
 			hash: common.BytesToHash(hash),
 			node: n,
+			path: common.CopyBytes(path),
 		}
+	} else if c.nodes != nil {
+		// Collect the node for the path-based scheme, it's only handed
+		// over to the database once the whole trie is committed.
+		c.nodes.markUpdated(path, common.BytesToHash(hash), n)
 	} else if db != nil {
 		// No leaf-callback used, but there's still a database. Do serial
 		// insertion
@@ -216,10 +244,18 @@ func (c *committer) commitLoop(db *Database) {
 			size = item.size
 			n    = item.node
 		)
+		if n == nil {
+			c.nodes.markDeleted(item.path)
+			continue
+		}
 		// We are pooling the trie nodes into an intermediate memory cache
-		db.lock.Lock()
-		db.insert(hash, size, n)
-		db.lock.Unlock()
+		if c.nodes != nil {
+			c.nodes.markUpdated(item.path, hash, n)
+		} else {
+			db.lock.Lock()
+			db.insert(hash, size, n)
+			db.lock.Unlock()
+		}
 
 		if c.onleaf != nil {
 			switch n := n.(type) {
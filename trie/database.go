@@ -88,6 +88,8 @@ type Database struct {
 	childrenSize  common.StorageSize // Storage size of the external children tracking
 	preimagesSize common.StorageSize // Storage size of the preimages cache
 
+	pathdb *pathDatabase // State of the path-based scheme, nil for the hash-based scheme
+
 	lock sync.RWMutex
 }
 
@@ -277,6 +279,10 @@ type Config struct {
 	Cache     int    // Memory allowance (MB) to use for caching trie nodes in memory
 	Journal   string // Journal of clean cache to survive node restarts
 	Preimages bool   // Flag whether the preimage of trie key is recorded
+
+	Scheme       string // Node storage scheme, rawdb.HashScheme if empty
+	StateHistory uint64 // Number of reverse state diffs retained by the path-based scheme, zero keeping all of them
+	ReadOnly     bool   // Flag whether the persistent state of the path-based scheme may be modified
 }
 
 // NewDatabase creates a new trie database to store ephemeral trie content before
@@ -308,6 +314,9 @@ func NewDatabaseWithConfig(diskdb ethdb.KeyValueStore, config *Config) *Database
 	if config == nil || config.Preimages { // TODO(karalabe): Flip to default off in the future
 		db.preimages = make(map[common.Hash][]byte)
 	}
+	if config != nil && config.Scheme == rawdb.PathScheme {
+		db.pathdb = newPathDatabase(db, config.StateHistory, config.ReadOnly)
+	}
 	return db
 }
 
@@ -367,9 +376,33 @@ func (db *Database) insertPreimage(hash common.Hash, preimage []byte) {
 	db.preimagesSize += common.StorageSize(common.HashLength + len(preimage))
 }
 
-// node retrieves a cached trie node from memory, or returns nil if none can be
-// found in the memory cache.
-func (db *Database) node(hash common.Hash) node {
+// node retrieves the trie node with the given owner, path and hash, or returns
+// nil if it can't be found. The owner and path are only used by the path-based
+// scheme.
+func (db *Database) node(owner common.Hash, path []byte, hash common.Hash) node {
+	if db.pathdb != nil {
+		if blob := db.pathNode(owner, path, hash); blob != nil {
+			return mustDecodeNode(hash[:], blob)
+		}
+		return nil
+	}
+	return db.nodeByHash(hash)
+}
+
+// nodeBlob retrieves the encoded trie node with the given owner, path and hash.
+func (db *Database) nodeBlob(owner common.Hash, path []byte, hash common.Hash) ([]byte, error) {
+	if db.pathdb != nil {
+		if blob := db.pathNode(owner, path, hash); blob != nil {
+			return blob, nil
+		}
+		return nil, errors.New("not found")
+	}
+	return db.Node(hash)
+}
+
+// nodeByHash retrieves a cached trie node from memory, or returns nil if none
+// can be found in the memory cache.
+func (db *Database) nodeByHash(hash common.Hash) node {
 	// Retrieve the node from the clean cache if available
 	if db.cleans != nil {
 		if enc := db.cleans.Get(nil, hash[:]); enc != nil {
@@ -405,11 +438,28 @@ func (db *Database) node(hash common.Hash) node {
 
 // Node retrieves an encoded cached trie node from memory. If it cannot be found
 // cached, the method queries the persistent database for the content.
+//
+// The path-based scheme can't locate persisted nodes by hash alone, so only
+// the nodes held in memory are available with it.
 func (db *Database) Node(hash common.Hash) ([]byte, error) {
 	// It doesn't make sense to retrieve the metaroot
 	if hash == (common.Hash{}) {
 		return nil, errors.New("not found")
 	}
+	if db.pathdb != nil {
+		db.lock.RLock()
+		dirty := db.pathdb.dirties[hash]
+		db.lock.RUnlock()
+		if dirty != nil {
+			return dirty.blob, nil
+		}
+		if db.cleans != nil {
+			if enc := db.cleans.Get(nil, hash[:]); enc != nil {
+				return enc, nil
+			}
+		}
+		return nil, errors.New("not found")
+	}
 	// Retrieve the node from the clean cache if available
 	if db.cleans != nil {
 		if enc := db.cleans.Get(nil, hash[:]); enc != nil {
@@ -468,6 +518,13 @@ func (db *Database) Nodes() []common.Hash {
 	db.lock.RLock()
 	defer db.lock.RUnlock()
 
+	if db.pathdb != nil {
+		hashes := make([]common.Hash, 0, len(db.pathdb.dirties))
+		for hash := range db.pathdb.dirties {
+			hashes = append(hashes, hash)
+		}
+		return hashes
+	}
 	var hashes = make([]common.Hash, 0, len(db.dirties))
 	for hash := range db.dirties {
 		if hash != (common.Hash{}) { // Special case for "root" references/nodes
@@ -481,7 +538,12 @@ func (db *Database) Nodes() []common.Hash {
 // This function is used to add reference between internal trie node
 // and external node(e.g. storage trie root), all internal trie nodes
 // are referenced together by database itself.
+//
+// It is a no-op for the path-based scheme, which doesn't garbage collect nodes.
 func (db *Database) Reference(child common.Hash, parent common.Hash) {
+	if db.pathdb != nil {
+		return
+	}
 	db.lock.Lock()
 	defer db.lock.Unlock()
 
@@ -509,13 +571,17 @@ func (db *Database) reference(child common.Hash, parent common.Hash) {
 	}
 }
 
-// Dereference removes an existing reference from a root node.
+// Dereference removes an existing reference from a root node. It is a no-op for
+// the path-based scheme.
 func (db *Database) Dereference(root common.Hash) {
 	// Sanity check to ensure that the meta-root is not removed
 	if root == (common.Hash{}) {
 		log.Error("Attempted to dereference the trie cache meta root")
 		return
 	}
+	if db.pathdb != nil {
+		return
+	}
 	db.lock.Lock()
 	defer db.lock.Unlock()
 
@@ -587,9 +653,15 @@ func (db *Database) dereference(child common.Hash, parent common.Hash) {
 // Cap iteratively flushes old but still referenced trie nodes until the total
 // memory usage goes below the given threshold.
 //
+// With the path-based scheme, the oldest diff layers are flattened into the disk
+// instead.
+//
 // Note, this method is a non-synchronized mutator. It is unsafe to call this
 // concurrently with other mutators.
 func (db *Database) Cap(limit common.StorageSize) error {
+	if db.pathdb != nil {
+		return db.pathCap(limit)
+	}
 	// Create a database batch to flush persistent data out. It is important that
 	// outside code doesn't see an inconsistent state (referenced data removed from
 	// memory cache during commit but not yet in persistent storage). This is ensured
@@ -690,9 +762,15 @@ func (db *Database) Cap(limit common.StorageSize) error {
 // to disk, forcefully tearing down all references in both directions. As a side
 // effect, all pre-images accumulated up to this point are also written.
 //
+// With the path-based scheme, all the diff layers up to the given state are
+// flattened into the disk, and the callback is not invoked.
+//
 // Note, this method is a non-synchronized mutator. It is unsafe to call this
 // concurrently with other mutators.
 func (db *Database) Commit(node common.Hash, report bool, callback func(common.Hash)) error {
+	if db.pathdb != nil {
+		return db.pathCommit(node)
+	}
 	// Create a database batch to flush persistent data out. It is important that
 	// outside code doesn't see an inconsistent state (referenced data removed from
 	// memory cache during commit but not yet in persistent storage). This is ensured
@@ -840,6 +918,9 @@ func (db *Database) Size() (common.StorageSize, common.StorageSize) {
 	db.lock.RLock()
 	defer db.lock.RUnlock()
 
+	if db.pathdb != nil {
+		return db.pathdb.size, db.preimagesSize
+	}
 	// db.dirtiesSize only contains the useful data in the cache, but when reporting
 	// the total memory consumption, the maintenance metadata is also needed to be
 	// counted.
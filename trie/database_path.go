@@ -0,0 +1,555 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package trie
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/metrics"
+	"github.com/autonity/autonity/rlp"
+)
+
+const (
+	// maxDiffLayers is the maximum number of diff layers kept in memory on top
+	// of the persistent state. Older layers are flattened into the disk.
+	maxDiffLayers = 128
+
+	// DefaultStateHistory is the default number of reverse diffs retained by the
+	// path-based scheme, bounding how far back the persistent state can be
+	// rolled back.
+	DefaultStateHistory = 90000
+)
+
+var (
+	pathDirtyHitMeter   = metrics.NewRegisteredMeter("trie/pathdb/dirty/hit", nil)
+	pathDirtyReadMeter  = metrics.NewRegisteredMeter("trie/pathdb/dirty/read", nil)
+	pathDiskReadMeter   = metrics.NewRegisteredMeter("trie/pathdb/disk/read", nil)
+	pathDiskFalseMeter  = metrics.NewRegisteredMeter("trie/pathdb/disk/false", nil)
+	pathFlushTimeTimer  = metrics.NewRegisteredResettingTimer("trie/pathdb/flush/time", nil)
+	pathFlushNodesMeter = metrics.NewRegisteredMeter("trie/pathdb/flush/nodes", nil)
+	pathFlushSizeMeter  = metrics.NewRegisteredMeter("trie/pathdb/flush/size", nil)
+)
+
+var (
+	// errReadOnly is returned if a read-only trie database is mutated.
+	errReadOnly = errors.New("read only trie database")
+
+	// errMissingParent is returned if a state is committed on top of a state
+	// which is neither persisted nor held in memory.
+	errMissingParent = errors.New("missing parent state")
+
+	// errStateUnrecoverable is returned if the persistent state can't be rolled
+	// back to the requested state.
+	errStateUnrecoverable = errors.New("state is unrecoverable")
+)
+
+// memoryNode is a trie node committed by the path-based scheme, along with
+// its hash. A nil blob marks a node deleted from the trie.
+type memoryNode struct {
+	hash common.Hash
+	blob []byte
+}
+
+// nodeSet contains the nodes written and deleted by the commit of a single
+// trie, keyed by their path.
+type nodeSet struct {
+	nodes map[string]*memoryNode
+}
+
+// newNodeSet initializes an empty node set.
+func newNodeSet() *nodeSet {
+	return &nodeSet{nodes: make(map[string]*memoryNode)}
+}
+
+// markUpdated marks the node as written with the given path.
+func (set *nodeSet) markUpdated(path []byte, hash common.Hash, n node) {
+	blob, err := rlp.EncodeToBytes(n)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode trie node: %v", err))
+	}
+	set.nodes[string(path)] = &memoryNode{hash: hash, blob: blob}
+}
+
+// markDeleted marks the node as deleted with the given path.
+func (set *nodeSet) markDeleted(path []byte) {
+	set.nodes[string(path)] = &memoryNode{}
+}
+
+// diffLayer is a collection of all the trie nodes changed by a state
+// transition, on top of the state of its parent. The persisted storage tries
+// of the accounts listed in wipes are removed before applying the nodes.
+type diffLayer struct {
+	root   common.Hash
+	parent common.Hash
+	nodes  map[common.Hash]map[string]*memoryNode // owner -> path -> node
+	wipes  map[common.Hash]struct{}
+	size   common.StorageSize
+}
+
+// dirtyNode is a trie node held by one or more diff layers, indexed by hash.
+type dirtyNode struct {
+	blob []byte
+	refs int
+}
+
+// pathDatabase is the state of the path-based scheme. A single version of
+// the state is persisted and the most recent state transitions are held in
+// memory as diff layers on top of it. Flattening a diff layer into the disk
+// records a reverse diff, so that the persistent state can be rolled back.
+type pathDatabase struct {
+	diskRoot common.Hash // Root of the persistent state
+	stateID  uint64      // Id of the persistent state, bumped by every flattened layer
+	history  uint64      // Number of reverse diffs to retain, zero keeping all of them
+	readOnly bool        // Flag whether the persistent state may be modified
+
+	layers  map[common.Hash]*diffLayer // Diff layers on top of the persistent state, keyed by state root
+	latest  common.Hash                // Root of the most recently created diff layer
+	dirties map[common.Hash]*dirtyNode // Nodes held by the diff layers, indexed by hash
+
+	pending map[common.Hash]map[string]*memoryNode // Nodes committed since the last state update
+	size    common.StorageSize                     // Storage size of the diff layers
+}
+
+// newPathDatabase loads the persistent state of the path-based scheme and the
+// diff layers journaled at the last shutdown.
+func newPathDatabase(db *Database, history uint64, readOnly bool) *pathDatabase {
+	pdb := &pathDatabase{
+		diskRoot: emptyRoot,
+		stateID:  rawdb.ReadPersistentStateID(db.diskdb),
+		history:  history,
+		readOnly: readOnly,
+		layers:   make(map[common.Hash]*diffLayer),
+		dirties:  make(map[common.Hash]*dirtyNode),
+		pending:  make(map[common.Hash]map[string]*memoryNode),
+	}
+	if blob, hash := rawdb.ReadAccountTrieNode(db.diskdb, nil); len(blob) > 0 {
+		pdb.diskRoot = hash
+	}
+	pdb.latest = pdb.diskRoot
+	if err := pdb.loadJournal(db); err != nil && !errors.Is(err, errMissingJournal) {
+		log.Info("Discarded trie journal", "err", err)
+	}
+	return pdb
+}
+
+// Scheme returns the node storage scheme used by the database.
+func (db *Database) Scheme() string {
+	if db.pathdb != nil {
+		return rawdb.PathScheme
+	}
+	return rawdb.HashScheme
+}
+
+// pathNode retrieves the encoded trie node with the given owner, path and hash
+// from the diff layers, the clean cache or the persistent state.
+func (db *Database) pathNode(owner common.Hash, path []byte, hash common.Hash) []byte {
+	db.lock.RLock()
+	dirty := db.pathdb.dirties[hash]
+	db.lock.RUnlock()
+
+	if dirty != nil {
+		pathDirtyHitMeter.Mark(1)
+		pathDirtyReadMeter.Mark(int64(len(dirty.blob)))
+		return dirty.blob
+	}
+	if db.cleans != nil {
+		if enc := db.cleans.Get(nil, hash[:]); enc != nil {
+			memcacheCleanHitMeter.Mark(1)
+			memcacheCleanReadMeter.Mark(int64(len(enc)))
+			return enc
+		}
+	}
+	// The persisted node may belong to a different version of the state, so
+	// only return it if it's the requested one.
+	blob, nodeHash := rawdb.ReadPathTrieNode(db.diskdb, owner, path)
+	if nodeHash != hash {
+		pathDiskFalseMeter.Mark(1)
+		return nil
+	}
+	pathDiskReadMeter.Mark(int64(len(blob)))
+	if db.cleans != nil {
+		db.cleans.Set(hash[:], blob)
+		memcacheCleanMissMeter.Mark(1)
+		memcacheCleanWriteMeter.Mark(int64(len(blob)))
+	}
+	return blob
+}
+
+// commitNodes stashes the nodes committed by a trie, until the state they
+// belong to is created by Update.
+func (db *Database) commitNodes(owner common.Hash, set *nodeSet) {
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	nodes := db.pathdb.pending[owner]
+	if nodes == nil {
+		db.pathdb.pending[owner] = set.nodes
+		return
+	}
+	for path, n := range set.nodes {
+		nodes[path] = n
+	}
+}
+
+// Update creates the state with the given root on top of its parent state,
+// out of the trie nodes committed since the last update. The storage tries of
+// the accounts in wipes are removed from the parent state before applying the
+// committed nodes. It is a no-op for the hash-based scheme.
+func (db *Database) Update(root common.Hash, parent common.Hash, wipes map[common.Hash]struct{}) error {
+	if db.pathdb == nil {
+		return nil
+	}
+	if db.pathdb.readOnly {
+		return errReadOnly
+	}
+	if parent == (common.Hash{}) {
+		parent = emptyRoot
+	}
+	db.lock.Lock()
+	pdb := db.pathdb
+	nodes := pdb.pending
+	pdb.pending = make(map[common.Hash]map[string]*memoryNode)
+
+	// Committing the same state twice, or an unchanged state, creates nothing.
+	if _, ok := pdb.layers[root]; ok || root == parent || root == pdb.diskRoot {
+		db.lock.Unlock()
+		return nil
+	}
+	if _, ok := pdb.layers[parent]; !ok && parent != pdb.diskRoot {
+		db.lock.Unlock()
+		return fmt.Errorf("%w: %x", errMissingParent, parent)
+	}
+	layer := &diffLayer{
+		root:   root,
+		parent: parent,
+		nodes:  nodes,
+		wipes:  wipes,
+	}
+	pdb.addLayer(layer)
+	db.lock.Unlock()
+
+	// Keep the number of diff layers bounded, flattening the oldest ones
+	for len(pdb.layers) > maxDiffLayers {
+		if err := db.flatten(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addLayer links a new diff layer and indexes its nodes.
+//
+// Note, this method assumes that the database's lock is held!
+func (pdb *pathDatabase) addLayer(layer *diffLayer) {
+	for _, nodes := range layer.nodes {
+		for path, n := range nodes {
+			layer.size += common.StorageSize(len(path) + len(n.blob) + common.HashLength)
+			if n.blob == nil {
+				continue
+			}
+			if dirty := pdb.dirties[n.hash]; dirty != nil {
+				dirty.refs++
+				continue
+			}
+			pdb.dirties[n.hash] = &dirtyNode{blob: n.blob, refs: 1}
+		}
+	}
+	pdb.layers[layer.root] = layer
+	pdb.latest = layer.root
+	pdb.size += layer.size
+}
+
+// removeLayer unlinks a diff layer and drops its nodes from the index.
+//
+// Note, this method assumes that the database's lock is held!
+func (pdb *pathDatabase) removeLayer(layer *diffLayer) {
+	for _, nodes := range layer.nodes {
+		for _, n := range nodes {
+			if n.blob == nil {
+				continue
+			}
+			if dirty := pdb.dirties[n.hash]; dirty != nil {
+				if dirty.refs--; dirty.refs == 0 {
+					delete(pdb.dirties, n.hash)
+				}
+			}
+		}
+	}
+	delete(pdb.layers, layer.root)
+	pdb.size -= layer.size
+}
+
+// bottom returns the diff layer right on top of the persistent state, on the
+// way to the most recent state.
+//
+// Note, this method assumes that the database's lock is held!
+func (pdb *pathDatabase) bottom() *diffLayer {
+	var bottom *diffLayer
+	for layer := pdb.layers[pdb.latest]; layer != nil; layer = pdb.layers[layer.parent] {
+		bottom = layer
+	}
+	return bottom
+}
+
+// dropStale removes all the diff layers which are not built on top of the
+// persistent state anymore.
+//
+// Note, this method assumes that the database's lock is held!
+func (pdb *pathDatabase) dropStale() {
+	live := map[common.Hash]bool{pdb.diskRoot: true}
+	for changed := true; changed; {
+		changed = false
+		for root, layer := range pdb.layers {
+			if !live[root] && live[layer.parent] {
+				live[root], changed = true, true
+			}
+		}
+	}
+	for root, layer := range pdb.layers {
+		if !live[root] {
+			pdb.removeLayer(layer)
+		}
+	}
+	if !live[pdb.latest] {
+		pdb.latest = pdb.diskRoot
+	}
+}
+
+// flatten writes the oldest diff layer on the way to the most recent state
+// into the disk, along with the reverse diff needed to roll it back.
+func (db *Database) flatten() error {
+	db.lock.RLock()
+	layer := db.pathdb.bottom()
+	db.lock.RUnlock()
+
+	if layer == nil {
+		return nil
+	}
+	var (
+		start   = time.Now()
+		pdb     = db.pathdb
+		batch   = db.diskdb.NewBatch()
+		id      = pdb.stateID + 1
+		history = &stateHistory{Parent: pdb.diskRoot, Root: layer.root}
+		written = make(map[common.Hash]map[string]bool)
+		nodes   int
+	)
+	// Record the previous content of every node modified by the layer first,
+	// wiping the deleted storage tries.
+	record := func(owner common.Hash, path []byte, prev []byte) {
+		if written[owner] == nil {
+			written[owner] = make(map[string]bool)
+		}
+		written[owner][string(path)] = true
+		history.Nodes = append(history.Nodes, historyNode{Owner: owner, Path: common.CopyBytes(path), Blob: common.CopyBytes(prev)})
+	}
+	for owner := range layer.wipes {
+		it := rawdb.IterateStorageTrieNodes(db.diskdb, owner)
+		for it.Next() {
+			_, _, path := rawdb.IsStorageTrieNode(it.Key())
+			record(owner, path, it.Value())
+			rawdb.DeleteStorageTrieNode(batch, owner, path)
+			nodes++
+		}
+		it.Release()
+	}
+	for owner, set := range layer.nodes {
+		for path, n := range set {
+			if !written[owner][path] {
+				prev, _ := rawdb.ReadPathTrieNode(db.diskdb, owner, []byte(path))
+				record(owner, []byte(path), prev)
+			}
+			if n.blob == nil {
+				rawdb.DeletePathTrieNode(batch, owner, []byte(path))
+			} else {
+				rawdb.WritePathTrieNode(batch, owner, []byte(path), n.blob)
+			}
+			nodes++
+		}
+	}
+	if err := history.write(batch, id); err != nil {
+		return err
+	}
+	rawdb.WriteStateID(batch, history.Parent, id-1)
+	rawdb.WriteStateID(batch, layer.root, id)
+	rawdb.WritePersistentStateID(batch, id)
+
+	// Prune the reverse diffs falling out of the retained window
+	if pdb.history > 0 && id > pdb.history {
+		pruneHistory(db.diskdb, batch, id-pdb.history)
+	}
+	// Flush the preimages along with the state, they are not needed by the
+	// persisted state but would be lost on a crash otherwise.
+	db.lock.RLock()
+	flushPreimages := db.preimages != nil && db.preimagesSize > 4*1024*1024
+	if flushPreimages {
+		rawdb.WritePreimages(batch, db.preimages)
+	}
+	db.lock.RUnlock()
+
+	size := batch.ValueSize()
+	if err := batch.Write(); err != nil {
+		log.Error("Failed to flatten diff layer", "err", err)
+		return err
+	}
+	db.lock.Lock()
+	if db.cleans != nil {
+		for _, set := range layer.nodes {
+			for _, n := range set {
+				if n.blob != nil {
+					db.cleans.Set(n.hash[:], n.blob)
+				}
+			}
+		}
+	}
+	if flushPreimages {
+		db.preimages, db.preimagesSize = make(map[common.Hash][]byte), 0
+	}
+	pdb.removeLayer(layer)
+	pdb.diskRoot, pdb.stateID = layer.root, id
+	pdb.dropStale()
+	db.lock.Unlock()
+
+	pathFlushTimeTimer.Update(time.Since(start))
+	pathFlushNodesMeter.Mark(int64(nodes))
+	pathFlushSizeMeter.Mark(int64(size))
+	log.Debug("Flattened diff layer into disk", "id", id, "root", layer.root, "nodes", nodes, "size", common.StorageSize(size), "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// pathCommit flattens all the diff layers up to the given state into the disk.
+func (db *Database) pathCommit(root common.Hash) error {
+	if db.pathdb.readOnly {
+		return errReadOnly
+	}
+	db.lock.RLock()
+	pending := len(db.pathdb.pending) > 0
+	diskRoot := db.pathdb.diskRoot
+	db.lock.RUnlock()
+
+	// Tries committed outside of a state transition are created on top of the
+	// persistent state.
+	if pending {
+		if err := db.Update(root, diskRoot, nil); err != nil {
+			return err
+		}
+	}
+	db.lock.Lock()
+	if _, ok := db.pathdb.layers[root]; !ok {
+		db.lock.Unlock()
+		if root == diskRoot || root == emptyRoot {
+			return nil
+		}
+		return fmt.Errorf("state %x is not available", root)
+	}
+	db.pathdb.latest = root
+	db.lock.Unlock()
+
+	for {
+		db.lock.RLock()
+		done := db.pathdb.diskRoot == root
+		db.lock.RUnlock()
+		if done {
+			break
+		}
+		if err := db.flatten(); err != nil {
+			return err
+		}
+	}
+	// Persist the preimages which didn't make it yet.
+	db.lock.Lock()
+	defer db.lock.Unlock()
+	if db.preimages != nil && len(db.preimages) > 0 {
+		rawdb.WritePreimages(db.diskdb, db.preimages)
+		db.preimages, db.preimagesSize = make(map[common.Hash][]byte), 0
+	}
+	return nil
+}
+
+// pathCap flattens diff layers into the disk until their total size goes below
+// the given threshold.
+func (db *Database) pathCap(limit common.StorageSize) error {
+	if db.pathdb.readOnly {
+		return nil
+	}
+	for {
+		db.lock.RLock()
+		size := db.pathdb.size
+		db.lock.RUnlock()
+		if size <= limit {
+			return nil
+		}
+		if err := db.flatten(); err != nil {
+			return err
+		}
+	}
+}
+
+// Enable resets the path-based scheme after the persistent state was replaced
+// underneath it, e.g. by snap sync. All the diff layers and the reverse diffs
+// are dropped, and the persistent state must have the given root.
+func (db *Database) Enable(root common.Hash) error {
+	if db.pathdb == nil {
+		return nil
+	}
+	if db.pathdb.readOnly {
+		return errReadOnly
+	}
+	blob, hash := rawdb.ReadAccountTrieNode(db.diskdb, nil)
+	if len(blob) == 0 {
+		hash = emptyRoot
+	}
+	if hash != root {
+		return fmt.Errorf("state root mismatch: stored %x, synced %x", hash, root)
+	}
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	// Drop the reverse diffs along with the state lookups, they don't match
+	// the new state anymore.
+	batch := db.diskdb.NewBatch()
+	for id := db.pathdb.stateID; id > 0; id-- {
+		h, err := readHistory(db.diskdb, id)
+		if err != nil {
+			break
+		}
+		rawdb.DeleteStateID(batch, h.Parent)
+		rawdb.DeleteStateID(batch, h.Root)
+		rawdb.DeleteStateHistory(batch, id)
+	}
+	rawdb.DeleteTrieJournal(batch)
+	rawdb.WritePersistentStateID(batch, 0)
+	rawdb.WriteStateID(batch, root, 0)
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	for _, layer := range db.pathdb.layers {
+		db.pathdb.removeLayer(layer)
+	}
+	db.pathdb.pending = make(map[common.Hash]map[string]*memoryNode)
+	db.pathdb.diskRoot, db.pathdb.latest, db.pathdb.stateID = root, root, 0
+	if db.cleans != nil {
+		db.cleans.Reset()
+	}
+	log.Info("Rebuilt trie database", "root", root)
+	return nil
+}
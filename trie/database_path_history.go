@@ -0,0 +1,164 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package trie
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/ethdb"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/rlp"
+)
+
+// historyNode is the content of a trie node before a state transition, an
+// empty blob meaning the node didn't exist.
+type historyNode struct {
+	Owner common.Hash
+	Path  []byte
+	Blob  []byte
+}
+
+// stateHistory is the reverse diff of a state transition flattened into the
+// persistent state. Applying it rolls the persistent state back from Root to
+// Parent.
+type stateHistory struct {
+	Parent common.Hash
+	Root   common.Hash
+	Nodes  []historyNode
+}
+
+// write stores the reverse diff with the given state id.
+func (h *stateHistory) write(db ethdb.KeyValueWriter, id uint64) error {
+	sort.Slice(h.Nodes, func(i, j int) bool {
+		if h.Nodes[i].Owner != h.Nodes[j].Owner {
+			return bytes.Compare(h.Nodes[i].Owner[:], h.Nodes[j].Owner[:]) < 0
+		}
+		return bytes.Compare(h.Nodes[i].Path, h.Nodes[j].Path) < 0
+	})
+	blob, err := rlp.EncodeToBytes(h)
+	if err != nil {
+		return err
+	}
+	rawdb.WriteStateHistory(db, id, blob)
+	return nil
+}
+
+// readHistory retrieves the reverse diff with the given state id.
+func readHistory(db ethdb.KeyValueReader, id uint64) (*stateHistory, error) {
+	blob := rawdb.ReadStateHistory(db, id)
+	if len(blob) == 0 {
+		return nil, fmt.Errorf("state history %d is not available", id)
+	}
+	var h stateHistory
+	if err := rlp.DecodeBytes(blob, &h); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+// pruneHistory deletes the reverse diff with the given state id, along with
+// the lookup of the state it rolls back to.
+func pruneHistory(db ethdb.KeyValueReader, batch ethdb.KeyValueWriter, id uint64) {
+	h, err := readHistory(db, id)
+	if err != nil {
+		return
+	}
+	if stored := rawdb.ReadStateID(db, h.Parent); stored != nil && *stored == id-1 {
+		rawdb.DeleteStateID(batch, h.Parent)
+	}
+	rawdb.DeleteStateHistory(batch, id)
+}
+
+// Recoverable returns whether the persistent state can be rolled back to the
+// state with the given root using the retained reverse diffs.
+func (db *Database) Recoverable(root common.Hash) bool {
+	if db.pathdb == nil {
+		return false
+	}
+	db.lock.RLock()
+	current := db.pathdb.stateID
+	db.lock.RUnlock()
+
+	id := rawdb.ReadStateID(db.diskdb, root)
+	if id == nil || *id >= current {
+		return false
+	}
+	// Reverse diffs are pruned from the oldest, so the whole range is retained
+	// if the oldest one needed is.
+	return rawdb.ReadStateHistory(db.diskdb, *id+1) != nil
+}
+
+// Recover rolls the persistent state back to the state with the given root,
+// applying the reverse diffs of all the state transitions since. All the diff
+// layers are discarded.
+func (db *Database) Recover(root common.Hash) error {
+	if db.pathdb == nil {
+		return errStateUnrecoverable
+	}
+	if db.pathdb.readOnly {
+		return errReadOnly
+	}
+	if !db.Recoverable(root) {
+		return fmt.Errorf("%w: %x", errStateUnrecoverable, root)
+	}
+	start := time.Now()
+	target := *rawdb.ReadStateID(db.diskdb, root)
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	pdb := db.pathdb
+	for _, layer := range pdb.layers {
+		pdb.removeLayer(layer)
+	}
+	pdb.pending = make(map[common.Hash]map[string]*memoryNode)
+	pdb.latest = pdb.diskRoot
+
+	for pdb.stateID > target {
+		h, err := readHistory(db.diskdb, pdb.stateID)
+		if err != nil {
+			return err
+		}
+		if h.Root != pdb.diskRoot {
+			return fmt.Errorf("state history %d mismatch: have root %x, want %x", pdb.stateID, h.Root, pdb.diskRoot)
+		}
+		batch := db.diskdb.NewBatch()
+		for _, n := range h.Nodes {
+			if len(n.Blob) == 0 {
+				rawdb.DeletePathTrieNode(batch, n.Owner, n.Path)
+			} else {
+				rawdb.WritePathTrieNode(batch, n.Owner, n.Path, n.Blob)
+			}
+		}
+		if stored := rawdb.ReadStateID(db.diskdb, h.Root); stored != nil && *stored == pdb.stateID {
+			rawdb.DeleteStateID(batch, h.Root)
+		}
+		rawdb.DeleteStateHistory(batch, pdb.stateID)
+		rawdb.WritePersistentStateID(batch, pdb.stateID-1)
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		pdb.stateID, pdb.diskRoot, pdb.latest = pdb.stateID-1, h.Parent, h.Parent
+	}
+	log.Info("Recovered persistent state", "root", root, "id", pdb.stateID, "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package trie
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/log"
+	"github.com/autonity/autonity/rlp"
+)
+
+// journalVersion ensures that an incompatible journal is detected and discarded.
+const journalVersion uint64 = 0
+
+var (
+	errMissingJournal       = errors.New("journal not found")
+	errUnexpectedVersion    = errors.New("unexpected journal version")
+	errUnmatchedJournalRoot = errors.New("unmatched journal root")
+)
+
+// journalNodes is the list of nodes of a single trie in a journaled diff layer.
+type journalNodes struct {
+	Owner  common.Hash
+	Paths  [][]byte
+	Blobs  [][]byte // empty for deleted nodes
+	Hashes []common.Hash
+}
+
+// journalLayer is a journaled diff layer.
+type journalLayer struct {
+	Root   common.Hash
+	Parent common.Hash
+	Wipes  []common.Hash
+	Nodes  []journalNodes
+}
+
+// journal is the content of the journal written at shutdown.
+type journal struct {
+	Version  uint64
+	DiskRoot common.Hash
+	Layers   []journalLayer // ordered from the bottom to the top
+}
+
+// Journal writes the diff layers leading to the state with the given root
+// into the database, so that they survive a restart. It is a no-op for the
+// hash-based scheme.
+func (db *Database) Journal(root common.Hash) error {
+	if db.pathdb == nil {
+		return nil
+	}
+	if db.pathdb.readOnly {
+		return errReadOnly
+	}
+	start := time.Now()
+
+	db.lock.Lock()
+	defer db.lock.Unlock()
+
+	pdb := db.pathdb
+	if _, ok := pdb.layers[root]; !ok && root != pdb.diskRoot {
+		return fmt.Errorf("state %x is not available", root)
+	}
+	var layers []journalLayer
+	for layer := pdb.layers[root]; layer != nil; layer = pdb.layers[layer.parent] {
+		entry := journalLayer{Root: layer.root, Parent: layer.parent}
+		for owner := range layer.wipes {
+			entry.Wipes = append(entry.Wipes, owner)
+		}
+		for owner, set := range layer.nodes {
+			nodes := journalNodes{Owner: owner}
+			for path, n := range set {
+				nodes.Paths = append(nodes.Paths, []byte(path))
+				nodes.Blobs = append(nodes.Blobs, n.blob)
+				nodes.Hashes = append(nodes.Hashes, n.hash)
+			}
+			entry.Nodes = append(entry.Nodes, nodes)
+		}
+		layers = append([]journalLayer{entry}, layers...)
+	}
+	blob, err := rlp.EncodeToBytes(&journal{Version: journalVersion, DiskRoot: pdb.diskRoot, Layers: layers})
+	if err != nil {
+		return err
+	}
+	batch := db.diskdb.NewBatch()
+	rawdb.WriteTrieJournal(batch, blob)
+	if db.preimages != nil && len(db.preimages) > 0 {
+		rawdb.WritePreimages(batch, db.preimages)
+		db.preimages, db.preimagesSize = make(map[common.Hash][]byte), 0
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	log.Info("Persisted trie journal", "disk", pdb.diskRoot, "layers", len(layers), "size", common.StorageSize(len(blob)), "elapsed", common.PrettyDuration(time.Since(start)))
+	return nil
+}
+
+// loadJournal restores the diff layers journaled at the last shutdown, as long
+// as they were built on top of the current persistent state.
+func (pdb *pathDatabase) loadJournal(db *Database) error {
+	blob := rawdb.ReadTrieJournal(db.diskdb)
+	if len(blob) == 0 {
+		return errMissingJournal
+	}
+	var j journal
+	if err := rlp.DecodeBytes(blob, &j); err != nil {
+		return err
+	}
+	if j.Version != journalVersion {
+		return fmt.Errorf("%w: have %d, want %d", errUnexpectedVersion, j.Version, journalVersion)
+	}
+	if j.DiskRoot != pdb.diskRoot {
+		return fmt.Errorf("%w: have %x, want %x", errUnmatchedJournalRoot, j.DiskRoot, pdb.diskRoot)
+	}
+	var (
+		layers []*diffLayer
+		known  = map[common.Hash]bool{pdb.diskRoot: true}
+	)
+	for _, entry := range j.Layers {
+		if !known[entry.Parent] {
+			return fmt.Errorf("%w: %x", errMissingParent, entry.Parent)
+		}
+		known[entry.Root] = true
+
+		layer := &diffLayer{
+			root:   entry.Root,
+			parent: entry.Parent,
+			nodes:  make(map[common.Hash]map[string]*memoryNode),
+		}
+		if len(entry.Wipes) > 0 {
+			layer.wipes = make(map[common.Hash]struct{})
+			for _, owner := range entry.Wipes {
+				layer.wipes[owner] = struct{}{}
+			}
+		}
+		for _, nodes := range entry.Nodes {
+			if len(nodes.Paths) != len(nodes.Blobs) || len(nodes.Paths) != len(nodes.Hashes) {
+				return errors.New("corrupted journal")
+			}
+			set := make(map[string]*memoryNode, len(nodes.Paths))
+			for i, path := range nodes.Paths {
+				n := &memoryNode{hash: nodes.Hashes[i]}
+				if len(nodes.Blobs[i]) > 0 {
+					n.blob = nodes.Blobs[i]
+					if crypto.Keccak256Hash(n.blob) != n.hash {
+						return errors.New("corrupted journal")
+					}
+				}
+				set[string(path)] = n
+			}
+			layer.nodes[nodes.Owner] = set
+		}
+		layers = append(layers, layer)
+	}
+	for _, layer := range layers {
+		pdb.addLayer(layer)
+	}
+	log.Info("Loaded trie journal", "disk", pdb.diskRoot, "layers", len(j.Layers))
+	return nil
+}
@@ -0,0 +1,392 @@
+// Copyright 2023 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package trie
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/rawdb"
+	"github.com/autonity/autonity/crypto"
+	"github.com/autonity/autonity/ethdb"
+	"github.com/autonity/autonity/ethdb/memorydb"
+)
+
+// pathTester builds a chain of states in a path-based trie database, tracking
+// the expected content of each of them.
+type pathTester struct {
+	t        *testing.T
+	disk     ethdb.KeyValueStore
+	db       *Database
+	roots    []common.Hash
+	contents []map[string][]byte
+}
+
+func newPathTester(t *testing.T, history uint64) *pathTester {
+	disk := memorydb.New()
+	return &pathTester{
+		t:        t,
+		disk:     disk,
+		db:       NewDatabaseWithConfig(disk, &Config{Scheme: rawdb.PathScheme, StateHistory: history}),
+		roots:    []common.Hash{emptyRoot},
+		contents: []map[string][]byte{{}},
+	}
+}
+
+// next creates a new state on top of the last one, updating and deleting a
+// few entries.
+func (pt *pathTester) next() common.Hash {
+	var (
+		n       = len(pt.roots)
+		parent  = pt.roots[n-1]
+		content = make(map[string][]byte)
+	)
+	for k, v := range pt.contents[n-1] {
+		content[k] = v
+	}
+	tr, err := New(parent, pt.db)
+	if err != nil {
+		pt.t.Fatalf("failed to open state %d: %v", n-1, err)
+	}
+	for i := 0; i < 20; i++ {
+		key := crypto.Keccak256([]byte(fmt.Sprintf("key-%d", (n*7+i)%100)))
+		if i%5 == 4 {
+			tr.Delete(key)
+			delete(content, string(key))
+			continue
+		}
+		val := crypto.Keccak256([]byte(fmt.Sprintf("val-%d-%d", n, i)))
+		tr.Update(key, val)
+		content[string(key)] = val
+	}
+	root, _, err := tr.Commit(nil)
+	if err != nil {
+		pt.t.Fatalf("failed to commit state %d: %v", n, err)
+	}
+	if err := pt.db.Update(root, parent, nil); err != nil {
+		pt.t.Fatalf("failed to update state %d: %v", n, err)
+	}
+	pt.roots = append(pt.roots, root)
+	pt.contents = append(pt.contents, content)
+	return root
+}
+
+// check verifies that the state with the given index is available in db, and
+// holds the expected content.
+func (pt *pathTester) check(db *Database, index int) error {
+	tr, err := New(pt.roots[index], db)
+	if err != nil {
+		return err
+	}
+	var count int
+	it := NewIterator(tr.NodeIterator(nil))
+	for it.Next() {
+		if want := pt.contents[index][string(it.Key)]; !bytes.Equal(it.Value, want) {
+			return fmt.Errorf("entry %x: content mismatch: have %x, want %x", it.Key, it.Value, want)
+		}
+		count++
+	}
+	if it.Err != nil {
+		return it.Err
+	}
+	if count != len(pt.contents[index]) {
+		return fmt.Errorf("entry count mismatch: have %d, want %d", count, len(pt.contents[index]))
+	}
+	return nil
+}
+
+// checkDisk verifies that the persisted nodes are exactly the ones of the
+// state with the given index.
+func (pt *pathTester) checkDisk(index int) {
+	tr, err := New(pt.roots[index], pt.db)
+	if err != nil {
+		pt.t.Fatalf("failed to open state %d: %v", index, err)
+	}
+	want := make(map[string]common.Hash)
+	for it := tr.NodeIterator(nil); it.Next(true); {
+		if it.Hash() != (common.Hash{}) {
+			want[string(it.Path())] = it.Hash()
+		}
+	}
+	have := make(map[string]common.Hash)
+	it := pt.disk.NewIterator(rawdb.TrieNodeAccountPrefix, nil)
+	defer it.Release()
+	for it.Next() {
+		if ok, path := rawdb.IsAccountTrieNode(it.Key()); ok {
+			have[string(path)] = crypto.Keccak256Hash(it.Value())
+		}
+	}
+	if len(have) != len(want) {
+		pt.t.Fatalf("persisted node count mismatch: have %d, want %d", len(have), len(want))
+	}
+	for path, hash := range want {
+		if have[path] != hash {
+			pt.t.Fatalf("persisted node %x mismatch: have %x, want %x", path, have[path], hash)
+		}
+	}
+}
+
+// Tests that the diff layers beyond the limit are flattened into the disk, and
+// that all the retained states remain accessible.
+func TestPathDatabaseFlatten(t *testing.T) {
+	pt := newPathTester(t, 0)
+	for i := 0; i < maxDiffLayers+10; i++ {
+		pt.next()
+	}
+	if have := len(pt.db.pathdb.layers); have != maxDiffLayers {
+		t.Fatalf("diff layer count mismatch: have %d, want %d", have, maxDiffLayers)
+	}
+	if have, want := pt.db.pathdb.diskRoot, pt.roots[10]; have != want {
+		t.Fatalf("disk root mismatch: have %x, want %x", have, want)
+	}
+	if id := rawdb.ReadPersistentStateID(pt.disk); id != 10 {
+		t.Fatalf("persistent state id mismatch: have %d, want %d", id, 10)
+	}
+	for i := 10; i < len(pt.roots); i++ {
+		if err := pt.check(pt.db, i); err != nil {
+			t.Fatalf("state %d: %v", i, err)
+		}
+	}
+	for i := 1; i < 10; i++ {
+		if err := pt.check(pt.db, i); err == nil {
+			t.Fatalf("state %d available after being flattened", i)
+		}
+	}
+	// Nodes deleted by the flattened layers have to be removed from disk
+	pt.checkDisk(10)
+
+	// Committing flattens the remaining layers as well
+	head := len(pt.roots) - 1
+	if err := pt.db.Commit(pt.roots[head], false, nil); err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+	if len(pt.db.pathdb.layers) != 0 {
+		t.Fatalf("diff layers left after commit: %d", len(pt.db.pathdb.layers))
+	}
+	pt.checkDisk(head)
+	if err := pt.check(NewDatabaseWithConfig(pt.disk, &Config{Scheme: rawdb.PathScheme}), head); err != nil {
+		t.Fatalf("persisted state: %v", err)
+	}
+}
+
+// Tests that the diff layers are restored from the journal after a restart,
+// unless the persistent state changed since.
+func TestPathDatabaseJournal(t *testing.T) {
+	pt := newPathTester(t, 0)
+	for i := 0; i < 5; i++ {
+		pt.next()
+	}
+	if err := pt.db.Commit(pt.roots[5], false, nil); err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+	for i := 0; i < 20; i++ {
+		pt.next()
+	}
+	head := len(pt.roots) - 1
+	if err := pt.db.Journal(pt.roots[head]); err != nil {
+		t.Fatalf("failed to journal: %v", err)
+	}
+	restored := NewDatabaseWithConfig(pt.disk, &Config{Scheme: rawdb.PathScheme})
+	for i := 5; i <= head; i++ {
+		if err := pt.check(restored, i); err != nil {
+			t.Fatalf("restored state %d: %v", i, err)
+		}
+	}
+	// New states have to be buildable on top of the restored ones
+	pt.db = restored
+	pt.next()
+	if err := pt.check(pt.db, head+1); err != nil {
+		t.Fatalf("state after restart: %v", err)
+	}
+	// A journal written on top of a different persistent state is discarded
+	if err := pt.db.Commit(pt.roots[head+1], false, nil); err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+	restored = NewDatabaseWithConfig(pt.disk, &Config{Scheme: rawdb.PathScheme})
+	if len(restored.pathdb.layers) != 0 {
+		t.Fatalf("stale journal loaded: %d layers", len(restored.pathdb.layers))
+	}
+	if err := pt.check(restored, head+1); err != nil {
+		t.Fatalf("persisted state: %v", err)
+	}
+}
+
+// Tests that the persistent state can be rolled back within the retained
+// state history, and that older histories are pruned.
+func TestPathDatabaseRecover(t *testing.T) {
+	pt := newPathTester(t, 4)
+	for i := 0; i < 10; i++ {
+		pt.next()
+		if err := pt.db.Commit(pt.roots[i+1], false, nil); err != nil {
+			t.Fatalf("failed to commit state %d: %v", i+1, err)
+		}
+	}
+	for i, want := range []bool{false, false, false, false, false, false, true, true, true, true, false} {
+		if have := pt.db.Recoverable(pt.roots[i]); have != want {
+			t.Errorf("state %d recoverability mismatch: have %v, want %v", i, have, want)
+		}
+	}
+	if err := pt.db.Recover(pt.roots[5]); err == nil {
+		t.Fatalf("recovered state beyond the retained history")
+	}
+	if err := pt.db.Recover(pt.roots[7]); err != nil {
+		t.Fatalf("failed to recover state: %v", err)
+	}
+	if err := pt.check(pt.db, 7); err != nil {
+		t.Fatalf("recovered state: %v", err)
+	}
+	pt.checkDisk(7)
+	for i := 8; i <= 10; i++ {
+		if err := pt.check(pt.db, i); err == nil {
+			t.Fatalf("state %d available after rollback", i)
+		}
+	}
+	if id := rawdb.ReadPersistentStateID(pt.disk); id != 7 {
+		t.Fatalf("persistent state id mismatch: have %d, want %d", id, 7)
+	}
+	// The chain continues from the recovered state
+	pt.roots, pt.contents = pt.roots[:8], pt.contents[:8]
+	pt.next()
+	if err := pt.db.Commit(pt.roots[8], false, nil); err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+	if err := pt.check(pt.db, 8); err != nil {
+		t.Fatalf("state after rollback: %v", err)
+	}
+	if !pt.db.Recoverable(pt.roots[7]) {
+		t.Fatalf("recovered state not recoverable anymore")
+	}
+}
+
+// Tests that wiping a storage trie removes all of its persisted nodes.
+func TestPathDatabaseWipe(t *testing.T) {
+	var (
+		disk  = memorydb.New()
+		db    = NewDatabaseWithConfig(disk, &Config{Scheme: rawdb.PathScheme})
+		owner = common.HexToHash("0x01")
+	)
+	storage, _ := NewWithOwner(owner, common.Hash{}, db)
+	for i := 0; i < 100; i++ {
+		storage.Update(crypto.Keccak256([]byte{byte(i)}), crypto.Keccak256([]byte{byte(i), 1}))
+	}
+	storageRoot, _, _ := storage.Commit(nil)
+
+	account, _ := New(common.Hash{}, db)
+	account.Update(owner[:], storageRoot[:])
+	root, _, _ := account.Commit(nil)
+	if err := db.Update(root, emptyRoot, nil); err != nil {
+		t.Fatalf("failed to update: %v", err)
+	}
+	if err := db.Commit(root, false, nil); err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+	countStorage := func() int {
+		var count int
+		it := rawdb.IterateStorageTrieNodes(disk, owner)
+		defer it.Release()
+		for it.Next() {
+			count++
+		}
+		return count
+	}
+	if countStorage() == 0 {
+		t.Fatalf("storage trie not persisted")
+	}
+	account, _ = New(root, db)
+	account.Update(owner[:], []byte{0x01})
+	wiped, _, _ := account.Commit(nil)
+	if err := db.Update(wiped, root, map[common.Hash]struct{}{owner: {}}); err != nil {
+		t.Fatalf("failed to update: %v", err)
+	}
+	if err := db.Commit(wiped, false, nil); err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+	if count := countStorage(); count != 0 {
+		t.Fatalf("storage trie nodes left after wipe: %d", count)
+	}
+	// The wiped storage trie is brought back by rolling back
+	if err := db.Recover(root); err != nil {
+		t.Fatalf("failed to recover: %v", err)
+	}
+	restored, err := NewWithOwner(owner, storageRoot, db)
+	if err != nil {
+		t.Fatalf("failed to open restored storage trie: %v", err)
+	}
+	if have, want := restored.Get(crypto.Keccak256([]byte{1})), crypto.Keccak256([]byte{1, 1}); !bytes.Equal(have, want) {
+		t.Fatalf("restored storage mismatch: have %x, want %x", have, want)
+	}
+}
+
+// Tests that the stack trie hands over the same nodes, at the same paths, as
+// the ones persisted by a regular trie.
+func TestStackTriePaths(t *testing.T) {
+	pt := newPathTester(t, 0)
+	pt.next()
+	if err := pt.db.Commit(pt.roots[1], false, nil); err != nil {
+		t.Fatalf("failed to commit: %v", err)
+	}
+	var keys []string
+	for key := range pt.contents[1] {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	disk := memorydb.New()
+	st := NewStackTrieWithOwner(func(owner common.Hash, path []byte, hash common.Hash, blob []byte) {
+		rawdb.WritePathTrieNode(disk, owner, path, blob)
+	}, common.Hash{})
+	for _, key := range keys {
+		st.Update([]byte(key), pt.contents[1][key])
+	}
+	if root, _ := st.Commit(); root != pt.roots[1] {
+		t.Fatalf("root mismatch: have %x, want %x", root, pt.roots[1])
+	}
+	pt.disk = disk
+	pt.checkDisk(1)
+}
+
+// Tests that a trie synced with the path-based scheme is stored by path.
+func TestPathSync(t *testing.T) {
+	srcDb, srcTrie, srcData := makeTestTrie()
+
+	diskdb := memorydb.New()
+	sched := NewSync(srcTrie.Hash(), diskdb, nil, rawdb.PathScheme)
+
+	nodes, _, codes := sched.Missing(100)
+	for len(nodes) > 0 || len(codes) > 0 {
+		for _, hash := range nodes {
+			data, err := srcDb.Node(hash)
+			if err != nil {
+				t.Fatalf("failed to retrieve node data for %x: %v", hash, err)
+			}
+			if err := sched.Process(SyncResult{hash, data}); err != nil && err != ErrAlreadyProcessed {
+				t.Fatalf("failed to process result %v", err)
+			}
+		}
+		batch := diskdb.NewBatch()
+		if err := sched.Commit(batch); err != nil {
+			t.Fatalf("failed to commit data: %v", err)
+		}
+		batch.Write()
+		nodes, _, codes = sched.Missing(100)
+	}
+	db := NewDatabaseWithConfig(diskdb, &Config{Scheme: rawdb.PathScheme})
+	checkTrieContents(t, db, srcTrie.Hash().Bytes(), srcData)
+}
@@ -33,15 +33,22 @@ import (
 
 var ErrCommitDisabled = errors.New("no database for committing")
 
+// NodeWriteFunc is used to provide all information of a dirty node for
+// committing, so that callers can flush the node into the database with
+// either scheme.
+type NodeWriteFunc = func(owner common.Hash, path []byte, hash common.Hash, blob []byte)
+
 var stPool = sync.Pool{
 	New: func() interface{} {
 		return NewStackTrie(nil)
 	},
 }
 
-func stackTrieFromPool(db ethdb.KeyValueWriter) *StackTrie {
+func stackTrieFromPool(db ethdb.KeyValueWriter, owner common.Hash, writeFn NodeWriteFunc) *StackTrie {
 	st := stPool.Get().(*StackTrie)
 	st.db = db
+	st.owner = owner
+	st.writeFn = writeFn
 	return st
 }
 
@@ -59,6 +66,8 @@ type StackTrie struct {
 	key      []byte               // key chunk covered by this (leaf|ext) node
 	children [16]*StackTrie       // list of children (for branch and exts)
 	db       ethdb.KeyValueWriter // Pointer to the commit db, can be nil
+	owner    common.Hash          // the hash of the account owning the trie, empty for the account trie
+	writeFn  NodeWriteFunc        // function for committing nodes with their paths, overrides db if set
 }
 
 // NewStackTrie allocates and initializes an empty trie.
@@ -69,6 +78,16 @@ func NewStackTrie(db ethdb.KeyValueWriter) *StackTrie {
 	}
 }
 
+// NewStackTrieWithOwner allocates and initializes an empty trie, handing the
+// committed nodes over to writeFn along with their owner and path.
+func NewStackTrieWithOwner(writeFn NodeWriteFunc, owner common.Hash) *StackTrie {
+	return &StackTrie{
+		nodeType: emptyNode,
+		owner:    owner,
+		writeFn:  writeFn,
+	}
+}
+
 // NewFromBinary initialises a serialized stacktrie with the given db.
 func NewFromBinary(data []byte, db ethdb.KeyValueWriter) (*StackTrie, error) {
 	var st StackTrie
@@ -155,16 +174,16 @@ func (st *StackTrie) setDb(db ethdb.KeyValueWriter) {
 	}
 }
 
-func newLeaf(key, val []byte, db ethdb.KeyValueWriter) *StackTrie {
-	st := stackTrieFromPool(db)
+func newLeaf(key, val []byte, db ethdb.KeyValueWriter, owner common.Hash, writeFn NodeWriteFunc) *StackTrie {
+	st := stackTrieFromPool(db, owner, writeFn)
 	st.nodeType = leafNode
 	st.key = append(st.key, key...)
 	st.val = val
 	return st
 }
 
-func newExt(key []byte, child *StackTrie, db ethdb.KeyValueWriter) *StackTrie {
-	st := stackTrieFromPool(db)
+func newExt(key []byte, child *StackTrie, db ethdb.KeyValueWriter, owner common.Hash, writeFn NodeWriteFunc) *StackTrie {
+	st := stackTrieFromPool(db, owner, writeFn)
 	st.nodeType = extNode
 	st.key = append(st.key, key...)
 	st.children[0] = child
@@ -186,7 +205,7 @@ func (st *StackTrie) TryUpdate(key, value []byte) error {
 	if len(value) == 0 {
 		panic("deletion not supported")
 	}
-	st.insert(k[:len(k)-1], value)
+	st.insert(k[:len(k)-1], value, nil)
 	return nil
 }
 
@@ -198,6 +217,8 @@ func (st *StackTrie) Update(key, value []byte) {
 
 func (st *StackTrie) Reset() {
 	st.db = nil
+	st.owner = common.Hash{}
+	st.writeFn = nil
 	st.key = st.key[:0]
 	st.val = nil
 	for i := range st.children {
@@ -219,8 +240,8 @@ func (st *StackTrie) getDiffIndex(key []byte) int {
 }
 
 // Helper function to that inserts a (key, value) pair into
-// the trie.
-func (st *StackTrie) insert(key, value []byte) {
+// the trie. The prefix is the path of st in the trie.
+func (st *StackTrie) insert(key, value []byte, prefix []byte) {
 	switch st.nodeType {
 	case branchNode: /* Branch */
 		idx := int(key[0])
@@ -228,16 +249,16 @@ func (st *StackTrie) insert(key, value []byte) {
 		for i := idx - 1; i >= 0; i-- {
 			if st.children[i] != nil {
 				if st.children[i].nodeType != hashedNode {
-					st.children[i].hash()
+					st.children[i].hash(append(prefix, byte(i)))
 				}
 				break
 			}
 		}
 		// Add new child
 		if st.children[idx] == nil {
-			st.children[idx] = newLeaf(key[1:], value, st.db)
+			st.children[idx] = newLeaf(key[1:], value, st.db, st.owner, st.writeFn)
 		} else {
-			st.children[idx].insert(key[1:], value)
+			st.children[idx].insert(key[1:], value, append(prefix, key[0]))
 		}
 	case extNode: /* Ext */
 		// Compare both key chunks and see where they differ
@@ -251,7 +272,7 @@ func (st *StackTrie) insert(key, value []byte) {
 		if diffidx == len(st.key) {
 			// Ext key and key segment are identical, recurse into
 			// the child node.
-			st.children[0].insert(key[diffidx:], value)
+			st.children[0].insert(key[diffidx:], value, append(prefix, st.key...))
 			return
 		}
 		// Save the original part. Depending if the break is
@@ -260,14 +281,16 @@ func (st *StackTrie) insert(key, value []byte) {
 		// node directly.
 		var n *StackTrie
 		if diffidx < len(st.key)-1 {
-			n = newExt(st.key[diffidx+1:], st.children[0], st.db)
+			n = newExt(st.key[diffidx+1:], st.children[0], st.db, st.owner, st.writeFn)
+			n.hash(append(prefix, st.key[:diffidx+1]...))
 		} else {
 			// Break on the last byte, no need to insert
-			// an extension node: reuse the current node
+			// an extension node: reuse the current node.
+			// The path prefix of the newly-inserted extension should
+			// also contain the different byte.
 			n = st.children[0]
+			n.hash(append(prefix, st.key...))
 		}
-		// Convert to hash
-		n.hash()
 		var p *StackTrie
 		if diffidx == 0 {
 			// the break is on the first byte, so
@@ -280,12 +303,12 @@ func (st *StackTrie) insert(key, value []byte) {
 			// the common prefix is at least one byte
 			// long, insert a new intermediate branch
 			// node.
-			st.children[0] = stackTrieFromPool(st.db)
+			st.children[0] = stackTrieFromPool(st.db, st.owner, st.writeFn)
 			st.children[0].nodeType = branchNode
 			p = st.children[0]
 		}
 		// Create a leaf for the inserted part
-		o := newLeaf(key[diffidx+1:], value, st.db)
+		o := newLeaf(key[diffidx+1:], value, st.db, st.owner, st.writeFn)
 
 		// Insert both child leaves where they belong:
 		origIdx := st.key[diffidx]
@@ -321,7 +344,7 @@ func (st *StackTrie) insert(key, value []byte) {
 			// Convert current node into an ext,
 			// and insert a child branch node.
 			st.nodeType = extNode
-			st.children[0] = NewStackTrie(st.db)
+			st.children[0] = stackTrieFromPool(st.db, st.owner, st.writeFn)
 			st.children[0].nodeType = branchNode
 			p = st.children[0]
 		}
@@ -331,11 +354,11 @@ func (st *StackTrie) insert(key, value []byte) {
 		// The child leave will be hashed directly in order to
 		// free up some memory.
 		origIdx := st.key[diffidx]
-		p.children[origIdx] = newLeaf(st.key[diffidx+1:], st.val, st.db)
-		p.children[origIdx].hash()
+		p.children[origIdx] = newLeaf(st.key[diffidx+1:], st.val, st.db, st.owner, st.writeFn)
+		p.children[origIdx].hash(append(prefix, st.key[:diffidx+1]...))
 
 		newIdx := key[diffidx]
-		p.children[newIdx] = newLeaf(key[diffidx+1:], value, st.db)
+		p.children[newIdx] = newLeaf(key[diffidx+1:], value, st.db, st.owner, st.writeFn)
 
 		// Finally, cut off the key part that has been passed
 		// over to the children.
@@ -364,7 +387,10 @@ func (st *StackTrie) insert(key, value []byte) {
 // This method will also:
 // set 'st.type' to hashedNode
 // clear 'st.key'
-func (st *StackTrie) hash() {
+//
+// The path is the position of st in the trie, used to commit the node with
+// the path-based scheme.
+func (st *StackTrie) hash(path []byte) {
 	/* Shortcut if node is already hashed */
 	if st.nodeType == hashedNode {
 		return
@@ -382,7 +408,7 @@ func (st *StackTrie) hash() {
 				nodes[i] = nilValueNode
 				continue
 			}
-			child.hash()
+			child.hash(append(path, byte(i)))
 			if len(child.val) < 32 {
 				nodes[i] = rawNode(child.val)
 			} else {
@@ -399,7 +425,7 @@ func (st *StackTrie) hash() {
 			panic(err)
 		}
 	case extNode:
-		st.children[0].hash()
+		st.children[0].hash(append(path, st.key...))
 		h = newHasher(false)
 		defer returnHasherToPool(h)
 		h.tmp.Reset()
@@ -451,16 +477,26 @@ func (st *StackTrie) hash() {
 	h.sha.Reset()
 	h.sha.Write(h.tmp)
 	h.sha.Read(st.val)
+	st.write(path, st.val, h.tmp)
+}
+
+// write commits the node with the given path and hash into the database, if
+// one is configured.
+func (st *StackTrie) write(path []byte, hash []byte, blob []byte) {
+	if st.writeFn != nil {
+		st.writeFn(st.owner, common.CopyBytes(path), common.BytesToHash(hash), common.CopyBytes(blob))
+		return
+	}
 	if st.db != nil {
 		// TODO! Is it safe to Put the slice here?
 		// Do all db implementations copy the value provided?
-		st.db.Put(st.val, h.tmp)
+		st.db.Put(hash, blob)
 	}
 }
 
 // Hash returns the hash of the current node
 func (st *StackTrie) Hash() (h common.Hash) {
-	st.hash()
+	st.hash(nil)
 	if len(st.val) != 32 {
 		// If the node's RLP isn't 32 bytes long, the node will not
 		// be hashed, and instead contain the  rlp-encoding of the
@@ -484,10 +520,10 @@ func (st *StackTrie) Hash() (h common.Hash) {
 // The associated database is expected, otherwise the whole commit
 // functionality should be disabled.
 func (st *StackTrie) Commit() (common.Hash, error) {
-	if st.db == nil {
+	if st.db == nil && st.writeFn == nil {
 		return common.Hash{}, ErrCommitDisabled
 	}
-	st.hash()
+	st.hash(nil)
 	if len(st.val) != 32 {
 		// If the node's RLP isn't 32 bytes long, the node will not
 		// be hashed (and committed), and instead contain the  rlp-encoding of the
@@ -498,7 +534,7 @@ func (st *StackTrie) Commit() (common.Hash, error) {
 		h.sha.Reset()
 		h.sha.Write(st.val)
 		h.sha.Read(ret)
-		st.db.Put(ret, st.val)
+		st.write(nil, ret, st.val)
 		return common.BytesToHash(ret), nil
 	}
 	return common.BytesToHash(st.val), nil
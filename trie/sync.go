@@ -94,21 +94,21 @@ type SyncResult struct {
 // syncMemBatch is an in-memory buffer of successfully downloaded but not yet
 // persisted data items.
 type syncMemBatch struct {
-	nodes map[common.Hash][]byte // In-memory membatch of recently completed nodes
+	nodes map[string][]byte      // In-memory membatch of recently completed nodes, keyed by node key
 	codes map[common.Hash][]byte // In-memory membatch of recently completed codes
 }
 
 // newSyncMemBatch allocates a new memory-buffer for not-yet persisted trie nodes.
 func newSyncMemBatch() *syncMemBatch {
 	return &syncMemBatch{
-		nodes: make(map[common.Hash][]byte),
+		nodes: make(map[string][]byte),
 		codes: make(map[common.Hash][]byte),
 	}
 }
 
-// hasNode reports the trie node with specific hash is already cached.
-func (batch *syncMemBatch) hasNode(hash common.Hash) bool {
-	_, ok := batch.nodes[hash]
+// hasNode reports the trie node with specific key is already cached.
+func (batch *syncMemBatch) hasNode(key string) bool {
+	_, ok := batch.nodes[key]
 	return ok
 }
 
@@ -121,21 +121,29 @@ func (batch *syncMemBatch) hasCode(hash common.Hash) bool {
 // Sync is the main state trie synchronisation scheduler, which provides yet
 // unknown trie hashes to retrieve, accepts node data associated with said hashes
 // and reconstructs the trie step by step until all is done.
+//
+// Trie nodes are identified by their hash with the hash-based scheme. With the
+// path-based scheme, they are identified by their path instead, since the same
+// node may have to be stored at several places.
 type Sync struct {
+	scheme   string                   // Node storage scheme of the synced state
 	database ethdb.KeyValueReader     // Persistent database to check for existing entries
 	membatch *syncMemBatch            // Memory buffer to avoid frequent database writes
-	nodeReqs map[common.Hash]*request // Pending requests pertaining to a trie node hash
+	nodeReqs map[string]*request      // Pending requests pertaining to a trie node key
+	hashReqs map[common.Hash][]string // Keys of the pending trie node requests by hash (path-based scheme only)
 	codeReqs map[common.Hash]*request // Pending requests pertaining to a code hash
 	queue    *prque.Prque             // Priority queue with the pending requests
 	fetches  map[int]int              // Number of active fetches per trie node depth
 }
 
 // NewSync creates a new trie data download scheduler.
-func NewSync(root common.Hash, database ethdb.KeyValueReader, callback LeafCallback) *Sync {
+func NewSync(root common.Hash, database ethdb.KeyValueReader, callback LeafCallback, scheme string) *Sync {
 	ts := &Sync{
+		scheme:   scheme,
 		database: database,
 		membatch: newSyncMemBatch(),
-		nodeReqs: make(map[common.Hash]*request),
+		nodeReqs: make(map[string]*request),
+		hashReqs: make(map[common.Hash][]string),
 		codeReqs: make(map[common.Hash]*request),
 		queue:    prque.New(nil),
 		fetches:  make(map[int]int),
@@ -150,12 +158,12 @@ func (s *Sync) AddSubTrie(root common.Hash, path []byte, parent common.Hash, cal
 	if root == emptyRoot {
 		return
 	}
-	if s.membatch.hasNode(root) {
+	if s.membatch.hasNode(s.nodeKey(path, root)) {
 		return
 	}
 	// If database says this is a duplicate, then at least the trie node is
 	// present, and we hold the assumption that it's NOT legacy contract code.
-	if rawdb.HasTrieNode(s.database, root) {
+	if s.hasNode(path, root) {
 		return
 	}
 	// Assemble the new sub-trie sync request
@@ -166,7 +174,7 @@ func (s *Sync) AddSubTrie(root common.Hash, path []byte, parent common.Hash, cal
 	}
 	// If this sub-trie has a designated parent, link them together
 	if parent != (common.Hash{}) {
-		ancestor := s.nodeReqs[parent]
+		ancestor := s.nodeReqs[s.nodeKey(path, parent)]
 		if ancestor == nil {
 			panic(fmt.Sprintf("sub-trie ancestor not found: %x", parent))
 		}
@@ -203,7 +211,7 @@ func (s *Sync) AddCodeEntry(hash common.Hash, path []byte, parent common.Hash) {
 	}
 	// If this sub-trie has a designated parent, link them together
 	if parent != (common.Hash{}) {
-		ancestor := s.nodeReqs[parent] // the parent of codereq can ONLY be nodereq
+		ancestor := s.nodeReqs[s.nodeKey(path, parent)] // the parent of codereq can ONLY be nodereq
 		if ancestor == nil {
 			panic(fmt.Sprintf("raw-entry ancestor not found: %x", parent))
 		}
@@ -235,12 +243,14 @@ func (s *Sync) Missing(max int) (nodes []common.Hash, paths []SyncPath, codes []
 		s.queue.Pop()
 		s.fetches[depth]++
 
-		hash := item.(common.Hash)
-		if req, ok := s.nodeReqs[hash]; ok {
-			nodeHashes = append(nodeHashes, hash)
-			nodePaths = append(nodePaths, newSyncPath(req.path))
-		} else {
-			codeHashes = append(codeHashes, hash)
+		switch item := item.(type) {
+		case string:
+			if req, ok := s.nodeReqs[item]; ok {
+				nodeHashes = append(nodeHashes, req.hash)
+				nodePaths = append(nodePaths, newSyncPath(req.path))
+			}
+		case common.Hash:
+			codeHashes = append(codeHashes, item)
 		}
 	}
 	return nodeHashes, nodePaths, codeHashes
@@ -254,7 +264,8 @@ func (s *Sync) Missing(max int) (nodes []common.Hash, paths []SyncPath, codes []
 // there is no downside.
 func (s *Sync) Process(result SyncResult) error {
 	// If the item was not requested either for code or node, bail out
-	if s.nodeReqs[result.Hash] == nil && s.codeReqs[result.Hash] == nil {
+	nodeReqs := s.nodeRequests(result.Hash)
+	if len(nodeReqs) == 0 && s.codeReqs[result.Hash] == nil {
 		return ErrNotRequested
 	}
 	// There is an pending code request for this data, commit directly
@@ -265,7 +276,10 @@ func (s *Sync) Process(result SyncResult) error {
 		s.commit(req)
 	}
 	// There is an pending node request for this data, fill it.
-	if req := s.nodeReqs[result.Hash]; req != nil && req.data == nil {
+	for _, req := range nodeReqs {
+		if req.data != nil {
+			continue
+		}
 		filled = true
 		// Decode the node data content and update the request
 		node, err := decodeNode(result.Hash[:], result.Data)
@@ -299,7 +313,12 @@ func (s *Sync) Process(result SyncResult) error {
 func (s *Sync) Commit(dbw ethdb.Batch) error {
 	// Dump the membatch into a database dbw
 	for key, value := range s.membatch.nodes {
-		rawdb.WriteTrieNode(dbw, key, value)
+		if s.scheme == rawdb.PathScheme {
+			owner, path := splitSyncPath([]byte(key))
+			rawdb.WritePathTrieNode(dbw, owner, path, value)
+		} else {
+			rawdb.WriteTrieNode(dbw, common.BytesToHash([]byte(key)), value)
+		}
 	}
 	for key, value := range s.membatch.codes {
 		rawdb.WriteCode(dbw, key, value)
@@ -318,16 +337,28 @@ func (s *Sync) Pending() int {
 // is already a pending request for this node, the new request will be discarded
 // and only a parent reference added to the old one.
 func (s *Sync) schedule(req *request) {
-	var reqset = s.nodeReqs
+	var item interface{}
 	if req.code {
-		reqset = s.codeReqs
-	}
-	// If we're already requesting this node, add a new reference and stop
-	if old, ok := reqset[req.hash]; ok {
-		old.parents = append(old.parents, req.parents...)
-		return
+		// If we're already requesting this code, add a new reference and stop
+		if old, ok := s.codeReqs[req.hash]; ok {
+			old.parents = append(old.parents, req.parents...)
+			return
+		}
+		s.codeReqs[req.hash] = req
+		item = req.hash
+	} else {
+		// If we're already requesting this node, add a new reference and stop
+		key := s.nodeKey(req.path, req.hash)
+		if old, ok := s.nodeReqs[key]; ok {
+			old.parents = append(old.parents, req.parents...)
+			return
+		}
+		s.nodeReqs[key] = req
+		if s.scheme == rawdb.PathScheme {
+			s.hashReqs[req.hash] = append(s.hashReqs[req.hash], key)
+		}
+		item = key
 	}
-	reqset[req.hash] = req
 
 	// Schedule the request for future retrieval. This queue is shared
 	// by both node requests and code requests. It can happen that there
@@ -338,7 +369,7 @@ func (s *Sync) schedule(req *request) {
 	for i := 0; i < 14 && i < len(req.path); i++ {
 		prio |= int64(15-req.path[i]) << (52 - i*4) // 15-nibble => lexicographic order
 	}
-	s.queue.Push(req.hash, prio)
+	s.queue.Push(item, prio)
 }
 
 // children retrieves all the missing children of a state trie entry for future
@@ -395,12 +426,12 @@ func (s *Sync) children(req *request, object node) ([]*request, error) {
 		if node, ok := (child.node).(hashNode); ok {
 			// Try to resolve the node from the local database
 			hash := common.BytesToHash(node)
-			if s.membatch.hasNode(hash) {
+			if s.membatch.hasNode(s.nodeKey(child.path, hash)) {
 				continue
 			}
 			// If database says duplicate, then at least the trie node is present
 			// and we hold the assumption that it's NOT legacy contract code.
-			if rawdb.HasTrieNode(s.database, hash) {
+			if s.hasNode(child.path, hash) {
 				continue
 			}
 			// Locally unknown node, schedule for retrieval
@@ -425,8 +456,23 @@ func (s *Sync) commit(req *request) (err error) {
 		delete(s.codeReqs, req.hash)
 		s.fetches[len(req.path)]--
 	} else {
-		s.membatch.nodes[req.hash] = req.data
-		delete(s.nodeReqs, req.hash)
+		key := s.nodeKey(req.path, req.hash)
+		s.membatch.nodes[key] = req.data
+		delete(s.nodeReqs, key)
+		if s.scheme == rawdb.PathScheme {
+			keys := s.hashReqs[req.hash]
+			for i, k := range keys {
+				if k == key {
+					keys = append(keys[:i], keys[i+1:]...)
+					break
+				}
+			}
+			if len(keys) == 0 {
+				delete(s.hashReqs, req.hash)
+			} else {
+				s.hashReqs[req.hash] = keys
+			}
+		}
 		s.fetches[len(req.path)]--
 	}
 	// Check all parents for completion
@@ -440,3 +486,47 @@ func (s *Sync) commit(req *request) (err error) {
 	}
 	return nil
 }
+
+// nodeKey returns the key identifying the trie node with the given path and
+// hash in the scheduler.
+func (s *Sync) nodeKey(path []byte, hash common.Hash) string {
+	if s.scheme == rawdb.PathScheme {
+		return string(path)
+	}
+	return string(hash[:])
+}
+
+// nodeRequests returns the pending requests of the trie node with the given hash.
+func (s *Sync) nodeRequests(hash common.Hash) []*request {
+	if s.scheme != rawdb.PathScheme {
+		if req := s.nodeReqs[string(hash[:])]; req != nil {
+			return []*request{req}
+		}
+		return nil
+	}
+	var reqs []*request
+	for _, key := range s.hashReqs[hash] {
+		reqs = append(reqs, s.nodeReqs[key])
+	}
+	return reqs
+}
+
+// hasNode reports whether the trie node with the given path and hash is
+// already present in the database.
+func (s *Sync) hasNode(path []byte, hash common.Hash) bool {
+	if s.scheme == rawdb.PathScheme {
+		owner, inner := splitSyncPath(path)
+		return rawdb.HasPathTrieNode(s.database, owner, inner, hash)
+	}
+	return rawdb.HasTrieNode(s.database, hash)
+}
+
+// splitSyncPath splits the path of a trie node in a layered trie into the
+// hash of the account owning it and its path in the storage trie. The owner
+// is empty for the nodes of the account trie.
+func splitSyncPath(path []byte) (common.Hash, []byte) {
+	if len(path) < 2*common.HashLength {
+		return common.Hash{}, path
+	}
+	return common.BytesToHash(hexToKeybytes(path[:2*common.HashLength])), path[2*common.HashLength:]
+}
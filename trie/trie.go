@@ -24,6 +24,7 @@ import (
 	"sync"
 
 	"github.com/autonity/autonity/common"
+	"github.com/autonity/autonity/core/rawdb"
 	"github.com/autonity/autonity/core/types"
 	"github.com/autonity/autonity/crypto"
 	"github.com/autonity/autonity/log"
@@ -60,12 +61,17 @@ type LeafCallback func(paths [][]byte, hexpath []byte, leaf []byte, parent commo
 //
 // Trie is not safe for concurrent use.
 type Trie struct {
-	db   *Database
-	root node
+	db    *Database
+	root  node
+	owner common.Hash // Hash of the account owning a storage trie, empty for the account trie
 	// Keep track of the number leafs which have been inserted since the last
 	// hashing operation. This number will not directly map to the number of
 	// actually unhashed nodes
 	unhashed int
+
+	// tracer is the tool to track the trie changes, it's only used by the
+	// path-based scheme to find out the nodes to delete from the disk.
+	tracer *tracer
 }
 
 // newFlag returns the cache flag value for a newly created node.
@@ -80,11 +86,23 @@ func (t *Trie) newFlag() nodeFlag {
 // New will panic if db is nil and returns a MissingNodeError if root does
 // not exist in the database. Accessing the trie loads nodes from db on demand.
 func New(root common.Hash, db *Database) (*Trie, error) {
+	return NewWithOwner(common.Hash{}, root, db)
+}
+
+// NewWithOwner creates a trie with an existing root node from db, owned by the
+// account with the given hash. The owner is empty for the account trie and only
+// matters to the path-based scheme, where it tells apart the nodes of different
+// storage tries living at the same path.
+func NewWithOwner(owner common.Hash, root common.Hash, db *Database) (*Trie, error) {
 	if db == nil {
 		panic("trie.New called without a database")
 	}
 	trie := &Trie{
-		db: db,
+		db:    db,
+		owner: owner,
+	}
+	if db.Scheme() == rawdb.PathScheme {
+		trie.tracer = newTracer()
 	}
 	if root != (common.Hash{}) && root != emptyRoot {
 		rootnode, err := trie.resolveHash(root[:], nil)
@@ -194,7 +212,7 @@ func (t *Trie) tryGetNode(origNode node, path []byte, pos int) (item []byte, new
 		if hash == nil {
 			return nil, origNode, 0, errors.New("non-consensus node")
 		}
-		blob, err := t.db.Node(common.BytesToHash(hash))
+		blob, err := t.db.nodeBlob(t.owner, path[:pos], common.BytesToHash(hash))
 		return blob, origNode, 1, err
 	}
 	// Path still needs to be traversed, descend into children
@@ -317,6 +335,11 @@ func (t *Trie) insert(n node, prefix, key []byte, value node) (bool, node, error
 		if matchlen == 0 {
 			return true, branch, nil
 		}
+		// New branch node is created as a child of the original short node.
+		// Track the newly inserted node in the tracer. The node identifier
+		// passed is the path from the root node.
+		t.tracer.onInsert(append(prefix, key[:matchlen]...))
+
 		// Otherwise, replace it with a short node leading up to the branch.
 		return true, &shortNode{key[:matchlen], branch, t.newFlag()}, nil
 
@@ -331,6 +354,11 @@ func (t *Trie) insert(n node, prefix, key []byte, value node) (bool, node, error
 		return true, n, nil
 
 	case nil:
+		// New short node is created and track it in the tracer. The node
+		// identifier passed is the path from the root node. Note the valueNode
+		// won't be tracked since it's always embedded in its parent.
+		t.tracer.onInsert(prefix)
+
 		return true, &shortNode{key, value, t.newFlag()}, nil
 
 	case hashNode:
@@ -383,6 +411,11 @@ func (t *Trie) delete(n node, prefix, key []byte) (bool, node, error) {
 			return false, n, nil // don't replace n on mismatch
 		}
 		if matchlen == len(key) {
+			// The matched short node is deleted entirely and track
+			// it in the deletion set. The same the valueNode doesn't
+			// need to be tracked at all since it's always embedded.
+			t.tracer.onDelete(prefix)
+
 			return true, nil, nil // remove n entirely for whole matches
 		}
 		// The key is longer than n.Key. Remove the remaining suffix
@@ -401,6 +434,11 @@ func (t *Trie) delete(n node, prefix, key []byte) (bool, node, error) {
 			// always creates a new slice) instead of append to
 			// avoid modifying n.Key since it might be shared with
 			// other nodes.
+			//
+			// The child shortNode is merged into its parent, track
+			// is deleted as well.
+			t.tracer.onDelete(append(prefix, n.Key...))
+
 			return true, &shortNode{concat(n.Key, child.Key...), child.Val, t.newFlag()}, nil
 		default:
 			return true, &shortNode{n.Key, child, t.newFlag()}, nil
@@ -451,11 +489,16 @@ func (t *Trie) delete(n node, prefix, key []byte) (bool, node, error) {
 				// shortNode{..., shortNode{...}}.  Since the entry
 				// might not be loaded yet, resolve it just for this
 				// check.
-				cnode, err := t.resolve(n.Children[pos], prefix)
+				cnode, err := t.resolve(n.Children[pos], append(prefix, byte(pos)))
 				if err != nil {
 					return false, nil, err
 				}
 				if cnode, ok := cnode.(*shortNode); ok {
+					// Replace the entire full node with the short node.
+					// Mark the original short node as deleted since the
+					// value is embedded into the parent now.
+					t.tracer.onDelete(append(prefix, byte(pos)))
+
 					k := append([]byte{byte(pos)}, cnode.Key...)
 					return true, &shortNode{k, cnode.Val, t.newFlag()}, nil
 				}
@@ -506,12 +549,25 @@ func (t *Trie) resolve(n node, prefix []byte) (node, error) {
 	return n, nil
 }
 
+// resolveHash loads the node with the given hash and path from the database,
+// tracking its path as persisted.
 func (t *Trie) resolveHash(n hashNode, prefix []byte) (node, error) {
+	node, err := t.readNode(n, prefix)
+	if err != nil {
+		return nil, err
+	}
+	t.tracer.onRead(prefix)
+	return node, nil
+}
+
+// readNode loads the node with the given hash and path from the database
+// without tracking it.
+func (t *Trie) readNode(n hashNode, prefix []byte) (node, error) {
 	hash := common.BytesToHash(n)
-	if node := t.db.node(hash); node != nil {
+	if node := t.db.node(t.owner, prefix, hash); node != nil {
 		return node, nil
 	}
-	return nil, &MissingNodeError{NodeHash: hash, Path: prefix}
+	return nil, &MissingNodeError{Owner: t.owner, NodeHash: hash, Path: prefix}
 }
 
 // Hash returns the root hash of the trie. It does not write to the
@@ -529,6 +585,16 @@ func (t *Trie) Commit(onleaf LeafCallback) (common.Hash, int, error) {
 		panic("commit called on trie with nil database")
 	}
 	if t.root == nil {
+		// All the nodes were deleted, they still need to be removed from
+		// the disk with the path-based scheme.
+		if deleted := t.tracer.deletedNodes(); len(deleted) > 0 {
+			set := newNodeSet()
+			for _, path := range deleted {
+				set.markDeleted([]byte(path))
+			}
+			t.db.commitNodes(t.owner, set)
+		}
+		t.tracer.reset()
 		return emptyRoot, 0, nil
 	}
 	// Derive the hash for all dirty nodes first. We hold the assumption
@@ -543,6 +609,9 @@ func (t *Trie) Commit(onleaf LeafCallback) (common.Hash, int, error) {
 	if _, dirty := t.root.cache(); !dirty {
 		return rootHash, 0, nil
 	}
+	if t.tracer != nil {
+		h.owner, h.tracer, h.nodes = t.owner, t.tracer, newNodeSet()
+	}
 	var wg sync.WaitGroup
 	if onleaf != nil {
 		h.onleaf = onleaf
@@ -565,6 +634,17 @@ func (t *Trie) Commit(onleaf LeafCallback) (common.Hash, int, error) {
 	if err != nil {
 		return common.Hash{}, 0, err
 	}
+	if h.nodes != nil {
+		// Nodes deleted from the trie are removed from the disk, unless a new
+		// node took their place.
+		for _, path := range t.tracer.deletedNodes() {
+			if _, ok := h.nodes.nodes[path]; !ok {
+				h.nodes.markDeleted([]byte(path))
+			}
+		}
+		t.db.commitNodes(t.owner, h.nodes)
+		t.tracer.reset()
+	}
 	t.root = newRoot
 	return rootHash, committed, nil
 }
@@ -586,4 +666,5 @@ func (t *Trie) hashRoot() (node, node, error) {
 func (t *Trie) Reset() {
 	t.root = nil
 	t.unhashed = 0
+	t.tracer.reset()
 }